@@ -16,15 +16,25 @@ import (
 	"github.com/finance-tracker/backend/config"
 	"github.com/finance-tracker/backend/internal/application/adapter"
 	aicategorization "github.com/finance-tracker/backend/internal/application/usecase/ai_categorization"
+	"github.com/finance-tracker/backend/internal/application/usecase/account"
+	"github.com/finance-tracker/backend/internal/application/usecase/attachment"
 	"github.com/finance-tracker/backend/internal/application/usecase/auth"
 	"github.com/finance-tracker/backend/internal/application/usecase/category"
 	categoryrule "github.com/finance-tracker/backend/internal/application/usecase/category_rule"
 	creditcard "github.com/finance-tracker/backend/internal/application/usecase/credit_card"
 	"github.com/finance-tracker/backend/internal/application/usecase/dashboard"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
 	"github.com/finance-tracker/backend/internal/application/usecase/goal"
 	"github.com/finance-tracker/backend/internal/application/usecase/group"
+	importprofile "github.com/finance-tracker/backend/internal/application/usecase/import_profile"
+	"github.com/finance-tracker/backend/internal/application/usecase/installment"
+	"github.com/finance-tracker/backend/internal/application/usecase/merchant"
 	"github.com/finance-tracker/backend/internal/application/usecase/reconciliation"
+	recurringschedule "github.com/finance-tracker/backend/internal/application/usecase/recurring_schedule"
+	"github.com/finance-tracker/backend/internal/application/usecase/tag"
 	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
+	"github.com/finance-tracker/backend/internal/application/usecase/transfer"
+	"github.com/finance-tracker/backend/internal/application/usecase/trash"
 	"github.com/finance-tracker/backend/internal/infra/db"
 	"github.com/finance-tracker/backend/internal/infra/server/router"
 	"github.com/finance-tracker/backend/internal/integration/adapters"
@@ -32,8 +42,12 @@ import (
 	"github.com/finance-tracker/backend/internal/integration/email/templates"
 	"github.com/finance-tracker/backend/internal/integration/entrypoint/controller"
 	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
+	"github.com/finance-tracker/backend/internal/integration/export"
 	"github.com/finance-tracker/backend/internal/integration/persistence"
 	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+	"github.com/finance-tracker/backend/internal/integration/scheduler"
+	"github.com/finance-tracker/backend/internal/integration/statement"
+	"github.com/finance-tracker/backend/internal/integration/storage"
 )
 
 func main() {
@@ -55,7 +69,7 @@ func main() {
 		"port", cfg.Server.Port,
 	)
 
-	// Create a cancellable context for the email worker
+	// Create a cancellable context for the email worker and background schedulers
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -77,6 +91,7 @@ func main() {
 			&model.PasswordResetTokenModel{},
 			&model.CategoryModel{},
 			&model.TransactionModel{},
+			&model.TransactionSplitModel{},
 			&model.GoalModel{},
 			&model.GroupModel{},
 			&model.GroupMemberModel{},
@@ -84,6 +99,20 @@ func main() {
 			&model.CategoryRuleModel{},
 			&model.EmailQueueModel{},
 			&model.AISuggestionModel{},
+			&model.ImportProfileModel{},
+			&model.DuplicateDismissalModel{},
+			&model.RecurringScheduleModel{},
+			&model.GoalAlertModel{},
+			&model.GoalContributionModel{},
+			&model.AccountModel{},
+			&model.BillingCycleOverrideModel{},
+			&model.ExchangeRateModel{},
+			&model.TagModel{},
+			&model.TransactionTagModel{},
+			&model.MerchantModel{},
+			&model.InstallmentPlanModel{},
+			&model.AttachmentModel{},
+			&model.TransactionChangeModel{},
 		); err != nil {
 			slog.Error("Failed to run database migrations", "error", err)
 			os.Exit(1)
@@ -113,6 +142,17 @@ func main() {
 	var categoryRuleController *controller.CategoryRuleController
 	var dashboardController *controller.DashboardController
 	var aiCategorizationController *controller.AiCategorizationController
+	var importController *controller.ImportController
+	var importProfileController *controller.ImportProfileController
+	var recurringScheduleController *controller.RecurringScheduleController
+	var accountController *controller.AccountController
+	var transferController *controller.TransferController
+	var exchangeRateController *controller.ExchangeRateController
+	var tagController *controller.TagController
+	var merchantController *controller.MerchantController
+	var installmentController *controller.InstallmentController
+	var attachmentController *controller.AttachmentController
+	var trashController *controller.TrashController
 	var loginRateLimiter *middleware.RateLimiter
 	var authMiddleware *middleware.AuthMiddleware
 
@@ -122,17 +162,39 @@ func main() {
 		tokenRepo := persistence.NewTokenRepository(database.DB())
 		categoryRepo := persistence.NewCategoryRepository(database.DB())
 		transactionRepo := persistence.NewTransactionRepository(database.DB())
+		transactionChangeRepo := persistence.NewTransactionChangeRepository(database.DB())
+		txManager := persistence.NewTxManager(database.DB())
 		goalRepo := persistence.NewGoalRepository(database.DB())
+		goalContributionRepo := persistence.NewGoalContributionRepository(database.DB())
 		groupRepo := persistence.NewGroupRepository(database.DB())
 		categoryRuleRepo := persistence.NewCategoryRuleRepository(database.DB())
 		emailQueueRepo := persistence.NewEmailQueueRepository(database.DB())
 		aiSuggestionRepo := persistence.NewAISuggestionRepository(database.DB())
+		importProfileRepo := persistence.NewImportProfileRepository(database.DB())
+		duplicateDismissalRepo := persistence.NewDuplicateDismissalRepository(database.DB())
+		recurringScheduleRepo := persistence.NewRecurringScheduleRepository(database.DB())
+		goalAlertRepo := persistence.NewGoalAlertRepository(database.DB())
+		accountRepo := persistence.NewAccountRepository(database.DB())
+		billingCycleOverrideRepo := persistence.NewBillingCycleOverrideRepository(database.DB())
+		exchangeRateRepo := persistence.NewExchangeRateRepository(database.DB())
+		tagRepo := persistence.NewTagRepository(database.DB())
+		merchantRepo := persistence.NewMerchantRepository(database.DB())
+		installmentPlanRepo := persistence.NewInstallmentPlanRepository(database.DB())
+		attachmentRepo := persistence.NewAttachmentRepository(database.DB())
+		trashRepo := persistence.NewTrashRepository(database.DB())
 
 		// Create adapters/services
 		passwordService := adapters.NewPasswordService()
 		tokenService := adapters.NewTokenService(cfg.JWT.Secret, tokenRepo)
 		resetTokenService := adapters.NewPasswordResetTokenService(tokenRepo)
 		geminiService := adapters.NewGeminiService(cfg.AI.GeminiAPIKey)
+		csvParser := statement.NewCSVParser()
+		exchangeRateParser := statement.NewExchangeRateParser()
+		cardStatementParser := statement.NewCardStatementParser(statement.DefaultCardStatementLayouts()...)
+		currencyConverter := exchangerate.NewConverter(exchangeRateRepo, userRepo)
+		merchantResolver := merchant.NewResolver(merchantRepo)
+		installmentTracker := installment.NewTracker(installmentPlanRepo)
+		calendarLoader := account.NewCalendarLoader(billingCycleOverrideRepo)
 		processingTracker := aicategorization.NewInMemoryProcessingTracker()
 
 		// Create email infrastructure
@@ -180,6 +242,66 @@ func main() {
 			slog.Info("Email worker disabled")
 		}
 
+		// Create and start goal alert scheduler if enabled
+		var goalAlertNotifier adapter.GoalAlertNotifier
+		if cfg.GoalAlerts.SchedulerEnabled {
+			evaluateGoalAlertsUseCase := goal.NewEvaluateGoalAlertsUseCase(goalRepo, goalAlertRepo, userRepo, categoryRepo, emailService, txManager)
+			goalAlertScheduler := scheduler.NewGoalAlertScheduler(evaluateGoalAlertsUseCase, scheduler.GoalAlertSchedulerConfig{
+				Interval: cfg.GoalAlerts.Interval,
+			})
+			goalAlertNotifier = goalAlertScheduler
+
+			// Start goal alert scheduler in background
+			go goalAlertScheduler.Start(ctx)
+		} else {
+			slog.Info("Goal alert scheduler disabled")
+		}
+
+		// Create attachment object storage
+		var objectStorage adapter.ObjectStorage
+		if cfg.Attachments.Storage == "local" {
+			localStorage, err := storage.NewLocalStorage(cfg.Attachments.LocalDir)
+			if err != nil {
+				slog.Error("Failed to initialize local attachment storage", "error", err)
+				os.Exit(1)
+			}
+			objectStorage = localStorage
+			slog.Warn("Using local attachment storage", "dir", cfg.Attachments.LocalDir)
+		} else {
+			s3Storage, err := storage.NewS3Storage(storage.S3Config{
+				Endpoint:  cfg.MinIO.Endpoint,
+				AccessKey: cfg.MinIO.AccessKey,
+				SecretKey: cfg.MinIO.SecretKey,
+				Bucket:    cfg.MinIO.Bucket,
+				Region:    cfg.MinIO.Region,
+				UseSSL:    cfg.MinIO.UseSSL,
+			})
+			if err != nil {
+				slog.Error("Failed to initialize attachment storage", "error", err)
+				os.Exit(1)
+			}
+			if err := s3Storage.EnsureBucket(ctx); err != nil {
+				slog.Warn("Failed to ensure attachment bucket exists", "bucket", cfg.MinIO.Bucket, "error", err)
+			}
+			objectStorage = s3Storage
+		}
+		thumbnailGenerator := storage.NewImageThumbnailGenerator(storage.DefaultThumbnailSize)
+
+		// Create and start attachment cleanup scheduler if enabled
+		var attachmentCleanupNotifier adapter.AttachmentCleanupNotifier
+		if cfg.Attachments.CleanupEnabled {
+			purgeOrphanedAttachmentsUseCase := attachment.NewPurgeOrphanedAttachmentsUseCase(attachmentRepo, objectStorage)
+			attachmentCleanupScheduler := scheduler.NewAttachmentCleanupScheduler(purgeOrphanedAttachmentsUseCase, scheduler.AttachmentCleanupSchedulerConfig{
+				Interval: cfg.Attachments.CleanupInterval,
+			})
+			attachmentCleanupNotifier = attachmentCleanupScheduler
+
+			// Start attachment cleanup scheduler in background
+			go attachmentCleanupScheduler.Start(ctx)
+		} else {
+			slog.Info("Attachment cleanup scheduler disabled")
+		}
+
 		// Create auth use cases
 		registerUseCase := auth.NewRegisterUserUseCase(userRepo, passwordService, tokenService)
 		loginUseCase := auth.NewLoginUserUseCase(userRepo, passwordService, tokenService)
@@ -187,27 +309,53 @@ func main() {
 		logoutUseCase := auth.NewLogoutUserUseCase(tokenService)
 		forgotPasswordUseCase := auth.NewForgotPasswordUseCase(userRepo, resetTokenService, emailService, cfg.Email.AppBaseURL)
 		resetPasswordUseCase := auth.NewResetPasswordUseCase(userRepo, passwordService, resetTokenService)
-		deleteAccountUseCase := auth.NewDeleteAccountUseCase(userRepo, passwordService, tokenService)
+		deleteAccountUseCase := auth.NewDeleteAccountUseCase(userRepo, passwordService, tokenService, attachmentCleanupNotifier)
 
 		// Create category use cases
 		listCategoriesUseCase := category.NewListCategoriesUseCase(categoryRepo)
 		createCategoryUseCase := category.NewCreateCategoryUseCase(categoryRepo)
 		updateCategoryUseCase := category.NewUpdateCategoryUseCase(categoryRepo)
-		deleteCategoryUseCase := category.NewDeleteCategoryUseCase(categoryRepo)
+		deleteCategoryUseCase := category.NewDeleteCategoryUseCase(categoryRepo, transactionRepo, transactionChangeRepo)
 
 		// Create transaction use cases
-		listTransactionsUseCase := transaction.NewListTransactionsUseCase(transactionRepo)
-		createTransactionUseCase := transaction.NewCreateTransactionUseCase(transactionRepo, categoryRepo, categoryRuleRepo)
-		updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(transactionRepo, categoryRepo)
-		deleteTransactionUseCase := transaction.NewDeleteTransactionUseCase(transactionRepo)
-		bulkDeleteTransactionsUseCase := transaction.NewBulkDeleteTransactionsUseCase(transactionRepo)
-		bulkCategorizeTransactionsUseCase := transaction.NewBulkCategorizeTransactionsUseCase(transactionRepo, categoryRepo)
+		listTransactionsUseCase := transaction.NewListTransactionsUseCase(transactionRepo, accountRepo)
+		createTransactionUseCase := transaction.NewCreateTransactionUseCase(transactionRepo, transactionChangeRepo, categoryRepo, categoryRuleRepo, accountRepo, goalAlertNotifier, currencyConverter, merchantResolver, calendarLoader)
+		updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(transactionRepo, transactionChangeRepo, categoryRepo, accountRepo, goalAlertNotifier, currencyConverter)
+		deleteTransactionUseCase := transaction.NewDeleteTransactionUseCase(transactionRepo, transactionChangeRepo, attachmentCleanupNotifier)
+		bulkDeleteTransactionsUseCase := transaction.NewBulkDeleteTransactionsUseCase(transactionRepo, transactionChangeRepo, attachmentCleanupNotifier)
+		bulkCategorizeTransactionsUseCase := transaction.NewBulkCategorizeTransactionsUseCase(transactionRepo, transactionChangeRepo, categoryRepo)
+		listDuplicatesUseCase := transaction.NewListDuplicatesUseCase(transactionRepo, duplicateDismissalRepo)
+		mergeDuplicateUseCase := transaction.NewMergeDuplicateUseCase(transactionRepo, transactionChangeRepo, attachmentRepo, txManager)
+		dismissDuplicateUseCase := transaction.NewDismissDuplicateUseCase(transactionRepo, duplicateDismissalRepo)
+		splitTransactionUseCase := transaction.NewSplitTransactionUseCase(transactionRepo, transactionChangeRepo, categoryRepo, goalAlertNotifier)
+		unsplitTransactionUseCase := transaction.NewUnsplitTransactionUseCase(transactionRepo, transactionChangeRepo, goalAlertNotifier)
+		bulkTagTransactionsUseCase := transaction.NewBulkTagTransactionsUseCase(transactionRepo, transactionChangeRepo, tagRepo)
+		getTransactionHistoryUseCase := transaction.NewGetTransactionHistoryUseCase(transactionRepo, transactionChangeRepo)
+		revertTransactionChangeUseCase := transaction.NewRevertTransactionChangeUseCase(transactionRepo, transactionChangeRepo, goalAlertNotifier)
+		revertTransactionOperationUseCase := transaction.NewRevertTransactionOperationUseCase(transactionRepo, transactionChangeRepo, goalAlertNotifier)
+		bulkUpdateTransactionsUseCase := transaction.NewBulkUpdateTransactionsUseCase(transactionRepo, transactionChangeRepo, categoryRepo, currencyConverter, goalAlertNotifier)
+		exportTransactionsUseCase := transaction.NewExportTransactionsUseCase(transactionRepo, userRepo, categoryRepo, accountRepo, map[adapter.ExportFormat]adapter.TransactionExporter{
+			adapter.ExportFormatCSV:  export.NewCSVExporter(),
+			adapter.ExportFormatXLSX: export.NewXLSXExporter(),
+			adapter.ExportFormatOFX:  export.NewOFXExporter(),
+		})
+		importStatementUseCase := transaction.NewImportStatementUseCase(transactionRepo, transactionChangeRepo, txManager, categoryRepo, categoryRuleRepo, goalAlertNotifier, currencyConverter, merchantResolver, installmentTracker)
+		previewCSVImportUseCase := transaction.NewPreviewCSVImportUseCase(transactionRepo, categoryRepo, categoryRuleRepo, importProfileRepo, userRepo, csvParser)
+		importCSVUseCase := transaction.NewImportCSVUseCase(transactionRepo, transactionChangeRepo, txManager, categoryRepo, categoryRuleRepo, importProfileRepo, userRepo, csvParser, goalAlertNotifier, currencyConverter, merchantResolver, installmentTracker)
 
 		// Create credit card use cases
 		previewImportUseCase := creditcard.NewPreviewImportUseCase(transactionRepo)
-		importTransactionsUseCase := creditcard.NewImportTransactionsUseCase(transactionRepo, categoryRepo, categoryRuleRepo)
-		collapseExpansionUseCase := creditcard.NewCollapseExpansionUseCase(transactionRepo)
+		importTransactionsUseCase := creditcard.NewImportTransactionsUseCase(transactionRepo, transactionChangeRepo, txManager, categoryRepo, categoryRuleRepo, accountRepo, goalAlertNotifier, currencyConverter, merchantResolver, installmentTracker, calendarLoader)
+		collapseExpansionUseCase := creditcard.NewCollapseExpansionUseCase(transactionRepo, transactionChangeRepo)
 		getStatusUseCase := creditcard.NewGetStatusUseCase(transactionRepo)
+		getForecastUseCase := creditcard.NewGetForecastUseCase(
+			transactionRepo,
+			accountRepo,
+			calendarLoader,
+			installment.NewGetCommitmentsUseCase(installmentPlanRepo, currencyConverter),
+			currencyConverter,
+		)
+		parseStatementPDFUseCase := creditcard.NewParseStatementPDFUseCase(accountRepo, calendarLoader, cardStatementParser)
 
 		// Create reconciliation repository and use cases
 		reconciliationRepo := persistence.NewReconciliationRepository(database.DB())
@@ -218,12 +366,21 @@ func main() {
 		unlinkUseCase := reconciliation.NewUnlinkUseCase(reconciliationRepo)
 		triggerReconciliationUseCase := reconciliation.NewTriggerReconciliationUseCase(reconciliationRepo)
 
+		// Create journal export use case; bill payments are matched to billing cycles through reconciliation
+		exportJournalUseCase := transaction.NewExportJournalUseCase(transactionRepo, userRepo, categoryRepo, accountRepo, reconciliationRepo, map[adapter.JournalFormat]adapter.JournalExporter{
+			adapter.JournalFormatBeancount: export.NewBeancountExporter(),
+			adapter.JournalFormatHledger:   export.NewHledgerExporter(),
+		})
+
 		// Create goal use cases
-		listGoalsUseCase := goal.NewListGoalsUseCase(goalRepo, categoryRepo)
+		listGoalsUseCase := goal.NewListGoalsUseCase(goalRepo, categoryRepo, goalContributionRepo)
 		createGoalUseCase := goal.NewCreateGoalUseCase(goalRepo, categoryRepo)
-		getGoalUseCase := goal.NewGetGoalUseCase(goalRepo, categoryRepo)
+		getGoalUseCase := goal.NewGetGoalUseCase(goalRepo, categoryRepo, goalContributionRepo)
 		updateGoalUseCase := goal.NewUpdateGoalUseCase(goalRepo)
 		deleteGoalUseCase := goal.NewDeleteGoalUseCase(goalRepo)
+		listGoalContributionsUseCase := goal.NewListGoalContributionsUseCase(goalRepo, goalContributionRepo)
+		addGoalContributionUseCase := goal.NewAddGoalContributionUseCase(goalRepo, goalContributionRepo, transactionRepo)
+		deleteGoalContributionUseCase := goal.NewDeleteGoalContributionUseCase(goalRepo, goalContributionRepo)
 
 		// Create group use cases
 		createGroupUseCase := group.NewCreateGroupUseCase(groupRepo, userRepo)
@@ -239,12 +396,40 @@ func main() {
 
 		// Create category rule use cases
 		listCategoryRulesUseCase := categoryrule.NewListCategoryRulesUseCase(categoryRuleRepo)
-		createCategoryRuleUseCase := categoryrule.NewCreateCategoryRuleUseCase(categoryRuleRepo, categoryRepo, transactionRepo)
+		createCategoryRuleUseCase := categoryrule.NewCreateCategoryRuleUseCase(categoryRuleRepo, categoryRepo, transactionRepo, transactionChangeRepo)
 		updateCategoryRuleUseCase := categoryrule.NewUpdateCategoryRuleUseCase(categoryRuleRepo, categoryRepo)
 		deleteCategoryRuleUseCase := categoryrule.NewDeleteCategoryRuleUseCase(categoryRuleRepo)
 		reorderCategoryRulesUseCase := categoryrule.NewReorderCategoryRulesUseCase(categoryRuleRepo)
 		testPatternUseCase := categoryrule.NewTestPatternUseCase(categoryRuleRepo)
 
+		// Create import profile use cases
+		listImportProfilesUseCase := importprofile.NewListImportProfilesUseCase(importProfileRepo)
+		createImportProfileUseCase := importprofile.NewCreateImportProfileUseCase(importProfileRepo)
+		updateImportProfileUseCase := importprofile.NewUpdateImportProfileUseCase(importProfileRepo)
+		deleteImportProfileUseCase := importprofile.NewDeleteImportProfileUseCase(importProfileRepo)
+
+		// Create recurring schedule use cases
+		listRecurringSchedulesUseCase := recurringschedule.NewListRecurringSchedulesUseCase(recurringScheduleRepo, categoryRepo)
+		getRecurringScheduleUseCase := recurringschedule.NewGetRecurringScheduleUseCase(recurringScheduleRepo, categoryRepo)
+		createRecurringScheduleUseCase := recurringschedule.NewCreateRecurringScheduleUseCase(recurringScheduleRepo, categoryRepo)
+		updateRecurringScheduleUseCase := recurringschedule.NewUpdateRecurringScheduleUseCase(recurringScheduleRepo, categoryRepo)
+		deleteRecurringScheduleUseCase := recurringschedule.NewDeleteRecurringScheduleUseCase(recurringScheduleRepo)
+		processRecurringSchedulesUseCase := recurringschedule.NewProcessRecurringSchedulesUseCase(recurringScheduleRepo, userRepo, emailService, currencyConverter)
+
+		// Create and start recurring scheduler if enabled
+		if cfg.Recurring.SchedulerEnabled {
+			recurringScheduler := scheduler.NewRecurringScheduler(processRecurringSchedulesUseCase, scheduler.RecurringSchedulerConfig{
+				Interval:      cfg.Recurring.Interval,
+				LookaheadDays: cfg.Recurring.LookaheadDays,
+				ReminderDays:  cfg.Recurring.ReminderDays,
+			})
+
+			// Start recurring scheduler in background
+			go recurringScheduler.Start(ctx)
+		} else {
+			slog.Info("Recurring scheduler disabled")
+		}
+
 		// Create auth controller
 		authController = controller.NewAuthController(
 			registerUseCase,
@@ -258,6 +443,7 @@ func main() {
 		// Create user controller
 		userController = controller.NewUserController(
 			deleteAccountUseCase,
+			exchangerate.NewUpdateBaseCurrencyUseCase(userRepo, transactionRepo, transactionChangeRepo, currencyConverter),
 		)
 
 		// Create category controller
@@ -276,14 +462,134 @@ func main() {
 			deleteTransactionUseCase,
 			bulkDeleteTransactionsUseCase,
 			bulkCategorizeTransactionsUseCase,
+			listDuplicatesUseCase,
+			mergeDuplicateUseCase,
+			dismissDuplicateUseCase,
+			splitTransactionUseCase,
+			unsplitTransactionUseCase,
+			bulkTagTransactionsUseCase,
+			getTransactionHistoryUseCase,
+			revertTransactionChangeUseCase,
+			revertTransactionOperationUseCase,
+			bulkUpdateTransactionsUseCase,
+			exportTransactionsUseCase,
+			exportJournalUseCase,
+		)
+
+		// Create statement import controller
+		importController = controller.NewImportController(
+			importStatementUseCase,
+			previewCSVImportUseCase,
+			importCSVUseCase,
+		)
+
+		// Create import profile controller
+		importProfileController = controller.NewImportProfileController(
+			listImportProfilesUseCase,
+			createImportProfileUseCase,
+			updateImportProfileUseCase,
+			deleteImportProfileUseCase,
+		)
+
+		// Create recurring schedule controller
+		recurringScheduleController = controller.NewRecurringScheduleController(
+			listRecurringSchedulesUseCase,
+			getRecurringScheduleUseCase,
+			createRecurringScheduleUseCase,
+			updateRecurringScheduleUseCase,
+			deleteRecurringScheduleUseCase,
+		)
+
+		// Create account controller
+		accountController = controller.NewAccountController(
+			account.NewListAccountsUseCase(accountRepo),
+			account.NewGetAccountUseCase(accountRepo),
+			account.NewCreateAccountUseCase(accountRepo),
+			account.NewUpdateAccountUseCase(accountRepo),
+			account.NewDeleteAccountUseCase(accountRepo, transactionRepo, transactionChangeRepo, attachmentCleanupNotifier),
+			account.NewListBillingCyclesUseCase(accountRepo, billingCycleOverrideRepo),
+			account.NewSetBillingCycleOverrideUseCase(accountRepo, billingCycleOverrideRepo),
+			account.NewDeleteBillingCycleOverrideUseCase(accountRepo, billingCycleOverrideRepo),
+			account.NewGetOpenBillUseCase(accountRepo, billingCycleOverrideRepo, currencyConverter),
+		)
+
+		// Create transfer controller
+		transferController = controller.NewTransferController(
+			transfer.NewGetTransferUseCase(transactionRepo),
+			transfer.NewCreateTransferUseCase(transactionRepo, transactionChangeRepo, accountRepo),
+			transfer.NewUpdateTransferUseCase(transactionRepo, transactionChangeRepo, accountRepo),
+			transfer.NewDeleteTransferUseCase(transactionRepo, transactionChangeRepo, attachmentCleanupNotifier),
+			transfer.NewConvertTransactionUseCase(transactionRepo, transactionChangeRepo, accountRepo, goalAlertNotifier),
+		)
+
+		// Create exchange rate controller
+		exchangeRateController = controller.NewExchangeRateController(
+			exchangerate.NewListExchangeRatesUseCase(exchangeRateRepo, currencyConverter),
+			exchangerate.NewCreateExchangeRateUseCase(exchangeRateRepo),
+			exchangerate.NewDeleteExchangeRateUseCase(exchangeRateRepo),
+			exchangerate.NewImportExchangeRatesUseCase(exchangeRateRepo, exchangeRateParser),
 		)
 
+		// Create tag controller
+		tagController = controller.NewTagController(
+			tag.NewListTagsUseCase(tagRepo),
+			tag.NewCreateTagUseCase(tagRepo),
+			tag.NewUpdateTagUseCase(tagRepo),
+			tag.NewDeleteTagUseCase(tagRepo),
+		)
+
+		// Create merchant controller
+		merchantController = controller.NewMerchantController(
+			merchant.NewListMerchantsUseCase(merchantRepo),
+			merchant.NewUpdateMerchantUseCase(merchantRepo, categoryRepo),
+			merchant.NewMergeMerchantsUseCase(merchantRepo),
+		)
+
+		// Create installment controller
+		installmentController = controller.NewInstallmentController(
+			installment.NewListPlansUseCase(installmentPlanRepo),
+			installment.NewGetPlanUseCase(installmentPlanRepo),
+			installment.NewGetCommitmentsUseCase(installmentPlanRepo, currencyConverter),
+		)
+
+		// Create attachment controller
+		attachmentController = controller.NewAttachmentController(
+			attachment.NewListAttachmentsUseCase(attachmentRepo, transactionRepo, accountRepo),
+			attachment.NewUploadAttachmentUseCase(attachmentRepo, transactionRepo, accountRepo, objectStorage, thumbnailGenerator),
+			attachment.NewCreateUploadURLUseCase(attachmentRepo, transactionRepo, accountRepo, objectStorage, cfg.Attachments.URLExpiry),
+			attachment.NewCompleteUploadUseCase(attachmentRepo, objectStorage, thumbnailGenerator),
+			attachment.NewGetAttachmentUseCase(attachmentRepo, objectStorage, cfg.Attachments.URLExpiry),
+			attachment.NewGetAttachmentContentUseCase(attachmentRepo, objectStorage),
+			attachment.NewDeleteAttachmentUseCase(attachmentRepo, objectStorage),
+		)
+
+		// Create trash controller
+		trashController = controller.NewTrashController(
+			trash.NewListTrashUseCase(trashRepo, cfg.Trash.RetentionDays),
+			trash.NewRestoreTrashItemsUseCase(trashRepo, transactionRepo, transactionChangeRepo, goalAlertNotifier, cfg.Trash.RetentionDays),
+		)
+
+		// Create and start trash retention scheduler if enabled
+		if cfg.Trash.PurgeEnabled {
+			trashRetentionScheduler := scheduler.NewTrashRetentionScheduler(trash.NewPurgeTrashUseCase(trashRepo), scheduler.TrashRetentionSchedulerConfig{
+				Interval:      cfg.Trash.PurgeInterval,
+				RetentionDays: cfg.Trash.RetentionDays,
+			})
+
+			// Start trash retention scheduler in background
+			go trashRetentionScheduler.Start(ctx)
+		} else {
+			slog.Info("Trash retention scheduler disabled")
+		}
+
 		// Create credit card controller
 		creditCardController = controller.NewCreditCardController(
 			previewImportUseCase,
 			importTransactionsUseCase,
 			collapseExpansionUseCase,
 			getStatusUseCase,
+			getForecastUseCase,
+			parseStatementPDFUseCase,
 		)
 
 		// Create reconciliation controller
@@ -303,6 +609,9 @@ func main() {
 			getGoalUseCase,
 			updateGoalUseCase,
 			deleteGoalUseCase,
+			listGoalContributionsUseCase,
+			addGoalContributionUseCase,
+			deleteGoalContributionUseCase,
 		)
 
 		// Create group controller
@@ -339,6 +648,8 @@ func main() {
 		getTrendsUseCase := dashboard.NewGetTrendsUseCase(dashboardRepo)
 		getCategoryBreakdownUseCase := dashboard.NewGetCategoryBreakdownUseCase(dashboardRepo)
 		getPeriodTransactionsUseCase := dashboard.NewGetPeriodTransactionsUseCase(dashboardRepo)
+		getTagBreakdownUseCase := dashboard.NewGetTagBreakdownUseCase(dashboardRepo)
+		getTopMerchantsUseCase := dashboard.NewGetTopMerchantsUseCase(dashboardRepo)
 
 		// Create dashboard controller
 		dashboardController = controller.NewDashboardController(
@@ -347,13 +658,15 @@ func main() {
 			getTrendsUseCase,
 			getCategoryBreakdownUseCase,
 			getPeriodTransactionsUseCase,
+			getTagBreakdownUseCase,
+			getTopMerchantsUseCase,
 		)
 
 		// Create AI categorization use cases
 		aiGetStatusUseCase := aicategorization.NewGetStatusUseCase(transactionRepo, aiSuggestionRepo, processingTracker)
 		aiStartCategorizationUseCase := aicategorization.NewStartCategorizationUseCase(transactionRepo, categoryRepo, aiSuggestionRepo, geminiService, processingTracker)
 		aiGetSuggestionsUseCase := aicategorization.NewGetSuggestionsUseCase(aiSuggestionRepo)
-		aiApproveSuggestionUseCase := aicategorization.NewApproveSuggestionUseCase(aiSuggestionRepo, categoryRepo, transactionRepo, categoryRuleRepo)
+		aiApproveSuggestionUseCase := aicategorization.NewApproveSuggestionUseCase(aiSuggestionRepo, categoryRepo, transactionRepo, transactionChangeRepo, categoryRuleRepo)
 		aiRejectSuggestionUseCase := aicategorization.NewRejectSuggestionUseCase(aiSuggestionRepo, geminiService, transactionRepo, categoryRepo)
 		aiClearSuggestionsUseCase := aicategorization.NewClearSuggestionsUseCase(aiSuggestionRepo)
 
@@ -377,7 +690,7 @@ func main() {
 	}
 
 	// Setup router
-	r := router.NewRouter(healthController, authController, userController, categoryController, transactionController, creditCardController, reconciliationController, goalController, groupController, categoryRuleController, dashboardController, aiCategorizationController, loginRateLimiter, authMiddleware)
+	r := router.NewRouter(healthController, authController, userController, categoryController, transactionController, creditCardController, reconciliationController, goalController, groupController, categoryRuleController, dashboardController, aiCategorizationController, importController, importProfileController, recurringScheduleController, accountController, transferController, exchangeRateController, tagController, merchantController, installmentController, attachmentController, trashController, loginRateLimiter, authMiddleware)
 	engine := r.Setup(cfg.Server.Environment)
 
 	// Create HTTP server
@@ -405,7 +718,7 @@ func main() {
 
 	slog.Info("Shutting down server...")
 
-	// Cancel context to stop email worker
+	// Cancel context to stop email worker and background schedulers
 	cancel()
 
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -0,0 +1,136 @@
+// Package main is the entry point for the Finance Tracker command-line tool.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+
+	"github.com/finance-tracker/backend/config"
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
+	"github.com/finance-tracker/backend/internal/infra/db"
+	"github.com/finance-tracker/backend/internal/integration/export"
+	"github.com/finance-tracker/backend/internal/integration/persistence"
+)
+
+const usage = `Usage: finance-tracker-cli <command> [flags]
+
+Commands:
+  export-journal   Export a user's ledger as a Beancount or hledger journal
+
+Run "finance-tracker-cli <command> -h" for the flags of a command.
+`
+
+func main() {
+	// Load .env file if it exists (development only)
+	_ = godotenv.Load()
+
+	// Log to stderr, so exports can be written to stdout
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	})))
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	var err error
+	switch os.Args[1] {
+	case "export-journal":
+		err = exportJournal(ctx, os.Args[2:])
+	case "-h", "--help", "help":
+		fmt.Fprint(os.Stdout, usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// exportJournal writes the ledger of a user as a plain-text accounting journal.
+func exportJournal(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("export-journal", flag.ExitOnError)
+	user := flags.String("user", "", "email or ID of the user whose ledger is exported (required)")
+	format := flags.String("format", string(adapter.JournalFormatBeancount), "journal format: beancount or hledger")
+	output := flags.String("output", "", "file to write the journal to (default: stdout)")
+	_ = flags.Parse(args)
+
+	if *user == "" {
+		flags.Usage()
+		return fmt.Errorf("-user is required")
+	}
+
+	// Connect to the API database
+	cfg := config.Load()
+	database, err := db.NewPostgresConnection(&cfg.Database)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer database.Close()
+
+	userRepo := persistence.NewUserRepository(database.DB())
+	useCase := transaction.NewExportJournalUseCase(
+		persistence.NewTransactionRepository(database.DB()),
+		userRepo,
+		persistence.NewCategoryRepository(database.DB()),
+		persistence.NewAccountRepository(database.DB()),
+		persistence.NewReconciliationRepository(database.DB()),
+		map[adapter.JournalFormat]adapter.JournalExporter{
+			adapter.JournalFormatBeancount: export.NewBeancountExporter(),
+			adapter.JournalFormatHledger:   export.NewHledgerExporter(),
+		},
+	)
+
+	// Resolve the user by ID or email
+	userID, err := uuid.Parse(*user)
+	if err != nil {
+		found, err := userRepo.FindByEmail(ctx, *user)
+		if err != nil {
+			return fmt.Errorf("failed to find user %q: %w", *user, err)
+		}
+		userID = found.ID
+	}
+
+	journal, err := useCase.Execute(ctx, transaction.ExportJournalInput{
+		UserID: userID,
+		Format: adapter.JournalFormat(*format),
+	})
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		return journal.Stream(ctx, os.Stdout)
+	}
+
+	file, err := os.Create(*output)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", *output, err)
+	}
+	if err := journal.Stream(ctx, file); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", *output, err)
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %s\n", *output)
+	return nil
+}
@@ -10,13 +10,17 @@ import (
 
 // Config holds all application configuration.
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	JWT      JWTConfig
-	MinIO    MinIOConfig
-	Email    EmailConfig
-	AI       AIConfig
+	Server      ServerConfig
+	Database    DatabaseConfig
+	Redis       RedisConfig
+	JWT         JWTConfig
+	MinIO       MinIOConfig
+	Email       EmailConfig
+	AI          AIConfig
+	Recurring   RecurringConfig
+	GoalAlerts  GoalAlertConfig
+	Attachments AttachmentConfig
+	Trash       TrashConfig
 }
 
 // AIConfig holds AI service configuration.
@@ -61,6 +65,7 @@ type MinIOConfig struct {
 	AccessKey string
 	SecretKey string
 	Bucket    string
+	Region    string
 	UseSSL    bool
 }
 
@@ -75,6 +80,36 @@ type EmailConfig struct {
 	BatchSize     int
 }
 
+// RecurringConfig holds recurring transaction scheduler configuration.
+type RecurringConfig struct {
+	SchedulerEnabled bool
+	Interval         time.Duration
+	LookaheadDays    int // Generate transactions this many days before their date
+	ReminderDays     int // Remind users about transactions this many days ahead (0 disables)
+}
+
+// GoalAlertConfig holds goal alert evaluation configuration.
+type GoalAlertConfig struct {
+	SchedulerEnabled bool
+	Interval         time.Duration
+}
+
+// AttachmentConfig holds receipt and document attachment configuration.
+type AttachmentConfig struct {
+	Storage         string        // "minio" for S3-compatible storage, "local" for the filesystem
+	LocalDir        string        // Root directory used by the local storage
+	URLExpiry       time.Duration // Lifetime of presigned upload and download URLs
+	CleanupEnabled  bool
+	CleanupInterval time.Duration
+}
+
+// TrashConfig holds trash retention configuration.
+type TrashConfig struct {
+	RetentionDays int // Deleted items are permanently deleted after this many days
+	PurgeEnabled  bool
+	PurgeInterval time.Duration
+}
+
 // Load loads configuration from environment variables.
 func Load() *Config {
 	return &Config{
@@ -106,6 +141,7 @@ func Load() *Config {
 			AccessKey: getEnv("MINIO_ACCESS_KEY", "minioadmin"),
 			SecretKey: getEnv("MINIO_SECRET_KEY", "minioadmin123"),
 			Bucket:    getEnv("MINIO_BUCKET", "finance-tracker-uploads"),
+			Region:    getEnv("MINIO_REGION", "us-east-1"),
 			UseSSL:    getEnvAsBool("MINIO_USE_SSL", false),
 		},
 		Email: EmailConfig{
@@ -120,6 +156,28 @@ func Load() *Config {
 		AI: AIConfig{
 			GeminiAPIKey: getEnv("GEMINI_API_KEY", ""),
 		},
+		Recurring: RecurringConfig{
+			SchedulerEnabled: getEnvAsBool("RECURRING_SCHEDULER_ENABLED", true),
+			Interval:         getEnvAsDuration("RECURRING_SCHEDULER_INTERVAL", time.Hour),
+			LookaheadDays:    getEnvAsInt("RECURRING_LOOKAHEAD_DAYS", 0),
+			ReminderDays:     getEnvAsInt("RECURRING_REMINDER_DAYS", 3),
+		},
+		GoalAlerts: GoalAlertConfig{
+			SchedulerEnabled: getEnvAsBool("GOAL_ALERTS_SCHEDULER_ENABLED", true),
+			Interval:         getEnvAsDuration("GOAL_ALERTS_SCHEDULER_INTERVAL", time.Hour),
+		},
+		Attachments: AttachmentConfig{
+			Storage:         getEnv("ATTACHMENT_STORAGE", "minio"),
+			LocalDir:        getEnv("ATTACHMENT_LOCAL_DIR", "./data/attachments"),
+			URLExpiry:       getEnvAsDuration("ATTACHMENT_URL_EXPIRY", 15*time.Minute),
+			CleanupEnabled:  getEnvAsBool("ATTACHMENT_CLEANUP_ENABLED", true),
+			CleanupInterval: getEnvAsDuration("ATTACHMENT_CLEANUP_INTERVAL", time.Hour),
+		},
+		Trash: TrashConfig{
+			RetentionDays: getEnvAsInt("TRASH_RETENTION_DAYS", 30),
+			PurgeEnabled:  getEnvAsBool("TRASH_PURGE_ENABLED", true),
+			PurgeInterval: getEnvAsDuration("TRASH_PURGE_INTERVAL", time.Hour),
+		},
 	}
 }
 
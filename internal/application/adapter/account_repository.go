@@ -0,0 +1,48 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// AccountRepository defines the interface for account persistence operations.
+type AccountRepository interface {
+	// Create creates a new account in the database.
+	Create(ctx context.Context, account *entity.Account) error
+
+	// FindByID retrieves an account by its ID.
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Account, error)
+
+	// FindByUser retrieves the user's accounts sorted by name. Archived accounts are only included when requested.
+	FindByUser(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]*entity.Account, error)
+
+	// ExistsByNameAndUser checks if the user already has an account with the given name (case-insensitive).
+	// The account with excludeID is ignored, which allows renaming an account to its own name.
+	ExistsByNameAndUser(ctx context.Context, name string, userID uuid.UUID, excludeID *uuid.UUID) (bool, error)
+
+	// Update updates an existing account in the database.
+	Update(ctx context.Context, account *entity.Account) error
+
+	// Delete soft-deletes an account and detaches its transactions, which are kept.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// HasTransactions checks if any transaction is recorded in the account.
+	HasTransactions(ctx context.Context, accountID uuid.UUID) (bool, error)
+
+	// GetTransactionTotals returns the sum of transaction amounts per account.
+	// Accounts without transactions are omitted from the result.
+	GetTransactionTotals(ctx context.Context, accountIDs []uuid.UUID) (map[uuid.UUID]decimal.Decimal, error)
+
+	// GetRunningTotals returns, for each of the given transactions of the account, the sum of the
+	// account's transaction amounts up to and including it (ordered by date, then creation time).
+	GetRunningTotals(ctx context.Context, accountID uuid.UUID, transactionIDs []uuid.UUID) (map[uuid.UUID]decimal.Decimal, error)
+
+	// FindBillTransactions retrieves the account's transactions charged in the billing cycle, oldest first.
+	// Bill payments and hidden entries (e.g., "Pagamento recebido") are excluded.
+	FindBillTransactions(ctx context.Context, accountID uuid.UUID, billingCycle string) ([]*entity.Transaction, error)
+}
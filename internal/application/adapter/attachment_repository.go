@@ -0,0 +1,44 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// AttachmentRepository defines the interface for attachment persistence operations.
+type AttachmentRepository interface {
+	// Create creates a new attachment record in the database.
+	Create(ctx context.Context, attachment *entity.Attachment) error
+
+	// FindByID retrieves an attachment by its ID.
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Attachment, error)
+
+	// FindByOwner retrieves the uploaded attachments of a transaction or account, oldest first.
+	FindByOwner(ctx context.Context, ownerType entity.AttachmentOwnerType, ownerID uuid.UUID) ([]*entity.Attachment, error)
+
+	// FindOrphaned retrieves up to limit attachments whose transaction, account or user was
+	// deleted, plus pending attachments created before pendingBefore. When userID is set,
+	// only that user's attachments are considered.
+	FindOrphaned(ctx context.Context, userID *uuid.UUID, pendingBefore time.Time, limit int) ([]*entity.Attachment, error)
+
+	// ReassignOwner moves all attachments of one transaction or account to another.
+	ReassignOwner(ctx context.Context, ownerType entity.AttachmentOwnerType, fromOwnerID uuid.UUID, toOwnerID uuid.UUID) error
+
+	// Update updates an existing attachment record in the database.
+	Update(ctx context.Context, attachment *entity.Attachment) error
+
+	// Delete removes an attachment record from the database.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// AttachmentCleanupNotifier is notified when a user deletes transactions or accounts so that
+// their attachments are removed from object storage. Notifications are handled asynchronously.
+type AttachmentCleanupNotifier interface {
+	// NotifyOwnersDeleted schedules the cleanup of the user's orphaned attachments.
+	NotifyOwnersDeleted(userID uuid.UUID)
+}
@@ -0,0 +1,24 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// BillingCycleOverrideRepository defines the interface for billing cycle override persistence operations.
+type BillingCycleOverrideRepository interface {
+	// Upsert saves the override, replacing the dates of an existing override for the same account
+	// and billing cycle. The ID and creation time of a replaced override are copied back onto it.
+	Upsert(ctx context.Context, override *entity.BillingCycleOverride) error
+
+	// FindByAccount retrieves the overrides of an account, sorted by billing cycle.
+	FindByAccount(ctx context.Context, accountID uuid.UUID) ([]*entity.BillingCycleOverride, error)
+
+	// Delete removes the override of an account's billing cycle.
+	// Returns domainerror.ErrBillingCycleOverrideNotFound when the cycle has no override.
+	Delete(ctx context.Context, accountID uuid.UUID, billingCycle string) error
+}
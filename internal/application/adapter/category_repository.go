@@ -39,7 +39,7 @@ type CategoryRepository interface {
 	// GetTransactionStats retrieves transaction statistics for categories within a date range.
 	GetTransactionStats(ctx context.Context, categoryIDs []uuid.UUID, startDate, endDate time.Time) (map[uuid.UUID]*CategoryStats, error)
 
-	// OrphanTransactionsByCategory sets category_id to NULL for all transactions with the given category ID.
+	// OrphanTransactionsByCategory sets category_id to NULL for all transactions and split lines with the given category ID.
 	OrphanTransactionsByCategory(ctx context.Context, categoryID uuid.UUID) error
 }
 
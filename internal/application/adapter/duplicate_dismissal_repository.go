@@ -0,0 +1,22 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// DuplicateDismissalRepository defines the interface for persisting reviewed duplicate pairs.
+type DuplicateDismissalRepository interface {
+	// Create records that a transaction pair is not a duplicate.
+	Create(ctx context.Context, dismissal *entity.DuplicateDismissal) error
+
+	// ExistsByPair checks if the pair was already dismissed. The IDs may be given in any order.
+	ExistsByPair(ctx context.Context, transactionID uuid.UUID, duplicateID uuid.UUID) (bool, error)
+
+	// FindByUser retrieves all dismissed pairs for a user.
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]*entity.DuplicateDismissal, error)
+}
@@ -3,6 +3,8 @@ package adapter
 
 import (
 	"context"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
 )
 
 // SendEmailInput represents the input for sending an email.
@@ -32,6 +34,12 @@ type EmailService interface {
 
 	// QueueGroupInvitationEmail queues a group invitation email.
 	QueueGroupInvitationEmail(ctx context.Context, input QueueGroupInvitationInput) error
+
+	// QueueRecurringReminderEmail queues a reminder about upcoming recurring transactions.
+	QueueRecurringReminderEmail(ctx context.Context, input QueueRecurringReminderInput) error
+
+	// QueueGoalAlertEmail queues an alert about spending reaching a goal threshold.
+	QueueGoalAlertEmail(ctx context.Context, input QueueGoalAlertInput) error
 }
 
 // QueuePasswordResetInput represents the input for queueing a password reset email.
@@ -52,3 +60,30 @@ type QueueGroupInvitationInput struct {
 	InviteURL    string
 	ExpiresIn    string
 }
+
+// QueueRecurringReminderInput represents the input for queueing a recurring transactions reminder.
+type QueueRecurringReminderInput struct {
+	UserEmail string
+	UserName  string
+	Items     []RecurringReminderItem
+}
+
+// QueueGoalAlertInput represents the input for queueing a goal threshold alert.
+type QueueGoalAlertInput struct {
+	UserEmail    string
+	UserName     string
+	Threshold    entity.GoalAlertThreshold
+	CategoryName string
+	LimitAmount  string // Formatted for display
+	SpentAmount  string // Formatted for display
+	Percentage   int
+	PeriodStart  string // Formatted for display
+	PeriodEnd    string // Formatted for display
+}
+
+// RecurringReminderItem represents an upcoming recurring transaction in a reminder email.
+type RecurringReminderItem struct {
+	Description string
+	Amount      string // Formatted for display
+	Date        string // Formatted for display
+}
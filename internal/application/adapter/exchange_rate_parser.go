@@ -0,0 +1,30 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ParsedExchangeRate represents a single rate parsed from an exchange rate file.
+type ParsedExchangeRate struct {
+	Row          int // 1-based line number in the source file
+	Date         time.Time
+	FromCurrency string
+	ToCurrency   string
+	Rate         decimal.Decimal
+}
+
+// ParsedExchangeRateFile represents the result of parsing an exchange rate file.
+// Lines that fail to parse are reported in Errors instead of failing the whole file.
+type ParsedExchangeRateFile struct {
+	Rates  []ParsedExchangeRate
+	Errors []StatementLineError
+}
+
+// ExchangeRateFileParser defines the interface for parsing exchange rate files.
+type ExchangeRateFileParser interface {
+	// ParseExchangeRates parses a delimited file with date, from, to and rate columns.
+	ParseExchangeRates(data []byte) (*ParsedExchangeRateFile, error)
+}
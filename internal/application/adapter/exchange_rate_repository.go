@@ -0,0 +1,32 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ExchangeRateRepository defines the interface for exchange rate persistence operations.
+type ExchangeRateRepository interface {
+	// Upsert saves the rates, replacing the rate of any existing entry for the same user,
+	// currency pair and date. The IDs of replaced entries are copied back onto the given rates.
+	Upsert(ctx context.Context, rates []*entity.ExchangeRate) error
+
+	// FindByID retrieves an exchange rate by its ID.
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.ExchangeRate, error)
+
+	// FindByUser retrieves the user's rates, newest first.
+	// When currency is set, only rates converting from or into it are returned.
+	FindByUser(ctx context.Context, userID uuid.UUID, currency string) ([]*entity.ExchangeRate, error)
+
+	// FindLatest retrieves the user's most recent rate on or before date between the two currencies,
+	// stored in either direction. Returns domainerror.ErrExchangeRateUnavailable when none exists.
+	FindLatest(ctx context.Context, userID uuid.UUID, fromCurrency, toCurrency string, date time.Time) (*entity.ExchangeRate, error)
+
+	// Delete removes an exchange rate from the database.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,24 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// GoalAlertRepository defines the interface for goal alert persistence operations.
+type GoalAlertRepository interface {
+	// CreateIfNotExists records the alert unless one already exists for the same goal,
+	// threshold and period. It reports whether the alert was created.
+	CreateIfNotExists(ctx context.Context, alert *entity.GoalAlert) (bool, error)
+}
+
+// GoalAlertNotifier is notified when a user's transactions change so that their
+// spending goals are re-evaluated. Notifications are handled asynchronously.
+type GoalAlertNotifier interface {
+	// NotifyTransactionsChanged schedules a goal evaluation for the user.
+	NotifyTransactionsChanged(userID uuid.UUID)
+}
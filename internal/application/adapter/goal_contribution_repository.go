@@ -0,0 +1,28 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// GoalContributionRepository defines the interface for savings goal contribution persistence operations.
+type GoalContributionRepository interface {
+	// Create creates a new contribution in the database.
+	Create(ctx context.Context, contribution *entity.GoalContribution) error
+
+	// FindByID retrieves a contribution by its ID.
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.GoalContribution, error)
+
+	// FindByGoalID retrieves all contributions for a goal, oldest first.
+	FindByGoalID(ctx context.Context, goalID uuid.UUID) ([]*entity.GoalContribution, error)
+
+	// ExistsByGoalAndTransaction checks if the transaction already contributes to the goal.
+	ExistsByGoalAndTransaction(ctx context.Context, goalID, transactionID uuid.UUID) (bool, error)
+
+	// Delete removes a contribution from the database (soft delete).
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -35,4 +35,7 @@ type GoalRepository interface {
 
 	// GetCurrentSpending calculates the current spending for a category within the goal period.
 	GetCurrentSpending(ctx context.Context, categoryID uuid.UUID, startDate, endDate time.Time) (float64, error)
+
+	// FindWithAlertsEnabled retrieves all spending limit goals with alert on exceed enabled.
+	FindWithAlertsEnabled(ctx context.Context) ([]*entity.Goal, error)
 }
@@ -0,0 +1,32 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ImportProfileRepository defines the interface for import profile persistence operations.
+type ImportProfileRepository interface {
+	// Create creates a new import profile in the database.
+	Create(ctx context.Context, profile *entity.ImportProfile) error
+
+	// FindByID retrieves an import profile by its ID.
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.ImportProfile, error)
+
+	// FindByUser retrieves all import profiles for a user, sorted by name.
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]*entity.ImportProfile, error)
+
+	// ExistsByNameAndUser checks if the user already has a profile with the given name (case-insensitive).
+	// The profile with excludeID is ignored, which allows renaming a profile to its own name.
+	ExistsByNameAndUser(ctx context.Context, name string, userID uuid.UUID, excludeID *uuid.UUID) (bool, error)
+
+	// Update updates an existing import profile in the database.
+	Update(ctx context.Context, profile *entity.ImportProfile) error
+
+	// Delete soft-deletes an import profile from the database.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
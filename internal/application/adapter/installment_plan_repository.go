@@ -0,0 +1,33 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// InstallmentPlanRepository defines the interface for installment plan persistence operations.
+type InstallmentPlanRepository interface {
+	// Create creates a new installment plan in the database.
+	Create(ctx context.Context, plan *entity.InstallmentPlan) error
+
+	// FindByID retrieves an installment plan by its ID.
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.InstallmentPlan, error)
+
+	// FindByUser retrieves the user's installment plans, oldest purchase first.
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]*entity.InstallmentPlan, error)
+
+	// FindByAccount retrieves the user's installment plans of a credit card, oldest purchase first.
+	// A nil account retrieves the plans imported without a card.
+	FindByAccount(ctx context.Context, userID uuid.UUID, accountID *uuid.UUID) ([]*entity.InstallmentPlan, error)
+
+	// FindRecordedInstallments returns the installment numbers recorded by the user's transactions
+	// for each plan, in ascending order. Plans without transactions are omitted.
+	FindRecordedInstallments(ctx context.Context, userID uuid.UUID) (map[uuid.UUID][]int, error)
+
+	// FindInstallments retrieves the transactions of a plan, ordered by installment number.
+	FindInstallments(ctx context.Context, planID uuid.UUID) ([]*entity.Transaction, error)
+}
@@ -0,0 +1,77 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// JournalFormat identifies the plain-text accounting syntax a journal is exported in.
+type JournalFormat string
+
+const (
+	JournalFormatBeancount JournalFormat = "beancount"
+	JournalFormatHledger   JournalFormat = "hledger" // Also read by ledger
+)
+
+// JournalAmount represents an amount of a commodity.
+type JournalAmount struct {
+	Number   decimal.Decimal
+	Currency string // ISO 4217 code
+}
+
+// JournalPosting represents a posting of a journal entry to an account.
+type JournalPosting struct {
+	Account string // Colon-separated account name, e.g. "Expenses:Food"
+	Amount  JournalAmount
+	Price   *JournalAmount // Total price in another currency, for postings that convert between currencies
+}
+
+// JournalEntry represents a balanced journal transaction.
+type JournalEntry struct {
+	Date      time.Time
+	Narration string
+	Notes     string
+	ID        uuid.UUID // Transaction the entry was built from
+	Tags      []string
+	Postings  []JournalPosting
+}
+
+// JournalExportOptions holds the settings a journal is written with.
+type JournalExportOptions struct {
+	BaseCurrency string // ISO 4217 code reports are converted into
+	GeneratedAt  time.Time
+}
+
+// JournalWriter writes journal directives to a file one at a time, in date order.
+type JournalWriter interface {
+	// Open declares an account, starting on date.
+	Open(date time.Time, account string) error
+
+	// Price records the rate converting one unit of commodity into amount.Currency on date.
+	Price(date time.Time, commodity string, rate JournalAmount) error
+
+	// Entry appends a transaction.
+	Entry(entry *JournalEntry) error
+
+	// Balance asserts the balance of an account after all postings dated on or before date.
+	Balance(date time.Time, account string, amount JournalAmount) error
+
+	// Close flushes the file. It does not close the underlying writer.
+	Close() error
+}
+
+// JournalExporter defines the interface for writing journals in a plain-text accounting syntax.
+type JournalExporter interface {
+	// ContentType returns the MIME type of the files written.
+	ContentType() string
+
+	// FileExtension returns the extension of the files written, without the dot.
+	FileExtension() string
+
+	// NewWriter starts a journal on w.
+	NewWriter(w io.Writer, options JournalExportOptions) (JournalWriter, error)
+}
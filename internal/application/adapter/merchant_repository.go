@@ -0,0 +1,34 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// MerchantRepository defines the interface for merchant persistence operations.
+type MerchantRepository interface {
+	// CreateOrGet creates a new merchant in the database. When the user already has a merchant with
+	// the same key, for example one created by a concurrent import, that merchant is returned instead.
+	CreateOrGet(ctx context.Context, merchant *entity.Merchant) (*entity.Merchant, error)
+
+	// FindByID retrieves a merchant by its ID.
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Merchant, error)
+
+	// FindByUser retrieves the user's merchants sorted by name.
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]*entity.Merchant, error)
+
+	// CountTransactions returns the number of the user's transactions assigned to each merchant.
+	// Merchants without transactions are omitted.
+	CountTransactions(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]int64, error)
+
+	// Update updates an existing merchant in the database.
+	Update(ctx context.Context, merchant *entity.Merchant) error
+
+	// Merge saves the target merchant, moves the transactions of the source merchants to it and
+	// soft-deletes the sources, atomically. Returns the number of transactions moved.
+	Merge(ctx context.Context, target *entity.Merchant, sourceIDs []uuid.UUID) (int64, error)
+}
@@ -0,0 +1,56 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// ObjectInfo describes an object in object storage.
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+}
+
+// ObjectStorage defines the interface for storing files (e.g., attachments) in S3-compatible
+// object storage.
+type ObjectStorage interface {
+	// Put stores the content under the key, replacing any existing object.
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+
+	// Get opens the object stored under the key. The caller must close the returned reader.
+	// Returns domainerror.ErrObjectNotFound when there is no such object.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Stat returns information about the object stored under the key.
+	// Returns domainerror.ErrObjectNotFound when there is no such object.
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+
+	// Delete removes the object stored under the key. Deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+
+	// PresignPut returns a URL the client can upload the object to with a PUT request
+	// carrying the given content type. Returns domainerror.ErrPresignNotSupported when
+	// the storage cannot issue presigned URLs.
+	PresignPut(ctx context.Context, key string, contentType string, expiry time.Duration) (string, error)
+
+	// PresignGet returns a URL the client can download the object from.
+	// Returns domainerror.ErrPresignNotSupported when the storage cannot issue presigned URLs.
+	PresignGet(ctx context.Context, key string, expiry time.Duration) (string, error)
+}
+
+// Thumbnail represents a generated image thumbnail.
+type Thumbnail struct {
+	Content      []byte
+	ContentType  string
+	SourceWidth  int // Width of the original image
+	SourceHeight int // Height of the original image
+}
+
+// ThumbnailGenerator defines the interface for generating thumbnails of attached images.
+type ThumbnailGenerator interface {
+	// Generate returns a thumbnail of the image, or nil when thumbnails are not supported
+	// for the content type.
+	Generate(content []byte, contentType string) (*Thumbnail, error)
+}
@@ -0,0 +1,47 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// RecurringScheduleRepository defines the interface for recurring schedule persistence operations.
+type RecurringScheduleRepository interface {
+	// Create creates a new recurring schedule in the database.
+	Create(ctx context.Context, schedule *entity.RecurringSchedule) error
+
+	// FindByID retrieves a recurring schedule by its ID.
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.RecurringSchedule, error)
+
+	// FindByUser retrieves all recurring schedules for a user, ordered by next occurrence.
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]*entity.RecurringSchedule, error)
+
+	// Update updates an existing recurring schedule in the database.
+	Update(ctx context.Context, schedule *entity.RecurringSchedule) error
+
+	// Delete removes a recurring schedule from the database (soft delete).
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Scheduler methods
+
+	// FindDue retrieves active schedules whose next occurrence is on or before the given date.
+	FindDue(ctx context.Context, asOf time.Time, limit int) ([]*entity.RecurringSchedule, error)
+
+	// FindPendingReminders retrieves active schedules with a next occurrence in the date range
+	// that have not been reminded for that occurrence yet.
+	FindPendingReminders(ctx context.Context, from, to time.Time) ([]*entity.RecurringSchedule, error)
+
+	// SaveOccurrence creates the materialized transaction and saves the advanced schedule atomically,
+	// provided the schedule's next occurrence is still the one materialized. Otherwise it returns
+	// ErrRecurringOccurrenceAlreadyProcessed and creates nothing.
+	SaveOccurrence(ctx context.Context, schedule *entity.RecurringSchedule, occurrence time.Time, transaction *entity.Transaction) error
+
+	// MarkReminded records that the user was reminded of the schedule's next occurrence,
+	// unless the next occurrence changed in the meantime.
+	MarkReminded(ctx context.Context, id uuid.UUID, occurrence time.Time) error
+}
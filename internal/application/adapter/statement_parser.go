@@ -0,0 +1,71 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ParsedStatementLine represents a single transaction line parsed from a statement file.
+type ParsedStatementLine struct {
+	Row                int // 1-based line number in the source file
+	ExternalID         string
+	Date               time.Time
+	Description        string
+	Amount             decimal.Decimal // Negative for debits, positive for credits
+	InstallmentCurrent *int
+	InstallmentTotal   *int
+}
+
+// StatementLineError describes a line that could not be parsed.
+type StatementLineError struct {
+	Row     int
+	Message string
+}
+
+// ParsedStatement represents the result of parsing a statement file.
+// Lines that fail to parse are reported in Errors instead of failing the whole file.
+type ParsedStatement struct {
+	Lines  []ParsedStatementLine
+	Errors []StatementLineError
+}
+
+// CSVStatementParser defines the interface for parsing CSV statements with a column mapping.
+type CSVStatementParser interface {
+	// ParseCSV parses the file using the profile's layout, column mapping and formats.
+	// The profile's DateFormat and NumberFormat must already be resolved (non-empty).
+	ParseCSV(data []byte, profile *entity.ImportProfile) (*ParsedStatement, error)
+}
+
+// ParsedCardStatementLine represents a single transaction line read from a credit card statement (fatura).
+type ParsedCardStatementLine struct {
+	Row                int // 1-based line number in the extracted statement text
+	Date               time.Time
+	Description        string
+	Amount             decimal.Decimal // Positive for purchases, negative for payments and refunds
+	InstallmentCurrent *int
+	InstallmentTotal   *int
+	OriginalAmount     *decimal.Decimal // Amount in the original currency of international purchases
+	OriginalCurrency   string           // ISO 4217 code of OriginalAmount
+	IsIOF              bool             // True for IOF (tax on international purchases) lines
+}
+
+// ParsedCardStatement represents the result of parsing a credit card statement (fatura).
+// Lines that fail to parse are reported in Errors instead of failing the whole file.
+type ParsedCardStatement struct {
+	Issuer  string // Identifier of the issuer layout that read the statement, such as "nubank"
+	DueDate time.Time
+	Total   *decimal.Decimal // Bill total printed on the statement, if found
+	Lines   []ParsedCardStatementLine
+	Errors  []StatementLineError
+}
+
+// CardStatementParser defines the interface for parsing credit card statements downloaded as PDF.
+type CardStatementParser interface {
+	// ParsePDF extracts the statement text and reads it with the layout of the issuer that produced it.
+	// "Pagamento recebido" entries are reported with that description, whatever the issuer prints.
+	ParsePDF(data []byte) (*ParsedCardStatement, error)
+}
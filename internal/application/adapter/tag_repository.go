@@ -0,0 +1,40 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// TagRepository defines the interface for tag persistence operations.
+type TagRepository interface {
+	// Create creates a new tag in the database.
+	Create(ctx context.Context, tag *entity.Tag) error
+
+	// FindByID retrieves a tag by its ID.
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Tag, error)
+
+	// FindByUser retrieves the user's tags sorted by name.
+	FindByUser(ctx context.Context, userID uuid.UUID) ([]*entity.Tag, error)
+
+	// ExistsByNameAndUser checks if the user already has a tag with the given name (case-insensitive).
+	// The tag with excludeID is ignored, which allows renaming a tag to its own name.
+	ExistsByNameAndUser(ctx context.Context, name string, userID uuid.UUID, excludeID *uuid.UUID) (bool, error)
+
+	// Update updates an existing tag in the database.
+	Update(ctx context.Context, tag *entity.Tag) error
+
+	// Delete soft-deletes a tag and removes it from its transactions, which are kept.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// AddToTransactions attaches the tags to the user's transactions, skipping links that already exist.
+	// Returns the number of links created.
+	AddToTransactions(ctx context.Context, transactionIDs []uuid.UUID, tagIDs []uuid.UUID, userID uuid.UUID) (int64, error)
+
+	// RemoveFromTransactions detaches the tags from the user's transactions.
+	// Returns the number of links removed.
+	RemoveFromTransactions(ctx context.Context, transactionIDs []uuid.UUID, tagIDs []uuid.UUID, userID uuid.UUID) (int64, error)
+}
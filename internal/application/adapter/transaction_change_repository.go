@@ -0,0 +1,30 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// TransactionChangeRepository defines the interface for the append-only transaction change history.
+type TransactionChangeRepository interface {
+	// CreateMany records changes in a single database transaction.
+	CreateMany(ctx context.Context, changes []*entity.TransactionChange) error
+
+	// FindByID retrieves a change by its ID.
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.TransactionChange, error)
+
+	// FindByTransaction retrieves the changes of a transaction, newest first.
+	FindByTransaction(ctx context.Context, transactionID uuid.UUID) ([]*entity.TransactionChange, error)
+
+	// FindByOperation retrieves the changes an operation made to the user's transactions.
+	FindByOperation(ctx context.Context, operationID uuid.UUID, userID uuid.UUID) ([]*entity.TransactionChange, error)
+
+	// SaveRevert saves the reverted transaction, records the revert and stores the revert fields of the
+	// reverted change in a single database transaction. The rest of a change is never updated.
+	// Returns domainerror.ErrChangeNotRevertable if the change has been reverted in the meantime.
+	SaveRevert(ctx context.Context, transaction *entity.Transaction, revert *entity.TransactionChange, reverted *entity.TransactionChange) error
+}
@@ -0,0 +1,68 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"io"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ExportFormat identifies the file format transactions are exported to.
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatXLSX ExportFormat = "xlsx"
+	ExportFormatOFX  ExportFormat = "ofx"
+)
+
+// ExportedTransaction represents a transaction being exported, with its references resolved to names.
+type ExportedTransaction struct {
+	Transaction  *entity.Transaction
+	CategoryName string // Empty for uncategorized transactions
+	AccountName  string // Empty when the transaction has no account
+	Tags         []string
+	Splits       []ExportedSplit // Split lines of a split transaction
+}
+
+// ExportedSplit represents a split line of an exported transaction.
+type ExportedSplit struct {
+	CategoryName string          // Empty for uncategorized split lines
+	Amount       decimal.Decimal // In the transaction currency
+}
+
+// TransactionExportOptions holds the preferences and period an export is written with.
+type TransactionExportOptions struct {
+	DateFormat   entity.DateFormat
+	NumberFormat entity.NumberFormat
+	BaseCurrency string     // ISO 4217 code base amounts are in
+	StartDate    *time.Time // Start of the exported period, when filtered by date
+	EndDate      *time.Time // End of the exported period, when filtered by date
+	GeneratedAt  time.Time
+}
+
+// TransactionExportWriter writes exported transactions to a file one at a time,
+// so exports do not have to be held in memory.
+type TransactionExportWriter interface {
+	// Write appends a transaction to the file.
+	Write(txn *ExportedTransaction) error
+
+	// Close writes the end of the file (e.g., totals) and flushes it.
+	// It does not close the underlying writer.
+	Close() error
+}
+
+// TransactionExporter defines the interface for writing transaction exports in a file format.
+type TransactionExporter interface {
+	// ContentType returns the MIME type of the files written.
+	ContentType() string
+
+	// FileExtension returns the extension of the files written, without the dot.
+	FileExtension() string
+
+	// NewWriter starts a file on w.
+	NewWriter(w io.Writer, options TransactionExportOptions) (TransactionExportWriter, error)
+}
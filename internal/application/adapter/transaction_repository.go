@@ -9,6 +9,7 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/finance-tracker/backend/internal/domain/entity"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
 )
 
 // TransactionFilter defines filter options for listing transactions.
@@ -17,15 +18,23 @@ type TransactionFilter struct {
 	StartDate   *time.Time
 	EndDate     *time.Time
 	CategoryIDs []uuid.UUID
+	AccountIDs  []uuid.UUID
+	TagIDs      []uuid.UUID // Matches transactions with any of the tags
 	Type        *entity.TransactionType
-	Search      string // Case-insensitive description match
-	GroupByDate bool
+	Query       *valueobject.TransactionQuery // Parsed search query; nil when not searching
+	MinAmount   *decimal.Decimal              // Minimum absolute amount, inclusive
+	MaxAmount   *decimal.Decimal              // Maximum absolute amount, inclusive
+	// UncategorizedOnly matches transactions without a category; transfers and split transactions are excluded
+	UncategorizedOnly bool
+	GroupByDate       bool
 }
 
 // TransactionPagination defines pagination options.
 type TransactionPagination struct {
-	Page  int
-	Limit int
+	Page   int
+	Limit  int
+	Sort   valueobject.TransactionSort
+	Cursor *valueobject.TransactionCursor // Starts after this transaction instead of at Page
 }
 
 // TransactionListResult represents the result of listing transactions.
@@ -35,6 +44,7 @@ type TransactionListResult struct {
 	Page         int
 	Limit        int
 	TotalPages   int
+	NextCursor   *valueobject.TransactionCursor // Last transaction of the page; nil on the last page
 }
 
 // TransactionTotals represents aggregated totals for transactions.
@@ -52,6 +62,10 @@ type TransactionRepository interface {
 	// FindByID retrieves a transaction by its ID.
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Transaction, error)
 
+	// FindByIDs retrieves the user's transactions with the given IDs, with their split lines and tags.
+	// IDs that do not exist or belong to another user are skipped.
+	FindByIDs(ctx context.Context, ids []uuid.UUID, userID uuid.UUID) ([]*entity.Transaction, error)
+
 	// FindByIDWithCategory retrieves a transaction with its category by ID.
 	FindByIDWithCategory(ctx context.Context, id uuid.UUID) (*entity.TransactionWithCategory, error)
 
@@ -61,22 +75,41 @@ type TransactionRepository interface {
 	// FindByFilter retrieves transactions based on filter criteria with pagination.
 	FindByFilter(ctx context.Context, filter TransactionFilter, pagination TransactionPagination) (*TransactionListResult, error)
 
+	// FindIDsByFilter retrieves the IDs of up to limit transactions matching the filter, newest first,
+	// with the total count of matching transactions.
+	FindIDsByFilter(ctx context.Context, filter TransactionFilter, limit int) ([]uuid.UUID, int64, error)
+
+	// StreamByFilter calls fn with consecutive batches of up to batchSize transactions matching the filter,
+	// in the sort order, with their category, split lines and tags. Only one batch is loaded at a time.
+	StreamByFilter(ctx context.Context, filter TransactionFilter, sort valueobject.TransactionSort, batchSize int, fn func([]*entity.TransactionWithCategory) error) error
+
 	// GetTotals calculates totals for transactions based on filter criteria.
 	GetTotals(ctx context.Context, filter TransactionFilter) (*TransactionTotals, error)
 
 	// Update updates an existing transaction in the database.
 	Update(ctx context.Context, transaction *entity.Transaction) error
 
+	// UpdateMany updates existing transactions in a single database transaction.
+	UpdateMany(ctx context.Context, transactions []*entity.Transaction) error
+
 	// Delete soft-deletes a transaction from the database.
 	Delete(ctx context.Context, id uuid.UUID) error
 
 	// BulkDelete soft-deletes multiple transactions by their IDs.
+	// The other leg of any transfer among them is deleted as well.
 	// Returns the count of deleted transactions.
 	BulkDelete(ctx context.Context, ids []uuid.UUID, userID uuid.UUID) (int64, error)
 
 	// BulkUpdateCategory updates the category for multiple transactions.
+	// Split transactions are skipped unless includeSplit is set, in which case their split lines are removed.
 	// Returns the count of updated transactions.
-	BulkUpdateCategory(ctx context.Context, ids []uuid.UUID, categoryID uuid.UUID, userID uuid.UUID) (int64, error)
+	BulkUpdateCategory(
+		ctx context.Context,
+		ids []uuid.UUID,
+		categoryID uuid.UUID,
+		userID uuid.UUID,
+		includeSplit bool,
+	) (int64, error)
 
 	// ExistsByIDAndUser checks if a transaction exists for a given ID and user.
 	ExistsByIDAndUser(ctx context.Context, id uuid.UUID, userID uuid.UUID) (bool, error)
@@ -85,14 +118,15 @@ type TransactionRepository interface {
 	ExistsAllByIDsAndUser(ctx context.Context, ids []uuid.UUID, userID uuid.UUID) (bool, error)
 
 	// BulkUpdateCategoryByPattern updates category for all uncategorized transactions
-	// matching the given pattern for the specified owner.
+	// matching the given pattern for the specified owner. Split transactions are left alone.
+	// Returns the IDs of the updated transactions.
 	BulkUpdateCategoryByPattern(
 		ctx context.Context,
 		pattern string,
 		categoryID uuid.UUID,
 		ownerType entity.OwnerType,
 		ownerID uuid.UUID,
-	) (int, error)
+	) ([]uuid.UUID, error)
 
 	// Credit card import methods
 
@@ -134,10 +168,12 @@ type TransactionRepository interface {
 	CollapseExpansion(ctx context.Context, billPaymentID uuid.UUID) error
 
 	// GetCreditCardStatus retrieves the CC status for a specific billing cycle.
+	// With an account, only the bill and transactions of that credit card are considered.
 	GetCreditCardStatus(
 		ctx context.Context,
 		userID uuid.UUID,
 		billingCycle string,
+		accountID *uuid.UUID,
 	) (*CreditCardStatus, error)
 
 	// IsBillExpanded checks if a bill payment has been expanded.
@@ -150,19 +186,89 @@ type TransactionRepository interface {
 	// Returns empty string if no CC transactions exist.
 	FindMostRecentCCBillingCycle(ctx context.Context, userID uuid.UUID) (string, error)
 
+	// FindImportedCCTransactions retrieves the statement lines imported into a billing cycle,
+	// including hidden ones, ordered by date with their split lines. Bill payments and card
+	// transactions entered in the app are excluded. When accountID is not nil, only the
+	// transactions of that card are returned.
+	FindImportedCCTransactions(
+		ctx context.Context,
+		userID uuid.UUID,
+		billingCycle string,
+		accountID *uuid.UUID,
+	) ([]*entity.Transaction, error)
+
+	// ApplyCCReimport creates the added CC transactions, saves the changed ones with their split
+	// lines, soft-deletes the removed ones, updates the original amount of the bill payment and
+	// records the changes in a single database transaction.
+	ApplyCCReimport(ctx context.Context, reimport *CCReimport) error
+
 	// GetExpensesByDateRange returns all expense transactions for a user
 	// within the specified date range, including category info.
 	// Only returns transactions with a category assigned (category_id IS NOT NULL).
+	// Split transactions yield one entry per categorized split line, with the line amount.
+	// When accountIDs is not empty, only transactions of those accounts are returned.
 	GetExpensesByDateRange(
 		ctx context.Context,
 		userID uuid.UUID,
 		startDate time.Time,
 		endDate time.Time,
+		accountIDs []uuid.UUID,
 	) ([]*entity.ExpenseWithCategory, error)
 
-	// CountUncategorizedByUser counts all transactions for a user that have no category assigned.
+	// CountUncategorizedByUser counts all transactions for a user that have no category assigned,
+	// excluding split transactions.
 	// This is used by the AI categorization feature to determine how many transactions need categorization.
 	CountUncategorizedByUser(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// Statement import methods
+
+	// FindExistingExternalIDs returns the subset of the given external IDs that already exist for the user.
+	// Soft-deleted transactions are included so that re-importing a statement does not restore them.
+	FindExistingExternalIDs(ctx context.Context, userID uuid.UUID, externalIDs []string) (map[string]bool, error)
+
+	// BulkCreate creates multiple transactions in a single database transaction.
+	BulkCreate(ctx context.Context, transactions []*entity.Transaction) error
+
+	// Duplicate detection methods
+
+	// FindDuplicateCandidates returns the user's transactions within the date range that can be
+	// compared for duplicates. Bill payments and hidden entries are excluded.
+	FindDuplicateCandidates(
+		ctx context.Context,
+		userID uuid.UUID,
+		startDate time.Time,
+		endDate time.Time,
+	) ([]*entity.Transaction, error)
+
+	// MergeDuplicate saves the kept transaction and soft-deletes the duplicate in a single database transaction.
+	MergeDuplicate(ctx context.Context, keep *entity.Transaction, duplicateID uuid.UUID) error
+
+	// Transfer methods
+
+	// FindByTransferID retrieves the legs of a transfer.
+	FindByTransferID(ctx context.Context, transferID uuid.UUID) ([]*entity.Transaction, error)
+
+	// SaveTransfer creates or updates both legs of a transfer in a single database transaction.
+	SaveTransfer(ctx context.Context, transfer *entity.Transfer) error
+
+	// DeleteTransfer soft-deletes both legs of a transfer in a single database transaction.
+	DeleteTransfer(ctx context.Context, transferID uuid.UUID, userID uuid.UUID) error
+
+	// Currency methods
+
+	// FindExchangeRateSnapshots returns the distinct currency and date pairs of the user's transactions,
+	// including soft-deleted ones, with Rate left zero.
+	FindExchangeRateSnapshots(ctx context.Context, userID uuid.UUID) ([]entity.ExchangeRateSnapshot, error)
+
+	// UpdateBaseCurrency sets the user's base currency and the exchange rate of the user's transactions
+	// of each snapshot's currency and date in a single database transaction.
+	UpdateBaseCurrency(ctx context.Context, userID uuid.UUID, baseCurrency string, snapshots []entity.ExchangeRateSnapshot) error
+
+	// Split methods
+
+	// SaveSplits replaces the split lines of a transaction with transaction.Splits and saves its
+	// split flag and category in a single database transaction.
+	SaveSplits(ctx context.Context, transaction *entity.Transaction) error
 }
 
 // CreditCardStatus represents the status of credit card transactions for a billing cycle.
@@ -176,3 +282,13 @@ type CreditCardStatus struct {
 	LinkedTransactions []*entity.Transaction
 	ExpandedAt         *time.Time
 }
+
+// CCReimport represents the difference a corrected statement applies to an imported billing cycle.
+type CCReimport struct {
+	Added              []*entity.Transaction       // Statement lines not imported yet
+	Changed            []*entity.Transaction       // Imported transactions updated from their corrected line
+	RemovedIDs         []uuid.UUID                 // Imported transactions missing from the statement
+	BillPaymentID      *uuid.UUID                  // Bill the cycle was imported with, nil for standalone imports
+	OriginalBillAmount decimal.Decimal             // Statement total, saved as the original amount of the bill
+	Changes            []*entity.TransactionChange // History of the added, changed and removed transactions
+}
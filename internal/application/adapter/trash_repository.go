@@ -0,0 +1,37 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// TrashRepository defines the interface for soft-deleted transactions, categories, goals and category rules.
+// Only items owned by the user are in their trash; group categories and rules are not.
+type TrashRepository interface {
+	// List retrieves the user's trash, most recently deleted first, with the total count.
+	// A nil itemType lists every type.
+	List(ctx context.Context, userID uuid.UUID, itemType *entity.TrashItemType, limit, offset int) ([]*entity.TrashItem, int64, error)
+
+	// FindByRefs retrieves the referenced items that are in the user's trash; others are left out.
+	FindByRefs(ctx context.Context, userID uuid.UUID, refs []entity.TrashItemRef) ([]*entity.TrashItem, error)
+
+	// FindDeletedReferences retrieves the categories in the trash that the items refer to.
+	FindDeletedReferences(ctx context.Context, refs []entity.TrashItemRef) ([]*entity.TrashReference, error)
+
+	// FindConflicts retrieves the items that would duplicate an active item if restored,
+	// i.e., goals for a category that has an active goal and rules whose pattern is in use.
+	FindConflicts(ctx context.Context, refs []entity.TrashItemRef) ([]*entity.TrashItem, error)
+
+	// Restore restores the items in a single database transaction. Both legs of a transfer are restored together.
+	// References to the detached categories and to deleted accounts are cleared from restored transactions.
+	// Returns the IDs of the restored transactions, transfer legs included.
+	Restore(ctx context.Context, userID uuid.UUID, refs []entity.TrashItemRef, detachCategoryIDs []uuid.UUID) ([]uuid.UUID, error)
+
+	// Purge permanently deletes every item that was deleted before the given time and returns how many were deleted.
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+}
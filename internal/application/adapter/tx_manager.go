@@ -0,0 +1,12 @@
+// Package adapter defines interfaces that will be implemented in the integration layer.
+package adapter
+
+import "context"
+
+// TxManager defines the interface for running several repository calls in one database transaction.
+type TxManager interface {
+	// WithinTx runs fn in a database transaction. Repository calls made with the context passed to fn
+	// take part in the transaction, which is committed when fn returns nil and rolled back otherwise.
+	// Calls nested in a running transaction join it.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
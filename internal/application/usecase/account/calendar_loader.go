@@ -0,0 +1,39 @@
+// Package account contains account-related use cases.
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// CalendarLoader loads the billing calendars of credit card accounts, so the billing cycle of a
+// card purchase can be derived from its date. It is shared by the use cases that record card
+// transactions.
+type CalendarLoader struct {
+	overrideRepo adapter.BillingCycleOverrideRepository
+}
+
+// NewCalendarLoader creates a new CalendarLoader instance.
+func NewCalendarLoader(overrideRepo adapter.BillingCycleOverrideRepository) *CalendarLoader {
+	return &CalendarLoader{
+		overrideRepo: overrideRepo,
+	}
+}
+
+// Load returns the billing calendar of the account with its overrides applied, or nil when the
+// account is not a credit card with a closing day.
+func (l *CalendarLoader) Load(ctx context.Context, account *entity.Account) (*entity.BillingCalendar, error) {
+	if !account.IsCreditCard() || account.ClosingDay == nil {
+		return nil, nil
+	}
+
+	overrides, err := l.overrideRepo.FindByAccount(ctx, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list billing cycle overrides: %w", err)
+	}
+
+	return entity.NewBillingCalendar(account, overrides), nil
+}
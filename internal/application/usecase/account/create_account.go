@@ -0,0 +1,159 @@
+// Package account contains account-related use cases.
+package account
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+const (
+	// MaxAccountNameLength is the maximum allowed length for account names.
+	MaxAccountNameLength = 100
+	// MaxInstitutionLength is the maximum allowed length for the institution name.
+	MaxInstitutionLength = 100
+)
+
+// currencyRegex matches ISO 4217 currency codes (e.g., "BRL", "USD").
+var currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// CreateAccountInput represents the input for account creation.
+type CreateAccountInput struct {
+	UserID         uuid.UUID
+	Name           string
+	Type           entity.AccountType
+	Institution    string
+	Currency       string // Optional, defaults to BRL
+	OpeningBalance decimal.Decimal
+	ClosingDay     *int // Credit cards only
+	DueDay         *int // Credit cards only
+}
+
+// CreateAccountOutput represents the output of account creation.
+type CreateAccountOutput struct {
+	Account *AccountOutput
+}
+
+// CreateAccountUseCase handles account creation logic.
+type CreateAccountUseCase struct {
+	accountRepo adapter.AccountRepository
+}
+
+// NewCreateAccountUseCase creates a new CreateAccountUseCase instance.
+func NewCreateAccountUseCase(accountRepo adapter.AccountRepository) *CreateAccountUseCase {
+	return &CreateAccountUseCase{
+		accountRepo: accountRepo,
+	}
+}
+
+// Execute performs the account creation.
+func (uc *CreateAccountUseCase) Execute(ctx context.Context, input CreateAccountInput) (*CreateAccountOutput, error) {
+	name := strings.TrimSpace(input.Name)
+	currency := strings.ToUpper(strings.TrimSpace(input.Currency))
+
+	// Build account
+	account := entity.NewAccount(input.UserID, name, input.Type, currency, input.OpeningBalance)
+	account.Institution = strings.TrimSpace(input.Institution)
+	account.ClosingDay = input.ClosingDay
+	account.DueDay = input.DueDay
+
+	// Validate account
+	if err := ValidateAccount(account); err != nil {
+		return nil, err
+	}
+
+	// Check if name already exists for this user
+	exists, err := uc.accountRepo.ExistsByNameAndUser(ctx, name, input.UserID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check account name existence: %w", err)
+	}
+	if exists {
+		return nil, domainerror.NewAccountError(
+			domainerror.ErrCodeAccountNameExists,
+			"an account with this name already exists",
+			domainerror.ErrAccountNameExists,
+		)
+	}
+
+	// Save account
+	if err := uc.accountRepo.Create(ctx, account); err != nil {
+		return nil, fmt.Errorf("failed to create account: %w", err)
+	}
+
+	// A new account has no transactions yet, so its balance is the opening balance
+	return &CreateAccountOutput{
+		Account: &AccountOutput{
+			Account:        account,
+			CurrentBalance: account.OpeningBalance,
+		},
+	}, nil
+}
+
+// ValidateAccount checks the account's name, type, currency and billing days.
+func ValidateAccount(account *entity.Account) error {
+	if account.Name == "" {
+		return domainerror.NewAccountError(
+			domainerror.ErrCodeAccountMissingFields,
+			"name is required",
+			domainerror.ErrAccountMissingFields,
+		)
+	}
+	if len(account.Name) > MaxAccountNameLength {
+		return domainerror.NewAccountError(
+			domainerror.ErrCodeAccountMissingFields,
+			fmt.Sprintf("name must not exceed %d characters", MaxAccountNameLength),
+			domainerror.ErrAccountMissingFields,
+		)
+	}
+	if len(account.Institution) > MaxInstitutionLength {
+		return domainerror.NewAccountError(
+			domainerror.ErrCodeAccountMissingFields,
+			fmt.Sprintf("institution must not exceed %d characters", MaxInstitutionLength),
+			domainerror.ErrAccountMissingFields,
+		)
+	}
+
+	if !entity.IsValidAccountType(account.Type) {
+		return domainerror.NewAccountError(
+			domainerror.ErrCodeInvalidAccountType,
+			"type must be one of checking, savings, credit_card or wallet",
+			domainerror.ErrInvalidAccountType,
+		)
+	}
+
+	if !currencyRegex.MatchString(account.Currency) {
+		return domainerror.NewAccountError(
+			domainerror.ErrCodeInvalidAccountCurrency,
+			"currency must be a 3-letter ISO 4217 code",
+			domainerror.ErrInvalidAccountCurrency,
+		)
+	}
+
+	// Billing cycle days only make sense for credit cards
+	if !account.IsCreditCard() && (account.ClosingDay != nil || account.DueDay != nil) {
+		return domainerror.NewAccountError(
+			domainerror.ErrCodeInvalidBillingDays,
+			"closing_day and due_day are only allowed for credit card accounts",
+			domainerror.ErrInvalidBillingDays,
+		)
+	}
+	for _, day := range []*int{account.ClosingDay, account.DueDay} {
+		if day != nil && (*day < 1 || *day > 31) {
+			return domainerror.NewAccountError(
+				domainerror.ErrCodeInvalidBillingDays,
+				"closing_day and due_day must be between 1 and 31",
+				domainerror.ErrInvalidBillingDays,
+			)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+// Package account contains account-related use cases.
+package account
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
+)
+
+// deleteAccountBatchSize is the number of transactions loaded at a time when snapshotting a deleted account.
+const deleteAccountBatchSize = 500
+
+// DeleteAccountInput represents the input for account deletion.
+type DeleteAccountInput struct {
+	AccountID uuid.UUID
+	UserID    uuid.UUID
+}
+
+// DeleteAccountOutput represents the output of account deletion.
+type DeleteAccountOutput struct {
+	Success bool
+}
+
+// DeleteAccountUseCase handles account deletion logic.
+type DeleteAccountUseCase struct {
+	accountRepo       adapter.AccountRepository
+	transactionRepo   adapter.TransactionRepository
+	changeRepo        adapter.TransactionChangeRepository
+	attachmentCleanup adapter.AttachmentCleanupNotifier
+}
+
+// NewDeleteAccountUseCase creates a new DeleteAccountUseCase instance.
+func NewDeleteAccountUseCase(
+	accountRepo adapter.AccountRepository,
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	attachmentCleanup adapter.AttachmentCleanupNotifier,
+) *DeleteAccountUseCase {
+	return &DeleteAccountUseCase{
+		accountRepo:       accountRepo,
+		transactionRepo:   transactionRepo,
+		changeRepo:        changeRepo,
+		attachmentCleanup: attachmentCleanup,
+	}
+}
+
+// Execute performs the account deletion. The account's transactions are kept but no longer
+// belong to any account.
+func (uc *DeleteAccountUseCase) Execute(ctx context.Context, input DeleteAccountInput) (*DeleteAccountOutput, error) {
+	// Find the existing account and check ownership
+	if _, err := findOwnedAccount(ctx, uc.accountRepo, input.AccountID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	// Snapshot the transactions that are detached from the account, for the history
+	filter := adapter.TransactionFilter{UserID: input.UserID, AccountIDs: []uuid.UUID{input.AccountID}}
+	var before []*entity.Transaction
+	err := uc.transactionRepo.StreamByFilter(ctx, filter, valueobject.DefaultTransactionSort(), deleteAccountBatchSize,
+		func(batch []*entity.TransactionWithCategory) error {
+			for _, txnWithCat := range batch {
+				before = append(before, txnWithCat.Transaction)
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find account transactions: %w", err)
+	}
+
+	// Delete the account
+	if err := uc.accountRepo.Delete(ctx, input.AccountID); err != nil {
+		return nil, fmt.Errorf("failed to delete account: %w", err)
+	}
+
+	// Record the detached transactions as a single operation; the account is already deleted,
+	// so a failure is logged instead of returned
+	if len(before) > 0 {
+		operationID := uuid.New()
+		changes := make([]*entity.TransactionChange, len(before))
+		for i, txn := range before {
+			after := *txn
+			after.AccountID = nil
+			changes[i] = entity.NewTransactionChange(txn, &after, &input.UserID, entity.TransactionChangeSourceManual, operationID)
+		}
+		if err := uc.changeRepo.CreateMany(ctx, changes); err != nil {
+			slog.Warn("Failed to record transaction changes",
+				"operation_id", operationID,
+				"count", len(changes),
+				"error", err,
+			)
+		}
+	}
+
+	// Remove the attachments of the deleted account in the background
+	if uc.attachmentCleanup != nil {
+		uc.attachmentCleanup.NotifyOwnersDeleted(input.UserID)
+	}
+
+	return &DeleteAccountOutput{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,68 @@
+// Package account contains account-related use cases.
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// DeleteBillingCycleOverrideInput represents the input for removing the override of a billing cycle.
+type DeleteBillingCycleOverrideInput struct {
+	AccountID    uuid.UUID
+	UserID       uuid.UUID
+	BillingCycle string // "YYYY-MM"
+}
+
+// DeleteBillingCycleOverrideOutput represents the output of removing the override of a billing cycle.
+type DeleteBillingCycleOverrideOutput struct {
+	Success bool
+}
+
+// DeleteBillingCycleOverrideUseCase handles restoring the card's regular days for a billing cycle.
+type DeleteBillingCycleOverrideUseCase struct {
+	accountRepo  adapter.AccountRepository
+	overrideRepo adapter.BillingCycleOverrideRepository
+}
+
+// NewDeleteBillingCycleOverrideUseCase creates a new DeleteBillingCycleOverrideUseCase instance.
+func NewDeleteBillingCycleOverrideUseCase(
+	accountRepo adapter.AccountRepository,
+	overrideRepo adapter.BillingCycleOverrideRepository,
+) *DeleteBillingCycleOverrideUseCase {
+	return &DeleteBillingCycleOverrideUseCase{
+		accountRepo:  accountRepo,
+		overrideRepo: overrideRepo,
+	}
+}
+
+// Execute removes the override, so the billing cycle follows the card's regular days again.
+func (uc *DeleteBillingCycleOverrideUseCase) Execute(
+	ctx context.Context,
+	input DeleteBillingCycleOverrideInput,
+) (*DeleteBillingCycleOverrideOutput, error) {
+	// Find the existing account and check ownership
+	if _, err := findOwnedAccount(ctx, uc.accountRepo, input.AccountID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.overrideRepo.Delete(ctx, input.AccountID, input.BillingCycle); err != nil {
+		if errors.Is(err, domainerror.ErrBillingCycleOverrideNotFound) {
+			return nil, domainerror.NewAccountError(
+				domainerror.ErrCodeBillingOverrideNotFound,
+				"billing cycle override not found",
+				domainerror.ErrBillingCycleOverrideNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to delete billing cycle override: %w", err)
+	}
+
+	return &DeleteBillingCycleOverrideOutput{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,84 @@
+// Package account contains account-related use cases.
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// GetAccountInput represents the input for retrieving an account.
+type GetAccountInput struct {
+	AccountID uuid.UUID
+	UserID    uuid.UUID
+}
+
+// GetAccountOutput represents the output of retrieving an account.
+type GetAccountOutput struct {
+	Account *AccountOutput
+}
+
+// GetAccountUseCase handles retrieving a single account.
+type GetAccountUseCase struct {
+	accountRepo adapter.AccountRepository
+}
+
+// NewGetAccountUseCase creates a new GetAccountUseCase instance.
+func NewGetAccountUseCase(accountRepo adapter.AccountRepository) *GetAccountUseCase {
+	return &GetAccountUseCase{
+		accountRepo: accountRepo,
+	}
+}
+
+// Execute retrieves the account with its current balance.
+func (uc *GetAccountUseCase) Execute(ctx context.Context, input GetAccountInput) (*GetAccountOutput, error) {
+	account, err := findOwnedAccount(ctx, uc.accountRepo, input.AccountID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := buildAccountOutputs(ctx, uc.accountRepo, []*entity.Account{account})
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetAccountOutput{
+		Account: outputs[0],
+	}, nil
+}
+
+// findOwnedAccount loads an account and verifies it belongs to the user.
+func findOwnedAccount(
+	ctx context.Context,
+	accountRepo adapter.AccountRepository,
+	accountID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Account, error) {
+	account, err := accountRepo.FindByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrAccountNotFound) {
+			return nil, domainerror.NewAccountError(
+				domainerror.ErrCodeAccountNotFound,
+				"account not found",
+				domainerror.ErrAccountNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to find account: %w", err)
+	}
+
+	if account.UserID != userID {
+		return nil, domainerror.NewAccountError(
+			domainerror.ErrCodeNotAuthorizedAccount,
+			"not authorized to access this account",
+			domainerror.ErrNotAuthorizedAccount,
+		)
+	}
+
+	return account, nil
+}
@@ -0,0 +1,90 @@
+// Package account contains account-related use cases.
+package account
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// GetOpenBillInput represents the input for retrieving a credit card's open bill.
+type GetOpenBillInput struct {
+	AccountID uuid.UUID
+	UserID    uuid.UUID
+	Date      time.Time // The bill open on this date is returned; defaults to today
+}
+
+// GetOpenBillOutput represents the open bill of a credit card.
+type GetOpenBillOutput struct {
+	AccountID    uuid.UUID
+	Period       *entity.BillingPeriod
+	Currency     string          // Base currency the total is in
+	Total        decimal.Decimal // Charges minus refunds recorded so far
+	Transactions []*entity.Transaction
+}
+
+// GetOpenBillUseCase handles computing the running total of a credit card bill before its statement
+// is imported.
+type GetOpenBillUseCase struct {
+	accountRepo  adapter.AccountRepository
+	overrideRepo adapter.BillingCycleOverrideRepository
+	converter    *exchangerate.Converter
+}
+
+// NewGetOpenBillUseCase creates a new GetOpenBillUseCase instance.
+func NewGetOpenBillUseCase(
+	accountRepo adapter.AccountRepository,
+	overrideRepo adapter.BillingCycleOverrideRepository,
+	converter *exchangerate.Converter,
+) *GetOpenBillUseCase {
+	return &GetOpenBillUseCase{
+		accountRepo:  accountRepo,
+		overrideRepo: overrideRepo,
+		converter:    converter,
+	}
+}
+
+// Execute returns the bill open on the given date with the card transactions charged in it so far.
+func (uc *GetOpenBillUseCase) Execute(ctx context.Context, input GetOpenBillInput) (*GetOpenBillOutput, error) {
+	if input.Date.IsZero() {
+		input.Date = time.Now().UTC()
+	}
+
+	account, calendar, _, err := findCardCalendar(ctx, uc.accountRepo, uc.overrideRepo, input.AccountID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	period := calendar.Period(calendar.BillingCycleOf(input.Date))
+	transactions, err := uc.accountRepo.FindBillTransactions(ctx, account.ID, period.BillingCycle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bill transactions: %w", err)
+	}
+
+	currency := entity.DefaultCurrency
+	if uc.converter != nil {
+		if currency, err = uc.converter.BaseCurrency(ctx, input.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	total := decimal.Zero
+	for _, txn := range transactions {
+		total = total.Add(txn.BillAmount())
+	}
+
+	return &GetOpenBillOutput{
+		AccountID:    account.ID,
+		Period:       period,
+		Currency:     currency,
+		Total:        total,
+		Transactions: transactions,
+	}, nil
+}
@@ -0,0 +1,85 @@
+// Package account contains account-related use cases.
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// AccountOutput represents an account with its current balance.
+type AccountOutput struct {
+	Account        *entity.Account
+	CurrentBalance decimal.Decimal // Opening balance plus all of the account's transactions
+}
+
+// ListAccountsInput represents the input for listing accounts.
+type ListAccountsInput struct {
+	UserID          uuid.UUID
+	IncludeArchived bool
+}
+
+// ListAccountsOutput represents the output of listing accounts.
+type ListAccountsOutput struct {
+	Accounts []*AccountOutput
+}
+
+// ListAccountsUseCase handles listing accounts logic.
+type ListAccountsUseCase struct {
+	accountRepo adapter.AccountRepository
+}
+
+// NewListAccountsUseCase creates a new ListAccountsUseCase instance.
+func NewListAccountsUseCase(accountRepo adapter.AccountRepository) *ListAccountsUseCase {
+	return &ListAccountsUseCase{
+		accountRepo: accountRepo,
+	}
+}
+
+// Execute performs the accounts listing.
+func (uc *ListAccountsUseCase) Execute(ctx context.Context, input ListAccountsInput) (*ListAccountsOutput, error) {
+	accounts, err := uc.accountRepo.FindByUser(ctx, input.UserID, input.IncludeArchived)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	outputs, err := buildAccountOutputs(ctx, uc.accountRepo, accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListAccountsOutput{
+		Accounts: outputs,
+	}, nil
+}
+
+// buildAccountOutputs computes the current balance of each account with a single totals query.
+func buildAccountOutputs(
+	ctx context.Context,
+	accountRepo adapter.AccountRepository,
+	accounts []*entity.Account,
+) ([]*AccountOutput, error) {
+	accountIDs := make([]uuid.UUID, len(accounts))
+	for i, a := range accounts {
+		accountIDs[i] = a.ID
+	}
+
+	totals, err := accountRepo.GetTransactionTotals(ctx, accountIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate account balances: %w", err)
+	}
+
+	outputs := make([]*AccountOutput, len(accounts))
+	for i, a := range accounts {
+		outputs[i] = &AccountOutput{
+			Account:        a,
+			CurrentBalance: a.OpeningBalance.Add(totals[a.ID]),
+		}
+	}
+	return outputs, nil
+}
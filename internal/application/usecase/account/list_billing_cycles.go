@@ -0,0 +1,110 @@
+// Package account contains account-related use cases.
+package account
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// UpcomingBillingCycles is the number of billing cycles listed, starting with the open one.
+const UpcomingBillingCycles = 6
+
+// ListBillingCyclesInput represents the input for listing a credit card's billing cycles.
+type ListBillingCyclesInput struct {
+	AccountID uuid.UUID
+	UserID    uuid.UUID
+}
+
+// BillingCycleOutput represents a billing cycle with the override that moved its dates, if any.
+type BillingCycleOutput struct {
+	Period   *entity.BillingPeriod
+	Override *entity.BillingCycleOverride // nil when the cycle follows the card's regular days
+}
+
+// ListBillingCyclesOutput represents the output of listing a credit card's billing cycles.
+type ListBillingCyclesOutput struct {
+	Cycles    []*BillingCycleOutput          // The open cycle and the following ones
+	Overrides []*entity.BillingCycleOverride // Every override of the card, sorted by billing cycle
+}
+
+// ListBillingCyclesUseCase handles listing the upcoming billing cycles of a credit card.
+type ListBillingCyclesUseCase struct {
+	accountRepo  adapter.AccountRepository
+	overrideRepo adapter.BillingCycleOverrideRepository
+}
+
+// NewListBillingCyclesUseCase creates a new ListBillingCyclesUseCase instance.
+func NewListBillingCyclesUseCase(
+	accountRepo adapter.AccountRepository,
+	overrideRepo adapter.BillingCycleOverrideRepository,
+) *ListBillingCyclesUseCase {
+	return &ListBillingCyclesUseCase{
+		accountRepo:  accountRepo,
+		overrideRepo: overrideRepo,
+	}
+}
+
+// Execute lists the open billing cycle of the card and the following ones, with their dates.
+func (uc *ListBillingCyclesUseCase) Execute(ctx context.Context, input ListBillingCyclesInput) (*ListBillingCyclesOutput, error) {
+	_, calendar, overrides, err := findCardCalendar(ctx, uc.accountRepo, uc.overrideRepo, input.AccountID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	overridesByCycle := make(map[string]*entity.BillingCycleOverride, len(overrides))
+	for _, override := range overrides {
+		overridesByCycle[override.BillingCycle] = override
+	}
+
+	openCycle := calendar.BillingCycleOf(time.Now().UTC())
+	cycles := make([]*BillingCycleOutput, UpcomingBillingCycles)
+	for i := range cycles {
+		billingCycle := entity.ShiftBillingCycle(openCycle, i)
+		cycles[i] = &BillingCycleOutput{
+			Period:   calendar.Period(billingCycle),
+			Override: overridesByCycle[billingCycle],
+		}
+	}
+
+	return &ListBillingCyclesOutput{
+		Cycles:    cycles,
+		Overrides: overrides,
+	}, nil
+}
+
+// findCardCalendar loads a credit card owned by the user with its billing calendar and overrides.
+// Cards without a closing day have no calendar.
+func findCardCalendar(
+	ctx context.Context,
+	accountRepo adapter.AccountRepository,
+	overrideRepo adapter.BillingCycleOverrideRepository,
+	accountID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Account, *entity.BillingCalendar, []*entity.BillingCycleOverride, error) {
+	account, err := findOwnedAccount(ctx, accountRepo, accountID, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if !account.IsCreditCard() || account.ClosingDay == nil {
+		return nil, nil, nil, domainerror.NewAccountError(
+			domainerror.ErrCodeBillingDaysNotSet,
+			"account must be a credit card with a closing day",
+			domainerror.ErrBillingDaysNotSet,
+		)
+	}
+
+	overrides, err := overrideRepo.FindByAccount(ctx, account.ID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to list billing cycle overrides: %w", err)
+	}
+
+	return account, entity.NewBillingCalendar(account, overrides), overrides, nil
+}
@@ -0,0 +1,133 @@
+// Package account contains account-related use cases.
+package account
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// MaxBillingDateShiftDays is how many days an override may move a bill's closing or due date
+// away from the card's regular day.
+const MaxBillingDateShiftDays = 7
+
+// SetBillingCycleOverrideInput represents the input for overriding the dates of a billing cycle.
+type SetBillingCycleOverrideInput struct {
+	AccountID    uuid.UUID
+	UserID       uuid.UUID
+	BillingCycle string     // "YYYY-MM"
+	ClosingDate  *time.Time // nil keeps the card's closing day
+	DueDate      *time.Time // nil keeps the card's due day
+}
+
+// SetBillingCycleOverrideOutput represents the output of overriding the dates of a billing cycle.
+type SetBillingCycleOverrideOutput struct {
+	Cycle *BillingCycleOutput
+}
+
+// SetBillingCycleOverrideUseCase handles moving the closing or due date of a single bill.
+type SetBillingCycleOverrideUseCase struct {
+	accountRepo  adapter.AccountRepository
+	overrideRepo adapter.BillingCycleOverrideRepository
+}
+
+// NewSetBillingCycleOverrideUseCase creates a new SetBillingCycleOverrideUseCase instance.
+func NewSetBillingCycleOverrideUseCase(
+	accountRepo adapter.AccountRepository,
+	overrideRepo adapter.BillingCycleOverrideRepository,
+) *SetBillingCycleOverrideUseCase {
+	return &SetBillingCycleOverrideUseCase{
+		accountRepo:  accountRepo,
+		overrideRepo: overrideRepo,
+	}
+}
+
+// Execute saves the override of the billing cycle, replacing any previous one.
+// Dates may only move up to MaxBillingDateShiftDays from the card's regular days.
+func (uc *SetBillingCycleOverrideUseCase) Execute(
+	ctx context.Context,
+	input SetBillingCycleOverrideInput,
+) (*SetBillingCycleOverrideOutput, error) {
+	account, calendar, overrides, err := findCardCalendar(ctx, uc.accountRepo, uc.overrideRepo, input.AccountID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate input
+	if entity.ShiftBillingCycle(input.BillingCycle, 0) != input.BillingCycle {
+		return nil, domainerror.NewAccountError(
+			domainerror.ErrCodeInvalidBillingOverride,
+			"billing cycle must be in YYYY-MM format",
+			domainerror.ErrInvalidBillingCycleOverride,
+		)
+	}
+	if input.ClosingDate == nil && input.DueDate == nil {
+		return nil, domainerror.NewAccountError(
+			domainerror.ErrCodeInvalidBillingOverride,
+			"closing_date or due_date is required",
+			domainerror.ErrInvalidBillingCycleOverride,
+		)
+	}
+
+	closingDate := calendar.RegularClosingDate(input.BillingCycle)
+	if input.ClosingDate != nil {
+		if !withinShift(*input.ClosingDate, closingDate) {
+			return nil, domainerror.NewAccountError(
+				domainerror.ErrCodeInvalidBillingOverride,
+				fmt.Sprintf("closing_date must be within %d days of the regular closing date", MaxBillingDateShiftDays),
+				domainerror.ErrInvalidBillingCycleOverride,
+			)
+		}
+		closingDate = *input.ClosingDate
+	}
+	if input.DueDate != nil {
+		if regularDueDate := calendar.RegularDueDate(input.BillingCycle); regularDueDate != nil && !withinShift(*input.DueDate, *regularDueDate) {
+			return nil, domainerror.NewAccountError(
+				domainerror.ErrCodeInvalidBillingOverride,
+				fmt.Sprintf("due_date must be within %d days of the regular due date", MaxBillingDateShiftDays),
+				domainerror.ErrInvalidBillingCycleOverride,
+			)
+		}
+		if !input.DueDate.After(closingDate) {
+			return nil, domainerror.NewAccountError(
+				domainerror.ErrCodeInvalidBillingOverride,
+				"due_date must be after the closing date",
+				domainerror.ErrInvalidBillingCycleOverride,
+			)
+		}
+	}
+
+	override := entity.NewBillingCycleOverride(account.ID, input.BillingCycle, input.ClosingDate, input.DueDate)
+	if err := uc.overrideRepo.Upsert(ctx, override); err != nil {
+		return nil, fmt.Errorf("failed to save billing cycle override: %w", err)
+	}
+
+	// Recompute the cycle's dates with the new override in place of any previous one
+	updated := []*entity.BillingCycleOverride{override}
+	for _, existing := range overrides {
+		if existing.BillingCycle != override.BillingCycle {
+			updated = append(updated, existing)
+		}
+	}
+	calendar = entity.NewBillingCalendar(account, updated)
+
+	return &SetBillingCycleOverrideOutput{
+		Cycle: &BillingCycleOutput{
+			Period:   calendar.Period(input.BillingCycle),
+			Override: override,
+		},
+	}, nil
+}
+
+// withinShift reports whether the date is at most MaxBillingDateShiftDays away from the regular date.
+func withinShift(date, regular time.Time) bool {
+	maxShift := time.Duration(MaxBillingDateShiftDays) * 24 * time.Hour
+	difference := date.Sub(regular)
+	return difference >= -maxShift && difference <= maxShift
+}
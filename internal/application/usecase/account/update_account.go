@@ -0,0 +1,140 @@
+// Package account contains account-related use cases.
+package account
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// UpdateAccountInput represents the input for account update.
+// Nil fields are left unchanged.
+type UpdateAccountInput struct {
+	AccountID        uuid.UUID
+	UserID           uuid.UUID
+	Name             *string
+	Type             *entity.AccountType
+	Institution      *string
+	Currency         *string
+	OpeningBalance   *decimal.Decimal
+	ClosingDay       *int
+	DueDay           *int
+	ClearBillingDays bool // Removes closing and due days (e.g., when changing away from credit card)
+	IsArchived       *bool
+}
+
+// UpdateAccountOutput represents the output of account update.
+type UpdateAccountOutput struct {
+	Account *AccountOutput
+}
+
+// UpdateAccountUseCase handles account update logic.
+type UpdateAccountUseCase struct {
+	accountRepo adapter.AccountRepository
+}
+
+// NewUpdateAccountUseCase creates a new UpdateAccountUseCase instance.
+func NewUpdateAccountUseCase(accountRepo adapter.AccountRepository) *UpdateAccountUseCase {
+	return &UpdateAccountUseCase{
+		accountRepo: accountRepo,
+	}
+}
+
+// Execute performs the account update.
+func (uc *UpdateAccountUseCase) Execute(ctx context.Context, input UpdateAccountInput) (*UpdateAccountOutput, error) {
+	// Find the existing account
+	account, err := findOwnedAccount(ctx, uc.accountRepo, input.AccountID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply changes
+	if input.Name != nil {
+		account.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Type != nil {
+		account.Type = *input.Type
+	}
+	if input.Institution != nil {
+		account.Institution = strings.TrimSpace(*input.Institution)
+	}
+	previousCurrency := account.Currency
+	if input.Currency != nil {
+		account.Currency = strings.ToUpper(strings.TrimSpace(*input.Currency))
+	}
+	if input.OpeningBalance != nil {
+		account.OpeningBalance = *input.OpeningBalance
+	}
+	if input.ClearBillingDays {
+		account.ClosingDay = nil
+		account.DueDay = nil
+	}
+	if input.ClosingDay != nil {
+		account.ClosingDay = input.ClosingDay
+	}
+	if input.DueDay != nil {
+		account.DueDay = input.DueDay
+	}
+	if input.IsArchived != nil {
+		account.IsArchived = *input.IsArchived
+	}
+
+	// Validate the resulting account
+	if err := ValidateAccount(account); err != nil {
+		return nil, err
+	}
+
+	// Check if the new name is taken by another account
+	if input.Name != nil {
+		exists, err := uc.accountRepo.ExistsByNameAndUser(ctx, account.Name, input.UserID, &account.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check account name existence: %w", err)
+		}
+		if exists {
+			return nil, domainerror.NewAccountError(
+				domainerror.ErrCodeAccountNameExists,
+				"an account with this name already exists",
+				domainerror.ErrAccountNameExists,
+			)
+		}
+	}
+
+	// Transactions are kept in their account's currency, so it cannot change under them
+	if account.Currency != previousCurrency {
+		hasTransactions, err := uc.accountRepo.HasTransactions(ctx, account.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check account transactions: %w", err)
+		}
+		if hasTransactions {
+			return nil, domainerror.NewAccountError(
+				domainerror.ErrCodeAccountCurrencyInUse,
+				"the currency of an account with transactions cannot be changed",
+				domainerror.ErrAccountCurrencyInUse,
+			)
+		}
+	}
+
+	account.UpdatedAt = time.Now().UTC()
+
+	// Save updated account
+	if err := uc.accountRepo.Update(ctx, account); err != nil {
+		return nil, fmt.Errorf("failed to update account: %w", err)
+	}
+
+	outputs, err := buildAccountOutputs(ctx, uc.accountRepo, []*entity.Account{account})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateAccountOutput{
+		Account: outputs[0],
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
 	"github.com/finance-tracker/backend/internal/domain/entity"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
 )
@@ -27,6 +28,7 @@ type ApproveSuggestionOutput struct {
 	CategoryRulePattern     string `json:"category_rule_pattern,omitempty"`
 	TransactionsUpdated     int    `json:"transactions_updated"`
 	WasNewCategoryCreated   bool   `json:"was_new_category_created"`
+	OperationID             string `json:"operation_id,omitempty"` // Groups the recorded transaction changes for revert
 }
 
 // ApproveSuggestionUseCase handles approving an AI suggestion.
@@ -34,6 +36,7 @@ type ApproveSuggestionUseCase struct {
 	suggestionRepo  adapter.AISuggestionRepository
 	categoryRepo    adapter.CategoryRepository
 	transactionRepo adapter.TransactionRepository
+	changeRepo      adapter.TransactionChangeRepository
 	ruleRepo        adapter.CategoryRuleRepository
 }
 
@@ -42,12 +45,14 @@ func NewApproveSuggestionUseCase(
 	suggestionRepo adapter.AISuggestionRepository,
 	categoryRepo adapter.CategoryRepository,
 	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
 	ruleRepo adapter.CategoryRuleRepository,
 ) *ApproveSuggestionUseCase {
 	return &ApproveSuggestionUseCase{
 		suggestionRepo:  suggestionRepo,
 		categoryRepo:    categoryRepo,
 		transactionRepo: transactionRepo,
+		changeRepo:      changeRepo,
 		ruleRepo:        ruleRepo,
 	}
 }
@@ -176,11 +181,25 @@ func (uc *ApproveSuggestionUseCase) Execute(ctx context.Context, input ApproveSu
 
 	// Categorize affected transactions
 	transactionIDs := append([]uuid.UUID{suggestion.TransactionID}, suggestion.AffectedTransactionIDs...)
-	updatedCount, err := uc.transactionRepo.BulkUpdateCategory(ctx, transactionIDs, categoryID, input.UserID)
+	before, err := uc.transactionRepo.FindByIDs(ctx, transactionIDs, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+	updatedCount, err := uc.transactionRepo.BulkUpdateCategory(ctx, transactionIDs, categoryID, input.UserID, false)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update transactions: %w", err)
 	}
 
+	// Record the changes in the transaction history as a single operation
+	operationID := uuid.New()
+	after, err := uc.transactionRepo.FindByIDs(ctx, transactionIDs, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+	transaction.RecordTransactionChanges(ctx, uc.changeRepo,
+		transaction.DiffTransactionChanges(before, after, &input.UserID, entity.TransactionChangeSourceAI, operationID)...,
+	)
+
 	// Update suggestion status to approved
 	suggestion.Status = entity.SuggestionStatusApproved
 	suggestion.UpdatedAt = time.Now().UTC()
@@ -195,6 +214,7 @@ func (uc *ApproveSuggestionUseCase) Execute(ctx context.Context, input ApproveSu
 		CategoryRulePattern:   rulePattern,
 		TransactionsUpdated:   int(updatedCount),
 		WasNewCategoryCreated: wasNewCategoryCreated,
+		OperationID:           operationID.String(),
 	}, nil
 }
 
@@ -0,0 +1,134 @@
+// Package attachment contains attachment-related use cases.
+package attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// CompleteUploadInput represents the input for completing a direct upload.
+type CompleteUploadInput struct {
+	AttachmentID uuid.UUID
+	UserID       uuid.UUID
+}
+
+// CompleteUploadOutput represents the output of completing a direct upload.
+type CompleteUploadOutput struct {
+	Attachment *entity.Attachment
+}
+
+// CompleteUploadUseCase handles marking a pending attachment as uploaded once the client has
+// put its file in object storage. The file is read back to record its checksum and thumbnail.
+// Completing an attachment that is already uploaded is a no-op.
+type CompleteUploadUseCase struct {
+	attachmentRepo adapter.AttachmentRepository
+	storage        adapter.ObjectStorage
+	thumbnails     adapter.ThumbnailGenerator
+}
+
+// NewCompleteUploadUseCase creates a new CompleteUploadUseCase instance.
+func NewCompleteUploadUseCase(
+	attachmentRepo adapter.AttachmentRepository,
+	storage adapter.ObjectStorage,
+	thumbnails adapter.ThumbnailGenerator,
+) *CompleteUploadUseCase {
+	return &CompleteUploadUseCase{
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+		thumbnails:     thumbnails,
+	}
+}
+
+// Execute verifies the uploaded file and marks the attachment as uploaded.
+func (uc *CompleteUploadUseCase) Execute(ctx context.Context, input CompleteUploadInput) (*CompleteUploadOutput, error) {
+	attachment, err := findOwnedAttachment(ctx, uc.attachmentRepo, input.AttachmentID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if attachment.IsUploaded() {
+		return &CompleteUploadOutput{Attachment: attachment}, nil
+	}
+
+	info, err := uc.storage.Stat(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrObjectNotFound) {
+			return nil, domainerror.NewAttachmentError(
+				domainerror.ErrCodeAttachmentNotUploaded,
+				"file has not been uploaded yet",
+				domainerror.ErrAttachmentNotUploaded,
+			)
+		}
+		return nil, fmt.Errorf("failed to check uploaded file: %w", err)
+	}
+
+	// The presigned URL does not limit the size, so oversized files are discarded here
+	if err := validateSize(info.Size); err != nil {
+		_ = uc.storage.Delete(ctx, attachment.StorageKey)
+		return nil, err
+	}
+
+	reader, err := uc.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read uploaded file: %w", err)
+	}
+	content, err := io.ReadAll(io.LimitReader(reader, MaxAttachmentSize))
+	reader.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read uploaded file: %w", err)
+	}
+
+	attachment.SizeBytes = int64(len(content))
+	attachment.Checksum = checksum(content)
+	attachment.Status = entity.AttachmentStatusUploaded
+	attachment.UpdatedAt = time.Now().UTC()
+
+	storeThumbnail(ctx, uc.storage, uc.thumbnails, attachment, content)
+
+	if err := uc.attachmentRepo.Update(ctx, attachment); err != nil {
+		return nil, fmt.Errorf("failed to update attachment: %w", err)
+	}
+
+	return &CompleteUploadOutput{
+		Attachment: attachment,
+	}, nil
+}
+
+// findOwnedAttachment retrieves an attachment and verifies it belongs to the user.
+func findOwnedAttachment(
+	ctx context.Context,
+	attachmentRepo adapter.AttachmentRepository,
+	attachmentID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Attachment, error) {
+	attachment, err := attachmentRepo.FindByID(ctx, attachmentID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrAttachmentNotFound) {
+			return nil, domainerror.NewAttachmentError(
+				domainerror.ErrCodeAttachmentNotFound,
+				"attachment not found",
+				domainerror.ErrAttachmentNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to find attachment: %w", err)
+	}
+
+	if attachment.UserID != userID {
+		return nil, domainerror.NewAttachmentError(
+			domainerror.ErrCodeNotAuthorizedAttachment,
+			"not authorized to access this attachment",
+			domainerror.ErrNotAuthorizedAttachment,
+		)
+	}
+
+	return attachment, nil
+}
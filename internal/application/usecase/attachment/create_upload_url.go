@@ -0,0 +1,100 @@
+// Package attachment contains attachment-related use cases.
+package attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// CreateUploadURLInput represents the input for starting a direct upload to object storage.
+type CreateUploadURLInput struct {
+	UserID      uuid.UUID
+	OwnerType   entity.AttachmentOwnerType
+	OwnerID     uuid.UUID
+	FileName    string
+	ContentType string
+	SizeBytes   int64 // Declared size, checked again when the upload is completed
+}
+
+// CreateUploadURLOutput represents the output of starting a direct upload.
+type CreateUploadURLOutput struct {
+	Attachment *entity.Attachment
+	UploadURL  string
+	ExpiresAt  time.Time
+}
+
+// CreateUploadURLUseCase handles issuing presigned URLs so that clients upload large files
+// straight to object storage. The attachment stays pending until the upload is completed.
+type CreateUploadURLUseCase struct {
+	attachmentRepo  adapter.AttachmentRepository
+	transactionRepo adapter.TransactionRepository
+	accountRepo     adapter.AccountRepository
+	storage         adapter.ObjectStorage
+	urlExpiry       time.Duration
+}
+
+// NewCreateUploadURLUseCase creates a new CreateUploadURLUseCase instance.
+func NewCreateUploadURLUseCase(
+	attachmentRepo adapter.AttachmentRepository,
+	transactionRepo adapter.TransactionRepository,
+	accountRepo adapter.AccountRepository,
+	storage adapter.ObjectStorage,
+	urlExpiry time.Duration,
+) *CreateUploadURLUseCase {
+	return &CreateUploadURLUseCase{
+		attachmentRepo:  attachmentRepo,
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		storage:         storage,
+		urlExpiry:       urlExpiry,
+	}
+}
+
+// Execute records a pending attachment and returns the URL to upload its file to.
+func (uc *CreateUploadURLUseCase) Execute(ctx context.Context, input CreateUploadURLInput) (*CreateUploadURLOutput, error) {
+	if err := validateOwner(ctx, uc.transactionRepo, uc.accountRepo, input.OwnerType, input.OwnerID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	contentType, err := validateFile(input.FileName, input.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSize(input.SizeBytes); err != nil {
+		return nil, err
+	}
+
+	attachment := entity.NewAttachment(input.UserID, input.OwnerType, input.OwnerID,
+		input.FileName, contentType, entity.AttachmentStatusPending)
+	attachment.SizeBytes = input.SizeBytes
+
+	uploadURL, err := uc.storage.PresignPut(ctx, attachment.StorageKey, contentType, uc.urlExpiry)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrPresignNotSupported) {
+			return nil, domainerror.NewAttachmentError(
+				domainerror.ErrCodePresignNotSupported,
+				"direct uploads are not available, upload the file to the attachments endpoint instead",
+				domainerror.ErrPresignNotSupported,
+			)
+		}
+		return nil, fmt.Errorf("failed to create upload URL: %w", err)
+	}
+
+	if err := uc.attachmentRepo.Create(ctx, attachment); err != nil {
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	return &CreateUploadURLOutput{
+		Attachment: attachment,
+		UploadURL:  uploadURL,
+		ExpiresAt:  attachment.CreatedAt.Add(uc.urlExpiry),
+	}, nil
+}
@@ -0,0 +1,60 @@
+// Package attachment contains attachment-related use cases.
+package attachment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+// DeleteAttachmentInput represents the input for attachment deletion.
+type DeleteAttachmentInput struct {
+	AttachmentID uuid.UUID
+	UserID       uuid.UUID
+}
+
+// DeleteAttachmentOutput represents the output of attachment deletion.
+type DeleteAttachmentOutput struct {
+	Success bool
+}
+
+// DeleteAttachmentUseCase handles removing an attachment and its files from object storage.
+type DeleteAttachmentUseCase struct {
+	attachmentRepo adapter.AttachmentRepository
+	storage        adapter.ObjectStorage
+}
+
+// NewDeleteAttachmentUseCase creates a new DeleteAttachmentUseCase instance.
+func NewDeleteAttachmentUseCase(
+	attachmentRepo adapter.AttachmentRepository,
+	storage adapter.ObjectStorage,
+) *DeleteAttachmentUseCase {
+	return &DeleteAttachmentUseCase{
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+	}
+}
+
+// Execute performs the attachment deletion. Files are removed first so that a storage
+// failure leaves the record in place to retry.
+func (uc *DeleteAttachmentUseCase) Execute(ctx context.Context, input DeleteAttachmentInput) (*DeleteAttachmentOutput, error) {
+	attachment, err := findOwnedAttachment(ctx, uc.attachmentRepo, input.AttachmentID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deleteObjects(ctx, uc.storage, attachment); err != nil {
+		return nil, err
+	}
+
+	if err := uc.attachmentRepo.Delete(ctx, attachment.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	return &DeleteAttachmentOutput{
+		Success: true,
+	}, nil
+}
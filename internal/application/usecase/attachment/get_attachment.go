@@ -0,0 +1,93 @@
+// Package attachment contains attachment-related use cases.
+package attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// GetAttachmentInput represents the input for getting an attachment.
+type GetAttachmentInput struct {
+	AttachmentID uuid.UUID
+	UserID       uuid.UUID
+}
+
+// GetAttachmentOutput represents the output of getting an attachment.
+// The URLs are empty when the object storage cannot presign them; the file is then
+// downloaded through the API.
+type GetAttachmentOutput struct {
+	Attachment   *entity.Attachment
+	DownloadURL  string
+	ThumbnailURL string
+	ExpiresAt    time.Time
+}
+
+// GetAttachmentUseCase handles getting an attachment with temporary download URLs.
+type GetAttachmentUseCase struct {
+	attachmentRepo adapter.AttachmentRepository
+	storage        adapter.ObjectStorage
+	urlExpiry      time.Duration
+}
+
+// NewGetAttachmentUseCase creates a new GetAttachmentUseCase instance.
+func NewGetAttachmentUseCase(
+	attachmentRepo adapter.AttachmentRepository,
+	storage adapter.ObjectStorage,
+	urlExpiry time.Duration,
+) *GetAttachmentUseCase {
+	return &GetAttachmentUseCase{
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+		urlExpiry:      urlExpiry,
+	}
+}
+
+// Execute returns the attachment and its download URLs.
+func (uc *GetAttachmentUseCase) Execute(ctx context.Context, input GetAttachmentInput) (*GetAttachmentOutput, error) {
+	attachment, err := findOwnedAttachment(ctx, uc.attachmentRepo, input.AttachmentID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &GetAttachmentOutput{Attachment: attachment}
+	if !attachment.IsUploaded() {
+		return output, nil
+	}
+
+	output.DownloadURL, err = uc.presign(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+	if attachment.ThumbnailKey != "" {
+		output.ThumbnailURL, err = uc.presign(ctx, attachment.ThumbnailKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if output.DownloadURL != "" {
+		output.ExpiresAt = time.Now().UTC().Add(uc.urlExpiry)
+	}
+
+	return output, nil
+}
+
+// presign returns a download URL for the object, or an empty string when the storage
+// cannot presign URLs.
+func (uc *GetAttachmentUseCase) presign(ctx context.Context, key string) (string, error) {
+	url, err := uc.storage.PresignGet(ctx, key, uc.urlExpiry)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrPresignNotSupported) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to create download URL: %w", err)
+	}
+	return url, nil
+}
@@ -0,0 +1,92 @@
+// Package attachment contains attachment-related use cases.
+package attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// GetAttachmentContentInput represents the input for downloading an attachment through the API.
+type GetAttachmentContentInput struct {
+	AttachmentID uuid.UUID
+	UserID       uuid.UUID
+	Thumbnail    bool // Download the thumbnail instead of the file
+}
+
+// GetAttachmentContentOutput represents the output of downloading an attachment.
+// The caller must close Content.
+type GetAttachmentContentOutput struct {
+	Content     io.ReadCloser
+	FileName    string
+	ContentType string
+}
+
+// GetAttachmentContentUseCase handles streaming an attachment's file or thumbnail from object storage.
+type GetAttachmentContentUseCase struct {
+	attachmentRepo adapter.AttachmentRepository
+	storage        adapter.ObjectStorage
+}
+
+// NewGetAttachmentContentUseCase creates a new GetAttachmentContentUseCase instance.
+func NewGetAttachmentContentUseCase(
+	attachmentRepo adapter.AttachmentRepository,
+	storage adapter.ObjectStorage,
+) *GetAttachmentContentUseCase {
+	return &GetAttachmentContentUseCase{
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+	}
+}
+
+// Execute opens the attachment's file or thumbnail.
+func (uc *GetAttachmentContentUseCase) Execute(ctx context.Context, input GetAttachmentContentInput) (*GetAttachmentContentOutput, error) {
+	attachment, err := findOwnedAttachment(ctx, uc.attachmentRepo, input.AttachmentID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !attachment.IsUploaded() {
+		return nil, domainerror.NewAttachmentError(
+			domainerror.ErrCodeAttachmentNotUploaded,
+			"file has not been uploaded yet",
+			domainerror.ErrAttachmentNotUploaded,
+		)
+	}
+
+	key, fileName, contentType := attachment.StorageKey, attachment.FileName, attachment.ContentType
+	if input.Thumbnail {
+		if attachment.ThumbnailKey == "" {
+			return nil, domainerror.NewAttachmentError(
+				domainerror.ErrCodeAttachmentNotFound,
+				"attachment has no thumbnail",
+				domainerror.ErrAttachmentNotFound,
+			)
+		}
+		key, fileName, contentType = attachment.ThumbnailKey, "thumbnail.jpg", "image/jpeg"
+	}
+
+	content, err := uc.storage.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrObjectNotFound) {
+			return nil, domainerror.NewAttachmentError(
+				domainerror.ErrCodeAttachmentNotFound,
+				"attachment file not found",
+				domainerror.ErrAttachmentNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+
+	return &GetAttachmentContentOutput{
+		Content:     content,
+		FileName:    fileName,
+		ContentType: contentType,
+	}, nil
+}
@@ -0,0 +1,60 @@
+// Package attachment contains attachment-related use cases.
+package attachment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ListAttachmentsInput represents the input for listing the attachments of a transaction or account.
+type ListAttachmentsInput struct {
+	UserID    uuid.UUID
+	OwnerType entity.AttachmentOwnerType
+	OwnerID   uuid.UUID
+}
+
+// ListAttachmentsOutput represents the output of listing attachments.
+type ListAttachmentsOutput struct {
+	Attachments []*entity.Attachment
+}
+
+// ListAttachmentsUseCase handles listing the uploaded attachments of a transaction or account.
+type ListAttachmentsUseCase struct {
+	attachmentRepo  adapter.AttachmentRepository
+	transactionRepo adapter.TransactionRepository
+	accountRepo     adapter.AccountRepository
+}
+
+// NewListAttachmentsUseCase creates a new ListAttachmentsUseCase instance.
+func NewListAttachmentsUseCase(
+	attachmentRepo adapter.AttachmentRepository,
+	transactionRepo adapter.TransactionRepository,
+	accountRepo adapter.AccountRepository,
+) *ListAttachmentsUseCase {
+	return &ListAttachmentsUseCase{
+		attachmentRepo:  attachmentRepo,
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+	}
+}
+
+// Execute returns the attachments, oldest first.
+func (uc *ListAttachmentsUseCase) Execute(ctx context.Context, input ListAttachmentsInput) (*ListAttachmentsOutput, error) {
+	if err := validateOwner(ctx, uc.transactionRepo, uc.accountRepo, input.OwnerType, input.OwnerID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := uc.attachmentRepo.FindByOwner(ctx, input.OwnerType, input.OwnerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+
+	return &ListAttachmentsOutput{
+		Attachments: attachments,
+	}, nil
+}
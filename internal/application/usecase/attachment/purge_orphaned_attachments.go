@@ -0,0 +1,89 @@
+// Package attachment contains attachment-related use cases.
+package attachment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+const (
+	// PendingUploadTTL is how long a pending attachment waits for its upload to be completed
+	// before it is purged.
+	PendingUploadTTL = 24 * time.Hour
+
+	// purgeBatchSize limits how many attachments a single purge removes.
+	purgeBatchSize = 500
+)
+
+// PurgeOrphanedAttachmentsInput represents the input for purging orphaned attachments.
+type PurgeOrphanedAttachmentsInput struct {
+	UserID *uuid.UUID // Optional, purges every user's orphaned attachments when nil
+	Now    time.Time
+}
+
+// PurgeOrphanedAttachmentsOutput represents the output of purging orphaned attachments.
+type PurgeOrphanedAttachmentsOutput struct {
+	PurgedCount int
+	FailedCount int
+}
+
+// PurgeOrphanedAttachmentsUseCase removes the attachments of deleted transactions, accounts
+// and users, and abandoned direct uploads, together with their files in object storage.
+type PurgeOrphanedAttachmentsUseCase struct {
+	attachmentRepo adapter.AttachmentRepository
+	storage        adapter.ObjectStorage
+}
+
+// NewPurgeOrphanedAttachmentsUseCase creates a new PurgeOrphanedAttachmentsUseCase instance.
+func NewPurgeOrphanedAttachmentsUseCase(
+	attachmentRepo adapter.AttachmentRepository,
+	storage adapter.ObjectStorage,
+) *PurgeOrphanedAttachmentsUseCase {
+	return &PurgeOrphanedAttachmentsUseCase{
+		attachmentRepo: attachmentRepo,
+		storage:        storage,
+	}
+}
+
+// Execute purges up to one batch of orphaned attachments. Failures on individual attachments
+// are logged and skipped; their records are kept so the next run retries them.
+func (uc *PurgeOrphanedAttachmentsUseCase) Execute(
+	ctx context.Context,
+	input PurgeOrphanedAttachmentsInput,
+) (*PurgeOrphanedAttachmentsOutput, error) {
+	attachments, err := uc.attachmentRepo.FindOrphaned(ctx, input.UserID, input.Now.Add(-PendingUploadTTL), purgeBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find orphaned attachments: %w", err)
+	}
+
+	output := &PurgeOrphanedAttachmentsOutput{}
+	for _, attachment := range attachments {
+		if err := deleteObjects(ctx, uc.storage, attachment); err != nil {
+			slog.Error("Failed to delete orphaned attachment files",
+				"error", err,
+				"attachmentID", attachment.ID,
+			)
+			output.FailedCount++
+			continue
+		}
+
+		if err := uc.attachmentRepo.Delete(ctx, attachment.ID); err != nil {
+			slog.Error("Failed to delete orphaned attachment",
+				"error", err,
+				"attachmentID", attachment.ID,
+			)
+			output.FailedCount++
+			continue
+		}
+
+		output.PurgedCount++
+	}
+
+	return output, nil
+}
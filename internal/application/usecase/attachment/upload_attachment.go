@@ -0,0 +1,280 @@
+// Package attachment contains attachment-related use cases.
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+const (
+	// MaxAttachmentSize is the maximum size of an attached file, in bytes.
+	MaxAttachmentSize = 10 << 20
+
+	// MaxFileNameLength is the maximum length of an attachment file name.
+	MaxFileNameLength = 255
+)
+
+// AllowedContentTypes lists the file types that can be attached: receipts and warranties
+// are photos or PDFs.
+var AllowedContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"image/heic":      true,
+	"application/pdf": true,
+}
+
+// UploadAttachmentInput represents the input for uploading an attachment through the API.
+type UploadAttachmentInput struct {
+	UserID      uuid.UUID
+	OwnerType   entity.AttachmentOwnerType
+	OwnerID     uuid.UUID
+	FileName    string
+	ContentType string // Detected from the content when empty or generic
+	Content     []byte
+}
+
+// UploadAttachmentOutput represents the output of uploading an attachment.
+type UploadAttachmentOutput struct {
+	Attachment *entity.Attachment
+}
+
+// UploadAttachmentUseCase handles uploading a file and attaching it to a transaction or account.
+type UploadAttachmentUseCase struct {
+	attachmentRepo  adapter.AttachmentRepository
+	transactionRepo adapter.TransactionRepository
+	accountRepo     adapter.AccountRepository
+	storage         adapter.ObjectStorage
+	thumbnails      adapter.ThumbnailGenerator
+}
+
+// NewUploadAttachmentUseCase creates a new UploadAttachmentUseCase instance.
+func NewUploadAttachmentUseCase(
+	attachmentRepo adapter.AttachmentRepository,
+	transactionRepo adapter.TransactionRepository,
+	accountRepo adapter.AccountRepository,
+	storage adapter.ObjectStorage,
+	thumbnails adapter.ThumbnailGenerator,
+) *UploadAttachmentUseCase {
+	return &UploadAttachmentUseCase{
+		attachmentRepo:  attachmentRepo,
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		storage:         storage,
+		thumbnails:      thumbnails,
+	}
+}
+
+// Execute stores the file in object storage and records its metadata.
+func (uc *UploadAttachmentUseCase) Execute(ctx context.Context, input UploadAttachmentInput) (*UploadAttachmentOutput, error) {
+	if err := validateOwner(ctx, uc.transactionRepo, uc.accountRepo, input.OwnerType, input.OwnerID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	if len(input.Content) == 0 {
+		return nil, domainerror.NewAttachmentError(
+			domainerror.ErrCodeAttachmentMissingFields,
+			"file is empty",
+			domainerror.ErrAttachmentMissingFields,
+		)
+	}
+	if err := validateSize(int64(len(input.Content))); err != nil {
+		return nil, err
+	}
+
+	contentType := input.ContentType
+	if normalizeContentType(contentType) == "" || normalizeContentType(contentType) == "application/octet-stream" {
+		contentType = http.DetectContentType(input.Content)
+	}
+	contentType, err := validateFile(input.FileName, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := entity.NewAttachment(input.UserID, input.OwnerType, input.OwnerID,
+		input.FileName, contentType, entity.AttachmentStatusUploaded)
+	attachment.SizeBytes = int64(len(input.Content))
+	attachment.Checksum = checksum(input.Content)
+
+	if err := uc.storage.Put(ctx, attachment.StorageKey, bytes.NewReader(input.Content), attachment.SizeBytes, contentType); err != nil {
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	storeThumbnail(ctx, uc.storage, uc.thumbnails, attachment, input.Content)
+
+	if err := uc.attachmentRepo.Create(ctx, attachment); err != nil {
+		// Do not leave unreferenced files behind
+		_ = deleteObjects(ctx, uc.storage, attachment)
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	return &UploadAttachmentOutput{
+		Attachment: attachment,
+	}, nil
+}
+
+// validateOwner verifies the transaction or account exists and belongs to the user.
+func validateOwner(
+	ctx context.Context,
+	transactionRepo adapter.TransactionRepository,
+	accountRepo adapter.AccountRepository,
+	ownerType entity.AttachmentOwnerType,
+	ownerID uuid.UUID,
+	userID uuid.UUID,
+) error {
+	var ownerUserID uuid.UUID
+	var err error
+
+	switch ownerType {
+	case entity.AttachmentOwnerTransaction:
+		var transaction *entity.Transaction
+		if transaction, err = transactionRepo.FindByID(ctx, ownerID); err == nil {
+			ownerUserID = transaction.UserID
+		} else if errors.Is(err, domainerror.ErrTransactionNotFound) {
+			err = domainerror.ErrAttachmentOwnerNotFound
+		}
+	case entity.AttachmentOwnerAccount:
+		var account *entity.Account
+		if account, err = accountRepo.FindByID(ctx, ownerID); err == nil {
+			ownerUserID = account.UserID
+		} else if errors.Is(err, domainerror.ErrAccountNotFound) {
+			err = domainerror.ErrAttachmentOwnerNotFound
+		}
+	default:
+		err = domainerror.ErrAttachmentOwnerNotFound
+	}
+
+	if errors.Is(err, domainerror.ErrAttachmentOwnerNotFound) {
+		return domainerror.NewAttachmentError(
+			domainerror.ErrCodeAttachmentOwnerNotFound,
+			fmt.Sprintf("%s not found", ownerType),
+			domainerror.ErrAttachmentOwnerNotFound,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find %s: %w", ownerType, err)
+	}
+
+	if ownerUserID != userID {
+		return domainerror.NewAttachmentError(
+			domainerror.ErrCodeNotAuthorizedAttachment,
+			fmt.Sprintf("%s does not belong to user", ownerType),
+			domainerror.ErrNotAuthorizedAttachment,
+		)
+	}
+
+	return nil
+}
+
+// validateFile checks the file name and type, returning the normalized content type.
+func validateFile(fileName string, contentType string) (string, error) {
+	if strings.TrimSpace(fileName) == "" {
+		return "", domainerror.NewAttachmentError(
+			domainerror.ErrCodeAttachmentMissingFields,
+			"file name is required",
+			domainerror.ErrAttachmentMissingFields,
+		)
+	}
+	if len(fileName) > MaxFileNameLength {
+		return "", domainerror.NewAttachmentError(
+			domainerror.ErrCodeAttachmentMissingFields,
+			fmt.Sprintf("file name must not exceed %d characters", MaxFileNameLength),
+			domainerror.ErrAttachmentMissingFields,
+		)
+	}
+
+	normalized := normalizeContentType(contentType)
+	if !AllowedContentTypes[normalized] {
+		return "", domainerror.NewAttachmentError(
+			domainerror.ErrCodeUnsupportedAttachmentType,
+			"only JPEG, PNG, GIF, WebP, HEIC images and PDF documents can be attached",
+			domainerror.ErrUnsupportedAttachmentType,
+		)
+	}
+
+	return normalized, nil
+}
+
+// validateSize checks the file does not exceed MaxAttachmentSize.
+func validateSize(size int64) error {
+	if size > MaxAttachmentSize {
+		return domainerror.NewAttachmentError(
+			domainerror.ErrCodeAttachmentTooLarge,
+			fmt.Sprintf("file must not exceed %d MB", MaxAttachmentSize>>20),
+			domainerror.ErrAttachmentTooLarge,
+		)
+	}
+	return nil
+}
+
+// normalizeContentType strips parameters (e.g., charset) and lower-cases the media type.
+func normalizeContentType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contentType))
+	}
+	return mediaType
+}
+
+// checksum returns the hex SHA-256 of the content.
+func checksum(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// storeThumbnail generates and stores a thumbnail for image attachments, recording the image
+// dimensions. Thumbnails are best effort: failures are logged and the attachment is kept.
+func storeThumbnail(
+	ctx context.Context,
+	storage adapter.ObjectStorage,
+	thumbnails adapter.ThumbnailGenerator,
+	attachment *entity.Attachment,
+	content []byte,
+) {
+	thumbnail, err := thumbnails.Generate(content, attachment.ContentType)
+	if err != nil {
+		slog.Warn("Failed to generate attachment thumbnail", "error", err, "attachmentID", attachment.ID)
+		return
+	}
+	if thumbnail == nil {
+		return
+	}
+
+	attachment.ImageWidth = &thumbnail.SourceWidth
+	attachment.ImageHeight = &thumbnail.SourceHeight
+
+	key := attachment.ThumbnailStorageKey()
+	if err := storage.Put(ctx, key, bytes.NewReader(thumbnail.Content), int64(len(thumbnail.Content)), thumbnail.ContentType); err != nil {
+		slog.Warn("Failed to store attachment thumbnail", "error", err, "attachmentID", attachment.ID)
+		return
+	}
+	attachment.ThumbnailKey = key
+}
+
+// deleteObjects removes the attachment's file and thumbnail from object storage.
+func deleteObjects(ctx context.Context, storage adapter.ObjectStorage, attachment *entity.Attachment) error {
+	if err := storage.Delete(ctx, attachment.StorageKey); err != nil {
+		return fmt.Errorf("failed to delete attachment file: %w", err)
+	}
+	if attachment.ThumbnailKey != "" {
+		if err := storage.Delete(ctx, attachment.ThumbnailKey); err != nil {
+			return fmt.Errorf("failed to delete attachment thumbnail: %w", err)
+		}
+	}
+	return nil
+}
@@ -25,9 +25,10 @@ type DeleteAccountOutput struct {
 
 // DeleteAccountUseCase handles account deletion logic.
 type DeleteAccountUseCase struct {
-	userRepo        adapter.UserRepository
-	passwordService adapter.PasswordService
-	tokenService    adapter.TokenService
+	userRepo          adapter.UserRepository
+	passwordService   adapter.PasswordService
+	tokenService      adapter.TokenService
+	attachmentCleanup adapter.AttachmentCleanupNotifier
 }
 
 // NewDeleteAccountUseCase creates a new DeleteAccountUseCase instance.
@@ -35,11 +36,13 @@ func NewDeleteAccountUseCase(
 	userRepo adapter.UserRepository,
 	passwordService adapter.PasswordService,
 	tokenService adapter.TokenService,
+	attachmentCleanup adapter.AttachmentCleanupNotifier,
 ) *DeleteAccountUseCase {
 	return &DeleteAccountUseCase{
-		userRepo:        userRepo,
-		passwordService: passwordService,
-		tokenService:    tokenService,
+		userRepo:          userRepo,
+		passwordService:   passwordService,
+		tokenService:      tokenService,
+		attachmentCleanup: attachmentCleanup,
 	}
 }
 
@@ -83,6 +86,11 @@ func (uc *DeleteAccountUseCase) Execute(ctx context.Context, input DeleteAccount
 		return nil, fmt.Errorf("failed to delete user: %w", err)
 	}
 
+	// Remove the user's attachments in the background
+	if uc.attachmentCleanup != nil {
+		uc.attachmentCleanup.NotifyOwnersDeleted(input.UserID)
+	}
+
 	return &DeleteAccountOutput{
 		Success: true,
 	}, nil
@@ -9,10 +9,15 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
 	"github.com/finance-tracker/backend/internal/domain/entity"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
 )
 
+// deleteCategoryBatchSize is the number of transactions loaded at a time when snapshotting a deleted category.
+const deleteCategoryBatchSize = 500
+
 // DeleteCategoryInput represents the input for category deletion.
 type DeleteCategoryInput struct {
 	CategoryID uuid.UUID
@@ -27,13 +32,21 @@ type DeleteCategoryOutput struct {
 
 // DeleteCategoryUseCase handles category deletion logic.
 type DeleteCategoryUseCase struct {
-	categoryRepo adapter.CategoryRepository
+	categoryRepo    adapter.CategoryRepository
+	transactionRepo adapter.TransactionRepository
+	changeRepo      adapter.TransactionChangeRepository
 }
 
 // NewDeleteCategoryUseCase creates a new DeleteCategoryUseCase instance.
-func NewDeleteCategoryUseCase(categoryRepo adapter.CategoryRepository) *DeleteCategoryUseCase {
+func NewDeleteCategoryUseCase(
+	categoryRepo adapter.CategoryRepository,
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+) *DeleteCategoryUseCase {
 	return &DeleteCategoryUseCase{
-		categoryRepo: categoryRepo,
+		categoryRepo:    categoryRepo,
+		transactionRepo: transactionRepo,
+		changeRepo:      changeRepo,
 	}
 }
 
@@ -61,11 +74,37 @@ func (uc *DeleteCategoryUseCase) Execute(ctx context.Context, input DeleteCatego
 		)
 	}
 
+	// Snapshot the transactions and split lines that reference this category, for the history
+	var before []*entity.Transaction
+	if input.OwnerType == entity.OwnerTypeUser {
+		filter := adapter.TransactionFilter{UserID: input.OwnerID, CategoryIDs: []uuid.UUID{input.CategoryID}}
+		err = uc.transactionRepo.StreamByFilter(ctx, filter, valueobject.DefaultTransactionSort(), deleteCategoryBatchSize,
+			func(batch []*entity.TransactionWithCategory) error {
+				for _, txnWithCat := range batch {
+					before = append(before, txnWithCat.Transaction)
+				}
+				return nil
+			})
+		if err != nil {
+			return nil, fmt.Errorf("failed to find category transactions: %w", err)
+		}
+	}
+
 	// Orphan transactions that reference this category (set category_id to NULL)
 	if err := uc.categoryRepo.OrphanTransactionsByCategory(ctx, input.CategoryID); err != nil {
 		return nil, fmt.Errorf("failed to orphan transactions: %w", err)
 	}
 
+	// Record the orphaned transactions as a single operation
+	operationID := uuid.New()
+	changes := make([]*entity.TransactionChange, 0, len(before))
+	for _, txn := range before {
+		changes = append(changes, entity.NewTransactionChange(
+			txn, orphanedTransaction(txn, input.CategoryID), &input.OwnerID, entity.TransactionChangeSourceManual, operationID,
+		))
+	}
+	transaction.RecordTransactionChanges(ctx, uc.changeRepo, changes...)
+
 	// Delete the category
 	if err := uc.categoryRepo.Delete(ctx, input.CategoryID); err != nil {
 		return nil, fmt.Errorf("failed to delete category: %w", err)
@@ -75,3 +114,20 @@ func (uc *DeleteCategoryUseCase) Execute(ctx context.Context, input DeleteCatego
 		Success: true,
 	}, nil
 }
+
+// orphanedTransaction returns a copy of the transaction with the category cleared from it and its split lines.
+func orphanedTransaction(txn *entity.Transaction, categoryID uuid.UUID) *entity.Transaction {
+	orphaned := *txn
+	if orphaned.CategoryID != nil && *orphaned.CategoryID == categoryID {
+		orphaned.CategoryID = nil
+	}
+	orphaned.Splits = make([]*entity.TransactionSplit, len(txn.Splits))
+	for i, split := range txn.Splits {
+		line := *split
+		if line.CategoryID != nil && *line.CategoryID == categoryID {
+			line.CategoryID = nil
+		}
+		orphaned.Splits[i] = &line
+	}
+	return &orphaned
+}
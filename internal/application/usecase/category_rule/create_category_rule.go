@@ -4,11 +4,13 @@ package categoryrule
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"regexp"
 
 	"github.com/google/uuid"
 
 	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
 	"github.com/finance-tracker/backend/internal/domain/entity"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
 )
@@ -31,6 +33,7 @@ type CreateCategoryRuleInput struct {
 type CreateCategoryRuleOutput struct {
 	Rule                *entity.CategoryRuleWithCategory
 	TransactionsUpdated int
+	OperationID         *uuid.UUID // Groups the recorded transaction changes for revert, nil when none were updated
 }
 
 // CreateCategoryRuleUseCase handles category rule creation logic.
@@ -38,6 +41,7 @@ type CreateCategoryRuleUseCase struct {
 	ruleRepo        adapter.CategoryRuleRepository
 	categoryRepo    adapter.CategoryRepository
 	transactionRepo adapter.TransactionRepository
+	changeRepo      adapter.TransactionChangeRepository
 }
 
 // NewCreateCategoryRuleUseCase creates a new CreateCategoryRuleUseCase instance.
@@ -45,11 +49,13 @@ func NewCreateCategoryRuleUseCase(
 	ruleRepo adapter.CategoryRuleRepository,
 	categoryRepo adapter.CategoryRepository,
 	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
 ) *CreateCategoryRuleUseCase {
 	return &CreateCategoryRuleUseCase{
 		ruleRepo:        ruleRepo,
 		categoryRepo:    categoryRepo,
 		transactionRepo: transactionRepo,
+		changeRepo:      changeRepo,
 	}
 }
 
@@ -152,8 +158,9 @@ func (uc *CreateCategoryRuleUseCase) Execute(ctx context.Context, input CreateCa
 
 	// Apply rule to existing uncategorized transactions
 	updatedCount := 0
+	var operationID *uuid.UUID
 	if rule.IsActive {
-		count, err := uc.transactionRepo.BulkUpdateCategoryByPattern(
+		updatedIDs, err := uc.transactionRepo.BulkUpdateCategoryByPattern(
 			ctx,
 			rule.Pattern,
 			rule.CategoryID,
@@ -164,7 +171,8 @@ func (uc *CreateCategoryRuleUseCase) Execute(ctx context.Context, input CreateCa
 			// Log warning but don't fail - rule was created successfully
 			// Just return 0 for updated count
 		} else {
-			updatedCount = count
+			updatedCount = len(updatedIDs)
+			operationID = uc.recordChanges(ctx, input, updatedIDs)
 		}
 	}
 
@@ -174,9 +182,56 @@ func (uc *CreateCategoryRuleUseCase) Execute(ctx context.Context, input CreateCa
 			Category: category,
 		},
 		TransactionsUpdated: updatedCount,
+		OperationID:         operationID,
 	}, nil
 }
 
+// recordChanges records the categorization of the updated transactions in their history as a single operation.
+// The transactions were uncategorized before the rule was applied. Returns the operation ID, or nil if none were updated.
+func (uc *CreateCategoryRuleUseCase) recordChanges(
+	ctx context.Context,
+	input CreateCategoryRuleInput,
+	updatedIDs []uuid.UUID,
+) *uuid.UUID {
+	if len(updatedIDs) == 0 {
+		return nil
+	}
+
+	// User rules only touch the owner's transactions; group rules touch those of every member
+	var after []*entity.Transaction
+	var actorID *uuid.UUID
+	if input.OwnerType == entity.OwnerTypeUser {
+		actorID = &input.OwnerID
+		found, err := uc.transactionRepo.FindByIDs(ctx, updatedIDs, input.OwnerID)
+		if err != nil {
+			slog.Warn("Failed to load categorized transactions for history", "error", err)
+			return nil
+		}
+		after = found
+	} else {
+		for _, id := range updatedIDs {
+			txn, err := uc.transactionRepo.FindByID(ctx, id)
+			if err != nil {
+				slog.Warn("Failed to load categorized transaction for history", "transaction_id", id, "error", err)
+				continue
+			}
+			after = append(after, txn)
+		}
+	}
+
+	operationID := uuid.New()
+	changes := make([]*entity.TransactionChange, 0, len(after))
+	for _, txn := range after {
+		before := *txn
+		before.CategoryID = nil
+		changes = append(changes,
+			entity.NewTransactionChange(&before, txn, actorID, entity.TransactionChangeSourceRule, operationID),
+		)
+	}
+	transaction.RecordTransactionChanges(ctx, uc.changeRepo, changes...)
+	return &operationID
+}
+
 // isValidOwnerType validates the owner type.
 func isValidOwnerType(ownerType entity.OwnerType) bool {
 	return ownerType == entity.OwnerTypeUser || ownerType == entity.OwnerTypeGroup
@@ -9,6 +9,8 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
+	"github.com/finance-tracker/backend/internal/domain/entity"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
 )
 
@@ -29,12 +31,17 @@ type CollapseExpansionOutput struct {
 // CollapseExpansionUseCase handles the CC expansion collapse logic.
 type CollapseExpansionUseCase struct {
 	transactionRepo adapter.TransactionRepository
+	changeRepo      adapter.TransactionChangeRepository
 }
 
 // NewCollapseExpansionUseCase creates a new CollapseExpansionUseCase instance.
-func NewCollapseExpansionUseCase(transactionRepo adapter.TransactionRepository) *CollapseExpansionUseCase {
+func NewCollapseExpansionUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+) *CollapseExpansionUseCase {
 	return &CollapseExpansionUseCase{
 		transactionRepo: transactionRepo,
+		changeRepo:      changeRepo,
 	}
 }
 
@@ -83,6 +90,17 @@ func (uc *CollapseExpansionUseCase) Execute(ctx context.Context, input CollapseE
 		return nil, err
 	}
 
+	// Record the deletion of the card transactions and the restored bill amount as a single operation
+	before := linkedTransactions
+	var after []*entity.Transaction
+	if restored, err := uc.transactionRepo.FindBillPaymentByID(ctx, input.BillPaymentID, input.UserID); err == nil {
+		before = append(before, billPayment)
+		after = append(after, restored)
+	}
+	transaction.RecordTransactionChanges(ctx, uc.changeRepo,
+		transaction.DiffTransactionChanges(before, after, &input.UserID, entity.TransactionChangeSourceManual, uuid.New())...,
+	)
+
 	return &CollapseExpansionOutput{
 		BillPaymentID:       input.BillPaymentID,
 		RestoredAmount:      restoredAmount,
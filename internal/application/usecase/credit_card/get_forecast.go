@@ -0,0 +1,297 @@
+// Package creditcard contains credit card import-related use cases.
+package creditcard
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/account"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
+	"github.com/finance-tracker/backend/internal/application/usecase/installment"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+const (
+	// DefaultForecastMonths is the number of bills forecast when none is given.
+	DefaultForecastMonths = 3
+	// MaxForecastMonths is the maximum number of bills forecast.
+	MaxForecastMonths = 12
+	// ForecastHistoryCycles is the number of past bills the variable spending and subscriptions
+	// are learned from.
+	ForecastHistoryCycles = 6
+)
+
+// GetForecastInput represents the input for forecasting the next credit card bills.
+type GetForecastInput struct {
+	UserID    uuid.UUID
+	AccountID *uuid.UUID // Optional - card whose closing day decides the open bill, else the current month's
+	Months    int        // Number of bills forecast, starting with the open one; defaults to DefaultForecastMonths
+}
+
+// BillForecast represents the projected amount of a credit card bill.
+// Committed is locked in; Expected adds the subscriptions and the average variable spending,
+// and Low and High bound it by one standard deviation of the past variable spending.
+type BillForecast struct {
+	BillingCycle  string
+	ClosingDate   *time.Time      // nil when the card's closing day is unknown
+	DueDate       *time.Time      // nil when the card's due day is unknown
+	Recorded      decimal.Decimal // Purchases already imported or entered, only in the open bill
+	Installments  decimal.Decimal // Outstanding installments charged in the bill
+	Subscriptions decimal.Decimal // Subscriptions expected and not charged yet
+	Variable      decimal.Decimal // Other spending expected and not recorded yet
+	Committed     decimal.Decimal
+	Expected      decimal.Decimal
+	Low           decimal.Decimal
+	High          decimal.Decimal
+}
+
+// GetForecastOutput represents the forecast of the next credit card bills.
+type GetForecastOutput struct {
+	Currency          string // Base currency the amounts are in
+	OpenBillingCycle  string
+	HistoryCycles     int             // Number of past bills the forecast is based on
+	AverageVariable   decimal.Decimal // Average spending per bill besides installments and subscriptions
+	VariableDeviation decimal.Decimal // Standard deviation of that spending
+	Subscriptions     []*entity.CardSubscription
+	Bills             []BillForecast
+}
+
+// GetForecastUseCase handles projecting the next credit card bills before their statements close.
+type GetForecastUseCase struct {
+	transactionRepo    adapter.TransactionRepository
+	accountRepo        adapter.AccountRepository
+	calendarLoader     *account.CalendarLoader
+	commitmentsUseCase *installment.GetCommitmentsUseCase
+	converter          *exchangerate.Converter
+}
+
+// NewGetForecastUseCase creates a new GetForecastUseCase instance.
+func NewGetForecastUseCase(
+	transactionRepo adapter.TransactionRepository,
+	accountRepo adapter.AccountRepository,
+	calendarLoader *account.CalendarLoader,
+	commitmentsUseCase *installment.GetCommitmentsUseCase,
+	converter *exchangerate.Converter,
+) *GetForecastUseCase {
+	return &GetForecastUseCase{
+		transactionRepo:    transactionRepo,
+		accountRepo:        accountRepo,
+		calendarLoader:     calendarLoader,
+		commitmentsUseCase: commitmentsUseCase,
+		converter:          converter,
+	}
+}
+
+// Execute forecasts the open bill and the following ones. Each bill combines the purchases
+// recorded so far (open bill only), the outstanding installments, the subscriptions detected on
+// past bills and the average of the remaining spending of past bills, whose variance gives the
+// confidence band.
+func (uc *GetForecastUseCase) Execute(ctx context.Context, input GetForecastInput) (*GetForecastOutput, error) {
+	// Apply defaults
+	if input.Months == 0 {
+		input.Months = DefaultForecastMonths
+	}
+
+	// Validate input
+	if input.Months < 1 || input.Months > MaxForecastMonths {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidForecastMonths,
+			fmt.Sprintf("months must be between 1 and %d", MaxForecastMonths),
+			domainerror.ErrInvalidForecastMonths,
+		)
+	}
+
+	// The card's calendar decides which bill is open; without one, bills follow calendar months
+	now := time.Now().UTC()
+	openCycle := now.Format("2006-01")
+	var calendar *entity.BillingCalendar
+	if input.AccountID != nil {
+		card, err := findCard(ctx, uc.accountRepo, *input.AccountID, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if uc.calendarLoader != nil {
+			if calendar, err = uc.calendarLoader.Load(ctx, card); err != nil {
+				return nil, err
+			}
+		}
+		if calendar != nil {
+			openCycle = calendar.BillingCycleOf(now)
+		}
+	}
+
+	// Learn subscriptions and variable spending from the past bills
+	var history [][]*entity.Transaction
+	var historyTransactions []*entity.Transaction
+	for i := ForecastHistoryCycles; i >= 1; i-- {
+		transactions, err := uc.billTransactions(ctx, input.UserID, input.AccountID, entity.ShiftBillingCycle(openCycle, -i))
+		if err != nil {
+			return nil, err
+		}
+		if len(transactions) == 0 {
+			continue
+		}
+		history = append(history, transactions)
+		historyTransactions = append(historyTransactions, transactions...)
+	}
+	subscriptions := entity.DetectCardSubscriptions(historyTransactions, entity.ShiftBillingCycle(openCycle, -1))
+
+	variables := make([]decimal.Decimal, len(history))
+	for i, transactions := range history {
+		variables[i] = variableSpending(transactions, subscriptions)
+	}
+	average, deviation := meanAndDeviation(variables)
+
+	recorded, err := uc.billTransactions(ctx, input.UserID, input.AccountID, openCycle)
+	if err != nil {
+		return nil, err
+	}
+
+	commitments, err := uc.commitmentsUseCase.Execute(ctx, installment.GetCommitmentsInput{
+		UserID:    input.UserID,
+		AccountID: input.AccountID,
+		FromCycle: openCycle,
+		Months:    input.Months,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &GetForecastOutput{
+		Currency:          commitments.Currency,
+		OpenBillingCycle:  openCycle,
+		HistoryCycles:     len(history),
+		AverageVariable:   average,
+		VariableDeviation: deviation,
+		Subscriptions:     subscriptions,
+		Bills:             make([]BillForecast, input.Months),
+	}
+	if output.Subscriptions == nil {
+		output.Subscriptions = []*entity.CardSubscription{}
+	}
+
+	for i := range output.Bills {
+		bill := BillForecast{
+			BillingCycle:  entity.ShiftBillingCycle(openCycle, i),
+			Recorded:      decimal.Zero,
+			Installments:  commitments.Months[i].Amount,
+			Subscriptions: decimal.Zero,
+			Variable:      average,
+		}
+		if calendar != nil {
+			period := calendar.Period(bill.BillingCycle)
+			bill.ClosingDate = &period.ClosingDate
+			bill.DueDate = period.DueDate
+		}
+
+		for _, subscription := range subscriptions {
+			if i > 0 || !chargedIn(subscription, recorded) {
+				bill.Subscriptions = bill.Subscriptions.Add(subscription.Amount)
+			}
+		}
+
+		// The open bill only expects the variable spending not recorded yet
+		if i == 0 {
+			bill.Recorded = billTotal(recorded)
+			bill.Variable = decimal.Max(average.Sub(variableSpending(recorded, subscriptions)), decimal.Zero)
+		}
+
+		bill.Committed = bill.Recorded.Add(bill.Installments)
+		bill.Expected = bill.Committed.Add(bill.Subscriptions).Add(bill.Variable)
+		bill.Low = decimal.Max(bill.Expected.Sub(deviation), bill.Committed)
+		bill.High = bill.Expected.Add(deviation)
+		output.Bills[i] = bill
+	}
+
+	return output, nil
+}
+
+// billTransactions returns the visible card transactions charged in the billing cycle, as reported
+// by the credit card status, only those of the card when an account is given.
+func (uc *GetForecastUseCase) billTransactions(
+	ctx context.Context,
+	userID uuid.UUID,
+	accountID *uuid.UUID,
+	billingCycle string,
+) ([]*entity.Transaction, error) {
+	status, err := uc.transactionRepo.GetCreditCardStatus(ctx, userID, billingCycle, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var transactions []*entity.Transaction
+	for _, txn := range status.LinkedTransactions {
+		if !txn.IsHidden {
+			transactions = append(transactions, txn)
+		}
+	}
+	return transactions, nil
+}
+
+// billTotal returns what the transactions add to their bill.
+func billTotal(transactions []*entity.Transaction) decimal.Decimal {
+	total := decimal.Zero
+	for _, txn := range transactions {
+		total = total.Add(txn.BillAmount())
+	}
+	return total
+}
+
+// variableSpending returns what the transactions add to their bill besides installments and
+// subscription charges.
+func variableSpending(transactions []*entity.Transaction, subscriptions []*entity.CardSubscription) decimal.Decimal {
+	total := decimal.Zero
+	for _, txn := range transactions {
+		if txn.InstallmentTotal != nil || txn.InstallmentPlanID != nil {
+			continue
+		}
+		isSubscription := false
+		for _, subscription := range subscriptions {
+			if subscription.Matches(txn) {
+				isSubscription = true
+				break
+			}
+		}
+		if !isSubscription {
+			total = total.Add(txn.BillAmount())
+		}
+	}
+	return total
+}
+
+// chargedIn reports whether the subscription was already charged in the transactions.
+func chargedIn(subscription *entity.CardSubscription, transactions []*entity.Transaction) bool {
+	for _, txn := range transactions {
+		if subscription.Matches(txn) {
+			return true
+		}
+	}
+	return false
+}
+
+// meanAndDeviation returns the mean and the population standard deviation of the values,
+// rounded to cents. Both are zero without values.
+func meanAndDeviation(values []decimal.Decimal) (decimal.Decimal, decimal.Decimal) {
+	if len(values) == 0 {
+		return decimal.Zero, decimal.Zero
+	}
+
+	count := decimal.NewFromInt(int64(len(values)))
+	mean := decimal.Sum(decimal.Zero, values...).Div(count)
+
+	variance := decimal.Zero
+	for _, value := range values {
+		difference := value.Sub(mean)
+		variance = variance.Add(difference.Mul(difference))
+	}
+	deviation := math.Sqrt(variance.Div(count).InexactFloat64())
+
+	return mean.Round(2), decimal.NewFromFloat(deviation).Round(2)
+}
@@ -81,7 +81,7 @@ func (uc *GetStatusUseCase) Execute(ctx context.Context, input GetStatusInput) (
 	}
 
 	// Get CC status from repository
-	status, err := uc.transactionRepo.GetCreditCardStatus(ctx, input.UserID, billingCycle)
+	status, err := uc.transactionRepo.GetCreditCardStatus(ctx, input.UserID, billingCycle, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,8 @@ package creditcard
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"regexp"
 	"time"
@@ -11,6 +13,11 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/account"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
+	"github.com/finance-tracker/backend/internal/application/usecase/installment"
+	"github.com/finance-tracker/backend/internal/application/usecase/merchant"
+	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
 	"github.com/finance-tracker/backend/internal/domain/entity"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
 )
@@ -18,19 +25,23 @@ import (
 // ImportTransactionsInput represents the input for importing CC transactions.
 type ImportTransactionsInput struct {
 	UserID            uuid.UUID
-	BillingCycle      string
+	BillingCycle      string     // Optional when the card has a closing day - then derived from each purchase date
+	AccountID         *uuid.UUID // Optional - credit card account the bill belongs to
 	BillPaymentID     *uuid.UUID // Optional - nil for standalone imports without linked bill
 	Transactions      []CCTransactionInput
 	ApplyAutoCategory bool
+	SkipDuplicates    bool // Skip transactions that match an existing transaction
+	Reimport          bool // Apply only the difference with the transactions already imported into the cycle
 }
 
 // ImportedTransactionSummary represents a summary of an imported transaction.
 type ImportedTransactionSummary struct {
-	ID          uuid.UUID
-	Date        time.Time
-	Description string
-	Amount      decimal.Decimal
-	CategoryID  *uuid.UUID
+	ID                uuid.UUID
+	Date              time.Time
+	Description       string
+	Amount            decimal.Decimal
+	CategoryID        *uuid.UUID
+	InstallmentPlanID *uuid.UUID // Plan the installment was grouped into, nil for single payments
 }
 
 // ImportTransactionsOutput represents the output of CC import operation.
@@ -40,34 +51,91 @@ type ImportTransactionsOutput struct {
 	BillPaymentID      *uuid.UUID // nil for standalone imports
 	BillingCycle       string
 	OriginalBillAmount decimal.Decimal
-	ImportedAt         time.Time
-	Transactions       []ImportedTransactionSummary
+	ImportedAt            time.Time
+	Transactions          []ImportedTransactionSummary
+	SkippedDuplicateCount int
+	InstallmentIssues     []installment.Issue // Missing or extra installments of the imported bill
+	UpdatedCount          int                 // Re-imports only: transactions updated from a corrected line
+	RemovedCount          int                 // Re-imports only: transactions missing from the statement
+	UnchangedCount        int                 // Re-imports only: transactions kept as they are
 }
 
 // ImportTransactionsUseCase handles the CC import logic.
 type ImportTransactionsUseCase struct {
-	transactionRepo  adapter.TransactionRepository
-	categoryRepo     adapter.CategoryRepository
-	categoryRuleRepo adapter.CategoryRuleRepository
+	transactionRepo    adapter.TransactionRepository
+	changeRepo         adapter.TransactionChangeRepository
+	txManager          adapter.TxManager
+	categoryRepo       adapter.CategoryRepository
+	categoryRuleRepo   adapter.CategoryRuleRepository
+	accountRepo        adapter.AccountRepository
+	goalAlertNotifier  adapter.GoalAlertNotifier
+	converter          *exchangerate.Converter
+	merchantResolver   *merchant.Resolver
+	installmentTracker *installment.Tracker
+	calendarLoader     *account.CalendarLoader
 }
 
 // NewImportTransactionsUseCase creates a new ImportTransactionsUseCase instance.
 func NewImportTransactionsUseCase(
 	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	txManager adapter.TxManager,
 	categoryRepo adapter.CategoryRepository,
 	categoryRuleRepo adapter.CategoryRuleRepository,
+	accountRepo adapter.AccountRepository,
+	goalAlertNotifier adapter.GoalAlertNotifier,
+	converter *exchangerate.Converter,
+	merchantResolver *merchant.Resolver,
+	installmentTracker *installment.Tracker,
+	calendarLoader *account.CalendarLoader,
 ) *ImportTransactionsUseCase {
 	return &ImportTransactionsUseCase{
-		transactionRepo:  transactionRepo,
-		categoryRepo:     categoryRepo,
-		categoryRuleRepo: categoryRuleRepo,
+		transactionRepo:    transactionRepo,
+		changeRepo:         changeRepo,
+		txManager:          txManager,
+		categoryRepo:       categoryRepo,
+		categoryRuleRepo:   categoryRuleRepo,
+		accountRepo:        accountRepo,
+		goalAlertNotifier:  goalAlertNotifier,
+		converter:          converter,
+		merchantResolver:   merchantResolver,
+		installmentTracker: installmentTracker,
+		calendarLoader:     calendarLoader,
 	}
 }
 
 // Execute performs the CC import operation.
 func (uc *ImportTransactionsUseCase) Execute(ctx context.Context, input ImportTransactionsInput) (*ImportTransactionsOutput, error) {
+	// Load the card's billing calendar, which derives billing cycles from purchase dates
+	var calendar *entity.BillingCalendar
+	if input.AccountID != nil {
+		card, err := findCard(ctx, uc.accountRepo, *input.AccountID, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if uc.calendarLoader != nil {
+			if calendar, err = uc.calendarLoader.Load(ctx, card); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// Validate billing cycle format
-	if !billingCycleRegex.MatchString(input.BillingCycle) {
+	if input.BillingCycle == "" && calendar == nil {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidBillingCycle,
+			"billing cycle is required unless the card has a closing day",
+			domainerror.ErrInvalidBillingCycle,
+		)
+	}
+	if input.Reimport && input.BillingCycle == "" {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidBillingCycle,
+			"billing cycle is required to re-import a bill",
+			domainerror.ErrInvalidBillingCycle,
+		)
+	}
+	if input.BillingCycle != "" && !billingCycleRegex.MatchString(input.BillingCycle) {
 		return nil, domainerror.NewTransactionError(
 			domainerror.ErrCodeInvalidBillingCycle,
 			"billing cycle must be in YYYY-MM format",
@@ -85,11 +153,14 @@ func (uc *ImportTransactionsUseCase) Execute(ctx context.Context, input ImportTr
 	}
 
 	var originalBillAmount decimal.Decimal
+	var billPayment *entity.Transaction
+	paymentReceivedRegex := regexp.MustCompile(PaymentReceivedPattern)
 
-	// If bill payment ID is provided, verify and validate it
-	if input.BillPaymentID != nil {
+	// If bill payment ID is provided, verify and validate it; a re-import keeps the bill of the cycle
+	if input.BillPaymentID != nil && !input.Reimport {
 		// Verify bill payment exists and belongs to user
-		billPayment, err := uc.transactionRepo.FindBillPaymentByID(ctx, *input.BillPaymentID, input.UserID)
+		var err error
+		billPayment, err = uc.transactionRepo.FindBillPaymentByID(ctx, *input.BillPaymentID, input.UserID)
 		if err != nil {
 			if err == domainerror.ErrBillPaymentNotFound {
 				return nil, domainerror.NewTransactionError(
@@ -132,20 +203,81 @@ func (uc *ImportTransactionsUseCase) Execute(ctx context.Context, input ImportTr
 		}
 	}
 
+	// A re-import only creates the lines missing from the imported cycle, updates the corrected
+	// ones and removes the ones no longer in the statement. "Pagamento recebido" entries are not
+	// purchases, so only the purchases are compared.
+	lines := input.Transactions
+	billPaymentID := input.BillPaymentID
+	var reimportDiff *ReimportDiff
+	var statementLines []CCTransactionInput
+	if input.Reimport {
+		statementTotal := decimal.Zero
+		for _, line := range input.Transactions {
+			if !paymentReceivedRegex.MatchString(line.Description) {
+				statementLines = append(statementLines, line)
+				statementTotal = statementTotal.Add(line.Amount)
+			}
+		}
+
+		var err error
+		reimportDiff, err = diffImportedCycle(ctx, uc.transactionRepo, input.UserID, input.BillingCycle, input.AccountID, statementLines)
+		if err != nil {
+			return nil, err
+		}
+		lines = make([]CCTransactionInput, len(reimportDiff.Added))
+		for i, index := range reimportDiff.Added {
+			lines[i] = statementLines[index]
+		}
+
+		// Added lines join the bill the cycle was imported with
+		status, err := uc.transactionRepo.GetCreditCardStatus(ctx, input.UserID, input.BillingCycle, input.AccountID)
+		if err != nil {
+			return nil, err
+		}
+		billPaymentID = status.BillPaymentID
+		originalBillAmount = statementTotal
+	}
+
+	// Find transactions already entered if they should be skipped
+	duplicateLines := make(map[int]bool)
+	if input.SkipDuplicates {
+		duplicates, err := findPossibleDuplicates(ctx, uc.transactionRepo, input.UserID, lines)
+		if err != nil {
+			return nil, err
+		}
+		for _, duplicate := range duplicates {
+			duplicateLines[duplicate.LineIndex] = true
+		}
+	}
+
 	// Create CC transaction entities
 	now := time.Now().UTC()
 	var transactions []*entity.Transaction
 	var transactionSummaries []ImportedTransactionSummary
 	categorizedCount := 0
-	paymentReceivedRegex := regexp.MustCompile(PaymentReceivedPattern)
+	skippedDuplicateCount := 0
 
 	// Calculate total amount for standalone imports
 	totalAmount := decimal.Zero
 
-	for _, txnInput := range input.Transactions {
+	for i, txnInput := range lines {
 		// Determine if this is a "Pagamento recebido" entry
 		isPaymentReceived := paymentReceivedRegex.MatchString(txnInput.Description)
 
+		if duplicateLines[i] && !isPaymentReceived {
+			skippedDuplicateCount++
+			continue
+		}
+
+		currency := exchangerate.NormalizeCurrency(txnInput.Currency)
+		if currency != "" && !entity.IsValidCurrencyCode(currency) {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeInvalidTxnCurrency,
+				"currency must be a 3-letter ISO 4217 code",
+				domainerror.ErrInvalidTransactionCurrency,
+			)
+		}
+
 		// Create transaction entity
 		txn := &entity.Transaction{
 			ID:                  uuid.New(),
@@ -154,15 +286,28 @@ func (uc *ImportTransactionsUseCase) Execute(ctx context.Context, input ImportTr
 			Description:         txnInput.Description,
 			Amount:              txnInput.Amount,
 			Type:                entity.TransactionTypeExpense, // CC transactions are expenses
-			CreditCardPaymentID: input.BillPaymentID,          // nil for standalone imports
+			CreditCardPaymentID: billPaymentID,                // nil for standalone imports
 			BillingCycle:        input.BillingCycle,
+			AccountID:           input.AccountID,
 			InstallmentCurrent:  txnInput.InstallmentCurrent,
 			InstallmentTotal:    txnInput.InstallmentTotal,
 			IsHidden:            isPaymentReceived, // Hide "Pagamento recebido" entries
+			Currency:            currency,
+			ExchangeRate:        decimal.NewFromInt(1),
 			CreatedAt:           now,
 			UpdatedAt:           now,
 		}
 
+		// Without a billing cycle, the installment is charged in the bill its purchase date falls in
+		// plus one bill per earlier installment
+		if txn.BillingCycle == "" {
+			installmentNumber := 1
+			if txnInput.InstallmentCurrent != nil {
+				installmentNumber = *txnInput.InstallmentCurrent
+			}
+			txn.BillingCycle = calendar.InstallmentBillingCycle(txn.Date, installmentNumber)
+		}
+
 		// Track total amount for standalone imports - preserve sign for algebraic sum
 		// Refunds (negative amounts like "Estorno de compra") should subtract from total
 		if !isPaymentReceived {
@@ -188,39 +333,238 @@ func (uc *ImportTransactionsUseCase) Execute(ctx context.Context, input ImportTr
 		})
 	}
 
+	// Update the corrected lines, keeping their category, notes and tags; the lines of a split
+	// transaction follow its new amount
+	var changed, changedBefore []*entity.Transaction
+	if reimportDiff != nil {
+		for _, change := range reimportDiff.Changed {
+			line := statementLines[change.LineIndex]
+			txn := change.Existing
+			before := *txn
+			txn.Date = line.Date
+			txn.Description = line.Description
+			txn.ChangeAmount(line.Amount)
+			txn.InstallmentCurrent = line.InstallmentCurrent
+			txn.InstallmentTotal = line.InstallmentTotal
+			txn.UpdatedAt = now
+			changed = append(changed, txn)
+			changedBefore = append(changedBefore, &before)
+		}
+	}
+
+	// Snapshot the rates into the user's base currency
+	if uc.converter != nil {
+		err := uc.converter.Snapshot(ctx, input.UserID, append(changed, transactions...)...)
+		var rateErr *domainerror.ExchangeRateError
+		if errors.As(err, &rateErr) {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeTxnRateUnavailable,
+				rateErr.Message,
+				domainerror.ErrExchangeRateUnavailable,
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// For standalone imports (no bill payment), use total amount as reference
-	if input.BillPaymentID == nil {
+	if input.BillPaymentID == nil && !input.Reimport {
 		originalBillAmount = totalAmount
 	}
 
-	// Create all CC transactions and optionally update bill payment
-	if input.BillPaymentID != nil {
-		// Import with linked bill - use the bulk create with bill update
-		if err := uc.transactionRepo.BulkCreateCCTransactions(
-			ctx,
-			transactions,
-			*input.BillPaymentID,
-			originalBillAmount,
-			input.BillingCycle,
-		); err != nil {
-			return nil, err
+	// A derived bill is named after the billing cycle most of its transactions are charged in
+	billingCycle := input.BillingCycle
+	if billingCycle == "" {
+		billingCycle = mostCommonBillingCycle(transactions)
+	}
+
+	// Recognize merchants, group installments into their plans, create all CC transactions and
+	// optionally update the bill payment atomically, so a failed import leaves no merchants or
+	// plans behind. With auto-categorization, transactions no rule matched get the merchant's
+	// default category.
+	var installmentIssues []installment.Issue
+	hasChanges := len(transactions) > 0
+	operationID := uuid.New()
+	err := uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if uc.merchantResolver != nil {
+			categorized, err := uc.merchantResolver.Assign(ctx, input.UserID, transactions, input.ApplyAutoCategory)
+			if err != nil {
+				return err
+			}
+			categorizedCount += categorized
+		}
+
+		// Check the bill against the plans
+		if uc.installmentTracker != nil {
+			issues, err := uc.installmentTracker.Track(ctx, input.UserID, input.AccountID, transactions)
+			if err != nil {
+				return err
+			}
+			installmentIssues = issues
+		}
+
+		// A re-import records its history with the transactions it applies
+		if reimportDiff != nil {
+			reimport := &adapter.CCReimport{
+				Added:              transactions,
+				Changed:            changed,
+				RemovedIDs:         make([]uuid.UUID, len(reimportDiff.Removed)),
+				BillPaymentID:      billPaymentID,
+				OriginalBillAmount: originalBillAmount,
+			}
+			for i, txn := range reimportDiff.Removed {
+				reimport.RemovedIDs[i] = txn.ID
+			}
+			reimport.Changes = reimportChanges(input.UserID, operationID, transactions, changedBefore, changed, reimportDiff.Removed)
+			if err := uc.transactionRepo.ApplyCCReimport(ctx, reimport); err != nil {
+				return err
+			}
+			hasChanges = hasChanges || len(changed) > 0 || len(reimport.RemovedIDs) > 0
+			return nil
+		}
+		if input.BillPaymentID != nil {
+			// Import with linked bill - use the bulk create with bill update
+			return uc.transactionRepo.BulkCreateCCTransactions(
+				ctx,
+				transactions,
+				*input.BillPaymentID,
+				originalBillAmount,
+				billingCycle,
+			)
 		}
-	} else {
 		// Standalone import - just create the transactions without updating any bill
-		if err := uc.transactionRepo.BulkCreateStandaloneCCTransactions(ctx, transactions); err != nil {
-			return nil, err
+		return uc.transactionRepo.BulkCreateStandaloneCCTransactions(ctx, transactions)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, txn := range transactions {
+		transactionSummaries[i].CategoryID = txn.CategoryID
+		transactionSummaries[i].InstallmentPlanID = txn.InstallmentPlanID
+	}
+
+	// Record the imported transactions and the expanded bill payment in the history as a single operation
+	if reimportDiff == nil {
+		changes := make([]*entity.TransactionChange, 0, len(transactions)+1)
+		for _, txn := range transactions {
+			changes = append(changes,
+				entity.NewTransactionChange(nil, txn, &input.UserID, entity.TransactionChangeSourceImport, operationID),
+			)
+		}
+		if billPayment != nil {
+			expanded := *billPayment
+			expanded.Amount = decimal.Zero
+			expanded.BillingCycle = billingCycle
+			changes = append(changes,
+				entity.NewTransactionChange(billPayment, &expanded, &input.UserID, entity.TransactionChangeSourceImport, operationID),
+			)
+		}
+		transaction.RecordTransactionChanges(ctx, uc.changeRepo, changes...)
+	}
+
+	// Re-evaluate spending goals in the background
+	if uc.goalAlertNotifier != nil && hasChanges {
+		uc.goalAlertNotifier.NotifyTransactionsChanged(input.UserID)
+	}
+
+	output := &ImportTransactionsOutput{
+		ImportedCount:         len(transactions),
+		CategorizedCount:      categorizedCount,
+		BillPaymentID:         billPaymentID,
+		BillingCycle:          billingCycle,
+		OriginalBillAmount:    originalBillAmount,
+		ImportedAt:            now,
+		Transactions:          transactionSummaries,
+		SkippedDuplicateCount: skippedDuplicateCount,
+		InstallmentIssues:     installmentIssues,
+	}
+	if reimportDiff != nil {
+		output.UpdatedCount = len(changed)
+		output.RemovedCount = len(reimportDiff.Removed)
+		output.UnchangedCount = reimportDiff.UnchangedCount
+	}
+
+	return output, nil
+}
+
+// findCard loads a credit card account and verifies it belongs to the user.
+func findCard(
+	ctx context.Context,
+	accountRepo adapter.AccountRepository,
+	accountID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Account, error) {
+	card, err := accountRepo.FindByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrAccountNotFound) {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeTxnAccountNotFound,
+				"account not found",
+				domainerror.ErrAccountNotFoundForTransaction,
+			)
+		}
+		return nil, fmt.Errorf("failed to find account: %w", err)
+	}
+
+	if card.UserID != userID {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeTxnAccountNotOwned,
+			"account does not belong to user",
+			domainerror.ErrAccountNotOwnedByUser,
+		)
+	}
+
+	if !card.IsCreditCard() {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeNotCreditCardAccount,
+			"account must be a credit card",
+			domainerror.ErrNotCreditCardAccount,
+		)
+	}
+
+	return card, nil
+}
+
+// reimportChanges returns the history of a re-import as a single operation: the added
+// transactions, the changed ones from their state before the correction, and the removed ones.
+func reimportChanges(
+	userID uuid.UUID,
+	operationID uuid.UUID,
+	added []*entity.Transaction,
+	changedBefore []*entity.Transaction,
+	changed []*entity.Transaction,
+	removed []*entity.Transaction,
+) []*entity.TransactionChange {
+	changes := make([]*entity.TransactionChange, 0, len(added)+len(changed)+len(removed))
+	for _, txn := range added {
+		changes = append(changes, entity.NewTransactionChange(nil, txn, &userID, entity.TransactionChangeSourceImport, operationID))
+	}
+	for i, txn := range changed {
+		// Corrections of untracked fields, such as the installment number, are not recorded
+		if change := entity.NewTransactionChange(changedBefore[i], txn, &userID, entity.TransactionChangeSourceImport, operationID); change != nil {
+			changes = append(changes, change)
 		}
 	}
+	for _, txn := range removed {
+		changes = append(changes, entity.NewTransactionChange(txn, nil, &userID, entity.TransactionChangeSourceImport, operationID))
+	}
+	return changes
+}
 
-	return &ImportTransactionsOutput{
-		ImportedCount:      len(transactions),
-		CategorizedCount:   categorizedCount,
-		BillPaymentID:      input.BillPaymentID,
-		BillingCycle:       input.BillingCycle,
-		OriginalBillAmount: originalBillAmount,
-		ImportedAt:         now,
-		Transactions:       transactionSummaries,
-	}, nil
+// mostCommonBillingCycle returns the billing cycle most transactions are charged in, the latest on a tie.
+func mostCommonBillingCycle(transactions []*entity.Transaction) string {
+	counts := make(map[string]int)
+	billingCycle := ""
+	for _, txn := range transactions {
+		counts[txn.BillingCycle]++
+		count := counts[txn.BillingCycle]
+		best := counts[billingCycle]
+		if count > best || (count == best && txn.BillingCycle > billingCycle) {
+			billingCycle = txn.BillingCycle
+		}
+	}
+	return billingCycle
 }
 
 // autoCategorize attempts to match the description against category rules.
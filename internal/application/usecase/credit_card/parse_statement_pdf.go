@@ -0,0 +1,147 @@
+// Package creditcard contains credit card import-related use cases.
+package creditcard
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/account"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// ParseStatementPDFInput represents the input for reading a credit card statement PDF.
+type ParseStatementPDFInput struct {
+	UserID    uuid.UUID
+	AccountID *uuid.UUID // Optional - card whose calendar names the billing cycle of the due date
+	Data      []byte
+}
+
+// StatementPDFLine represents a transaction line read from a statement PDF.
+type StatementPDFLine struct {
+	CCTransactionInput
+	Row              int              // 1-based line number in the extracted statement text
+	OriginalAmount   *decimal.Decimal // Amount in the original currency of international purchases
+	OriginalCurrency string
+	IsIOF            bool // True for IOF (tax on international purchases) lines
+}
+
+// ParseStatementPDFOutput represents a credit card statement read from a PDF, ready to be
+// previewed and imported.
+type ParseStatementPDFOutput struct {
+	Issuer         string
+	BillingCycle   string // Billing cycle of the statement's due date
+	DueDate        time.Time
+	StatementTotal *decimal.Decimal // Bill total printed on the statement, if found
+	LinesTotal     decimal.Decimal  // Sum of the lines, "Pagamento recebido" entries excluded
+	Lines          []StatementPDFLine
+	Errors         []adapter.StatementLineError
+}
+
+// ParseStatementPDFUseCase handles reading credit card statements downloaded as PDF.
+// Nothing is saved; the lines are meant for the import preview.
+type ParseStatementPDFUseCase struct {
+	accountRepo     adapter.AccountRepository
+	calendarLoader  *account.CalendarLoader
+	statementParser adapter.CardStatementParser
+}
+
+// NewParseStatementPDFUseCase creates a new ParseStatementPDFUseCase instance.
+func NewParseStatementPDFUseCase(
+	accountRepo adapter.AccountRepository,
+	calendarLoader *account.CalendarLoader,
+	statementParser adapter.CardStatementParser,
+) *ParseStatementPDFUseCase {
+	return &ParseStatementPDFUseCase{
+		accountRepo:     accountRepo,
+		calendarLoader:  calendarLoader,
+		statementParser: statementParser,
+	}
+}
+
+// Execute reads the statement with the layout of its issuer.
+func (uc *ParseStatementPDFUseCase) Execute(ctx context.Context, input ParseStatementPDFInput) (*ParseStatementPDFOutput, error) {
+	// Resolve the card before parsing, so that an invalid account is reported first
+	var calendar *entity.BillingCalendar
+	if input.AccountID != nil {
+		card, err := findCard(ctx, uc.accountRepo, *input.AccountID, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if uc.calendarLoader != nil {
+			if calendar, err = uc.calendarLoader.Load(ctx, card); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	parsed, err := uc.statementParser.ParsePDF(input.Data)
+	if err != nil {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidStatementFile,
+			err.Error(),
+			domainerror.ErrInvalidStatementFile,
+		)
+	}
+
+	if len(parsed.Lines) == 0 && len(parsed.Errors) == 0 {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeEmptyStatement,
+			"statement contains no transactions",
+			domainerror.ErrEmptyStatement,
+		)
+	}
+
+	output := &ParseStatementPDFOutput{
+		Issuer:         parsed.Issuer,
+		BillingCycle:   statementBillingCycle(parsed.DueDate, calendar),
+		DueDate:        parsed.DueDate,
+		StatementTotal: parsed.Total,
+		LinesTotal:     decimal.Zero,
+		Lines:          make([]StatementPDFLine, len(parsed.Lines)),
+		Errors:         parsed.Errors,
+	}
+
+	paymentReceivedRegex := regexp.MustCompile(PaymentReceivedPattern)
+	for i, line := range parsed.Lines {
+		output.Lines[i] = StatementPDFLine{
+			CCTransactionInput: CCTransactionInput{
+				Date:               line.Date,
+				Description:        line.Description,
+				Amount:             line.Amount,
+				InstallmentCurrent: line.InstallmentCurrent,
+				InstallmentTotal:   line.InstallmentTotal,
+			},
+			Row:              line.Row,
+			OriginalAmount:   line.OriginalAmount,
+			OriginalCurrency: line.OriginalCurrency,
+			IsIOF:            line.IsIOF,
+		}
+		if !paymentReceivedRegex.MatchString(line.Description) {
+			output.LinesTotal = output.LinesTotal.Add(line.Amount)
+		}
+	}
+
+	return output, nil
+}
+
+// statementBillingCycle returns the billing cycle whose bill is due on the date. Without the
+// card's calendar, the cycle is the month of the due date.
+func statementBillingCycle(dueDate time.Time, calendar *entity.BillingCalendar) string {
+	dueCycle := dueDate.Format("2006-01")
+	if calendar == nil {
+		return dueCycle
+	}
+	// The bill of a cycle is due in the cycle's month or in the next one
+	for _, cycle := range []string{dueCycle, entity.ShiftBillingCycle(dueCycle, -1)} {
+		if cycleDue := calendar.DueDate(cycle); cycleDue != nil && cycleDue.Format("2006-01-02") == dueDate.Format("2006-01-02") {
+			return cycle
+		}
+	}
+	return dueCycle
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/finance-tracker/backend/internal/application/adapter"
 	"github.com/finance-tracker/backend/internal/domain/entity"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
 )
 
 const (
@@ -40,6 +41,7 @@ type CCTransactionInput struct {
 	Amount             decimal.Decimal
 	InstallmentCurrent *int
 	InstallmentTotal   *int
+	Currency           string // Optional ISO 4217 code, defaults to the user's base currency
 }
 
 // BillMatch represents a potential match between CC payment and bank bill payment.
@@ -53,11 +55,24 @@ type BillMatch struct {
 	MatchScore        float64
 }
 
+// PossibleDuplicate represents an existing transaction that looks like the same entry
+// as one of the transactions to import.
+type PossibleDuplicate struct {
+	LineIndex     int // Index into TransactionsToImport
+	TransactionID uuid.UUID
+	Date          time.Time
+	Description   string
+	Amount        decimal.Decimal
+	Score         float64
+}
+
 // PreviewImportInput represents the input for previewing CC import.
 type PreviewImportInput struct {
 	UserID       uuid.UUID
 	BillingCycle string
+	AccountID    *uuid.UUID // Optional - card whose imported lines a re-import is compared with
 	Transactions []CCTransactionInput
+	Reimport     bool // Compare the statement with the transactions already imported into the cycle
 }
 
 // PreviewImportOutput represents the output of CC import preview.
@@ -69,6 +84,8 @@ type PreviewImportOutput struct {
 	TransactionsToImport  []CCTransactionInput
 	PaymentReceivedAmount decimal.Decimal
 	HasExistingImport     bool
+	PossibleDuplicates    []PossibleDuplicate
+	Reimport              *ReimportDiff // Only for re-imports; line indexes refer to TransactionsToImport
 }
 
 // PreviewImportUseCase handles the CC import preview logic.
@@ -104,7 +121,7 @@ func (uc *PreviewImportUseCase) Execute(ctx context.Context, input PreviewImport
 	}
 
 	// Check if billing cycle already has imported transactions
-	status, err := uc.transactionRepo.GetCreditCardStatus(ctx, input.UserID, input.BillingCycle)
+	status, err := uc.transactionRepo.GetCreditCardStatus(ctx, input.UserID, input.BillingCycle, input.AccountID)
 	if err != nil {
 		// Wrap database errors in TransactionError for proper error handling
 		var txnErr *domainerror.TransactionError
@@ -162,6 +179,41 @@ func (uc *PreviewImportUseCase) Execute(ctx context.Context, input PreviewImport
 	// Match bills with CC payment amount
 	matches := uc.matchBillPayments(potentialBills, ccPaymentDate, ccPaymentAmount)
 
+	// A re-import only adds the lines missing from the imported cycle
+	var reimportDiff *ReimportDiff
+	newLines := make([]int, len(transactionsToImport))
+	for i := range newLines {
+		newLines[i] = i
+	}
+	if input.Reimport {
+		reimportDiff, err = diffImportedCycle(ctx, uc.transactionRepo, input.UserID, input.BillingCycle, input.AccountID, transactionsToImport)
+		if err != nil {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeInternalError,
+				"failed to compare with the imported transactions",
+				err,
+			)
+		}
+		newLines = reimportDiff.Added
+	}
+
+	// Flag transactions that were already entered (manually or by another import)
+	linesToCheck := make([]CCTransactionInput, len(newLines))
+	for i, index := range newLines {
+		linesToCheck[i] = transactionsToImport[index]
+	}
+	duplicates, err := findPossibleDuplicates(ctx, uc.transactionRepo, input.UserID, linesToCheck)
+	if err != nil {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInternalError,
+			"failed to find possible duplicates",
+			err,
+		)
+	}
+	for i := range duplicates {
+		duplicates[i].LineIndex = newLines[duplicates[i].LineIndex]
+	}
+
 	return &PreviewImportOutput{
 		BillingCycle:          input.BillingCycle,
 		TotalTransactions:     len(transactionsToImport),
@@ -170,9 +222,73 @@ func (uc *PreviewImportUseCase) Execute(ctx context.Context, input PreviewImport
 		TransactionsToImport:  transactionsToImport,
 		PaymentReceivedAmount: paymentReceivedAmount,
 		HasExistingImport:     status.IsExpanded,
+		PossibleDuplicates:    duplicates,
+		Reimport:              reimportDiff,
 	}, nil
 }
 
+// findPossibleDuplicates compares the transactions against the user's existing transactions
+// around the same dates and returns every likely duplicate, best match first per line.
+func findPossibleDuplicates(
+	ctx context.Context,
+	transactionRepo adapter.TransactionRepository,
+	userID uuid.UUID,
+	transactions []CCTransactionInput,
+) ([]PossibleDuplicate, error) {
+	duplicates := []PossibleDuplicate{}
+	if len(transactions) == 0 {
+		return duplicates, nil
+	}
+
+	config := valueobject.DefaultDuplicateDetectionConfig()
+
+	// Fetch candidates once for the whole date span
+	minDate, maxDate := transactions[0].Date, transactions[0].Date
+	for _, txn := range transactions[1:] {
+		if txn.Date.Before(minDate) {
+			minDate = txn.Date
+		}
+		if txn.Date.After(maxDate) {
+			maxDate = txn.Date
+		}
+	}
+	window := config.DateWindowDays + 1
+	existing, err := transactionRepo.FindDuplicateCandidates(
+		ctx,
+		userID,
+		minDate.AddDate(0, 0, -window),
+		maxDate.AddDate(0, 0, window),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]valueobject.DuplicateCandidate, len(existing))
+	for i, txn := range existing {
+		candidates[i] = valueobject.DuplicateCandidate{
+			ID:          txn.ID,
+			Date:        txn.Date,
+			Description: txn.Description,
+			Amount:      txn.Amount,
+		}
+	}
+
+	for i, txn := range transactions {
+		for _, match := range config.FindMatches(txn.Date, txn.Description, txn.Amount, candidates) {
+			duplicates = append(duplicates, PossibleDuplicate{
+				LineIndex:     i,
+				TransactionID: match.Candidate.ID,
+				Date:          match.Candidate.Date,
+				Description:   match.Candidate.Description,
+				Amount:        match.Candidate.Amount,
+				Score:         match.Score,
+			})
+		}
+	}
+
+	return duplicates, nil
+}
+
 // matchBillPayments finds and scores potential bill payment matches.
 func (uc *PreviewImportUseCase) matchBillPayments(
 	bills []*entity.Transaction,
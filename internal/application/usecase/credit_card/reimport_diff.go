@@ -0,0 +1,111 @@
+// Package creditcard contains credit card import-related use cases.
+package creditcard
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
+)
+
+// Fields of an imported transaction a corrected statement can change.
+const (
+	ChangedFieldDate        = "date"
+	ChangedFieldDescription = "description"
+	ChangedFieldAmount      = "amount"
+)
+
+// ChangedLine represents an imported transaction whose line the corrected statement changed.
+type ChangedLine struct {
+	LineIndex int                 // Index into the statement lines
+	Existing  *entity.Transaction // Transaction as imported before
+	Fields    []string            // Changed fields: ChangedFieldDate, ChangedFieldDescription or ChangedFieldAmount
+}
+
+// ReimportDiff represents the difference between an imported billing cycle and a corrected statement.
+type ReimportDiff struct {
+	Added          []int                 // Indexes of the statement lines not imported yet
+	Changed        []ChangedLine         // Imported transactions updated from their corrected line
+	Removed        []*entity.Transaction // Imported transactions missing from the statement
+	UnchangedCount int                   // Imported transactions kept as they are
+}
+
+// diffImportedCycle compares the statement lines with the transactions imported into the billing
+// cycle, matching them on date, amount and description similarity. Hidden transactions are
+// compared too, so a line the user hid is not imported again. "Pagamento recebido" entries are
+// not purchases: they must be left out of the lines and are left out of the imported transactions.
+func diffImportedCycle(
+	ctx context.Context,
+	transactionRepo adapter.TransactionRepository,
+	userID uuid.UUID,
+	billingCycle string,
+	accountID *uuid.UUID,
+	lines []CCTransactionInput,
+) (*ReimportDiff, error) {
+	cycleTransactions, err := transactionRepo.FindImportedCCTransactions(ctx, userID, billingCycle, accountID)
+	if err != nil {
+		return nil, err
+	}
+	paymentReceivedRegex := regexp.MustCompile(PaymentReceivedPattern)
+	imported := make([]*entity.Transaction, 0, len(cycleTransactions))
+	for _, txn := range cycleTransactions {
+		if !paymentReceivedRegex.MatchString(txn.Description) {
+			imported = append(imported, txn)
+		}
+	}
+
+	recorded := make([]valueobject.StatementLine, len(imported))
+	for i, txn := range imported {
+		recorded[i] = valueobject.StatementLine{Date: txn.Date, Description: txn.Description, Amount: txn.Amount}
+	}
+	statement := make([]valueobject.StatementLine, len(lines))
+	for i, line := range lines {
+		statement[i] = valueobject.StatementLine{Date: line.Date, Description: line.Description, Amount: line.Amount}
+	}
+
+	statementDiff := valueobject.DefaultDuplicateDetectionConfig().DiffStatement(recorded, statement)
+
+	diff := &ReimportDiff{
+		Added:   statementDiff.Added,
+		Changed: []ChangedLine{},
+		Removed: make([]*entity.Transaction, len(statementDiff.Removed)),
+	}
+	for _, match := range statementDiff.Matches {
+		if !match.Changed {
+			diff.UnchangedCount++
+			continue
+		}
+		existing := imported[match.RecordedIndex]
+		line := lines[match.StatementIndex]
+		diff.Changed = append(diff.Changed, ChangedLine{
+			LineIndex: match.StatementIndex,
+			Existing:  existing,
+			Fields:    changedFields(existing, line),
+		})
+	}
+	for i, index := range statementDiff.Removed {
+		diff.Removed[i] = imported[index]
+	}
+
+	return diff, nil
+}
+
+// changedFields returns the fields of the imported transaction the statement line changes.
+func changedFields(existing *entity.Transaction, line CCTransactionInput) []string {
+	var fields []string
+	if existing.Date.Format("2006-01-02") != line.Date.Format("2006-01-02") {
+		fields = append(fields, ChangedFieldDate)
+	}
+	if strings.TrimSpace(existing.Description) != strings.TrimSpace(line.Description) {
+		fields = append(fields, ChangedFieldDescription)
+	}
+	if !existing.Amount.Equal(line.Amount) {
+		fields = append(fields, ChangedFieldAmount)
+	}
+	return fields
+}
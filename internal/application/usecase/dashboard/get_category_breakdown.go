@@ -26,9 +26,10 @@ const UncategorizedIcon = "question-mark"
 
 // GetCategoryBreakdownInput represents the input for getting category breakdown.
 type GetCategoryBreakdownInput struct {
-	UserID    uuid.UUID
-	StartDate time.Time
-	EndDate   time.Time
+	UserID     uuid.UUID
+	StartDate  time.Time
+	EndDate    time.Time
+	AccountIDs []uuid.UUID // Optional filter
 }
 
 // CategoryBreakdownItem represents a single category in the breakdown.
@@ -45,6 +46,7 @@ type CategoryBreakdownItem struct {
 // GetCategoryBreakdownOutput represents the output of getting category breakdown.
 type GetCategoryBreakdownOutput struct {
 	Period        BreakdownPeriod         `json:"period"`
+	Currency      string                  `json:"currency"` // Base currency the amounts are converted into
 	TotalExpenses decimal.Decimal         `json:"total_expenses"`
 	Categories    []CategoryBreakdownItem `json:"categories"`
 }
@@ -84,11 +86,17 @@ func (uc *GetCategoryBreakdownUseCase) Execute(
 		input.UserID,
 		input.StartDate,
 		input.EndDate,
+		input.AccountIDs,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get category breakdown: %w", err)
 	}
 
+	currency, err := uc.dashboardRepo.GetBaseCurrency(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get base currency: %w", err)
+	}
+
 	// Convert raw data to output format
 	categories := make([]CategoryBreakdownItem, 0, len(rawBreakdown))
 	for _, raw := range rawBreakdown {
@@ -139,6 +147,7 @@ func (uc *GetCategoryBreakdownUseCase) Execute(
 			EndDate:     input.EndDate,
 			PeriodLabel: periodLabel,
 		},
+		Currency:      currency,
 		TotalExpenses: totalExpenses,
 		Categories:    categories,
 	}, nil
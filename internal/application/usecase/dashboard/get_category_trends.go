@@ -37,6 +37,7 @@ type GetCategoryTrendsInput struct {
 	EndDate       time.Time
 	Granularity   Granularity
 	TopCategories int
+	AccountIDs    []uuid.UUID // Optional filter
 }
 
 // GetCategoryTrendsOutput represents the output of getting category trends.
@@ -117,7 +118,7 @@ func (uc *GetCategoryTrendsUseCase) Execute(
 
 	// 2. Get expense transactions in date range
 	expenses, err := uc.transactionRepo.GetExpensesByDateRange(
-		ctx, input.UserID, input.StartDate, input.EndDate,
+		ctx, input.UserID, input.StartDate, input.EndDate, input.AccountIDs,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get expenses: %w", err)
@@ -10,6 +10,7 @@ import (
 	"github.com/shopspring/decimal"
 
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
 )
 
 // DefaultTransactionLimit is the default limit for transactions per page.
@@ -23,7 +24,12 @@ type GetPeriodTransactionsInput struct {
 	UserID     uuid.UUID
 	StartDate  time.Time
 	EndDate    time.Time
-	CategoryID *uuid.UUID // Optional filter
+	CategoryID *uuid.UUID  // Optional filter
+	AccountIDs []uuid.UUID // Optional filter
+	TagIDs     []uuid.UUID // Optional filter, matches transactions with any of the tags
+	SortBy     string      // date (default), amount, description or category
+	SortOrder  string      // asc or desc; defaults depend on SortBy
+	Cursor     string      // Opaque token of the previous page; takes precedence over Offset
 	Limit      int
 	Offset     int
 }
@@ -54,11 +60,14 @@ type TransactionPagination struct {
 	Limit   int  `json:"limit"`
 	Offset  int  `json:"offset"`
 	HasMore bool `json:"has_more"`
+	// NextCursor is passed as the cursor parameter to fetch the next page; empty on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // GetPeriodTransactionsOutput represents the output of getting period transactions.
 type GetPeriodTransactionsOutput struct {
 	Period       TransactionsPeriod      `json:"period"`
+	Currency     string                  `json:"currency"` // Base currency the summary is converted into
 	Summary      TransactionSummary      `json:"summary"`
 	Transactions []PeriodTransactionItem `json:"transactions"`
 	Pagination   TransactionPagination   `json:"pagination"`
@@ -107,31 +116,63 @@ func (uc *GetPeriodTransactionsUseCase) Execute(
 		offset = 0
 	}
 
+	// Parse sort and cursor
+	sort, cursor, err := uc.parseSort(input)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil {
+		offset = 0
+	}
+
 	// Get period summary
 	summary, err := uc.dashboardRepo.GetPeriodSummary(
 		ctx,
 		input.UserID,
 		input.StartDate,
 		input.EndDate,
+		input.AccountIDs,
+		input.TagIDs,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get period summary: %w", err)
 	}
 
-	// Get transactions
+	currency, err := uc.dashboardRepo.GetBaseCurrency(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get base currency: %w", err)
+	}
+
+	// Get transactions, plus one more to know if there is a next page
 	transactions, total, err := uc.dashboardRepo.GetTransactionsByPeriod(
 		ctx,
 		input.UserID,
 		input.StartDate,
 		input.EndDate,
 		input.CategoryID,
-		limit,
+		input.AccountIDs,
+		input.TagIDs,
+		sort,
+		cursor,
+		limit+1,
 		offset,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get transactions: %w", err)
 	}
 
+	var nextCursor string
+	hasMore := len(transactions) > limit
+	if hasMore {
+		transactions = transactions[:limit]
+		last := transactions[len(transactions)-1]
+		keys := valueobject.TransactionSortKeys{Date: last.Date, Amount: last.Amount, Description: last.Description}
+		if last.CategoryName != nil {
+			keys.CategoryName = *last.CategoryName
+		}
+		nextCursor = valueobject.NewTransactionCursor(sort, keys, last.CreatedAt, last.ID).Encode()
+	}
+
 	// Convert to output format
 	transactionItems := make([]PeriodTransactionItem, 0, len(transactions))
 	for _, t := range transactions {
@@ -162,6 +203,7 @@ func (uc *GetPeriodTransactionsUseCase) Execute(
 			EndDate:     input.EndDate,
 			PeriodLabel: periodLabel,
 		},
+		Currency: currency,
 		Summary: TransactionSummary{
 			TotalIncome:      summary.TotalIncome,
 			TotalExpenses:    summary.TotalExpenses,
@@ -170,14 +212,55 @@ func (uc *GetPeriodTransactionsUseCase) Execute(
 		},
 		Transactions: transactionItems,
 		Pagination: TransactionPagination{
-			Total:   total,
-			Limit:   limit,
-			Offset:  offset,
-			HasMore: offset+len(transactionItems) < total,
+			Total:      total,
+			Limit:      limit,
+			Offset:     offset,
+			HasMore:    hasMore,
+			NextCursor: nextCursor,
 		},
 	}, nil
 }
 
+// parseSort parses the sort parameters and pagination cursor.
+// Without sort parameters, a cursor continues in the sort it was issued for.
+func (uc *GetPeriodTransactionsUseCase) parseSort(
+	input GetPeriodTransactionsInput,
+) (valueobject.TransactionSort, *valueobject.TransactionCursor, error) {
+	var cursor *valueobject.TransactionCursor
+	if input.Cursor != "" {
+		decoded, err := valueobject.DecodeTransactionCursor(input.Cursor)
+		if err != nil {
+			return valueobject.TransactionSort{}, nil, domainerror.NewDashboardError(
+				domainerror.ErrCodeInvalidCursor,
+				"invalid cursor",
+				domainerror.ErrInvalidCursor,
+			)
+		}
+		cursor = decoded
+		if input.SortBy == "" && input.SortOrder == "" {
+			return cursor.Sort, cursor, nil
+		}
+	}
+
+	sort, err := valueobject.ParseTransactionSort(input.SortBy, input.SortOrder)
+	if err != nil {
+		return valueobject.TransactionSort{}, nil, domainerror.NewDashboardError(
+			domainerror.ErrCodeInvalidSort,
+			err.Error(),
+			domainerror.ErrInvalidSort,
+		)
+	}
+
+	if cursor != nil && cursor.Sort != sort {
+		return valueobject.TransactionSort{}, nil, domainerror.NewDashboardError(
+			domainerror.ErrCodeInvalidCursor,
+			"cursor was issued for a different sort",
+			domainerror.ErrInvalidCursor,
+		)
+	}
+	return sort, cursor, nil
+}
+
 // validateInput validates the input parameters.
 func (uc *GetPeriodTransactionsUseCase) validateInput(input GetPeriodTransactionsInput) error {
 	if input.StartDate.IsZero() {
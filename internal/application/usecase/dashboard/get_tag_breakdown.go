@@ -0,0 +1,176 @@
+// Package dashboard contains dashboard-related use cases.
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// GetTagBreakdownInput represents the input for getting tag breakdown.
+type GetTagBreakdownInput struct {
+	UserID     uuid.UUID
+	StartDate  time.Time
+	EndDate    time.Time
+	AccountIDs []uuid.UUID // Optional filter
+}
+
+// TagBreakdownItem represents a single tag in the breakdown.
+type TagBreakdownItem struct {
+	TagID            string          `json:"tag_id"`
+	TagName          string          `json:"tag_name"`
+	TagColor         string          `json:"tag_color"`
+	Amount           decimal.Decimal `json:"amount"`
+	Percentage       float64         `json:"percentage"` // Share of all expenses in the period
+	TransactionCount int             `json:"transaction_count"`
+}
+
+// GetTagBreakdownOutput represents the output of getting tag breakdown.
+// Transactions can have several tags, so tag amounts may add up to more than the total expenses.
+type GetTagBreakdownOutput struct {
+	Period        BreakdownPeriod    `json:"period"`
+	Currency      string             `json:"currency"`       // Base currency the amounts are converted into
+	TotalExpenses decimal.Decimal    `json:"total_expenses"` // All expenses in the period, tagged or not
+	Tags          []TagBreakdownItem `json:"tags"`
+}
+
+// GetTagBreakdownUseCase handles getting spending breakdown by tag.
+type GetTagBreakdownUseCase struct {
+	dashboardRepo DashboardRepository
+}
+
+// NewGetTagBreakdownUseCase creates a new GetTagBreakdownUseCase instance.
+func NewGetTagBreakdownUseCase(dashboardRepo DashboardRepository) *GetTagBreakdownUseCase {
+	return &GetTagBreakdownUseCase{
+		dashboardRepo: dashboardRepo,
+	}
+}
+
+// Execute retrieves spending breakdown by tag for the given period.
+func (uc *GetTagBreakdownUseCase) Execute(
+	ctx context.Context,
+	input GetTagBreakdownInput,
+) (*GetTagBreakdownOutput, error) {
+	// Validate input
+	if err := uc.validateInput(input); err != nil {
+		return nil, err
+	}
+
+	// Get tag breakdown from repository
+	rawBreakdown, err := uc.dashboardRepo.GetTagBreakdown(
+		ctx,
+		input.UserID,
+		input.StartDate,
+		input.EndDate,
+		input.AccountIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag breakdown: %w", err)
+	}
+
+	// Percentages are relative to all expenses in the period
+	summary, err := uc.dashboardRepo.GetPeriodSummary(
+		ctx,
+		input.UserID,
+		input.StartDate,
+		input.EndDate,
+		input.AccountIDs,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get period summary: %w", err)
+	}
+
+	currency, err := uc.dashboardRepo.GetBaseCurrency(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get base currency: %w", err)
+	}
+
+	// Convert raw data to output format
+	tags := make([]TagBreakdownItem, 0, len(rawBreakdown))
+	for _, raw := range rawBreakdown {
+		var percentage float64
+		if !summary.TotalExpenses.IsZero() {
+			pct := raw.Amount.Mul(decimal.NewFromInt(100)).Div(summary.TotalExpenses)
+			percentage, _ = pct.Round(2).Float64()
+		}
+
+		tags = append(tags, TagBreakdownItem{
+			TagID:            raw.TagID.String(),
+			TagName:          raw.TagName,
+			TagColor:         raw.TagColor,
+			Amount:           raw.Amount,
+			Percentage:       percentage,
+			TransactionCount: raw.TransactionCount,
+		})
+	}
+
+	// Generate period label based on the date range
+	periodLabel := uc.generatePeriodLabel(input.StartDate, input.EndDate)
+
+	return &GetTagBreakdownOutput{
+		Period: BreakdownPeriod{
+			StartDate:   input.StartDate,
+			EndDate:     input.EndDate,
+			PeriodLabel: periodLabel,
+		},
+		Currency:      currency,
+		TotalExpenses: summary.TotalExpenses,
+		Tags:          tags,
+	}, nil
+}
+
+// validateInput validates the input parameters.
+func (uc *GetTagBreakdownUseCase) validateInput(input GetTagBreakdownInput) error {
+	if input.StartDate.IsZero() {
+		return domainerror.NewDashboardError(
+			domainerror.ErrCodeMissingStartDate,
+			"start_date is required",
+			domainerror.ErrMissingStartDate,
+		)
+	}
+
+	if input.EndDate.IsZero() {
+		return domainerror.NewDashboardError(
+			domainerror.ErrCodeMissingEndDate,
+			"end_date is required",
+			domainerror.ErrMissingEndDate,
+		)
+	}
+
+	if input.EndDate.Before(input.StartDate) {
+		return domainerror.NewDashboardError(
+			domainerror.ErrCodeInvalidDateRange,
+			"end_date must be after start_date",
+			domainerror.ErrInvalidDateRange,
+		)
+	}
+
+	return nil
+}
+
+// generatePeriodLabel generates a human-readable label for the period.
+func (uc *GetTagBreakdownUseCase) generatePeriodLabel(startDate, endDate time.Time) string {
+	// If the period spans a single month, use monthly format
+	if startDate.Year() == endDate.Year() && startDate.Month() == endDate.Month() {
+		return GeneratePeriodLabel(startDate, GranularityMonthly)
+	}
+
+	// If the period spans a single quarter
+	startQuarter := (int(startDate.Month())-1)/3 + 1
+	endQuarter := (int(endDate.Month())-1)/3 + 1
+	if startDate.Year() == endDate.Year() && startQuarter == endQuarter {
+		return GeneratePeriodLabel(startDate, GranularityQuarterly)
+	}
+
+	// Otherwise, show the date range
+	return fmt.Sprintf("%s - %s",
+		GeneratePeriodLabel(startDate, GranularityMonthly),
+		GeneratePeriodLabel(endDate, GranularityMonthly),
+	)
+}
@@ -0,0 +1,279 @@
+// Package dashboard contains dashboard-related use cases.
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+const (
+	// DefaultTopMerchants is the number of merchants returned when no limit is given.
+	DefaultTopMerchants = 10
+	// MaxTopMerchants is the maximum number of merchants returned.
+	MaxTopMerchants = 50
+)
+
+// GetTopMerchantsInput represents the input for getting the top merchants.
+type GetTopMerchantsInput struct {
+	UserID      uuid.UUID
+	StartDate   time.Time
+	EndDate     time.Time
+	Granularity Granularity // weekly, monthly or quarterly; defaults to monthly
+	Limit       int         // Number of merchants, defaults to DefaultTopMerchants
+	AccountIDs  []uuid.UUID // Optional filter
+}
+
+// TopMerchantItem represents a merchant with its spending over the whole period.
+type TopMerchantItem struct {
+	MerchantID       uuid.UUID
+	MerchantName     string
+	Amount           decimal.Decimal
+	Percentage       float64 // Share of all expenses in the period
+	TransactionCount int
+	AverageAmount    decimal.Decimal // Average spent per transaction
+}
+
+// MerchantTrendPoint represents the spending and frequency of the top merchants in one period.
+type MerchantTrendPoint struct {
+	Date        time.Time
+	PeriodLabel string
+	Merchants   []MerchantPeriodAmount // One entry per top merchant, in the order of the ranking
+}
+
+// MerchantPeriodAmount represents a merchant's spending in one period.
+type MerchantPeriodAmount struct {
+	MerchantID       uuid.UUID
+	Amount           decimal.Decimal
+	TransactionCount int
+}
+
+// GetTopMerchantsOutput represents the output of getting the top merchants.
+type GetTopMerchantsOutput struct {
+	Period        TrendPeriod
+	Currency      string          // Base currency the amounts are converted into
+	TotalExpenses decimal.Decimal // All expenses in the period, with a merchant or not
+	Merchants     []TopMerchantItem
+	Trends        []MerchantTrendPoint
+}
+
+// GetTopMerchantsUseCase handles getting the merchants the user spends the most with.
+type GetTopMerchantsUseCase struct {
+	dashboardRepo DashboardRepository
+}
+
+// NewGetTopMerchantsUseCase creates a new GetTopMerchantsUseCase instance.
+func NewGetTopMerchantsUseCase(dashboardRepo DashboardRepository) *GetTopMerchantsUseCase {
+	return &GetTopMerchantsUseCase{
+		dashboardRepo: dashboardRepo,
+	}
+}
+
+// Execute ranks merchants by spending in the period and returns the spending and number of
+// transactions of the top ones in each period of the granularity.
+func (uc *GetTopMerchantsUseCase) Execute(
+	ctx context.Context,
+	input GetTopMerchantsInput,
+) (*GetTopMerchantsOutput, error) {
+	// Apply defaults
+	if input.Granularity == "" {
+		input.Granularity = GranularityMonthly
+	}
+	if input.Limit <= 0 {
+		input.Limit = DefaultTopMerchants
+	}
+	if input.Limit > MaxTopMerchants {
+		input.Limit = MaxTopMerchants
+	}
+
+	// Validate input
+	if err := uc.validateInput(input); err != nil {
+		return nil, err
+	}
+
+	// Get spending per merchant and day from repository
+	rawSpending, err := uc.dashboardRepo.GetMerchantSpending(
+		ctx,
+		input.UserID,
+		input.StartDate,
+		input.EndDate,
+		input.AccountIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merchant spending: %w", err)
+	}
+
+	// Percentages are relative to all expenses in the period
+	summary, err := uc.dashboardRepo.GetPeriodSummary(
+		ctx,
+		input.UserID,
+		input.StartDate,
+		input.EndDate,
+		input.AccountIDs,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get period summary: %w", err)
+	}
+
+	currency, err := uc.dashboardRepo.GetBaseCurrency(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get base currency: %w", err)
+	}
+
+	// Rank merchants by spending over the whole period
+	merchants := uc.rankMerchants(rawSpending, summary.TotalExpenses, input.Limit)
+
+	return &GetTopMerchantsOutput{
+		Period: TrendPeriod{
+			StartDate:   input.StartDate,
+			EndDate:     input.EndDate,
+			Granularity: input.Granularity,
+		},
+		Currency:      currency,
+		TotalExpenses: summary.TotalExpenses,
+		Merchants:     merchants,
+		Trends:        uc.aggregateByPeriod(rawSpending, merchants, input),
+	}, nil
+}
+
+// validateInput validates the input parameters.
+func (uc *GetTopMerchantsUseCase) validateInput(input GetTopMerchantsInput) error {
+	if input.StartDate.IsZero() {
+		return domainerror.NewDashboardError(
+			domainerror.ErrCodeMissingStartDate,
+			"start_date is required",
+			domainerror.ErrMissingStartDate,
+		)
+	}
+
+	if input.EndDate.IsZero() {
+		return domainerror.NewDashboardError(
+			domainerror.ErrCodeMissingEndDate,
+			"end_date is required",
+			domainerror.ErrMissingEndDate,
+		)
+	}
+
+	if input.EndDate.Before(input.StartDate) {
+		return domainerror.NewDashboardError(
+			domainerror.ErrCodeInvalidDateRange,
+			"end_date must be after start_date",
+			domainerror.ErrInvalidDateRange,
+		)
+	}
+
+	if input.Granularity != GranularityWeekly &&
+		input.Granularity != GranularityMonthly &&
+		input.Granularity != GranularityQuarterly {
+		return domainerror.NewDashboardError(
+			domainerror.ErrCodeInvalidGranularity,
+			"granularity must be: weekly, monthly, or quarterly",
+			domainerror.ErrInvalidGranularity,
+		)
+	}
+
+	return nil
+}
+
+// rankMerchants totals the spending per merchant and returns the top ones, highest spending first.
+func (uc *GetTopMerchantsUseCase) rankMerchants(
+	rawSpending []RawMerchantSpending,
+	totalExpenses decimal.Decimal,
+	limit int,
+) []TopMerchantItem {
+	totals := make(map[uuid.UUID]*TopMerchantItem)
+	for _, raw := range rawSpending {
+		item, exists := totals[raw.MerchantID]
+		if !exists {
+			item = &TopMerchantItem{
+				MerchantID:   raw.MerchantID,
+				MerchantName: raw.MerchantName,
+			}
+			totals[raw.MerchantID] = item
+		}
+		item.Amount = item.Amount.Add(raw.Amount)
+		item.TransactionCount += raw.TransactionCount
+	}
+
+	merchants := make([]TopMerchantItem, 0, len(totals))
+	for _, item := range totals {
+		if !totalExpenses.IsZero() {
+			pct := item.Amount.Mul(decimal.NewFromInt(100)).Div(totalExpenses)
+			item.Percentage, _ = pct.Round(2).Float64()
+		}
+		if item.TransactionCount > 0 {
+			item.AverageAmount = item.Amount.Div(decimal.NewFromInt(int64(item.TransactionCount))).Round(2)
+		}
+		merchants = append(merchants, *item)
+	}
+
+	// Sort by amount descending; ties by name for a stable ranking
+	sort.Slice(merchants, func(i, j int) bool {
+		if !merchants[i].Amount.Equal(merchants[j].Amount) {
+			return merchants[i].Amount.GreaterThan(merchants[j].Amount)
+		}
+		return merchants[i].MerchantName < merchants[j].MerchantName
+	})
+
+	if len(merchants) > limit {
+		merchants = merchants[:limit]
+	}
+	return merchants
+}
+
+// aggregateByPeriod groups the spending of the top merchants by period, including periods
+// without spending so charts have no gaps.
+func (uc *GetTopMerchantsUseCase) aggregateByPeriod(
+	rawSpending []RawMerchantSpending,
+	merchants []TopMerchantItem,
+	input GetTopMerchantsInput,
+) []MerchantTrendPoint {
+	// Initialize aggregation map: periodKey -> merchantID -> spending
+	periods := GeneratePeriodSeries(input.StartDate, input.EndDate, input.Granularity)
+	aggregation := make(map[string]map[uuid.UUID]*MerchantPeriodAmount, len(periods))
+	for _, p := range periods {
+		amounts := make(map[uuid.UUID]*MerchantPeriodAmount, len(merchants))
+		for _, merchant := range merchants {
+			amounts[merchant.MerchantID] = &MerchantPeriodAmount{MerchantID: merchant.MerchantID}
+		}
+		aggregation[GetPeriodKeyForDate(p.Date, input.Granularity)] = amounts
+	}
+
+	// Aggregate spending into periods, skipping merchants outside the top
+	for _, raw := range rawSpending {
+		amounts, exists := aggregation[GetPeriodKeyForDate(raw.Date, input.Granularity)]
+		if !exists {
+			continue
+		}
+		amount, isTop := amounts[raw.MerchantID]
+		if !isTop {
+			continue
+		}
+		amount.Amount = amount.Amount.Add(raw.Amount)
+		amount.TransactionCount += raw.TransactionCount
+	}
+
+	// Build trend points in the order of the ranking
+	trends := make([]MerchantTrendPoint, 0, len(periods))
+	for _, p := range periods {
+		amounts := aggregation[GetPeriodKeyForDate(p.Date, input.Granularity)]
+		point := MerchantTrendPoint{
+			Date:        p.Date,
+			PeriodLabel: p.PeriodLabel,
+			Merchants:   make([]MerchantPeriodAmount, 0, len(merchants)),
+		}
+		for _, merchant := range merchants {
+			point.Merchants = append(point.Merchants, *amounts[merchant.MerchantID])
+		}
+		trends = append(trends, point)
+	}
+
+	return trends
+}
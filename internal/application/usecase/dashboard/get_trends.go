@@ -18,6 +18,7 @@ type GetTrendsInput struct {
 	StartDate   time.Time
 	EndDate     time.Time
 	Granularity Granularity
+	AccountIDs  []uuid.UUID // Optional filter
 }
 
 // TrendPoint represents a single trend data point.
@@ -32,8 +33,9 @@ type TrendPoint struct {
 
 // GetTrendsOutput represents the output of getting trends.
 type GetTrendsOutput struct {
-	Period TrendsPeriod `json:"period"`
-	Trends []TrendPoint `json:"trends"`
+	Period   TrendsPeriod `json:"period"`
+	Currency string       `json:"currency"` // Base currency the amounts are converted into
+	Trends   []TrendPoint `json:"trends"`
 }
 
 // TrendsPeriod represents the period information for trends.
@@ -72,11 +74,17 @@ func (uc *GetTrendsUseCase) Execute(
 		input.StartDate,
 		input.EndDate,
 		input.Granularity,
+		input.AccountIDs,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get trends: %w", err)
 	}
 
+	currency, err := uc.dashboardRepo.GetBaseCurrency(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get base currency: %w", err)
+	}
+
 	// Create a map for quick lookup of raw data by period key
 	rawDataMap := make(map[string]RawTrendData)
 	for _, rd := range rawTrends {
@@ -122,7 +130,8 @@ func (uc *GetTrendsUseCase) Execute(
 			EndDate:     input.EndDate,
 			Granularity: input.Granularity,
 		},
-		Trends: trends,
+		Currency: currency,
+		Trends:   trends,
 	}, nil
 }
 
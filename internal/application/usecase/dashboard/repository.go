@@ -7,9 +7,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
 )
 
 // DashboardRepository defines the interface for dashboard data operations.
+// Methods that take accountIDs only consider transactions of those accounts, and methods that take tagIDs only
+// consider transactions with any of those tags; an empty slice means all transactions.
 type DashboardRepository interface {
 	// GetDateRange returns the date range of user's transactions.
 	GetDateRange(ctx context.Context, userID uuid.UUID) (*DateRange, error)
@@ -20,6 +24,7 @@ type DashboardRepository interface {
 		userID uuid.UUID,
 		startDate, endDate time.Time,
 		granularity Granularity,
+		accountIDs []uuid.UUID,
 	) ([]RawTrendData, error)
 
 	// GetCategoryBreakdown returns spending breakdown by category for a period.
@@ -27,14 +32,38 @@ type DashboardRepository interface {
 		ctx context.Context,
 		userID uuid.UUID,
 		startDate, endDate time.Time,
+		accountIDs []uuid.UUID,
 	) ([]RawCategoryBreakdown, decimal.Decimal, error)
 
-	// GetTransactionsByPeriod returns transactions for a specific period.
+	// GetTagBreakdown returns spending per tag for a period. A transaction with several tags
+	// counts towards each of them; tags without spending in the period are omitted.
+	GetTagBreakdown(
+		ctx context.Context,
+		userID uuid.UUID,
+		startDate, endDate time.Time,
+		accountIDs []uuid.UUID,
+	) ([]RawTagBreakdown, error)
+
+	// GetMerchantSpending returns spending per merchant and day for a period. Transactions
+	// without a merchant are omitted.
+	GetMerchantSpending(
+		ctx context.Context,
+		userID uuid.UUID,
+		startDate, endDate time.Time,
+		accountIDs []uuid.UUID,
+	) ([]RawMerchantSpending, error)
+
+	// GetTransactionsByPeriod returns transactions for a specific period in the given sort.
+	// With a cursor, transactions start right after it and offset is ignored.
 	GetTransactionsByPeriod(
 		ctx context.Context,
 		userID uuid.UUID,
 		startDate, endDate time.Time,
 		categoryID *uuid.UUID,
+		accountIDs []uuid.UUID,
+		tagIDs []uuid.UUID,
+		sort valueobject.TransactionSort,
+		cursor *valueobject.TransactionCursor,
 		limit, offset int,
 	) ([]PeriodTransaction, int, error)
 
@@ -43,7 +72,12 @@ type DashboardRepository interface {
 		ctx context.Context,
 		userID uuid.UUID,
 		startDate, endDate time.Time,
+		accountIDs []uuid.UUID,
+		tagIDs []uuid.UUID,
 	) (*PeriodSummary, error)
+
+	// GetBaseCurrency returns the currency the user's amounts are aggregated in.
+	GetBaseCurrency(ctx context.Context, userID uuid.UUID) (string, error)
 }
 
 // DateRange represents the date boundaries of a user's transaction history.
@@ -71,6 +105,24 @@ type RawCategoryBreakdown struct {
 	TransactionCount int
 }
 
+// RawTagBreakdown represents raw tag breakdown from the database.
+type RawTagBreakdown struct {
+	TagID            uuid.UUID
+	TagName          string
+	TagColor         string
+	Amount           decimal.Decimal
+	TransactionCount int
+}
+
+// RawMerchantSpending represents a merchant's spending on one day from the database.
+type RawMerchantSpending struct {
+	MerchantID       uuid.UUID
+	MerchantName     string
+	Date             time.Time
+	Amount           decimal.Decimal
+	TransactionCount int
+}
+
 // PeriodTransaction represents a transaction within a period.
 type PeriodTransaction struct {
 	ID            uuid.UUID
@@ -81,6 +133,7 @@ type PeriodTransaction struct {
 	CategoryName  *string
 	CategoryColor *string
 	CategoryIcon  *string
+	CreatedAt     time.Time
 }
 
 // PeriodSummary represents summary totals for a period.
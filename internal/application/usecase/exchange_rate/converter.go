@@ -0,0 +1,116 @@
+// Package exchangerate contains exchange rate and currency-related use cases.
+package exchangerate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// Converter resolves exchange rates from the user's rate table and snapshots them onto transactions.
+// It is shared by the use cases that record transactions.
+type Converter struct {
+	exchangeRateRepo adapter.ExchangeRateRepository
+	userRepo         adapter.UserRepository
+}
+
+// NewConverter creates a new Converter instance.
+func NewConverter(
+	exchangeRateRepo adapter.ExchangeRateRepository,
+	userRepo adapter.UserRepository,
+) *Converter {
+	return &Converter{
+		exchangeRateRepo: exchangeRateRepo,
+		userRepo:         userRepo,
+	}
+}
+
+// BaseCurrency returns the currency the user's reports are converted into.
+func (c *Converter) BaseCurrency(ctx context.Context, userID uuid.UUID) (string, error) {
+	user, err := c.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if user.BaseCurrency == "" {
+		return entity.DefaultCurrency, nil
+	}
+	return user.BaseCurrency, nil
+}
+
+// Rate returns the rate converting fromCurrency into toCurrency using the user's most recent rate
+// on or before date. Returns an ExchangeRateError with ErrCodeExchangeRateUnavailable when none exists.
+func (c *Converter) Rate(
+	ctx context.Context,
+	userID uuid.UUID,
+	fromCurrency, toCurrency string,
+	date time.Time,
+) (decimal.Decimal, error) {
+	if fromCurrency == toCurrency {
+		return decimal.NewFromInt(1), nil
+	}
+
+	exchangeRate, err := c.exchangeRateRepo.FindLatest(ctx, userID, fromCurrency, toCurrency, date)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrExchangeRateUnavailable) {
+			return decimal.Zero, unavailableRateError(fromCurrency, toCurrency, date)
+		}
+		return decimal.Zero, fmt.Errorf("failed to find exchange rate: %w", err)
+	}
+
+	rate, ok := exchangeRate.RateFor(fromCurrency, toCurrency)
+	if !ok {
+		return decimal.Zero, unavailableRateError(fromCurrency, toCurrency, date)
+	}
+	return rate, nil
+}
+
+// Snapshot records on each transaction the rate converting its amount into the user's base currency
+// on the transaction date. Transactions without a currency are recorded in the base currency.
+func (c *Converter) Snapshot(ctx context.Context, userID uuid.UUID, transactions ...*entity.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	baseCurrency, err := c.BaseCurrency(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// Imports share few currency and date pairs, so look each one up only once
+	rates := make(map[string]decimal.Decimal)
+	for _, txn := range transactions {
+		if txn.Currency == "" {
+			txn.Currency = baseCurrency
+		}
+
+		key := txn.Currency + "|" + txn.Date.Format("2006-01-02")
+		rate, ok := rates[key]
+		if !ok {
+			rate, err = c.Rate(ctx, userID, txn.Currency, baseCurrency, txn.Date)
+			if err != nil {
+				return err
+			}
+			rates[key] = rate
+		}
+		txn.ExchangeRate = rate
+	}
+	return nil
+}
+
+// unavailableRateError builds the error returned when no rate covers a currency pair and date.
+func unavailableRateError(fromCurrency, toCurrency string, date time.Time) error {
+	return domainerror.NewExchangeRateError(
+		domainerror.ErrCodeExchangeRateUnavailable,
+		fmt.Sprintf("no exchange rate from %s to %s on or before %s", fromCurrency, toCurrency, date.Format("2006-01-02")),
+		domainerror.ErrExchangeRateUnavailable,
+	)
+}
@@ -0,0 +1,122 @@
+// Package exchangerate contains exchange rate and currency-related use cases.
+package exchangerate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// CreateExchangeRateInput represents the input for entering an exchange rate manually.
+type CreateExchangeRateInput struct {
+	UserID       uuid.UUID
+	FromCurrency string
+	ToCurrency   string
+	Rate         decimal.Decimal // Value of one unit of FromCurrency in ToCurrency
+	Date         time.Time
+}
+
+// CreateExchangeRateOutput represents the output of exchange rate creation.
+type CreateExchangeRateOutput struct {
+	ExchangeRate *entity.ExchangeRate
+}
+
+// CreateExchangeRateUseCase handles manual exchange rate entry.
+type CreateExchangeRateUseCase struct {
+	exchangeRateRepo adapter.ExchangeRateRepository
+}
+
+// NewCreateExchangeRateUseCase creates a new CreateExchangeRateUseCase instance.
+func NewCreateExchangeRateUseCase(exchangeRateRepo adapter.ExchangeRateRepository) *CreateExchangeRateUseCase {
+	return &CreateExchangeRateUseCase{
+		exchangeRateRepo: exchangeRateRepo,
+	}
+}
+
+// Execute saves the rate, replacing any rate already entered for the same pair and date.
+// Transactions keep the rate snapshotted when they were recorded.
+func (uc *CreateExchangeRateUseCase) Execute(ctx context.Context, input CreateExchangeRateInput) (*CreateExchangeRateOutput, error) {
+	if input.Date.IsZero() {
+		return nil, domainerror.NewExchangeRateError(
+			domainerror.ErrCodeExchangeRateMissingFields,
+			"date is required",
+			domainerror.ErrExchangeRateMissingFields,
+		)
+	}
+
+	// Build and validate rate
+	exchangeRate := entity.NewExchangeRate(
+		input.UserID,
+		NormalizeCurrency(input.FromCurrency),
+		NormalizeCurrency(input.ToCurrency),
+		input.Rate,
+		input.Date,
+		entity.ExchangeRateSourceManual,
+	)
+
+	if err := ValidateExchangeRate(exchangeRate); err != nil {
+		return nil, err
+	}
+
+	// Save rate
+	if err := uc.exchangeRateRepo.Upsert(ctx, []*entity.ExchangeRate{exchangeRate}); err != nil {
+		return nil, fmt.Errorf("failed to save exchange rate: %w", err)
+	}
+
+	return &CreateExchangeRateOutput{
+		ExchangeRate: exchangeRate,
+	}, nil
+}
+
+// NormalizeCurrency trims and upper-cases a currency code.
+func NormalizeCurrency(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// ValidateCurrency checks that the code is an ISO 4217 currency code.
+func ValidateCurrency(code string) error {
+	if !entity.IsValidCurrencyCode(code) {
+		return domainerror.NewExchangeRateError(
+			domainerror.ErrCodeInvalidCurrency,
+			"currency must be a 3-letter ISO 4217 code",
+			domainerror.ErrInvalidCurrency,
+		)
+	}
+	return nil
+}
+
+// ValidateExchangeRate validates the currencies and rate of an exchange rate.
+func ValidateExchangeRate(exchangeRate *entity.ExchangeRate) error {
+	if err := ValidateCurrency(exchangeRate.FromCurrency); err != nil {
+		return err
+	}
+	if err := ValidateCurrency(exchangeRate.ToCurrency); err != nil {
+		return err
+	}
+
+	if exchangeRate.FromCurrency == exchangeRate.ToCurrency {
+		return domainerror.NewExchangeRateError(
+			domainerror.ErrCodeSameCurrency,
+			"from and to currencies must be different",
+			domainerror.ErrSameCurrency,
+		)
+	}
+
+	if !exchangeRate.Rate.IsPositive() {
+		return domainerror.NewExchangeRateError(
+			domainerror.ErrCodeInvalidExchangeRate,
+			"rate must be greater than zero",
+			domainerror.ErrInvalidExchangeRate,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+// Package exchangerate contains exchange rate and currency-related use cases.
+package exchangerate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// DeleteExchangeRateInput represents the input for exchange rate deletion.
+type DeleteExchangeRateInput struct {
+	ExchangeRateID uuid.UUID
+	UserID         uuid.UUID
+}
+
+// DeleteExchangeRateOutput represents the output of exchange rate deletion.
+type DeleteExchangeRateOutput struct {
+	Success bool
+}
+
+// DeleteExchangeRateUseCase handles exchange rate deletion logic.
+type DeleteExchangeRateUseCase struct {
+	exchangeRateRepo adapter.ExchangeRateRepository
+}
+
+// NewDeleteExchangeRateUseCase creates a new DeleteExchangeRateUseCase instance.
+func NewDeleteExchangeRateUseCase(exchangeRateRepo adapter.ExchangeRateRepository) *DeleteExchangeRateUseCase {
+	return &DeleteExchangeRateUseCase{
+		exchangeRateRepo: exchangeRateRepo,
+	}
+}
+
+// Execute performs the exchange rate deletion. Rates already snapshotted on transactions are kept.
+func (uc *DeleteExchangeRateUseCase) Execute(ctx context.Context, input DeleteExchangeRateInput) (*DeleteExchangeRateOutput, error) {
+	// Find the existing rate and check ownership
+	exchangeRate, err := uc.exchangeRateRepo.FindByID(ctx, input.ExchangeRateID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrExchangeRateNotFound) {
+			return nil, domainerror.NewExchangeRateError(
+				domainerror.ErrCodeExchangeRateNotFound,
+				"exchange rate not found",
+				domainerror.ErrExchangeRateNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to find exchange rate: %w", err)
+	}
+
+	if exchangeRate.UserID != input.UserID {
+		return nil, domainerror.NewExchangeRateError(
+			domainerror.ErrCodeNotAuthorizedExchangeRate,
+			"not authorized to delete this exchange rate",
+			domainerror.ErrNotAuthorizedExchangeRate,
+		)
+	}
+
+	// Delete the rate
+	if err := uc.exchangeRateRepo.Delete(ctx, input.ExchangeRateID); err != nil {
+		return nil, fmt.Errorf("failed to delete exchange rate: %w", err)
+	}
+
+	return &DeleteExchangeRateOutput{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,95 @@
+// Package exchangerate contains exchange rate and currency-related use cases.
+package exchangerate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// ImportExchangeRatesInput represents the input for loading exchange rates from a file.
+type ImportExchangeRatesInput struct {
+	UserID uuid.UUID
+	Data   []byte
+}
+
+// ImportExchangeRatesOutput represents the output of an exchange rate file import.
+type ImportExchangeRatesOutput struct {
+	ImportedCount int
+	Errors        []adapter.StatementLineError // Lines that could not be parsed and were not imported
+}
+
+// ImportExchangeRatesUseCase handles loading exchange rates from a file.
+type ImportExchangeRatesUseCase struct {
+	exchangeRateRepo adapter.ExchangeRateRepository
+	parser           adapter.ExchangeRateFileParser
+}
+
+// NewImportExchangeRatesUseCase creates a new ImportExchangeRatesUseCase instance.
+func NewImportExchangeRatesUseCase(
+	exchangeRateRepo adapter.ExchangeRateRepository,
+	parser adapter.ExchangeRateFileParser,
+) *ImportExchangeRatesUseCase {
+	return &ImportExchangeRatesUseCase{
+		exchangeRateRepo: exchangeRateRepo,
+		parser:           parser,
+	}
+}
+
+// Execute parses the file and saves every valid rate, replacing rates already entered for the
+// same pair and date. Invalid lines are reported back instead of failing the import.
+func (uc *ImportExchangeRatesUseCase) Execute(ctx context.Context, input ImportExchangeRatesInput) (*ImportExchangeRatesOutput, error) {
+	parsed, err := uc.parser.ParseExchangeRates(input.Data)
+	if err != nil {
+		return nil, domainerror.NewExchangeRateError(
+			domainerror.ErrCodeInvalidExchangeRateFile,
+			err.Error(),
+			domainerror.ErrInvalidExchangeRateFile,
+		)
+	}
+
+	lineErrors := append([]adapter.StatementLineError{}, parsed.Errors...)
+	rates := make([]*entity.ExchangeRate, 0, len(parsed.Rates))
+	for _, line := range parsed.Rates {
+		exchangeRate := entity.NewExchangeRate(
+			input.UserID,
+			NormalizeCurrency(line.FromCurrency),
+			NormalizeCurrency(line.ToCurrency),
+			line.Rate,
+			line.Date,
+			entity.ExchangeRateSourceFile,
+		)
+
+		if err := ValidateExchangeRate(exchangeRate); err != nil {
+			lineErrors = append(lineErrors, adapter.StatementLineError{
+				Row:     line.Row,
+				Message: err.Error(),
+			})
+			continue
+		}
+		rates = append(rates, exchangeRate)
+	}
+
+	if len(rates) == 0 {
+		return nil, domainerror.NewExchangeRateError(
+			domainerror.ErrCodeEmptyExchangeRateFile,
+			"exchange rate file contains no valid rates",
+			domainerror.ErrEmptyExchangeRateFile,
+		)
+	}
+
+	// Save all rates atomically
+	if err := uc.exchangeRateRepo.Upsert(ctx, rates); err != nil {
+		return nil, fmt.Errorf("failed to import exchange rates: %w", err)
+	}
+
+	return &ImportExchangeRatesOutput{
+		ImportedCount: len(rates),
+		Errors:        lineErrors,
+	}, nil
+}
@@ -0,0 +1,66 @@
+// Package exchangerate contains exchange rate and currency-related use cases.
+package exchangerate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ListExchangeRatesInput represents the input for listing exchange rates.
+type ListExchangeRatesInput struct {
+	UserID   uuid.UUID
+	Currency string // Optional, only rates converting from or into this currency
+}
+
+// ListExchangeRatesOutput represents the output of listing exchange rates.
+type ListExchangeRatesOutput struct {
+	BaseCurrency  string
+	ExchangeRates []*entity.ExchangeRate
+}
+
+// ListExchangeRatesUseCase handles listing exchange rates logic.
+type ListExchangeRatesUseCase struct {
+	exchangeRateRepo adapter.ExchangeRateRepository
+	converter        *Converter
+}
+
+// NewListExchangeRatesUseCase creates a new ListExchangeRatesUseCase instance.
+func NewListExchangeRatesUseCase(
+	exchangeRateRepo adapter.ExchangeRateRepository,
+	converter *Converter,
+) *ListExchangeRatesUseCase {
+	return &ListExchangeRatesUseCase{
+		exchangeRateRepo: exchangeRateRepo,
+		converter:        converter,
+	}
+}
+
+// Execute lists the user's rates, newest first, along with their base currency.
+func (uc *ListExchangeRatesUseCase) Execute(ctx context.Context, input ListExchangeRatesInput) (*ListExchangeRatesOutput, error) {
+	currency := NormalizeCurrency(input.Currency)
+	if currency != "" {
+		if err := ValidateCurrency(currency); err != nil {
+			return nil, err
+		}
+	}
+
+	baseCurrency, err := uc.converter.BaseCurrency(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := uc.exchangeRateRepo.FindByUser(ctx, input.UserID, currency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exchange rates: %w", err)
+	}
+
+	return &ListExchangeRatesOutput{
+		BaseCurrency:  baseCurrency,
+		ExchangeRates: rates,
+	}, nil
+}
@@ -0,0 +1,151 @@
+// Package exchangerate contains exchange rate and currency-related use cases.
+package exchangerate
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
+)
+
+// updateBaseCurrencyBatchSize is the number of transactions loaded at a time when snapshotting their rates.
+const updateBaseCurrencyBatchSize = 500
+
+// UpdateBaseCurrencyInput represents the input for changing the user's base currency.
+type UpdateBaseCurrencyInput struct {
+	UserID       uuid.UUID
+	BaseCurrency string
+}
+
+// UpdateBaseCurrencyOutput represents the output of a base currency change.
+type UpdateBaseCurrencyOutput struct {
+	BaseCurrency      string
+	RecalculatedCount int // Number of currency and date pairs whose snapshotted rate was recalculated
+}
+
+// UpdateBaseCurrencyUseCase handles changing the currency the user's reports are converted into.
+type UpdateBaseCurrencyUseCase struct {
+	userRepo        adapter.UserRepository
+	transactionRepo adapter.TransactionRepository
+	changeRepo      adapter.TransactionChangeRepository
+	converter       *Converter
+}
+
+// NewUpdateBaseCurrencyUseCase creates a new UpdateBaseCurrencyUseCase instance.
+func NewUpdateBaseCurrencyUseCase(
+	userRepo adapter.UserRepository,
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	converter *Converter,
+) *UpdateBaseCurrencyUseCase {
+	return &UpdateBaseCurrencyUseCase{
+		userRepo:        userRepo,
+		transactionRepo: transactionRepo,
+		changeRepo:      changeRepo,
+		converter:       converter,
+	}
+}
+
+// Execute changes the base currency and re-snapshots the exchange rate of every existing transaction
+// into it. The change is rejected when a rate for any currency and date in use is missing.
+func (uc *UpdateBaseCurrencyUseCase) Execute(ctx context.Context, input UpdateBaseCurrencyInput) (*UpdateBaseCurrencyOutput, error) {
+	baseCurrency := NormalizeCurrency(input.BaseCurrency)
+	if err := ValidateCurrency(baseCurrency); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if user.BaseCurrency == baseCurrency {
+		return &UpdateBaseCurrencyOutput{
+			BaseCurrency: baseCurrency,
+		}, nil
+	}
+
+	// Resolve the rate into the new base currency for every currency and date in use
+	snapshots, err := uc.transactionRepo.FindExchangeRateSnapshots(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction currencies: %w", err)
+	}
+
+	for i := range snapshots {
+		rate, err := uc.converter.Rate(ctx, input.UserID, snapshots[i].Currency, baseCurrency, snapshots[i].Date)
+		if err != nil {
+			return nil, err
+		}
+		snapshots[i].Rate = rate
+	}
+
+	// Snapshot the transactions whose rate is rewritten, for the history
+	var before []*entity.Transaction
+	filter := adapter.TransactionFilter{UserID: input.UserID}
+	err = uc.transactionRepo.StreamByFilter(ctx, filter, valueobject.DefaultTransactionSort(), updateBaseCurrencyBatchSize,
+		func(batch []*entity.TransactionWithCategory) error {
+			for _, txnWithCat := range batch {
+				before = append(before, txnWithCat.Transaction)
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+
+	// Save the new base currency together with the recalculated rates
+	if err := uc.transactionRepo.UpdateBaseCurrency(ctx, input.UserID, baseCurrency, snapshots); err != nil {
+		return nil, fmt.Errorf("failed to update base currency: %w", err)
+	}
+
+	uc.recordRateChanges(ctx, input.UserID, before, snapshots)
+
+	return &UpdateBaseCurrencyOutput{
+		BaseCurrency:      baseCurrency,
+		RecalculatedCount: len(snapshots),
+	}, nil
+}
+
+// recordRateChanges records the rewritten exchange rates as a single operation in the transaction history.
+// The base currency is already saved, so a failure is logged instead of returned.
+func (uc *UpdateBaseCurrencyUseCase) recordRateChanges(
+	ctx context.Context,
+	userID uuid.UUID,
+	before []*entity.Transaction,
+	snapshots []entity.ExchangeRateSnapshot,
+) {
+	rates := make(map[string]entity.ExchangeRateSnapshot, len(snapshots))
+	for _, snapshot := range snapshots {
+		rates[snapshot.Currency+"|"+snapshot.Date.Format("2006-01-02")] = snapshot
+	}
+
+	operationID := uuid.New()
+	changes := make([]*entity.TransactionChange, 0, len(before))
+	for _, txn := range before {
+		snapshot, ok := rates[txn.Currency+"|"+txn.Date.Format("2006-01-02")]
+		if !ok {
+			continue
+		}
+		after := *txn
+		after.ExchangeRate = snapshot.Rate
+		if change := entity.NewTransactionChange(txn, &after, &userID, entity.TransactionChangeSourceManual, operationID); change != nil {
+			changes = append(changes, change)
+		}
+	}
+	if len(changes) == 0 {
+		return
+	}
+
+	if err := uc.changeRepo.CreateMany(ctx, changes); err != nil {
+		slog.Warn("Failed to record transaction changes",
+			"operation_id", operationID,
+			"count", len(changes),
+			"error", err,
+		)
+	}
+}
@@ -0,0 +1,185 @@
+// Package goal contains goal-related use cases.
+package goal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// MaxContributionNotesLength is the maximum length of contribution notes.
+const MaxContributionNotesLength = 1000
+
+// AddGoalContributionInput represents the input for adding a contribution to a savings goal.
+// When TransactionID is set, Amount defaults to the transaction's amount in the base currency and Date to its date.
+type AddGoalContributionInput struct {
+	GoalID        uuid.UUID
+	UserID        uuid.UUID
+	TransactionID *uuid.UUID // Optional, links an existing transaction
+	Amount        *float64   // Required for manual deposits
+	Date          *time.Time // Optional, defaults to today
+	Notes         string
+}
+
+// AddGoalContributionOutput represents the output of adding a contribution.
+type AddGoalContributionOutput struct {
+	Contribution *entity.GoalContribution
+}
+
+// AddGoalContributionUseCase handles adding contributions to savings goals.
+type AddGoalContributionUseCase struct {
+	goalRepo         adapter.GoalRepository
+	contributionRepo adapter.GoalContributionRepository
+	transactionRepo  adapter.TransactionRepository
+}
+
+// NewAddGoalContributionUseCase creates a new AddGoalContributionUseCase instance.
+func NewAddGoalContributionUseCase(
+	goalRepo adapter.GoalRepository,
+	contributionRepo adapter.GoalContributionRepository,
+	transactionRepo adapter.TransactionRepository,
+) *AddGoalContributionUseCase {
+	return &AddGoalContributionUseCase{
+		goalRepo:         goalRepo,
+		contributionRepo: contributionRepo,
+		transactionRepo:  transactionRepo,
+	}
+}
+
+// Execute performs the contribution creation.
+func (uc *AddGoalContributionUseCase) Execute(ctx context.Context, input AddGoalContributionInput) (*AddGoalContributionOutput, error) {
+	goal, err := findOwnedSavingsGoal(ctx, uc.goalRepo, input.GoalID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(input.Notes) > MaxContributionNotesLength {
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeContributionNotesTooLong,
+			fmt.Sprintf("notes must be at most %d characters", MaxContributionNotesLength),
+			domainerror.ErrContributionNotesTooLong,
+		)
+	}
+
+	var amount float64
+	date := time.Now().UTC()
+	if input.TransactionID != nil {
+		// Linked transactions default to their own amount, in the base currency, and date
+		transaction, err := uc.findOwnedTransaction(ctx, *input.TransactionID, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		exists, err := uc.contributionRepo.ExistsByGoalAndTransaction(ctx, goal.ID, transaction.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check contribution existence: %w", err)
+		}
+		if exists {
+			return nil, domainerror.NewGoalError(
+				domainerror.ErrCodeContributionAlreadyExists,
+				"transaction already contributes to this goal",
+				domainerror.ErrContributionAlreadyExists,
+			)
+		}
+
+		amount = transaction.BaseAmount().Abs().InexactFloat64()
+		date = transaction.Date
+	} else if input.Amount == nil {
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeMissingGoalFields,
+			"amount is required for manual contributions",
+			domainerror.ErrMissingGoalFields,
+		)
+	}
+
+	if input.Amount != nil {
+		amount = *input.Amount
+	}
+	if amount <= 0 {
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeInvalidContributionAmount,
+			"contribution amount must be greater than zero",
+			domainerror.ErrInvalidContributionAmount,
+		)
+	}
+
+	if input.Date != nil {
+		date = *input.Date
+	}
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	contribution := entity.NewGoalContribution(goal, amount, date, input.TransactionID, input.Notes)
+	if err := uc.contributionRepo.Create(ctx, contribution); err != nil {
+		return nil, fmt.Errorf("failed to create goal contribution: %w", err)
+	}
+
+	return &AddGoalContributionOutput{
+		Contribution: contribution,
+	}, nil
+}
+
+// findOwnedTransaction loads a transaction and checks that it belongs to the user.
+func (uc *AddGoalContributionUseCase) findOwnedTransaction(ctx context.Context, transactionID, userID uuid.UUID) (*entity.Transaction, error) {
+	transaction, err := uc.transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrTransactionNotFound) {
+			return nil, domainerror.NewGoalError(
+				domainerror.ErrCodeContributionTxNotFound,
+				"transaction not found",
+				domainerror.ErrContributionTransactionNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to find transaction: %w", err)
+	}
+
+	// Other users' transactions are reported as missing so their existence is not revealed
+	if transaction.UserID != userID {
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeContributionTxNotFound,
+			"transaction not found",
+			domainerror.ErrContributionTransactionNotFound,
+		)
+	}
+
+	return transaction, nil
+}
+
+// findOwnedSavingsGoal loads a goal, checks that it belongs to the user and is a savings goal.
+func findOwnedSavingsGoal(ctx context.Context, goalRepo adapter.GoalRepository, goalID, userID uuid.UUID) (*entity.Goal, error) {
+	goal, err := goalRepo.FindByID(ctx, goalID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrGoalNotFound) {
+			return nil, domainerror.NewGoalError(
+				domainerror.ErrCodeGoalNotFound,
+				"goal not found",
+				domainerror.ErrGoalNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to find goal: %w", err)
+	}
+
+	if goal.UserID != userID {
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeUnauthorizedGoalAccess,
+			"not authorized to access this goal",
+			domainerror.ErrUnauthorizedGoalAccess,
+		)
+	}
+
+	if !goal.IsSavings() {
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeGoalNotSavings,
+			"contributions are only supported for savings goals",
+			domainerror.ErrGoalNotSavings,
+		)
+	}
+
+	return goal, nil
+}
@@ -4,6 +4,8 @@ package goal
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -12,13 +14,22 @@ import (
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
 )
 
+// MaxGoalNameLength is the maximum length of a savings goal name.
+const MaxGoalNameLength = 100
+
 // CreateGoalInput represents the input for goal creation.
 type CreateGoalInput struct {
 	UserID        uuid.UUID
+	Kind          entity.GoalKind // Optional, defaults to spending_limit
 	CategoryID    uuid.UUID
 	LimitAmount   float64
 	AlertOnExceed *bool              // Optional, defaults to true
 	Period        *entity.GoalPeriod // Optional, defaults to monthly
+
+	// Savings goal fields
+	Name         string
+	TargetAmount float64
+	TargetDate   *time.Time // Optional
 }
 
 // CreateGoalOutput represents the output of goal creation.
@@ -42,6 +53,27 @@ func NewCreateGoalUseCase(goalRepo adapter.GoalRepository, categoryRepo adapter.
 
 // Execute performs the goal creation.
 func (uc *CreateGoalUseCase) Execute(ctx context.Context, input CreateGoalInput) (*CreateGoalOutput, error) {
+	switch input.Kind {
+	case entity.GoalKindSavings:
+		return uc.createSavingsGoal(ctx, input)
+	case "", entity.GoalKindSpendingLimit:
+	default:
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeInvalidGoalKind,
+			"kind must be 'spending_limit' or 'savings'",
+			domainerror.ErrInvalidGoalKind,
+		)
+	}
+
+	// Savings fields do not apply to spending limits
+	if input.Name != "" || input.TargetAmount != 0 || input.TargetDate != nil {
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeInvalidGoalKind,
+			"name, target_amount and target_date only apply to savings goals",
+			domainerror.ErrInvalidGoalKind,
+		)
+	}
+
 	// Validate limit amount
 	if input.LimitAmount <= 0 {
 		return nil, domainerror.NewGoalError(
@@ -51,6 +83,15 @@ func (uc *CreateGoalUseCase) Execute(ctx context.Context, input CreateGoalInput)
 		)
 	}
 
+	// Validate category is provided
+	if input.CategoryID == uuid.Nil {
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeMissingGoalFields,
+			"category_id is required for spending limit goals",
+			domainerror.ErrMissingGoalFields,
+		)
+	}
+
 	// Validate category exists
 	category, err := uc.categoryRepo.FindByID(ctx, input.CategoryID)
 	if err != nil {
@@ -121,6 +162,48 @@ func (uc *CreateGoalUseCase) Execute(ctx context.Context, input CreateGoalInput)
 	}, nil
 }
 
+// createSavingsGoal validates and creates a savings goal.
+func (uc *CreateGoalUseCase) createSavingsGoal(ctx context.Context, input CreateGoalInput) (*CreateGoalOutput, error) {
+	// Spending limit fields do not apply to savings goals
+	if input.CategoryID != uuid.Nil || input.LimitAmount != 0 || input.Period != nil {
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeInvalidGoalKind,
+			"category_id, limit_amount and period only apply to spending limit goals",
+			domainerror.ErrInvalidGoalKind,
+		)
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" || len(name) > MaxGoalNameLength {
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeMissingGoalFields,
+			fmt.Sprintf("name is required and must be at most %d characters", MaxGoalNameLength),
+			domainerror.ErrMissingGoalFields,
+		)
+	}
+
+	if input.TargetAmount <= 0 {
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeInvalidTargetAmount,
+			"target amount must be greater than zero",
+			domainerror.ErrInvalidTargetAmount,
+		)
+	}
+
+	goal := entity.NewSavingsGoal(input.UserID, name, input.TargetAmount, input.TargetDate)
+
+	// Savings goals have no spending to alert on
+	goal.AlertOnExceed = false
+
+	if err := uc.goalRepo.Create(ctx, goal); err != nil {
+		return nil, fmt.Errorf("failed to create goal: %w", err)
+	}
+
+	return &CreateGoalOutput{
+		Goal: goal,
+	}, nil
+}
+
 // isValidGoalPeriod validates the goal period.
 func isValidGoalPeriod(period entity.GoalPeriod) bool {
 	return period == entity.GoalPeriodMonthly ||
@@ -0,0 +1,70 @@
+// Package goal contains goal-related use cases.
+package goal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// DeleteGoalContributionInput represents the input for contribution deletion.
+type DeleteGoalContributionInput struct {
+	GoalID         uuid.UUID
+	ContributionID uuid.UUID
+	UserID         uuid.UUID
+}
+
+// DeleteGoalContributionOutput represents the output of contribution deletion.
+type DeleteGoalContributionOutput struct {
+	Success bool
+}
+
+// DeleteGoalContributionUseCase handles contribution deletion logic.
+// A linked transaction is kept; it simply stops counting towards the goal.
+type DeleteGoalContributionUseCase struct {
+	goalRepo         adapter.GoalRepository
+	contributionRepo adapter.GoalContributionRepository
+}
+
+// NewDeleteGoalContributionUseCase creates a new DeleteGoalContributionUseCase instance.
+func NewDeleteGoalContributionUseCase(
+	goalRepo adapter.GoalRepository,
+	contributionRepo adapter.GoalContributionRepository,
+) *DeleteGoalContributionUseCase {
+	return &DeleteGoalContributionUseCase{
+		goalRepo:         goalRepo,
+		contributionRepo: contributionRepo,
+	}
+}
+
+// Execute performs the contribution deletion.
+func (uc *DeleteGoalContributionUseCase) Execute(ctx context.Context, input DeleteGoalContributionInput) (*DeleteGoalContributionOutput, error) {
+	if _, err := findOwnedSavingsGoal(ctx, uc.goalRepo, input.GoalID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	contribution, err := uc.contributionRepo.FindByID(ctx, input.ContributionID)
+	if err != nil && !errors.Is(err, domainerror.ErrGoalContributionNotFound) {
+		return nil, fmt.Errorf("failed to find goal contribution: %w", err)
+	}
+	if contribution == nil || contribution.GoalID != input.GoalID {
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeContributionNotFound,
+			"goal contribution not found",
+			domainerror.ErrGoalContributionNotFound,
+		)
+	}
+
+	if err := uc.contributionRepo.Delete(ctx, contribution.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete goal contribution: %w", err)
+	}
+
+	return &DeleteGoalContributionOutput{
+		Success: true,
+	}, nil
+}
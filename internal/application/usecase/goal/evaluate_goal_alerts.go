@@ -0,0 +1,172 @@
+// Package goal contains goal-related use cases.
+package goal
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// EvaluateGoalAlertsInput represents the input for evaluating goal alerts.
+type EvaluateGoalAlertsInput struct {
+	UserID *uuid.UUID // Optional, evaluates every user's goals when nil
+	Now    time.Time
+}
+
+// EvaluateGoalAlertsOutput represents the output of evaluating goal alerts.
+type EvaluateGoalAlertsOutput struct {
+	GoalsEvaluated int
+	AlertsQueued   int
+}
+
+// EvaluateGoalAlertsUseCase compares spending in the current goal period against each goal
+// with alerts enabled and queues an email the first time a threshold is reached in the period.
+type EvaluateGoalAlertsUseCase struct {
+	goalRepo      adapter.GoalRepository
+	goalAlertRepo adapter.GoalAlertRepository
+	userRepo      adapter.UserRepository
+	categoryRepo  adapter.CategoryRepository
+	emailService  adapter.EmailService
+	txManager     adapter.TxManager
+}
+
+// NewEvaluateGoalAlertsUseCase creates a new EvaluateGoalAlertsUseCase instance.
+func NewEvaluateGoalAlertsUseCase(
+	goalRepo adapter.GoalRepository,
+	goalAlertRepo adapter.GoalAlertRepository,
+	userRepo adapter.UserRepository,
+	categoryRepo adapter.CategoryRepository,
+	emailService adapter.EmailService,
+	txManager adapter.TxManager,
+) *EvaluateGoalAlertsUseCase {
+	return &EvaluateGoalAlertsUseCase{
+		goalRepo:      goalRepo,
+		goalAlertRepo: goalAlertRepo,
+		userRepo:      userRepo,
+		categoryRepo:  categoryRepo,
+		emailService:  emailService,
+		txManager:     txManager,
+	}
+}
+
+// Execute performs the goal alert evaluation. Failures on individual goals are logged
+// and skipped so that one bad goal does not block the others.
+func (uc *EvaluateGoalAlertsUseCase) Execute(ctx context.Context, input EvaluateGoalAlertsInput) (*EvaluateGoalAlertsOutput, error) {
+	now := input.Now
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+
+	var goals []*entity.Goal
+	var err error
+	if input.UserID != nil {
+		goals, err = uc.goalRepo.FindByUserID(ctx, *input.UserID)
+	} else {
+		goals, err = uc.goalRepo.FindWithAlertsEnabled(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find goals: %w", err)
+	}
+
+	output := &EvaluateGoalAlertsOutput{}
+	users := make(map[uuid.UUID]*entity.User)
+
+	for _, g := range goals {
+		if !g.AlertOnExceed || g.IsSavings() {
+			continue
+		}
+
+		// Load each user once and respect their notification preference
+		user, ok := users[g.UserID]
+		if !ok {
+			user, err = uc.userRepo.FindByID(ctx, g.UserID)
+			if err != nil {
+				slog.Error("Failed to load user for goal alerts",
+					"user_id", g.UserID,
+					"error", err)
+			}
+			users[g.UserID] = user
+		}
+		if user == nil || !user.GoalAlerts {
+			continue
+		}
+
+		output.GoalsEvaluated++
+		queued, err := uc.evaluateGoal(ctx, g, user, now)
+		output.AlertsQueued += queued
+		if err != nil {
+			slog.Error("Failed to evaluate goal alert",
+				"goal_id", g.ID,
+				"error", err)
+		}
+	}
+
+	return output, nil
+}
+
+// evaluateGoal records and sends the highest newly reached threshold for the goal's current period.
+func (uc *EvaluateGoalAlertsUseCase) evaluateGoal(ctx context.Context, g *entity.Goal, user *entity.User, now time.Time) (int, error) {
+	startDate, endDate := calculatePeriodDatesAt(g.Period, g.StartDate, g.EndDate, now)
+	if now.Before(startDate) || now.After(endDate) {
+		return 0, nil
+	}
+
+	spent, err := uc.goalRepo.GetCurrentSpending(ctx, g.CategoryID, startDate, endDate)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get current spending: %w", err)
+	}
+
+	categoryName := ""
+	if category, err := uc.categoryRepo.FindByID(ctx, g.CategoryID); err == nil {
+		categoryName = category.Name
+	}
+
+	// Claim every reached threshold, so a jump straight past the limit does not
+	// send a late 80% alert, but only notify about the highest new one. The claims and
+	// the email are saved atomically, so a failed enqueue leaves the thresholds unclaimed
+	// and the alert is retried on the next evaluation.
+	var notify entity.GoalAlertThreshold
+	err = uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		for _, threshold := range g.ReachedThresholds(spent) {
+			created, err := uc.goalAlertRepo.CreateIfNotExists(ctx, entity.NewGoalAlert(g, threshold, startDate, spent))
+			if err != nil {
+				return fmt.Errorf("failed to record goal alert: %w", err)
+			}
+			if created {
+				notify = threshold
+			}
+		}
+		if notify == "" {
+			return nil
+		}
+
+		dateLayout := user.DateFormat.Layout()
+		if err := uc.emailService.QueueGoalAlertEmail(ctx, adapter.QueueGoalAlertInput{
+			UserEmail:    user.Email,
+			UserName:     user.Name,
+			Threshold:    notify,
+			CategoryName: categoryName,
+			LimitAmount:  user.NumberFormat.Format(decimal.NewFromFloat(g.LimitAmount)),
+			SpentAmount:  user.NumberFormat.Format(decimal.NewFromFloat(spent)),
+			Percentage:   int(math.Floor(spent / g.LimitAmount * 100)),
+			PeriodStart:  startDate.Format(dateLayout),
+			PeriodEnd:    endDate.Format(dateLayout),
+		}); err != nil {
+			return fmt.Errorf("failed to queue goal alert email: %w", err)
+		}
+		return nil
+	})
+	if err != nil || notify == "" {
+		return 0, err
+	}
+
+	return 1, nil
+}
@@ -5,11 +5,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/finance-tracker/backend/internal/application/adapter"
-	"github.com/finance-tracker/backend/internal/domain/entity"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
 )
 
@@ -21,22 +21,26 @@ type GetGoalInput struct {
 
 // GetGoalOutput represents the output of getting a goal.
 type GetGoalOutput struct {
-	Goal          *entity.Goal
-	Category      *entity.Category
-	CurrentAmount float64
+	Goal *GoalOutput
 }
 
 // GetGoalUseCase handles getting a goal by ID.
 type GetGoalUseCase struct {
-	goalRepo     adapter.GoalRepository
-	categoryRepo adapter.CategoryRepository
+	goalRepo         adapter.GoalRepository
+	categoryRepo     adapter.CategoryRepository
+	contributionRepo adapter.GoalContributionRepository
 }
 
 // NewGetGoalUseCase creates a new GetGoalUseCase instance.
-func NewGetGoalUseCase(goalRepo adapter.GoalRepository, categoryRepo adapter.CategoryRepository) *GetGoalUseCase {
+func NewGetGoalUseCase(
+	goalRepo adapter.GoalRepository,
+	categoryRepo adapter.CategoryRepository,
+	contributionRepo adapter.GoalContributionRepository,
+) *GetGoalUseCase {
 	return &GetGoalUseCase{
-		goalRepo:     goalRepo,
-		categoryRepo: categoryRepo,
+		goalRepo:         goalRepo,
+		categoryRepo:     categoryRepo,
+		contributionRepo: contributionRepo,
 	}
 }
 
@@ -64,24 +68,7 @@ func (uc *GetGoalUseCase) Execute(ctx context.Context, input GetGoalInput) (*Get
 		)
 	}
 
-	// Fetch category for this goal
-	category, err := uc.categoryRepo.FindByID(ctx, goal.CategoryID)
-	if err != nil {
-		category = nil
-	}
-
-	// Calculate period dates
-	startDate, endDate := calculatePeriodDates(goal.Period, goal.StartDate, goal.EndDate)
-
-	// Get current spending for this category within the period
-	currentAmount, err := uc.goalRepo.GetCurrentSpending(ctx, goal.CategoryID, startDate, endDate)
-	if err != nil {
-		currentAmount = 0
-	}
-
 	return &GetGoalOutput{
-		Goal:          goal,
-		Category:      category,
-		CurrentAmount: currentAmount,
+		Goal: buildGoalOutput(ctx, uc.goalRepo, uc.categoryRepo, uc.contributionRepo, goal, time.Now().UTC()),
 	}, nil
 }
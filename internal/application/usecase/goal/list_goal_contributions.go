@@ -0,0 +1,60 @@
+// Package goal contains goal-related use cases.
+package goal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ListGoalContributionsInput represents the input for listing a savings goal's contributions.
+type ListGoalContributionsInput struct {
+	GoalID uuid.UUID
+	UserID uuid.UUID
+}
+
+// ListGoalContributionsOutput represents the output of listing contributions.
+type ListGoalContributionsOutput struct {
+	Contributions []*entity.GoalContribution
+	Progress      entity.SavingsProgress
+}
+
+// ListGoalContributionsUseCase handles listing contributions of a savings goal.
+type ListGoalContributionsUseCase struct {
+	goalRepo         adapter.GoalRepository
+	contributionRepo adapter.GoalContributionRepository
+}
+
+// NewListGoalContributionsUseCase creates a new ListGoalContributionsUseCase instance.
+func NewListGoalContributionsUseCase(
+	goalRepo adapter.GoalRepository,
+	contributionRepo adapter.GoalContributionRepository,
+) *ListGoalContributionsUseCase {
+	return &ListGoalContributionsUseCase{
+		goalRepo:         goalRepo,
+		contributionRepo: contributionRepo,
+	}
+}
+
+// Execute performs the contribution listing.
+func (uc *ListGoalContributionsUseCase) Execute(ctx context.Context, input ListGoalContributionsInput) (*ListGoalContributionsOutput, error) {
+	goal, err := findOwnedSavingsGoal(ctx, uc.goalRepo, input.GoalID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	contributions, err := uc.contributionRepo.FindByGoalID(ctx, goal.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find goal contributions: %w", err)
+	}
+
+	return &ListGoalContributionsOutput{
+		Contributions: contributions,
+		Progress:      goal.SavingsProgressAt(contributions, time.Now().UTC()),
+	}, nil
+}
@@ -3,6 +3,7 @@ package goal
 
 import (
 	"context"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -22,32 +23,45 @@ type ListGoalsOutput struct {
 }
 
 // GoalOutput represents a single goal in the output.
+// CurrentAmount is the spending within the period for spending limits and the saved amount for savings goals.
 type GoalOutput struct {
-	ID            uuid.UUID
-	UserID        uuid.UUID
-	CategoryID    uuid.UUID
-	Category      *entity.Category
-	LimitAmount   float64
-	CurrentAmount float64
-	AlertOnExceed bool
-	Period        entity.GoalPeriod
-	StartDate     *time.Time
-	EndDate       *time.Time
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	ID                      uuid.UUID
+	UserID                  uuid.UUID
+	Kind                    entity.GoalKind
+	CategoryID              uuid.UUID
+	Category                *entity.Category
+	LimitAmount             float64
+	CurrentAmount           float64
+	ProgressPercent         float64
+	AlertOnExceed           bool
+	Period                  entity.GoalPeriod
+	StartDate               *time.Time
+	EndDate                 *time.Time
+	Name                    string
+	TargetAmount            float64
+	TargetDate              *time.Time
+	ProjectedCompletionDate *time.Time // Savings goals only
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
 }
 
 // ListGoalsUseCase handles listing goals logic.
 type ListGoalsUseCase struct {
-	goalRepo     adapter.GoalRepository
-	categoryRepo adapter.CategoryRepository
+	goalRepo         adapter.GoalRepository
+	categoryRepo     adapter.CategoryRepository
+	contributionRepo adapter.GoalContributionRepository
 }
 
 // NewListGoalsUseCase creates a new ListGoalsUseCase instance.
-func NewListGoalsUseCase(goalRepo adapter.GoalRepository, categoryRepo adapter.CategoryRepository) *ListGoalsUseCase {
+func NewListGoalsUseCase(
+	goalRepo adapter.GoalRepository,
+	categoryRepo adapter.CategoryRepository,
+	contributionRepo adapter.GoalContributionRepository,
+) *ListGoalsUseCase {
 	return &ListGoalsUseCase{
-		goalRepo:     goalRepo,
-		categoryRepo: categoryRepo,
+		goalRepo:         goalRepo,
+		categoryRepo:     categoryRepo,
+		contributionRepo: contributionRepo,
 	}
 }
 
@@ -62,48 +76,80 @@ func (uc *ListGoalsUseCase) Execute(ctx context.Context, input ListGoalsInput) (
 		Goals: make([]*GoalOutput, 0, len(goals)),
 	}
 
+	now := time.Now().UTC()
 	for _, g := range goals {
-		// Fetch category for this goal
-		cat, err := uc.categoryRepo.FindByID(ctx, g.CategoryID)
-		if err != nil {
-			// If category not found, skip this goal or continue without category
-			cat = nil
-		}
+		output.Goals = append(output.Goals, buildGoalOutput(ctx, uc.goalRepo, uc.categoryRepo, uc.contributionRepo, g, now))
+	}
+
+	return output, nil
+}
 
-		// Calculate period dates
-		startDate, endDate := calculatePeriodDates(g.Period, g.StartDate, g.EndDate)
+// buildGoalOutput loads the category and progress of a goal.
+// Lookup failures leave the affected fields empty rather than failing the request.
+func buildGoalOutput(
+	ctx context.Context,
+	goalRepo adapter.GoalRepository,
+	categoryRepo adapter.CategoryRepository,
+	contributionRepo adapter.GoalContributionRepository,
+	g *entity.Goal,
+	now time.Time,
+) *GoalOutput {
+	goalOutput := &GoalOutput{
+		ID:            g.ID,
+		UserID:        g.UserID,
+		Kind:          g.Kind,
+		CategoryID:    g.CategoryID,
+		LimitAmount:   g.LimitAmount,
+		AlertOnExceed: g.AlertOnExceed,
+		Period:        g.Period,
+		StartDate:     g.StartDate,
+		EndDate:       g.EndDate,
+		Name:          g.Name,
+		TargetAmount:  g.TargetAmount,
+		TargetDate:    g.TargetDate,
+		CreatedAt:     g.CreatedAt,
+		UpdatedAt:     g.UpdatedAt,
+	}
 
-		// Get current spending for this category within the period
-		currentAmount, err := uc.goalRepo.GetCurrentSpending(ctx, g.CategoryID, startDate, endDate)
+	if g.IsSavings() {
+		contributions, err := contributionRepo.FindByGoalID(ctx, g.ID)
 		if err != nil {
-			currentAmount = 0
+			contributions = nil
 		}
 
-		goalOutput := &GoalOutput{
-			ID:            g.ID,
-			UserID:        g.UserID,
-			CategoryID:    g.CategoryID,
-			Category:      cat,
-			LimitAmount:   g.LimitAmount,
-			CurrentAmount: currentAmount,
-			AlertOnExceed: g.AlertOnExceed,
-			Period:        g.Period,
-			StartDate:     g.StartDate,
-			EndDate:       g.EndDate,
-			CreatedAt:     g.CreatedAt,
-			UpdatedAt:     g.UpdatedAt,
-		}
+		progress := g.SavingsProgressAt(contributions, now)
+		goalOutput.CurrentAmount = progress.SavedAmount
+		goalOutput.ProgressPercent = progress.ProgressPercent
+		goalOutput.ProjectedCompletionDate = progress.ProjectedCompletionDate
+		return goalOutput
+	}
 
-		output.Goals = append(output.Goals, goalOutput)
+	// Fetch category for this goal
+	cat, err := categoryRepo.FindByID(ctx, g.CategoryID)
+	if err != nil {
+		// If category not found, continue without category
+		cat = nil
 	}
+	goalOutput.Category = cat
 
-	return output, nil
-}
+	// Calculate period dates
+	startDate, endDate := calculatePeriodDatesAt(g.Period, g.StartDate, g.EndDate, now)
 
-// calculatePeriodDates calculates the start and end dates for a goal period.
-func calculatePeriodDates(period entity.GoalPeriod, customStart, customEnd *time.Time) (time.Time, time.Time) {
-	now := time.Now().UTC()
+	// Get current spending for this category within the period
+	currentAmount, err := goalRepo.GetCurrentSpending(ctx, g.CategoryID, startDate, endDate)
+	if err != nil {
+		currentAmount = 0
+	}
+	goalOutput.CurrentAmount = currentAmount
+	if g.LimitAmount > 0 {
+		goalOutput.ProgressPercent = math.Round(currentAmount/g.LimitAmount*10000) / 100
+	}
+
+	return goalOutput
+}
 
+// calculatePeriodDatesAt calculates the start and end dates for the goal period containing now.
+func calculatePeriodDatesAt(period entity.GoalPeriod, customStart, customEnd *time.Time, now time.Time) (time.Time, time.Time) {
 	// If custom dates are provided, use them
 	if customStart != nil && customEnd != nil {
 		return *customStart, *customEnd
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,6 +22,12 @@ type UpdateGoalInput struct {
 	LimitAmount   *float64           // Optional
 	AlertOnExceed *bool              // Optional
 	Period        *entity.GoalPeriod // Optional
+
+	// Savings goal fields
+	Name            *string    // Optional
+	TargetAmount    *float64   // Optional
+	TargetDate      *time.Time // Optional
+	ClearTargetDate bool       // Removes the target date
 }
 
 // UpdateGoalOutput represents the output of goal update.
@@ -64,6 +71,55 @@ func (uc *UpdateGoalUseCase) Execute(ctx context.Context, input UpdateGoalInput)
 		)
 	}
 
+	// Only fields of the goal's kind can be updated
+	if goal.IsSavings() {
+		if input.LimitAmount != nil || input.Period != nil {
+			return nil, domainerror.NewGoalError(
+				domainerror.ErrCodeInvalidGoalKind,
+				"limit_amount and period only apply to spending limit goals",
+				domainerror.ErrInvalidGoalKind,
+			)
+		}
+	} else if input.Name != nil || input.TargetAmount != nil || input.TargetDate != nil || input.ClearTargetDate {
+		return nil, domainerror.NewGoalError(
+			domainerror.ErrCodeInvalidGoalKind,
+			"name, target_amount and target_date only apply to savings goals",
+			domainerror.ErrInvalidGoalKind,
+		)
+	}
+
+	// Update name if provided
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" || len(name) > MaxGoalNameLength {
+			return nil, domainerror.NewGoalError(
+				domainerror.ErrCodeMissingGoalFields,
+				fmt.Sprintf("name is required and must be at most %d characters", MaxGoalNameLength),
+				domainerror.ErrMissingGoalFields,
+			)
+		}
+		goal.Name = name
+	}
+
+	// Update target amount if provided
+	if input.TargetAmount != nil {
+		if *input.TargetAmount <= 0 {
+			return nil, domainerror.NewGoalError(
+				domainerror.ErrCodeInvalidTargetAmount,
+				"target amount must be greater than zero",
+				domainerror.ErrInvalidTargetAmount,
+			)
+		}
+		goal.TargetAmount = *input.TargetAmount
+	}
+
+	// Update target date if provided
+	if input.ClearTargetDate {
+		goal.TargetDate = nil
+	} else if input.TargetDate != nil {
+		goal.TargetDate = input.TargetDate
+	}
+
 	// Update limit amount if provided
 	if input.LimitAmount != nil {
 		if *input.LimitAmount <= 0 {
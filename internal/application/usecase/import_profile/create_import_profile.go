@@ -0,0 +1,208 @@
+// Package importprofile contains import profile-related use cases.
+package importprofile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+const (
+	// MaxProfileNameLength is the maximum allowed length for import profile names.
+	MaxProfileNameLength = 100
+	// MaxColumnReferenceLength is the maximum allowed length for a column name or index.
+	MaxColumnReferenceLength = 100
+	// MaxSkipRows is the maximum number of leading lines that can be skipped.
+	MaxSkipRows = 50
+)
+
+// CreateImportProfileInput represents the input for import profile creation.
+type CreateImportProfileInput struct {
+	UserID            uuid.UUID
+	Name              string
+	Delimiter         string
+	HasHeader         *bool // Optional, defaults to true
+	SkipRows          int
+	DateColumn        string
+	DescriptionColumn string
+	AmountColumn      string
+	DebitColumn       string
+	CreditColumn      string
+	InstallmentColumn string
+	DateFormat        entity.DateFormat   // Optional, empty uses the user's preference
+	NumberFormat      entity.NumberFormat // Optional, empty uses the user's preference
+	InvertAmounts     bool
+}
+
+// CreateImportProfileOutput represents the output of import profile creation.
+type CreateImportProfileOutput struct {
+	Profile *entity.ImportProfile
+}
+
+// CreateImportProfileUseCase handles import profile creation logic.
+type CreateImportProfileUseCase struct {
+	profileRepo adapter.ImportProfileRepository
+}
+
+// NewCreateImportProfileUseCase creates a new CreateImportProfileUseCase instance.
+func NewCreateImportProfileUseCase(profileRepo adapter.ImportProfileRepository) *CreateImportProfileUseCase {
+	return &CreateImportProfileUseCase{
+		profileRepo: profileRepo,
+	}
+}
+
+// Execute performs the import profile creation.
+func (uc *CreateImportProfileUseCase) Execute(ctx context.Context, input CreateImportProfileInput) (*CreateImportProfileOutput, error) {
+	name := strings.TrimSpace(input.Name)
+
+	// Build profile
+	profile := entity.NewImportProfile(input.UserID, name)
+	profile.Delimiter = input.Delimiter
+	if input.HasHeader != nil {
+		profile.HasHeader = *input.HasHeader
+	}
+	profile.SkipRows = input.SkipRows
+	profile.DateColumn = strings.TrimSpace(input.DateColumn)
+	profile.DescriptionColumn = strings.TrimSpace(input.DescriptionColumn)
+	profile.AmountColumn = strings.TrimSpace(input.AmountColumn)
+	profile.DebitColumn = strings.TrimSpace(input.DebitColumn)
+	profile.CreditColumn = strings.TrimSpace(input.CreditColumn)
+	profile.InstallmentColumn = strings.TrimSpace(input.InstallmentColumn)
+	profile.DateFormat = input.DateFormat
+	profile.NumberFormat = input.NumberFormat
+	profile.InvertAmounts = input.InvertAmounts
+
+	// Validate profile
+	if err := ValidateImportProfile(profile); err != nil {
+		return nil, err
+	}
+
+	// Check if name already exists for this user
+	exists, err := uc.profileRepo.ExistsByNameAndUser(ctx, name, input.UserID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check profile name existence: %w", err)
+	}
+	if exists {
+		return nil, domainerror.NewImportProfileError(
+			domainerror.ErrCodeImportProfileNameExists,
+			"an import profile with this name already exists",
+			domainerror.ErrImportProfileNameExists,
+		)
+	}
+
+	// Save profile
+	if err := uc.profileRepo.Create(ctx, profile); err != nil {
+		return nil, fmt.Errorf("failed to create import profile: %w", err)
+	}
+
+	return &CreateImportProfileOutput{
+		Profile: profile,
+	}, nil
+}
+
+// ValidateImportProfile checks that a profile has a name, a complete column mapping and supported formats.
+func ValidateImportProfile(profile *entity.ImportProfile) error {
+	if profile.Name == "" {
+		return domainerror.NewImportProfileError(
+			domainerror.ErrCodeImportProfileMissingFields,
+			"name is required",
+			domainerror.ErrImportProfileMissingFields,
+		)
+	}
+	if len(profile.Name) > MaxProfileNameLength {
+		return domainerror.NewImportProfileError(
+			domainerror.ErrCodeImportProfileMissingFields,
+			fmt.Sprintf("name must not exceed %d characters", MaxProfileNameLength),
+			domainerror.ErrImportProfileMissingFields,
+		)
+	}
+
+	return ValidateColumnMapping(profile)
+}
+
+// ValidateColumnMapping checks the file layout, column mapping and formats of a profile.
+// It is also used for one-off mappings that are not saved as a profile.
+func ValidateColumnMapping(profile *entity.ImportProfile) error {
+	switch profile.Delimiter {
+	case "", ",", ";", "\t", "|":
+	default:
+		return domainerror.NewImportProfileError(
+			domainerror.ErrCodeInvalidDelimiter,
+			"delimiter must be one of ',', ';', '|' or tab",
+			domainerror.ErrInvalidDelimiter,
+		)
+	}
+
+	if profile.SkipRows < 0 || profile.SkipRows > MaxSkipRows {
+		return domainerror.NewImportProfileError(
+			domainerror.ErrCodeInvalidColumnMapping,
+			fmt.Sprintf("skip_rows must be between 0 and %d", MaxSkipRows),
+			domainerror.ErrInvalidColumnMapping,
+		)
+	}
+
+	if profile.DateColumn == "" || profile.DescriptionColumn == "" {
+		return domainerror.NewImportProfileError(
+			domainerror.ErrCodeInvalidColumnMapping,
+			"date and description columns are required",
+			domainerror.ErrInvalidColumnMapping,
+		)
+	}
+
+	hasDebitCredit := profile.DebitColumn != "" || profile.CreditColumn != ""
+	if profile.AmountColumn == "" && !hasDebitCredit {
+		return domainerror.NewImportProfileError(
+			domainerror.ErrCodeInvalidColumnMapping,
+			"either an amount column or debit/credit columns are required",
+			domainerror.ErrInvalidColumnMapping,
+		)
+	}
+	if profile.AmountColumn != "" && hasDebitCredit {
+		return domainerror.NewImportProfileError(
+			domainerror.ErrCodeInvalidColumnMapping,
+			"amount column cannot be combined with debit/credit columns",
+			domainerror.ErrInvalidColumnMapping,
+		)
+	}
+
+	for _, column := range []string{
+		profile.DateColumn,
+		profile.DescriptionColumn,
+		profile.AmountColumn,
+		profile.DebitColumn,
+		profile.CreditColumn,
+		profile.InstallmentColumn,
+	} {
+		if len(column) > MaxColumnReferenceLength {
+			return domainerror.NewImportProfileError(
+				domainerror.ErrCodeInvalidColumnMapping,
+				fmt.Sprintf("column references must not exceed %d characters", MaxColumnReferenceLength),
+				domainerror.ErrInvalidColumnMapping,
+			)
+		}
+	}
+
+	if profile.DateFormat != "" && !entity.IsValidDateFormat(profile.DateFormat) {
+		return domainerror.NewImportProfileError(
+			domainerror.ErrCodeInvalidImportDateFormat,
+			"date_format must be one of DD/MM/YYYY, MM/DD/YYYY or YYYY-MM-DD",
+			domainerror.ErrInvalidImportDateFormat,
+		)
+	}
+
+	if profile.NumberFormat != "" && !entity.IsValidNumberFormat(profile.NumberFormat) {
+		return domainerror.NewImportProfileError(
+			domainerror.ErrCodeInvalidImportNumberFormat,
+			"number_format must be BR or US",
+			domainerror.ErrInvalidImportNumberFormat,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Package importprofile contains import profile-related use cases.
+package importprofile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+// DeleteImportProfileInput represents the input for import profile deletion.
+type DeleteImportProfileInput struct {
+	ProfileID uuid.UUID
+	UserID    uuid.UUID
+}
+
+// DeleteImportProfileOutput represents the output of import profile deletion.
+type DeleteImportProfileOutput struct {
+	Success bool
+}
+
+// DeleteImportProfileUseCase handles import profile deletion logic.
+type DeleteImportProfileUseCase struct {
+	profileRepo adapter.ImportProfileRepository
+}
+
+// NewDeleteImportProfileUseCase creates a new DeleteImportProfileUseCase instance.
+func NewDeleteImportProfileUseCase(profileRepo adapter.ImportProfileRepository) *DeleteImportProfileUseCase {
+	return &DeleteImportProfileUseCase{
+		profileRepo: profileRepo,
+	}
+}
+
+// Execute performs the import profile deletion.
+func (uc *DeleteImportProfileUseCase) Execute(ctx context.Context, input DeleteImportProfileInput) (*DeleteImportProfileOutput, error) {
+	// Find the existing profile and check ownership
+	if _, err := findOwnedProfile(ctx, uc.profileRepo, input.ProfileID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	// Delete the profile
+	if err := uc.profileRepo.Delete(ctx, input.ProfileID); err != nil {
+		return nil, fmt.Errorf("failed to delete import profile: %w", err)
+	}
+
+	return &DeleteImportProfileOutput{
+		Success: true,
+	}, nil
+}
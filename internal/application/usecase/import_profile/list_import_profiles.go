@@ -0,0 +1,46 @@
+// Package importprofile contains import profile-related use cases.
+package importprofile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ListImportProfilesInput represents the input for listing import profiles.
+type ListImportProfilesInput struct {
+	UserID uuid.UUID
+}
+
+// ListImportProfilesOutput represents the output of listing import profiles.
+type ListImportProfilesOutput struct {
+	Profiles []*entity.ImportProfile
+}
+
+// ListImportProfilesUseCase handles listing import profiles logic.
+type ListImportProfilesUseCase struct {
+	profileRepo adapter.ImportProfileRepository
+}
+
+// NewListImportProfilesUseCase creates a new ListImportProfilesUseCase instance.
+func NewListImportProfilesUseCase(profileRepo adapter.ImportProfileRepository) *ListImportProfilesUseCase {
+	return &ListImportProfilesUseCase{
+		profileRepo: profileRepo,
+	}
+}
+
+// Execute performs the import profiles listing.
+func (uc *ListImportProfilesUseCase) Execute(ctx context.Context, input ListImportProfilesInput) (*ListImportProfilesOutput, error) {
+	profiles, err := uc.profileRepo.FindByUser(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import profiles: %w", err)
+	}
+
+	return &ListImportProfilesOutput{
+		Profiles: profiles,
+	}, nil
+}
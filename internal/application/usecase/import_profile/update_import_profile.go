@@ -0,0 +1,164 @@
+// Package importprofile contains import profile-related use cases.
+package importprofile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// UpdateImportProfileInput represents the input for import profile update.
+// Nil fields are left unchanged; an empty string clears optional columns.
+type UpdateImportProfileInput struct {
+	ProfileID         uuid.UUID
+	UserID            uuid.UUID
+	Name              *string
+	Delimiter         *string
+	HasHeader         *bool
+	SkipRows          *int
+	DateColumn        *string
+	DescriptionColumn *string
+	AmountColumn      *string
+	DebitColumn       *string
+	CreditColumn      *string
+	InstallmentColumn *string
+	DateFormat        *entity.DateFormat
+	NumberFormat      *entity.NumberFormat
+	InvertAmounts     *bool
+}
+
+// UpdateImportProfileOutput represents the output of import profile update.
+type UpdateImportProfileOutput struct {
+	Profile *entity.ImportProfile
+}
+
+// UpdateImportProfileUseCase handles import profile update logic.
+type UpdateImportProfileUseCase struct {
+	profileRepo adapter.ImportProfileRepository
+}
+
+// NewUpdateImportProfileUseCase creates a new UpdateImportProfileUseCase instance.
+func NewUpdateImportProfileUseCase(profileRepo adapter.ImportProfileRepository) *UpdateImportProfileUseCase {
+	return &UpdateImportProfileUseCase{
+		profileRepo: profileRepo,
+	}
+}
+
+// Execute performs the import profile update.
+func (uc *UpdateImportProfileUseCase) Execute(ctx context.Context, input UpdateImportProfileInput) (*UpdateImportProfileOutput, error) {
+	// Find the existing profile
+	profile, err := findOwnedProfile(ctx, uc.profileRepo, input.ProfileID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply changes
+	if input.Name != nil {
+		profile.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Delimiter != nil {
+		profile.Delimiter = *input.Delimiter
+	}
+	if input.HasHeader != nil {
+		profile.HasHeader = *input.HasHeader
+	}
+	if input.SkipRows != nil {
+		profile.SkipRows = *input.SkipRows
+	}
+	if input.DateColumn != nil {
+		profile.DateColumn = strings.TrimSpace(*input.DateColumn)
+	}
+	if input.DescriptionColumn != nil {
+		profile.DescriptionColumn = strings.TrimSpace(*input.DescriptionColumn)
+	}
+	if input.AmountColumn != nil {
+		profile.AmountColumn = strings.TrimSpace(*input.AmountColumn)
+	}
+	if input.DebitColumn != nil {
+		profile.DebitColumn = strings.TrimSpace(*input.DebitColumn)
+	}
+	if input.CreditColumn != nil {
+		profile.CreditColumn = strings.TrimSpace(*input.CreditColumn)
+	}
+	if input.InstallmentColumn != nil {
+		profile.InstallmentColumn = strings.TrimSpace(*input.InstallmentColumn)
+	}
+	if input.DateFormat != nil {
+		profile.DateFormat = *input.DateFormat
+	}
+	if input.NumberFormat != nil {
+		profile.NumberFormat = *input.NumberFormat
+	}
+	if input.InvertAmounts != nil {
+		profile.InvertAmounts = *input.InvertAmounts
+	}
+
+	// Validate the resulting profile
+	if err := ValidateImportProfile(profile); err != nil {
+		return nil, err
+	}
+
+	// Check if the new name is taken by another profile
+	if input.Name != nil {
+		exists, err := uc.profileRepo.ExistsByNameAndUser(ctx, profile.Name, input.UserID, &profile.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check profile name existence: %w", err)
+		}
+		if exists {
+			return nil, domainerror.NewImportProfileError(
+				domainerror.ErrCodeImportProfileNameExists,
+				"an import profile with this name already exists",
+				domainerror.ErrImportProfileNameExists,
+			)
+		}
+	}
+
+	profile.UpdatedAt = time.Now().UTC()
+
+	// Save updated profile
+	if err := uc.profileRepo.Update(ctx, profile); err != nil {
+		return nil, fmt.Errorf("failed to update import profile: %w", err)
+	}
+
+	return &UpdateImportProfileOutput{
+		Profile: profile,
+	}, nil
+}
+
+// findOwnedProfile loads a profile and verifies it belongs to the user.
+func findOwnedProfile(
+	ctx context.Context,
+	profileRepo adapter.ImportProfileRepository,
+	profileID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.ImportProfile, error) {
+	profile, err := profileRepo.FindByID(ctx, profileID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrImportProfileNotFound) {
+			return nil, domainerror.NewImportProfileError(
+				domainerror.ErrCodeImportProfileNotFound,
+				"import profile not found",
+				domainerror.ErrImportProfileNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to find import profile: %w", err)
+	}
+
+	if profile.UserID != userID {
+		return nil, domainerror.NewImportProfileError(
+			domainerror.ErrCodeNotAuthorizedImportProfile,
+			"not authorized to access this import profile",
+			domainerror.ErrNotAuthorizedImportProfile,
+		)
+	}
+
+	return profile, nil
+}
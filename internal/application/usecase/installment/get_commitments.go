@@ -0,0 +1,159 @@
+// Package installment contains installment-related use cases.
+package installment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+const (
+	// DefaultCommitmentMonths is the number of billing cycles projected when none is given.
+	DefaultCommitmentMonths = 12
+	// MaxCommitmentMonths is the maximum number of billing cycles projected.
+	MaxCommitmentMonths = 36
+)
+
+// GetCommitmentsInput represents the input for projecting future installment commitments.
+type GetCommitmentsInput struct {
+	UserID    uuid.UUID
+	AccountID *uuid.UUID // Optional - only the plans of this credit card are projected
+	FromCycle string     // First billing cycle projected, "YYYY-MM"; defaults to the current month
+	Months    int        // Number of billing cycles projected, defaults to DefaultCommitmentMonths
+}
+
+// CommittedInstallment represents an outstanding installment charged in a billing cycle.
+type CommittedInstallment struct {
+	PlanID            uuid.UUID
+	Description       string
+	InstallmentNumber int
+	TotalInstallments int
+	Amount            decimal.Decimal
+}
+
+// CommitmentMonth represents the part of a billing cycle's bill locked in by installments.
+type CommitmentMonth struct {
+	BillingCycle string
+	Amount       decimal.Decimal
+	Installments []CommittedInstallment // Oldest purchase first
+}
+
+// GetCommitmentsOutput represents the output of projecting future installment commitments.
+type GetCommitmentsOutput struct {
+	Currency       string // Base currency the amounts are in
+	TotalCommitted decimal.Decimal
+	Months         []CommitmentMonth
+}
+
+// GetCommitmentsUseCase handles projecting outstanding installments onto future bills.
+type GetCommitmentsUseCase struct {
+	planRepo  adapter.InstallmentPlanRepository
+	converter *exchangerate.Converter
+}
+
+// NewGetCommitmentsUseCase creates a new GetCommitmentsUseCase instance.
+func NewGetCommitmentsUseCase(
+	planRepo adapter.InstallmentPlanRepository,
+	converter *exchangerate.Converter,
+) *GetCommitmentsUseCase {
+	return &GetCommitmentsUseCase{
+		planRepo:  planRepo,
+		converter: converter,
+	}
+}
+
+// Execute returns, for each billing cycle from FromCycle on, the installments not recorded yet
+// that will be charged in it. Installments after the last recorded one of each plan are
+// outstanding; cycles without outstanding installments are included with a zero amount.
+func (uc *GetCommitmentsUseCase) Execute(ctx context.Context, input GetCommitmentsInput) (*GetCommitmentsOutput, error) {
+	// Apply defaults
+	if input.FromCycle == "" {
+		input.FromCycle = time.Now().UTC().Format("2006-01")
+	}
+	if input.Months == 0 {
+		input.Months = DefaultCommitmentMonths
+	}
+
+	// Validate input
+	if entity.ShiftBillingCycle(input.FromCycle, 0) != input.FromCycle {
+		return nil, domainerror.NewInstallmentError(
+			domainerror.ErrCodeInvalidCommitmentStart,
+			"from must be in YYYY-MM format",
+			domainerror.ErrInvalidCommitmentStart,
+		)
+	}
+	if input.Months < 1 || input.Months > MaxCommitmentMonths {
+		return nil, domainerror.NewInstallmentError(
+			domainerror.ErrCodeInvalidCommitmentMonths,
+			fmt.Sprintf("months must be between 1 and %d", MaxCommitmentMonths),
+			domainerror.ErrInvalidCommitmentMonths,
+		)
+	}
+
+	var plans []*entity.InstallmentPlan
+	var err error
+	if input.AccountID != nil {
+		plans, err = uc.planRepo.FindByAccount(ctx, input.UserID, input.AccountID)
+	} else {
+		plans, err = uc.planRepo.FindByUser(ctx, input.UserID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list installment plans: %w", err)
+	}
+
+	recorded, err := uc.planRepo.FindRecordedInstallments(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recorded installments: %w", err)
+	}
+
+	currency := entity.DefaultCurrency
+	if uc.converter != nil {
+		if currency, err = uc.converter.BaseCurrency(ctx, input.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	output := &GetCommitmentsOutput{
+		Currency:       currency,
+		TotalCommitted: decimal.Zero,
+		Months:         make([]CommitmentMonth, input.Months),
+	}
+	for i := range output.Months {
+		output.Months[i] = CommitmentMonth{
+			BillingCycle: entity.ShiftBillingCycle(input.FromCycle, i),
+			Amount:       decimal.Zero,
+			Installments: []CommittedInstallment{},
+		}
+	}
+
+	// Plans are sorted by first billing cycle and description, so installments stay in that order
+	for _, plan := range plans {
+		last := lastInstallment(recorded[plan.ID])
+		for i := range output.Months {
+			month := &output.Months[i]
+			number := plan.InstallmentNumberIn(month.BillingCycle)
+			if number <= last {
+				continue
+			}
+			month.Installments = append(month.Installments, CommittedInstallment{
+				PlanID:            plan.ID,
+				Description:       plan.Description,
+				InstallmentNumber: number,
+				TotalInstallments: plan.TotalInstallments,
+				Amount:            plan.InstallmentAmount,
+			})
+			month.Amount = month.Amount.Add(plan.InstallmentAmount)
+			output.TotalCommitted = output.TotalCommitted.Add(plan.InstallmentAmount)
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,78 @@
+// Package installment contains installment-related use cases.
+package installment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// GetPlanInput represents the input for getting an installment plan.
+type GetPlanInput struct {
+	PlanID uuid.UUID
+	UserID uuid.UUID
+}
+
+// GetPlanOutput represents an installment plan with the transactions of its installments.
+type GetPlanOutput struct {
+	Plan         *PlanOutput
+	Installments []*entity.Transaction // Ordered by installment number
+}
+
+// GetPlanUseCase handles getting an installment plan logic.
+type GetPlanUseCase struct {
+	planRepo adapter.InstallmentPlanRepository
+}
+
+// NewGetPlanUseCase creates a new GetPlanUseCase instance.
+func NewGetPlanUseCase(planRepo adapter.InstallmentPlanRepository) *GetPlanUseCase {
+	return &GetPlanUseCase{
+		planRepo: planRepo,
+	}
+}
+
+// Execute retrieves the plan and its installments.
+func (uc *GetPlanUseCase) Execute(ctx context.Context, input GetPlanInput) (*GetPlanOutput, error) {
+	plan, err := uc.planRepo.FindByID(ctx, input.PlanID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrInstallmentPlanNotFound) {
+			return nil, domainerror.NewInstallmentError(
+				domainerror.ErrCodeInstallmentPlanNotFound,
+				"installment plan not found",
+				domainerror.ErrInstallmentPlanNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to find installment plan: %w", err)
+	}
+
+	if plan.UserID != input.UserID {
+		return nil, domainerror.NewInstallmentError(
+			domainerror.ErrCodeNotAuthorizedInstallmentPlan,
+			"not authorized to access this installment plan",
+			domainerror.ErrNotAuthorizedInstallmentPlan,
+		)
+	}
+
+	installments, err := uc.planRepo.FindInstallments(ctx, plan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find installments: %w", err)
+	}
+
+	recorded := make([]int, 0, len(installments))
+	for _, txn := range installments {
+		if txn.InstallmentCurrent != nil {
+			recorded = append(recorded, *txn.InstallmentCurrent)
+		}
+	}
+
+	return &GetPlanOutput{
+		Plan:         newPlanOutput(plan, recorded),
+		Installments: installments,
+	}, nil
+}
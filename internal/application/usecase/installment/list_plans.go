@@ -0,0 +1,103 @@
+// Package installment contains installment-related use cases.
+package installment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ListPlansInput represents the input for listing installment plans.
+type ListPlansInput struct {
+	UserID     uuid.UUID
+	ActiveOnly bool // Only plans with installments still to be charged
+}
+
+// PlanOutput represents an installment plan with the progress of its installments.
+type PlanOutput struct {
+	Plan                  *entity.InstallmentPlan
+	RecordedInstallments  []int           // Installment numbers recorded by transactions, ascending
+	RemainingInstallments int             // Installments after the last recorded one
+	RemainingAmount       decimal.Decimal // Amount of the remaining installments
+	NextBillingCycle      string          // Billing cycle of the next installment, empty when finished
+}
+
+// ListPlansOutput represents the output of listing installment plans.
+type ListPlansOutput struct {
+	Plans []*PlanOutput
+}
+
+// ListPlansUseCase handles listing installment plans logic.
+type ListPlansUseCase struct {
+	planRepo adapter.InstallmentPlanRepository
+}
+
+// NewListPlansUseCase creates a new ListPlansUseCase instance.
+func NewListPlansUseCase(planRepo adapter.InstallmentPlanRepository) *ListPlansUseCase {
+	return &ListPlansUseCase{
+		planRepo: planRepo,
+	}
+}
+
+// Execute performs the installment plans listing.
+func (uc *ListPlansUseCase) Execute(ctx context.Context, input ListPlansInput) (*ListPlansOutput, error) {
+	plans, err := uc.planRepo.FindByUser(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list installment plans: %w", err)
+	}
+
+	recorded, err := uc.planRepo.FindRecordedInstallments(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recorded installments: %w", err)
+	}
+
+	output := &ListPlansOutput{
+		Plans: make([]*PlanOutput, 0, len(plans)),
+	}
+	for _, plan := range plans {
+		planOutput := newPlanOutput(plan, recorded[plan.ID])
+		if input.ActiveOnly && planOutput.RemainingInstallments == 0 {
+			continue
+		}
+		output.Plans = append(output.Plans, planOutput)
+	}
+
+	return output, nil
+}
+
+// newPlanOutput builds the progress of a plan from its recorded installment numbers.
+// Installments before the first recorded one are considered charged before the plan was tracked.
+func newPlanOutput(plan *entity.InstallmentPlan, recorded []int) *PlanOutput {
+	last := lastInstallment(recorded)
+	remaining := plan.TotalInstallments - last
+
+	output := &PlanOutput{
+		Plan:                  plan,
+		RecordedInstallments:  recorded,
+		RemainingInstallments: remaining,
+		RemainingAmount:       plan.InstallmentAmount.Mul(decimal.NewFromInt(int64(remaining))),
+	}
+	if output.RecordedInstallments == nil {
+		output.RecordedInstallments = []int{}
+	}
+	if remaining > 0 {
+		output.NextBillingCycle = plan.BillingCycleOf(last + 1)
+	}
+	return output
+}
+
+// lastInstallment returns the highest recorded installment number, or 0 when none is recorded.
+func lastInstallment(recorded []int) int {
+	last := 0
+	for _, number := range recorded {
+		if number > last {
+			last = number
+		}
+	}
+	return last
+}
@@ -0,0 +1,210 @@
+// Package installment contains installment-related use cases.
+package installment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// IssueType represents a discrepancy between an installment plan and the imported installments.
+type IssueType string
+
+const (
+	// IssueMissing is reported when an imported bill lacks an installment the plan expects in it.
+	IssueMissing IssueType = "missing"
+	// IssueExtra is reported when an installment was already recorded for the plan.
+	IssueExtra IssueType = "extra"
+)
+
+// Issue represents a missing or extra installment detected on import.
+type Issue struct {
+	Type              IssueType
+	PlanID            uuid.UUID
+	Description       string
+	InstallmentNumber int
+	TotalInstallments int
+	BillingCycle      string
+	TransactionID     *uuid.UUID // Imported transaction of an extra installment, nil for missing ones
+}
+
+// Tracker groups the installments of new transactions into installment plans and checks
+// imported bills against the plans. It is shared by the use cases that import transactions.
+type Tracker struct {
+	planRepo adapter.InstallmentPlanRepository
+}
+
+// NewTracker creates a new Tracker instance.
+func NewTracker(planRepo adapter.InstallmentPlanRepository) *Tracker {
+	return &Tracker{
+		planRepo: planRepo,
+	}
+}
+
+// Track sets the installment plan of each transaction that carries installment info, creating
+// the plans of purchases seen for the first time. The transactions are the ones imported for
+// the credit card account, or without a card when accountID is nil; only the plans of that card
+// are considered. Installments of a purchase are recognized by their description without the
+// installment marker, their number of installments and the billing cycle of the first
+// installment. Identical purchases made in the same billing cycle get a plan each.
+//
+// Returns an extra issue for each installment the plan already had, and a missing issue for
+// each installment a plan expects in one of the imported billing cycles but that was neither
+// imported nor recorded before. Only transactions with a billing cycle (credit card bills) are
+// checked for missing installments, since other statements do not cover a whole bill.
+func (t *Tracker) Track(ctx context.Context, userID uuid.UUID, accountID *uuid.UUID, transactions []*entity.Transaction) ([]Issue, error) {
+	if len(transactions) == 0 {
+		return nil, nil
+	}
+
+	plans, err := t.planRepo.FindByAccount(ctx, userID, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list installment plans: %w", err)
+	}
+
+	recorded, err := t.planRepo.FindRecordedInstallments(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recorded installments: %w", err)
+	}
+	recordedBefore := make(map[uuid.UUID]map[int]bool, len(recorded))
+	seen := make(map[uuid.UUID]map[int]bool, len(recorded))
+	for planID, numbers := range recorded {
+		recordedBefore[planID] = make(map[int]bool, len(numbers))
+		seen[planID] = make(map[int]bool, len(numbers))
+		for _, number := range numbers {
+			recordedBefore[planID][number] = true
+			seen[planID][number] = true
+		}
+	}
+
+	var issues []Issue
+	billingCycles := make(map[string]bool)
+	for _, txn := range transactions {
+		if txn.Type == entity.TransactionTypeTransfer || txn.IsCreditCardPayment || txn.IsHidden {
+			continue
+		}
+		if txn.BillingCycle != "" {
+			billingCycles[txn.BillingCycle] = true
+		}
+		if txn.InstallmentPlanID != nil || !hasInstallment(txn) {
+			continue
+		}
+
+		billingCycle := txn.BillingCycle
+		if billingCycle == "" {
+			billingCycle = txn.Date.Format("2006-01")
+		}
+		firstBillingCycle := entity.ShiftBillingCycle(billingCycle, -(*txn.InstallmentCurrent - 1))
+		if firstBillingCycle == "" {
+			continue
+		}
+
+		// An installment joins the first plan still missing it. When every matching plan already has
+		// it, it is extra if it was recorded before, and the installment of another identical
+		// purchase if it was only seen in this import.
+		number := *txn.InstallmentCurrent
+		plan := findPlan(plans, txn.Description, *txn.InstallmentTotal, firstBillingCycle, func(plan *entity.InstallmentPlan) bool {
+			return !seen[plan.ID][number]
+		})
+		if plan == nil {
+			plan = findPlan(plans, txn.Description, *txn.InstallmentTotal, firstBillingCycle, func(plan *entity.InstallmentPlan) bool {
+				return recordedBefore[plan.ID][number]
+			})
+		}
+		if plan == nil {
+			plan = entity.NewInstallmentPlan(
+				userID,
+				entity.InstallmentBaseDescription(txn.Description),
+				*txn.InstallmentTotal,
+				txn.BaseAmount().Abs(),
+				firstBillingCycle,
+			)
+			plan.AccountID = accountID
+			plan.MerchantID = txn.MerchantID
+			if err := t.planRepo.Create(ctx, plan); err != nil {
+				return nil, fmt.Errorf("failed to create installment plan: %w", err)
+			}
+			plans = append(plans, plan)
+		}
+
+		planID := plan.ID
+		txn.InstallmentPlanID = &planID
+
+		if seen[plan.ID] == nil {
+			seen[plan.ID] = make(map[int]bool)
+		}
+		if seen[plan.ID][number] {
+			transactionID := txn.ID
+			issues = append(issues, Issue{
+				Type:              IssueExtra,
+				PlanID:            plan.ID,
+				Description:       plan.Description,
+				InstallmentNumber: number,
+				TotalInstallments: plan.TotalInstallments,
+				BillingCycle:      billingCycle,
+				TransactionID:     &transactionID,
+			})
+		}
+		seen[plan.ID][number] = true
+	}
+
+	// Check the imported bills for installments the plans expect in them
+	for billingCycle := range billingCycles {
+		for _, plan := range plans {
+			number := plan.InstallmentNumberIn(billingCycle)
+			if number == 0 || seen[plan.ID][number] {
+				continue
+			}
+			issues = append(issues, Issue{
+				Type:              IssueMissing,
+				PlanID:            plan.ID,
+				Description:       plan.Description,
+				InstallmentNumber: number,
+				TotalInstallments: plan.TotalInstallments,
+				BillingCycle:      billingCycle,
+			})
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].BillingCycle != issues[j].BillingCycle {
+			return issues[i].BillingCycle < issues[j].BillingCycle
+		}
+		if issues[i].Description != issues[j].Description {
+			return strings.ToLower(issues[i].Description) < strings.ToLower(issues[j].Description)
+		}
+		return issues[i].InstallmentNumber < issues[j].InstallmentNumber
+	})
+
+	return issues, nil
+}
+
+// hasInstallment reports whether the transaction is an installment of a purchase split into
+// more than one installment.
+func hasInstallment(txn *entity.Transaction) bool {
+	return txn.InstallmentCurrent != nil && txn.InstallmentTotal != nil &&
+		*txn.InstallmentTotal > 1 &&
+		*txn.InstallmentCurrent >= 1 && *txn.InstallmentCurrent <= *txn.InstallmentTotal
+}
+
+// findPlan returns the first plan an installment belongs to that accepts it, or nil when none does.
+func findPlan(
+	plans []*entity.InstallmentPlan,
+	description string,
+	totalInstallments int,
+	firstBillingCycle string,
+	accept func(plan *entity.InstallmentPlan) bool,
+) *entity.InstallmentPlan {
+	for _, plan := range plans {
+		if plan.Matches(description, totalInstallments, firstBillingCycle) && accept(plan) {
+			return plan
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Package merchant contains merchant-related use cases.
+package merchant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ListMerchantsInput represents the input for listing merchants.
+type ListMerchantsInput struct {
+	UserID uuid.UUID
+}
+
+// MerchantOutput represents a merchant with the number of transactions assigned to it.
+type MerchantOutput struct {
+	Merchant         *entity.Merchant
+	TransactionCount int64
+}
+
+// ListMerchantsOutput represents the output of listing merchants.
+type ListMerchantsOutput struct {
+	Merchants []*MerchantOutput
+}
+
+// ListMerchantsUseCase handles listing merchants logic.
+type ListMerchantsUseCase struct {
+	merchantRepo adapter.MerchantRepository
+}
+
+// NewListMerchantsUseCase creates a new ListMerchantsUseCase instance.
+func NewListMerchantsUseCase(merchantRepo adapter.MerchantRepository) *ListMerchantsUseCase {
+	return &ListMerchantsUseCase{
+		merchantRepo: merchantRepo,
+	}
+}
+
+// Execute performs the merchants listing.
+func (uc *ListMerchantsUseCase) Execute(ctx context.Context, input ListMerchantsInput) (*ListMerchantsOutput, error) {
+	merchants, err := uc.merchantRepo.FindByUser(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list merchants: %w", err)
+	}
+
+	counts, err := uc.merchantRepo.CountTransactions(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count merchant transactions: %w", err)
+	}
+
+	output := &ListMerchantsOutput{
+		Merchants: make([]*MerchantOutput, len(merchants)),
+	}
+	for i, merchant := range merchants {
+		output.Merchants[i] = &MerchantOutput{
+			Merchant:         merchant,
+			TransactionCount: counts[merchant.ID],
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,99 @@
+// Package merchant contains merchant-related use cases.
+package merchant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// MergeMerchantsInput represents the input for merging merchants into another.
+type MergeMerchantsInput struct {
+	TargetID  uuid.UUID
+	UserID    uuid.UUID
+	SourceIDs []uuid.UUID
+}
+
+// MergeMerchantsOutput represents the output of merging merchants.
+type MergeMerchantsOutput struct {
+	Merchant   *entity.Merchant
+	MovedCount int64 // Number of transactions moved to the target merchant
+}
+
+// MergeMerchantsUseCase handles merging merchants logic.
+type MergeMerchantsUseCase struct {
+	merchantRepo adapter.MerchantRepository
+}
+
+// NewMergeMerchantsUseCase creates a new MergeMerchantsUseCase instance.
+func NewMergeMerchantsUseCase(merchantRepo adapter.MerchantRepository) *MergeMerchantsUseCase {
+	return &MergeMerchantsUseCase{
+		merchantRepo: merchantRepo,
+	}
+}
+
+// Execute merges the source merchants into the target. Their transactions move to the target and
+// their keys and aliases become aliases of the target, so future transactions are recognized as
+// the target too. The target keeps its default category, or takes the first source's.
+func (uc *MergeMerchantsUseCase) Execute(ctx context.Context, input MergeMerchantsInput) (*MergeMerchantsOutput, error) {
+	if len(input.SourceIDs) == 0 {
+		return nil, domainerror.NewMerchantError(
+			domainerror.ErrCodeMerchantMissingFields,
+			"at least one merchant to merge is required",
+			domainerror.ErrMerchantMissingFields,
+		)
+	}
+
+	// Find the target and source merchants
+	target, err := findOwnedMerchant(ctx, uc.merchantRepo, input.TargetID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceIDs := make([]uuid.UUID, 0, len(input.SourceIDs))
+	seen := make(map[uuid.UUID]bool, len(input.SourceIDs))
+	for _, sourceID := range input.SourceIDs {
+		if sourceID == target.ID {
+			return nil, domainerror.NewMerchantError(
+				domainerror.ErrCodeInvalidMerchantMerge,
+				"a merchant cannot be merged into itself",
+				domainerror.ErrInvalidMerchantMerge,
+			)
+		}
+		if seen[sourceID] {
+			continue
+		}
+		seen[sourceID] = true
+
+		source, err := findOwnedMerchant(ctx, uc.merchantRepo, sourceID, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Keep recognizing the source's transactions as the target
+		target.Aliases = mergeAliases(target.Key, target.Aliases, append([]string{source.Key}, source.Aliases...))
+		if target.DefaultCategoryID == nil {
+			target.DefaultCategoryID = source.DefaultCategoryID
+		}
+		sourceIDs = append(sourceIDs, source.ID)
+	}
+
+	target.UpdatedAt = time.Now().UTC()
+
+	// Move the transactions and delete the sources atomically
+	moved, err := uc.merchantRepo.Merge(ctx, target, sourceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge merchants: %w", err)
+	}
+
+	return &MergeMerchantsOutput{
+		Merchant:   target,
+		MovedCount: moved,
+	}, nil
+}
@@ -0,0 +1,108 @@
+// Package merchant contains merchant-related use cases.
+package merchant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
+)
+
+// MaxNameLength is the maximum allowed length for merchant names and keys.
+const MaxNameLength = 100
+
+// Resolver recognizes the merchant of new transactions from their descriptions, creating merchants
+// the user does not have yet. It is shared by the use cases that record transactions.
+type Resolver struct {
+	merchantRepo adapter.MerchantRepository
+}
+
+// NewResolver creates a new Resolver instance.
+func NewResolver(merchantRepo adapter.MerchantRepository) *Resolver {
+	return &Resolver{
+		merchantRepo: merchantRepo,
+	}
+}
+
+// Assign sets the merchant of each transaction that has none. A merchant is recognized by the
+// merchant key of the description, then by an alias contained in the description; transfers and
+// credit card bill payments have no merchant. When applyDefaultCategory is set, uncategorized
+// transactions get the default category of their merchant. Returns the number of transactions
+// categorized that way. Imports call it within their database transaction, so the merchants
+// it creates are rolled back with the import.
+func (r *Resolver) Assign(
+	ctx context.Context,
+	userID uuid.UUID,
+	transactions []*entity.Transaction,
+	applyDefaultCategory bool,
+) (int, error) {
+	if len(transactions) == 0 {
+		return 0, nil
+	}
+
+	merchants, err := r.merchantRepo.FindByUser(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list merchants: %w", err)
+	}
+
+	categorized := 0
+	for _, txn := range transactions {
+		if txn.MerchantID != nil || txn.Type == entity.TransactionTypeTransfer || txn.IsCreditCardPayment || txn.IsHidden {
+			continue
+		}
+
+		key := truncate(valueobject.MerchantKey(txn.Description))
+		if key == "" {
+			continue
+		}
+
+		merchant := findMerchant(merchants, key, txn.Description)
+		if merchant == nil {
+			merchant, err = r.merchantRepo.CreateOrGet(ctx, entity.NewMerchant(userID, key))
+			if err != nil {
+				return 0, fmt.Errorf("failed to create merchant: %w", err)
+			}
+			merchants = append(merchants, merchant)
+		}
+
+		merchantID := merchant.ID
+		txn.MerchantID = &merchantID
+
+		if applyDefaultCategory && txn.CategoryID == nil && !txn.IsSplit && merchant.DefaultCategoryID != nil {
+			categoryID := *merchant.DefaultCategoryID
+			txn.CategoryID = &categoryID
+			categorized++
+		}
+	}
+
+	return categorized, nil
+}
+
+// findMerchant returns the merchant a description belongs to, preferring a merchant with the same
+// key over one whose alias the description contains. Returns nil when none matches.
+func findMerchant(merchants []*entity.Merchant, key string, description string) *entity.Merchant {
+	for _, merchant := range merchants {
+		if merchant.HasKey(key) {
+			return merchant
+		}
+	}
+	for _, merchant := range merchants {
+		if merchant.MatchesAlias(description) {
+			return merchant
+		}
+	}
+	return nil
+}
+
+// truncate trims a name or key to MaxNameLength characters.
+func truncate(value string) string {
+	runes := []rune(value)
+	if len(runes) <= MaxNameLength {
+		return value
+	}
+	return string(runes[:MaxNameLength])
+}
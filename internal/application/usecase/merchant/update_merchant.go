@@ -0,0 +1,148 @@
+// Package merchant contains merchant-related use cases.
+package merchant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// UpdateMerchantInput represents the input for merchant update.
+// Nil fields are left unchanged.
+type UpdateMerchantInput struct {
+	MerchantID           uuid.UUID
+	UserID               uuid.UUID
+	Name                 *string
+	Aliases              *[]string // Replaces all aliases
+	DefaultCategoryID    *uuid.UUID
+	ClearDefaultCategory bool // Set to true to remove the default category
+}
+
+// UpdateMerchantOutput represents the output of merchant update.
+type UpdateMerchantOutput struct {
+	Merchant *entity.Merchant
+}
+
+// UpdateMerchantUseCase handles merchant update logic.
+type UpdateMerchantUseCase struct {
+	merchantRepo adapter.MerchantRepository
+	categoryRepo adapter.CategoryRepository
+}
+
+// NewUpdateMerchantUseCase creates a new UpdateMerchantUseCase instance.
+func NewUpdateMerchantUseCase(
+	merchantRepo adapter.MerchantRepository,
+	categoryRepo adapter.CategoryRepository,
+) *UpdateMerchantUseCase {
+	return &UpdateMerchantUseCase{
+		merchantRepo: merchantRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Execute performs the merchant update. Renaming a merchant renames it in all reports, since
+// transactions reference the merchant rather than its name.
+func (uc *UpdateMerchantUseCase) Execute(ctx context.Context, input UpdateMerchantInput) (*UpdateMerchantOutput, error) {
+	// Find the existing merchant
+	merchant, err := findOwnedMerchant(ctx, uc.merchantRepo, input.MerchantID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply changes
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" || len([]rune(name)) > MaxNameLength {
+			return nil, domainerror.NewMerchantError(
+				domainerror.ErrCodeMerchantMissingFields,
+				fmt.Sprintf("name is required and must not exceed %d characters", MaxNameLength),
+				domainerror.ErrMerchantMissingFields,
+			)
+		}
+		merchant.Name = name
+	}
+	if input.Aliases != nil {
+		merchant.Aliases = mergeAliases(merchant.Key, nil, *input.Aliases)
+	}
+
+	// Validate the default category
+	if input.ClearDefaultCategory {
+		merchant.DefaultCategoryID = nil
+	} else if input.DefaultCategoryID != nil {
+		category, err := uc.categoryRepo.FindByID(ctx, *input.DefaultCategoryID)
+		if err != nil || category.OwnerType != entity.OwnerTypeUser || category.OwnerID != input.UserID {
+			return nil, domainerror.NewMerchantError(
+				domainerror.ErrCodeMerchantCategoryNotFound,
+				"default category not found",
+				domainerror.ErrMerchantCategoryNotFound,
+			)
+		}
+		categoryID := category.ID
+		merchant.DefaultCategoryID = &categoryID
+	}
+
+	merchant.UpdatedAt = time.Now().UTC()
+
+	// Save updated merchant
+	if err := uc.merchantRepo.Update(ctx, merchant); err != nil {
+		return nil, fmt.Errorf("failed to update merchant: %w", err)
+	}
+
+	return &UpdateMerchantOutput{
+		Merchant: merchant,
+	}, nil
+}
+
+// mergeAliases appends the added aliases to the existing ones, trimmed and without blanks,
+// the merchant's own key or case-insensitive repeats.
+func mergeAliases(key string, existing []string, added []string) []string {
+	aliases := make([]string, 0, len(existing)+len(added))
+	seen := map[string]bool{strings.ToUpper(key): true}
+	for _, alias := range append(existing, added...) {
+		alias = truncate(strings.TrimSpace(alias))
+		if alias == "" || seen[strings.ToUpper(alias)] {
+			continue
+		}
+		seen[strings.ToUpper(alias)] = true
+		aliases = append(aliases, alias)
+	}
+	return aliases
+}
+
+// findOwnedMerchant loads a merchant and verifies it belongs to the user.
+func findOwnedMerchant(
+	ctx context.Context,
+	merchantRepo adapter.MerchantRepository,
+	merchantID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Merchant, error) {
+	merchant, err := merchantRepo.FindByID(ctx, merchantID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrMerchantNotFound) {
+			return nil, domainerror.NewMerchantError(
+				domainerror.ErrCodeMerchantNotFound,
+				"merchant not found",
+				domainerror.ErrMerchantNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to find merchant: %w", err)
+	}
+
+	if merchant.UserID != userID {
+		return nil, domainerror.NewMerchantError(
+			domainerror.ErrCodeNotAuthorizedMerchant,
+			"not authorized to access this merchant",
+			domainerror.ErrNotAuthorizedMerchant,
+		)
+	}
+
+	return merchant, nil
+}
@@ -0,0 +1,240 @@
+// Package recurringschedule contains recurring schedule-related use cases.
+package recurringschedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+const (
+	// MaxDescriptionLength is the maximum allowed length for schedule descriptions.
+	MaxDescriptionLength = 255
+	// MaxNotesLength is the maximum allowed length for schedule notes.
+	MaxNotesLength = 1000
+	// MaxInterval is the maximum number of periods between occurrences.
+	MaxInterval = 12
+	// UpcomingOccurrencesCount is the number of upcoming dates returned for a schedule.
+	UpcomingOccurrencesCount = 5
+)
+
+// CreateRecurringScheduleInput represents the input for recurring schedule creation.
+// Occurrences before today are never generated, since they were usually entered by hand.
+type CreateRecurringScheduleInput struct {
+	UserID         uuid.UUID
+	Description    string
+	Amount         decimal.Decimal
+	Type           entity.TransactionType
+	CategoryID     *uuid.UUID
+	Notes          string
+	Frequency      entity.RecurrenceFrequency
+	Interval       *int // Optional, defaults to 1
+	DayOfMonth     *int // Optional, defaults to the start date's day (monthly/yearly)
+	DayOfWeek      *int // Optional, defaults to the start date's weekday (weekly)
+	StartDate      time.Time
+	EndDate        *time.Time
+	MaxOccurrences *int
+}
+
+// CreateRecurringScheduleOutput represents the output of recurring schedule creation.
+type CreateRecurringScheduleOutput struct {
+	Schedule *RecurringScheduleOutput
+}
+
+// CreateRecurringScheduleUseCase handles recurring schedule creation logic.
+type CreateRecurringScheduleUseCase struct {
+	scheduleRepo adapter.RecurringScheduleRepository
+	categoryRepo adapter.CategoryRepository
+}
+
+// NewCreateRecurringScheduleUseCase creates a new CreateRecurringScheduleUseCase instance.
+func NewCreateRecurringScheduleUseCase(
+	scheduleRepo adapter.RecurringScheduleRepository,
+	categoryRepo adapter.CategoryRepository,
+) *CreateRecurringScheduleUseCase {
+	return &CreateRecurringScheduleUseCase{
+		scheduleRepo: scheduleRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Execute performs the recurring schedule creation.
+func (uc *CreateRecurringScheduleUseCase) Execute(ctx context.Context, input CreateRecurringScheduleInput) (*CreateRecurringScheduleOutput, error) {
+	if input.Description == "" || input.StartDate.IsZero() {
+		return nil, domainerror.NewRecurringScheduleError(
+			domainerror.ErrCodeRecurringScheduleMissingFields,
+			"description and start_date are required",
+			domainerror.ErrRecurringScheduleMissingFields,
+		)
+	}
+
+	// Build schedule entity
+	schedule := entity.NewRecurringSchedule(
+		input.UserID,
+		input.Description,
+		input.Amount,
+		input.Type,
+		input.Frequency,
+		input.StartDate,
+	)
+	schedule.Notes = input.Notes
+	schedule.EndDate = input.EndDate
+	schedule.MaxOccurrences = input.MaxOccurrences
+	if input.Interval != nil {
+		schedule.Interval = *input.Interval
+	}
+
+	// Default the day from the start date so the rule is explicit
+	switch input.Frequency {
+	case entity.RecurrenceFrequencyWeekly:
+		dayOfWeek := int(schedule.StartDate.Weekday())
+		if input.DayOfWeek != nil {
+			dayOfWeek = *input.DayOfWeek
+		}
+		schedule.DayOfWeek = &dayOfWeek
+	default:
+		dayOfMonth := schedule.StartDate.Day()
+		if input.DayOfMonth != nil {
+			dayOfMonth = *input.DayOfMonth
+		}
+		schedule.DayOfMonth = &dayOfMonth
+	}
+
+	if err := ValidateRecurringSchedule(schedule); err != nil {
+		return nil, err
+	}
+
+	// Validate category if provided
+	var category *entity.Category
+	if input.CategoryID != nil {
+		found, err := findOwnedCategory(ctx, uc.categoryRepo, *input.CategoryID, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		schedule.CategoryID = input.CategoryID
+		category = found
+	}
+
+	// Compute the first occurrence, skipping past dates
+	schedule.Reschedule()
+	schedule.SkipTo(time.Now().UTC())
+
+	// Save schedule to database
+	if err := uc.scheduleRepo.Create(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to create recurring schedule: %w", err)
+	}
+
+	return &CreateRecurringScheduleOutput{
+		Schedule: toRecurringScheduleOutput(schedule, category),
+	}, nil
+}
+
+// ValidateRecurringSchedule validates the schedule's transaction fields and recurrence rule.
+func ValidateRecurringSchedule(schedule *entity.RecurringSchedule) error {
+	if len(schedule.Description) > MaxDescriptionLength {
+		return domainerror.NewRecurringScheduleError(
+			domainerror.ErrCodeRecurringScheduleMissingFields,
+			fmt.Sprintf("description must not exceed %d characters", MaxDescriptionLength),
+			domainerror.ErrRecurringScheduleMissingFields,
+		)
+	}
+
+	if len(schedule.Notes) > MaxNotesLength {
+		return domainerror.NewRecurringScheduleError(
+			domainerror.ErrCodeRecurringScheduleMissingFields,
+			fmt.Sprintf("notes must not exceed %d characters", MaxNotesLength),
+			domainerror.ErrRecurringScheduleMissingFields,
+		)
+	}
+
+	if schedule.Amount.IsZero() {
+		return domainerror.NewRecurringScheduleError(
+			domainerror.ErrCodeInvalidRecurringAmount,
+			"amount must not be zero",
+			domainerror.ErrInvalidRecurringAmount,
+		)
+	}
+
+	if schedule.Type != entity.TransactionTypeExpense && schedule.Type != entity.TransactionTypeIncome {
+		return domainerror.NewRecurringScheduleError(
+			domainerror.ErrCodeRecurringScheduleMissingFields,
+			"type must be 'expense' or 'income'",
+			domainerror.ErrRecurringScheduleMissingFields,
+		)
+	}
+
+	if !entity.IsValidRecurrenceFrequency(schedule.Frequency) {
+		return domainerror.NewRecurringScheduleError(
+			domainerror.ErrCodeInvalidRecurrenceFrequency,
+			"frequency must be 'weekly', 'monthly', or 'yearly'",
+			domainerror.ErrInvalidRecurrenceFrequency,
+		)
+	}
+
+	if schedule.Interval < 1 || schedule.Interval > MaxInterval {
+		return domainerror.NewRecurringScheduleError(
+			domainerror.ErrCodeInvalidRecurrenceInterval,
+			fmt.Sprintf("interval must be between 1 and %d", MaxInterval),
+			domainerror.ErrInvalidRecurrenceInterval,
+		)
+	}
+
+	if schedule.DayOfMonth != nil && (*schedule.DayOfMonth < 1 || *schedule.DayOfMonth > 31) {
+		return domainerror.NewRecurringScheduleError(
+			domainerror.ErrCodeInvalidRecurrenceDay,
+			"day_of_month must be between 1 and 31",
+			domainerror.ErrInvalidRecurrenceDay,
+		)
+	}
+
+	if schedule.DayOfWeek != nil && (*schedule.DayOfWeek < 0 || *schedule.DayOfWeek > 6) {
+		return domainerror.NewRecurringScheduleError(
+			domainerror.ErrCodeInvalidRecurrenceDay,
+			"day_of_week must be between 0 (Sunday) and 6 (Saturday)",
+			domainerror.ErrInvalidRecurrenceDay,
+		)
+	}
+
+	if schedule.EndDate != nil && schedule.EndDate.Before(schedule.StartDate) {
+		return domainerror.NewRecurringScheduleError(
+			domainerror.ErrCodeInvalidRecurrenceEnd,
+			"end_date must not be before start_date",
+			domainerror.ErrInvalidRecurrenceEnd,
+		)
+	}
+
+	if schedule.MaxOccurrences != nil && *schedule.MaxOccurrences < 1 {
+		return domainerror.NewRecurringScheduleError(
+			domainerror.ErrCodeInvalidRecurrenceEnd,
+			"max_occurrences must be at least 1",
+			domainerror.ErrInvalidRecurrenceEnd,
+		)
+	}
+
+	return nil
+}
+
+// findOwnedCategory loads a category and verifies it belongs to the user.
+func findOwnedCategory(
+	ctx context.Context,
+	categoryRepo adapter.CategoryRepository,
+	categoryID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Category, error) {
+	category, err := categoryRepo.FindByID(ctx, categoryID)
+	if err != nil || category.OwnerType != entity.OwnerTypeUser || category.OwnerID != userID {
+		return nil, domainerror.NewRecurringScheduleError(
+			domainerror.ErrCodeRecurringCategoryNotFound,
+			"category not found",
+			domainerror.ErrRecurringCategoryNotFound,
+		)
+	}
+	return category, nil
+}
@@ -0,0 +1,50 @@
+// Package recurringschedule contains recurring schedule-related use cases.
+package recurringschedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+// DeleteRecurringScheduleInput represents the input for recurring schedule deletion.
+type DeleteRecurringScheduleInput struct {
+	ScheduleID uuid.UUID
+	UserID     uuid.UUID
+}
+
+// DeleteRecurringScheduleOutput represents the output of recurring schedule deletion.
+type DeleteRecurringScheduleOutput struct {
+	Success bool
+}
+
+// DeleteRecurringScheduleUseCase handles recurring schedule deletion logic.
+// Transactions already generated by the schedule are kept.
+type DeleteRecurringScheduleUseCase struct {
+	scheduleRepo adapter.RecurringScheduleRepository
+}
+
+// NewDeleteRecurringScheduleUseCase creates a new DeleteRecurringScheduleUseCase instance.
+func NewDeleteRecurringScheduleUseCase(scheduleRepo adapter.RecurringScheduleRepository) *DeleteRecurringScheduleUseCase {
+	return &DeleteRecurringScheduleUseCase{
+		scheduleRepo: scheduleRepo,
+	}
+}
+
+// Execute performs the recurring schedule deletion.
+func (uc *DeleteRecurringScheduleUseCase) Execute(ctx context.Context, input DeleteRecurringScheduleInput) (*DeleteRecurringScheduleOutput, error) {
+	if _, err := findOwnedSchedule(ctx, uc.scheduleRepo, input.ScheduleID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.scheduleRepo.Delete(ctx, input.ScheduleID); err != nil {
+		return nil, fmt.Errorf("failed to delete recurring schedule: %w", err)
+	}
+
+	return &DeleteRecurringScheduleOutput{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,58 @@
+// Package recurringschedule contains recurring schedule-related use cases.
+package recurringschedule
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// GetRecurringScheduleInput represents the input for getting a recurring schedule.
+type GetRecurringScheduleInput struct {
+	ScheduleID uuid.UUID
+	UserID     uuid.UUID
+}
+
+// GetRecurringScheduleOutput represents the output of getting a recurring schedule.
+type GetRecurringScheduleOutput struct {
+	Schedule *RecurringScheduleOutput
+}
+
+// GetRecurringScheduleUseCase handles getting a single recurring schedule.
+type GetRecurringScheduleUseCase struct {
+	scheduleRepo adapter.RecurringScheduleRepository
+	categoryRepo adapter.CategoryRepository
+}
+
+// NewGetRecurringScheduleUseCase creates a new GetRecurringScheduleUseCase instance.
+func NewGetRecurringScheduleUseCase(
+	scheduleRepo adapter.RecurringScheduleRepository,
+	categoryRepo adapter.CategoryRepository,
+) *GetRecurringScheduleUseCase {
+	return &GetRecurringScheduleUseCase{
+		scheduleRepo: scheduleRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Execute performs getting the recurring schedule.
+func (uc *GetRecurringScheduleUseCase) Execute(ctx context.Context, input GetRecurringScheduleInput) (*GetRecurringScheduleOutput, error) {
+	schedule, err := findOwnedSchedule(ctx, uc.scheduleRepo, input.ScheduleID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var category *entity.Category
+	if schedule.CategoryID != nil {
+		if found, err := uc.categoryRepo.FindByID(ctx, *schedule.CategoryID); err == nil {
+			category = found
+		}
+	}
+
+	return &GetRecurringScheduleOutput{
+		Schedule: toRecurringScheduleOutput(schedule, category),
+	}, nil
+}
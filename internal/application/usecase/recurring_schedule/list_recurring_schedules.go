@@ -0,0 +1,130 @@
+// Package recurringschedule contains recurring schedule-related use cases.
+package recurringschedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ListRecurringSchedulesInput represents the input for listing recurring schedules.
+type ListRecurringSchedulesInput struct {
+	UserID uuid.UUID
+}
+
+// ListRecurringSchedulesOutput represents the output of listing recurring schedules.
+type ListRecurringSchedulesOutput struct {
+	Schedules []*RecurringScheduleOutput
+}
+
+// RecurringScheduleOutput represents a single recurring schedule in the output.
+type RecurringScheduleOutput struct {
+	ID                  uuid.UUID
+	UserID              uuid.UUID
+	Description         string
+	Amount              decimal.Decimal
+	Type                entity.TransactionType
+	CategoryID          *uuid.UUID
+	Category            *entity.Category
+	Notes               string
+	Frequency           entity.RecurrenceFrequency
+	Interval            int
+	DayOfMonth          *int
+	DayOfWeek           *int
+	StartDate           time.Time
+	EndDate             *time.Time
+	MaxOccurrences      *int
+	OccurrenceCount     int
+	LastOccurrenceDate  *time.Time
+	NextOccurrence      *time.Time
+	UpcomingOccurrences []time.Time
+	IsActive            bool
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// ListRecurringSchedulesUseCase handles listing recurring schedules logic.
+type ListRecurringSchedulesUseCase struct {
+	scheduleRepo adapter.RecurringScheduleRepository
+	categoryRepo adapter.CategoryRepository
+}
+
+// NewListRecurringSchedulesUseCase creates a new ListRecurringSchedulesUseCase instance.
+func NewListRecurringSchedulesUseCase(
+	scheduleRepo adapter.RecurringScheduleRepository,
+	categoryRepo adapter.CategoryRepository,
+) *ListRecurringSchedulesUseCase {
+	return &ListRecurringSchedulesUseCase{
+		scheduleRepo: scheduleRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Execute performs the recurring schedule listing.
+func (uc *ListRecurringSchedulesUseCase) Execute(ctx context.Context, input ListRecurringSchedulesInput) (*ListRecurringSchedulesOutput, error) {
+	schedules, err := uc.scheduleRepo.FindByUser(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recurring schedules: %w", err)
+	}
+
+	output := &ListRecurringSchedulesOutput{
+		Schedules: make([]*RecurringScheduleOutput, 0, len(schedules)),
+	}
+
+	// Load each category once
+	categories := make(map[uuid.UUID]*entity.Category)
+	for _, s := range schedules {
+		var category *entity.Category
+		if s.CategoryID != nil {
+			if cached, ok := categories[*s.CategoryID]; ok {
+				category = cached
+			} else if found, err := uc.categoryRepo.FindByID(ctx, *s.CategoryID); err == nil {
+				category = found
+				categories[*s.CategoryID] = found
+			}
+		}
+
+		output.Schedules = append(output.Schedules, toRecurringScheduleOutput(s, category))
+	}
+
+	return output, nil
+}
+
+// toRecurringScheduleOutput converts a schedule entity to output.
+func toRecurringScheduleOutput(schedule *entity.RecurringSchedule, category *entity.Category) *RecurringScheduleOutput {
+	var upcoming []time.Time
+	if schedule.IsActive {
+		upcoming = schedule.UpcomingOccurrences(UpcomingOccurrencesCount)
+	}
+
+	return &RecurringScheduleOutput{
+		ID:                  schedule.ID,
+		UserID:              schedule.UserID,
+		Description:         schedule.Description,
+		Amount:              schedule.Amount,
+		Type:                schedule.Type,
+		CategoryID:          schedule.CategoryID,
+		Category:            category,
+		Notes:               schedule.Notes,
+		Frequency:           schedule.Frequency,
+		Interval:            schedule.Interval,
+		DayOfMonth:          schedule.DayOfMonth,
+		DayOfWeek:           schedule.DayOfWeek,
+		StartDate:           schedule.StartDate,
+		EndDate:             schedule.EndDate,
+		MaxOccurrences:      schedule.MaxOccurrences,
+		OccurrenceCount:     schedule.OccurrenceCount,
+		LastOccurrenceDate:  schedule.LastOccurrenceDate,
+		NextOccurrence:      schedule.NextOccurrence,
+		UpcomingOccurrences: upcoming,
+		IsActive:            schedule.IsActive,
+		CreatedAt:           schedule.CreatedAt,
+		UpdatedAt:           schedule.UpdatedAt,
+	}
+}
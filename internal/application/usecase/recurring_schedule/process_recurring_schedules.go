@@ -0,0 +1,247 @@
+// Package recurringschedule contains recurring schedule-related use cases.
+package recurringschedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+const (
+	// processBatchSize is the number of due schedules loaded per batch.
+	processBatchSize = 100
+	// maxOccurrencesPerRun caps how many entries a single schedule can generate in one run,
+	// across all batches, so a misconfigured schedule cannot flood the user's transactions.
+	// A schedule that is further behind catches up over the next runs.
+	maxOccurrencesPerRun = 60
+)
+
+// ProcessRecurringSchedulesInput represents the input for a scheduler run.
+type ProcessRecurringSchedulesInput struct {
+	Now           time.Time
+	LookaheadDays int // Generate occurrences up to this many days ahead of Now
+	ReminderDays  int // Remind about occurrences up to this many days ahead; 0 disables reminders
+}
+
+// ProcessRecurringSchedulesOutput represents the output of a scheduler run.
+type ProcessRecurringSchedulesOutput struct {
+	GeneratedCount  int
+	RemindersQueued int
+}
+
+// ProcessRecurringSchedulesUseCase materializes due recurring schedules into transactions
+// and queues reminder emails about upcoming ones.
+type ProcessRecurringSchedulesUseCase struct {
+	scheduleRepo adapter.RecurringScheduleRepository
+	userRepo     adapter.UserRepository
+	emailService adapter.EmailService
+	converter    *exchangerate.Converter
+}
+
+// NewProcessRecurringSchedulesUseCase creates a new ProcessRecurringSchedulesUseCase instance.
+func NewProcessRecurringSchedulesUseCase(
+	scheduleRepo adapter.RecurringScheduleRepository,
+	userRepo adapter.UserRepository,
+	emailService adapter.EmailService,
+	converter *exchangerate.Converter,
+) *ProcessRecurringSchedulesUseCase {
+	return &ProcessRecurringSchedulesUseCase{
+		scheduleRepo: scheduleRepo,
+		userRepo:     userRepo,
+		emailService: emailService,
+		converter:    converter,
+	}
+}
+
+// Execute performs a scheduler run. Failures on individual schedules are logged and skipped
+// so that one bad schedule does not block the others.
+func (uc *ProcessRecurringSchedulesUseCase) Execute(ctx context.Context, input ProcessRecurringSchedulesInput) (*ProcessRecurringSchedulesOutput, error) {
+	now := input.Now
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	asOf := today.AddDate(0, 0, input.LookaheadDays)
+
+	output := &ProcessRecurringSchedulesOutput{}
+
+	generated, err := uc.generateDueOccurrences(ctx, asOf)
+	output.GeneratedCount = generated
+	if err != nil {
+		return output, err
+	}
+
+	if input.ReminderDays > 0 && uc.emailService != nil {
+		queued, err := uc.queueReminders(ctx, asOf.AddDate(0, 0, 1), today.AddDate(0, 0, input.ReminderDays))
+		output.RemindersQueued = queued
+		if err != nil {
+			return output, err
+		}
+	}
+
+	return output, nil
+}
+
+// generateDueOccurrences creates transactions for every occurrence on or before asOf.
+func (uc *ProcessRecurringSchedulesUseCase) generateDueOccurrences(ctx context.Context, asOf time.Time) (int, error) {
+	generated := 0
+	failed := make(map[uuid.UUID]bool)
+	generatedBySchedule := make(map[uuid.UUID]int)
+
+	for {
+		schedules, err := uc.scheduleRepo.FindDue(ctx, asOf, processBatchSize)
+		if err != nil {
+			return generated, fmt.Errorf("failed to find due recurring schedules: %w", err)
+		}
+
+		progressed := false
+		for _, schedule := range schedules {
+			remaining := maxOccurrencesPerRun - generatedBySchedule[schedule.ID]
+			if failed[schedule.ID] || remaining <= 0 {
+				continue
+			}
+
+			count, err := uc.materialize(ctx, schedule, asOf, remaining)
+			generated += count
+			generatedBySchedule[schedule.ID] += count
+			if err != nil {
+				slog.Error("Failed to generate recurring transaction",
+					"schedule_id", schedule.ID,
+					"error", err)
+				failed[schedule.ID] = true
+				continue
+			}
+			if count > 0 {
+				progressed = true
+			}
+		}
+
+		// Stop when the last batch was partial or nothing could be generated
+		if len(schedules) < processBatchSize || !progressed {
+			return generated, nil
+		}
+	}
+}
+
+// materialize generates up to limit of the schedule's due occurrences, saving each one atomically.
+func (uc *ProcessRecurringSchedulesUseCase) materialize(ctx context.Context, schedule *entity.RecurringSchedule, asOf time.Time, limit int) (int, error) {
+	count := 0
+	for count < limit && schedule.IsDue(asOf) {
+		transaction := entity.NewTransaction(
+			schedule.UserID,
+			*schedule.NextOccurrence,
+			schedule.Description,
+			schedule.Amount,
+			schedule.Type,
+			schedule.CategoryID,
+			schedule.Notes,
+			true,
+		)
+		scheduleID := schedule.ID
+		transaction.RecurringScheduleID = &scheduleID
+
+		// Record the transaction in the user's base currency
+		if uc.converter != nil {
+			if err := uc.converter.Snapshot(ctx, schedule.UserID, transaction); err != nil {
+				return count, err
+			}
+		}
+
+		occurrence := *schedule.NextOccurrence
+		schedule.Advance()
+		schedule.UpdatedAt = time.Now().UTC()
+
+		if err := uc.scheduleRepo.SaveOccurrence(ctx, schedule, occurrence, transaction); err != nil {
+			// Another run generated this occurrence first; it continues from here
+			if errors.Is(err, domainerror.ErrRecurringOccurrenceAlreadyProcessed) {
+				return count, nil
+			}
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// queueReminders sends one reminder email per user listing their occurrences in the range.
+// Occurrences that will be generated in this run are excluded by starting the range after asOf.
+func (uc *ProcessRecurringSchedulesUseCase) queueReminders(ctx context.Context, from, to time.Time) (int, error) {
+	if to.Before(from) {
+		return 0, nil
+	}
+
+	schedules, err := uc.scheduleRepo.FindPendingReminders(ctx, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find pending recurring reminders: %w", err)
+	}
+
+	// Group by user, preserving the occurrence order
+	var userIDs []uuid.UUID
+	byUser := make(map[uuid.UUID][]*entity.RecurringSchedule)
+	for _, schedule := range schedules {
+		if _, ok := byUser[schedule.UserID]; !ok {
+			userIDs = append(userIDs, schedule.UserID)
+		}
+		byUser[schedule.UserID] = append(byUser[schedule.UserID], schedule)
+	}
+
+	queued := 0
+	for _, userID := range userIDs {
+		userSchedules := byUser[userID]
+
+		user, err := uc.userRepo.FindByID(ctx, userID)
+		if err != nil {
+			slog.Error("Failed to load user for recurring reminder",
+				"user_id", userID,
+				"error", err)
+			continue
+		}
+
+		if user.RecurringReminders {
+			items := make([]adapter.RecurringReminderItem, 0, len(userSchedules))
+			for _, schedule := range userSchedules {
+				amount := schedule.Amount.Abs()
+				if schedule.Type == entity.TransactionTypeExpense {
+					amount = amount.Neg()
+				}
+				items = append(items, adapter.RecurringReminderItem{
+					Description: schedule.Description,
+					Amount:      user.NumberFormat.Format(amount),
+					Date:        schedule.NextOccurrence.Format(user.DateFormat.Layout()),
+				})
+			}
+
+			if err := uc.emailService.QueueRecurringReminderEmail(ctx, adapter.QueueRecurringReminderInput{
+				UserEmail: user.Email,
+				UserName:  user.Name,
+				Items:     items,
+			}); err != nil {
+				slog.Error("Failed to queue recurring reminder email",
+					"user_id", userID,
+					"error", err)
+				continue
+			}
+			queued++
+		}
+
+		// Mark as reminded even when reminders are disabled, so they are not re-checked every run
+		for _, schedule := range userSchedules {
+			if err := uc.scheduleRepo.MarkReminded(ctx, schedule.ID, *schedule.NextOccurrence); err != nil {
+				slog.Error("Failed to mark recurring schedule as reminded",
+					"schedule_id", schedule.ID,
+					"error", err)
+			}
+		}
+	}
+
+	return queued, nil
+}
@@ -0,0 +1,197 @@
+// Package recurringschedule contains recurring schedule-related use cases.
+package recurringschedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// UpdateRecurringScheduleInput represents the input for recurring schedule update.
+// Changing the recurrence rule or resuming a paused schedule recomputes the next
+// occurrence from today; already generated transactions are left untouched.
+type UpdateRecurringScheduleInput struct {
+	ScheduleID          uuid.UUID
+	UserID              uuid.UUID
+	Description         *string
+	Amount              *decimal.Decimal
+	Type                *entity.TransactionType
+	CategoryID          *uuid.UUID
+	ClearCategory       bool
+	Notes               *string
+	Frequency           *entity.RecurrenceFrequency
+	Interval            *int
+	DayOfMonth          *int
+	DayOfWeek           *int
+	StartDate           *time.Time
+	EndDate             *time.Time
+	ClearEndDate        bool
+	MaxOccurrences      *int
+	ClearMaxOccurrences bool
+	IsActive            *bool
+}
+
+// UpdateRecurringScheduleOutput represents the output of recurring schedule update.
+type UpdateRecurringScheduleOutput struct {
+	Schedule *RecurringScheduleOutput
+}
+
+// UpdateRecurringScheduleUseCase handles recurring schedule update logic.
+type UpdateRecurringScheduleUseCase struct {
+	scheduleRepo adapter.RecurringScheduleRepository
+	categoryRepo adapter.CategoryRepository
+}
+
+// NewUpdateRecurringScheduleUseCase creates a new UpdateRecurringScheduleUseCase instance.
+func NewUpdateRecurringScheduleUseCase(
+	scheduleRepo adapter.RecurringScheduleRepository,
+	categoryRepo adapter.CategoryRepository,
+) *UpdateRecurringScheduleUseCase {
+	return &UpdateRecurringScheduleUseCase{
+		scheduleRepo: scheduleRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Execute performs the recurring schedule update.
+func (uc *UpdateRecurringScheduleUseCase) Execute(ctx context.Context, input UpdateRecurringScheduleInput) (*UpdateRecurringScheduleOutput, error) {
+	schedule, err := findOwnedSchedule(ctx, uc.scheduleRepo, input.ScheduleID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update transaction fields
+	if input.Description != nil {
+		schedule.Description = *input.Description
+	}
+	if input.Amount != nil {
+		schedule.Amount = *input.Amount
+	}
+	if input.Type != nil {
+		schedule.Type = *input.Type
+	}
+	if input.Notes != nil {
+		schedule.Notes = *input.Notes
+	}
+
+	// Update recurrence rule
+	ruleChanged := false
+	if input.Frequency != nil && *input.Frequency != schedule.Frequency {
+		schedule.Frequency = *input.Frequency
+		ruleChanged = true
+	}
+	if input.Interval != nil {
+		schedule.Interval = *input.Interval
+		ruleChanged = true
+	}
+	if input.DayOfMonth != nil {
+		schedule.DayOfMonth = input.DayOfMonth
+		ruleChanged = true
+	}
+	if input.DayOfWeek != nil {
+		schedule.DayOfWeek = input.DayOfWeek
+		ruleChanged = true
+	}
+	if input.StartDate != nil {
+		startDate := time.Date(input.StartDate.Year(), input.StartDate.Month(), input.StartDate.Day(), 0, 0, 0, 0, time.UTC)
+		schedule.StartDate = startDate
+		ruleChanged = true
+	}
+	if input.ClearEndDate {
+		schedule.EndDate = nil
+		ruleChanged = true
+	} else if input.EndDate != nil {
+		schedule.EndDate = input.EndDate
+		ruleChanged = true
+	}
+	if input.ClearMaxOccurrences {
+		schedule.MaxOccurrences = nil
+		ruleChanged = true
+	} else if input.MaxOccurrences != nil {
+		schedule.MaxOccurrences = input.MaxOccurrences
+		ruleChanged = true
+	}
+
+	resumed := false
+	if input.IsActive != nil {
+		resumed = *input.IsActive && !schedule.IsActive
+		schedule.IsActive = *input.IsActive
+	}
+
+	if err := ValidateRecurringSchedule(schedule); err != nil {
+		return nil, err
+	}
+
+	// Update category if provided
+	var category *entity.Category
+	if input.ClearCategory {
+		schedule.CategoryID = nil
+	} else if input.CategoryID != nil {
+		found, err := findOwnedCategory(ctx, uc.categoryRepo, *input.CategoryID, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		schedule.CategoryID = input.CategoryID
+		category = found
+	} else if schedule.CategoryID != nil {
+		if found, err := uc.categoryRepo.FindByID(ctx, *schedule.CategoryID); err == nil {
+			category = found
+		}
+	}
+
+	// Recompute the next occurrence without generating missed dates
+	if ruleChanged {
+		schedule.Reschedule()
+	}
+	if ruleChanged || resumed {
+		schedule.SkipTo(time.Now().UTC())
+	}
+
+	schedule.UpdatedAt = time.Now().UTC()
+
+	if err := uc.scheduleRepo.Update(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to update recurring schedule: %w", err)
+	}
+
+	return &UpdateRecurringScheduleOutput{
+		Schedule: toRecurringScheduleOutput(schedule, category),
+	}, nil
+}
+
+// findOwnedSchedule loads a recurring schedule and verifies it belongs to the user.
+func findOwnedSchedule(
+	ctx context.Context,
+	scheduleRepo adapter.RecurringScheduleRepository,
+	scheduleID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.RecurringSchedule, error) {
+	schedule, err := scheduleRepo.FindByID(ctx, scheduleID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrRecurringScheduleNotFound) {
+			return nil, domainerror.NewRecurringScheduleError(
+				domainerror.ErrCodeRecurringScheduleNotFound,
+				"recurring schedule not found",
+				domainerror.ErrRecurringScheduleNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to find recurring schedule: %w", err)
+	}
+
+	if schedule.UserID != userID {
+		return nil, domainerror.NewRecurringScheduleError(
+			domainerror.ErrCodeNotAuthorizedRecurringSchedule,
+			"not authorized to access this recurring schedule",
+			domainerror.ErrNotAuthorizedRecurringSchedule,
+		)
+	}
+
+	return schedule, nil
+}
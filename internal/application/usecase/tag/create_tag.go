@@ -0,0 +1,108 @@
+// Package tag contains tag-related use cases.
+package tag
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// MaxTagNameLength is the maximum allowed length for tag names.
+const MaxTagNameLength = 50
+
+// hexColorRegex matches hex colors (e.g., "#64748B" or "#FFF").
+var hexColorRegex = regexp.MustCompile(`^#([A-Fa-f0-9]{6}|[A-Fa-f0-9]{3})$`)
+
+// CreateTagInput represents the input for tag creation.
+type CreateTagInput struct {
+	UserID uuid.UUID
+	Name   string
+	Color  string // Optional, defaults to DefaultTagColor
+}
+
+// CreateTagOutput represents the output of tag creation.
+type CreateTagOutput struct {
+	Tag *entity.Tag
+}
+
+// CreateTagUseCase handles tag creation logic.
+type CreateTagUseCase struct {
+	tagRepo adapter.TagRepository
+}
+
+// NewCreateTagUseCase creates a new CreateTagUseCase instance.
+func NewCreateTagUseCase(tagRepo adapter.TagRepository) *CreateTagUseCase {
+	return &CreateTagUseCase{
+		tagRepo: tagRepo,
+	}
+}
+
+// Execute performs the tag creation.
+func (uc *CreateTagUseCase) Execute(ctx context.Context, input CreateTagInput) (*CreateTagOutput, error) {
+	name := strings.TrimSpace(input.Name)
+
+	// Build tag
+	tag := entity.NewTag(input.UserID, name, strings.TrimSpace(input.Color))
+
+	// Validate tag
+	if err := ValidateTag(tag); err != nil {
+		return nil, err
+	}
+
+	// Check if name already exists for this user
+	exists, err := uc.tagRepo.ExistsByNameAndUser(ctx, name, input.UserID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check tag name existence: %w", err)
+	}
+	if exists {
+		return nil, domainerror.NewTagError(
+			domainerror.ErrCodeTagNameExists,
+			"a tag with this name already exists",
+			domainerror.ErrTagNameExists,
+		)
+	}
+
+	// Save tag
+	if err := uc.tagRepo.Create(ctx, tag); err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	return &CreateTagOutput{
+		Tag: tag,
+	}, nil
+}
+
+// ValidateTag checks the tag's name and color.
+func ValidateTag(tag *entity.Tag) error {
+	if tag.Name == "" {
+		return domainerror.NewTagError(
+			domainerror.ErrCodeTagMissingFields,
+			"name is required",
+			domainerror.ErrTagMissingFields,
+		)
+	}
+	if len(tag.Name) > MaxTagNameLength {
+		return domainerror.NewTagError(
+			domainerror.ErrCodeTagMissingFields,
+			fmt.Sprintf("name must not exceed %d characters", MaxTagNameLength),
+			domainerror.ErrTagMissingFields,
+		)
+	}
+
+	if !hexColorRegex.MatchString(tag.Color) {
+		return domainerror.NewTagError(
+			domainerror.ErrCodeInvalidTagColor,
+			"color must be a valid hex format (#XXXXXX)",
+			domainerror.ErrInvalidTagColor,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Package tag contains tag-related use cases.
+package tag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+// DeleteTagInput represents the input for tag deletion.
+type DeleteTagInput struct {
+	TagID  uuid.UUID
+	UserID uuid.UUID
+}
+
+// DeleteTagOutput represents the output of tag deletion.
+type DeleteTagOutput struct {
+	Success bool
+}
+
+// DeleteTagUseCase handles tag deletion logic.
+type DeleteTagUseCase struct {
+	tagRepo adapter.TagRepository
+}
+
+// NewDeleteTagUseCase creates a new DeleteTagUseCase instance.
+func NewDeleteTagUseCase(tagRepo adapter.TagRepository) *DeleteTagUseCase {
+	return &DeleteTagUseCase{
+		tagRepo: tagRepo,
+	}
+}
+
+// Execute performs the tag deletion. The tag is removed from its transactions, which are kept.
+func (uc *DeleteTagUseCase) Execute(ctx context.Context, input DeleteTagInput) (*DeleteTagOutput, error) {
+	// Find the existing tag and check ownership
+	if _, err := findOwnedTag(ctx, uc.tagRepo, input.TagID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	// Delete the tag
+	if err := uc.tagRepo.Delete(ctx, input.TagID); err != nil {
+		return nil, fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	return &DeleteTagOutput{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,120 @@
+// Package tag contains tag-related use cases.
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// UpdateTagInput represents the input for tag update.
+// Nil fields are left unchanged.
+type UpdateTagInput struct {
+	TagID  uuid.UUID
+	UserID uuid.UUID
+	Name   *string
+	Color  *string
+}
+
+// UpdateTagOutput represents the output of tag update.
+type UpdateTagOutput struct {
+	Tag *entity.Tag
+}
+
+// UpdateTagUseCase handles tag update logic.
+type UpdateTagUseCase struct {
+	tagRepo adapter.TagRepository
+}
+
+// NewUpdateTagUseCase creates a new UpdateTagUseCase instance.
+func NewUpdateTagUseCase(tagRepo adapter.TagRepository) *UpdateTagUseCase {
+	return &UpdateTagUseCase{
+		tagRepo: tagRepo,
+	}
+}
+
+// Execute performs the tag update.
+func (uc *UpdateTagUseCase) Execute(ctx context.Context, input UpdateTagInput) (*UpdateTagOutput, error) {
+	// Find the existing tag
+	tag, err := findOwnedTag(ctx, uc.tagRepo, input.TagID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply changes
+	if input.Name != nil {
+		tag.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Color != nil {
+		tag.Color = strings.TrimSpace(*input.Color)
+	}
+
+	// Validate the resulting tag
+	if err := ValidateTag(tag); err != nil {
+		return nil, err
+	}
+
+	// Check if the new name is taken by another tag
+	if input.Name != nil {
+		exists, err := uc.tagRepo.ExistsByNameAndUser(ctx, tag.Name, input.UserID, &tag.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check tag name existence: %w", err)
+		}
+		if exists {
+			return nil, domainerror.NewTagError(
+				domainerror.ErrCodeTagNameExists,
+				"a tag with this name already exists",
+				domainerror.ErrTagNameExists,
+			)
+		}
+	}
+
+	tag.UpdatedAt = time.Now().UTC()
+
+	// Save updated tag
+	if err := uc.tagRepo.Update(ctx, tag); err != nil {
+		return nil, fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	return &UpdateTagOutput{
+		Tag: tag,
+	}, nil
+}
+
+// findOwnedTag loads a tag and verifies it belongs to the user.
+func findOwnedTag(
+	ctx context.Context,
+	tagRepo adapter.TagRepository,
+	tagID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Tag, error) {
+	tag, err := tagRepo.FindByID(ctx, tagID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrTagNotFound) {
+			return nil, domainerror.NewTagError(
+				domainerror.ErrCodeTagNotFound,
+				"tag not found",
+				domainerror.ErrTagNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to find tag: %w", err)
+	}
+
+	if tag.UserID != userID {
+		return nil, domainerror.NewTagError(
+			domainerror.ErrCodeNotAuthorizedTag,
+			"not authorized to access this tag",
+			domainerror.ErrNotAuthorizedTag,
+		)
+	}
+
+	return tag, nil
+}
@@ -17,26 +17,31 @@ type BulkCategorizeTransactionsInput struct {
 	TransactionIDs []uuid.UUID
 	CategoryID     uuid.UUID
 	UserID         uuid.UUID
+	IncludeSplit   bool // Set to true to also categorize split transactions, removing their split lines
 }
 
 // BulkCategorizeTransactionsOutput represents the output of bulk transaction categorization.
 type BulkCategorizeTransactionsOutput struct {
 	UpdatedCount int64
+	OperationID  uuid.UUID // Groups the recorded changes, so the whole operation can be reverted
 }
 
 // BulkCategorizeTransactionsUseCase handles bulk transaction categorization logic.
 type BulkCategorizeTransactionsUseCase struct {
 	transactionRepo adapter.TransactionRepository
+	changeRepo      adapter.TransactionChangeRepository
 	categoryRepo    adapter.CategoryRepository
 }
 
 // NewBulkCategorizeTransactionsUseCase creates a new BulkCategorizeTransactionsUseCase instance.
 func NewBulkCategorizeTransactionsUseCase(
 	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
 	categoryRepo adapter.CategoryRepository,
 ) *BulkCategorizeTransactionsUseCase {
 	return &BulkCategorizeTransactionsUseCase{
 		transactionRepo: transactionRepo,
+		changeRepo:      changeRepo,
 		categoryRepo:    categoryRepo,
 	}
 }
@@ -84,13 +89,43 @@ func (uc *BulkCategorizeTransactionsUseCase) Execute(ctx context.Context, input
 		)
 	}
 
+	// Load the transactions before the update and apply the same rules in memory for the change
+	// history, so nothing can fail once the update is saved
+	transactions, err := uc.transactionRepo.FindByIDs(ctx, input.TransactionIDs, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+	var before, after []*entity.Transaction
+	for _, transaction := range transactions {
+		// Transfers never get a category; split transactions are skipped unless included
+		if transaction.IsTransfer() || (transaction.IsSplit && !input.IncludeSplit) {
+			continue
+		}
+		categorized := snapshotTransaction(transaction)
+		categoryID := input.CategoryID
+		categorized.CategoryID = &categoryID
+		categorized.IsSplit = false
+		categorized.Splits = nil
+		before = append(before, transaction)
+		after = append(after, categorized)
+	}
+
 	// Perform bulk category update (atomic operation)
-	updatedCount, err := uc.transactionRepo.BulkUpdateCategory(ctx, input.TransactionIDs, input.CategoryID, input.UserID)
+	updatedCount, err := uc.transactionRepo.BulkUpdateCategory(
+		ctx, input.TransactionIDs, input.CategoryID, input.UserID, input.IncludeSplit,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to bulk categorize transactions: %w", err)
 	}
 
+	// Record the changes in the transaction history as a single operation
+	operationID := uuid.New()
+	RecordTransactionChanges(ctx, uc.changeRepo,
+		DiffTransactionChanges(before, after, &input.UserID, entity.TransactionChangeSourceBulk, operationID)...,
+	)
+
 	return &BulkCategorizeTransactionsOutput{
 		UpdatedCount: updatedCount,
+		OperationID:  operationID,
 	}, nil
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
 )
 
@@ -24,13 +25,21 @@ type BulkDeleteTransactionsOutput struct {
 
 // BulkDeleteTransactionsUseCase handles bulk transaction deletion logic.
 type BulkDeleteTransactionsUseCase struct {
-	transactionRepo adapter.TransactionRepository
+	transactionRepo   adapter.TransactionRepository
+	changeRepo        adapter.TransactionChangeRepository
+	attachmentCleanup adapter.AttachmentCleanupNotifier
 }
 
 // NewBulkDeleteTransactionsUseCase creates a new BulkDeleteTransactionsUseCase instance.
-func NewBulkDeleteTransactionsUseCase(transactionRepo adapter.TransactionRepository) *BulkDeleteTransactionsUseCase {
+func NewBulkDeleteTransactionsUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	attachmentCleanup adapter.AttachmentCleanupNotifier,
+) *BulkDeleteTransactionsUseCase {
 	return &BulkDeleteTransactionsUseCase{
-		transactionRepo: transactionRepo,
+		transactionRepo:   transactionRepo,
+		changeRepo:        changeRepo,
+		attachmentCleanup: attachmentCleanup,
 	}
 }
 
@@ -58,13 +67,62 @@ func (uc *BulkDeleteTransactionsUseCase) Execute(ctx context.Context, input Bulk
 		)
 	}
 
+	// Keep the transactions, including the other leg of selected transfers, for the change history
+	deleted, err := uc.findWithTransferLegs(ctx, input.TransactionIDs, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Perform bulk delete (atomic operation)
 	deletedCount, err := uc.transactionRepo.BulkDelete(ctx, input.TransactionIDs, input.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to bulk delete transactions: %w", err)
 	}
 
+	// Record the deletions in the transaction history as a single operation
+	RecordTransactionChanges(ctx, uc.changeRepo,
+		DiffTransactionChanges(deleted, nil, &input.UserID, entity.TransactionChangeSourceBulk, uuid.New())...,
+	)
+
+	// Remove the attachments of the deleted transactions in the background
+	if uc.attachmentCleanup != nil {
+		uc.attachmentCleanup.NotifyOwnersDeleted(input.UserID)
+	}
+
 	return &BulkDeleteTransactionsOutput{
 		DeletedCount: deletedCount,
 	}, nil
 }
+
+// findWithTransferLegs retrieves the transactions to delete, adding the other leg of selected transfers.
+func (uc *BulkDeleteTransactionsUseCase) findWithTransferLegs(
+	ctx context.Context,
+	ids []uuid.UUID,
+	userID uuid.UUID,
+) ([]*entity.Transaction, error) {
+	transactions, err := uc.transactionRepo.FindByIDs(ctx, ids, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(transactions))
+	for _, txn := range transactions {
+		seen[txn.ID] = true
+	}
+	for _, txn := range transactions {
+		if !txn.IsTransfer() {
+			continue
+		}
+		legs, err := uc.transactionRepo.FindByTransferID(ctx, *txn.TransferID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find transfer: %w", err)
+		}
+		for _, leg := range legs {
+			if !seen[leg.ID] {
+				seen[leg.ID] = true
+				transactions = append(transactions, leg)
+			}
+		}
+	}
+	return transactions, nil
+}
@@ -0,0 +1,168 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// BulkTagTransactionsInput represents the input for bulk transaction tagging.
+type BulkTagTransactionsInput struct {
+	TransactionIDs []uuid.UUID
+	TagIDs         []uuid.UUID
+	UserID         uuid.UUID
+	Remove         bool // Set to true to detach the tags instead of attaching them
+}
+
+// BulkTagTransactionsOutput represents the output of bulk transaction tagging.
+type BulkTagTransactionsOutput struct {
+	UpdatedCount int64 // Number of transaction-tag links created or removed
+}
+
+// BulkTagTransactionsUseCase handles bulk transaction tagging logic.
+type BulkTagTransactionsUseCase struct {
+	transactionRepo adapter.TransactionRepository
+	changeRepo      adapter.TransactionChangeRepository
+	tagRepo         adapter.TagRepository
+}
+
+// NewBulkTagTransactionsUseCase creates a new BulkTagTransactionsUseCase instance.
+func NewBulkTagTransactionsUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	tagRepo adapter.TagRepository,
+) *BulkTagTransactionsUseCase {
+	return &BulkTagTransactionsUseCase{
+		transactionRepo: transactionRepo,
+		changeRepo:      changeRepo,
+		tagRepo:         tagRepo,
+	}
+}
+
+// Execute performs the bulk transaction tagging.
+func (uc *BulkTagTransactionsUseCase) Execute(ctx context.Context, input BulkTagTransactionsInput) (*BulkTagTransactionsOutput, error) {
+	// Validate that IDs list is not empty
+	if len(input.TransactionIDs) == 0 {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeEmptyTransactionIDs,
+			"transaction IDs list cannot be empty",
+			domainerror.ErrEmptyTransactionIDs,
+		)
+	}
+
+	// Validate tags exist and belong to user
+	tags := make([]*entity.Tag, 0, len(input.TagIDs))
+	for _, tagID := range input.TagIDs {
+		tag, err := uc.tagRepo.FindByID(ctx, tagID)
+		if err != nil {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeTxnTagNotFound,
+				"tag not found",
+				domainerror.ErrTagNotFoundForTransaction,
+			)
+		}
+
+		if tag.UserID != input.UserID {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeTxnTagNotOwned,
+				"tag does not belong to user",
+				domainerror.ErrTagNotOwnedByUser,
+			)
+		}
+		tags = append(tags, tag)
+	}
+
+	// Verify all transactions exist and belong to the user
+	allExist, err := uc.transactionRepo.ExistsAllByIDsAndUser(ctx, input.TransactionIDs, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify transactions: %w", err)
+	}
+	if !allExist {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeTransactionNotFound,
+			"one or more transactions not found or not owned by user",
+			domainerror.ErrTransactionNotFound,
+		)
+	}
+
+	// Load the transactions with their tags before the update for the change history
+	before, err := uc.transactionRepo.FindByIDs(ctx, input.TransactionIDs, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+
+	// Attach or detach the tags (atomic operation); existing links are left as they are
+	var updatedCount int64
+	if input.Remove {
+		updatedCount, err = uc.tagRepo.RemoveFromTransactions(ctx, input.TransactionIDs, input.TagIDs, input.UserID)
+	} else {
+		updatedCount, err = uc.tagRepo.AddToTransactions(ctx, input.TransactionIDs, input.TagIDs, input.UserID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to bulk tag transactions: %w", err)
+	}
+
+	// Record the changes in the transaction history as a single operation
+	after := make([]*entity.Transaction, len(before))
+	for i, transaction := range before {
+		after[i] = snapshotTransaction(transaction)
+		after[i].Tags = applyBulkTags(transaction.Tags, tags, input.Remove)
+	}
+	RecordTransactionChanges(ctx, uc.changeRepo,
+		DiffTransactionChanges(before, after, &input.UserID, entity.TransactionChangeSourceBulk, uuid.New())...,
+	)
+
+	return &BulkTagTransactionsOutput{
+		UpdatedCount: updatedCount,
+	}, nil
+}
+
+// applyBulkTags returns the tags of a transaction after the tags are attached or detached.
+func applyBulkTags(current []*entity.Tag, tags []*entity.Tag, remove bool) []*entity.Tag {
+	changed := make(map[uuid.UUID]bool, len(tags))
+	for _, tag := range tags {
+		changed[tag.ID] = true
+	}
+
+	result := make([]*entity.Tag, 0, len(current)+len(tags))
+	for _, tag := range current {
+		if remove && changed[tag.ID] {
+			continue
+		}
+		// Links that already exist are left as they are
+		delete(changed, tag.ID)
+		result = append(result, tag)
+	}
+	if !remove {
+		for _, tag := range tags {
+			if changed[tag.ID] {
+				delete(changed, tag.ID)
+				result = append(result, tag)
+			}
+		}
+	}
+	return result
+}
+
+// toTagOutputs builds the tag outputs of a transaction.
+func toTagOutputs(tags []*entity.Tag) []*TagOutput {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	outputs := make([]*TagOutput, len(tags))
+	for i, tag := range tags {
+		outputs[i] = &TagOutput{
+			ID:    tag.ID,
+			Name:  tag.Name,
+			Color: tag.Color,
+		}
+	}
+	return outputs
+}
@@ -0,0 +1,362 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// MaxBulkUpdateTransactions is the maximum number of transactions a bulk update can select.
+const MaxBulkUpdateTransactions = 1000
+
+// BulkUpdateSampleSize is the number of updated transactions returned as a sample.
+const BulkUpdateSampleSize = 5
+
+// NotesMode defines how a bulk update sets the notes of the transactions.
+type NotesMode string
+
+const (
+	NotesModeReplace NotesMode = "replace" // Default
+	NotesModeAppend  NotesMode = "append"  // Appended on a new line to existing notes
+)
+
+// BulkTransactionChanges represents the changes a bulk update applies to every selected transaction.
+type BulkTransactionChanges struct {
+	DateShiftDays int // Moves the date by this many days; negative moves it back
+	Notes         *string
+	NotesMode     NotesMode
+	Type          *entity.TransactionType
+	IsRecurring   *bool
+	IsHidden      *bool
+	CategoryID    *uuid.UUID // Not applied to split transactions, which are categorized through their split lines
+	ClearCategory bool       // Set to true to remove the category
+}
+
+// isEmpty returns true if the changes do not change any field.
+func (c BulkTransactionChanges) isEmpty() bool {
+	return c.DateShiftDays == 0 && c.Notes == nil && c.Type == nil && c.IsRecurring == nil &&
+		c.IsHidden == nil && c.CategoryID == nil && !c.ClearCategory
+}
+
+// BulkUpdateTransactionsInput represents the input for bulk transaction updates.
+// Transactions are selected either by ID or by the listing filters.
+type BulkUpdateTransactionsInput struct {
+	UserID         uuid.UUID
+	TransactionIDs []uuid.UUID
+	Filter         *ListTransactionsInput // Pagination and sort fields are ignored
+	Changes        BulkTransactionChanges
+	DryRun         bool // Set to true to only report what would be updated
+}
+
+// BulkUpdateTransactionsOutput represents the output of bulk transaction updates.
+type BulkUpdateTransactionsOutput struct {
+	MatchedCount int64                // Selected transactions
+	UpdatedCount int64                // Transactions changed, or that would be changed on a dry run
+	SkippedCount int64                // Transfer legs, which are updated through their transfer
+	Sample       []*TransactionOutput // First updated transactions, with the changes applied
+	DryRun       bool
+	OperationID  *uuid.UUID // Groups the recorded changes, so the whole operation can be reverted; nil on dry runs
+}
+
+// BulkUpdateTransactionsUseCase handles bulk transaction update logic.
+type BulkUpdateTransactionsUseCase struct {
+	transactionRepo   adapter.TransactionRepository
+	changeRepo        adapter.TransactionChangeRepository
+	categoryRepo      adapter.CategoryRepository
+	converter         *exchangerate.Converter
+	goalAlertNotifier adapter.GoalAlertNotifier
+}
+
+// NewBulkUpdateTransactionsUseCase creates a new BulkUpdateTransactionsUseCase instance.
+func NewBulkUpdateTransactionsUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	categoryRepo adapter.CategoryRepository,
+	converter *exchangerate.Converter,
+	goalAlertNotifier adapter.GoalAlertNotifier,
+) *BulkUpdateTransactionsUseCase {
+	return &BulkUpdateTransactionsUseCase{
+		transactionRepo:   transactionRepo,
+		changeRepo:        changeRepo,
+		categoryRepo:      categoryRepo,
+		converter:         converter,
+		goalAlertNotifier: goalAlertNotifier,
+	}
+}
+
+// Execute performs the bulk transaction update. All transactions are updated at once or none is.
+func (uc *BulkUpdateTransactionsUseCase) Execute(ctx context.Context, input BulkUpdateTransactionsInput) (*BulkUpdateTransactionsOutput, error) {
+	// Validate changes
+	changes := input.Changes
+	if changes.isEmpty() {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeNoBulkChanges,
+			"at least one change is required",
+			domainerror.ErrNoBulkChanges,
+		)
+	}
+	if changes.NotesMode == "" {
+		changes.NotesMode = NotesModeReplace
+	}
+	if changes.NotesMode != NotesModeReplace && changes.NotesMode != NotesModeAppend {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidNotesMode,
+			"notes mode must be 'replace' or 'append'",
+			domainerror.ErrInvalidNotesMode,
+		)
+	}
+	if changes.Type != nil && !isValidTransactionType(*changes.Type) {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidTransactionType,
+			"transaction type must be 'expense' or 'income'",
+			domainerror.ErrInvalidTransactionType,
+		)
+	}
+
+	// Validate category exists and belongs to user
+	var category *entity.Category
+	if changes.ClearCategory {
+		changes.CategoryID = nil
+	} else if changes.CategoryID != nil {
+		cat, err := uc.categoryRepo.FindByID(ctx, *changes.CategoryID)
+		if err != nil {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeTxnCategoryNotFound,
+				"category not found",
+				domainerror.ErrCategoryNotFoundForTransaction,
+			)
+		}
+		if cat.OwnerType != entity.OwnerTypeUser || cat.OwnerID != input.UserID {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeTxnCategoryNotOwned,
+				"category does not belong to user",
+				domainerror.ErrCategoryNotOwnedByUser,
+			)
+		}
+		category = cat
+	}
+
+	// Select the transactions
+	ids, err := uc.selectTransactions(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := uc.transactionRepo.FindByIDs(ctx, ids, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+	transactions = orderTransactionsByIDs(transactions, ids)
+
+	// Apply the changes in memory
+	output := &BulkUpdateTransactionsOutput{
+		MatchedCount: int64(len(transactions)),
+		DryRun:       input.DryRun,
+	}
+	var befores, updated, shifted []*entity.Transaction
+	for _, transaction := range transactions {
+		// Transfer legs are edited together through the transfer
+		if transaction.IsTransfer() {
+			output.SkippedCount++
+			continue
+		}
+
+		before := snapshotTransaction(transaction)
+		changed, err := applyBulkTransactionChanges(transaction, changes)
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			continue
+		}
+		if changes.DateShiftDays != 0 {
+			shifted = append(shifted, transaction)
+		}
+		befores = append(befores, before)
+		updated = append(updated, transaction)
+	}
+	output.UpdatedCount = int64(len(updated))
+
+	// Moved transactions take the exchange rate of their new date
+	if len(shifted) > 0 {
+		if err := snapshotExchangeRates(ctx, uc.converter, input.UserID, shifted); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := 0; i < len(updated) && i < BulkUpdateSampleSize; i++ {
+		var sampleCategory *entity.Category
+		if category != nil && updated[i].CategoryID != nil && *updated[i].CategoryID == category.ID {
+			sampleCategory = category
+		}
+		output.Sample = append(output.Sample, toImportedTransactionOutput(updated[i], sampleCategory))
+	}
+
+	if input.DryRun || len(updated) == 0 {
+		return output, nil
+	}
+
+	// Save all transactions atomically
+	now := time.Now().UTC()
+	for _, transaction := range updated {
+		transaction.UpdatedAt = now
+	}
+	if err := uc.transactionRepo.UpdateMany(ctx, updated); err != nil {
+		return nil, fmt.Errorf("failed to bulk update transactions: %w", err)
+	}
+
+	// Record the changes in the transaction history as a single operation
+	operationID := uuid.New()
+	RecordTransactionChanges(ctx, uc.changeRepo,
+		DiffTransactionChanges(befores, updated, &input.UserID, entity.TransactionChangeSourceBulk, operationID)...,
+	)
+	output.OperationID = &operationID
+
+	// Re-evaluate spending goals in the background
+	if uc.goalAlertNotifier != nil {
+		uc.goalAlertNotifier.NotifyTransactionsChanged(input.UserID)
+	}
+
+	return output, nil
+}
+
+// selectTransactions returns the IDs of the selected transactions, checking they belong to the user.
+func (uc *BulkUpdateTransactionsUseCase) selectTransactions(ctx context.Context, input BulkUpdateTransactionsInput) ([]uuid.UUID, error) {
+	if (len(input.TransactionIDs) == 0) == (input.Filter == nil) {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidBulkSelection,
+			"select transactions either by IDs or by a filter",
+			domainerror.ErrInvalidBulkSelection,
+		)
+	}
+
+	tooMany := domainerror.NewTransactionError(
+		domainerror.ErrCodeTooManyTxnsSelected,
+		fmt.Sprintf("a bulk update cannot select more than %d transactions", MaxBulkUpdateTransactions),
+		domainerror.ErrTooManyTransactionsSelected,
+	)
+
+	if input.Filter != nil {
+		filterInput := *input.Filter
+		filterInput.UserID = input.UserID
+		filter, err := buildTransactionFilter(filterInput)
+		if err != nil {
+			return nil, err
+		}
+
+		ids, total, err := uc.transactionRepo.FindIDsByFilter(ctx, filter, MaxBulkUpdateTransactions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find transactions: %w", err)
+		}
+		if total > MaxBulkUpdateTransactions {
+			return nil, tooMany
+		}
+		return ids, nil
+	}
+
+	// Deduplicate explicit IDs, keeping their order
+	seen := make(map[uuid.UUID]bool, len(input.TransactionIDs))
+	ids := make([]uuid.UUID, 0, len(input.TransactionIDs))
+	for _, id := range input.TransactionIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > MaxBulkUpdateTransactions {
+		return nil, tooMany
+	}
+
+	// Verify all transactions exist and belong to the user
+	allExist, err := uc.transactionRepo.ExistsAllByIDsAndUser(ctx, ids, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify transactions: %w", err)
+	}
+	if !allExist {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeTransactionNotFound,
+			"one or more transactions not found or not owned by user",
+			domainerror.ErrTransactionNotFound,
+		)
+	}
+	return ids, nil
+}
+
+// applyBulkTransactionChanges applies the changes to the transaction and returns whether any field changed.
+func applyBulkTransactionChanges(transaction *entity.Transaction, changes BulkTransactionChanges) (bool, error) {
+	changed := false
+
+	if changes.DateShiftDays != 0 {
+		transaction.Date = transaction.Date.AddDate(0, 0, changes.DateShiftDays)
+		changed = true
+	}
+
+	if changes.Notes != nil {
+		notes := *changes.Notes
+		if changes.NotesMode == NotesModeAppend && transaction.Notes != "" {
+			notes = transaction.Notes + "\n" + notes
+		}
+		if len(notes) > MaxNotesLength {
+			return false, domainerror.NewTransactionError(
+				domainerror.ErrCodeNotesTooLong,
+				fmt.Sprintf("notes of transaction %s would exceed %d characters", transaction.ID, MaxNotesLength),
+				domainerror.ErrNotesTooLong,
+			)
+		}
+		changed = changed || notes != transaction.Notes
+		transaction.Notes = notes
+	}
+
+	if changes.Type != nil && *changes.Type != transaction.Type {
+		transaction.Type = *changes.Type
+		changed = true
+	}
+
+	if changes.IsRecurring != nil && *changes.IsRecurring != transaction.IsRecurring {
+		transaction.IsRecurring = *changes.IsRecurring
+		changed = true
+	}
+
+	if changes.IsHidden != nil && *changes.IsHidden != transaction.IsHidden {
+		transaction.IsHidden = *changes.IsHidden
+		changed = true
+	}
+
+	// The category of a split transaction is set through its split lines
+	if !transaction.IsSplit {
+		if changes.ClearCategory && transaction.CategoryID != nil {
+			transaction.CategoryID = nil
+			changed = true
+		} else if changes.CategoryID != nil &&
+			(transaction.CategoryID == nil || *transaction.CategoryID != *changes.CategoryID) {
+			categoryID := *changes.CategoryID
+			transaction.CategoryID = &categoryID
+			changed = true
+		}
+	}
+
+	return changed, nil
+}
+
+// orderTransactionsByIDs returns the transactions in the order of the IDs.
+func orderTransactionsByIDs(transactions []*entity.Transaction, ids []uuid.UUID) []*entity.Transaction {
+	byID := make(map[uuid.UUID]*entity.Transaction, len(transactions))
+	for _, transaction := range transactions {
+		byID[transaction.ID] = transaction
+	}
+
+	ordered := make([]*entity.Transaction, 0, len(transactions))
+	for _, id := range ids {
+		if transaction, ok := byID[id]; ok {
+			ordered = append(ordered, transaction)
+		}
+	}
+	return ordered
+}
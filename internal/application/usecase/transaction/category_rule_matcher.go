@@ -0,0 +1,95 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// compiledCategoryRule pairs a category rule with its compiled case-insensitive pattern.
+type compiledCategoryRule struct {
+	rule  *entity.CategoryRule
+	regex *regexp.Regexp
+}
+
+// categoryRuleMatcher applies a user's category rules to many descriptions.
+// Rules are fetched and compiled once, and matched categories are cached,
+// which keeps bulk imports from hitting the database once per line.
+type categoryRuleMatcher struct {
+	categoryRepo adapter.CategoryRepository
+	rules        []compiledCategoryRule
+	categories   map[uuid.UUID]*entity.Category
+}
+
+// newCategoryRuleMatcher loads the active rules for the user, sorted by priority (highest first).
+// Failing to load rules is not fatal: the matcher simply never matches.
+func newCategoryRuleMatcher(
+	ctx context.Context,
+	categoryRuleRepo adapter.CategoryRuleRepository,
+	categoryRepo adapter.CategoryRepository,
+	userID uuid.UUID,
+) *categoryRuleMatcher {
+	matcher := &categoryRuleMatcher{
+		categoryRepo: categoryRepo,
+		categories:   make(map[uuid.UUID]*entity.Category),
+	}
+
+	rules, err := categoryRuleRepo.FindActiveByOwner(ctx, entity.OwnerTypeUser, userID)
+	if err != nil {
+		slog.Warn("Failed to fetch category rules for auto-categorization",
+			"userID", userID,
+			"error", err,
+		)
+		return matcher
+	}
+
+	for _, rule := range rules {
+		re, err := regexp.Compile("(?i)" + rule.Pattern)
+		if err != nil {
+			slog.Debug("Invalid regex pattern in category rule",
+				"ruleID", rule.ID,
+				"pattern", rule.Pattern,
+				"error", err,
+			)
+			continue
+		}
+		matcher.rules = append(matcher.rules, compiledCategoryRule{rule: rule, regex: re})
+	}
+
+	return matcher
+}
+
+// Match returns the category of the first rule matching the description, or nil if none match.
+func (m *categoryRuleMatcher) Match(ctx context.Context, description string) (*uuid.UUID, *entity.Category) {
+	for _, compiled := range m.rules {
+		if !compiled.regex.MatchString(description) {
+			continue
+		}
+
+		categoryID := compiled.rule.CategoryID
+		category, ok := m.categories[categoryID]
+		if !ok {
+			cat, err := m.categoryRepo.FindByID(ctx, categoryID)
+			if err != nil {
+				slog.Debug("Failed to fetch category for matched rule",
+					"ruleID", compiled.rule.ID,
+					"categoryID", categoryID,
+					"error", err,
+				)
+				continue
+			}
+			m.categories[categoryID] = cat
+			category = cat
+		}
+
+		return &categoryID, category
+	}
+
+	return nil, nil
+}
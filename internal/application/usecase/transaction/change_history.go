@@ -0,0 +1,83 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// RecordTransactionChanges appends changes to the transaction history, skipping nil (no-op) changes.
+// Recording is best-effort: the mutation has already been saved, so a failure is logged instead of returned.
+func RecordTransactionChanges(
+	ctx context.Context,
+	changeRepo adapter.TransactionChangeRepository,
+	changes ...*entity.TransactionChange,
+) {
+	recorded := compactTransactionChanges(changes)
+	if err := SaveTransactionChanges(ctx, changeRepo, recorded...); err != nil {
+		slog.Warn("Failed to record transaction changes",
+			"operation_id", recorded[0].OperationID,
+			"count", len(recorded),
+			"error", err,
+		)
+	}
+}
+
+// SaveTransactionChanges appends changes to the transaction history, skipping nil (no-op) changes.
+// Unlike RecordTransactionChanges it returns the failure, for callers that write the history in the
+// same database transaction as the mutation.
+func SaveTransactionChanges(
+	ctx context.Context,
+	changeRepo adapter.TransactionChangeRepository,
+	changes ...*entity.TransactionChange,
+) error {
+	recorded := compactTransactionChanges(changes)
+	if changeRepo == nil || len(recorded) == 0 {
+		return nil
+	}
+	return changeRepo.CreateMany(ctx, recorded)
+}
+
+// compactTransactionChanges drops the nil changes returned for mutations that changed no tracked field.
+func compactTransactionChanges(changes []*entity.TransactionChange) []*entity.TransactionChange {
+	recorded := make([]*entity.TransactionChange, 0, len(changes))
+	for _, change := range changes {
+		if change != nil {
+			recorded = append(recorded, change)
+		}
+	}
+	return recorded
+}
+
+// DiffTransactionChanges records the changes between snapshots of transactions taken before and after an operation.
+// Transactions missing from after are recorded as deleted; unchanged transactions are skipped.
+func DiffTransactionChanges(
+	before, after []*entity.Transaction,
+	actorID *uuid.UUID,
+	source entity.TransactionChangeSource,
+	operationID uuid.UUID,
+) []*entity.TransactionChange {
+	afterByID := make(map[uuid.UUID]*entity.Transaction, len(after))
+	for _, txn := range after {
+		afterByID[txn.ID] = txn
+	}
+
+	changes := make([]*entity.TransactionChange, 0, len(before))
+	for _, txn := range before {
+		if change := entity.NewTransactionChange(txn, afterByID[txn.ID], actorID, source, operationID); change != nil {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// snapshotTransaction returns a copy of the transaction's tracked fields, taken before it is modified.
+func snapshotTransaction(transaction *entity.Transaction) *entity.Transaction {
+	snapshot := *transaction
+	return &snapshot
+}
@@ -3,6 +3,7 @@ package transaction
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"regexp"
@@ -12,6 +13,9 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/account"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
+	"github.com/finance-tracker/backend/internal/application/usecase/merchant"
 	"github.com/finance-tracker/backend/internal/domain/entity"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
 )
@@ -31,38 +35,61 @@ type CreateTransactionInput struct {
 	Amount              decimal.Decimal
 	Type                entity.TransactionType
 	CategoryID          *uuid.UUID
+	AccountID           *uuid.UUID
 	Notes               string
 	IsRecurring         bool
-	BillingCycle        string // Format: "YYYY-MM" (e.g., "2024-11") - for credit card transactions
-	IsCreditCardPayment bool   // True if this is a credit card payment transaction
+	BillingCycle        string           // Format: "YYYY-MM" (e.g., "2024-11") - derived for cards with a closing day
+	IsCreditCardPayment bool             // True if this is a credit card payment transaction
+	Currency            string           // Optional ISO 4217 code, defaults to the account's or the user's base currency
+	ExchangeRate        *decimal.Decimal // Optional rate into the base currency, looked up when not given
 }
 
 // CreateTransactionOutput represents the output of transaction creation.
 type CreateTransactionOutput struct {
-	Transaction *TransactionOutput
+	Transaction        *TransactionOutput
+	PossibleDuplicates []*DuplicateMatchOutput // Existing transactions that look like the same entry
 }
 
 // CreateTransactionUseCase handles transaction creation logic.
 type CreateTransactionUseCase struct {
-	transactionRepo  adapter.TransactionRepository
-	categoryRepo     adapter.CategoryRepository
-	categoryRuleRepo adapter.CategoryRuleRepository
+	transactionRepo   adapter.TransactionRepository
+	changeRepo        adapter.TransactionChangeRepository
+	categoryRepo      adapter.CategoryRepository
+	categoryRuleRepo  adapter.CategoryRuleRepository
+	accountRepo       adapter.AccountRepository
+	goalAlertNotifier adapter.GoalAlertNotifier
+	converter         *exchangerate.Converter
+	merchantResolver  *merchant.Resolver
+	calendarLoader    *account.CalendarLoader
 }
 
 // NewCreateTransactionUseCase creates a new CreateTransactionUseCase instance.
 func NewCreateTransactionUseCase(
 	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
 	categoryRepo adapter.CategoryRepository,
 	categoryRuleRepo adapter.CategoryRuleRepository,
+	accountRepo adapter.AccountRepository,
+	goalAlertNotifier adapter.GoalAlertNotifier,
+	converter *exchangerate.Converter,
+	merchantResolver *merchant.Resolver,
+	calendarLoader *account.CalendarLoader,
 ) *CreateTransactionUseCase {
 	return &CreateTransactionUseCase{
-		transactionRepo:  transactionRepo,
-		categoryRepo:     categoryRepo,
-		categoryRuleRepo: categoryRuleRepo,
+		transactionRepo:   transactionRepo,
+		changeRepo:        changeRepo,
+		categoryRepo:      categoryRepo,
+		categoryRuleRepo:  categoryRuleRepo,
+		accountRepo:       accountRepo,
+		goalAlertNotifier: goalAlertNotifier,
+		converter:         converter,
+		merchantResolver:  merchantResolver,
+		calendarLoader:    calendarLoader,
 	}
 }
 
 // Execute performs the transaction creation.
+// Purchases on a credit card with a closing day are charged in the bill their date falls in.
 func (uc *CreateTransactionUseCase) Execute(ctx context.Context, input CreateTransactionInput) (*CreateTransactionOutput, error) {
 	// Validate description length
 	if len(input.Description) > MaxDescriptionLength {
@@ -91,6 +118,12 @@ func (uc *CreateTransactionUseCase) Execute(ctx context.Context, input CreateTra
 		)
 	}
 
+	// Validate currency
+	currency, err := normalizeTransactionCurrency(input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate category if provided, or auto-categorize if not
 	var category *entity.Category
 	if input.CategoryID != nil {
@@ -122,6 +155,30 @@ func (uc *CreateTransactionUseCase) Execute(ctx context.Context, input CreateTra
 		}
 	}
 
+	// Validate account if provided; its transactions default to its currency
+	isCardEntry := false
+	var calendar *entity.BillingCalendar
+	if input.AccountID != nil {
+		account, err := validateTransactionAccount(ctx, uc.accountRepo, *input.AccountID, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if currency == "" {
+			currency = account.Currency
+		}
+		if err := validateAccountCurrency(account, currency); err != nil {
+			return nil, err
+		}
+
+		// Card purchases and refunds belong to a bill, unlike payments of the bill itself
+		isCardEntry = account.IsCreditCard() && !input.IsCreditCardPayment
+		if isCardEntry && uc.calendarLoader != nil {
+			if calendar, err = uc.calendarLoader.Load(ctx, account); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// Create transaction entity
 	transaction := entity.NewTransaction(
 		input.UserID,
@@ -141,12 +198,47 @@ func (uc *CreateTransactionUseCase) Execute(ctx context.Context, input CreateTra
 	if input.IsCreditCardPayment {
 		transaction.IsCreditCardPayment = true
 	}
+	if calendar != nil && transaction.BillingCycle == "" {
+		transaction.BillingCycle = calendar.BillingCycleOf(transaction.Date)
+	}
+	transaction.IsManualCardEntry = isCardEntry && transaction.BillingCycle != ""
+	transaction.AccountID = input.AccountID
+	transaction.Currency = currency
+
+	// Snapshot the rate into the user's base currency
+	if err := snapshotExchangeRate(ctx, uc.converter, transaction, input.ExchangeRate); err != nil {
+		return nil, err
+	}
+
+	// Recognize the merchant; an uncategorized transaction gets the merchant's default category
+	if uc.merchantResolver != nil {
+		if _, err := uc.merchantResolver.Assign(ctx, input.UserID, []*entity.Transaction{transaction}, true); err != nil {
+			return nil, fmt.Errorf("failed to assign merchant: %w", err)
+		}
+		if category == nil && transaction.CategoryID != nil {
+			category, _ = uc.categoryRepo.FindByID(ctx, *transaction.CategoryID)
+		}
+	}
+
+	// Look for existing transactions that are likely the same entry (e.g., already imported)
+	possibleDuplicates := newDuplicateDetector(ctx, uc.transactionRepo, input.UserID, []time.Time{input.Date}).
+		Find(input.Date, input.Description, input.Amount)
 
 	// Save transaction to database
 	if err := uc.transactionRepo.Create(ctx, transaction); err != nil {
 		return nil, fmt.Errorf("failed to create transaction: %w", err)
 	}
 
+	// Record the creation in the transaction history
+	RecordTransactionChanges(ctx, uc.changeRepo,
+		entity.NewTransactionChange(nil, transaction, &input.UserID, entity.TransactionChangeSourceManual, uuid.New()),
+	)
+
+	// Re-evaluate spending goals in the background
+	if uc.goalAlertNotifier != nil {
+		uc.goalAlertNotifier.NotifyTransactionsChanged(input.UserID)
+	}
+
 	// Build output
 	output := &CreateTransactionOutput{
 		Transaction: &TransactionOutput{
@@ -166,7 +258,14 @@ func (uc *CreateTransactionUseCase) Execute(ctx context.Context, input CreateTra
 			InstallmentCurrent:  transaction.InstallmentCurrent,
 			InstallmentTotal:    transaction.InstallmentTotal,
 			CreditCardPaymentID: transaction.CreditCardPaymentID,
+			AccountID:           transaction.AccountID,
+			Currency:            transaction.Currency,
+			ExchangeRate:        transaction.ExchangeRate,
+			BaseAmount:          transaction.BaseAmount(),
+			MerchantID:          transaction.MerchantID,
+			InstallmentPlanID:   transaction.InstallmentPlanID,
 		},
+		PossibleDuplicates: possibleDuplicates,
 	}
 
 	// Add category if present
@@ -183,6 +282,49 @@ func (uc *CreateTransactionUseCase) Execute(ctx context.Context, input CreateTra
 	return output, nil
 }
 
+// validateTransactionAccount checks that the account exists and belongs to the user.
+func validateTransactionAccount(
+	ctx context.Context,
+	accountRepo adapter.AccountRepository,
+	accountID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Account, error) {
+	account, err := accountRepo.FindByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrAccountNotFound) {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeTxnAccountNotFound,
+				"account not found",
+				domainerror.ErrAccountNotFoundForTransaction,
+			)
+		}
+		return nil, fmt.Errorf("failed to find account: %w", err)
+	}
+
+	if account.UserID != userID {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeTxnAccountNotOwned,
+			"account does not belong to user",
+			domainerror.ErrAccountNotOwnedByUser,
+		)
+	}
+
+	return account, nil
+}
+
+// validateAccountCurrency checks that a transaction is in its account's currency, so the account
+// balance never adds up amounts in different currencies.
+func validateAccountCurrency(account *entity.Account, currency string) error {
+	if currency != "" && currency != account.Currency {
+		return domainerror.NewTransactionError(
+			domainerror.ErrCodeTxnCurrencyMismatch,
+			fmt.Sprintf("transaction currency %s differs from the account currency %s", currency, account.Currency),
+			domainerror.ErrTransactionCurrencyMismatch,
+		)
+	}
+	return nil
+}
+
 // isValidTransactionType validates the transaction type.
 func isValidTransactionType(transactionType entity.TransactionType) bool {
 	return transactionType == entity.TransactionTypeExpense || transactionType == entity.TransactionTypeIncome
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
 )
 
@@ -25,13 +26,21 @@ type DeleteTransactionOutput struct {
 
 // DeleteTransactionUseCase handles transaction deletion logic.
 type DeleteTransactionUseCase struct {
-	transactionRepo adapter.TransactionRepository
+	transactionRepo   adapter.TransactionRepository
+	changeRepo        adapter.TransactionChangeRepository
+	attachmentCleanup adapter.AttachmentCleanupNotifier
 }
 
 // NewDeleteTransactionUseCase creates a new DeleteTransactionUseCase instance.
-func NewDeleteTransactionUseCase(transactionRepo adapter.TransactionRepository) *DeleteTransactionUseCase {
+func NewDeleteTransactionUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	attachmentCleanup adapter.AttachmentCleanupNotifier,
+) *DeleteTransactionUseCase {
 	return &DeleteTransactionUseCase{
-		transactionRepo: transactionRepo,
+		transactionRepo:   transactionRepo,
+		changeRepo:        changeRepo,
+		attachmentCleanup: attachmentCleanup,
 	}
 }
 
@@ -59,11 +68,47 @@ func (uc *DeleteTransactionUseCase) Execute(ctx context.Context, input DeleteTra
 		)
 	}
 
+	// Deleting either leg of a transfer deletes the whole transfer
+	if transaction.IsTransfer() {
+		legs, err := uc.transactionRepo.FindByTransferID(ctx, *transaction.TransferID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find transfer: %w", err)
+		}
+
+		if err := uc.transactionRepo.DeleteTransfer(ctx, *transaction.TransferID, input.UserID); err != nil {
+			return nil, fmt.Errorf("failed to delete transfer: %w", err)
+		}
+
+		// Record the deletion of both legs in the transaction history
+		RecordTransactionChanges(ctx, uc.changeRepo,
+			DiffTransactionChanges(legs, nil, &input.UserID, entity.TransactionChangeSourceManual, uuid.New())...,
+		)
+
+		// Remove the attachments of the deleted transfer in the background
+		if uc.attachmentCleanup != nil {
+			uc.attachmentCleanup.NotifyOwnersDeleted(input.UserID)
+		}
+
+		return &DeleteTransactionOutput{
+			Success: true,
+		}, nil
+	}
+
 	// Delete the transaction (soft delete)
 	if err := uc.transactionRepo.Delete(ctx, input.TransactionID); err != nil {
 		return nil, fmt.Errorf("failed to delete transaction: %w", err)
 	}
 
+	// Record the deletion in the transaction history
+	RecordTransactionChanges(ctx, uc.changeRepo,
+		entity.NewTransactionChange(transaction, nil, &input.UserID, entity.TransactionChangeSourceManual, uuid.New()),
+	)
+
+	// Remove the attachments of the deleted transaction in the background
+	if uc.attachmentCleanup != nil {
+		uc.attachmentCleanup.NotifyOwnersDeleted(input.UserID)
+	}
+
 	return &DeleteTransactionOutput{
 		Success: true,
 	}, nil
@@ -0,0 +1,76 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// DismissDuplicateInput represents the input for dismissing a likely duplicate pair.
+type DismissDuplicateInput struct {
+	UserID        uuid.UUID
+	TransactionID uuid.UUID
+	DuplicateID   uuid.UUID
+}
+
+// DismissDuplicateOutput represents the output of dismissing a likely duplicate pair.
+type DismissDuplicateOutput struct {
+	Success bool
+}
+
+// DismissDuplicateUseCase handles marking two transactions as distinct.
+type DismissDuplicateUseCase struct {
+	transactionRepo adapter.TransactionRepository
+	dismissalRepo   adapter.DuplicateDismissalRepository
+}
+
+// NewDismissDuplicateUseCase creates a new DismissDuplicateUseCase instance.
+func NewDismissDuplicateUseCase(
+	transactionRepo adapter.TransactionRepository,
+	dismissalRepo adapter.DuplicateDismissalRepository,
+) *DismissDuplicateUseCase {
+	return &DismissDuplicateUseCase{
+		transactionRepo: transactionRepo,
+		dismissalRepo:   dismissalRepo,
+	}
+}
+
+// Execute performs the dismissal. Dismissing an already dismissed pair succeeds.
+func (uc *DismissDuplicateUseCase) Execute(ctx context.Context, input DismissDuplicateInput) (*DismissDuplicateOutput, error) {
+	if input.TransactionID == input.DuplicateID {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeSameTransactionPair,
+			"a transaction cannot be dismissed against itself",
+			domainerror.ErrSameTransactionPair,
+		)
+	}
+
+	// Check ownership of both transactions
+	for _, id := range []uuid.UUID{input.TransactionID, input.DuplicateID} {
+		if _, err := findOwnedTransaction(ctx, uc.transactionRepo, id, input.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Record the dismissal once
+	exists, err := uc.dismissalRepo.ExistsByPair(ctx, input.TransactionID, input.DuplicateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check dismissed duplicates: %w", err)
+	}
+	if !exists {
+		dismissal := entity.NewDuplicateDismissal(input.UserID, input.TransactionID, input.DuplicateID)
+		if err := uc.dismissalRepo.Create(ctx, dismissal); err != nil {
+			return nil, fmt.Errorf("failed to dismiss duplicate: %w", err)
+		}
+	}
+
+	return &DismissDuplicateOutput{
+		Success: true,
+	}, nil
+}
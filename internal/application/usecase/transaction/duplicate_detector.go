@@ -0,0 +1,107 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
+)
+
+// DuplicateMatchOutput represents an existing transaction that is likely a duplicate of an entry.
+type DuplicateMatchOutput struct {
+	TransactionID uuid.UUID
+	Date          time.Time
+	Description   string
+	Amount        decimal.Decimal
+	Score         float64 // 0-1.0, higher is more likely
+}
+
+// duplicateDetector compares new entries against the user's existing transactions.
+// Candidates are fetched once for the whole date span of the entries,
+// which keeps bulk imports from hitting the database once per line.
+type duplicateDetector struct {
+	config     valueobject.DuplicateDetectionConfig
+	candidates []valueobject.DuplicateCandidate
+}
+
+// newDuplicateDetector loads the user's transactions around the given dates.
+// Failing to load candidates is not fatal: the detector simply never matches.
+func newDuplicateDetector(
+	ctx context.Context,
+	transactionRepo adapter.TransactionRepository,
+	userID uuid.UUID,
+	dates []time.Time,
+) *duplicateDetector {
+	detector := &duplicateDetector{
+		config: valueobject.DefaultDuplicateDetectionConfig(),
+	}
+	if len(dates) == 0 {
+		return detector
+	}
+
+	minDate, maxDate := dates[0], dates[0]
+	for _, date := range dates[1:] {
+		if date.Before(minDate) {
+			minDate = date
+		}
+		if date.After(maxDate) {
+			maxDate = date
+		}
+	}
+
+	window := detector.config.DateWindowDays + 1
+	transactions, err := transactionRepo.FindDuplicateCandidates(
+		ctx,
+		userID,
+		minDate.AddDate(0, 0, -window),
+		maxDate.AddDate(0, 0, window),
+	)
+	if err != nil {
+		slog.Warn("Failed to fetch transactions for duplicate detection",
+			"userID", userID,
+			"error", err,
+		)
+		return detector
+	}
+
+	detector.candidates = toDuplicateCandidates(transactions)
+	return detector
+}
+
+// Find returns the existing transactions that are likely duplicates of the entry, best match first.
+func (d *duplicateDetector) Find(date time.Time, description string, amount decimal.Decimal) []*DuplicateMatchOutput {
+	matches := d.config.FindMatches(date, description, amount, d.candidates)
+
+	output := make([]*DuplicateMatchOutput, len(matches))
+	for i, match := range matches {
+		output[i] = &DuplicateMatchOutput{
+			TransactionID: match.Candidate.ID,
+			Date:          match.Candidate.Date,
+			Description:   match.Candidate.Description,
+			Amount:        match.Candidate.Amount,
+			Score:         match.Score,
+		}
+	}
+	return output
+}
+
+// toDuplicateCandidates converts transactions to duplicate detection candidates.
+func toDuplicateCandidates(transactions []*entity.Transaction) []valueobject.DuplicateCandidate {
+	candidates := make([]valueobject.DuplicateCandidate, len(transactions))
+	for i, txn := range transactions {
+		candidates[i] = valueobject.DuplicateCandidate{
+			ID:          txn.ID,
+			Date:        txn.Date,
+			Description: txn.Description,
+			Amount:      txn.Amount,
+		}
+	}
+	return candidates
+}
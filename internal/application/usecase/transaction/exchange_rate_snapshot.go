@@ -0,0 +1,90 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// normalizeTransactionCurrency upper-cases the currency and checks it is an ISO 4217 code.
+// An empty currency is kept empty, meaning the user's base currency.
+func normalizeTransactionCurrency(currency string) (string, error) {
+	currency = exchangerate.NormalizeCurrency(currency)
+	if currency != "" && !entity.IsValidCurrencyCode(currency) {
+		return "", domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidTxnCurrency,
+			"currency must be a 3-letter ISO 4217 code",
+			domainerror.ErrInvalidTransactionCurrency,
+		)
+	}
+	return currency, nil
+}
+
+// snapshotExchangeRate records the rate converting the transaction into the user's base currency.
+// A positive explicitRate (e.g., the rate printed on a card statement) takes precedence over the
+// user's rate table for foreign-currency transactions.
+func snapshotExchangeRate(
+	ctx context.Context,
+	converter *exchangerate.Converter,
+	transaction *entity.Transaction,
+	explicitRate *decimal.Decimal,
+) error {
+	if explicitRate != nil && !explicitRate.IsPositive() {
+		return domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidTxnRate,
+			"exchange rate must be greater than zero",
+			domainerror.ErrInvalidTransactionRate,
+		)
+	}
+
+	if converter == nil {
+		if explicitRate != nil {
+			transaction.ExchangeRate = *explicitRate
+		}
+		return nil
+	}
+
+	if explicitRate != nil {
+		baseCurrency, err := converter.BaseCurrency(ctx, transaction.UserID)
+		if err != nil {
+			return err
+		}
+		if transaction.Currency != "" && transaction.Currency != baseCurrency {
+			transaction.ExchangeRate = *explicitRate
+			return nil
+		}
+	}
+
+	return snapshotExchangeRates(ctx, converter, transaction.UserID, []*entity.Transaction{transaction})
+}
+
+// snapshotExchangeRates records the rate into the user's base currency on each transaction using
+// the user's rate table. A missing rate is reported as a transaction error.
+func snapshotExchangeRates(
+	ctx context.Context,
+	converter *exchangerate.Converter,
+	userID uuid.UUID,
+	transactions []*entity.Transaction,
+) error {
+	if converter == nil {
+		return nil
+	}
+
+	err := converter.Snapshot(ctx, userID, transactions...)
+	var rateErr *domainerror.ExchangeRateError
+	if errors.As(err, &rateErr) {
+		return domainerror.NewTransactionError(
+			domainerror.ErrCodeTxnRateUnavailable,
+			rateErr.Message,
+			domainerror.ErrExchangeRateUnavailable,
+		)
+	}
+	return err
+}
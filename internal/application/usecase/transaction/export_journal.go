@@ -0,0 +1,616 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
+)
+
+// linkedCyclesPageSize is the number of reconciled billing cycles loaded at a time.
+const linkedCyclesPageSize = 100
+
+// Journal account names.
+const (
+	journalUnassignedAccount     = "Assets:Unassigned"
+	journalCreditCardAccount     = "Liabilities:Credit-Card"
+	journalOpeningBalanceAccount = "Equity:Opening-Balances"
+	journalTransferAccount       = "Equity:Transfers" // Counterpart of transfer legs whose other leg was deleted
+	journalUncategorizedName     = "Uncategorized"
+)
+
+// ExportJournalInput represents the input for exporting the ledger as a plain-text accounting journal.
+type ExportJournalInput struct {
+	UserID uuid.UUID
+	Format adapter.JournalFormat // Defaults to Beancount
+}
+
+// ExportJournalOutput represents a prepared journal export. The file is only written when streamed,
+// so the caller can send the content type and file name first.
+type ExportJournalOutput struct {
+	ContentType string
+	FileName    string
+
+	exporter   adapter.JournalExporter
+	userID     uuid.UUID
+	options    adapter.JournalExportOptions
+	categories map[uuid.UUID]*entity.Category
+	accounts   map[uuid.UUID]*entity.Account
+	bills      map[uuid.UUID]*journalBill // Reconciled bill payments by ID
+	repo       adapter.TransactionRepository
+}
+
+// ExportJournalUseCase handles exporting the ledger to Beancount and hledger journals.
+type ExportJournalUseCase struct {
+	transactionRepo    adapter.TransactionRepository
+	userRepo           adapter.UserRepository
+	categoryRepo       adapter.CategoryRepository
+	accountRepo        adapter.AccountRepository
+	reconciliationRepo adapter.ReconciliationRepository
+	exporters          map[adapter.JournalFormat]adapter.JournalExporter
+}
+
+// NewExportJournalUseCase creates a new ExportJournalUseCase instance.
+func NewExportJournalUseCase(
+	transactionRepo adapter.TransactionRepository,
+	userRepo adapter.UserRepository,
+	categoryRepo adapter.CategoryRepository,
+	accountRepo adapter.AccountRepository,
+	reconciliationRepo adapter.ReconciliationRepository,
+	exporters map[adapter.JournalFormat]adapter.JournalExporter,
+) *ExportJournalUseCase {
+	return &ExportJournalUseCase{
+		transactionRepo:    transactionRepo,
+		userRepo:           userRepo,
+		categoryRepo:       categoryRepo,
+		accountRepo:        accountRepo,
+		reconciliationRepo: reconciliationRepo,
+		exporters:          exporters,
+	}
+}
+
+// Execute validates the export and prepares it for streaming.
+func (uc *ExportJournalUseCase) Execute(ctx context.Context, input ExportJournalInput) (*ExportJournalOutput, error) {
+	// Resolve the exporter
+	format := input.Format
+	if format == "" {
+		format = adapter.JournalFormatBeancount
+	}
+	exporter, ok := uc.exporters[format]
+	if !ok {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidExportFormat,
+			fmt.Sprintf("unsupported journal format '%s'", format),
+			domainerror.ErrInvalidExportFormat,
+		)
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// Categories become income and expense accounts; split lines are not loaded with their category
+	categories, err := uc.categoryRepo.FindByOwner(ctx, entity.OwnerTypeUser, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find categories: %w", err)
+	}
+	categoriesByID := make(map[uuid.UUID]*entity.Category, len(categories))
+	for _, category := range categories {
+		categoriesByID[category.ID] = category
+	}
+
+	accounts, err := uc.accountRepo.FindByUser(ctx, input.UserID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find accounts: %w", err)
+	}
+	accountsByID := make(map[uuid.UUID]*entity.Account, len(accounts))
+	for _, account := range accounts {
+		accountsByID[account.ID] = account
+	}
+
+	// Bill payments reconciled with a billing cycle pay off that cycle's liability on each card
+	// the bill's transactions were charged to
+	bills := make(map[uuid.UUID]*journalBill)
+	for offset := 0; ; offset += linkedCyclesPageSize {
+		cycles, err := uc.reconciliationRepo.GetLinkedBillingCycles(ctx, input.UserID, linkedCyclesPageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get linked billing cycles: %w", err)
+		}
+		for _, cycle := range cycles {
+			linked, err := uc.transactionRepo.GetLinkedTransactions(ctx, cycle.BillID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get linked transactions: %w", err)
+			}
+			bills[cycle.BillID] = newJournalBill(cycle.BillingCycle, linked, accountsByID)
+		}
+		if len(cycles) < linkedCyclesPageSize {
+			break
+		}
+	}
+
+	now := time.Now().UTC()
+	return &ExportJournalOutput{
+		ContentType: exporter.ContentType(),
+		FileName:    fmt.Sprintf("transactions-%s.%s", now.Format("20060102"), exporter.FileExtension()),
+		exporter:    exporter,
+		userID:      input.UserID,
+		options: adapter.JournalExportOptions{
+			BaseCurrency: user.BaseCurrency,
+			GeneratedAt:  now,
+		},
+		categories: categoriesByID,
+		accounts:   accountsByID,
+		bills:      bills,
+		repo:       uc.transactionRepo,
+	}, nil
+}
+
+// Stream writes the journal to w, loading the transactions oldest first in batches.
+func (o *ExportJournalOutput) Stream(ctx context.Context, w io.Writer) error {
+	writer, err := o.exporter.NewWriter(w, o.options)
+	if err != nil {
+		return err
+	}
+
+	builder := newJournalBuilder(o, writer)
+	filter := adapter.TransactionFilter{UserID: o.userID}
+	order := valueobject.TransactionSort{Field: valueobject.TransactionSortByDate, Direction: valueobject.SortAscending}
+	err = o.repo.StreamByFilter(ctx, filter, order, ExportBatchSize, func(batch []*entity.TransactionWithCategory) error {
+		for _, txnWithCat := range batch {
+			if err := builder.add(txnWithCat.Transaction); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export journal: %w", err)
+	}
+
+	if err := builder.finish(); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+// journalCycleKey identifies the billing cycle of a card. Cards with the same closing day share
+// cycle names, so the card is part of the key.
+type journalCycleKey struct {
+	card         string
+	billingCycle string
+}
+
+// journalCycle tracks the liability of a credit card billing cycle.
+type journalCycle struct {
+	account    string
+	balance    decimal.Decimal
+	currency   string
+	mixed      bool       // Postings in more than one currency, so no single balance can be asserted
+	lastDate   time.Time  // Date of the last posting to the cycle
+	billPaidAt *time.Time // Set once the reconciled bill payment is posted
+}
+
+// journalBill is a reconciled bill payment and what it owes to each card it pays.
+type journalBill struct {
+	billingCycle string
+	cards        []string                   // Card accounts, sorted
+	owed         map[string]decimal.Decimal // Total of the bill's transactions on each card
+}
+
+// newJournalBill groups the transactions linked to a bill payment by the card they were charged to.
+func newJournalBill(billingCycle string, linked []*entity.Transaction, accounts map[uuid.UUID]*entity.Account) *journalBill {
+	bill := &journalBill{billingCycle: billingCycle, owed: make(map[string]decimal.Decimal)}
+	for _, t := range linked {
+		if t.IsHidden || t.BillingCycle != billingCycle {
+			continue
+		}
+		// Statement imports record card charges as positive amounts
+		owed := t.Amount
+		if t.IsManualCardEntry {
+			owed = owed.Neg()
+		}
+		card := cardAccount(t, accounts)
+		if _, ok := bill.owed[card]; !ok {
+			bill.cards = append(bill.cards, card)
+		}
+		bill.owed[card] = bill.owed[card].Add(owed)
+	}
+	sort.Strings(bill.cards)
+	return bill
+}
+
+// journalPrice is an exchange rate to record before the next entry.
+type journalPrice struct {
+	date      time.Time
+	commodity string
+	rate      adapter.JournalAmount
+}
+
+// journalBuilder turns transactions into journal directives. Accounts are opened at the first
+// transaction date, transfer legs are joined into one entry, and billing cycles paid by a
+// reconciled bill get a balance assertion.
+type journalBuilder struct {
+	output    *ExportJournalOutput
+	writer    adapter.JournalWriter
+	firstDate *time.Time
+	opened    map[string]bool
+	prices    map[string]bool
+	newPrices []journalPrice
+	transfers map[uuid.UUID]*entity.Transaction // First legs waiting for their other leg
+	pending   []uuid.UUID                       // Transfer IDs in the order their first leg was read
+	cycles    map[journalCycleKey]*journalCycle
+}
+
+// newJournalBuilder creates a builder writing to writer.
+func newJournalBuilder(output *ExportJournalOutput, writer adapter.JournalWriter) *journalBuilder {
+	return &journalBuilder{
+		output:    output,
+		writer:    writer,
+		opened:    make(map[string]bool),
+		prices:    make(map[string]bool),
+		transfers: make(map[uuid.UUID]*entity.Transaction),
+		cycles:    make(map[journalCycleKey]*journalCycle),
+	}
+}
+
+// add writes the entry of a transaction. Hidden transactions are not part of the ledger.
+func (b *journalBuilder) add(t *entity.Transaction) error {
+	if t.IsHidden {
+		return nil
+	}
+	if b.firstDate == nil {
+		date := t.Date
+		b.firstDate = &date
+	}
+
+	if t.TransferID != nil {
+		first, ok := b.transfers[*t.TransferID]
+		if !ok {
+			b.transfers[*t.TransferID] = t
+			b.pending = append(b.pending, *t.TransferID)
+			return nil
+		}
+		delete(b.transfers, *t.TransferID)
+		return b.writeTransfer(first, t)
+	}
+
+	if bill, ok := b.output.bills[t.ID]; ok {
+		return b.writeBillPayment(t, bill)
+	}
+	return b.writeTransaction(t)
+}
+
+// finish writes transfers missing a leg and the balance assertions of paid billing cycles.
+func (b *journalBuilder) finish() error {
+	for _, transferID := range b.pending {
+		leg, ok := b.transfers[transferID]
+		if !ok {
+			continue
+		}
+		amount := b.amount(leg, leg.Amount)
+		entry := b.entry(leg, []adapter.JournalPosting{
+			{Account: b.moneyAccount(leg), Amount: amount},
+			{Account: journalTransferAccount, Amount: negate(amount)},
+		})
+		if err := b.writeEntry(entry); err != nil {
+			return err
+		}
+	}
+
+	keys := make([]journalCycleKey, 0, len(b.cycles))
+	for key := range b.cycles {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].card != keys[j].card {
+			return keys[i].card < keys[j].card
+		}
+		return keys[i].billingCycle < keys[j].billingCycle
+	})
+	for _, key := range keys {
+		cycle := b.cycles[key]
+		if cycle.billPaidAt == nil || cycle.mixed {
+			continue
+		}
+		date := cycle.lastDate
+		if cycle.billPaidAt.After(date) {
+			date = *cycle.billPaidAt
+		}
+		amount := adapter.JournalAmount{Number: cycle.balance, Currency: cycle.currency}
+		if err := b.writer.Balance(date, cycle.account, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeTransaction writes an expense or income, posting to its categories and the account it was paid from.
+// Credit card purchases are owed on the liability of their billing cycle.
+func (b *journalBuilder) writeTransaction(t *entity.Transaction) error {
+	// Statement imports record card charges as positive amounts; card purchases entered in the app
+	// keep the app's sign
+	signed := func(amount decimal.Decimal) decimal.Decimal { return amount }
+	if t.BillingCycle != "" && !t.IsManualCardEntry {
+		signed = decimal.Decimal.Neg
+	}
+	total := signed(t.Amount)
+
+	var postings []adapter.JournalPosting
+	if t.IsSplit && len(t.Splits) > 0 {
+		allocated := decimal.Zero
+		for _, split := range t.Splits {
+			var category *entity.Category
+			if split.CategoryID != nil {
+				category = b.output.categories[*split.CategoryID]
+			}
+			amount := signed(split.Amount)
+			postings = append(postings, adapter.JournalPosting{
+				Account: categoryAccount(category, amount),
+				Amount:  b.amount(t, amount.Neg()),
+			})
+			allocated = allocated.Add(amount)
+		}
+		if remainder := total.Sub(allocated); !remainder.IsZero() {
+			postings = append(postings, adapter.JournalPosting{
+				Account: categoryAccount(nil, remainder),
+				Amount:  b.amount(t, remainder.Neg()),
+			})
+		}
+	} else {
+		var category *entity.Category
+		if t.CategoryID != nil {
+			category = b.output.categories[*t.CategoryID]
+		}
+		postings = append(postings, adapter.JournalPosting{
+			Account: categoryAccount(category, total),
+			Amount:  b.amount(t, total.Neg()),
+		})
+	}
+
+	money := adapter.JournalPosting{Account: b.moneyAccount(t), Amount: b.amount(t, total)}
+	if t.BillingCycle != "" {
+		money.Account = b.postToCycle(t, cardAccount(t, b.output.accounts), t.BillingCycle, money.Amount)
+	}
+	postings = append(postings, money)
+
+	return b.writeEntry(b.entry(t, postings))
+}
+
+// writeBillPayment writes a reconciled bill payment as paying off its billing cycle. Expanded bills are
+// zeroed in the ledger, so the original bill amount is paid. A bill covering several cards pays each
+// card what was charged to it, and the first card the rest, so a mismatch fails its balance assertion.
+func (b *journalBuilder) writeBillPayment(t *entity.Transaction, bill *journalBill) error {
+	paid := t.Amount.Abs()
+	if t.OriginalAmount != nil {
+		paid = t.OriginalAmount.Abs()
+	}
+	amount := b.amount(t, paid)
+
+	cards := bill.cards
+	if len(cards) == 0 {
+		cards = []string{journalCreditCardAccount}
+	}
+	shares := make([]decimal.Decimal, len(cards))
+	shares[0] = amount.Number
+	for i := 1; i < len(cards); i++ {
+		shares[i] = bill.owed[cards[i]]
+		shares[0] = shares[0].Sub(shares[i])
+	}
+
+	date := t.Date
+	postings := make([]adapter.JournalPosting, 0, len(cards)+1)
+	for i, card := range cards {
+		share := adapter.JournalAmount{Number: shares[i], Currency: amount.Currency}
+		cycleAccount := b.postToCycle(t, card, bill.billingCycle, share)
+		b.cycles[journalCycleKey{card: card, billingCycle: bill.billingCycle}].billPaidAt = &date
+		postings = append(postings, adapter.JournalPosting{Account: cycleAccount, Amount: share})
+	}
+	postings = append(postings, adapter.JournalPosting{Account: b.moneyAccount(t), Amount: negate(amount)})
+
+	return b.writeEntry(b.entry(t, postings))
+}
+
+// writeTransfer writes both legs of a transfer as one entry. Legs in different currencies
+// are converted at the amount received.
+func (b *journalBuilder) writeTransfer(first, second *entity.Transaction) error {
+	from := adapter.JournalPosting{Account: b.moneyAccount(first), Amount: b.amount(first, first.Amount)}
+	to := adapter.JournalPosting{Account: b.moneyAccount(second), Amount: b.amount(second, second.Amount)}
+	if from.Amount.Currency != to.Amount.Currency {
+		price := adapter.JournalAmount{Number: to.Amount.Number.Abs(), Currency: to.Amount.Currency}
+		from.Price = &price
+	}
+	return b.writeEntry(b.entry(first, []adapter.JournalPosting{from, to}))
+}
+
+// writeEntry opens the accounts the entry posts to, records the rates of its currencies and writes it.
+func (b *journalBuilder) writeEntry(entry *adapter.JournalEntry) error {
+	for _, price := range b.newPrices {
+		if err := b.writer.Price(price.date, price.commodity, price.rate); err != nil {
+			return err
+		}
+	}
+	b.newPrices = nil
+
+	for _, posting := range entry.Postings {
+		if err := b.open(posting.Account); err != nil {
+			return err
+		}
+	}
+	return b.writer.Entry(entry)
+}
+
+// open declares an account the first time it is posted to. Accounts of the user open with their opening balance.
+func (b *journalBuilder) open(account string) error {
+	if b.opened[account] {
+		return nil
+	}
+	b.opened[account] = true
+	if err := b.writer.Open(*b.firstDate, account); err != nil {
+		return err
+	}
+
+	for _, a := range b.output.accounts {
+		if a.OpeningBalance.IsZero() || accountName(a) != account {
+			continue
+		}
+		currency := a.Currency
+		if currency == "" {
+			currency = b.output.options.BaseCurrency
+		}
+		balance := adapter.JournalAmount{Number: a.OpeningBalance, Currency: currency}
+		return b.writeEntry(&adapter.JournalEntry{
+			Date:      *b.firstDate,
+			Narration: "Opening balance",
+			Postings: []adapter.JournalPosting{
+				{Account: account, Amount: balance},
+				{Account: journalOpeningBalanceAccount, Amount: negate(balance)},
+			},
+		})
+	}
+	return nil
+}
+
+// entry builds the entry of a transaction.
+func (b *journalBuilder) entry(t *entity.Transaction, postings []adapter.JournalPosting) *adapter.JournalEntry {
+	entry := &adapter.JournalEntry{
+		Date:      t.Date,
+		Narration: t.Description,
+		Notes:     t.Notes,
+		ID:        t.ID,
+		Postings:  postings,
+	}
+	for _, tag := range t.Tags {
+		entry.Tags = append(entry.Tags, tag.Name)
+	}
+	return entry
+}
+
+// amount returns an amount in the currency of the transaction, recording the rate into the base
+// currency the first time a foreign currency is used on a date.
+func (b *journalBuilder) amount(t *entity.Transaction, number decimal.Decimal) adapter.JournalAmount {
+	currency := t.Currency
+	if currency == "" {
+		currency = b.output.options.BaseCurrency
+	}
+	if currency != b.output.options.BaseCurrency && !t.ExchangeRate.IsZero() {
+		key := t.Date.Format("2006-01-02") + currency
+		if !b.prices[key] {
+			b.prices[key] = true
+			b.newPrices = append(b.newPrices, journalPrice{
+				date:      t.Date,
+				commodity: currency,
+				rate:      adapter.JournalAmount{Number: t.ExchangeRate, Currency: b.output.options.BaseCurrency},
+			})
+		}
+	}
+	return adapter.JournalAmount{Number: number, Currency: currency}
+}
+
+// postToCycle adds a posting to the liability of a card's billing cycle and returns the cycle account.
+func (b *journalBuilder) postToCycle(t *entity.Transaction, card string, billingCycle string, amount adapter.JournalAmount) string {
+	key := journalCycleKey{card: card, billingCycle: billingCycle}
+	cycle, ok := b.cycles[key]
+	if !ok {
+		cycle = &journalCycle{
+			account:  card + ":" + accountComponent(billingCycle),
+			currency: amount.Currency,
+		}
+		b.cycles[key] = cycle
+	}
+
+	if amount.Currency != cycle.currency {
+		cycle.mixed = true
+	}
+	cycle.balance = cycle.balance.Add(amount.Number)
+	if t.Date.After(cycle.lastDate) {
+		cycle.lastDate = t.Date
+	}
+	return cycle.account
+}
+
+// moneyAccount returns the asset or liability account a transaction was paid from or into.
+func (b *journalBuilder) moneyAccount(t *entity.Transaction) string {
+	if t.AccountID != nil {
+		if account, ok := b.output.accounts[*t.AccountID]; ok {
+			return accountName(account)
+		}
+	}
+	return journalUnassignedAccount
+}
+
+// cardAccount returns the liability account of the card a transaction was charged to. Card
+// transactions without a credit card account share a generic card account.
+func cardAccount(t *entity.Transaction, accounts map[uuid.UUID]*entity.Account) string {
+	if t.AccountID != nil {
+		if account, ok := accounts[*t.AccountID]; ok && account.IsCreditCard() {
+			return accountName(account)
+		}
+	}
+	return journalCreditCardAccount
+}
+
+// accountName returns the journal account of a user account; credit cards are liabilities.
+func accountName(account *entity.Account) string {
+	if account.IsCreditCard() {
+		return "Liabilities:" + accountComponent(account.Name)
+	}
+	return "Assets:" + accountComponent(account.Name)
+}
+
+// categoryAccount returns the income or expense account of a category. Without a category,
+// the sign of the amount decides between the uncategorized income and expense accounts.
+func categoryAccount(category *entity.Category, amount decimal.Decimal) string {
+	if category == nil {
+		if amount.IsPositive() {
+			return "Income:" + journalUncategorizedName
+		}
+		return "Expenses:" + journalUncategorizedName
+	}
+	if category.Type == entity.CategoryTypeIncome {
+		return "Income:" + accountComponent(category.Name)
+	}
+	return "Expenses:" + accountComponent(category.Name)
+}
+
+// accountComponent turns a name into an account name component, which starts with a capital
+// letter or digit and only contains letters, digits and dashes.
+func accountComponent(name string) string {
+	var builder strings.Builder
+	dash := false
+	for _, r := range strings.TrimSpace(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && builder.Len() > 0 {
+				builder.WriteRune('-')
+			}
+			dash = false
+			if builder.Len() == 0 {
+				r = unicode.ToUpper(r)
+			}
+			builder.WriteRune(r)
+			continue
+		}
+		dash = true
+	}
+	if builder.Len() == 0 {
+		return "Unnamed"
+	}
+	return builder.String()
+}
+
+// negate returns the opposite amount.
+func negate(amount adapter.JournalAmount) adapter.JournalAmount {
+	return adapter.JournalAmount{Number: amount.Number.Neg(), Currency: amount.Currency}
+}
@@ -0,0 +1,190 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
+)
+
+// ExportBatchSize is the number of transactions loaded at a time while an export is written.
+const ExportBatchSize = 500
+
+// ExportTransactionsInput represents the input for exporting transactions.
+type ExportTransactionsInput struct {
+	Filter ListTransactionsInput // Pagination fields are ignored; without SortBy, transactions are exported oldest first
+	Format adapter.ExportFormat  // Defaults to CSV
+}
+
+// ExportTransactionsOutput represents a prepared export. The file is only written when streamed,
+// so the caller can send the content type and file name first.
+type ExportTransactionsOutput struct {
+	ContentType string
+	FileName    string
+
+	exporter   adapter.TransactionExporter
+	filter     adapter.TransactionFilter
+	sort       valueobject.TransactionSort
+	options    adapter.TransactionExportOptions
+	categories map[uuid.UUID]string
+	accounts   map[uuid.UUID]string
+	repo       adapter.TransactionRepository
+}
+
+// ExportTransactionsUseCase handles transaction export logic.
+type ExportTransactionsUseCase struct {
+	transactionRepo adapter.TransactionRepository
+	userRepo        adapter.UserRepository
+	categoryRepo    adapter.CategoryRepository
+	accountRepo     adapter.AccountRepository
+	exporters       map[adapter.ExportFormat]adapter.TransactionExporter
+}
+
+// NewExportTransactionsUseCase creates a new ExportTransactionsUseCase instance.
+func NewExportTransactionsUseCase(
+	transactionRepo adapter.TransactionRepository,
+	userRepo adapter.UserRepository,
+	categoryRepo adapter.CategoryRepository,
+	accountRepo adapter.AccountRepository,
+	exporters map[adapter.ExportFormat]adapter.TransactionExporter,
+) *ExportTransactionsUseCase {
+	return &ExportTransactionsUseCase{
+		transactionRepo: transactionRepo,
+		userRepo:        userRepo,
+		categoryRepo:    categoryRepo,
+		accountRepo:     accountRepo,
+		exporters:       exporters,
+	}
+}
+
+// Execute validates the export and prepares it for streaming.
+func (uc *ExportTransactionsUseCase) Execute(ctx context.Context, input ExportTransactionsInput) (*ExportTransactionsOutput, error) {
+	// Resolve the exporter
+	format := input.Format
+	if format == "" {
+		format = adapter.ExportFormatCSV
+	}
+	exporter, ok := uc.exporters[format]
+	if !ok {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidExportFormat,
+			fmt.Sprintf("unsupported export format '%s'", format),
+			domainerror.ErrInvalidExportFormat,
+		)
+	}
+
+	// Build filter
+	filter, err := buildTransactionFilter(input.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse sort; exports read chronologically unless another order is requested
+	sort := valueobject.TransactionSort{Field: valueobject.TransactionSortByDate, Direction: valueobject.SortAscending}
+	if input.Filter.SortBy != "" || input.Filter.SortOrder != "" {
+		sort, _, err = parseTransactionListSort(input.Filter.SortBy, input.Filter.SortOrder, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Get the user's formatting preferences
+	user, err := uc.userRepo.FindByID(ctx, input.Filter.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+
+	// Resolve category and account names; split lines are not loaded with their category
+	categories, err := uc.categoryRepo.FindByOwner(ctx, entity.OwnerTypeUser, input.Filter.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find categories: %w", err)
+	}
+	categoryNames := make(map[uuid.UUID]string, len(categories))
+	for _, category := range categories {
+		categoryNames[category.ID] = category.Name
+	}
+
+	accounts, err := uc.accountRepo.FindByUser(ctx, input.Filter.UserID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find accounts: %w", err)
+	}
+	accountNames := make(map[uuid.UUID]string, len(accounts))
+	for _, account := range accounts {
+		accountNames[account.ID] = account.Name
+	}
+
+	now := time.Now().UTC()
+	return &ExportTransactionsOutput{
+		ContentType: exporter.ContentType(),
+		FileName:    fmt.Sprintf("transactions-%s.%s", now.Format("20060102"), exporter.FileExtension()),
+		exporter:    exporter,
+		filter:      filter,
+		sort:        sort,
+		options: adapter.TransactionExportOptions{
+			DateFormat:   user.DateFormat,
+			NumberFormat: user.NumberFormat,
+			BaseCurrency: user.BaseCurrency,
+			StartDate:    input.Filter.StartDate,
+			EndDate:      input.Filter.EndDate,
+			GeneratedAt:  now,
+		},
+		categories: categoryNames,
+		accounts:   accountNames,
+		repo:       uc.transactionRepo,
+	}, nil
+}
+
+// Stream writes the export file to w, loading the transactions in batches.
+func (o *ExportTransactionsOutput) Stream(ctx context.Context, w io.Writer) error {
+	writer, err := o.exporter.NewWriter(w, o.options)
+	if err != nil {
+		return err
+	}
+
+	err = o.repo.StreamByFilter(ctx, o.filter, o.sort, ExportBatchSize, func(batch []*entity.TransactionWithCategory) error {
+		for _, txnWithCat := range batch {
+			if err := writer.Write(o.toExportedTransaction(txnWithCat)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export transactions: %w", err)
+	}
+
+	return writer.Close()
+}
+
+// toExportedTransaction resolves the references of a transaction to names.
+func (o *ExportTransactionsOutput) toExportedTransaction(txnWithCat *entity.TransactionWithCategory) *adapter.ExportedTransaction {
+	txn := txnWithCat.Transaction
+	exported := &adapter.ExportedTransaction{Transaction: txn}
+
+	if txnWithCat.Category != nil {
+		exported.CategoryName = txnWithCat.Category.Name
+	}
+	if txn.AccountID != nil {
+		exported.AccountName = o.accounts[*txn.AccountID]
+	}
+	for _, tag := range txn.Tags {
+		exported.Tags = append(exported.Tags, tag.Name)
+	}
+	for _, split := range txn.Splits {
+		exportedSplit := adapter.ExportedSplit{Amount: split.Amount}
+		if split.CategoryID != nil {
+			exportedSplit.CategoryName = o.categories[*split.CategoryID]
+		}
+		exported.Splits = append(exported.Splits, exportedSplit)
+	}
+
+	return exported
+}
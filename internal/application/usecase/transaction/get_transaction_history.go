@@ -0,0 +1,113 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// GetTransactionHistoryInput represents the input for getting the change history of a transaction.
+type GetTransactionHistoryInput struct {
+	TransactionID uuid.UUID
+	UserID        uuid.UUID
+}
+
+// TransactionChangeOutput represents a recorded change to a transaction.
+type TransactionChangeOutput struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	ActorID       *uuid.UUID
+	Source        entity.TransactionChangeSource
+	Action        entity.TransactionChangeAction
+	OperationID   uuid.UUID
+	Fields        []entity.TransactionFieldChange
+	Revertable    bool
+	RevertedAt    *time.Time
+	RevertedByID  *uuid.UUID
+	CreatedAt     time.Time
+}
+
+// GetTransactionHistoryOutput represents the output of getting the change history of a transaction.
+type GetTransactionHistoryOutput struct {
+	Changes []*TransactionChangeOutput // Newest first
+}
+
+// GetTransactionHistoryUseCase handles retrieving the change history of a transaction.
+type GetTransactionHistoryUseCase struct {
+	transactionRepo adapter.TransactionRepository
+	changeRepo      adapter.TransactionChangeRepository
+}
+
+// NewGetTransactionHistoryUseCase creates a new GetTransactionHistoryUseCase instance.
+func NewGetTransactionHistoryUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+) *GetTransactionHistoryUseCase {
+	return &GetTransactionHistoryUseCase{
+		transactionRepo: transactionRepo,
+		changeRepo:      changeRepo,
+	}
+}
+
+// Execute retrieves the change history of a transaction.
+// The history of deleted transactions remains available to their owner.
+func (uc *GetTransactionHistoryUseCase) Execute(ctx context.Context, input GetTransactionHistoryInput) (*GetTransactionHistoryOutput, error) {
+	changes, err := uc.changeRepo.FindByTransaction(ctx, input.TransactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transaction history: %w", err)
+	}
+
+	// Verify ownership through the history, or through the transaction if it has none
+	ownerID := uuid.Nil
+	if len(changes) > 0 {
+		ownerID = changes[0].UserID
+	} else {
+		transaction, err := uc.transactionRepo.FindByID(ctx, input.TransactionID)
+		if err != nil && !errors.Is(err, domainerror.ErrTransactionNotFound) {
+			return nil, fmt.Errorf("failed to find transaction: %w", err)
+		}
+		if transaction != nil {
+			ownerID = transaction.UserID
+		}
+	}
+	if ownerID != input.UserID {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeTransactionNotFound,
+			"transaction not found",
+			domainerror.ErrTransactionNotFound,
+		)
+	}
+
+	output := &GetTransactionHistoryOutput{
+		Changes: make([]*TransactionChangeOutput, 0, len(changes)),
+	}
+	for _, change := range changes {
+		output.Changes = append(output.Changes, toTransactionChangeOutput(change))
+	}
+	return output, nil
+}
+
+// toTransactionChangeOutput converts a transaction change to its output.
+func toTransactionChangeOutput(change *entity.TransactionChange) *TransactionChangeOutput {
+	return &TransactionChangeOutput{
+		ID:            change.ID,
+		TransactionID: change.TransactionID,
+		ActorID:       change.ActorID,
+		Source:        change.Source,
+		Action:        change.Action,
+		OperationID:   change.OperationID,
+		Fields:        change.Fields,
+		Revertable:    change.Revertable() == nil,
+		RevertedAt:    change.RevertedAt,
+		RevertedByID:  change.RevertedByID,
+		CreatedAt:     change.CreatedAt,
+	}
+}
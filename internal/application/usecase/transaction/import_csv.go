@@ -0,0 +1,103 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
+	"github.com/finance-tracker/backend/internal/application/usecase/installment"
+	"github.com/finance-tracker/backend/internal/application/usecase/merchant"
+)
+
+// ImportCSVInput represents the input for importing a CSV statement.
+type ImportCSVInput struct {
+	UserID            uuid.UUID
+	Data              []byte
+	Source            CSVImportSource
+	ApplyAutoCategory bool
+	Currency          string // Optional ISO 4217 code of the statement, defaults to the base currency
+}
+
+// ImportCSVOutput represents the output of a CSV statement import.
+type ImportCSVOutput struct {
+	Result *ImportStatementOutput
+	Errors []adapter.StatementLineError // Lines that could not be parsed and were not imported
+}
+
+// ImportCSVUseCase handles importing a CSV statement using a saved or one-off column mapping.
+type ImportCSVUseCase struct {
+	profileRepo     adapter.ImportProfileRepository
+	userRepo        adapter.UserRepository
+	csvParser       adapter.CSVStatementParser
+	statementImport *ImportStatementUseCase
+}
+
+// NewImportCSVUseCase creates a new ImportCSVUseCase instance.
+func NewImportCSVUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	txManager adapter.TxManager,
+	categoryRepo adapter.CategoryRepository,
+	categoryRuleRepo adapter.CategoryRuleRepository,
+	profileRepo adapter.ImportProfileRepository,
+	userRepo adapter.UserRepository,
+	csvParser adapter.CSVStatementParser,
+	goalAlertNotifier adapter.GoalAlertNotifier,
+	converter *exchangerate.Converter,
+	merchantResolver *merchant.Resolver,
+	installmentTracker *installment.Tracker,
+) *ImportCSVUseCase {
+	return &ImportCSVUseCase{
+		profileRepo:     profileRepo,
+		userRepo:        userRepo,
+		csvParser:       csvParser,
+		statementImport: NewImportStatementUseCase(transactionRepo, changeRepo, txManager, categoryRepo, categoryRuleRepo, goalAlertNotifier, converter, merchantResolver, installmentTracker),
+	}
+}
+
+// Execute performs the CSV import.
+// Lines that fail to parse are reported back; lines already imported are skipped.
+func (uc *ImportCSVUseCase) Execute(ctx context.Context, input ImportCSVInput) (*ImportCSVOutput, error) {
+	// Resolve mapping and parse file
+	profile, err := resolveCSVImportProfile(ctx, uc.profileRepo, uc.userRepo, input.UserID, input.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := parseCSVStatement(uc.csvParser, input.Data, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert parsed lines to statement lines
+	lines := make([]StatementLineInput, len(parsed.Lines))
+	for i, line := range parsed.Lines {
+		lines[i] = StatementLineInput{
+			ExternalID:         line.ExternalID,
+			Date:               line.Date,
+			Description:        line.Description,
+			Amount:             line.Amount,
+			InstallmentCurrent: line.InstallmentCurrent,
+			InstallmentTotal:   line.InstallmentTotal,
+		}
+	}
+
+	// Import the lines
+	result, err := uc.statementImport.Execute(ctx, ImportStatementInput{
+		UserID:            input.UserID,
+		Lines:             lines,
+		ApplyAutoCategory: input.ApplyAutoCategory,
+		Currency:          input.Currency,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportCSVOutput{
+		Result: result,
+		Errors: parsed.Errors,
+	}, nil
+}
@@ -0,0 +1,304 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
+	"github.com/finance-tracker/backend/internal/application/usecase/installment"
+	"github.com/finance-tracker/backend/internal/application/usecase/merchant"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// StatementLineInput represents a single parsed bank statement line.
+type StatementLineInput struct {
+	ExternalID         string // Bank-provided identifier used for idempotent imports (e.g., OFX FITID)
+	Date               time.Time
+	Description        string
+	Amount             decimal.Decimal // Negative for debits, positive for credits
+	InstallmentCurrent *int            // Optional installment info (e.g., 1 in "1/3")
+	InstallmentTotal   *int
+}
+
+// ImportStatementInput represents the input for importing a bank statement.
+type ImportStatementInput struct {
+	UserID            uuid.UUID
+	Lines             []StatementLineInput
+	ApplyAutoCategory bool
+	Currency          string // Optional ISO 4217 code of the statement (e.g., OFX CURDEF), defaults to the base currency
+}
+
+// ImportStatementOutput represents the output of a bank statement import.
+type ImportStatementOutput struct {
+	ImportedCount      int
+	SkippedCount       int // Lines skipped because they were already imported
+	CategorizedCount   int
+	ImportedAt         time.Time
+	Transactions       []*TransactionOutput
+	SkippedExternalIDs []string
+	InstallmentIssues  []installment.Issue // Extra installments of plans already recorded
+}
+
+// ImportStatementUseCase handles importing parsed bank statement lines as transactions.
+type ImportStatementUseCase struct {
+	transactionRepo    adapter.TransactionRepository
+	changeRepo         adapter.TransactionChangeRepository
+	txManager          adapter.TxManager
+	categoryRepo       adapter.CategoryRepository
+	categoryRuleRepo   adapter.CategoryRuleRepository
+	goalAlertNotifier  adapter.GoalAlertNotifier
+	converter          *exchangerate.Converter
+	merchantResolver   *merchant.Resolver
+	installmentTracker *installment.Tracker
+}
+
+// NewImportStatementUseCase creates a new ImportStatementUseCase instance.
+func NewImportStatementUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	txManager adapter.TxManager,
+	categoryRepo adapter.CategoryRepository,
+	categoryRuleRepo adapter.CategoryRuleRepository,
+	goalAlertNotifier adapter.GoalAlertNotifier,
+	converter *exchangerate.Converter,
+	merchantResolver *merchant.Resolver,
+	installmentTracker *installment.Tracker,
+) *ImportStatementUseCase {
+	return &ImportStatementUseCase{
+		transactionRepo:    transactionRepo,
+		changeRepo:         changeRepo,
+		txManager:          txManager,
+		categoryRepo:       categoryRepo,
+		categoryRuleRepo:   categoryRuleRepo,
+		goalAlertNotifier:  goalAlertNotifier,
+		converter:          converter,
+		merchantResolver:   merchantResolver,
+		installmentTracker: installmentTracker,
+	}
+}
+
+// Execute performs the statement import.
+// Lines whose external ID was already imported (or repeats within the same file) are skipped.
+func (uc *ImportStatementUseCase) Execute(ctx context.Context, input ImportStatementInput) (*ImportStatementOutput, error) {
+	// Validate lines
+	if len(input.Lines) == 0 {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeEmptyStatement,
+			"statement contains no transactions",
+			domainerror.ErrEmptyStatement,
+		)
+	}
+
+	currency, err := normalizeTransactionCurrency(input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find lines that were already imported
+	externalIDs := make([]string, 0, len(input.Lines))
+	for _, line := range input.Lines {
+		if line.ExternalID != "" {
+			externalIDs = append(externalIDs, line.ExternalID)
+		}
+	}
+
+	existing, err := uc.transactionRepo.FindExistingExternalIDs(ctx, input.UserID, externalIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing imports: %w", err)
+	}
+
+	// Prepare category rules for auto-categorization if enabled
+	var matcher *categoryRuleMatcher
+	if input.ApplyAutoCategory {
+		matcher = newCategoryRuleMatcher(ctx, uc.categoryRuleRepo, uc.categoryRepo, input.UserID)
+	}
+
+	now := time.Now().UTC()
+	output := &ImportStatementOutput{
+		ImportedAt:         now,
+		Transactions:       make([]*TransactionOutput, 0, len(input.Lines)),
+		SkippedExternalIDs: []string{},
+	}
+	transactions := make([]*entity.Transaction, 0, len(input.Lines))
+	categories := make([]*entity.Category, 0, len(input.Lines))
+
+	for _, line := range input.Lines {
+		if line.ExternalID != "" {
+			if existing[line.ExternalID] {
+				output.SkippedCount++
+				output.SkippedExternalIDs = append(output.SkippedExternalIDs, line.ExternalID)
+				continue
+			}
+			existing[line.ExternalID] = true
+		}
+
+		description := truncateDescription(line.Description)
+
+		txnType := entity.TransactionTypeIncome
+		if line.Amount.IsNegative() {
+			txnType = entity.TransactionTypeExpense
+		}
+
+		var categoryID *uuid.UUID
+		var category *entity.Category
+		if matcher != nil {
+			categoryID, category = matcher.Match(ctx, description)
+			if categoryID != nil {
+				output.CategorizedCount++
+			}
+		}
+
+		txn := entity.NewTransaction(
+			input.UserID,
+			line.Date,
+			description,
+			line.Amount,
+			txnType,
+			categoryID,
+			"",
+			false,
+		)
+		txn.UploadedAt = &now
+		txn.InstallmentCurrent = line.InstallmentCurrent
+		txn.InstallmentTotal = line.InstallmentTotal
+		txn.Currency = currency
+		if line.ExternalID != "" {
+			externalID := line.ExternalID
+			txn.ExternalID = &externalID
+		}
+
+		transactions = append(transactions, txn)
+		categories = append(categories, category)
+	}
+
+	// Snapshot the rates into the user's base currency
+	if err := snapshotExchangeRates(ctx, uc.converter, input.UserID, transactions); err != nil {
+		return nil, err
+	}
+
+	// Recognize merchants, group installments into their plans and save all new transactions
+	// atomically, so a failed import leaves no merchants or plans behind. With auto-categorization,
+	// transactions no rule matched get the merchant's default category.
+	err = uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if uc.merchantResolver != nil {
+			categorized, err := uc.merchantResolver.Assign(ctx, input.UserID, transactions, input.ApplyAutoCategory)
+			if err != nil {
+				return fmt.Errorf("failed to assign merchants: %w", err)
+			}
+			output.CategorizedCount += categorized
+		}
+
+		if uc.installmentTracker != nil {
+			issues, err := uc.installmentTracker.Track(ctx, input.UserID, nil, transactions)
+			if err != nil {
+				return fmt.Errorf("failed to track installments: %w", err)
+			}
+			output.InstallmentIssues = issues
+		}
+
+		if err := uc.transactionRepo.BulkCreate(ctx, transactions); err != nil {
+			return fmt.Errorf("failed to import transactions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output.ImportedCount = len(transactions)
+
+	// Load the categories merchants gave to transactions
+	merchantCategories := make(map[uuid.UUID]*entity.Category)
+	for i, txn := range transactions {
+		if categories[i] != nil || txn.CategoryID == nil {
+			continue
+		}
+		if _, ok := merchantCategories[*txn.CategoryID]; !ok {
+			merchantCategories[*txn.CategoryID], _ = uc.categoryRepo.FindByID(ctx, *txn.CategoryID)
+		}
+		categories[i] = merchantCategories[*txn.CategoryID]
+	}
+
+	for i, txn := range transactions {
+		output.Transactions = append(output.Transactions, toImportedTransactionOutput(txn, categories[i]))
+	}
+
+	// Record the imported transactions in the history as a single operation
+	operationID := uuid.New()
+	changes := make([]*entity.TransactionChange, 0, len(transactions))
+	for _, txn := range transactions {
+		changes = append(changes,
+			entity.NewTransactionChange(nil, txn, &input.UserID, entity.TransactionChangeSourceImport, operationID),
+		)
+	}
+	RecordTransactionChanges(ctx, uc.changeRepo, changes...)
+
+	// Re-evaluate spending goals in the background
+	if uc.goalAlertNotifier != nil && len(transactions) > 0 {
+		uc.goalAlertNotifier.NotifyTransactionsChanged(input.UserID)
+	}
+
+	return output, nil
+}
+
+// truncateDescription trims a description to MaxDescriptionLength without splitting multi-byte characters.
+func truncateDescription(description string) string {
+	if len(description) <= MaxDescriptionLength {
+		return description
+	}
+
+	cut := 0
+	for i := range description {
+		if i > MaxDescriptionLength {
+			break
+		}
+		cut = i
+	}
+	return description[:cut]
+}
+
+// toImportedTransactionOutput builds a TransactionOutput for a newly imported transaction.
+func toImportedTransactionOutput(txn *entity.Transaction, category *entity.Category) *TransactionOutput {
+	output := &TransactionOutput{
+		ID:                 txn.ID,
+		UserID:             txn.UserID,
+		Date:               txn.Date,
+		Description:        txn.Description,
+		Amount:             txn.Amount,
+		Type:               txn.Type,
+		CategoryID:         txn.CategoryID,
+		Notes:              txn.Notes,
+		IsRecurring:        txn.IsRecurring,
+		CreatedAt:          txn.CreatedAt,
+		UpdatedAt:          txn.UpdatedAt,
+		InstallmentCurrent: txn.InstallmentCurrent,
+		InstallmentTotal:   txn.InstallmentTotal,
+		Currency:           txn.Currency,
+		ExchangeRate:       txn.ExchangeRate,
+		BaseAmount:         txn.BaseAmount(),
+		IsSplit:            txn.IsSplit,
+		Splits:             toTransactionSplitOutputs(txn.Splits),
+		Tags:               toTagOutputs(txn.Tags),
+		MerchantID:         txn.MerchantID,
+		InstallmentPlanID:  txn.InstallmentPlanID,
+	}
+
+	if category != nil {
+		output.Category = &CategoryOutput{
+			ID:    category.ID,
+			Name:  category.Name,
+			Color: category.Color,
+			Icon:  category.Icon,
+			Type:  category.Type,
+		}
+	}
+
+	return output
+}
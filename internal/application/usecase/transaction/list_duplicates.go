@@ -0,0 +1,129 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
+)
+
+// DefaultDuplicateReviewDays is how far back duplicates are searched when no date range is given.
+const DefaultDuplicateReviewDays = 90
+
+// ListDuplicatesInput represents the input for listing likely duplicate transactions.
+type ListDuplicatesInput struct {
+	UserID    uuid.UUID
+	StartDate *time.Time // Optional, defaults to DefaultDuplicateReviewDays ago
+	EndDate   *time.Time // Optional, defaults to today
+}
+
+// DuplicatePairOutput represents two transactions that are likely the same entry.
+// Transaction is the one created first; Duplicate is the later one.
+type DuplicatePairOutput struct {
+	Transaction *TransactionOutput
+	Duplicate   *TransactionOutput
+	Score       float64
+}
+
+// ListDuplicatesOutput represents the output of listing likely duplicate transactions.
+type ListDuplicatesOutput struct {
+	Pairs []*DuplicatePairOutput
+}
+
+// ListDuplicatesUseCase handles finding likely duplicate transactions for review.
+type ListDuplicatesUseCase struct {
+	transactionRepo adapter.TransactionRepository
+	dismissalRepo   adapter.DuplicateDismissalRepository
+}
+
+// NewListDuplicatesUseCase creates a new ListDuplicatesUseCase instance.
+func NewListDuplicatesUseCase(
+	transactionRepo adapter.TransactionRepository,
+	dismissalRepo adapter.DuplicateDismissalRepository,
+) *ListDuplicatesUseCase {
+	return &ListDuplicatesUseCase{
+		transactionRepo: transactionRepo,
+		dismissalRepo:   dismissalRepo,
+	}
+}
+
+// Execute performs the duplicate listing. Pairs the user already dismissed are excluded.
+func (uc *ListDuplicatesUseCase) Execute(ctx context.Context, input ListDuplicatesInput) (*ListDuplicatesOutput, error) {
+	// Resolve date range
+	now := time.Now().UTC()
+	endDate := time.Date(now.Year(), now.Month(), now.Day(), 23, 59, 59, 0, time.UTC)
+	if input.EndDate != nil {
+		endDate = *input.EndDate
+	}
+	startDate := endDate.AddDate(0, 0, -DefaultDuplicateReviewDays)
+	if input.StartDate != nil {
+		startDate = *input.StartDate
+	}
+
+	// Load transactions and dismissed pairs
+	transactions, err := uc.transactionRepo.FindDuplicateCandidates(ctx, input.UserID, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transactions: %w", err)
+	}
+
+	dismissals, err := uc.dismissalRepo.FindByUser(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find dismissed duplicates: %w", err)
+	}
+
+	dismissed := make(map[[2]uuid.UUID]bool, len(dismissals))
+	for _, d := range dismissals {
+		dismissed[[2]uuid.UUID{d.TransactionID, d.DuplicateID}] = true
+	}
+
+	// Compare each transaction with the following ones inside the date window
+	// (transactions are sorted by date, so the inner loop stops early)
+	config := valueobject.DefaultDuplicateDetectionConfig()
+	window := time.Duration(config.DateWindowDays+1) * 24 * time.Hour
+	output := &ListDuplicatesOutput{
+		Pairs: []*DuplicatePairOutput{},
+	}
+
+	for i, a := range transactions {
+		for _, b := range transactions[i+1:] {
+			if b.Date.Sub(a.Date) > window {
+				break
+			}
+
+			score, ok := config.Score(a.Date, a.Description, a.Amount, b.Date, b.Description, b.Amount)
+			if !ok {
+				continue
+			}
+
+			first, second := entity.OrderedTransactionPair(a.ID, b.ID)
+			if dismissed[[2]uuid.UUID{first, second}] {
+				continue
+			}
+
+			original, duplicate := a, b
+			if duplicate.CreatedAt.Before(original.CreatedAt) {
+				original, duplicate = duplicate, original
+			}
+
+			output.Pairs = append(output.Pairs, &DuplicatePairOutput{
+				Transaction: toImportedTransactionOutput(original, nil),
+				Duplicate:   toImportedTransactionOutput(duplicate, nil),
+				Score:       score,
+			})
+		}
+	}
+
+	// Most likely duplicates first
+	sort.SliceStable(output.Pairs, func(i, j int) bool {
+		return output.Pairs[i].Score > output.Pairs[j].Score
+	})
+
+	return output, nil
+}
@@ -3,6 +3,8 @@ package transaction
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,6 +12,8 @@ import (
 
 	"github.com/finance-tracker/backend/internal/application/adapter"
 	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
 )
 
 // ListTransactionsInput represents the input for listing transactions.
@@ -18,11 +22,20 @@ type ListTransactionsInput struct {
 	StartDate   *time.Time
 	EndDate     *time.Time
 	CategoryIDs []uuid.UUID
+	AccountIDs  []uuid.UUID
+	TagIDs      []uuid.UUID
 	Type        *entity.TransactionType
-	Search      string
-	GroupByDate bool
-	Page        int
-	Limit       int
+	Search      string           // Search query, see valueobject.ParseTransactionQuery for the syntax
+	MinAmount   *decimal.Decimal // Minimum absolute amount, inclusive
+	MaxAmount   *decimal.Decimal // Maximum absolute amount, inclusive
+	// UncategorizedOnly lists only transactions without a category
+	UncategorizedOnly bool
+	GroupByDate       bool
+	SortBy            string // date (default), amount, description or category
+	SortOrder         string // asc or desc; defaults depend on SortBy
+	Cursor            string // Opaque token of the previous page; takes precedence over Page
+	Page              int
+	Limit             int
 }
 
 // TransactionOutput represents a single transaction in the output.
@@ -46,6 +59,26 @@ type TransactionOutput struct {
 	InstallmentCurrent     *int       // Current installment number
 	InstallmentTotal       *int       // Total installments
 	CreditCardPaymentID    *uuid.UUID // ID of linked bill payment, nil if pending
+	// Recurring schedule fields
+	RecurringScheduleID *uuid.UUID // ID of the schedule that generated this transaction
+	// Account fields
+	AccountID      *uuid.UUID       // ID of the account the transaction belongs to
+	RunningBalance *decimal.Decimal // Account balance after this transaction; only set when filtering by a single account
+	// Transfer fields
+	TransferID *uuid.UUID // ID of the transfer this transaction is a leg of
+	// Currency fields
+	Currency     string          // ISO 4217 code of Amount
+	ExchangeRate decimal.Decimal // Rate into the user's base currency, snapshotted when recorded
+	BaseAmount   decimal.Decimal // Amount converted into the user's base currency
+	// Split fields
+	IsSplit bool                      // True when the amount is attributed to categories through Splits
+	Splits  []*TransactionSplitOutput // Split lines of a split transaction
+	// Tag fields
+	Tags []*TagOutput // Tags attached to the transaction
+	// Merchant fields
+	MerchantID *uuid.UUID // ID of the merchant the transaction was made with
+	// Installment plan fields
+	InstallmentPlanID *uuid.UUID // ID of the plan grouping the installments of the purchase
 }
 
 // CategoryOutput represents category information in transaction output.
@@ -57,12 +90,20 @@ type CategoryOutput struct {
 	Type  entity.CategoryType
 }
 
+// TagOutput represents tag information in transaction output.
+type TagOutput struct {
+	ID    uuid.UUID
+	Name  string
+	Color string
+}
+
 // PaginationOutput represents pagination information in the output.
 type PaginationOutput struct {
 	Page       int
 	Limit      int
 	Total      int64
 	TotalPages int
+	NextCursor string // Token of the next page; empty on the last page
 }
 
 // TotalsOutput represents aggregated totals in the output.
@@ -82,12 +123,17 @@ type ListTransactionsOutput struct {
 // ListTransactionsUseCase handles listing transactions logic.
 type ListTransactionsUseCase struct {
 	transactionRepo adapter.TransactionRepository
+	accountRepo     adapter.AccountRepository
 }
 
 // NewListTransactionsUseCase creates a new ListTransactionsUseCase instance.
-func NewListTransactionsUseCase(transactionRepo adapter.TransactionRepository) *ListTransactionsUseCase {
+func NewListTransactionsUseCase(
+	transactionRepo adapter.TransactionRepository,
+	accountRepo adapter.AccountRepository,
+) *ListTransactionsUseCase {
 	return &ListTransactionsUseCase{
 		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
 	}
 }
 
@@ -107,20 +153,23 @@ func (uc *ListTransactionsUseCase) Execute(ctx context.Context, input ListTransa
 	}
 
 	// Build filter
-	filter := adapter.TransactionFilter{
-		UserID:      input.UserID,
-		StartDate:   input.StartDate,
-		EndDate:     input.EndDate,
-		CategoryIDs: input.CategoryIDs,
-		Type:        input.Type,
-		Search:      input.Search,
-		GroupByDate: input.GroupByDate,
+	filter, err := buildTransactionFilter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse sort and cursor
+	sort, cursor, err := parseTransactionListSort(input.SortBy, input.SortOrder, input.Cursor)
+	if err != nil {
+		return nil, err
 	}
 
 	// Build pagination
 	pagination := adapter.TransactionPagination{
-		Page:  page,
-		Limit: limit,
+		Page:   page,
+		Limit:  limit,
+		Sort:   sort,
+		Cursor: cursor,
 	}
 
 	// Fetch transactions
@@ -152,6 +201,10 @@ func (uc *ListTransactionsUseCase) Execute(ctx context.Context, input ListTransa
 		},
 	}
 
+	if result.NextCursor != nil {
+		output.Pagination.NextCursor = result.NextCursor.Encode()
+	}
+
 	for i, txnWithCat := range result.Transactions {
 		txnOutput := &TransactionOutput{
 			ID:                     txnWithCat.Transaction.ID,
@@ -171,6 +224,17 @@ func (uc *ListTransactionsUseCase) Execute(ctx context.Context, input ListTransa
 			InstallmentCurrent:     txnWithCat.Transaction.InstallmentCurrent,
 			InstallmentTotal:       txnWithCat.Transaction.InstallmentTotal,
 			CreditCardPaymentID:    txnWithCat.Transaction.CreditCardPaymentID,
+			RecurringScheduleID:    txnWithCat.Transaction.RecurringScheduleID,
+			AccountID:              txnWithCat.Transaction.AccountID,
+			TransferID:             txnWithCat.Transaction.TransferID,
+			Currency:               txnWithCat.Transaction.Currency,
+			ExchangeRate:           txnWithCat.Transaction.ExchangeRate,
+			BaseAmount:             txnWithCat.Transaction.BaseAmount(),
+			IsSplit:                txnWithCat.Transaction.IsSplit,
+			Splits:                 toTransactionSplitOutputs(txnWithCat.Transaction.Splits),
+			Tags:                   toTagOutputs(txnWithCat.Transaction.Tags),
+			MerchantID:             txnWithCat.Transaction.MerchantID,
+			InstallmentPlanID:      txnWithCat.Transaction.InstallmentPlanID,
 		}
 
 		// Add category if present
@@ -187,5 +251,133 @@ func (uc *ListTransactionsUseCase) Execute(ctx context.Context, input ListTransa
 		output.Transactions[i] = txnOutput
 	}
 
+	// Running balances are only meaningful within a single account
+	if len(input.AccountIDs) == 1 {
+		if err := uc.addRunningBalances(ctx, input.UserID, input.AccountIDs[0], output.Transactions); err != nil {
+			return nil, err
+		}
+	}
+
 	return output, nil
 }
+
+// buildTransactionFilter validates the filters of a listing and converts them to a repository filter.
+// Bulk updates and exports select transactions with the same filters.
+func buildTransactionFilter(input ListTransactionsInput) (adapter.TransactionFilter, error) {
+	// Parse search query
+	query, err := valueobject.ParseTransactionQuery(input.Search)
+	if err != nil {
+		return adapter.TransactionFilter{}, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidSearchQuery,
+			"invalid search query: "+err.Error(),
+			domainerror.ErrInvalidSearchQuery,
+		)
+	}
+	if query.IsEmpty() {
+		query = nil
+	}
+
+	// Validate amount range
+	if input.MinAmount != nil && input.MaxAmount != nil && input.MinAmount.Abs().GreaterThan(input.MaxAmount.Abs()) {
+		return adapter.TransactionFilter{}, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidAmountRange,
+			"minAmount must not be greater than maxAmount",
+			domainerror.ErrInvalidAmountRange,
+		)
+	}
+
+	return adapter.TransactionFilter{
+		UserID:            input.UserID,
+		StartDate:         input.StartDate,
+		EndDate:           input.EndDate,
+		CategoryIDs:       input.CategoryIDs,
+		AccountIDs:        input.AccountIDs,
+		TagIDs:            input.TagIDs,
+		Type:              input.Type,
+		Query:             query,
+		MinAmount:         input.MinAmount,
+		MaxAmount:         input.MaxAmount,
+		UncategorizedOnly: input.UncategorizedOnly,
+		GroupByDate:       input.GroupByDate,
+	}, nil
+}
+
+// parseTransactionListSort parses the sort parameters and pagination cursor of a listing.
+// Without sort parameters, a cursor continues in the sort it was issued for.
+func parseTransactionListSort(sortBy, sortOrder, cursorToken string) (valueobject.TransactionSort, *valueobject.TransactionCursor, error) {
+	var cursor *valueobject.TransactionCursor
+	if cursorToken != "" {
+		decoded, err := valueobject.DecodeTransactionCursor(cursorToken)
+		if err != nil {
+			return valueobject.TransactionSort{}, nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeInvalidTxnCursor,
+				"invalid cursor",
+				domainerror.ErrInvalidTransactionCursor,
+			)
+		}
+		cursor = decoded
+		if sortBy == "" && sortOrder == "" {
+			return cursor.Sort, cursor, nil
+		}
+	}
+
+	sort, err := valueobject.ParseTransactionSort(sortBy, sortOrder)
+	if err != nil {
+		return valueobject.TransactionSort{}, nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidTxnSort,
+			err.Error(),
+			domainerror.ErrInvalidTransactionSort,
+		)
+	}
+
+	if cursor != nil && cursor.Sort != sort {
+		return valueobject.TransactionSort{}, nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidTxnCursor,
+			"cursor was issued for a different sort",
+			domainerror.ErrInvalidTransactionCursor,
+		)
+	}
+	return sort, cursor, nil
+}
+
+// addRunningBalances sets the account balance after each listed transaction.
+// Balances are computed over the whole account, so they do not depend on the other filters or the page.
+func (uc *ListTransactionsUseCase) addRunningBalances(
+	ctx context.Context,
+	userID uuid.UUID,
+	accountID uuid.UUID,
+	transactions []*TransactionOutput,
+) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	account, err := uc.accountRepo.FindByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrAccountNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to find account: %w", err)
+	}
+	if account.UserID != userID {
+		return nil
+	}
+
+	transactionIDs := make([]uuid.UUID, len(transactions))
+	for i, txn := range transactions {
+		transactionIDs[i] = txn.ID
+	}
+
+	runningTotals, err := uc.accountRepo.GetRunningTotals(ctx, accountID, transactionIDs)
+	if err != nil {
+		return fmt.Errorf("failed to calculate running balances: %w", err)
+	}
+
+	for _, txn := range transactions {
+		if total, ok := runningTotals[txn.ID]; ok {
+			balance := account.OpeningBalance.Add(total)
+			txn.RunningBalance = &balance
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// MergeDuplicateInput represents the input for merging two duplicate transactions.
+type MergeDuplicateInput struct {
+	UserID      uuid.UUID
+	KeepID      uuid.UUID // Transaction that remains
+	DuplicateID uuid.UUID // Transaction that is deleted
+}
+
+// MergeDuplicateOutput represents the output of merging two duplicate transactions.
+type MergeDuplicateOutput struct {
+	Transaction *TransactionOutput
+	DeletedID   uuid.UUID
+}
+
+// MergeDuplicateUseCase handles merging a duplicate transaction into the one being kept.
+type MergeDuplicateUseCase struct {
+	transactionRepo adapter.TransactionRepository
+	changeRepo      adapter.TransactionChangeRepository
+	attachmentRepo  adapter.AttachmentRepository
+	txManager       adapter.TxManager
+}
+
+// NewMergeDuplicateUseCase creates a new MergeDuplicateUseCase instance.
+func NewMergeDuplicateUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	attachmentRepo adapter.AttachmentRepository,
+	txManager adapter.TxManager,
+) *MergeDuplicateUseCase {
+	return &MergeDuplicateUseCase{
+		transactionRepo: transactionRepo,
+		changeRepo:      changeRepo,
+		attachmentRepo:  attachmentRepo,
+		txManager:       txManager,
+	}
+}
+
+// Execute performs the merge. Details missing on the kept transaction (category, notes,
+// installments) are copied from the duplicate and its attachments are moved over before the
+// duplicate is soft-deleted.
+func (uc *MergeDuplicateUseCase) Execute(ctx context.Context, input MergeDuplicateInput) (*MergeDuplicateOutput, error) {
+	if input.KeepID == input.DuplicateID {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeSameTransactionPair,
+			"a transaction cannot be merged with itself",
+			domainerror.ErrSameTransactionPair,
+		)
+	}
+
+	// Find both transactions and check ownership
+	keep, err := findOwnedTransaction(ctx, uc.transactionRepo, input.KeepID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	duplicate, err := findOwnedTransaction(ctx, uc.transactionRepo, input.DuplicateID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Transfer legs and split transactions carry state a merge would leave inconsistent
+	for _, txn := range []*entity.Transaction{keep, duplicate} {
+		if txn.IsTransfer() {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeTransactionIsTransfer,
+				"transaction is part of a transfer and cannot be merged",
+				domainerror.ErrTransactionIsTransfer,
+			)
+		}
+		if txn.IsSplit {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeTransactionIsSplit,
+				"transaction is split across categories; remove its split lines before merging",
+				domainerror.ErrTransactionIsSplit,
+			)
+		}
+	}
+
+	// Fill in details the kept transaction is missing
+	before := snapshotTransaction(keep)
+	if keep.CategoryID == nil && duplicate.CategoryID != nil {
+		keep.CategoryID = duplicate.CategoryID
+	}
+	if keep.Notes == "" && duplicate.Notes != "" {
+		keep.Notes = duplicate.Notes
+	}
+	if keep.InstallmentTotal == nil && duplicate.InstallmentTotal != nil {
+		keep.InstallmentCurrent = duplicate.InstallmentCurrent
+		keep.InstallmentTotal = duplicate.InstallmentTotal
+	}
+	keep.UpdatedAt = time.Now().UTC()
+
+	// Move the duplicate's receipts, save the kept transaction, delete the duplicate and record both
+	// as a single history operation atomically, so a failed merge leaves no attachment moved
+	operationID := uuid.New()
+	err = uc.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := uc.attachmentRepo.ReassignOwner(ctx, entity.AttachmentOwnerTransaction, duplicate.ID, keep.ID); err != nil {
+			return fmt.Errorf("failed to move duplicate attachments: %w", err)
+		}
+
+		if err := uc.transactionRepo.MergeDuplicate(ctx, keep, duplicate.ID); err != nil {
+			return fmt.Errorf("failed to merge duplicate transaction: %w", err)
+		}
+
+		if err := SaveTransactionChanges(ctx, uc.changeRepo,
+			entity.NewTransactionChange(before, keep, &input.UserID, entity.TransactionChangeSourceManual, operationID),
+			entity.NewTransactionChange(duplicate, nil, &input.UserID, entity.TransactionChangeSourceManual, operationID),
+		); err != nil {
+			return fmt.Errorf("failed to record merge history: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MergeDuplicateOutput{
+		Transaction: toImportedTransactionOutput(keep, nil),
+		DeletedID:   duplicate.ID,
+	}, nil
+}
+
+// findOwnedTransaction loads a transaction and verifies it belongs to the user.
+func findOwnedTransaction(
+	ctx context.Context,
+	transactionRepo adapter.TransactionRepository,
+	transactionID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Transaction, error) {
+	transaction, err := transactionRepo.FindByID(ctx, transactionID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrTransactionNotFound) {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeTransactionNotFound,
+				"transaction not found",
+				domainerror.ErrTransactionNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to find transaction: %w", err)
+	}
+
+	if transaction.UserID != userID {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeNotAuthorizedTransaction,
+			"not authorized to modify this transaction",
+			domainerror.ErrNotAuthorizedToModifyTransaction,
+		)
+	}
+
+	return transaction, nil
+}
@@ -0,0 +1,270 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	importprofile "github.com/finance-tracker/backend/internal/application/usecase/import_profile"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// CSVImportSource identifies the column mapping used to read a CSV statement.
+// Exactly one of ProfileID (a saved import profile) or Mapping (a one-off mapping) must be set.
+type CSVImportSource struct {
+	ProfileID *uuid.UUID
+	Mapping   *entity.ImportProfile
+}
+
+// PreviewCSVImportInput represents the input for previewing a CSV statement import.
+type PreviewCSVImportInput struct {
+	UserID            uuid.UUID
+	Data              []byte
+	Source            CSVImportSource
+	ApplyAutoCategory bool
+}
+
+// CSVPreviewLine represents a single parsed CSV line in the preview.
+type CSVPreviewLine struct {
+	Row                int
+	ExternalID         string
+	Date               time.Time
+	Description        string
+	Amount             decimal.Decimal
+	Type               entity.TransactionType
+	InstallmentCurrent *int
+	InstallmentTotal   *int
+	AlreadyImported    bool                    // True if this line was imported before and will be skipped
+	SuggestedCategory  *CategoryOutput         // Category matched by the user's rules, if any
+	PossibleDuplicates []*DuplicateMatchOutput // Existing transactions that look like the same entry
+}
+
+// PreviewCSVImportOutput represents the output of a CSV statement import preview.
+type PreviewCSVImportOutput struct {
+	Profile              *entity.ImportProfile // Effective mapping, with formats resolved
+	Lines                []*CSVPreviewLine
+	Errors               []adapter.StatementLineError
+	NewCount             int
+	AlreadyImportedCount int
+}
+
+// PreviewCSVImportUseCase handles parsing a CSV statement without saving anything.
+type PreviewCSVImportUseCase struct {
+	transactionRepo  adapter.TransactionRepository
+	categoryRepo     adapter.CategoryRepository
+	categoryRuleRepo adapter.CategoryRuleRepository
+	profileRepo      adapter.ImportProfileRepository
+	userRepo         adapter.UserRepository
+	csvParser        adapter.CSVStatementParser
+}
+
+// NewPreviewCSVImportUseCase creates a new PreviewCSVImportUseCase instance.
+func NewPreviewCSVImportUseCase(
+	transactionRepo adapter.TransactionRepository,
+	categoryRepo adapter.CategoryRepository,
+	categoryRuleRepo adapter.CategoryRuleRepository,
+	profileRepo adapter.ImportProfileRepository,
+	userRepo adapter.UserRepository,
+	csvParser adapter.CSVStatementParser,
+) *PreviewCSVImportUseCase {
+	return &PreviewCSVImportUseCase{
+		transactionRepo:  transactionRepo,
+		categoryRepo:     categoryRepo,
+		categoryRuleRepo: categoryRuleRepo,
+		profileRepo:      profileRepo,
+		userRepo:         userRepo,
+		csvParser:        csvParser,
+	}
+}
+
+// Execute performs the CSV import preview.
+func (uc *PreviewCSVImportUseCase) Execute(ctx context.Context, input PreviewCSVImportInput) (*PreviewCSVImportOutput, error) {
+	// Resolve mapping and parse file
+	profile, err := resolveCSVImportProfile(ctx, uc.profileRepo, uc.userRepo, input.UserID, input.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := parseCSVStatement(uc.csvParser, input.Data, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find lines that were already imported
+	externalIDs := make([]string, len(parsed.Lines))
+	for i, line := range parsed.Lines {
+		externalIDs[i] = line.ExternalID
+	}
+
+	existing, err := uc.transactionRepo.FindExistingExternalIDs(ctx, input.UserID, externalIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing imports: %w", err)
+	}
+
+	// Prepare category rules for suggestions if enabled
+	var matcher *categoryRuleMatcher
+	if input.ApplyAutoCategory {
+		matcher = newCategoryRuleMatcher(ctx, uc.categoryRuleRepo, uc.categoryRepo, input.UserID)
+	}
+
+	// Load existing transactions around the statement dates for duplicate detection
+	dates := make([]time.Time, len(parsed.Lines))
+	for i, line := range parsed.Lines {
+		dates[i] = line.Date
+	}
+	detector := newDuplicateDetector(ctx, uc.transactionRepo, input.UserID, dates)
+
+	// Build preview lines
+	output := &PreviewCSVImportOutput{
+		Profile: profile,
+		Lines:   make([]*CSVPreviewLine, len(parsed.Lines)),
+		Errors:  parsed.Errors,
+	}
+
+	for i, line := range parsed.Lines {
+		description := truncateDescription(line.Description)
+
+		txnType := entity.TransactionTypeIncome
+		if line.Amount.IsNegative() {
+			txnType = entity.TransactionTypeExpense
+		}
+
+		previewLine := &CSVPreviewLine{
+			Row:                line.Row,
+			ExternalID:         line.ExternalID,
+			Date:               line.Date,
+			Description:        description,
+			Amount:             line.Amount,
+			Type:               txnType,
+			InstallmentCurrent: line.InstallmentCurrent,
+			InstallmentTotal:   line.InstallmentTotal,
+			AlreadyImported:    existing[line.ExternalID],
+		}
+
+		if previewLine.AlreadyImported {
+			output.AlreadyImportedCount++
+		} else {
+			output.NewCount++
+			previewLine.PossibleDuplicates = detector.Find(line.Date, description, line.Amount)
+		}
+
+		if matcher != nil {
+			if _, category := matcher.Match(ctx, description); category != nil {
+				previewLine.SuggestedCategory = &CategoryOutput{
+					ID:    category.ID,
+					Name:  category.Name,
+					Color: category.Color,
+					Icon:  category.Icon,
+					Type:  category.Type,
+				}
+			}
+		}
+
+		output.Lines[i] = previewLine
+	}
+
+	return output, nil
+}
+
+// resolveCSVImportProfile returns the mapping to use for a CSV import.
+// Saved profiles must belong to the user; missing date/number formats fall back to the user's preferences.
+func resolveCSVImportProfile(
+	ctx context.Context,
+	profileRepo adapter.ImportProfileRepository,
+	userRepo adapter.UserRepository,
+	userID uuid.UUID,
+	source CSVImportSource,
+) (*entity.ImportProfile, error) {
+	var profile *entity.ImportProfile
+
+	switch {
+	case source.ProfileID != nil && source.Mapping != nil:
+		return nil, domainerror.NewImportProfileError(
+			domainerror.ErrCodeInvalidColumnMapping,
+			"provide either a profile_id or a mapping, not both",
+			domainerror.ErrInvalidColumnMapping,
+		)
+	case source.ProfileID != nil:
+		found, err := profileRepo.FindByID(ctx, *source.ProfileID)
+		if err != nil {
+			if errors.Is(err, domainerror.ErrImportProfileNotFound) {
+				return nil, domainerror.NewImportProfileError(
+					domainerror.ErrCodeImportProfileNotFound,
+					"import profile not found",
+					domainerror.ErrImportProfileNotFound,
+				)
+			}
+			return nil, fmt.Errorf("failed to find import profile: %w", err)
+		}
+		if found.UserID != userID {
+			return nil, domainerror.NewImportProfileError(
+				domainerror.ErrCodeNotAuthorizedImportProfile,
+				"not authorized to access this import profile",
+				domainerror.ErrNotAuthorizedImportProfile,
+			)
+		}
+		profile = found
+	case source.Mapping != nil:
+		if err := importprofile.ValidateColumnMapping(source.Mapping); err != nil {
+			return nil, err
+		}
+		mapping := *source.Mapping
+		mapping.UserID = userID
+		profile = &mapping
+	default:
+		return nil, domainerror.NewImportProfileError(
+			domainerror.ErrCodeInvalidColumnMapping,
+			"a profile_id or a mapping is required",
+			domainerror.ErrInvalidColumnMapping,
+		)
+	}
+
+	// Fall back to the user's preferred formats
+	if profile.DateFormat == "" || profile.NumberFormat == "" {
+		user, err := userRepo.FindByID(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find user: %w", err)
+		}
+		if profile.DateFormat == "" {
+			profile.DateFormat = user.DateFormat
+		}
+		if profile.NumberFormat == "" {
+			profile.NumberFormat = user.NumberFormat
+		}
+	}
+
+	return profile, nil
+}
+
+// parseCSVStatement parses the file and converts parser failures into statement errors.
+func parseCSVStatement(
+	csvParser adapter.CSVStatementParser,
+	data []byte,
+	profile *entity.ImportProfile,
+) (*adapter.ParsedStatement, error) {
+	parsed, err := csvParser.ParseCSV(data, profile)
+	if err != nil {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidStatementFile,
+			err.Error(),
+			domainerror.ErrInvalidStatementFile,
+		)
+	}
+
+	if len(parsed.Lines) == 0 && len(parsed.Errors) == 0 {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeEmptyStatement,
+			"statement contains no transactions",
+			domainerror.ErrEmptyStatement,
+		)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,148 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// RevertTransactionChangeInput represents the input for reverting a single transaction change.
+type RevertTransactionChangeInput struct {
+	ChangeID uuid.UUID
+	UserID   uuid.UUID
+}
+
+// RevertTransactionChangeOutput represents the output of reverting a transaction change.
+type RevertTransactionChangeOutput struct {
+	Change *TransactionChangeOutput // Change recorded by the revert
+}
+
+// RevertTransactionChangeUseCase handles reverting a single transaction change.
+type RevertTransactionChangeUseCase struct {
+	transactionRepo   adapter.TransactionRepository
+	changeRepo        adapter.TransactionChangeRepository
+	goalAlertNotifier adapter.GoalAlertNotifier
+}
+
+// NewRevertTransactionChangeUseCase creates a new RevertTransactionChangeUseCase instance.
+func NewRevertTransactionChangeUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	goalAlertNotifier adapter.GoalAlertNotifier,
+) *RevertTransactionChangeUseCase {
+	return &RevertTransactionChangeUseCase{
+		transactionRepo:   transactionRepo,
+		changeRepo:        changeRepo,
+		goalAlertNotifier: goalAlertNotifier,
+	}
+}
+
+// Execute reverts the change, restoring the fields it set to their previous values.
+// The revert is itself recorded in the history, so it can be reverted in turn.
+func (uc *RevertTransactionChangeUseCase) Execute(ctx context.Context, input RevertTransactionChangeInput) (*RevertTransactionChangeOutput, error) {
+	// Find the change
+	change, err := uc.changeRepo.FindByID(ctx, input.ChangeID)
+	if err != nil && !errors.Is(err, domainerror.ErrTransactionChangeNotFound) {
+		return nil, fmt.Errorf("failed to find transaction change: %w", err)
+	}
+	if change == nil || change.UserID != input.UserID {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeTxnChangeNotFound,
+			"transaction change not found",
+			domainerror.ErrTransactionChangeNotFound,
+		)
+	}
+
+	revert, err := revertTransactionChange(ctx, uc.transactionRepo, uc.changeRepo, change, input.UserID, uuid.New())
+	if err != nil {
+		return nil, err
+	}
+
+	// Re-evaluate spending goals in the background
+	if uc.goalAlertNotifier != nil {
+		uc.goalAlertNotifier.NotifyTransactionsChanged(input.UserID)
+	}
+
+	return &RevertTransactionChangeOutput{
+		Change: toTransactionChangeOutput(revert),
+	}, nil
+}
+
+// revertTransactionChange applies a change in reverse, records the revert and marks the change as reverted.
+// The change is only reverted while the transaction still has the values it set, so later changes are never lost.
+func revertTransactionChange(
+	ctx context.Context,
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	change *entity.TransactionChange,
+	actorID uuid.UUID,
+	operationID uuid.UUID,
+) (*entity.TransactionChange, error) {
+	if err := change.Revertable(); err != nil {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeChangeNotRevertable,
+			err.Error(),
+			domainerror.ErrChangeNotRevertable,
+		)
+	}
+
+	transaction, err := transactionRepo.FindByID(ctx, change.TransactionID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrTransactionNotFound) {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeTxnChangedSince,
+				"transaction has been deleted since the change",
+				domainerror.ErrTransactionChangedSince,
+			)
+		}
+		return nil, fmt.Errorf("failed to find transaction: %w", err)
+	}
+	if !change.IsCurrent(transaction) {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeTxnChangedSince,
+			"transaction has changed since; revert the later changes first",
+			domainerror.ErrTransactionChangedSince,
+		)
+	}
+
+	// The splits of a split transaction must add up to its amount, and they are not kept in the history
+	if transaction.IsSplit && change.HasField(entity.TransactionFieldAmount) {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeChangeNotRevertable,
+			"amount changes of split transactions cannot be reverted; edit the splits instead",
+			domainerror.ErrChangeNotRevertable,
+		)
+	}
+
+	// Restore the previous values
+	before := snapshotTransaction(transaction)
+	if err := change.ApplyReverse(transaction); err != nil {
+		return nil, fmt.Errorf("failed to revert transaction change: %w", err)
+	}
+
+	// Save the transaction, record the revert and link it from the reverted change atomically
+	revert := entity.NewTransactionChange(before, transaction, &actorID, entity.TransactionChangeSourceRevert, operationID)
+	if revert == nil {
+		return nil, fmt.Errorf("revert of change %s changed no fields", change.ID)
+	}
+	change.MarkReverted(revert.ID)
+	if err := changeRepo.SaveRevert(ctx, transaction, revert, change); err != nil {
+		if errors.Is(err, domainerror.ErrChangeNotRevertable) {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeChangeNotRevertable,
+				"change has already been reverted",
+				domainerror.ErrChangeNotRevertable,
+			)
+		}
+		return nil, fmt.Errorf("failed to save transaction change revert: %w", err)
+	}
+
+	return revert, nil
+}
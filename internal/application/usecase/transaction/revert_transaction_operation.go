@@ -0,0 +1,91 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// RevertTransactionOperationInput represents the input for reverting every change of an operation.
+type RevertTransactionOperationInput struct {
+	OperationID uuid.UUID
+	UserID      uuid.UUID
+}
+
+// RevertTransactionOperationOutput represents the output of reverting an operation.
+type RevertTransactionOperationOutput struct {
+	OperationID   uuid.UUID // Operation of the recorded reverts, so the revert can be undone as a whole
+	RevertedCount int
+	SkippedCount  int                        // Changes not revertable, already reverted or overwritten since
+	Changes       []*TransactionChangeOutput // Changes recorded by the revert
+}
+
+// RevertTransactionOperationUseCase handles reverting every change made by an operation, such as a bulk categorize.
+type RevertTransactionOperationUseCase struct {
+	transactionRepo   adapter.TransactionRepository
+	changeRepo        adapter.TransactionChangeRepository
+	goalAlertNotifier adapter.GoalAlertNotifier
+}
+
+// NewRevertTransactionOperationUseCase creates a new RevertTransactionOperationUseCase instance.
+func NewRevertTransactionOperationUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	goalAlertNotifier adapter.GoalAlertNotifier,
+) *RevertTransactionOperationUseCase {
+	return &RevertTransactionOperationUseCase{
+		transactionRepo:   transactionRepo,
+		changeRepo:        changeRepo,
+		goalAlertNotifier: goalAlertNotifier,
+	}
+}
+
+// Execute reverts the changes of the operation that can still be reverted and skips the others,
+// e.g., transactions the user edited again after the operation.
+func (uc *RevertTransactionOperationUseCase) Execute(ctx context.Context, input RevertTransactionOperationInput) (*RevertTransactionOperationOutput, error) {
+	// Find the changes of the operation
+	changes, err := uc.changeRepo.FindByOperation(ctx, input.OperationID, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find operation changes: %w", err)
+	}
+	if len(changes) == 0 {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeTxnChangeNotFound,
+			"operation not found",
+			domainerror.ErrTransactionChangeNotFound,
+		)
+	}
+
+	output := &RevertTransactionOperationOutput{
+		OperationID: uuid.New(),
+		Changes:     make([]*TransactionChangeOutput, 0, len(changes)),
+	}
+
+	// Revert the latest changes first, in case the operation changed a transaction more than once
+	for i := len(changes) - 1; i >= 0; i-- {
+		revert, err := revertTransactionChange(ctx, uc.transactionRepo, uc.changeRepo, changes[i], input.UserID, output.OperationID)
+		if err != nil {
+			var txnErr *domainerror.TransactionError
+			if errors.As(err, &txnErr) {
+				output.SkippedCount++
+				continue
+			}
+			return nil, err
+		}
+		output.RevertedCount++
+		output.Changes = append(output.Changes, toTransactionChangeOutput(revert))
+	}
+
+	// Re-evaluate spending goals in the background
+	if uc.goalAlertNotifier != nil && output.RevertedCount > 0 {
+		uc.goalAlertNotifier.NotifyTransactionsChanged(input.UserID)
+	}
+
+	return output, nil
+}
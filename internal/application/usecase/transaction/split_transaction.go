@@ -0,0 +1,178 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// SplitLineInput represents a single split line in the input.
+type SplitLineInput struct {
+	Amount     decimal.Decimal // Same sign as the transaction amount
+	CategoryID *uuid.UUID
+	Notes      string
+}
+
+// SplitTransactionInput represents the input for splitting a transaction across categories.
+type SplitTransactionInput struct {
+	TransactionID uuid.UUID
+	UserID        uuid.UUID
+	Splits        []SplitLineInput
+}
+
+// SplitTransactionOutput represents the output of splitting a transaction.
+type SplitTransactionOutput struct {
+	Transaction *TransactionOutput
+}
+
+// TransactionSplitOutput represents a split line in transaction output.
+type TransactionSplitOutput struct {
+	ID         uuid.UUID
+	CategoryID *uuid.UUID
+	Amount     decimal.Decimal
+	Notes      string
+}
+
+// SplitTransactionUseCase handles splitting a transaction across categories.
+// Existing split lines are replaced, and the transaction loses its own category.
+type SplitTransactionUseCase struct {
+	transactionRepo   adapter.TransactionRepository
+	changeRepo        adapter.TransactionChangeRepository
+	categoryRepo      adapter.CategoryRepository
+	goalAlertNotifier adapter.GoalAlertNotifier
+}
+
+// NewSplitTransactionUseCase creates a new SplitTransactionUseCase instance.
+func NewSplitTransactionUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	categoryRepo adapter.CategoryRepository,
+	goalAlertNotifier adapter.GoalAlertNotifier,
+) *SplitTransactionUseCase {
+	return &SplitTransactionUseCase{
+		transactionRepo:   transactionRepo,
+		changeRepo:        changeRepo,
+		categoryRepo:      categoryRepo,
+		goalAlertNotifier: goalAlertNotifier,
+	}
+}
+
+// Execute splits the transaction into the given lines.
+func (uc *SplitTransactionUseCase) Execute(ctx context.Context, input SplitTransactionInput) (*SplitTransactionOutput, error) {
+	transaction, err := findOwnedTransaction(ctx, uc.transactionRepo, input.TransactionID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Transfers are neither income nor expense, so they are never split across categories
+	if transaction.IsTransfer() {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeTransactionIsTransfer,
+			"transaction is part of a transfer and cannot be split",
+			domainerror.ErrTransactionIsTransfer,
+		)
+	}
+
+	// Build split lines, validating each category once
+	splits := make([]*entity.TransactionSplit, len(input.Splits))
+	validCategories := make(map[uuid.UUID]bool)
+	for i, line := range input.Splits {
+		if len(line.Notes) > MaxNotesLength {
+			return nil, domainerror.NewTransactionError(
+				domainerror.ErrCodeNotesTooLong,
+				fmt.Sprintf("notes must not exceed %d characters", MaxNotesLength),
+				domainerror.ErrNotesTooLong,
+			)
+		}
+
+		if line.CategoryID != nil && !validCategories[*line.CategoryID] {
+			if err := uc.validateCategory(ctx, *line.CategoryID, input.UserID); err != nil {
+				return nil, err
+			}
+			validCategories[*line.CategoryID] = true
+		}
+
+		splits[i] = entity.NewTransactionSplit(transaction.ID, line.Amount, line.CategoryID, line.Notes)
+	}
+
+	if !transaction.CanBeSplitInto(splits) {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeInvalidTxnSplits,
+			fmt.Sprintf(
+				"at least %d split lines with the sign of the transaction amount must add up to %s",
+				entity.MinTransactionSplits, transaction.Amount.String(),
+			),
+			domainerror.ErrInvalidTransactionSplits,
+		)
+	}
+
+	before := snapshotTransaction(transaction)
+	transaction.Split(splits)
+	transaction.UpdatedAt = time.Now().UTC()
+
+	if err := uc.transactionRepo.SaveSplits(ctx, transaction); err != nil {
+		return nil, fmt.Errorf("failed to save transaction splits: %w", err)
+	}
+
+	// Record the change in the transaction history
+	RecordTransactionChanges(ctx, uc.changeRepo,
+		entity.NewTransactionChange(before, transaction, &input.UserID, entity.TransactionChangeSourceManual, uuid.New()),
+	)
+
+	// Re-evaluate spending goals in the background
+	if uc.goalAlertNotifier != nil {
+		uc.goalAlertNotifier.NotifyTransactionsChanged(input.UserID)
+	}
+
+	return &SplitTransactionOutput{
+		Transaction: toImportedTransactionOutput(transaction, nil),
+	}, nil
+}
+
+// validateCategory verifies the category exists and belongs to the user.
+func (uc *SplitTransactionUseCase) validateCategory(ctx context.Context, categoryID uuid.UUID, userID uuid.UUID) error {
+	category, err := uc.categoryRepo.FindByID(ctx, categoryID)
+	if err != nil {
+		return domainerror.NewTransactionError(
+			domainerror.ErrCodeTxnCategoryNotFound,
+			"category not found",
+			domainerror.ErrCategoryNotFoundForTransaction,
+		)
+	}
+
+	if category.OwnerType != entity.OwnerTypeUser || category.OwnerID != userID {
+		return domainerror.NewTransactionError(
+			domainerror.ErrCodeTxnCategoryNotOwned,
+			"category does not belong to user",
+			domainerror.ErrCategoryNotOwnedByUser,
+		)
+	}
+
+	return nil
+}
+
+// toTransactionSplitOutputs builds the split line outputs of a transaction.
+func toTransactionSplitOutputs(splits []*entity.TransactionSplit) []*TransactionSplitOutput {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	outputs := make([]*TransactionSplitOutput, len(splits))
+	for i, split := range splits {
+		outputs[i] = &TransactionSplitOutput{
+			ID:         split.ID,
+			CategoryID: split.CategoryID,
+			Amount:     split.Amount,
+			Notes:      split.Notes,
+		}
+	}
+	return outputs
+}
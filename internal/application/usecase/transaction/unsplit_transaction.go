@@ -0,0 +1,77 @@
+// Package transaction contains transaction-related use cases.
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// UnsplitTransactionInput represents the input for removing the split lines of a transaction.
+type UnsplitTransactionInput struct {
+	TransactionID uuid.UUID
+	UserID        uuid.UUID
+}
+
+// UnsplitTransactionOutput represents the output of removing the split lines of a transaction.
+type UnsplitTransactionOutput struct {
+	Success bool
+}
+
+// UnsplitTransactionUseCase handles removing the split lines of a transaction.
+// The transaction is left uncategorized; unsplitting a transaction that is not split is a no-op.
+type UnsplitTransactionUseCase struct {
+	transactionRepo   adapter.TransactionRepository
+	changeRepo        adapter.TransactionChangeRepository
+	goalAlertNotifier adapter.GoalAlertNotifier
+}
+
+// NewUnsplitTransactionUseCase creates a new UnsplitTransactionUseCase instance.
+func NewUnsplitTransactionUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	goalAlertNotifier adapter.GoalAlertNotifier,
+) *UnsplitTransactionUseCase {
+	return &UnsplitTransactionUseCase{
+		transactionRepo:   transactionRepo,
+		changeRepo:        changeRepo,
+		goalAlertNotifier: goalAlertNotifier,
+	}
+}
+
+// Execute removes the split lines of the transaction.
+func (uc *UnsplitTransactionUseCase) Execute(ctx context.Context, input UnsplitTransactionInput) (*UnsplitTransactionOutput, error) {
+	transaction, err := findOwnedTransaction(ctx, uc.transactionRepo, input.TransactionID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !transaction.IsSplit {
+		return &UnsplitTransactionOutput{Success: true}, nil
+	}
+
+	before := snapshotTransaction(transaction)
+	transaction.Unsplit()
+	transaction.UpdatedAt = time.Now().UTC()
+
+	if err := uc.transactionRepo.SaveSplits(ctx, transaction); err != nil {
+		return nil, fmt.Errorf("failed to remove transaction splits: %w", err)
+	}
+
+	// Record the change in the transaction history
+	RecordTransactionChanges(ctx, uc.changeRepo,
+		entity.NewTransactionChange(before, transaction, &input.UserID, entity.TransactionChangeSourceManual, uuid.New()),
+	)
+
+	// Re-evaluate spending goals in the background
+	if uc.goalAlertNotifier != nil {
+		uc.goalAlertNotifier.NotifyTransactionsChanged(input.UserID)
+	}
+
+	return &UnsplitTransactionOutput{Success: true}, nil
+}
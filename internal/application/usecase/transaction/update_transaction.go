@@ -11,6 +11,7 @@ import (
 	"github.com/shopspring/decimal"
 
 	"github.com/finance-tracker/backend/internal/application/adapter"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
 	"github.com/finance-tracker/backend/internal/domain/entity"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
 )
@@ -25,8 +26,12 @@ type UpdateTransactionInput struct {
 	Type          *entity.TransactionType
 	CategoryID    *uuid.UUID
 	ClearCategory bool // Set to true to remove category
+	AccountID     *uuid.UUID
+	ClearAccount  bool // Set to true to detach the transaction from its account
 	Notes         *string
 	IsRecurring   *bool
+	Currency      *string          // ISO 4217 code, re-snapshots the exchange rate when changed
+	ExchangeRate  *decimal.Decimal // Optional rate into the base currency, overrides the user's rate table
 }
 
 // UpdateTransactionOutput represents the output of transaction update.
@@ -36,18 +41,30 @@ type UpdateTransactionOutput struct {
 
 // UpdateTransactionUseCase handles transaction update logic.
 type UpdateTransactionUseCase struct {
-	transactionRepo adapter.TransactionRepository
-	categoryRepo    adapter.CategoryRepository
+	transactionRepo   adapter.TransactionRepository
+	changeRepo        adapter.TransactionChangeRepository
+	categoryRepo      adapter.CategoryRepository
+	accountRepo       adapter.AccountRepository
+	goalAlertNotifier adapter.GoalAlertNotifier
+	converter         *exchangerate.Converter
 }
 
 // NewUpdateTransactionUseCase creates a new UpdateTransactionUseCase instance.
 func NewUpdateTransactionUseCase(
 	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
 	categoryRepo adapter.CategoryRepository,
+	accountRepo adapter.AccountRepository,
+	goalAlertNotifier adapter.GoalAlertNotifier,
+	converter *exchangerate.Converter,
 ) *UpdateTransactionUseCase {
 	return &UpdateTransactionUseCase{
-		transactionRepo: transactionRepo,
-		categoryRepo:    categoryRepo,
+		transactionRepo:   transactionRepo,
+		changeRepo:        changeRepo,
+		categoryRepo:      categoryRepo,
+		accountRepo:       accountRepo,
+		goalAlertNotifier: goalAlertNotifier,
+		converter:         converter,
 	}
 }
 
@@ -75,8 +92,32 @@ func (uc *UpdateTransactionUseCase) Execute(ctx context.Context, input UpdateTra
 		)
 	}
 
+	// Transfer legs are edited together through the transfer
+	if transaction.IsTransfer() {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeTransactionIsTransfer,
+			"transaction is part of a transfer and must be updated through the transfer",
+			domainerror.ErrTransactionIsTransfer,
+		)
+	}
+
+	// The amount and category of a split transaction are set through its split lines
+	if transaction.IsSplit &&
+		(input.CategoryID != nil || (input.Amount != nil && !input.Amount.Equal(transaction.Amount))) {
+		return nil, domainerror.NewTransactionError(
+			domainerror.ErrCodeTransactionIsSplit,
+			"transaction is split across categories; update or remove its split lines instead",
+			domainerror.ErrTransactionIsSplit,
+		)
+	}
+
+	// Keep the values before the update for the change history
+	before := snapshotTransaction(transaction)
+
 	// Update fields if provided
+	resnapshot := input.ExchangeRate != nil
 	if input.Date != nil {
+		resnapshot = resnapshot || !input.Date.Equal(transaction.Date)
 		transaction.Date = *input.Date
 	}
 
@@ -139,6 +180,17 @@ func (uc *UpdateTransactionUseCase) Execute(ctx context.Context, input UpdateTra
 		}
 	}
 
+	// Handle account update
+	var account *entity.Account
+	if input.ClearAccount {
+		transaction.AccountID = nil
+	} else if input.AccountID != nil {
+		if account, err = validateTransactionAccount(ctx, uc.accountRepo, *input.AccountID, input.UserID); err != nil {
+			return nil, err
+		}
+		transaction.AccountID = input.AccountID
+	}
+
 	if input.Notes != nil {
 		if len(*input.Notes) > MaxNotesLength {
 			return nil, domainerror.NewTransactionError(
@@ -154,6 +206,34 @@ func (uc *UpdateTransactionUseCase) Execute(ctx context.Context, input UpdateTra
 		transaction.IsRecurring = *input.IsRecurring
 	}
 
+	// Handle currency update
+	if input.Currency != nil {
+		currency, err := normalizeTransactionCurrency(*input.Currency)
+		if err != nil {
+			return nil, err
+		}
+		resnapshot = resnapshot || currency != transaction.Currency
+		transaction.Currency = currency
+	}
+
+	// A transaction moved to an account, or whose currency changed, must stay in its account's currency
+	if account == nil && input.Currency != nil && transaction.AccountID != nil {
+		if account, err = validateTransactionAccount(ctx, uc.accountRepo, *transaction.AccountID, input.UserID); err != nil {
+			return nil, err
+		}
+	}
+	if account != nil {
+		if err := validateAccountCurrency(account, transaction.Currency); err != nil {
+			return nil, err
+		}
+	}
+
+	if resnapshot {
+		if err := snapshotExchangeRate(ctx, uc.converter, transaction, input.ExchangeRate); err != nil {
+			return nil, err
+		}
+	}
+
 	// Update timestamp
 	transaction.UpdatedAt = time.Now().UTC()
 
@@ -162,6 +242,16 @@ func (uc *UpdateTransactionUseCase) Execute(ctx context.Context, input UpdateTra
 		return nil, fmt.Errorf("failed to update transaction: %w", err)
 	}
 
+	// Record the change in the transaction history
+	RecordTransactionChanges(ctx, uc.changeRepo,
+		entity.NewTransactionChange(before, transaction, &input.UserID, entity.TransactionChangeSourceManual, uuid.New()),
+	)
+
+	// Re-evaluate spending goals in the background
+	if uc.goalAlertNotifier != nil {
+		uc.goalAlertNotifier.NotifyTransactionsChanged(input.UserID)
+	}
+
 	// Build output
 	output := &UpdateTransactionOutput{
 		Transaction: &TransactionOutput{
@@ -180,6 +270,15 @@ func (uc *UpdateTransactionUseCase) Execute(ctx context.Context, input UpdateTra
 			IsExpandedBill:     transaction.ExpandedAt != nil,
 			InstallmentCurrent: transaction.InstallmentCurrent,
 			InstallmentTotal:   transaction.InstallmentTotal,
+			AccountID:          transaction.AccountID,
+			Currency:           transaction.Currency,
+			ExchangeRate:       transaction.ExchangeRate,
+			BaseAmount:         transaction.BaseAmount(),
+			IsSplit:            transaction.IsSplit,
+			Splits:             toTransactionSplitOutputs(transaction.Splits),
+			Tags:               toTagOutputs(transaction.Tags),
+			MerchantID:         transaction.MerchantID,
+			InstallmentPlanID:  transaction.InstallmentPlanID,
 		},
 	}
 
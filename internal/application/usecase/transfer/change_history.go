@@ -0,0 +1,46 @@
+// Package transfer contains use cases for transfers between accounts.
+package transfer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// recordTransferChanges records the changes to a transfer's legs as a single operation in the transaction history.
+// Legs only in after were created and legs only in before were deleted.
+func recordTransferChanges(
+	ctx context.Context,
+	changeRepo adapter.TransactionChangeRepository,
+	userID uuid.UUID,
+	before, after []*entity.Transaction,
+) {
+	operationID := uuid.New()
+	changes := transaction.DiffTransactionChanges(before, after, &userID, entity.TransactionChangeSourceManual, operationID)
+
+	existed := make(map[uuid.UUID]bool, len(before))
+	for _, leg := range before {
+		existed[leg.ID] = true
+	}
+	for _, leg := range after {
+		if !existed[leg.ID] {
+			changes = append(changes, entity.NewTransactionChange(nil, leg, &userID, entity.TransactionChangeSourceManual, operationID))
+		}
+	}
+
+	transaction.RecordTransactionChanges(ctx, changeRepo, changes...)
+}
+
+// snapshotLegs returns copies of the transfer's legs, taken before they are modified.
+func snapshotLegs(legs []*entity.Transaction) []*entity.Transaction {
+	snapshots := make([]*entity.Transaction, len(legs))
+	for i, leg := range legs {
+		snapshot := *leg
+		snapshots[i] = &snapshot
+	}
+	return snapshots
+}
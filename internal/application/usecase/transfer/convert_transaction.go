@@ -0,0 +1,172 @@
+// Package transfer contains use cases for transfers between accounts.
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// ConvertTransactionInput represents the input for converting a transaction into a transfer.
+type ConvertTransactionInput struct {
+	TransactionID    uuid.UUID
+	UserID           uuid.UUID
+	CounterAccountID uuid.UUID // Account on the other side of the transfer
+}
+
+// ConvertTransactionOutput represents the output of converting a transaction into a transfer.
+type ConvertTransactionOutput struct {
+	Transfer *TransferOutput
+}
+
+// ConvertTransactionUseCase turns an existing transaction into one leg of a transfer and
+// creates the matching leg in the counter account. This is how a credit card bill payment
+// recorded in a checking account stops counting as an expense once the card is an account.
+type ConvertTransactionUseCase struct {
+	transactionRepo   adapter.TransactionRepository
+	changeRepo        adapter.TransactionChangeRepository
+	accountRepo       adapter.AccountRepository
+	goalAlertNotifier adapter.GoalAlertNotifier
+}
+
+// NewConvertTransactionUseCase creates a new ConvertTransactionUseCase instance.
+func NewConvertTransactionUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	accountRepo adapter.AccountRepository,
+	goalAlertNotifier adapter.GoalAlertNotifier,
+) *ConvertTransactionUseCase {
+	return &ConvertTransactionUseCase{
+		transactionRepo:   transactionRepo,
+		changeRepo:        changeRepo,
+		accountRepo:       accountRepo,
+		goalAlertNotifier: goalAlertNotifier,
+	}
+}
+
+// Execute converts the transaction and creates the counter leg atomically.
+func (uc *ConvertTransactionUseCase) Execute(ctx context.Context, input ConvertTransactionInput) (*ConvertTransactionOutput, error) {
+	// Find the existing transaction
+	transaction, err := uc.transactionRepo.FindByID(ctx, input.TransactionID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrTransactionNotFound) {
+			return nil, domainerror.NewTransferError(
+				domainerror.ErrCodeTransferTransactionMissing,
+				"transaction not found",
+				domainerror.ErrTransactionNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to find transaction: %w", err)
+	}
+
+	if transaction.UserID != input.UserID {
+		return nil, domainerror.NewTransferError(
+			domainerror.ErrCodeNotAuthorizedTransfer,
+			"not authorized to convert this transaction",
+			domainerror.ErrNotAuthorizedToModifyTransaction,
+		)
+	}
+
+	if err := checkConvertible(transaction); err != nil {
+		return nil, err
+	}
+
+	if *transaction.AccountID == input.CounterAccountID {
+		return nil, domainerror.NewTransferError(
+			domainerror.ErrCodeSameTransferAccount,
+			"source and destination accounts must be different",
+			domainerror.ErrSameTransferAccount,
+		)
+	}
+
+	counterAccount, err := findTransferAccount(ctx, uc.accountRepo, input.CounterAccountID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTransferCurrency(transaction.Currency, counterAccount); err != nil {
+		return nil, err
+	}
+
+	// A bill payment moves money into the card, so it can only become a transfer to a credit card
+	if transaction.IsCreditCardPayment && !counterAccount.IsCreditCard() {
+		return nil, domainerror.NewTransferError(
+			domainerror.ErrCodeTransactionNotConvertible,
+			"credit card bill payments can only be converted into a transfer to a credit card account",
+			domainerror.ErrTransactionNotConvertible,
+		)
+	}
+
+	// The existing transaction becomes one leg; the counter leg mirrors it in the other account
+	before := snapshotLegs([]*entity.Transaction{transaction})
+	transferID := uuid.New()
+	hadCategory := transaction.CategoryID != nil
+
+	transaction.Type = entity.TransactionTypeTransfer
+	transaction.TransferID = &transferID
+	transaction.CategoryID = nil
+	transaction.IsRecurring = false
+	transaction.UpdatedAt = time.Now().UTC()
+
+	counterLeg := entity.NewTransaction(
+		input.UserID,
+		transaction.Date,
+		transaction.Description,
+		transaction.Amount.Neg(),
+		entity.TransactionTypeTransfer,
+		nil,
+		transaction.Notes,
+		false,
+	)
+	counterLeg.AccountID = &counterAccount.ID
+	counterLeg.TransferID = &transferID
+	counterLeg.Currency = transaction.Currency
+	counterLeg.ExchangeRate = transaction.ExchangeRate
+
+	transfer, _ := entity.TransferFromLegs([]*entity.Transaction{transaction, counterLeg})
+
+	if err := uc.transactionRepo.SaveTransfer(ctx, transfer); err != nil {
+		return nil, fmt.Errorf("failed to convert transaction: %w", err)
+	}
+
+	// Record the update of the transaction and the creation of the counter leg as a single operation
+	recordTransferChanges(ctx, uc.changeRepo, input.UserID, before, transfer.Legs())
+
+	// The transaction no longer counts towards its former category's spending goal
+	if hadCategory && uc.goalAlertNotifier != nil {
+		uc.goalAlertNotifier.NotifyTransactionsChanged(input.UserID)
+	}
+
+	return &ConvertTransactionOutput{
+		Transfer: toTransferOutput(transfer),
+	}, nil
+}
+
+// checkConvertible reports why a transaction cannot become a transfer leg, if it cannot.
+func checkConvertible(transaction *entity.Transaction) error {
+	var reason string
+	switch {
+	case transaction.IsTransfer():
+		reason = "transaction is already part of a transfer"
+	case transaction.AccountID == nil:
+		reason = "transaction must belong to an account to be converted into a transfer"
+	case transaction.ExpandedAt != nil:
+		reason = "expanded bill payments cannot be converted into a transfer"
+	case transaction.Amount.IsZero():
+		reason = "transactions with a zero amount cannot be converted into a transfer"
+	default:
+		return nil
+	}
+
+	return domainerror.NewTransferError(
+		domainerror.ErrCodeTransactionNotConvertible,
+		reason,
+		domainerror.ErrTransactionNotConvertible,
+	)
+}
@@ -0,0 +1,243 @@
+// Package transfer contains use cases for transfers between accounts.
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+const (
+	// MaxDescriptionLength is the maximum allowed length for transfer descriptions.
+	MaxDescriptionLength = 255
+	// MaxNotesLength is the maximum allowed length for transfer notes.
+	MaxNotesLength = 1000
+)
+
+// CreateTransferInput represents the input for transfer creation.
+type CreateTransferInput struct {
+	UserID        uuid.UUID
+	FromAccountID uuid.UUID
+	ToAccountID   uuid.UUID
+	Date          time.Time
+	Description   string
+	Amount        decimal.Decimal // Amount moved; must be positive
+	Notes         string
+}
+
+// TransferOutput represents a transfer in the output.
+type TransferOutput struct {
+	ID                uuid.UUID
+	UserID            uuid.UUID
+	FromAccountID     *uuid.UUID // Nil when the source account was deleted
+	ToAccountID       *uuid.UUID // Nil when the destination account was deleted
+	FromTransactionID uuid.UUID  // Outgoing leg (negative amount in the source account)
+	ToTransactionID   uuid.UUID  // Incoming leg (positive amount in the destination account)
+	Date              time.Time
+	Description       string
+	Amount            decimal.Decimal
+	Notes             string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// CreateTransferOutput represents the output of transfer creation.
+type CreateTransferOutput struct {
+	Transfer *TransferOutput
+}
+
+// CreateTransferUseCase handles transfer creation logic.
+type CreateTransferUseCase struct {
+	transactionRepo adapter.TransactionRepository
+	changeRepo      adapter.TransactionChangeRepository
+	accountRepo     adapter.AccountRepository
+}
+
+// NewCreateTransferUseCase creates a new CreateTransferUseCase instance.
+func NewCreateTransferUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	accountRepo adapter.AccountRepository,
+) *CreateTransferUseCase {
+	return &CreateTransferUseCase{
+		transactionRepo: transactionRepo,
+		changeRepo:      changeRepo,
+		accountRepo:     accountRepo,
+	}
+}
+
+// Execute creates both legs of the transfer atomically.
+func (uc *CreateTransferUseCase) Execute(ctx context.Context, input CreateTransferInput) (*CreateTransferOutput, error) {
+	description := strings.TrimSpace(input.Description)
+
+	if err := validateTransferFields(description, input.Notes, input.Amount); err != nil {
+		return nil, err
+	}
+
+	currency, err := validateTransferAccounts(ctx, uc.accountRepo, input.FromAccountID, input.ToAccountID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := entity.NewTransfer(
+		input.UserID,
+		input.FromAccountID,
+		input.ToAccountID,
+		input.Date,
+		description,
+		input.Amount,
+		input.Notes,
+	)
+	for _, leg := range transfer.Legs() {
+		leg.Currency = currency
+	}
+
+	if err := uc.transactionRepo.SaveTransfer(ctx, transfer); err != nil {
+		return nil, fmt.Errorf("failed to create transfer: %w", err)
+	}
+
+	// Record the creation of both legs in the transaction history
+	recordTransferChanges(ctx, uc.changeRepo, input.UserID, nil, transfer.Legs())
+
+	return &CreateTransferOutput{
+		Transfer: toTransferOutput(transfer),
+	}, nil
+}
+
+// validateTransferFields checks the description, notes and amount of a transfer.
+func validateTransferFields(description string, notes string, amount decimal.Decimal) error {
+	if description == "" {
+		return domainerror.NewTransferError(
+			domainerror.ErrCodeTransferMissingFields,
+			"description is required",
+			domainerror.ErrTransferMissingFields,
+		)
+	}
+
+	if len(description) > MaxDescriptionLength {
+		return domainerror.NewTransferError(
+			domainerror.ErrCodeTransferDescriptionTooLong,
+			fmt.Sprintf("description must not exceed %d characters", MaxDescriptionLength),
+			domainerror.ErrDescriptionTooLong,
+		)
+	}
+
+	if len(notes) > MaxNotesLength {
+		return domainerror.NewTransferError(
+			domainerror.ErrCodeTransferNotesTooLong,
+			fmt.Sprintf("notes must not exceed %d characters", MaxNotesLength),
+			domainerror.ErrNotesTooLong,
+		)
+	}
+
+	if !amount.IsPositive() {
+		return domainerror.NewTransferError(
+			domainerror.ErrCodeInvalidTransferAmount,
+			"amount must be greater than zero",
+			domainerror.ErrInvalidTransferAmount,
+		)
+	}
+
+	return nil
+}
+
+// validateTransferAccounts checks that both accounts differ, exist, belong to the user and use the
+// same currency, which it returns. Both legs move the same amount, so they must be in one currency.
+func validateTransferAccounts(
+	ctx context.Context,
+	accountRepo adapter.AccountRepository,
+	fromAccountID uuid.UUID,
+	toAccountID uuid.UUID,
+	userID uuid.UUID,
+) (string, error) {
+	if fromAccountID == toAccountID {
+		return "", domainerror.NewTransferError(
+			domainerror.ErrCodeSameTransferAccount,
+			"source and destination accounts must be different",
+			domainerror.ErrSameTransferAccount,
+		)
+	}
+
+	fromAccount, err := findTransferAccount(ctx, accountRepo, fromAccountID, userID)
+	if err != nil {
+		return "", err
+	}
+	toAccount, err := findTransferAccount(ctx, accountRepo, toAccountID, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := validateTransferCurrency(fromAccount.Currency, toAccount); err != nil {
+		return "", err
+	}
+	return fromAccount.Currency, nil
+}
+
+// validateTransferCurrency checks that an account of a transfer uses the currency of the other side.
+func validateTransferCurrency(currency string, account *entity.Account) error {
+	if currency != "" && currency != account.Currency {
+		return domainerror.NewTransferError(
+			domainerror.ErrCodeTransferCurrencyMismatch,
+			fmt.Sprintf("transfers between accounts in %s and %s are not supported", currency, account.Currency),
+			domainerror.ErrTransferCurrencyMismatch,
+		)
+	}
+	return nil
+}
+
+// findTransferAccount loads an account used by a transfer and verifies it belongs to the user.
+func findTransferAccount(
+	ctx context.Context,
+	accountRepo adapter.AccountRepository,
+	accountID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Account, error) {
+	account, err := accountRepo.FindByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, domainerror.ErrAccountNotFound) {
+			return nil, domainerror.NewTransferError(
+				domainerror.ErrCodeTransferAccountNotFound,
+				"account not found",
+				domainerror.ErrTransferAccountNotFound,
+			)
+		}
+		return nil, fmt.Errorf("failed to find account: %w", err)
+	}
+
+	if account.UserID != userID {
+		return nil, domainerror.NewTransferError(
+			domainerror.ErrCodeTransferAccountNotOwned,
+			"account does not belong to user",
+			domainerror.ErrTransferAccountNotOwned,
+		)
+	}
+
+	return account, nil
+}
+
+// toTransferOutput converts a transfer entity to its output representation.
+func toTransferOutput(transfer *entity.Transfer) *TransferOutput {
+	return &TransferOutput{
+		ID:                transfer.ID,
+		UserID:            transfer.From.UserID,
+		FromAccountID:     transfer.From.AccountID,
+		ToAccountID:       transfer.To.AccountID,
+		FromTransactionID: transfer.From.ID,
+		ToTransactionID:   transfer.To.ID,
+		Date:              transfer.From.Date,
+		Description:       transfer.From.Description,
+		Amount:            transfer.Amount(),
+		Notes:             transfer.From.Notes,
+		CreatedAt:         transfer.From.CreatedAt,
+		UpdatedAt:         transfer.From.UpdatedAt,
+	}
+}
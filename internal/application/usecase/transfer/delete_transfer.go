@@ -0,0 +1,68 @@
+// Package transfer contains use cases for transfers between accounts.
+package transfer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+// DeleteTransferInput represents the input for transfer deletion.
+type DeleteTransferInput struct {
+	TransferID uuid.UUID
+	UserID     uuid.UUID
+}
+
+// DeleteTransferOutput represents the output of transfer deletion.
+type DeleteTransferOutput struct {
+	Success bool
+}
+
+// DeleteTransferUseCase handles transfer deletion logic.
+type DeleteTransferUseCase struct {
+	transactionRepo   adapter.TransactionRepository
+	changeRepo        adapter.TransactionChangeRepository
+	attachmentCleanup adapter.AttachmentCleanupNotifier
+}
+
+// NewDeleteTransferUseCase creates a new DeleteTransferUseCase instance.
+func NewDeleteTransferUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	attachmentCleanup adapter.AttachmentCleanupNotifier,
+) *DeleteTransferUseCase {
+	return &DeleteTransferUseCase{
+		transactionRepo:   transactionRepo,
+		changeRepo:        changeRepo,
+		attachmentCleanup: attachmentCleanup,
+	}
+}
+
+// Execute soft-deletes both legs of the transfer atomically.
+func (uc *DeleteTransferUseCase) Execute(ctx context.Context, input DeleteTransferInput) (*DeleteTransferOutput, error) {
+	// Find the existing transfer and check ownership
+	transfer, err := findOwnedTransfer(ctx, uc.transactionRepo, input.TransferID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete both legs
+	if err := uc.transactionRepo.DeleteTransfer(ctx, input.TransferID, input.UserID); err != nil {
+		return nil, fmt.Errorf("failed to delete transfer: %w", err)
+	}
+
+	// Record the deletion of both legs in the transaction history
+	recordTransferChanges(ctx, uc.changeRepo, input.UserID, transfer.Legs(), nil)
+
+	// Remove the attachments of the deleted transfer in the background
+	if uc.attachmentCleanup != nil {
+		uc.attachmentCleanup.NotifyOwnersDeleted(input.UserID)
+	}
+
+	return &DeleteTransferOutput{
+		Success: true,
+	}, nil
+}
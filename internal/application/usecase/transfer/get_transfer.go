@@ -0,0 +1,80 @@
+// Package transfer contains use cases for transfers between accounts.
+package transfer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// GetTransferInput represents the input for retrieving a transfer.
+type GetTransferInput struct {
+	TransferID uuid.UUID
+	UserID     uuid.UUID
+}
+
+// GetTransferOutput represents the output of retrieving a transfer.
+type GetTransferOutput struct {
+	Transfer *TransferOutput
+}
+
+// GetTransferUseCase handles retrieving a single transfer.
+type GetTransferUseCase struct {
+	transactionRepo adapter.TransactionRepository
+}
+
+// NewGetTransferUseCase creates a new GetTransferUseCase instance.
+func NewGetTransferUseCase(transactionRepo adapter.TransactionRepository) *GetTransferUseCase {
+	return &GetTransferUseCase{
+		transactionRepo: transactionRepo,
+	}
+}
+
+// Execute retrieves the transfer with both of its legs.
+func (uc *GetTransferUseCase) Execute(ctx context.Context, input GetTransferInput) (*GetTransferOutput, error) {
+	transfer, err := findOwnedTransfer(ctx, uc.transactionRepo, input.TransferID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetTransferOutput{
+		Transfer: toTransferOutput(transfer),
+	}, nil
+}
+
+// findOwnedTransfer loads both legs of a transfer and verifies it belongs to the user.
+func findOwnedTransfer(
+	ctx context.Context,
+	transactionRepo adapter.TransactionRepository,
+	transferID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.Transfer, error) {
+	legs, err := transactionRepo.FindByTransferID(ctx, transferID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find transfer: %w", err)
+	}
+
+	transfer, ok := entity.TransferFromLegs(legs)
+	if !ok {
+		return nil, domainerror.NewTransferError(
+			domainerror.ErrCodeTransferNotFound,
+			"transfer not found",
+			domainerror.ErrTransferNotFound,
+		)
+	}
+
+	if transfer.From.UserID != userID || transfer.To.UserID != userID {
+		return nil, domainerror.NewTransferError(
+			domainerror.ErrCodeNotAuthorizedTransfer,
+			"not authorized to access this transfer",
+			domainerror.ErrNotAuthorizedTransfer,
+		)
+	}
+
+	return transfer, nil
+}
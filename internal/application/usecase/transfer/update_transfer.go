@@ -0,0 +1,139 @@
+// Package transfer contains use cases for transfers between accounts.
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// UpdateTransferInput represents the input for transfer update.
+// Nil fields are left unchanged.
+type UpdateTransferInput struct {
+	TransferID    uuid.UUID
+	UserID        uuid.UUID
+	FromAccountID *uuid.UUID
+	ToAccountID   *uuid.UUID
+	Date          *time.Time
+	Description   *string
+	Amount        *decimal.Decimal
+	Notes         *string
+}
+
+// UpdateTransferOutput represents the output of transfer update.
+type UpdateTransferOutput struct {
+	Transfer *TransferOutput
+}
+
+// UpdateTransferUseCase handles transfer update logic.
+type UpdateTransferUseCase struct {
+	transactionRepo adapter.TransactionRepository
+	changeRepo      adapter.TransactionChangeRepository
+	accountRepo     adapter.AccountRepository
+}
+
+// NewUpdateTransferUseCase creates a new UpdateTransferUseCase instance.
+func NewUpdateTransferUseCase(
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	accountRepo adapter.AccountRepository,
+) *UpdateTransferUseCase {
+	return &UpdateTransferUseCase{
+		transactionRepo: transactionRepo,
+		changeRepo:      changeRepo,
+		accountRepo:     accountRepo,
+	}
+}
+
+// Execute applies the changes to both legs of the transfer atomically.
+func (uc *UpdateTransferUseCase) Execute(ctx context.Context, input UpdateTransferInput) (*UpdateTransferOutput, error) {
+	transfer, err := findOwnedTransfer(ctx, uc.transactionRepo, input.TransferID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	current := toTransferOutput(transfer)
+
+	// Merge changes with the current values
+	fromAccountID, toAccountID := current.FromAccountID, current.ToAccountID
+	if input.FromAccountID != nil {
+		fromAccountID = input.FromAccountID
+	}
+	if input.ToAccountID != nil {
+		toAccountID = input.ToAccountID
+	}
+
+	// A leg loses its account when the account is deleted; a new one must be provided
+	if fromAccountID == nil || toAccountID == nil {
+		return nil, domainerror.NewTransferError(
+			domainerror.ErrCodeTransferMissingFields,
+			"source and destination accounts are required",
+			domainerror.ErrTransferMissingFields,
+		)
+	}
+
+	date := current.Date
+	if input.Date != nil {
+		date = *input.Date
+	}
+
+	description := current.Description
+	if input.Description != nil {
+		description = strings.TrimSpace(*input.Description)
+	}
+
+	amount := current.Amount
+	if input.Amount != nil {
+		amount = *input.Amount
+	}
+
+	notes := current.Notes
+	if input.Notes != nil {
+		notes = *input.Notes
+	}
+
+	if err := validateTransferFields(description, notes, amount); err != nil {
+		return nil, err
+	}
+
+	if input.FromAccountID != nil || input.ToAccountID != nil {
+		currency, err := validateTransferAccounts(ctx, uc.accountRepo, *fromAccountID, *toAccountID, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		for _, leg := range transfer.Legs() {
+			leg.Currency = currency
+		}
+	}
+
+	// Apply changes to both legs, keeping their values before the update for the change history
+	before := snapshotLegs(transfer.Legs())
+	now := time.Now().UTC()
+	transfer.From.AccountID = fromAccountID
+	transfer.From.Amount = amount.Neg()
+	transfer.To.AccountID = toAccountID
+	transfer.To.Amount = amount
+	for _, leg := range transfer.Legs() {
+		leg.Date = date
+		leg.Description = description
+		leg.Notes = notes
+		leg.UpdatedAt = now
+	}
+
+	if err := uc.transactionRepo.SaveTransfer(ctx, transfer); err != nil {
+		return nil, fmt.Errorf("failed to update transfer: %w", err)
+	}
+
+	recordTransferChanges(ctx, uc.changeRepo, input.UserID, before, transfer.Legs())
+
+	return &UpdateTransferOutput{
+		Transfer: toTransferOutput(transfer),
+	}, nil
+}
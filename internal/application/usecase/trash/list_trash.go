@@ -0,0 +1,129 @@
+// Package trash contains trash-related use cases.
+package trash
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// DefaultTrashLimit is the default number of items per page.
+const DefaultTrashLimit = 50
+
+// MaxTrashLimit is the maximum allowed number of items per page.
+const MaxTrashLimit = 100
+
+// DefaultRetentionDays is how long deleted items stay in the trash when no retention is configured.
+const DefaultRetentionDays = 30
+
+// ListTrashInput represents the input for listing the trash.
+type ListTrashInput struct {
+	UserID uuid.UUID
+	Type   string // Optional filter: transaction, category, goal or category_rule
+	Limit  int
+	Offset int
+}
+
+// TrashItemOutput represents an item in the trash.
+type TrashItemOutput struct {
+	Type      entity.TrashItemType
+	ID        uuid.UUID
+	Name      string
+	DeletedAt time.Time
+	ExpiresAt time.Time // When the retention job permanently deletes the item
+}
+
+// ListTrashOutput represents the output of listing the trash.
+type ListTrashOutput struct {
+	Items         []*TrashItemOutput
+	Total         int64
+	Limit         int
+	Offset        int
+	RetentionDays int
+}
+
+// ListTrashUseCase handles listing the user's soft-deleted items.
+type ListTrashUseCase struct {
+	trashRepo     adapter.TrashRepository
+	retentionDays int
+}
+
+// NewListTrashUseCase creates a new ListTrashUseCase instance.
+func NewListTrashUseCase(trashRepo adapter.TrashRepository, retentionDays int) *ListTrashUseCase {
+	return &ListTrashUseCase{
+		trashRepo:     trashRepo,
+		retentionDays: retentionDays,
+	}
+}
+
+// Execute lists the trash, most recently deleted first.
+func (uc *ListTrashUseCase) Execute(ctx context.Context, input ListTrashInput) (*ListTrashOutput, error) {
+	var itemType *entity.TrashItemType
+	if input.Type != "" {
+		parsed, err := parseTrashItemType(input.Type)
+		if err != nil {
+			return nil, err
+		}
+		itemType = &parsed
+	}
+
+	// Apply default/max limits
+	limit := input.Limit
+	if limit <= 0 {
+		limit = DefaultTrashLimit
+	}
+	if limit > MaxTrashLimit {
+		limit = MaxTrashLimit
+	}
+	offset := input.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	items, total, err := uc.trashRepo.List(ctx, input.UserID, itemType, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trash: %w", err)
+	}
+
+	return &ListTrashOutput{
+		Items:         toTrashItemOutputs(items, uc.retentionDays),
+		Total:         total,
+		Limit:         limit,
+		Offset:        offset,
+		RetentionDays: uc.retentionDays,
+	}, nil
+}
+
+// parseTrashItemType validates an item type parameter.
+func parseTrashItemType(value string) (entity.TrashItemType, error) {
+	itemType := entity.TrashItemType(value)
+	if !itemType.IsValid() {
+		return "", domainerror.NewTrashError(
+			domainerror.ErrCodeInvalidTrashItemType,
+			fmt.Sprintf("invalid item type %q; expected transaction, category, goal or category_rule", value),
+			domainerror.ErrInvalidTrashItemType,
+		)
+	}
+	return itemType, nil
+}
+
+// toTrashItemOutputs converts trash items to their outputs.
+func toTrashItemOutputs(items []*entity.TrashItem, retentionDays int) []*TrashItemOutput {
+	outputs := make([]*TrashItemOutput, len(items))
+	for i, item := range items {
+		outputs[i] = &TrashItemOutput{
+			Type:      item.Type,
+			ID:        item.ID,
+			Name:      item.Name,
+			DeletedAt: item.DeletedAt,
+			ExpiresAt: item.ExpiresAt(retentionDays),
+		}
+	}
+	return outputs
+}
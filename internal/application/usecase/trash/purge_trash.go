@@ -0,0 +1,51 @@
+// Package trash contains trash-related use cases.
+package trash
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+// PurgeTrashInput represents the input for purging expired items from the trash.
+type PurgeTrashInput struct {
+	Now           time.Time
+	RetentionDays int // Items deleted longer ago than this are purged
+}
+
+// PurgeTrashOutput represents the output of purging the trash.
+type PurgeTrashOutput struct {
+	PurgedCount int64
+}
+
+// PurgeTrashUseCase handles permanently deleting items whose retention period has ended.
+type PurgeTrashUseCase struct {
+	trashRepo adapter.TrashRepository
+}
+
+// NewPurgeTrashUseCase creates a new PurgeTrashUseCase instance.
+func NewPurgeTrashUseCase(trashRepo adapter.TrashRepository) *PurgeTrashUseCase {
+	return &PurgeTrashUseCase{
+		trashRepo: trashRepo,
+	}
+}
+
+// Execute permanently deletes the items of every user that were deleted before the retention period.
+// Attachments of purged transactions are removed afterwards by the attachment cleanup.
+func (uc *PurgeTrashUseCase) Execute(ctx context.Context, input PurgeTrashInput) (*PurgeTrashOutput, error) {
+	retentionDays := input.RetentionDays
+	if retentionDays < 0 {
+		retentionDays = 0
+	}
+
+	purged, err := uc.trashRepo.Purge(ctx, input.Now.AddDate(0, 0, -retentionDays))
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge trash: %w", err)
+	}
+
+	return &PurgeTrashOutput{
+		PurgedCount: purged,
+	}, nil
+}
@@ -0,0 +1,253 @@
+// Package trash contains trash-related use cases.
+package trash
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+// ReferenceMode defines what happens to references to categories that are also in the trash.
+type ReferenceMode string
+
+const (
+	// ReferenceModeFail rejects the restore, so the user decides what to do (default).
+	ReferenceModeFail ReferenceMode = ""
+	// ReferenceModeRestore restores the referenced categories as well.
+	ReferenceModeRestore ReferenceMode = "restore"
+	// ReferenceModeDetach restores the items without the categories, e.g., transactions become uncategorized.
+	ReferenceModeDetach ReferenceMode = "detach"
+)
+
+// RestoreTrashItemsInput represents the input for restoring items from the trash.
+type RestoreTrashItemsInput struct {
+	UserID     uuid.UUID
+	Items      []entity.TrashItemRef
+	References ReferenceMode
+}
+
+// RestoreTrashItemsOutput represents the output of restoring items from the trash.
+type RestoreTrashItemsOutput struct {
+	Restored            []*TrashItemOutput // Requested items, followed by the categories restored with them
+	DetachedCategoryIDs []uuid.UUID        // Categories left in the trash and removed from restored items
+}
+
+// RestoreTrashItemsUseCase handles restoring soft-deleted items.
+type RestoreTrashItemsUseCase struct {
+	trashRepo         adapter.TrashRepository
+	transactionRepo   adapter.TransactionRepository
+	changeRepo        adapter.TransactionChangeRepository
+	goalAlertNotifier adapter.GoalAlertNotifier
+	retentionDays     int
+}
+
+// NewRestoreTrashItemsUseCase creates a new RestoreTrashItemsUseCase instance.
+func NewRestoreTrashItemsUseCase(
+	trashRepo adapter.TrashRepository,
+	transactionRepo adapter.TransactionRepository,
+	changeRepo adapter.TransactionChangeRepository,
+	goalAlertNotifier adapter.GoalAlertNotifier,
+	retentionDays int,
+) *RestoreTrashItemsUseCase {
+	return &RestoreTrashItemsUseCase{
+		trashRepo:         trashRepo,
+		transactionRepo:   transactionRepo,
+		changeRepo:        changeRepo,
+		goalAlertNotifier: goalAlertNotifier,
+		retentionDays:     retentionDays,
+	}
+}
+
+// Execute restores the items after revalidating their references and checking they do not
+// duplicate active items. Nothing is restored if any item cannot be.
+func (uc *RestoreTrashItemsUseCase) Execute(ctx context.Context, input RestoreTrashItemsInput) (*RestoreTrashItemsOutput, error) {
+	// Validate input
+	if len(input.Items) == 0 {
+		return nil, domainerror.NewTrashError(
+			domainerror.ErrCodeEmptyTrashItems,
+			"items list cannot be empty",
+			domainerror.ErrEmptyTrashItems,
+		)
+	}
+	if input.References != ReferenceModeFail &&
+		input.References != ReferenceModeRestore &&
+		input.References != ReferenceModeDetach {
+		return nil, domainerror.NewTrashError(
+			domainerror.ErrCodeInvalidReferenceMode,
+			fmt.Sprintf("invalid references mode %q; expected restore or detach", input.References),
+			domainerror.ErrInvalidReferenceMode,
+		)
+	}
+	refs := make([]entity.TrashItemRef, 0, len(input.Items))
+	seen := make(map[entity.TrashItemRef]bool, len(input.Items))
+	for _, ref := range input.Items {
+		if _, err := parseTrashItemType(string(ref.Type)); err != nil {
+			return nil, err
+		}
+		if !seen[ref] {
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+
+	// Find the items in the user's trash
+	items, err := uc.findItems(ctx, input.UserID, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revalidate references to categories that are also in the trash
+	references, err := uc.trashRepo.FindDeletedReferences(ctx, refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find deleted references: %w", err)
+	}
+	var categoryRefs []entity.TrashItemRef
+	var detachCategoryIDs []uuid.UUID
+	for _, reference := range references {
+		categoryRef := entity.TrashItemRef{Type: entity.TrashItemTypeCategory, ID: reference.CategoryID}
+		if seen[categoryRef] {
+			continue
+		}
+
+		switch input.References {
+		case ReferenceModeRestore:
+			seen[categoryRef] = true
+			categoryRefs = append(categoryRefs, categoryRef)
+		case ReferenceModeDetach:
+			if !reference.Detachable {
+				return nil, domainerror.NewTrashError(
+					domainerror.ErrCodeTrashReferenceDeleted,
+					fmt.Sprintf("%s cannot be restored without its category %q; restore the category as well",
+						itemLabel(reference.Item.Type), reference.CategoryName),
+					domainerror.ErrTrashReferenceDeleted,
+				)
+			}
+			if !containsID(detachCategoryIDs, reference.CategoryID) {
+				detachCategoryIDs = append(detachCategoryIDs, reference.CategoryID)
+			}
+		default:
+			return nil, domainerror.NewTrashError(
+				domainerror.ErrCodeTrashReferenceDeleted,
+				fmt.Sprintf("category %q is also in the trash; restore it as well or detach it", reference.CategoryName),
+				domainerror.ErrTrashReferenceDeleted,
+			)
+		}
+	}
+
+	// Categories restored with the items must be in the user's trash too (not, e.g., a group's)
+	if len(categoryRefs) > 0 {
+		categories, err := uc.findItems(ctx, input.UserID, categoryRefs)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, categories...)
+		refs = append(refs, categoryRefs...)
+	}
+
+	// Restored items must not duplicate active ones
+	conflicts, err := uc.trashRepo.FindConflicts(ctx, refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check restore conflicts: %w", err)
+	}
+	if len(conflicts) > 0 {
+		conflict := conflicts[0]
+		return nil, domainerror.NewTrashError(
+			domainerror.ErrCodeTrashRestoreConflict,
+			fmt.Sprintf("%s %q conflicts with an active %s", itemLabel(conflict.Type), conflict.Name, itemLabel(conflict.Type)),
+			domainerror.ErrTrashRestoreConflict,
+		)
+	}
+
+	// Restore all items at once
+	restoredIDs, err := uc.trashRepo.Restore(ctx, input.UserID, refs, detachCategoryIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore items: %w", err)
+	}
+	uc.recordRestoredTransactions(ctx, input.UserID, restoredIDs)
+
+	// Re-evaluate spending goals in the background
+	if uc.goalAlertNotifier != nil {
+		uc.goalAlertNotifier.NotifyTransactionsChanged(input.UserID)
+	}
+
+	return &RestoreTrashItemsOutput{
+		Restored:            toTrashItemOutputs(items, uc.retentionDays),
+		DetachedCategoryIDs: detachCategoryIDs,
+	}, nil
+}
+
+// recordRestoredTransactions records the restored transactions in their history as a single operation.
+// The items are already restored, so a failure to load them is logged instead of returned.
+func (uc *RestoreTrashItemsUseCase) recordRestoredTransactions(ctx context.Context, userID uuid.UUID, transactionIDs []uuid.UUID) {
+	if len(transactionIDs) == 0 || uc.transactionRepo == nil {
+		return
+	}
+
+	restored, err := uc.transactionRepo.FindByIDs(ctx, transactionIDs, userID)
+	if err != nil {
+		slog.Warn("Failed to load restored transactions for history", "error", err)
+		return
+	}
+
+	operationID := uuid.New()
+	changes := make([]*entity.TransactionChange, len(restored))
+	for i, txn := range restored {
+		changes[i] = entity.NewTransactionChange(nil, txn, &userID, entity.TransactionChangeSourceManual, operationID)
+	}
+	transaction.RecordTransactionChanges(ctx, uc.changeRepo, changes...)
+}
+
+// findItems retrieves the referenced items from the user's trash, failing if any is missing.
+// Items are returned in the order of the references.
+func (uc *RestoreTrashItemsUseCase) findItems(
+	ctx context.Context,
+	userID uuid.UUID,
+	refs []entity.TrashItemRef,
+) ([]*entity.TrashItem, error) {
+	found, err := uc.trashRepo.FindByRefs(ctx, userID, refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find trash items: %w", err)
+	}
+
+	byRef := make(map[entity.TrashItemRef]*entity.TrashItem, len(found))
+	for _, item := range found {
+		byRef[item.Ref()] = item
+	}
+
+	items := make([]*entity.TrashItem, 0, len(refs))
+	for _, ref := range refs {
+		item, ok := byRef[ref]
+		if !ok {
+			return nil, domainerror.NewTrashError(
+				domainerror.ErrCodeTrashItemNotFound,
+				fmt.Sprintf("%s %s not found in trash", itemLabel(ref.Type), ref.ID),
+				domainerror.ErrTrashItemNotFound,
+			)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// itemLabel returns the item type as used in messages, e.g., "category rule".
+func itemLabel(itemType entity.TrashItemType) string {
+	return strings.ReplaceAll(string(itemType), "_", " ")
+}
+
+// containsID returns true if the ID is in the list.
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// AccountType represents the kind of financial account.
+type AccountType string
+
+const (
+	AccountTypeChecking   AccountType = "checking"
+	AccountTypeSavings    AccountType = "savings"
+	AccountTypeCreditCard AccountType = "credit_card"
+	AccountTypeWallet     AccountType = "wallet"
+)
+
+// DefaultAccountCurrency is the currency used when an account does not specify one.
+const DefaultAccountCurrency = DefaultCurrency
+
+// Account represents a place where money is held or owed (a bank account, credit card or wallet).
+// Transactions optionally reference the account they belong to.
+type Account struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	Name           string
+	Type           AccountType
+	Institution    string          // Optional bank or issuer name (e.g., "Nubank")
+	Currency       string          // ISO 4217 code (e.g., "BRL")
+	OpeningBalance decimal.Decimal // Balance before the first tracked transaction
+	ClosingDay     *int            // Credit cards only: day of month the statement closes
+	DueDay         *int            // Credit cards only: day of month the bill is due
+	IsArchived     bool            // Archived accounts are hidden from pickers but keep their history
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time // Soft-delete support
+}
+
+// NewAccount creates a new Account entity.
+func NewAccount(userID uuid.UUID, name string, accountType AccountType, currency string, openingBalance decimal.Decimal) *Account {
+	now := time.Now().UTC()
+
+	if currency == "" {
+		currency = DefaultAccountCurrency
+	}
+
+	return &Account{
+		ID:             uuid.New(),
+		UserID:         userID,
+		Name:           name,
+		Type:           accountType,
+		Currency:       currency,
+		OpeningBalance: openingBalance,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// IsCreditCard reports whether the account is a credit card.
+func (a *Account) IsCreditCard() bool {
+	return a.Type == AccountTypeCreditCard
+}
+
+// IsValidAccountType checks whether the account type is one of the supported types.
+func IsValidAccountType(accountType AccountType) bool {
+	switch accountType {
+	case AccountTypeChecking, AccountTypeSavings, AccountTypeCreditCard, AccountTypeWallet:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,109 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentOwnerType defines what an attachment belongs to.
+type AttachmentOwnerType string
+
+const (
+	AttachmentOwnerTransaction AttachmentOwnerType = "transaction"
+	AttachmentOwnerAccount     AttachmentOwnerType = "account"
+)
+
+// IsValid checks if the attachment owner type is valid.
+func (t AttachmentOwnerType) IsValid() bool {
+	return t == AttachmentOwnerTransaction || t == AttachmentOwnerAccount
+}
+
+// AttachmentStatus defines the upload state of an attachment.
+type AttachmentStatus string
+
+const (
+	// AttachmentStatusPending means an upload URL was issued but the upload was not completed yet.
+	AttachmentStatusPending AttachmentStatus = "pending"
+	// AttachmentStatusUploaded means the file is in object storage.
+	AttachmentStatusUploaded AttachmentStatus = "uploaded"
+)
+
+// Attachment represents a file (e.g., a receipt or a warranty) kept next to a transaction
+// or an account. The file itself lives in object storage under StorageKey.
+type Attachment struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	OwnerType    AttachmentOwnerType
+	OwnerID      uuid.UUID
+	FileName     string
+	ContentType  string
+	SizeBytes    int64
+	Checksum     string // Hex SHA-256 of the file, empty until uploaded
+	StorageKey   string
+	ThumbnailKey string // Empty when no thumbnail was generated
+	ImageWidth   *int   // Set for images
+	ImageHeight  *int   // Set for images
+	Status       AttachmentStatus
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewAttachment creates a new Attachment entity with its storage key.
+func NewAttachment(
+	userID uuid.UUID,
+	ownerType AttachmentOwnerType,
+	ownerID uuid.UUID,
+	fileName string,
+	contentType string,
+	status AttachmentStatus,
+) *Attachment {
+	now := time.Now().UTC()
+	id := uuid.New()
+
+	return &Attachment{
+		ID:          id,
+		UserID:      userID,
+		OwnerType:   ownerType,
+		OwnerID:     ownerID,
+		FileName:    fileName,
+		ContentType: contentType,
+		StorageKey:  fmt.Sprintf("users/%s/attachments/%s/%s", userID, id, storageFileName(fileName)),
+		Status:      status,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// IsUploaded returns true if the file is in object storage.
+func (a *Attachment) IsUploaded() bool {
+	return a.Status == AttachmentStatusUploaded
+}
+
+// ThumbnailStorageKey returns the key the attachment's thumbnail is stored under.
+func (a *Attachment) ThumbnailStorageKey() string {
+	return path.Join(path.Dir(a.StorageKey), "thumbnail.jpg")
+}
+
+// storageFileName reduces a user-supplied file name to characters that are safe in object keys.
+func storageFileName(fileName string) string {
+	var b strings.Builder
+	for _, r := range path.Base(strings.ReplaceAll(fileName, "\\", "/")) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+
+	name := strings.Trim(b.String(), ".")
+	if name == "" {
+		return "file"
+	}
+	return name
+}
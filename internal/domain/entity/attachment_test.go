@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewAttachment_StorageKeys(t *testing.T) {
+	userID := uuid.New()
+
+	attachment := NewAttachment(userID, AttachmentOwnerTransaction, uuid.New(), "Receipt.PDF", "application/pdf", AttachmentStatusUploaded)
+
+	prefix := "users/" + userID.String() + "/attachments/" + attachment.ID.String() + "/"
+	if attachment.StorageKey != prefix+"Receipt.PDF" {
+		t.Errorf("expected storage key %q, got %q", prefix+"Receipt.PDF", attachment.StorageKey)
+	}
+	if attachment.ThumbnailStorageKey() != prefix+"thumbnail.jpg" {
+		t.Errorf("expected thumbnail key %q, got %q", prefix+"thumbnail.jpg", attachment.ThumbnailStorageKey())
+	}
+	if !attachment.IsUploaded() {
+		t.Error("expected attachment to be uploaded")
+	}
+}
+
+func TestStorageFileName(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		expected string
+	}{
+		{"keeps safe names", "receipt_2024-11.jpg", "receipt_2024-11.jpg"},
+		{"replaces spaces and accents", "nota fiscal ção.pdf", "nota_fiscal___o.pdf"},
+		{"drops unix directories", "../../etc/passwd", "passwd"},
+		{"drops windows directories", `C:\Users\me\scan.png`, "scan.png"},
+		{"trims leading dots", ".hidden", "hidden"},
+		{"falls back when nothing is left", "..", "file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := storageFileName(tt.fileName)
+			if got != tt.expected {
+				t.Errorf("storageFileName(%q) = %q, expected %q", tt.fileName, got, tt.expected)
+			}
+			if strings.Contains(got, "/") {
+				t.Errorf("storageFileName(%q) = %q contains a path separator", tt.fileName, got)
+			}
+		})
+	}
+}
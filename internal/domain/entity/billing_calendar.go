@@ -0,0 +1,158 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BillingCycleOverride moves the closing or due date of one billing cycle of a credit card,
+// e.g., when the regular day falls on a holiday.
+type BillingCycleOverride struct {
+	ID           uuid.UUID
+	AccountID    uuid.UUID
+	BillingCycle string     // Billing cycle the override applies to, "YYYY-MM"
+	ClosingDate  *time.Time // nil keeps the card's closing day
+	DueDate      *time.Time // nil keeps the card's due day
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewBillingCycleOverride creates a new BillingCycleOverride entity.
+func NewBillingCycleOverride(accountID uuid.UUID, billingCycle string, closingDate, dueDate *time.Time) *BillingCycleOverride {
+	now := time.Now().UTC()
+
+	return &BillingCycleOverride{
+		ID:           uuid.New(),
+		AccountID:    accountID,
+		BillingCycle: billingCycle,
+		ClosingDate:  closingDate,
+		DueDate:      dueDate,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+// BillingPeriod represents the purchases charged in one credit card bill.
+type BillingPeriod struct {
+	BillingCycle string     // "YYYY-MM", named after the month the bill closes in
+	StartDate    time.Time  // Closing date of the previous bill, the first purchase date charged in this one
+	ClosingDate  time.Time  // Purchases from this date on are charged in the next bill
+	DueDate      *time.Time // nil when the card has no due day
+}
+
+// Contains reports whether a purchase made on the date is charged in the bill.
+func (p *BillingPeriod) Contains(date time.Time) bool {
+	return !date.Before(p.StartDate) && date.Before(p.ClosingDate)
+}
+
+// BillingCalendar derives the billing cycles of a credit card from its closing and due days.
+// The bill of a cycle closes on the closing day of the cycle's month and is due on the next due
+// day after it; days past the end of a short month fall on its last day.
+type BillingCalendar struct {
+	closingDay int
+	dueDay     *int
+	overrides  map[string]*BillingCycleOverride
+}
+
+// NewBillingCalendar creates the billing calendar of a credit card account. Returns nil when the
+// account is not a credit card or has no closing day.
+func NewBillingCalendar(account *Account, overrides []*BillingCycleOverride) *BillingCalendar {
+	if !account.IsCreditCard() || account.ClosingDay == nil {
+		return nil
+	}
+
+	calendar := &BillingCalendar{
+		closingDay: *account.ClosingDay,
+		dueDay:     account.DueDay,
+		overrides:  make(map[string]*BillingCycleOverride, len(overrides)),
+	}
+	for _, override := range overrides {
+		calendar.overrides[override.BillingCycle] = override
+	}
+	return calendar
+}
+
+// RegularClosingDate returns the closing date of the billing cycle from the card's closing day,
+// ignoring overrides. Returns the zero time when the cycle is not in "YYYY-MM" format.
+func (c *BillingCalendar) RegularClosingDate(billingCycle string) time.Time {
+	month, err := time.Parse(billingCycleLayout, billingCycle)
+	if err != nil {
+		return time.Time{}
+	}
+	return dayOfMonth(month, c.closingDay)
+}
+
+// RegularDueDate returns the due date of the billing cycle from the card's due day, ignoring
+// overrides. Returns nil when the card has no due day or the cycle is not in "YYYY-MM" format.
+func (c *BillingCalendar) RegularDueDate(billingCycle string) *time.Time {
+	month, err := time.Parse(billingCycleLayout, billingCycle)
+	if err != nil || c.dueDay == nil {
+		return nil
+	}
+
+	// A due day on or before the closing day falls in the following month
+	if *c.dueDay <= c.closingDay {
+		month = month.AddDate(0, 1, 0)
+	}
+	dueDate := dayOfMonth(month, *c.dueDay)
+	return &dueDate
+}
+
+// ClosingDate returns the closing date of the billing cycle.
+func (c *BillingCalendar) ClosingDate(billingCycle string) time.Time {
+	if override, ok := c.overrides[billingCycle]; ok && override.ClosingDate != nil {
+		return *override.ClosingDate
+	}
+	return c.RegularClosingDate(billingCycle)
+}
+
+// DueDate returns the due date of the billing cycle, or nil when the card has no due day.
+func (c *BillingCalendar) DueDate(billingCycle string) *time.Time {
+	if override, ok := c.overrides[billingCycle]; ok && override.DueDate != nil {
+		dueDate := *override.DueDate
+		return &dueDate
+	}
+	return c.RegularDueDate(billingCycle)
+}
+
+// Period returns the purchase period and dates of the billing cycle.
+func (c *BillingCalendar) Period(billingCycle string) *BillingPeriod {
+	return &BillingPeriod{
+		BillingCycle: billingCycle,
+		StartDate:    c.ClosingDate(ShiftBillingCycle(billingCycle, -1)),
+		ClosingDate:  c.ClosingDate(billingCycle),
+		DueDate:      c.DueDate(billingCycle),
+	}
+}
+
+// BillingCycleOf returns the billing cycle a purchase made on the date is charged in.
+// Purchases made on the closing date are charged in the next bill.
+func (c *BillingCalendar) BillingCycleOf(date time.Time) string {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	billingCycle := date.Format(billingCycleLayout)
+	for !date.Before(c.ClosingDate(billingCycle)) {
+		billingCycle = ShiftBillingCycle(billingCycle, 1)
+	}
+	for date.Before(c.ClosingDate(ShiftBillingCycle(billingCycle, -1))) {
+		billingCycle = ShiftBillingCycle(billingCycle, -1)
+	}
+	return billingCycle
+}
+
+// InstallmentBillingCycle returns the billing cycle the given installment of a purchase made on
+// the date is charged in; the first installment is charged in the purchase's bill.
+func (c *BillingCalendar) InstallmentBillingCycle(date time.Time, installmentNumber int) string {
+	return ShiftBillingCycle(c.BillingCycleOf(date), installmentNumber-1)
+}
+
+// dayOfMonth returns the given day of the month, or the month's last day when it is shorter.
+func dayOfMonth(month time.Time, day int) time.Time {
+	lastDay := time.Date(month.Year(), month.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(month.Year(), month.Month(), day, 0, 0, 0, 0, time.UTC)
+}
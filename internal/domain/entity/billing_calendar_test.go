@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func newCardWithBillingDays(closingDay int, dueDay *int) *Account {
+	card := NewAccount(uuid.New(), "Card", AccountTypeCreditCard, "", decimal.Zero)
+	card.ClosingDay = &closingDay
+	card.DueDay = dueDay
+	return card
+}
+
+func calendarDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNewBillingCalendar_RequiresCardWithClosingDay(t *testing.T) {
+	checking := NewAccount(uuid.New(), "Checking", AccountTypeChecking, "", decimal.Zero)
+	if NewBillingCalendar(checking, nil) != nil {
+		t.Error("NewBillingCalendar() for a checking account is not nil, want nil")
+	}
+
+	card := NewAccount(uuid.New(), "Card", AccountTypeCreditCard, "", decimal.Zero)
+	if NewBillingCalendar(card, nil) != nil {
+		t.Error("NewBillingCalendar() for a card without closing day is not nil, want nil")
+	}
+}
+
+func TestBillingCalendar_BillingCycleOf(t *testing.T) {
+	calendar := NewBillingCalendar(newCardWithBillingDays(25, nil), nil)
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected string
+	}{
+		{"before closing day", calendarDate(2024, time.November, 5), "2024-11"},
+		{"day before closing", calendarDate(2024, time.November, 24), "2024-11"},
+		{"on closing day", calendarDate(2024, time.November, 25), "2024-12"},
+		{"after closing day", calendarDate(2024, time.December, 30), "2025-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calendar.BillingCycleOf(tt.date); got != tt.expected {
+				t.Errorf("BillingCycleOf(%s) = %q, want %q", tt.date.Format("2006-01-02"), got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBillingCalendar_ShortMonths(t *testing.T) {
+	calendar := NewBillingCalendar(newCardWithBillingDays(31, nil), nil)
+
+	if got := calendar.ClosingDate("2025-02"); !got.Equal(calendarDate(2025, time.February, 28)) {
+		t.Errorf("ClosingDate(2025-02) = %s, want 2025-02-28", got.Format("2006-01-02"))
+	}
+	if got := calendar.BillingCycleOf(calendarDate(2025, time.March, 1)); got != "2025-03" {
+		t.Errorf("BillingCycleOf(2025-03-01) = %q, want %q", got, "2025-03")
+	}
+}
+
+func TestBillingCalendar_DueDate(t *testing.T) {
+	dueAfterClosing := 28
+	calendar := NewBillingCalendar(newCardWithBillingDays(20, &dueAfterClosing), nil)
+	if got := calendar.DueDate("2024-11"); got == nil || !got.Equal(calendarDate(2024, time.November, 28)) {
+		t.Errorf("DueDate(2024-11) = %v, want 2024-11-28", got)
+	}
+
+	dueBeforeClosing := 5
+	calendar = NewBillingCalendar(newCardWithBillingDays(25, &dueBeforeClosing), nil)
+	if got := calendar.DueDate("2024-12"); got == nil || !got.Equal(calendarDate(2025, time.January, 5)) {
+		t.Errorf("DueDate(2024-12) = %v, want 2025-01-05", got)
+	}
+
+	calendar = NewBillingCalendar(newCardWithBillingDays(25, nil), nil)
+	if got := calendar.DueDate("2024-12"); got != nil {
+		t.Errorf("DueDate() without due day = %v, want nil", got)
+	}
+}
+
+func TestBillingCalendar_Overrides(t *testing.T) {
+	dueDay := 5
+	card := newCardWithBillingDays(25, &dueDay)
+	closingDate := calendarDate(2024, time.December, 27)
+	dueDate := calendarDate(2025, time.January, 6)
+	override := NewBillingCycleOverride(card.ID, "2024-12", &closingDate, &dueDate)
+	calendar := NewBillingCalendar(card, []*BillingCycleOverride{override})
+
+	period := calendar.Period("2024-12")
+	if !period.ClosingDate.Equal(closingDate) {
+		t.Errorf("ClosingDate = %s, want 2024-12-27", period.ClosingDate.Format("2006-01-02"))
+	}
+	if period.DueDate == nil || !period.DueDate.Equal(dueDate) {
+		t.Errorf("DueDate = %v, want 2025-01-06", period.DueDate)
+	}
+	if !period.StartDate.Equal(calendarDate(2024, time.November, 25)) {
+		t.Errorf("StartDate = %s, want 2024-11-25", period.StartDate.Format("2006-01-02"))
+	}
+
+	// Purchases after the regular closing day stay in the postponed bill
+	if got := calendar.BillingCycleOf(calendarDate(2024, time.December, 26)); got != "2024-12" {
+		t.Errorf("BillingCycleOf(2024-12-26) = %q, want %q", got, "2024-12")
+	}
+	if got := calendar.Period("2025-01").StartDate; !got.Equal(closingDate) {
+		t.Errorf("next StartDate = %s, want 2024-12-27", got.Format("2006-01-02"))
+	}
+	if !period.Contains(calendarDate(2024, time.December, 26)) || period.Contains(closingDate) {
+		t.Error("Contains() does not cover the purchases up to the day before the closing date")
+	}
+}
+
+func TestBillingCalendar_InstallmentBillingCycle(t *testing.T) {
+	calendar := NewBillingCalendar(newCardWithBillingDays(25, nil), nil)
+
+	if got := calendar.InstallmentBillingCycle(calendarDate(2024, time.November, 26), 1); got != "2024-12" {
+		t.Errorf("InstallmentBillingCycle(1) = %q, want %q", got, "2024-12")
+	}
+	if got := calendar.InstallmentBillingCycle(calendarDate(2024, time.November, 26), 3); got != "2025-02" {
+		t.Errorf("InstallmentBillingCycle(3) = %q, want %q", got, "2025-02")
+	}
+}
@@ -0,0 +1,117 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// MinSubscriptionOccurrences is the number of consecutive billing cycles a charge must appear in
+	// to be considered a subscription.
+	MinSubscriptionOccurrences = 3
+
+	// subscriptionAmountTolerance is how much (as a fraction of the latest charge) the charges of a
+	// subscription may vary, to allow for price adjustments and exchange rate changes.
+	subscriptionAmountTolerance = 0.1
+)
+
+// CardSubscription represents a recurring charge detected on credit card bills,
+// such as a streaming service or a gym membership.
+type CardSubscription struct {
+	Description      string          // Description of the latest charge
+	MerchantID       *uuid.UUID      // Merchant of the charges, nil when not recognized
+	Amount           decimal.Decimal // Latest charge in the user's base currency, expected on the next bills
+	LastBillingCycle string          // Billing cycle of the latest charge, "YYYY-MM"
+	Occurrences      int             // Number of consecutive billing cycles the charge appeared in
+}
+
+// Matches reports whether the card transaction is a charge of the subscription.
+func (s *CardSubscription) Matches(t *Transaction) bool {
+	return subscriptionKey(t) == s.key()
+}
+
+// key identifies the charges of the subscription: the merchant when recognized, the description otherwise.
+func (s *CardSubscription) key() string {
+	if s.MerchantID != nil {
+		return s.MerchantID.String()
+	}
+	return strings.ToLower(strings.TrimSpace(s.Description))
+}
+
+// DetectCardSubscriptions finds the subscriptions among the card transactions of complete bills,
+// the latest of which is lastBillingCycle. A subscription is charged once per bill, with a stable
+// amount, in at least MinSubscriptionOccurrences consecutive bills up to the latest or the one
+// before it. Installments, refunds and hidden entries are ignored.
+// Subscriptions are returned sorted by description.
+func DetectCardSubscriptions(transactions []*Transaction, lastBillingCycle string) []*CardSubscription {
+	// Group charges by subscription key and billing cycle
+	charges := make(map[string]map[string][]*Transaction)
+	for _, t := range transactions {
+		if t.IsHidden || t.InstallmentTotal != nil || t.InstallmentPlanID != nil || !t.BillAmount().IsPositive() {
+			continue
+		}
+		key := subscriptionKey(t)
+		if charges[key] == nil {
+			charges[key] = make(map[string][]*Transaction)
+		}
+		charges[key][t.BillingCycle] = append(charges[key][t.BillingCycle], t)
+	}
+
+	var subscriptions []*CardSubscription
+	for _, byCycle := range charges {
+		if subscription := detectSubscription(byCycle, lastBillingCycle); subscription != nil {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].Description < subscriptions[j].Description
+	})
+	return subscriptions
+}
+
+// detectSubscription returns the subscription formed by the charges of a key, grouped by billing
+// cycle, or nil when they do not form one.
+func detectSubscription(byCycle map[string][]*Transaction, lastBillingCycle string) *CardSubscription {
+	// The latest charge must be in one of the last two bills, so cancelled subscriptions are dropped
+	latestCycle := lastBillingCycle
+	if len(byCycle[latestCycle]) == 0 {
+		latestCycle = ShiftBillingCycle(lastBillingCycle, -1)
+	}
+	if len(byCycle[latestCycle]) != 1 {
+		return nil
+	}
+	latest := byCycle[latestCycle][0]
+	tolerance := latest.BillAmount().Mul(decimal.NewFromFloat(subscriptionAmountTolerance))
+
+	occurrences := 0
+	for cycle := latestCycle; len(byCycle[cycle]) == 1; cycle = ShiftBillingCycle(cycle, -1) {
+		if byCycle[cycle][0].BillAmount().Sub(latest.BillAmount()).Abs().GreaterThan(tolerance) {
+			break
+		}
+		occurrences++
+	}
+	if occurrences < MinSubscriptionOccurrences {
+		return nil
+	}
+
+	return &CardSubscription{
+		Description:      latest.Description,
+		MerchantID:       latest.MerchantID,
+		Amount:           latest.BillAmount(),
+		LastBillingCycle: latestCycle,
+		Occurrences:      occurrences,
+	}
+}
+
+// subscriptionKey identifies the subscription a card transaction may be a charge of.
+func subscriptionKey(t *Transaction) string {
+	if t.MerchantID != nil {
+		return t.MerchantID.String()
+	}
+	return strings.ToLower(strings.TrimSpace(t.Description))
+}
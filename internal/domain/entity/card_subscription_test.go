@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func newCardCharge(description string, amount float64, billingCycle string) *Transaction {
+	charge := NewTransaction(uuid.New(), calendarDate(2024, 1, 10), description, decimal.NewFromFloat(amount), TransactionTypeExpense, nil, "", false)
+	charge.BillingCycle = billingCycle
+	return charge
+}
+
+func TestDetectCardSubscriptions(t *testing.T) {
+	installmentTotal := 3
+	installment := newCardCharge("Loja X - Parcela 1/3", 100, "2024-09")
+	installment.InstallmentTotal = &installmentTotal
+
+	transactions := []*Transaction{
+		newCardCharge("Netflix", 39.90, "2024-08"),
+		newCardCharge("NETFLIX", 39.90, "2024-09"),
+		newCardCharge("Netflix", 42.90, "2024-10"),
+		newCardCharge("Mercado", 250, "2024-08"),
+		newCardCharge("Mercado", 180, "2024-09"),
+		newCardCharge("Mercado", 90, "2024-09"),
+		newCardCharge("Mercado", 300, "2024-10"),
+		newCardCharge("Academia", 99, "2024-06"),
+		newCardCharge("Academia", 99, "2024-07"),
+		newCardCharge("Academia", 99, "2024-08"),
+		newCardCharge("Spotify", 21.90, "2024-09"),
+		newCardCharge("Spotify", 21.90, "2024-10"),
+		installment,
+	}
+
+	subscriptions := DetectCardSubscriptions(transactions, "2024-10")
+	if len(subscriptions) != 1 {
+		t.Fatalf("DetectCardSubscriptions() returned %d subscriptions, want 1", len(subscriptions))
+	}
+
+	netflix := subscriptions[0]
+	if netflix.Description != "Netflix" || netflix.LastBillingCycle != "2024-10" || netflix.Occurrences != 3 {
+		t.Errorf("subscription = %+v, want Netflix charged in 3 cycles up to 2024-10", netflix)
+	}
+	if !netflix.Amount.Equal(decimal.NewFromFloat(42.90)) {
+		t.Errorf("Amount = %s, want 42.9", netflix.Amount)
+	}
+	if !netflix.Matches(newCardCharge("netflix ", 42.90, "2024-11")) {
+		t.Error("Matches() = false for a charge with the same description, want true")
+	}
+}
+
+func TestDetectCardSubscriptions_MissingLatestBill(t *testing.T) {
+	transactions := []*Transaction{
+		newCardCharge("Academia", 99, "2024-07"),
+		newCardCharge("Academia", 99, "2024-08"),
+		newCardCharge("Academia", 99, "2024-09"),
+	}
+
+	if got := DetectCardSubscriptions(transactions, "2024-10"); len(got) != 1 {
+		t.Errorf("DetectCardSubscriptions() with the latest bill missing the charge returned %d, want 1", len(got))
+	}
+	if got := DetectCardSubscriptions(transactions, "2024-11"); len(got) != 0 {
+		t.Errorf("DetectCardSubscriptions() with two bills missing the charge returned %d, want 0", len(got))
+	}
+}
+
+func TestDetectCardSubscriptions_UnstableAmount(t *testing.T) {
+	transactions := []*Transaction{
+		newCardCharge("Uber", 20, "2024-08"),
+		newCardCharge("Uber", 45, "2024-09"),
+		newCardCharge("Uber", 32, "2024-10"),
+	}
+
+	if got := DetectCardSubscriptions(transactions, "2024-10"); len(got) != 0 {
+		t.Errorf("DetectCardSubscriptions() with varying amounts returned %d, want 0", len(got))
+	}
+}
@@ -0,0 +1,39 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DuplicateDismissal records that the user reviewed two transactions flagged as likely
+// duplicates and confirmed they are distinct, so the pair is not flagged again.
+type DuplicateDismissal struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	TransactionID uuid.UUID // Lower of the two IDs, so each pair is stored once
+	DuplicateID   uuid.UUID // Higher of the two IDs
+	CreatedAt     time.Time
+}
+
+// NewDuplicateDismissal creates a new DuplicateDismissal entity with the pair in canonical order.
+func NewDuplicateDismissal(userID, transactionID, duplicateID uuid.UUID) *DuplicateDismissal {
+	first, second := OrderedTransactionPair(transactionID, duplicateID)
+
+	return &DuplicateDismissal{
+		ID:            uuid.New(),
+		UserID:        userID,
+		TransactionID: first,
+		DuplicateID:   second,
+		CreatedAt:     time.Now().UTC(),
+	}
+}
+
+// OrderedTransactionPair returns the two IDs in canonical (lexicographic) order.
+func OrderedTransactionPair(a, b uuid.UUID) (uuid.UUID, uuid.UUID) {
+	if a.String() > b.String() {
+		return b, a
+	}
+	return a, b
+}
@@ -21,8 +21,11 @@ const (
 type EmailTemplateType string
 
 const (
-	TemplatePasswordReset   EmailTemplateType = "password_reset"
-	TemplateGroupInvitation EmailTemplateType = "group_invitation"
+	TemplatePasswordReset     EmailTemplateType = "password_reset"
+	TemplateGroupInvitation   EmailTemplateType = "group_invitation"
+	TemplateRecurringReminder EmailTemplateType = "recurring_reminder"
+	TemplateGoalExceeded      EmailTemplateType = "goal_exceeded"
+	TemplateGoal80Percent     EmailTemplateType = "goal_80_percent"
 )
 
 // EmailJob represents an email in the queue waiting to be sent.
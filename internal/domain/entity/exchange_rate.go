@@ -0,0 +1,89 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// DefaultCurrency is the base currency of new users and the currency of transactions recorded without one.
+const DefaultCurrency = "BRL"
+
+// ExchangeRatePrecision is the number of decimal places kept for exchange rates.
+const ExchangeRatePrecision = 8
+
+// currencyCodeRegex matches ISO 4217 currency codes (e.g., "BRL", "USD").
+var currencyCodeRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// IsValidCurrencyCode reports whether the code is an upper-case ISO 4217 currency code.
+func IsValidCurrencyCode(code string) bool {
+	return currencyCodeRegex.MatchString(code)
+}
+
+// ExchangeRateSource identifies how an exchange rate was entered.
+type ExchangeRateSource string
+
+const (
+	ExchangeRateSourceManual ExchangeRateSource = "manual"
+	ExchangeRateSourceFile   ExchangeRateSource = "file"
+)
+
+// ExchangeRate is the value of one unit of FromCurrency in ToCurrency on a given date.
+// Rates are kept per user; a rate also serves the reverse pair by inverting it.
+type ExchangeRate struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	FromCurrency string
+	ToCurrency   string
+	Rate         decimal.Decimal
+	Date         time.Time
+	Source       ExchangeRateSource
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewExchangeRate creates a new ExchangeRate entity.
+func NewExchangeRate(
+	userID uuid.UUID,
+	fromCurrency, toCurrency string,
+	rate decimal.Decimal,
+	date time.Time,
+	source ExchangeRateSource,
+) *ExchangeRate {
+	now := time.Now().UTC()
+
+	return &ExchangeRate{
+		ID:           uuid.New(),
+		UserID:       userID,
+		FromCurrency: fromCurrency,
+		ToCurrency:   toCurrency,
+		Rate:         rate,
+		Date:         date,
+		Source:       source,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+// RateFor returns the rate converting from into to, inverting the stored rate for the reverse pair.
+// ok is false when the rate is for a different pair of currencies.
+func (r *ExchangeRate) RateFor(from, to string) (rate decimal.Decimal, ok bool) {
+	switch {
+	case r.FromCurrency == from && r.ToCurrency == to:
+		return r.Rate, true
+	case r.FromCurrency == to && r.ToCurrency == from && r.Rate.IsPositive():
+		return decimal.NewFromInt(1).DivRound(r.Rate, ExchangeRatePrecision), true
+	default:
+		return decimal.Zero, false
+	}
+}
+
+// ExchangeRateSnapshot is the rate recorded on a user's transactions of one currency and date.
+type ExchangeRateSnapshot struct {
+	Currency string
+	Date     time.Time
+	Rate     decimal.Decimal
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestExchangeRate_RateFor(t *testing.T) {
+	rate := NewExchangeRate(uuid.New(), "USD", "BRL", decimal.RequireFromString("5"), time.Now(), ExchangeRateSourceManual)
+
+	if direct, ok := rate.RateFor("USD", "BRL"); !ok || !direct.Equal(decimal.RequireFromString("5")) {
+		t.Errorf("expected direct rate 5, got %s (%v)", direct, ok)
+	}
+	if inverse, ok := rate.RateFor("BRL", "USD"); !ok || !inverse.Equal(decimal.RequireFromString("0.2")) {
+		t.Errorf("expected inverse rate 0.2, got %s (%v)", inverse, ok)
+	}
+	if _, ok := rate.RateFor("EUR", "BRL"); ok {
+		t.Error("expected no rate for an unrelated pair")
+	}
+}
+
+func TestTransaction_BaseAmount(t *testing.T) {
+	txn := NewTransaction(uuid.New(), time.Now(), "Conference ticket", decimal.RequireFromString("-100.10"), TransactionTypeExpense, nil, "", false)
+	if !txn.BaseAmount().Equal(txn.Amount) {
+		t.Errorf("expected base amount to equal amount at rate 1, got %s", txn.BaseAmount())
+	}
+
+	txn.ExchangeRate = decimal.RequireFromString("5.4321")
+	if !txn.BaseAmount().Equal(decimal.RequireFromString("-543.75")) {
+		t.Errorf("expected base amount -543.75, got %s", txn.BaseAmount())
+	}
+}
+
+func TestIsValidCurrencyCode(t *testing.T) {
+	for code, valid := range map[string]bool{"BRL": true, "USD": true, "usd": false, "US": false, "DOLLAR": false} {
+		if IsValidCurrencyCode(code) != valid {
+			t.Errorf("IsValidCurrencyCode(%q) expected %v", code, valid)
+		}
+	}
+}
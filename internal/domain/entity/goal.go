@@ -7,6 +7,14 @@ import (
 	"github.com/google/uuid"
 )
 
+// GoalKind represents the kind of goal.
+type GoalKind string
+
+const (
+	GoalKindSpendingLimit GoalKind = "spending_limit"
+	GoalKindSavings       GoalKind = "savings"
+)
+
 // GoalPeriod represents the period type for a spending goal.
 type GoalPeriod string
 
@@ -16,19 +24,26 @@ const (
 	GoalPeriodYearly  GoalPeriod = "yearly"
 )
 
-// Goal represents a spending limit goal in the Finance Tracker system.
+// Goal represents a goal in the Finance Tracker system: either a spending limit
+// for a category or a savings target funded by contributions.
 type Goal struct {
 	ID            uuid.UUID
 	UserID        uuid.UUID
-	CategoryID    uuid.UUID
+	Kind          GoalKind
+	CategoryID    uuid.UUID // uuid.Nil for savings goals
 	LimitAmount   float64
 	AlertOnExceed bool
-	Period        GoalPeriod
+	Period        GoalPeriod // Empty for savings goals
 	StartDate     *time.Time
 	EndDate       *time.Time
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 	DeletedAt     *time.Time // Soft-delete support
+
+	// Savings goal fields
+	Name         string
+	TargetAmount float64
+	TargetDate   *time.Time // Optional deadline
 }
 
 // NewGoal creates a new Goal entity.
@@ -38,6 +53,7 @@ func NewGoal(userID, categoryID uuid.UUID, limitAmount float64, alertOnExceed bo
 	return &Goal{
 		ID:            uuid.New(),
 		UserID:        userID,
+		Kind:          GoalKindSpendingLimit,
 		CategoryID:    categoryID,
 		LimitAmount:   limitAmount,
 		AlertOnExceed: alertOnExceed,
@@ -47,6 +63,27 @@ func NewGoal(userID, categoryID uuid.UUID, limitAmount float64, alertOnExceed bo
 	}
 }
 
+// NewSavingsGoal creates a new savings Goal entity.
+func NewSavingsGoal(userID uuid.UUID, name string, targetAmount float64, targetDate *time.Time) *Goal {
+	now := time.Now().UTC()
+
+	return &Goal{
+		ID:           uuid.New(),
+		UserID:       userID,
+		Kind:         GoalKindSavings,
+		Name:         name,
+		TargetAmount: targetAmount,
+		TargetDate:   targetDate,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+// IsSavings reports whether the goal is a savings target rather than a spending limit.
+func (g *Goal) IsSavings() bool {
+	return g.Kind == GoalKindSavings
+}
+
 // GoalWithCategory represents a goal with its associated category.
 type GoalWithCategory struct {
 	Goal          *Goal
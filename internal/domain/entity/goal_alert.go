@@ -0,0 +1,62 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GoalAlertThreshold represents a spending level of a goal that triggers an alert.
+type GoalAlertThreshold string
+
+const (
+	GoalAlertThreshold80Percent GoalAlertThreshold = "80_percent"
+	GoalAlertThresholdExceeded  GoalAlertThreshold = "exceeded"
+)
+
+// GoalAlertWarningRatio is the share of the goal limit that triggers the 80% alert.
+const GoalAlertWarningRatio = 0.8
+
+// GoalAlert records that a threshold alert was sent for a goal period,
+// so each threshold fires at most once per period.
+type GoalAlert struct {
+	ID          uuid.UUID
+	GoalID      uuid.UUID
+	UserID      uuid.UUID
+	Threshold   GoalAlertThreshold
+	PeriodStart time.Time
+	SpentAmount float64
+	LimitAmount float64
+	CreatedAt   time.Time
+}
+
+// NewGoalAlert creates a new GoalAlert entity.
+func NewGoalAlert(goal *Goal, threshold GoalAlertThreshold, periodStart time.Time, spentAmount float64) *GoalAlert {
+	return &GoalAlert{
+		ID:          uuid.New(),
+		GoalID:      goal.ID,
+		UserID:      goal.UserID,
+		Threshold:   threshold,
+		PeriodStart: periodStart,
+		SpentAmount: spentAmount,
+		LimitAmount: goal.LimitAmount,
+		CreatedAt:   time.Now().UTC(),
+	}
+}
+
+// ReachedThresholds returns the alert thresholds reached by the spending, lowest first.
+func (g *Goal) ReachedThresholds(spentAmount float64) []GoalAlertThreshold {
+	if g.LimitAmount <= 0 {
+		return nil
+	}
+
+	var thresholds []GoalAlertThreshold
+	if spentAmount >= g.LimitAmount*GoalAlertWarningRatio {
+		thresholds = append(thresholds, GoalAlertThreshold80Percent)
+	}
+	if spentAmount > g.LimitAmount {
+		thresholds = append(thresholds, GoalAlertThresholdExceeded)
+	}
+	return thresholds
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGoal_ReachedThresholds(t *testing.T) {
+	goal := &Goal{LimitAmount: 500}
+
+	cases := []struct {
+		spent float64
+		want  []GoalAlertThreshold
+	}{
+		{0, nil},
+		{399.99, nil},
+		{400, []GoalAlertThreshold{GoalAlertThreshold80Percent}},
+		{500, []GoalAlertThreshold{GoalAlertThreshold80Percent}},
+		{500.01, []GoalAlertThreshold{GoalAlertThreshold80Percent, GoalAlertThresholdExceeded}},
+	}
+
+	for _, tc := range cases {
+		if got := goal.ReachedThresholds(tc.spent); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("ReachedThresholds(%v): expected %v, got %v", tc.spent, tc.want, got)
+		}
+	}
+
+	if got := (&Goal{}).ReachedThresholds(100); got != nil {
+		t.Errorf("expected no thresholds for a goal without limit, got %v", got)
+	}
+}
@@ -0,0 +1,97 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GoalProjectionMinDays is the shortest contribution history used to estimate the savings rate,
+// so a single recent deposit does not project an unrealistically early completion.
+const GoalProjectionMinDays = 30
+
+// GoalContribution represents money put towards a savings goal, either a manual
+// deposit or an existing transaction linked to the goal.
+type GoalContribution struct {
+	ID            uuid.UUID
+	GoalID        uuid.UUID
+	UserID        uuid.UUID
+	TransactionID *uuid.UUID // Linked transaction, nil for manual deposits
+	Amount        float64
+	Date          time.Time
+	Notes         string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     *time.Time // Soft-delete support
+}
+
+// NewGoalContribution creates a new GoalContribution entity.
+func NewGoalContribution(goal *Goal, amount float64, date time.Time, transactionID *uuid.UUID, notes string) *GoalContribution {
+	now := time.Now().UTC()
+
+	return &GoalContribution{
+		ID:            uuid.New(),
+		GoalID:        goal.ID,
+		UserID:        goal.UserID,
+		TransactionID: transactionID,
+		Amount:        amount,
+		Date:          date,
+		Notes:         notes,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// SavingsProgress summarizes how far a savings goal is from its target.
+type SavingsProgress struct {
+	SavedAmount             float64
+	ProgressPercent         float64
+	ProjectedCompletionDate *time.Time // Nil when there is no contribution history to project from
+}
+
+// SavingsProgressAt calculates the saved amount, progress and projected completion date of a
+// savings goal. The projection extends the average daily contribution rate since the first
+// contribution; once the target is reached it is the date of the contribution that reached it.
+func (g *Goal) SavingsProgressAt(contributions []*GoalContribution, now time.Time) SavingsProgress {
+	var progress SavingsProgress
+	if len(contributions) == 0 {
+		return progress
+	}
+
+	sorted := make([]*GoalContribution, len(contributions))
+	copy(sorted, contributions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+
+	for _, c := range sorted {
+		progress.SavedAmount += c.Amount
+		if progress.ProjectedCompletionDate == nil && g.TargetAmount > 0 && progress.SavedAmount >= g.TargetAmount {
+			reachedAt := c.Date
+			progress.ProjectedCompletionDate = &reachedAt
+		}
+	}
+
+	if g.TargetAmount > 0 {
+		progress.ProgressPercent = math.Round(progress.SavedAmount/g.TargetAmount*10000) / 100
+	}
+	if progress.ProjectedCompletionDate != nil || progress.SavedAmount <= 0 {
+		return progress
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	first := sorted[0].Date
+	first = time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, time.UTC)
+
+	elapsedDays := math.Max(today.Sub(first).Hours()/24, GoalProjectionMinDays)
+	dailyRate := progress.SavedAmount / elapsedDays
+	remainingDays := int(math.Ceil((g.TargetAmount - progress.SavedAmount) / dailyRate))
+
+	projected := today.AddDate(0, 0, remainingDays)
+	progress.ProjectedCompletionDate = &projected
+
+	return progress
+}
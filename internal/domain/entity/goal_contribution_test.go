@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGoal_SavingsProgressAt(t *testing.T) {
+	goal := &Goal{Kind: GoalKindSavings, TargetAmount: 20000}
+	now := time.Date(2026, 4, 1, 15, 0, 0, 0, time.UTC)
+
+	if got := goal.SavingsProgressAt(nil, now); got.SavedAmount != 0 || got.ProjectedCompletionDate != nil {
+		t.Errorf("expected empty progress without contributions, got %+v", got)
+	}
+
+	// R$ 6.000 over 90 days is R$ 66,67/day, so R$ 14.000 remaining takes 210 days
+	contributions := []*GoalContribution{
+		{Amount: 2000, Date: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{Amount: 2000, Date: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Amount: 2000, Date: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	got := goal.SavingsProgressAt(contributions, now)
+	if got.SavedAmount != 6000 || got.ProgressPercent != 30 {
+		t.Errorf("expected 6000 saved at 30%%, got %v at %v%%", got.SavedAmount, got.ProgressPercent)
+	}
+	if got.ProjectedCompletionDate == nil || got.ProjectedCompletionDate.Format("2006-01-02") != "2026-10-28" {
+		t.Errorf("expected projection 2026-10-28, got %v", got.ProjectedCompletionDate)
+	}
+
+	// A single deposit today is spread over the minimum window
+	recent := []*GoalContribution{{Amount: 1000, Date: now}}
+	got = goal.SavingsProgressAt(recent, now)
+	if got.ProjectedCompletionDate == nil || got.ProjectedCompletionDate.Format("2006-01-02") != "2027-10-23" {
+		t.Errorf("expected projection 2027-10-23, got %v", got.ProjectedCompletionDate)
+	}
+
+	// Once reached, the completion date is when the target was crossed
+	reached := append(contributions, &GoalContribution{Amount: 15000, Date: time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)})
+	got = goal.SavingsProgressAt(reached, now)
+	if got.ProgressPercent != 105 || got.ProjectedCompletionDate.Format("2006-01-02") != "2026-03-20" {
+		t.Errorf("expected completion on 2026-03-20 at 105%%, got %v at %v%%", got.ProjectedCompletionDate, got.ProgressPercent)
+	}
+}
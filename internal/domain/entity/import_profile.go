@@ -0,0 +1,55 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImportProfile represents a saved CSV column mapping for a bank export (e.g., "Nubank", "Itaú").
+// Column references are header names when HasHeader is true, or zero-based column indexes.
+type ImportProfile struct {
+	ID                uuid.UUID
+	UserID            uuid.UUID
+	Name              string
+	Delimiter         string // "," ";" or "\t"; empty means auto-detect
+	HasHeader         bool
+	SkipRows          int // Lines to skip before the header (or first data row)
+	DateColumn        string
+	DescriptionColumn string
+	AmountColumn      string       // Signed amount column; mutually exclusive with Debit/Credit columns
+	DebitColumn       string       // Optional column with debit (outflow) values
+	CreditColumn      string       // Optional column with credit (inflow) values
+	InstallmentColumn string       // Optional column with installment info (e.g., "1/3")
+	DateFormat        DateFormat   // Empty means use the user's preference
+	NumberFormat      NumberFormat // Empty means use the user's preference
+	InvertAmounts     bool         // True when the file lists expenses as positive values (e.g., card statements)
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         *time.Time // Soft-delete support
+}
+
+// NewImportProfile creates a new ImportProfile entity.
+func NewImportProfile(userID uuid.UUID, name string) *ImportProfile {
+	now := time.Now().UTC()
+
+	return &ImportProfile{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		HasHeader: true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsValidDateFormat checks whether the date format is one of the supported formats.
+func IsValidDateFormat(format DateFormat) bool {
+	return format == DateFormatDMY || format == DateFormatMDY || format == DateFormatYMD
+}
+
+// IsValidNumberFormat checks whether the number format is one of the supported formats.
+func IsValidNumberFormat(format NumberFormat) bool {
+	return format == NumberFormatBR || format == NumberFormatUS
+}
@@ -0,0 +1,114 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// billingCycleLayout is the time layout of billing cycles ("YYYY-MM").
+const billingCycleLayout = "2006-01"
+
+// installmentMarkerRegex matches the installment marker banks append to descriptions,
+// such as " - Parcela 1/3", " PARC 02/12" or " 1/3".
+var installmentMarkerRegex = regexp.MustCompile(`(?i)\s*-?\s*(?:parcela|parc\.?)?\s*\d{1,2}\s*/\s*\d{1,2}\s*$`)
+
+// InstallmentPlan groups the installments of a purchase paid over several credit card bills.
+// Each installment is recorded as its own transaction in the bill of its billing cycle.
+type InstallmentPlan struct {
+	ID                uuid.UUID
+	UserID            uuid.UUID
+	AccountID         *uuid.UUID      // Credit card the purchase was made with, nil when imported without a card
+	Description       string          // Purchase description without the installment marker
+	TotalInstallments int             // Number of installments the purchase was split into
+	InstallmentAmount decimal.Decimal // Amount of each installment in the user's base currency
+	FirstBillingCycle string          // Billing cycle of the first installment, "YYYY-MM"
+	MerchantID        *uuid.UUID      // Merchant of the purchase, nil when not recognized
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         *time.Time // Soft-delete support
+}
+
+// NewInstallmentPlan creates a new InstallmentPlan entity.
+func NewInstallmentPlan(
+	userID uuid.UUID,
+	description string,
+	totalInstallments int,
+	installmentAmount decimal.Decimal,
+	firstBillingCycle string,
+) *InstallmentPlan {
+	now := time.Now().UTC()
+
+	return &InstallmentPlan{
+		ID:                uuid.New(),
+		UserID:            userID,
+		Description:       description,
+		TotalInstallments: totalInstallments,
+		InstallmentAmount: installmentAmount,
+		FirstBillingCycle: firstBillingCycle,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+}
+
+// TotalAmount returns the amount of the whole purchase.
+func (p *InstallmentPlan) TotalAmount() decimal.Decimal {
+	return p.InstallmentAmount.Mul(decimal.NewFromInt(int64(p.TotalInstallments)))
+}
+
+// BillingCycleOf returns the billing cycle the given installment is charged in.
+func (p *InstallmentPlan) BillingCycleOf(installmentNumber int) string {
+	return ShiftBillingCycle(p.FirstBillingCycle, installmentNumber-1)
+}
+
+// LastBillingCycle returns the billing cycle of the last installment.
+func (p *InstallmentPlan) LastBillingCycle() string {
+	return p.BillingCycleOf(p.TotalInstallments)
+}
+
+// InstallmentNumberIn returns the number of the installment charged in the billing cycle,
+// or 0 when the plan has no installment in it.
+func (p *InstallmentPlan) InstallmentNumberIn(billingCycle string) int {
+	first, err := time.Parse(billingCycleLayout, p.FirstBillingCycle)
+	if err != nil {
+		return 0
+	}
+	cycle, err := time.Parse(billingCycleLayout, billingCycle)
+	if err != nil {
+		return 0
+	}
+
+	number := (cycle.Year()-first.Year())*12 + int(cycle.Month()-first.Month()) + 1
+	if number < 1 || number > p.TotalInstallments {
+		return 0
+	}
+	return number
+}
+
+// Matches reports whether an installment with the given description, total and first billing
+// cycle belongs to the plan. Descriptions are compared without their installment markers.
+func (p *InstallmentPlan) Matches(description string, totalInstallments int, firstBillingCycle string) bool {
+	return p.TotalInstallments == totalInstallments &&
+		p.FirstBillingCycle == firstBillingCycle &&
+		strings.EqualFold(p.Description, InstallmentBaseDescription(description))
+}
+
+// InstallmentBaseDescription returns the description without the installment marker
+// (e.g., "Loja X - Parcela 1/3" becomes "Loja X").
+func InstallmentBaseDescription(description string) string {
+	return strings.TrimSpace(installmentMarkerRegex.ReplaceAllString(description, ""))
+}
+
+// ShiftBillingCycle returns the billing cycle the given number of months after (or before, when
+// negative) the cycle. Returns an empty string when the cycle is not in "YYYY-MM" format.
+func ShiftBillingCycle(billingCycle string, months int) string {
+	cycle, err := time.Parse(billingCycleLayout, billingCycle)
+	if err != nil {
+		return ""
+	}
+	return cycle.AddDate(0, months, 0).Format(billingCycleLayout)
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestNewInstallmentPlan(t *testing.T) {
+	userID := uuid.New()
+	plan := NewInstallmentPlan(userID, "Loja X", 3, decimal.NewFromFloat(33.34), "2024-11")
+
+	if plan.UserID != userID {
+		t.Errorf("UserID = %v, want %v", plan.UserID, userID)
+	}
+	if !plan.TotalAmount().Equal(decimal.NewFromFloat(100.02)) {
+		t.Errorf("TotalAmount() = %s, want 100.02", plan.TotalAmount())
+	}
+	if got := plan.LastBillingCycle(); got != "2025-01" {
+		t.Errorf("LastBillingCycle() = %q, want %q", got, "2025-01")
+	}
+}
+
+func TestInstallmentPlan_InstallmentNumberIn(t *testing.T) {
+	plan := &InstallmentPlan{TotalInstallments: 3, FirstBillingCycle: "2024-11"}
+
+	tests := []struct {
+		billingCycle string
+		expected     int
+	}{
+		{"2024-10", 0},
+		{"2024-11", 1},
+		{"2024-12", 2},
+		{"2025-01", 3},
+		{"2025-02", 0},
+		{"invalid", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.billingCycle, func(t *testing.T) {
+			if got := plan.InstallmentNumberIn(tt.billingCycle); got != tt.expected {
+				t.Errorf("InstallmentNumberIn(%q) = %d, want %d", tt.billingCycle, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestInstallmentPlan_Matches(t *testing.T) {
+	plan := &InstallmentPlan{Description: "Loja X", TotalInstallments: 3, FirstBillingCycle: "2024-11"}
+
+	if !plan.Matches("LOJA X - Parcela 2/3", 3, "2024-11") {
+		t.Error("Matches() = false for an installment of the same purchase, want true")
+	}
+	if plan.Matches("Loja X - Parcela 1/6", 6, "2024-11") {
+		t.Error("Matches() = true for a purchase with another number of installments, want false")
+	}
+	if plan.Matches("Loja X - Parcela 1/3", 3, "2024-12") {
+		t.Error("Matches() = true for a purchase made in another cycle, want false")
+	}
+}
+
+func TestInstallmentBaseDescription(t *testing.T) {
+	tests := []struct {
+		description string
+		expected    string
+	}{
+		{"Loja X - Parcela 1/3", "Loja X"},
+		{"LOJA X PARC 02/12", "LOJA X"},
+		{"Loja X 1/3", "Loja X"},
+		{"Loja X", "Loja X"},
+		{"Assinatura 01/2024", "Assinatura 01/2024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if got := InstallmentBaseDescription(tt.description); got != tt.expected {
+				t.Errorf("InstallmentBaseDescription(%q) = %q, want %q", tt.description, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestShiftBillingCycle(t *testing.T) {
+	tests := []struct {
+		billingCycle string
+		months       int
+		expected     string
+	}{
+		{"2024-11", 0, "2024-11"},
+		{"2024-11", 2, "2025-01"},
+		{"2024-01", -1, "2023-12"},
+		{"2024/11", 1, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.billingCycle, func(t *testing.T) {
+			if got := ShiftBillingCycle(tt.billingCycle, tt.months); got != tt.expected {
+				t.Errorf("ShiftBillingCycle(%q, %d) = %q, want %q", tt.billingCycle, tt.months, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Merchant represents a business the user's transactions are made with, under one canonical name
+// however each bank spells it (e.g., "IFD*IFOOD" and "IFOOD *RESTAURANTE X" are both "iFood").
+type Merchant struct {
+	ID                uuid.UUID
+	UserID            uuid.UUID
+	Name              string     // Canonical display name
+	Key               string     // Normalized merchant key of the descriptions it was created from
+	Aliases           []string   // Other keys or description fragments that belong to the merchant
+	DefaultCategoryID *uuid.UUID // Category given to its uncategorized transactions, nil for none
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         *time.Time // Soft-delete support
+}
+
+// NewMerchant creates a new Merchant entity named after its key (e.g., "UBER" becomes "Uber").
+func NewMerchant(userID uuid.UUID, key string) *Merchant {
+	now := time.Now().UTC()
+
+	return &Merchant{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      MerchantNameFromKey(key),
+		Key:       key,
+		Aliases:   []string{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// HasKey reports whether a normalized merchant key belongs to the merchant, either as its own key
+// or as an alias (e.g., the key of a merchant merged into it).
+func (m *Merchant) HasKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	if strings.EqualFold(m.Key, key) {
+		return true
+	}
+	for _, alias := range m.Aliases {
+		if strings.EqualFold(alias, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchesAlias reports whether a transaction description contains one of the merchant's aliases,
+// ignoring case.
+func (m *Merchant) MatchesAlias(description string) bool {
+	description = strings.ToUpper(description)
+	for _, alias := range m.Aliases {
+		if alias != "" && strings.Contains(description, strings.ToUpper(alias)) {
+			return true
+		}
+	}
+	return false
+}
+
+// MerchantNameFromKey turns a normalized merchant key into a display name by capitalizing each word.
+func MerchantNameFromKey(key string) string {
+	words := strings.Fields(strings.ToLower(key))
+	for i, word := range words {
+		runes := []rune(word)
+		runes[0] = []rune(strings.ToUpper(string(runes[0])))[0]
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
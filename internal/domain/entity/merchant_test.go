@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewMerchant(t *testing.T) {
+	userID := uuid.New()
+	merchant := NewMerchant(userID, "PADARIA SAO")
+
+	if merchant.UserID != userID {
+		t.Errorf("UserID = %v, want %v", merchant.UserID, userID)
+	}
+	if merchant.Name != "Padaria Sao" {
+		t.Errorf("Name = %q, want %q", merchant.Name, "Padaria Sao")
+	}
+	if merchant.Key != "PADARIA SAO" {
+		t.Errorf("Key = %q, want %q", merchant.Key, "PADARIA SAO")
+	}
+	if merchant.Aliases == nil || len(merchant.Aliases) != 0 {
+		t.Errorf("Aliases = %v, want an empty list", merchant.Aliases)
+	}
+}
+
+func TestMerchant_HasKey(t *testing.T) {
+	merchant := &Merchant{Key: "IFOOD", Aliases: []string{"RESTAURANTE X"}}
+
+	tests := []struct {
+		key      string
+		expected bool
+	}{
+		{"IFOOD", true},
+		{"ifood", true},
+		{"RESTAURANTE X", true},
+		{"RAPPI", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := merchant.HasKey(tt.key); got != tt.expected {
+				t.Errorf("HasKey(%q) = %v, want %v", tt.key, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMerchant_MatchesAlias(t *testing.T) {
+	merchant := &Merchant{Key: "IFOOD", Aliases: []string{"ifd*", ""}}
+
+	if !merchant.MatchesAlias("IFD*RESTAURANTE X") {
+		t.Error("expected a description containing an alias to match")
+	}
+	if merchant.MatchesAlias("RESTAURANTE X") {
+		t.Error("expected a description without an alias not to match")
+	}
+}
+
+func TestMerchantNameFromKey(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{"UBER", "Uber"},
+		{"PADARIA SAO", "Padaria Sao"},
+		{"AÇOUGUE BOM", "Açougue Bom"},
+		{"PAG*PIX", "Pag*pix"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := MerchantNameFromKey(tt.key); got != tt.expected {
+				t.Errorf("MerchantNameFromKey(%q) = %q, want %q", tt.key, got, tt.expected)
+			}
+		})
+	}
+}
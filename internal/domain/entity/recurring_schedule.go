@@ -0,0 +1,217 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RecurrenceFrequency represents how often a recurring schedule repeats.
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyWeekly  RecurrenceFrequency = "weekly"
+	RecurrenceFrequencyMonthly RecurrenceFrequency = "monthly"
+	RecurrenceFrequencyYearly  RecurrenceFrequency = "yearly"
+)
+
+// RecurringSchedule represents a transaction that repeats on a schedule (rent, salary, subscriptions).
+// The scheduler materializes a Transaction for each occurrence once its date is reached.
+type RecurringSchedule struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Description string
+	Amount      decimal.Decimal // Same sign convention as Transaction.Amount
+	Type        TransactionType
+	CategoryID  *uuid.UUID
+	Notes       string
+
+	// Recurrence rule
+	Frequency      RecurrenceFrequency
+	Interval       int        // Repeat every N weeks/months/years
+	DayOfMonth     *int       // Monthly/yearly: 1-31, clamped to the last day of shorter months
+	DayOfWeek      *int       // Weekly: 0 (Sunday) - 6 (Saturday)
+	StartDate      time.Time  // First possible occurrence; yearly schedules repeat on its month
+	EndDate        *time.Time // Optional, no occurrences after this date
+	MaxOccurrences *int       // Optional, stop after this many occurrences
+
+	// Progress
+	OccurrenceCount    int
+	LastOccurrenceDate *time.Time // Date of the last materialized occurrence
+	NextOccurrence     *time.Time // Nil once the schedule has ended
+	LastRemindedFor    *time.Time // Occurrence date the last reminder email was sent for
+	IsActive           bool       // Paused schedules keep their position but generate nothing
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time // Soft-delete support
+}
+
+// NewRecurringSchedule creates a new active RecurringSchedule entity repeating every period.
+func NewRecurringSchedule(
+	userID uuid.UUID,
+	description string,
+	amount decimal.Decimal,
+	transactionType TransactionType,
+	frequency RecurrenceFrequency,
+	startDate time.Time,
+) *RecurringSchedule {
+	now := time.Now().UTC()
+
+	return &RecurringSchedule{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Description: description,
+		Amount:      amount,
+		Type:        transactionType,
+		Frequency:   frequency,
+		Interval:    1,
+		StartDate:   truncateToDate(startDate),
+		IsActive:    true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// IsValidRecurrenceFrequency checks whether the frequency is one of the supported values.
+func IsValidRecurrenceFrequency(frequency RecurrenceFrequency) bool {
+	return frequency == RecurrenceFrequencyWeekly ||
+		frequency == RecurrenceFrequencyMonthly ||
+		frequency == RecurrenceFrequencyYearly
+}
+
+// IsDue reports whether the next occurrence should be materialized as of the given date.
+func (s *RecurringSchedule) IsDue(asOf time.Time) bool {
+	return s.IsActive && s.NextOccurrence != nil && !s.NextOccurrence.After(truncateToDate(asOf))
+}
+
+// Reschedule recomputes the next occurrence from the recurrence rule, skipping dates
+// that were already materialized. It is used on creation and whenever the rule changes.
+func (s *RecurringSchedule) Reschedule() {
+	from := s.StartDate
+	if s.LastOccurrenceDate != nil && !s.LastOccurrenceDate.Before(from) {
+		from = s.LastOccurrenceDate.AddDate(0, 0, 1)
+	}
+
+	next := s.firstOccurrence()
+	for next.Before(from) {
+		next = s.occurrenceAfter(next)
+	}
+	s.setNext(next)
+}
+
+// Advance records that the next occurrence was materialized and moves to the following one.
+func (s *RecurringSchedule) Advance() {
+	if s.NextOccurrence == nil {
+		return
+	}
+
+	current := *s.NextOccurrence
+	s.OccurrenceCount++
+	s.LastOccurrenceDate = &current
+	s.setNext(s.occurrenceAfter(current))
+}
+
+// SkipTo moves the next occurrence forward to the first one on or after the given date
+// without materializing the skipped occurrences (e.g., past dates already entered by hand).
+func (s *RecurringSchedule) SkipTo(date time.Time) {
+	date = truncateToDate(date)
+	for s.NextOccurrence != nil && s.NextOccurrence.Before(date) {
+		s.setNext(s.occurrenceAfter(*s.NextOccurrence))
+	}
+}
+
+// UpcomingOccurrences returns up to n occurrences starting with the next one.
+func (s *RecurringSchedule) UpcomingOccurrences(n int) []time.Time {
+	preview := *s
+	occurrences := make([]time.Time, 0, n)
+	for len(occurrences) < n && preview.NextOccurrence != nil {
+		occurrences = append(occurrences, *preview.NextOccurrence)
+		preview.Advance()
+	}
+	return occurrences
+}
+
+// setNext sets the next occurrence, or clears it if the schedule has ended.
+func (s *RecurringSchedule) setNext(next time.Time) {
+	if (s.EndDate != nil && next.After(truncateToDate(*s.EndDate))) ||
+		(s.MaxOccurrences != nil && s.OccurrenceCount >= *s.MaxOccurrences) {
+		s.NextOccurrence = nil
+		return
+	}
+	s.NextOccurrence = &next
+}
+
+// firstOccurrence returns the first date on or after StartDate that matches the rule.
+func (s *RecurringSchedule) firstOccurrence() time.Time {
+	start := s.StartDate
+
+	switch s.Frequency {
+	case RecurrenceFrequencyWeekly:
+		offset := (s.dayOfWeek() - int(start.Weekday()) + 7) % 7
+		return start.AddDate(0, 0, offset)
+	case RecurrenceFrequencyYearly:
+		first := dateInMonth(start.Year(), start.Month(), s.dayOfMonth())
+		if first.Before(start) {
+			first = dateInMonth(start.Year()+1, start.Month(), s.dayOfMonth())
+		}
+		return first
+	default:
+		first := dateInMonth(start.Year(), start.Month(), s.dayOfMonth())
+		if first.Before(start) {
+			first = dateInMonth(start.Year(), start.Month()+1, s.dayOfMonth())
+		}
+		return first
+	}
+}
+
+// occurrenceAfter returns the occurrence following the given one.
+func (s *RecurringSchedule) occurrenceAfter(occurrence time.Time) time.Time {
+	interval := s.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	switch s.Frequency {
+	case RecurrenceFrequencyWeekly:
+		return occurrence.AddDate(0, 0, 7*interval)
+	case RecurrenceFrequencyYearly:
+		return dateInMonth(occurrence.Year()+interval, occurrence.Month(), s.dayOfMonth())
+	default:
+		return dateInMonth(occurrence.Year(), occurrence.Month()+time.Month(interval), s.dayOfMonth())
+	}
+}
+
+// dayOfMonth returns the configured day of month, defaulting to the start date's day.
+func (s *RecurringSchedule) dayOfMonth() int {
+	if s.DayOfMonth != nil {
+		return *s.DayOfMonth
+	}
+	return s.StartDate.Day()
+}
+
+// dayOfWeek returns the configured weekday, defaulting to the start date's weekday.
+func (s *RecurringSchedule) dayOfWeek() int {
+	if s.DayOfWeek != nil {
+		return *s.DayOfWeek
+	}
+	return int(s.StartDate.Weekday())
+}
+
+// dateInMonth returns the given day of the month, clamped to the month's last day.
+// Months outside 1-12 are normalized (e.g., month 13 is January of the next year).
+func dateInMonth(year int, month time.Month, day int) time.Time {
+	firstOfMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
+
+// truncateToDate drops the time component, keeping the calendar date in UTC.
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
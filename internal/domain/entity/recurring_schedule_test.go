@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func testDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestRecurringSchedule_MonthlyClampsToMonthEnd(t *testing.T) {
+	day := 31
+	s := NewRecurringSchedule(uuid.New(), "Rent", decimal.NewFromInt(-1500), TransactionTypeExpense,
+		RecurrenceFrequencyMonthly, testDate(2024, 1, 10))
+	s.DayOfMonth = &day
+	s.Reschedule()
+
+	want := []time.Time{testDate(2024, 1, 31), testDate(2024, 2, 29), testDate(2024, 3, 31), testDate(2024, 4, 30)}
+	for i, w := range want {
+		if s.NextOccurrence == nil || !s.NextOccurrence.Equal(w) {
+			t.Fatalf("occurrence %d: expected %s, got %v", i, w.Format("2006-01-02"), s.NextOccurrence)
+		}
+		s.Advance()
+	}
+	if s.OccurrenceCount != len(want) {
+		t.Errorf("expected %d occurrences, got %d", len(want), s.OccurrenceCount)
+	}
+}
+
+func TestRecurringSchedule_WeeklyWithIntervalAndCount(t *testing.T) {
+	friday := int(time.Friday)
+	count := 3
+	s := NewRecurringSchedule(uuid.New(), "Cleaning", decimal.NewFromInt(-120), TransactionTypeExpense,
+		RecurrenceFrequencyWeekly, testDate(2024, 11, 4)) // Monday
+	s.DayOfWeek = &friday
+	s.Interval = 2
+	s.MaxOccurrences = &count
+	s.Reschedule()
+
+	want := []time.Time{testDate(2024, 11, 8), testDate(2024, 11, 22), testDate(2024, 12, 6)}
+	for i, w := range want {
+		if s.NextOccurrence == nil || !s.NextOccurrence.Equal(w) {
+			t.Fatalf("occurrence %d: expected %s, got %v", i, w.Format("2006-01-02"), s.NextOccurrence)
+		}
+		s.Advance()
+	}
+	if s.NextOccurrence != nil {
+		t.Errorf("expected schedule to end after %d occurrences, next is %s", count, s.NextOccurrence)
+	}
+}
+
+func TestRecurringSchedule_EndDateAndDue(t *testing.T) {
+	end := testDate(2026, 3, 1)
+	s := NewRecurringSchedule(uuid.New(), "Insurance", decimal.NewFromInt(-900), TransactionTypeExpense,
+		RecurrenceFrequencyYearly, testDate(2024, 2, 29))
+	s.EndDate = &end
+	s.Reschedule()
+
+	if s.IsDue(testDate(2024, 2, 28)) || !s.IsDue(testDate(2024, 2, 29)) {
+		t.Fatalf("unexpected due state for %s", s.NextOccurrence)
+	}
+
+	s.Advance()
+	if s.NextOccurrence == nil || !s.NextOccurrence.Equal(testDate(2025, 2, 28)) {
+		t.Fatalf("expected 2025-02-28, got %v", s.NextOccurrence)
+	}
+	s.Advance()
+	if s.NextOccurrence == nil || !s.NextOccurrence.Equal(testDate(2026, 2, 28)) {
+		t.Fatalf("expected 2026-02-28, got %v", s.NextOccurrence)
+	}
+	s.Advance()
+	if s.NextOccurrence != nil {
+		t.Errorf("expected schedule to end after %s, next is %s", end.Format("2006-01-02"), s.NextOccurrence)
+	}
+}
+
+func TestRecurringSchedule_RescheduleSkipsMaterializedDates(t *testing.T) {
+	s := NewRecurringSchedule(uuid.New(), "Salary", decimal.NewFromInt(8000), TransactionTypeIncome,
+		RecurrenceFrequencyMonthly, testDate(2024, 1, 5))
+	s.Reschedule()
+	s.Advance() // 2024-01-05
+	s.Advance() // 2024-02-05
+
+	// Moving payday to the 1st must not produce another February occurrence
+	day := 1
+	s.DayOfMonth = &day
+	s.Reschedule()
+	if s.NextOccurrence == nil || !s.NextOccurrence.Equal(testDate(2024, 3, 1)) {
+		t.Fatalf("expected 2024-03-01, got %v", s.NextOccurrence)
+	}
+}
@@ -0,0 +1,41 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultTagColor is the color used when a tag does not specify one.
+const DefaultTagColor = "#64748B"
+
+// Tag represents a user-defined label attached to any number of transactions
+// (e.g., "vacation-2026" or "reimbursable"). Unlike categories, a transaction can have many tags.
+type Tag struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Name      string
+	Color     string // Hex color (e.g., "#64748B")
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time // Soft-delete support
+}
+
+// NewTag creates a new Tag entity.
+func NewTag(userID uuid.UUID, name string, color string) *Tag {
+	now := time.Now().UTC()
+
+	if color == "" {
+		color = DefaultTagColor
+	}
+
+	return &Tag{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		Color:     color,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
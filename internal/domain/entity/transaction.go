@@ -8,12 +8,13 @@ import (
 	"github.com/shopspring/decimal"
 )
 
-// TransactionType represents the type of transaction (expense or income).
+// TransactionType represents the type of transaction (expense, income or transfer).
 type TransactionType string
 
 const (
-	TransactionTypeExpense TransactionType = "expense"
-	TransactionTypeIncome  TransactionType = "income"
+	TransactionTypeExpense  TransactionType = "expense"
+	TransactionTypeIncome   TransactionType = "income"
+	TransactionTypeTransfer TransactionType = "transfer" // Money moved between the user's own accounts
 )
 
 // Transaction represents a financial transaction in the Finance Tracker system.
@@ -41,9 +42,40 @@ type Transaction struct {
 	InstallmentCurrent  *int            // Current installment number (e.g., 1 in "Parcela 1/3")
 	InstallmentTotal    *int            // Total installments (e.g., 3 in "Parcela 1/3")
 	IsHidden            bool            // True for "Pagamento recebido" entries that should be hidden
+	IsManualCardEntry   bool            // True for card transactions entered in the app, which keep the app's sign
+
+	// Statement import fields
+	ExternalID *string // Bank-provided identifier (e.g., OFX FITID) used to de-duplicate imports
+
+	// Recurring schedule fields
+	RecurringScheduleID *uuid.UUID // Schedule that generated this transaction, if any
+
+	// Account fields
+	AccountID *uuid.UUID // Account the transaction belongs to, nil when not assigned
+
+	// Transfer fields
+	TransferID *uuid.UUID // Shared by both legs of a transfer, nil for regular transactions
+
+	// Currency fields
+	Currency     string          // ISO 4217 code of Amount; empty means the user's base currency
+	ExchangeRate decimal.Decimal // Rate converting Amount into the user's base currency, snapshotted when recorded
+
+	// Split fields
+	IsSplit bool                // True when the amount is attributed to categories through Splits
+	Splits  []*TransactionSplit // Split lines, only loaded for split transactions
+
+	// Tag fields
+	Tags []*Tag // Tags attached to the transaction, only present when loaded
+
+	// Merchant fields
+	MerchantID *uuid.UUID // Merchant the transaction was made with, nil when not recognized
+
+	// Installment plan fields
+	InstallmentPlanID *uuid.UUID // Plan grouping the installments of the purchase, nil for single payments
 }
 
 // NewTransaction creates a new Transaction entity.
+// The transaction is recorded in the user's base currency until another currency and rate are set.
 func NewTransaction(
 	userID uuid.UUID,
 	date time.Time,
@@ -68,7 +100,27 @@ func NewTransaction(
 		IsRecurring: isRecurring,
 		CreatedAt:   now,
 		UpdatedAt:   now,
+
+		ExchangeRate: decimal.NewFromInt(1),
+	}
+}
+
+// BaseAmount returns the amount converted into the user's base currency using the snapshotted rate.
+func (t *Transaction) BaseAmount() decimal.Decimal {
+	if t.ExchangeRate.IsZero() {
+		return t.Amount
+	}
+	return t.Amount.Mul(t.ExchangeRate).Round(2)
+}
+
+// BillAmount returns what the transaction adds to its credit card bill, in the user's base currency.
+// Statement imports record charges as positive amounts and refunds as negative ones, while card
+// transactions entered in the app keep the app's sign (negative for purchases).
+func (t *Transaction) BillAmount() decimal.Decimal {
+	if t.IsManualCardEntry {
+		return t.BaseAmount().Neg()
 	}
+	return t.BaseAmount()
 }
 
 // TransactionWithCategory represents a transaction with its associated category.
@@ -0,0 +1,318 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TransactionChangeSource identifies the feature a transaction change came from.
+type TransactionChangeSource string
+
+const (
+	TransactionChangeSourceManual TransactionChangeSource = "manual" // Edited by the user
+	TransactionChangeSourceBulk   TransactionChangeSource = "bulk"   // Bulk categorize or delete
+	TransactionChangeSourceRule   TransactionChangeSource = "rule"   // Category rule applied to existing transactions
+	TransactionChangeSourceAI     TransactionChangeSource = "ai"     // Approved AI categorization suggestion
+	TransactionChangeSourceImport TransactionChangeSource = "import" // CSV or statement import
+	TransactionChangeSourceRevert TransactionChangeSource = "revert" // Revert of an earlier change
+)
+
+// TransactionChangeAction is the kind of mutation a change records.
+type TransactionChangeAction string
+
+const (
+	TransactionChangeActionCreate TransactionChangeAction = "create"
+	TransactionChangeActionUpdate TransactionChangeAction = "update"
+	TransactionChangeActionDelete TransactionChangeAction = "delete"
+)
+
+// Transaction fields tracked by the change history.
+const (
+	TransactionFieldDate         = "date"
+	TransactionFieldDescription  = "description"
+	TransactionFieldAmount       = "amount"
+	TransactionFieldType         = "type"
+	TransactionFieldCategoryID   = "category_id"
+	TransactionFieldAccountID    = "account_id"
+	TransactionFieldNotes        = "notes"
+	TransactionFieldCurrency     = "currency"
+	TransactionFieldExchangeRate = "exchange_rate"
+	TransactionFieldIsSplit      = "is_split"
+	TransactionFieldSplits       = "splits"
+	TransactionFieldTagIDs       = "tag_ids"
+)
+
+// trackedTransactionFields lists the tracked fields in the order changes are reported.
+var trackedTransactionFields = []string{
+	TransactionFieldDate,
+	TransactionFieldDescription,
+	TransactionFieldAmount,
+	TransactionFieldType,
+	TransactionFieldCategoryID,
+	TransactionFieldAccountID,
+	TransactionFieldNotes,
+	TransactionFieldCurrency,
+	TransactionFieldExchangeRate,
+	TransactionFieldIsSplit,
+	TransactionFieldSplits,
+	TransactionFieldTagIDs,
+}
+
+// TransactionFieldChange is the value of a field before and after a change; nil means empty.
+type TransactionFieldChange struct {
+	Field  string
+	Before *string
+	After  *string
+}
+
+// TransactionChange is an append-only record of a mutation to a transaction.
+// Changes made by one operation (e.g., a bulk categorize) share an OperationID so they can be reverted together.
+type TransactionChange struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	UserID        uuid.UUID  // Owner of the transaction
+	ActorID       *uuid.UUID // User who made the change, nil for automated changes
+	Source        TransactionChangeSource
+	Action        TransactionChangeAction
+	OperationID   uuid.UUID
+	Fields        []TransactionFieldChange
+	RevertedAt    *time.Time
+	RevertedByID  *uuid.UUID // Change that reverted this one
+	CreatedAt     time.Time
+}
+
+// NewTransactionChange records the mutation of a transaction from before to after.
+// before is nil for created transactions and after is nil for deleted ones.
+// Returns nil when an update leaves every tracked field unchanged.
+func NewTransactionChange(
+	before, after *Transaction,
+	actorID *uuid.UUID,
+	source TransactionChangeSource,
+	operationID uuid.UUID,
+) *TransactionChange {
+	action := TransactionChangeActionUpdate
+	subject := after
+	switch {
+	case before == nil:
+		action = TransactionChangeActionCreate
+	case after == nil:
+		action = TransactionChangeActionDelete
+		subject = before
+	}
+
+	fields := make([]TransactionFieldChange, 0)
+	for _, field := range trackedTransactionFields {
+		var beforeValue, afterValue *string
+		if before != nil {
+			beforeValue = TransactionFieldValue(before, field)
+		}
+		if after != nil {
+			afterValue = TransactionFieldValue(after, field)
+		}
+		if !equalFieldValues(beforeValue, afterValue) {
+			fields = append(fields, TransactionFieldChange{Field: field, Before: beforeValue, After: afterValue})
+		}
+	}
+	if action == TransactionChangeActionUpdate && len(fields) == 0 {
+		return nil
+	}
+
+	return &TransactionChange{
+		ID:            uuid.New(),
+		TransactionID: subject.ID,
+		UserID:        subject.UserID,
+		ActorID:       actorID,
+		Source:        source,
+		Action:        action,
+		OperationID:   operationID,
+		Fields:        fields,
+		CreatedAt:     time.Now().UTC(),
+	}
+}
+
+// IsReverted returns true if the change has been reverted.
+func (c *TransactionChange) IsReverted() bool {
+	return c.RevertedAt != nil
+}
+
+// Revertable returns an error describing why the change cannot be applied in reverse, or nil if it can.
+// Only updates are revertable, and not those that changed split lines, which are not kept in the history,
+// or tags, which are attached and detached in bulk.
+func (c *TransactionChange) Revertable() error {
+	if c.IsReverted() {
+		return fmt.Errorf("change has already been reverted")
+	}
+	if c.Action != TransactionChangeActionUpdate {
+		return fmt.Errorf("only updates can be reverted, not a %s", c.Action)
+	}
+	for _, field := range c.Fields {
+		if field.Field == TransactionFieldIsSplit || field.Field == TransactionFieldSplits {
+			return fmt.Errorf("changes to split transactions cannot be reverted")
+		}
+		if field.Field == TransactionFieldTagIDs {
+			return fmt.Errorf("tag changes cannot be reverted; tag or untag the transaction instead")
+		}
+	}
+	return nil
+}
+
+// HasField returns true if the change set the field.
+func (c *TransactionChange) HasField(field string) bool {
+	for _, fieldChange := range c.Fields {
+		if fieldChange.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCurrent returns true if the transaction still has every value the change set.
+func (c *TransactionChange) IsCurrent(transaction *Transaction) bool {
+	for _, field := range c.Fields {
+		if !equalFieldValues(TransactionFieldValue(transaction, field.Field), field.After) {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyReverse sets the fields of the transaction back to their values before the change.
+func (c *TransactionChange) ApplyReverse(transaction *Transaction) error {
+	for _, field := range c.Fields {
+		if err := setTransactionFieldValue(transaction, field.Field, field.Before); err != nil {
+			return err
+		}
+	}
+	transaction.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// MarkReverted records that the change was reverted by another change.
+func (c *TransactionChange) MarkReverted(revertedByID uuid.UUID) {
+	now := time.Now().UTC()
+	c.RevertedAt = &now
+	c.RevertedByID = &revertedByID
+}
+
+// TransactionFieldValue returns the value of a tracked field as stored in the history; nil means empty.
+func TransactionFieldValue(transaction *Transaction, field string) *string {
+	var value string
+	switch field {
+	case TransactionFieldDate:
+		value = transaction.Date.Format("2006-01-02")
+	case TransactionFieldDescription:
+		value = transaction.Description
+	case TransactionFieldAmount:
+		value = transaction.Amount.String()
+	case TransactionFieldType:
+		value = string(transaction.Type)
+	case TransactionFieldCategoryID:
+		if transaction.CategoryID == nil {
+			return nil
+		}
+		value = transaction.CategoryID.String()
+	case TransactionFieldAccountID:
+		if transaction.AccountID == nil {
+			return nil
+		}
+		value = transaction.AccountID.String()
+	case TransactionFieldNotes:
+		value = transaction.Notes
+	case TransactionFieldCurrency:
+		value = transaction.Currency
+	case TransactionFieldExchangeRate:
+		value = transaction.ExchangeRate.String()
+	case TransactionFieldIsSplit:
+		value = fmt.Sprintf("%t", transaction.IsSplit)
+	case TransactionFieldSplits:
+		// Split lines as "category:amount", sorted, since their IDs change whenever they are replaced
+		lines := make([]string, len(transaction.Splits))
+		for i, split := range transaction.Splits {
+			categoryID := ""
+			if split.CategoryID != nil {
+				categoryID = split.CategoryID.String()
+			}
+			lines[i] = categoryID + ":" + split.Amount.String()
+		}
+		sort.Strings(lines)
+		value = strings.Join(lines, ";")
+	case TransactionFieldTagIDs:
+		// Sorted, so the order tags were loaded in is not reported as a change
+		tagIDs := make([]string, len(transaction.Tags))
+		for i, tag := range transaction.Tags {
+			tagIDs[i] = tag.ID.String()
+		}
+		sort.Strings(tagIDs)
+		value = strings.Join(tagIDs, ",")
+	}
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// setTransactionFieldValue sets a tracked field from its history value.
+func setTransactionFieldValue(transaction *Transaction, field string, value *string) error {
+	text := ""
+	if value != nil {
+		text = *value
+	}
+
+	switch field {
+	case TransactionFieldDate:
+		date, err := time.Parse("2006-01-02", text)
+		if err != nil {
+			return fmt.Errorf("invalid %s %q: %w", field, text, err)
+		}
+		transaction.Date = date
+	case TransactionFieldDescription:
+		transaction.Description = text
+	case TransactionFieldAmount, TransactionFieldExchangeRate:
+		amount, err := decimal.NewFromString(text)
+		if err != nil {
+			return fmt.Errorf("invalid %s %q: %w", field, text, err)
+		}
+		if field == TransactionFieldAmount {
+			transaction.Amount = amount
+		} else {
+			transaction.ExchangeRate = amount
+		}
+	case TransactionFieldType:
+		transaction.Type = TransactionType(text)
+	case TransactionFieldCategoryID, TransactionFieldAccountID:
+		var id *uuid.UUID
+		if value != nil {
+			parsed, err := uuid.Parse(text)
+			if err != nil {
+				return fmt.Errorf("invalid %s %q: %w", field, text, err)
+			}
+			id = &parsed
+		}
+		if field == TransactionFieldCategoryID {
+			transaction.CategoryID = id
+		} else {
+			transaction.AccountID = id
+		}
+	case TransactionFieldNotes:
+		transaction.Notes = text
+	case TransactionFieldCurrency:
+		transaction.Currency = text
+	default:
+		return fmt.Errorf("field %q cannot be reverted", field)
+	}
+	return nil
+}
+
+// equalFieldValues compares two history values, treating nil as empty.
+func equalFieldValues(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
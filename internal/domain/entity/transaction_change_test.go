@@ -0,0 +1,164 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func newChangeTestTransaction() *Transaction {
+	date := time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC)
+	return NewTransaction(uuid.New(), date, "Uber", decimal.NewFromInt(-30), TransactionTypeExpense, nil, "", false)
+}
+
+func TestNewTransactionChange_Update(t *testing.T) {
+	before := newChangeTestTransaction()
+	after := *before
+	categoryID := uuid.New()
+	after.CategoryID = &categoryID
+	after.Notes = "work trip"
+	actorID := uuid.New()
+
+	change := NewTransactionChange(before, &after, &actorID, TransactionChangeSourceRule, uuid.New())
+	if change == nil {
+		t.Fatal("expected a change")
+	}
+	if change.Action != TransactionChangeActionUpdate || change.TransactionID != before.ID || change.UserID != before.UserID {
+		t.Errorf("unexpected change %+v", change)
+	}
+	if len(change.Fields) != 2 {
+		t.Fatalf("expected 2 changed fields, got %+v", change.Fields)
+	}
+	category := change.Fields[0]
+	if category.Field != TransactionFieldCategoryID || category.Before != nil || *category.After != categoryID.String() {
+		t.Errorf("unexpected category change %+v", category)
+	}
+	notes := change.Fields[1]
+	if notes.Field != TransactionFieldNotes || notes.Before != nil || *notes.After != "work trip" {
+		t.Errorf("unexpected notes change %+v", notes)
+	}
+}
+
+func TestNewTransactionChange_NoChange(t *testing.T) {
+	before := newChangeTestTransaction()
+	after := *before
+	if change := NewTransactionChange(before, &after, nil, TransactionChangeSourceManual, uuid.New()); change != nil {
+		t.Errorf("expected no change, got %+v", change)
+	}
+}
+
+func TestNewTransactionChange_CreateAndDelete(t *testing.T) {
+	transaction := newChangeTestTransaction()
+
+	created := NewTransactionChange(nil, transaction, nil, TransactionChangeSourceImport, uuid.New())
+	if created.Action != TransactionChangeActionCreate || created.Revertable() == nil {
+		t.Errorf("expected a create change that cannot be reverted, got %+v", created)
+	}
+
+	deleted := NewTransactionChange(transaction, nil, nil, TransactionChangeSourceManual, uuid.New())
+	if deleted.Action != TransactionChangeActionDelete || deleted.TransactionID != transaction.ID {
+		t.Errorf("expected a delete change, got %+v", deleted)
+	}
+}
+
+func TestTransactionChange_ApplyReverse(t *testing.T) {
+	before := newChangeTestTransaction()
+	accountID := uuid.New()
+	before.AccountID = &accountID
+	after := *before
+	after.Date = before.Date.AddDate(0, 0, 1)
+	after.Amount = decimal.RequireFromString("-32.90")
+	after.AccountID = nil
+	change := NewTransactionChange(before, &after, nil, TransactionChangeSourceManual, uuid.New())
+
+	if err := change.Revertable(); err != nil {
+		t.Fatalf("expected change to be revertable: %v", err)
+	}
+	if !change.IsCurrent(&after) {
+		t.Fatal("expected change to be current")
+	}
+	if err := change.ApplyReverse(&after); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !after.Date.Equal(before.Date) || !after.Amount.Equal(before.Amount) || after.AccountID == nil || *after.AccountID != accountID {
+		t.Errorf("expected transaction to be restored, got %+v", after)
+	}
+	if change.IsCurrent(&after) {
+		t.Error("expected change to no longer be current")
+	}
+	if !change.HasField(TransactionFieldAmount) || change.HasField(TransactionFieldNotes) {
+		t.Errorf("unexpected changed fields %+v", change.Fields)
+	}
+
+	change.MarkReverted(uuid.New())
+	if change.Revertable() == nil {
+		t.Error("expected a reverted change not to be revertable")
+	}
+}
+
+func TestTransactionChange_SplitNotRevertable(t *testing.T) {
+	before := newChangeTestTransaction()
+	before.IsSplit = true
+	after := *before
+	after.IsSplit = false
+	categoryID := uuid.New()
+	after.CategoryID = &categoryID
+
+	change := NewTransactionChange(before, &after, nil, TransactionChangeSourceBulk, uuid.New())
+	if change.Revertable() == nil {
+		t.Error("expected a change to a split transaction not to be revertable")
+	}
+}
+
+func TestTransactionChange_SplitLinesNotRevertable(t *testing.T) {
+	before := newChangeTestTransaction()
+	food, transport := uuid.New(), uuid.New()
+	before.Split([]*TransactionSplit{
+		NewTransactionSplit(before.ID, decimal.NewFromInt(-20), &food, ""),
+		NewTransactionSplit(before.ID, decimal.NewFromInt(-10), &transport, ""),
+	})
+	after := *before
+	after.Split([]*TransactionSplit{
+		NewTransactionSplit(before.ID, decimal.NewFromInt(-10), &transport, ""),
+		NewTransactionSplit(before.ID, decimal.NewFromInt(-20), &food, ""),
+	})
+	if change := NewTransactionChange(before, &after, nil, TransactionChangeSourceManual, uuid.New()); change != nil {
+		t.Errorf("expected the same split lines not to be a change, got %+v", change)
+	}
+
+	after.Split([]*TransactionSplit{
+		NewTransactionSplit(before.ID, decimal.NewFromInt(-25), &food, ""),
+		NewTransactionSplit(before.ID, decimal.NewFromInt(-5), &transport, ""),
+	})
+	change := NewTransactionChange(before, &after, nil, TransactionChangeSourceManual, uuid.New())
+	if change == nil || !change.HasField(TransactionFieldSplits) || change.HasField(TransactionFieldIsSplit) {
+		t.Fatalf("expected a split lines change, got %+v", change)
+	}
+	if change.Revertable() == nil {
+		t.Error("expected a split lines change not to be revertable")
+	}
+}
+
+func TestTransactionChange_TagsNotRevertable(t *testing.T) {
+	before := newChangeTestTransaction()
+	first, second := &Tag{ID: uuid.New()}, &Tag{ID: uuid.New()}
+	before.Tags = []*Tag{second, first}
+	after := *before
+	after.Tags = []*Tag{first, second, {ID: uuid.New()}}
+
+	reordered := *before
+	reordered.Tags = []*Tag{first, second}
+	if change := NewTransactionChange(before, &reordered, nil, TransactionChangeSourceBulk, uuid.New()); change != nil {
+		t.Errorf("expected the order of tags not to be a change, got %+v", change)
+	}
+
+	change := NewTransactionChange(before, &after, nil, TransactionChangeSourceBulk, uuid.New())
+	if change == nil || !change.HasField(TransactionFieldTagIDs) {
+		t.Fatalf("expected a tag change, got %+v", change)
+	}
+	if change.Revertable() == nil {
+		t.Error("expected a tag change not to be revertable")
+	}
+}
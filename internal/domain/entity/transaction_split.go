@@ -0,0 +1,112 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// MinTransactionSplits is the minimum number of lines a split transaction has.
+const MinTransactionSplits = 2
+
+// TransactionSplit represents the part of a transaction attributed to a single category
+// (e.g., the pharmacy items of a supermarket receipt). The lines of a split transaction
+// add up to its amount, and reports attribute each line to its own category.
+type TransactionSplit struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	CategoryID    *uuid.UUID      // Optional, can be uncategorized
+	Amount        decimal.Decimal // Same sign as the transaction amount, in the transaction currency
+	Notes         string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewTransactionSplit creates a new split line for a transaction.
+func NewTransactionSplit(
+	transactionID uuid.UUID,
+	amount decimal.Decimal,
+	categoryID *uuid.UUID,
+	notes string,
+) *TransactionSplit {
+	now := time.Now().UTC()
+
+	return &TransactionSplit{
+		ID:            uuid.New(),
+		TransactionID: transactionID,
+		CategoryID:    categoryID,
+		Amount:        amount,
+		Notes:         notes,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// CanBeSplitInto reports whether the lines are a valid split of the transaction: at least
+// MinTransactionSplits non-zero lines with the same sign as the transaction, adding up to its amount.
+func (t *Transaction) CanBeSplitInto(splits []*TransactionSplit) bool {
+	if len(splits) < MinTransactionSplits {
+		return false
+	}
+
+	total := decimal.Zero
+	for _, split := range splits {
+		if split.Amount.IsZero() || split.Amount.Sign() != t.Amount.Sign() {
+			return false
+		}
+		total = total.Add(split.Amount)
+	}
+	return total.Equal(t.Amount)
+}
+
+// Split replaces the lines of the transaction. A split transaction has no category of its own.
+func (t *Transaction) Split(splits []*TransactionSplit) {
+	t.Splits = splits
+	t.IsSplit = true
+	t.CategoryID = nil
+}
+
+// Unsplit removes the lines of the transaction, leaving it uncategorized.
+func (t *Transaction) Unsplit() {
+	t.Splits = nil
+	t.IsSplit = false
+	t.CategoryID = nil
+}
+
+// ChangeAmount sets the amount of the transaction. The lines of a split transaction are rescaled
+// in proportion to the new amount, the last line taking the rounding difference; when they no
+// longer form a valid split, the transaction is unsplit.
+func (t *Transaction) ChangeAmount(amount decimal.Decimal) {
+	previous := t.Amount
+	t.Amount = amount
+	if !t.IsSplit || previous.Equal(amount) {
+		return
+	}
+	if previous.IsZero() {
+		t.Unsplit()
+		return
+	}
+
+	now := time.Now().UTC()
+	splits := make([]*TransactionSplit, len(t.Splits))
+	remaining := amount
+	for i, split := range t.Splits {
+		rescaled := *split
+		if i == len(t.Splits)-1 {
+			rescaled.Amount = remaining
+		} else {
+			rescaled.Amount = split.Amount.Mul(amount).Div(previous).Round(2)
+			remaining = remaining.Sub(rescaled.Amount)
+		}
+		rescaled.UpdatedAt = now
+		splits[i] = &rescaled
+	}
+
+	if !t.CanBeSplitInto(splits) {
+		t.Unsplit()
+		return
+	}
+	t.Splits = splits
+}
@@ -0,0 +1,119 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func newSplitTestTransaction(amount string) *Transaction {
+	categoryID := uuid.New()
+	return NewTransaction(uuid.New(), time.Now(), "Supermarket", decimal.RequireFromString(amount),
+		TransactionTypeExpense, &categoryID, "", false)
+}
+
+func splitLines(transaction *Transaction, amounts ...string) []*TransactionSplit {
+	splits := make([]*TransactionSplit, len(amounts))
+	for i, amount := range amounts {
+		splits[i] = NewTransactionSplit(transaction.ID, decimal.RequireFromString(amount), nil, "")
+	}
+	return splits
+}
+
+func TestTransaction_CanBeSplitInto(t *testing.T) {
+	transaction := newSplitTestTransaction("-100")
+
+	tests := []struct {
+		name    string
+		amounts []string
+		want    bool
+	}{
+		{"lines add up to the amount", []string{"-70", "-30"}, true},
+		{"lines with cents add up to the amount", []string{"-33.33", "-33.33", "-33.34"}, true},
+		{"lines fall short of the amount", []string{"-70", "-20"}, false},
+		{"single line", []string{"-100"}, false},
+		{"line with the opposite sign", []string{"-120", "20"}, false},
+		{"zero line", []string{"-100", "0"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := transaction.CanBeSplitInto(splitLines(transaction, tt.amounts...)); got != tt.want {
+				t.Errorf("CanBeSplitInto(%v) = %v, want %v", tt.amounts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransaction_SplitAndUnsplit(t *testing.T) {
+	transaction := newSplitTestTransaction("-100")
+	splits := splitLines(transaction, "-70", "-30")
+
+	transaction.Split(splits)
+	if !transaction.IsSplit || transaction.CategoryID != nil || len(transaction.Splits) != 2 {
+		t.Fatalf("expected an uncategorized split transaction with 2 lines, got %+v", transaction)
+	}
+
+	transaction.Unsplit()
+	if transaction.IsSplit || transaction.CategoryID != nil || transaction.Splits != nil {
+		t.Errorf("expected an uncategorized transaction without lines, got %+v", transaction)
+	}
+}
+
+func TestTransaction_ChangeAmount(t *testing.T) {
+	tests := []struct {
+		name      string
+		amounts   []string
+		newAmount string
+		want      []string
+	}{
+		{"lines are rescaled in proportion", []string{"-70", "-30"}, "-200", []string{"-140", "-60"}},
+		{"last line takes the rounding difference", []string{"-50", "-50"}, "-100.01", []string{"-50.01", "-50"}},
+		{"line rounding to zero unsplits", []string{"-99.99", "-0.01"}, "-0.01", nil},
+		{"amount changing sign flips the lines", []string{"-70", "-30"}, "100", []string{"70", "30"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transaction := newSplitTestTransaction("-100")
+			transaction.Split(splitLines(transaction, tt.amounts...))
+
+			transaction.ChangeAmount(decimal.RequireFromString(tt.newAmount))
+
+			if !transaction.Amount.Equal(decimal.RequireFromString(tt.newAmount)) {
+				t.Fatalf("expected amount %s, got %s", tt.newAmount, transaction.Amount)
+			}
+			if tt.want == nil {
+				if transaction.IsSplit || transaction.Splits != nil {
+					t.Fatalf("expected the transaction to be unsplit, got %+v", transaction.Splits)
+				}
+				return
+			}
+			if len(transaction.Splits) != len(tt.want) {
+				t.Fatalf("expected %d lines, got %d", len(tt.want), len(transaction.Splits))
+			}
+			for i, want := range tt.want {
+				if !transaction.Splits[i].Amount.Equal(decimal.RequireFromString(want)) {
+					t.Errorf("line %d: expected %s, got %s", i, want, transaction.Splits[i].Amount)
+				}
+			}
+		})
+	}
+}
+
+func TestTransaction_ChangeAmountKeepsPreviousLines(t *testing.T) {
+	transaction := newSplitTestTransaction("-100")
+	transaction.Split(splitLines(transaction, "-70", "-30"))
+	previous := transaction.Splits
+
+	transaction.ChangeAmount(decimal.RequireFromString("-50"))
+
+	if !previous[0].Amount.Equal(decimal.RequireFromString("-70")) {
+		t.Errorf("expected the previous lines to be left as they were, got %s", previous[0].Amount)
+	}
+	if transaction.Splits[0].ID != previous[0].ID {
+		t.Errorf("expected the rescaled line to keep its ID")
+	}
+}
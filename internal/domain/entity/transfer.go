@@ -0,0 +1,81 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Transfer represents money moved between two of the user's accounts.
+// It is stored as two transactions (legs) sharing a TransferID: a negative leg in the
+// source account and a positive leg in the destination account. Transfers are neither
+// income nor expense, so they never count towards totals, trends or goals.
+type Transfer struct {
+	ID   uuid.UUID
+	From *Transaction // Outgoing leg (negative amount)
+	To   *Transaction // Incoming leg (positive amount)
+}
+
+// NewTransfer creates the two legs of a transfer of a positive amount between accounts.
+func NewTransfer(
+	userID uuid.UUID,
+	fromAccountID uuid.UUID,
+	toAccountID uuid.UUID,
+	date time.Time,
+	description string,
+	amount decimal.Decimal,
+	notes string,
+) *Transfer {
+	transferID := uuid.New()
+
+	from := NewTransaction(userID, date, description, amount.Abs().Neg(), TransactionTypeTransfer, nil, notes, false)
+	from.AccountID = &fromAccountID
+	from.TransferID = &transferID
+
+	to := NewTransaction(userID, date, description, amount.Abs(), TransactionTypeTransfer, nil, notes, false)
+	to.AccountID = &toAccountID
+	to.TransferID = &transferID
+
+	return &Transfer{
+		ID:   transferID,
+		From: from,
+		To:   to,
+	}
+}
+
+// TransferFromLegs rebuilds a Transfer from its stored legs.
+// It returns false when the legs do not form a complete transfer.
+func TransferFromLegs(legs []*Transaction) (*Transfer, bool) {
+	if len(legs) != 2 || legs[0].TransferID == nil || legs[1].TransferID == nil ||
+		*legs[0].TransferID != *legs[1].TransferID {
+		return nil, false
+	}
+
+	from, to := legs[0], legs[1]
+	if from.Amount.IsPositive() {
+		from, to = to, from
+	}
+
+	return &Transfer{
+		ID:   *from.TransferID,
+		From: from,
+		To:   to,
+	}, true
+}
+
+// Amount returns the amount moved between the accounts (always positive).
+func (t *Transfer) Amount() decimal.Decimal {
+	return t.To.Amount
+}
+
+// Legs returns both transactions of the transfer.
+func (t *Transfer) Legs() []*Transaction {
+	return []*Transaction{t.From, t.To}
+}
+
+// IsTransfer reports whether the transaction is a leg of a transfer between accounts.
+func (t *Transaction) IsTransfer() bool {
+	return t.TransferID != nil
+}
@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestNewTransfer_CreatesOpposingLegs(t *testing.T) {
+	userID, checking, savings := uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2024, 11, 20, 0, 0, 0, 0, time.UTC)
+
+	transfer := NewTransfer(userID, checking, savings, date, "Monthly savings", decimal.RequireFromString("-500"), "")
+
+	if !transfer.From.Amount.Equal(decimal.RequireFromString("-500")) || *transfer.From.AccountID != checking {
+		t.Errorf("expected -500 out of checking, got %s from %v", transfer.From.Amount, transfer.From.AccountID)
+	}
+	if !transfer.To.Amount.Equal(decimal.RequireFromString("500")) || *transfer.To.AccountID != savings {
+		t.Errorf("expected 500 into savings, got %s into %v", transfer.To.Amount, transfer.To.AccountID)
+	}
+	for _, leg := range transfer.Legs() {
+		if leg.Type != TransactionTypeTransfer || *leg.TransferID != transfer.ID || leg.CategoryID != nil {
+			t.Errorf("unexpected leg %+v", leg)
+		}
+	}
+	if !transfer.Amount().Equal(decimal.RequireFromString("500")) {
+		t.Errorf("expected amount 500, got %s", transfer.Amount())
+	}
+}
+
+func TestTransferFromLegs(t *testing.T) {
+	transfer := NewTransfer(uuid.New(), uuid.New(), uuid.New(), time.Now(), "Savings", decimal.NewFromInt(100), "")
+
+	// Leg order does not matter
+	rebuilt, ok := TransferFromLegs([]*Transaction{transfer.To, transfer.From})
+	if !ok || rebuilt.From != transfer.From || rebuilt.To != transfer.To || rebuilt.ID != transfer.ID {
+		t.Fatalf("expected transfer to be rebuilt from its legs, got %+v", rebuilt)
+	}
+
+	if _, ok := TransferFromLegs([]*Transaction{transfer.From}); ok {
+		t.Error("expected a single leg not to form a transfer")
+	}
+
+	other := NewTransfer(uuid.New(), uuid.New(), uuid.New(), time.Now(), "Other", decimal.NewFromInt(100), "")
+	if _, ok := TransferFromLegs([]*Transaction{transfer.From, other.To}); ok {
+		t.Error("expected legs of different transfers not to form a transfer")
+	}
+}
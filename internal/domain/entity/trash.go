@@ -0,0 +1,60 @@
+// Package entity defines the core business entities for the domain layer.
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashItemType identifies the kind of soft-deleted item kept in the trash.
+type TrashItemType string
+
+const (
+	TrashItemTypeTransaction  TrashItemType = "transaction"
+	TrashItemTypeCategory     TrashItemType = "category"
+	TrashItemTypeGoal         TrashItemType = "goal"
+	TrashItemTypeCategoryRule TrashItemType = "category_rule"
+)
+
+// IsValid returns true if the type is one kept in the trash.
+func (t TrashItemType) IsValid() bool {
+	switch t {
+	case TrashItemTypeTransaction, TrashItemTypeCategory, TrashItemTypeGoal, TrashItemTypeCategoryRule:
+		return true
+	default:
+		return false
+	}
+}
+
+// TrashItemRef identifies an item in the trash.
+type TrashItemRef struct {
+	Type TrashItemType
+	ID   uuid.UUID
+}
+
+// TrashItem is a soft-deleted transaction, category, goal or category rule, kept until the retention period ends.
+type TrashItem struct {
+	Type      TrashItemType
+	ID        uuid.UUID
+	Name      string // Description of transactions, pattern of rules and name of the others
+	DeletedAt time.Time
+}
+
+// Ref returns the reference of the item.
+func (i *TrashItem) Ref() TrashItemRef {
+	return TrashItemRef{Type: i.Type, ID: i.ID}
+}
+
+// ExpiresAt returns when the item is permanently deleted, given the retention period in days.
+func (i *TrashItem) ExpiresAt(retentionDays int) time.Time {
+	return i.DeletedAt.AddDate(0, 0, retentionDays)
+}
+
+// TrashReference is a category in the trash that an item being restored refers to.
+type TrashReference struct {
+	Item         TrashItemRef
+	CategoryID   uuid.UUID
+	CategoryName string
+	Detachable   bool // The item remains valid without the category, e.g., a transaction becomes uncategorized
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestTrashItemType_IsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		itemType TrashItemType
+		expected bool
+	}{
+		{"transaction", TrashItemTypeTransaction, true},
+		{"category", TrashItemTypeCategory, true},
+		{"goal", TrashItemTypeGoal, true},
+		{"category rule", TrashItemTypeCategoryRule, true},
+		{"account", TrashItemType("account"), false},
+		{"empty", TrashItemType(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.itemType.IsValid(); got != tt.expected {
+				t.Errorf("IsValid() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTrashItem_ExpiresAt(t *testing.T) {
+	deletedAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	item := &TrashItem{Type: TrashItemTypeGoal, ID: uuid.New(), DeletedAt: deletedAt}
+
+	expected := time.Date(2024, 2, 14, 10, 30, 0, 0, time.UTC)
+	if got := item.ExpiresAt(30); !got.Equal(expected) {
+		t.Errorf("ExpiresAt(30) = %v, want %v", got, expected)
+	}
+	if got := item.ExpiresAt(0); !got.Equal(deletedAt) {
+		t.Errorf("ExpiresAt(0) = %v, want %v", got, deletedAt)
+	}
+	if ref := item.Ref(); ref.Type != TrashItemTypeGoal || ref.ID != item.ID {
+		t.Errorf("Ref() = %+v, want type goal and ID %s", ref, item.ID)
+	}
+}
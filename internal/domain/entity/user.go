@@ -2,9 +2,11 @@
 package entity
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // DateFormat represents the user's preferred date format.
@@ -24,6 +26,43 @@ const (
 	NumberFormatUS NumberFormat = "US" // 1,234.56
 )
 
+// Layout returns the Go time layout for the date format, defaulting to YYYY-MM-DD.
+func (f DateFormat) Layout() string {
+	switch f {
+	case DateFormatDMY:
+		return "02/01/2006"
+	case DateFormatMDY:
+		return "01/02/2006"
+	default:
+		return "2006-01-02"
+	}
+}
+
+// Format formats an amount with two decimals and thousands separators (e.g., "-1.234,56" for BR).
+func (f NumberFormat) Format(amount decimal.Decimal) string {
+	thousands, decimalSep := ",", "."
+	if f == NumberFormatBR {
+		thousands, decimalSep = ".", ","
+	}
+
+	fixed := amount.Abs().StringFixed(2)
+	integer, fraction := fixed[:len(fixed)-3], fixed[len(fixed)-2:]
+
+	var b strings.Builder
+	if amount.IsNegative() {
+		b.WriteByte('-')
+	}
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteString(thousands)
+		}
+		b.WriteRune(digit)
+	}
+	b.WriteString(decimalSep)
+	b.WriteString(fraction)
+	return b.String()
+}
+
 // FirstDayOfWeek represents the user's preferred first day of the week.
 type FirstDayOfWeek string
 
@@ -44,6 +83,7 @@ type User struct {
 	EmailNotifications bool
 	GoalAlerts         bool
 	RecurringReminders bool
+	BaseCurrency       string // ISO 4217 code reports are converted into (e.g., "BRL")
 	TermsAcceptedAt    time.Time
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
@@ -64,6 +104,7 @@ func NewUser(email, name, passwordHash string, termsAcceptedAt time.Time) *User
 		EmailNotifications: true,
 		GoalAlerts:         true,
 		RecurringReminders: true,
+		BaseCurrency:       DefaultCurrency,
 		TermsAcceptedAt:    termsAcceptedAt,
 		CreatedAt:          now,
 		UpdatedAt:          now,
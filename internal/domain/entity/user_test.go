@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestNumberFormat_Format(t *testing.T) {
+	cases := []struct {
+		format NumberFormat
+		amount string
+		want   string
+	}{
+		{NumberFormatBR, "-1500", "-1.500,00"},
+		{NumberFormatBR, "1234567.891", "1.234.567,89"},
+		{NumberFormatUS, "-0.5", "-0.50"},
+		{NumberFormatUS, "999", "999.00"},
+	}
+
+	for _, tc := range cases {
+		if got := tc.format.Format(decimal.RequireFromString(tc.amount)); got != tc.want {
+			t.Errorf("%s.Format(%s): expected %q, got %q", tc.format, tc.amount, tc.want, got)
+		}
+	}
+}
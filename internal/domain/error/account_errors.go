@@ -0,0 +1,89 @@
+// Package error defines domain-specific errors for the Finance Tracker application.
+package error
+
+import "errors"
+
+// Account domain errors.
+var (
+	// ErrAccountNotFound is returned when an account is not found in the system.
+	ErrAccountNotFound = errors.New("account not found")
+
+	// ErrAccountNameExists is returned when the user already has an account with the same name.
+	ErrAccountNameExists = errors.New("account name already exists")
+
+	// ErrNotAuthorizedAccount is returned when the account does not belong to the user.
+	ErrNotAuthorizedAccount = errors.New("not authorized to access account")
+
+	// ErrInvalidAccountType is returned when the account type is not supported.
+	ErrInvalidAccountType = errors.New("invalid account type")
+
+	// ErrInvalidAccountCurrency is returned when the currency is not a 3-letter ISO code.
+	ErrInvalidAccountCurrency = errors.New("invalid currency")
+
+	// ErrAccountCurrencyInUse is returned when the currency of an account that has transactions is changed.
+	ErrAccountCurrencyInUse = errors.New("account currency is used by its transactions")
+
+	// ErrInvalidBillingDays is returned when closing or due days are invalid or set on a non-card account.
+	ErrInvalidBillingDays = errors.New("invalid billing days")
+
+	// ErrAccountMissingFields is returned when required fields are missing.
+	ErrAccountMissingFields = errors.New("missing required fields")
+
+	// ErrBillingDaysNotSet is returned when a billing calendar is requested for an account that is
+	// not a credit card with a closing day.
+	ErrBillingDaysNotSet = errors.New("credit card closing day not set")
+
+	// ErrInvalidBillingCycleOverride is returned when an override's billing cycle or dates are invalid.
+	ErrInvalidBillingCycleOverride = errors.New("invalid billing cycle override")
+
+	// ErrBillingCycleOverrideNotFound is returned when a billing cycle has no override.
+	ErrBillingCycleOverrideNotFound = errors.New("billing cycle override not found")
+)
+
+// AccountErrorCode defines error codes for account errors.
+// Format: ACC-XXYYYY where XX is category and YYYY is specific error.
+type AccountErrorCode string
+
+const (
+	// Validation errors (01XXXX)
+	ErrCodeAccountNotFound         AccountErrorCode = "ACC-010001"
+	ErrCodeAccountNameExists       AccountErrorCode = "ACC-010002"
+	ErrCodeNotAuthorizedAccount    AccountErrorCode = "ACC-010003"
+	ErrCodeInvalidAccountType      AccountErrorCode = "ACC-010004"
+	ErrCodeInvalidAccountCurrency  AccountErrorCode = "ACC-010005"
+	ErrCodeInvalidBillingDays      AccountErrorCode = "ACC-010006"
+	ErrCodeAccountMissingFields    AccountErrorCode = "ACC-010007"
+	ErrCodeBillingDaysNotSet       AccountErrorCode = "ACC-010008"
+	ErrCodeInvalidBillingOverride  AccountErrorCode = "ACC-010009"
+	ErrCodeBillingOverrideNotFound AccountErrorCode = "ACC-010010"
+	ErrCodeAccountCurrencyInUse    AccountErrorCode = "ACC-010011"
+)
+
+// AccountError represents an account error with code and message.
+type AccountError struct {
+	Code    AccountErrorCode
+	Message string
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *AccountError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e *AccountError) Unwrap() error {
+	return e.Err
+}
+
+// NewAccountError creates a new AccountError with the given code and message.
+func NewAccountError(code AccountErrorCode, message string, err error) *AccountError {
+	return &AccountError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
@@ -0,0 +1,81 @@
+// Package error defines domain-specific errors for the Finance Tracker application.
+package error
+
+import "errors"
+
+// Attachment domain errors.
+var (
+	// ErrAttachmentNotFound is returned when an attachment is not found in the system.
+	ErrAttachmentNotFound = errors.New("attachment not found")
+
+	// ErrNotAuthorizedAttachment is returned when the attachment does not belong to the user.
+	ErrNotAuthorizedAttachment = errors.New("not authorized to access attachment")
+
+	// ErrAttachmentOwnerNotFound is returned when the transaction or account to attach to is not found.
+	ErrAttachmentOwnerNotFound = errors.New("attachment owner not found")
+
+	// ErrAttachmentTooLarge is returned when the file exceeds the maximum attachment size.
+	ErrAttachmentTooLarge = errors.New("attachment too large")
+
+	// ErrUnsupportedAttachmentType is returned when the file type cannot be attached.
+	ErrUnsupportedAttachmentType = errors.New("unsupported attachment type")
+
+	// ErrAttachmentMissingFields is returned when required fields are missing.
+	ErrAttachmentMissingFields = errors.New("missing required fields")
+
+	// ErrAttachmentNotUploaded is returned when the file of a pending attachment is not in storage.
+	ErrAttachmentNotUploaded = errors.New("attachment not uploaded")
+
+	// ErrPresignNotSupported is returned when the object storage cannot issue presigned URLs.
+	ErrPresignNotSupported = errors.New("presigned URLs not supported")
+
+	// ErrObjectNotFound is returned when an object is not found in object storage.
+	ErrObjectNotFound = errors.New("object not found")
+)
+
+// AttachmentErrorCode defines error codes for attachment errors.
+// Format: ATT-XXYYYY where XX is category and YYYY is specific error.
+type AttachmentErrorCode string
+
+const (
+	// Validation errors (01XXXX)
+	ErrCodeAttachmentNotFound        AttachmentErrorCode = "ATT-010001"
+	ErrCodeNotAuthorizedAttachment   AttachmentErrorCode = "ATT-010002"
+	ErrCodeAttachmentOwnerNotFound   AttachmentErrorCode = "ATT-010003"
+	ErrCodeAttachmentTooLarge        AttachmentErrorCode = "ATT-010004"
+	ErrCodeUnsupportedAttachmentType AttachmentErrorCode = "ATT-010005"
+	ErrCodeAttachmentMissingFields   AttachmentErrorCode = "ATT-010006"
+	ErrCodeAttachmentNotUploaded     AttachmentErrorCode = "ATT-010007"
+
+	// Storage errors (02XXXX)
+	ErrCodePresignNotSupported AttachmentErrorCode = "ATT-020001"
+)
+
+// AttachmentError represents an attachment error with code and message.
+type AttachmentError struct {
+	Code    AttachmentErrorCode
+	Message string
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *AttachmentError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e *AttachmentError) Unwrap() error {
+	return e.Err
+}
+
+// NewAttachmentError creates a new AttachmentError with the given code and message.
+func NewAttachmentError(code AttachmentErrorCode, message string, err error) *AttachmentError {
+	return &AttachmentError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
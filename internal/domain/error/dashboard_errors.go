@@ -22,6 +22,12 @@ var (
 
 	// ErrInvalidDateFormat is returned when date format is invalid.
 	ErrInvalidDateFormat = errors.New("invalid date format, expected YYYY-MM-DD")
+
+	// ErrInvalidSort is returned when the sort field or order is not valid.
+	ErrInvalidSort = errors.New("invalid sort")
+
+	// ErrInvalidCursor is returned when a pagination cursor is malformed or belongs to another sort.
+	ErrInvalidCursor = errors.New("invalid cursor")
 )
 
 // DashboardErrorCode defines error codes for dashboard errors.
@@ -36,6 +42,8 @@ const (
 	ErrCodeInvalidGranularity DashboardErrorCode = "DSH-010004"
 	ErrCodeMissingGranularity DashboardErrorCode = "DSH-010005"
 	ErrCodeInvalidDateFormat  DashboardErrorCode = "DSH-010006"
+	ErrCodeInvalidSort        DashboardErrorCode = "DSH-010007"
+	ErrCodeInvalidCursor      DashboardErrorCode = "DSH-010008"
 
 	// Internal errors (99XXXX)
 	ErrCodeDashboardInternalError DashboardErrorCode = "DSH-990001"
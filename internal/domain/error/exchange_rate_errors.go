@@ -0,0 +1,92 @@
+// Package error defines domain-specific errors for the Finance Tracker application.
+package error
+
+import "errors"
+
+// Exchange rate domain errors.
+var (
+	// ErrExchangeRateNotFound is returned when an exchange rate is not found in the system.
+	ErrExchangeRateNotFound = errors.New("exchange rate not found")
+
+	// ErrNotAuthorizedExchangeRate is returned when the exchange rate does not belong to the user.
+	ErrNotAuthorizedExchangeRate = errors.New("not authorized to access exchange rate")
+
+	// ErrInvalidCurrency is returned when a currency is not a 3-letter ISO code.
+	ErrInvalidCurrency = errors.New("invalid currency")
+
+	// ErrInvalidExchangeRate is returned when the rate is not positive.
+	ErrInvalidExchangeRate = errors.New("invalid exchange rate")
+
+	// ErrSameCurrency is returned when a rate converts a currency into itself.
+	ErrSameCurrency = errors.New("currencies must be different")
+
+	// ErrExchangeRateMissingFields is returned when required fields are missing.
+	ErrExchangeRateMissingFields = errors.New("missing required fields")
+
+	// ErrExchangeRateUnavailable is returned when no rate on or before a date exists for a currency pair.
+	ErrExchangeRateUnavailable = errors.New("exchange rate unavailable")
+
+	// Exchange rate file errors.
+
+	// ErrInvalidExchangeRateFile is returned when an uploaded rate file cannot be parsed.
+	ErrInvalidExchangeRateFile = errors.New("invalid exchange rate file")
+
+	// ErrEmptyExchangeRateFile is returned when a rate file contains no valid rates.
+	ErrEmptyExchangeRateFile = errors.New("exchange rate file contains no rates")
+
+	// ErrMissingExchangeRateFile is returned when no rate file is uploaded.
+	ErrMissingExchangeRateFile = errors.New("exchange rate file is required")
+
+	// ErrExchangeRateFileTooLarge is returned when an uploaded rate file exceeds the size limit.
+	ErrExchangeRateFileTooLarge = errors.New("exchange rate file too large")
+)
+
+// ExchangeRateErrorCode defines error codes for exchange rate errors.
+// Format: FXR-XXYYYY where XX is category and YYYY is specific error.
+type ExchangeRateErrorCode string
+
+const (
+	// Validation errors (01XXXX)
+	ErrCodeExchangeRateNotFound      ExchangeRateErrorCode = "FXR-010001"
+	ErrCodeNotAuthorizedExchangeRate ExchangeRateErrorCode = "FXR-010002"
+	ErrCodeInvalidCurrency           ExchangeRateErrorCode = "FXR-010003"
+	ErrCodeInvalidExchangeRate       ExchangeRateErrorCode = "FXR-010004"
+	ErrCodeSameCurrency              ExchangeRateErrorCode = "FXR-010005"
+	ErrCodeExchangeRateMissingFields ExchangeRateErrorCode = "FXR-010006"
+	ErrCodeExchangeRateUnavailable   ExchangeRateErrorCode = "FXR-010007"
+
+	// File import errors (02XXXX)
+	ErrCodeInvalidExchangeRateFile  ExchangeRateErrorCode = "FXR-020001"
+	ErrCodeEmptyExchangeRateFile    ExchangeRateErrorCode = "FXR-020002"
+	ErrCodeMissingExchangeRateFile  ExchangeRateErrorCode = "FXR-020003"
+	ErrCodeExchangeRateFileTooLarge ExchangeRateErrorCode = "FXR-020004"
+)
+
+// ExchangeRateError represents an exchange rate error with code and message.
+type ExchangeRateError struct {
+	Code    ExchangeRateErrorCode
+	Message string
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *ExchangeRateError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e *ExchangeRateError) Unwrap() error {
+	return e.Err
+}
+
+// NewExchangeRateError creates a new ExchangeRateError with the given code and message.
+func NewExchangeRateError(code ExchangeRateErrorCode, message string, err error) *ExchangeRateError {
+	return &ExchangeRateError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
@@ -25,6 +25,33 @@ var (
 
 	// ErrInvalidGoalPeriod is returned when the goal period is invalid.
 	ErrInvalidGoalPeriod = errors.New("invalid goal period")
+
+	// ErrMissingGoalFields is returned when required fields for the goal kind are missing.
+	ErrMissingGoalFields = errors.New("missing required fields")
+
+	// ErrInvalidGoalKind is returned when the goal kind is invalid or a field does not apply to the goal kind.
+	ErrInvalidGoalKind = errors.New("invalid goal kind")
+
+	// ErrInvalidTargetAmount is returned when the savings target amount is invalid (zero or negative).
+	ErrInvalidTargetAmount = errors.New("invalid target amount")
+
+	// ErrGoalNotSavings is returned when contributions are used with a goal that is not a savings goal.
+	ErrGoalNotSavings = errors.New("goal is not a savings goal")
+
+	// ErrInvalidContributionAmount is returned when the contribution amount is invalid (zero or negative).
+	ErrInvalidContributionAmount = errors.New("invalid contribution amount")
+
+	// ErrGoalContributionNotFound is returned when a goal contribution is not found in the system.
+	ErrGoalContributionNotFound = errors.New("goal contribution not found")
+
+	// ErrContributionTransactionNotFound is returned when the transaction linked to a contribution is not found.
+	ErrContributionTransactionNotFound = errors.New("transaction not found")
+
+	// ErrContributionAlreadyExists is returned when the transaction is already linked to the goal.
+	ErrContributionAlreadyExists = errors.New("transaction already contributes to this goal")
+
+	// ErrContributionNotesTooLong is returned when the contribution notes exceed the maximum length.
+	ErrContributionNotesTooLong = errors.New("contribution notes too long")
 )
 
 // GoalErrorCode defines error codes for goal errors.
@@ -41,6 +68,14 @@ const (
 	ErrCodeUnauthorizedGoalAccess    GoalErrorCode = "GOL-010006"
 	ErrCodeInvalidGoalPeriod         GoalErrorCode = "GOL-010007"
 	ErrCodeMissingGoalFields         GoalErrorCode = "GOL-010008"
+	ErrCodeInvalidGoalKind           GoalErrorCode = "GOL-010009"
+	ErrCodeInvalidTargetAmount       GoalErrorCode = "GOL-010010"
+	ErrCodeGoalNotSavings            GoalErrorCode = "GOL-010011"
+	ErrCodeInvalidContributionAmount GoalErrorCode = "GOL-010012"
+	ErrCodeContributionNotFound      GoalErrorCode = "GOL-010013"
+	ErrCodeContributionTxNotFound    GoalErrorCode = "GOL-010014"
+	ErrCodeContributionAlreadyExists GoalErrorCode = "GOL-010015"
+	ErrCodeContributionNotesTooLong  GoalErrorCode = "GOL-010016"
 )
 
 // GoalError represents a goal error with code and message.
@@ -0,0 +1,76 @@
+// Package error defines domain-specific errors for the Finance Tracker application.
+package error
+
+import "errors"
+
+// ImportProfile domain errors.
+var (
+	// ErrImportProfileNotFound is returned when an import profile is not found in the system.
+	ErrImportProfileNotFound = errors.New("import profile not found")
+
+	// ErrImportProfileNameExists is returned when the user already has a profile with the same name.
+	ErrImportProfileNameExists = errors.New("import profile name already exists")
+
+	// ErrNotAuthorizedImportProfile is returned when the profile does not belong to the user.
+	ErrNotAuthorizedImportProfile = errors.New("not authorized to access import profile")
+
+	// ErrInvalidColumnMapping is returned when the column mapping is incomplete or inconsistent.
+	ErrInvalidColumnMapping = errors.New("invalid column mapping")
+
+	// ErrInvalidImportDateFormat is returned when the date format is not supported.
+	ErrInvalidImportDateFormat = errors.New("invalid date format")
+
+	// ErrInvalidImportNumberFormat is returned when the number format is not supported.
+	ErrInvalidImportNumberFormat = errors.New("invalid number format")
+
+	// ErrInvalidDelimiter is returned when the CSV delimiter is not supported.
+	ErrInvalidDelimiter = errors.New("invalid delimiter")
+
+	// ErrImportProfileMissingFields is returned when required fields are missing.
+	ErrImportProfileMissingFields = errors.New("missing required fields")
+)
+
+// ImportProfileErrorCode defines error codes for import profile errors.
+// Format: IMP-XXYYYY where XX is category and YYYY is specific error.
+type ImportProfileErrorCode string
+
+const (
+	// Validation errors (01XXXX)
+	ErrCodeImportProfileNotFound      ImportProfileErrorCode = "IMP-010001"
+	ErrCodeImportProfileNameExists    ImportProfileErrorCode = "IMP-010002"
+	ErrCodeNotAuthorizedImportProfile ImportProfileErrorCode = "IMP-010003"
+	ErrCodeInvalidColumnMapping       ImportProfileErrorCode = "IMP-010004"
+	ErrCodeInvalidImportDateFormat    ImportProfileErrorCode = "IMP-010005"
+	ErrCodeInvalidImportNumberFormat  ImportProfileErrorCode = "IMP-010006"
+	ErrCodeInvalidDelimiter           ImportProfileErrorCode = "IMP-010007"
+	ErrCodeImportProfileMissingFields ImportProfileErrorCode = "IMP-010008"
+)
+
+// ImportProfileError represents an import profile error with code and message.
+type ImportProfileError struct {
+	Code    ImportProfileErrorCode
+	Message string
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *ImportProfileError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e *ImportProfileError) Unwrap() error {
+	return e.Err
+}
+
+// NewImportProfileError creates a new ImportProfileError with the given code and message.
+func NewImportProfileError(code ImportProfileErrorCode, message string, err error) *ImportProfileError {
+	return &ImportProfileError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
@@ -0,0 +1,60 @@
+// Package error defines domain-specific errors for the Finance Tracker application.
+package error
+
+import "errors"
+
+// Installment domain errors.
+var (
+	// ErrInstallmentPlanNotFound is returned when an installment plan is not found in the system.
+	ErrInstallmentPlanNotFound = errors.New("installment plan not found")
+
+	// ErrNotAuthorizedInstallmentPlan is returned when the installment plan does not belong to the user.
+	ErrNotAuthorizedInstallmentPlan = errors.New("not authorized to access installment plan")
+
+	// ErrInvalidCommitmentMonths is returned when the commitment horizon is out of range.
+	ErrInvalidCommitmentMonths = errors.New("invalid commitment months")
+
+	// ErrInvalidCommitmentStart is returned when the first billing cycle of commitments is not in YYYY-MM format.
+	ErrInvalidCommitmentStart = errors.New("invalid commitment start")
+)
+
+// InstallmentErrorCode defines error codes for installment errors.
+// Format: INS-XXYYYY where XX is category and YYYY is specific error.
+type InstallmentErrorCode string
+
+const (
+	// Validation errors (01XXXX)
+	ErrCodeInstallmentPlanNotFound      InstallmentErrorCode = "INS-010001"
+	ErrCodeNotAuthorizedInstallmentPlan InstallmentErrorCode = "INS-010002"
+	ErrCodeInvalidCommitmentMonths      InstallmentErrorCode = "INS-010003"
+	ErrCodeInvalidCommitmentStart       InstallmentErrorCode = "INS-010004"
+)
+
+// InstallmentError represents an installment error with code and message.
+type InstallmentError struct {
+	Code    InstallmentErrorCode
+	Message string
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *InstallmentError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e *InstallmentError) Unwrap() error {
+	return e.Err
+}
+
+// NewInstallmentError creates a new InstallmentError with the given code and message.
+func NewInstallmentError(code InstallmentErrorCode, message string, err error) *InstallmentError {
+	return &InstallmentError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
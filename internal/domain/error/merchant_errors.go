@@ -0,0 +1,64 @@
+// Package error defines domain-specific errors for the Finance Tracker application.
+package error
+
+import "errors"
+
+// Merchant domain errors.
+var (
+	// ErrMerchantNotFound is returned when a merchant is not found in the system.
+	ErrMerchantNotFound = errors.New("merchant not found")
+
+	// ErrNotAuthorizedMerchant is returned when the merchant does not belong to the user.
+	ErrNotAuthorizedMerchant = errors.New("not authorized to access merchant")
+
+	// ErrMerchantMissingFields is returned when required fields are missing.
+	ErrMerchantMissingFields = errors.New("missing required fields")
+
+	// ErrInvalidMerchantMerge is returned when a merchant would be merged into itself.
+	ErrInvalidMerchantMerge = errors.New("invalid merchant merge")
+
+	// ErrMerchantCategoryNotFound is returned when the default category does not exist or is not owned by the user.
+	ErrMerchantCategoryNotFound = errors.New("merchant default category not found")
+)
+
+// MerchantErrorCode defines error codes for merchant errors.
+// Format: MER-XXYYYY where XX is category and YYYY is specific error.
+type MerchantErrorCode string
+
+const (
+	// Validation errors (01XXXX)
+	ErrCodeMerchantNotFound         MerchantErrorCode = "MER-010001"
+	ErrCodeNotAuthorizedMerchant    MerchantErrorCode = "MER-010002"
+	ErrCodeMerchantMissingFields    MerchantErrorCode = "MER-010003"
+	ErrCodeInvalidMerchantMerge     MerchantErrorCode = "MER-010004"
+	ErrCodeMerchantCategoryNotFound MerchantErrorCode = "MER-010005"
+)
+
+// MerchantError represents a merchant error with code and message.
+type MerchantError struct {
+	Code    MerchantErrorCode
+	Message string
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *MerchantError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e *MerchantError) Unwrap() error {
+	return e.Err
+}
+
+// NewMerchantError creates a new MerchantError with the given code and message.
+func NewMerchantError(code MerchantErrorCode, message string, err error) *MerchantError {
+	return &MerchantError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
@@ -0,0 +1,84 @@
+// Package error defines domain-specific errors for the Finance Tracker application.
+package error
+
+import "errors"
+
+// RecurringSchedule domain errors.
+var (
+	// ErrRecurringScheduleNotFound is returned when a recurring schedule is not found in the system.
+	ErrRecurringScheduleNotFound = errors.New("recurring schedule not found")
+
+	// ErrNotAuthorizedRecurringSchedule is returned when the schedule does not belong to the user.
+	ErrNotAuthorizedRecurringSchedule = errors.New("not authorized to access recurring schedule")
+
+	// ErrInvalidRecurrenceFrequency is returned when the frequency is not supported.
+	ErrInvalidRecurrenceFrequency = errors.New("invalid recurrence frequency")
+
+	// ErrInvalidRecurrenceInterval is returned when the interval is out of range.
+	ErrInvalidRecurrenceInterval = errors.New("invalid recurrence interval")
+
+	// ErrInvalidRecurrenceDay is returned when the day of month or day of week is out of range.
+	ErrInvalidRecurrenceDay = errors.New("invalid recurrence day")
+
+	// ErrInvalidRecurrenceEnd is returned when the end date or occurrence count is inconsistent.
+	ErrInvalidRecurrenceEnd = errors.New("invalid recurrence end")
+
+	// ErrInvalidRecurringAmount is returned when the amount is zero.
+	ErrInvalidRecurringAmount = errors.New("invalid recurring amount")
+
+	// ErrRecurringCategoryNotFound is returned when the category is not found or not owned by the user.
+	ErrRecurringCategoryNotFound = errors.New("category not found")
+
+	// ErrRecurringScheduleMissingFields is returned when required fields are missing or invalid.
+	ErrRecurringScheduleMissingFields = errors.New("missing required fields")
+
+	// ErrRecurringOccurrenceAlreadyProcessed is returned when another scheduler run already generated
+	// the occurrence or the schedule's next occurrence changed since it was loaded.
+	ErrRecurringOccurrenceAlreadyProcessed = errors.New("recurring occurrence already processed")
+)
+
+// RecurringScheduleErrorCode defines error codes for recurring schedule errors.
+// Format: REC-XXYYYY where XX is category and YYYY is specific error.
+type RecurringScheduleErrorCode string
+
+const (
+	// Validation errors (01XXXX)
+	ErrCodeRecurringScheduleNotFound      RecurringScheduleErrorCode = "REC-010001"
+	ErrCodeNotAuthorizedRecurringSchedule RecurringScheduleErrorCode = "REC-010002"
+	ErrCodeInvalidRecurrenceFrequency     RecurringScheduleErrorCode = "REC-010003"
+	ErrCodeInvalidRecurrenceInterval      RecurringScheduleErrorCode = "REC-010004"
+	ErrCodeInvalidRecurrenceDay           RecurringScheduleErrorCode = "REC-010005"
+	ErrCodeInvalidRecurrenceEnd           RecurringScheduleErrorCode = "REC-010006"
+	ErrCodeInvalidRecurringAmount         RecurringScheduleErrorCode = "REC-010007"
+	ErrCodeRecurringCategoryNotFound      RecurringScheduleErrorCode = "REC-010008"
+	ErrCodeRecurringScheduleMissingFields RecurringScheduleErrorCode = "REC-010009"
+)
+
+// RecurringScheduleError represents a recurring schedule error with code and message.
+type RecurringScheduleError struct {
+	Code    RecurringScheduleErrorCode
+	Message string
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *RecurringScheduleError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e *RecurringScheduleError) Unwrap() error {
+	return e.Err
+}
+
+// NewRecurringScheduleError creates a new RecurringScheduleError with the given code and message.
+func NewRecurringScheduleError(code RecurringScheduleErrorCode, message string, err error) *RecurringScheduleError {
+	return &RecurringScheduleError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
@@ -0,0 +1,64 @@
+// Package error defines domain-specific errors for the Finance Tracker application.
+package error
+
+import "errors"
+
+// Tag domain errors.
+var (
+	// ErrTagNotFound is returned when a tag is not found in the system.
+	ErrTagNotFound = errors.New("tag not found")
+
+	// ErrTagNameExists is returned when the user already has a tag with the same name.
+	ErrTagNameExists = errors.New("tag name already exists")
+
+	// ErrNotAuthorizedTag is returned when the tag does not belong to the user.
+	ErrNotAuthorizedTag = errors.New("not authorized to access tag")
+
+	// ErrInvalidTagColor is returned when the tag color is not a hex color.
+	ErrInvalidTagColor = errors.New("invalid tag color")
+
+	// ErrTagMissingFields is returned when required fields are missing.
+	ErrTagMissingFields = errors.New("missing required fields")
+)
+
+// TagErrorCode defines error codes for tag errors.
+// Format: TAG-XXYYYY where XX is category and YYYY is specific error.
+type TagErrorCode string
+
+const (
+	// Validation errors (01XXXX)
+	ErrCodeTagNotFound      TagErrorCode = "TAG-010001"
+	ErrCodeTagNameExists    TagErrorCode = "TAG-010002"
+	ErrCodeNotAuthorizedTag TagErrorCode = "TAG-010003"
+	ErrCodeInvalidTagColor  TagErrorCode = "TAG-010004"
+	ErrCodeTagMissingFields TagErrorCode = "TAG-010005"
+)
+
+// TagError represents a tag error with code and message.
+type TagError struct {
+	Code    TagErrorCode
+	Message string
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *TagError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e *TagError) Unwrap() error {
+	return e.Err
+}
+
+// NewTagError creates a new TagError with the given code and message.
+func NewTagError(code TagErrorCode, message string, err error) *TagError {
+	return &TagError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
@@ -38,6 +38,72 @@ var (
 	// ErrTransactionIDsNotFound is returned when one or more transaction IDs are not found.
 	ErrTransactionIDsNotFound = errors.New("one or more transactions not found")
 
+	// ErrAccountNotFoundForTransaction is returned when the specified account is not found.
+	ErrAccountNotFoundForTransaction = errors.New("account not found")
+
+	// ErrAccountNotOwnedByUser is returned when the account does not belong to the user.
+	ErrAccountNotOwnedByUser = errors.New("account does not belong to user")
+
+	// ErrTransactionIsTransfer is returned when a transfer leg is edited as a regular transaction.
+	ErrTransactionIsTransfer = errors.New("transaction is part of a transfer")
+
+	// ErrInvalidTransactionCurrency is returned when the currency is not a 3-letter ISO code.
+	ErrInvalidTransactionCurrency = errors.New("invalid transaction currency")
+
+	// ErrTransactionCurrencyMismatch is returned when a transaction's currency differs from its account's.
+	ErrTransactionCurrencyMismatch = errors.New("transaction currency differs from account currency")
+
+	// ErrInvalidTransactionRate is returned when an explicit exchange rate is not positive.
+	ErrInvalidTransactionRate = errors.New("invalid transaction exchange rate")
+
+	// ErrInvalidTransactionSplits is returned when split lines do not add up to the transaction amount.
+	ErrInvalidTransactionSplits = errors.New("invalid transaction splits")
+
+	// ErrTransactionIsSplit is returned when the amount or category of a split transaction is edited directly.
+	ErrTransactionIsSplit = errors.New("transaction is split across categories")
+
+	// ErrTagNotFoundForTransaction is returned when the specified tag is not found.
+	ErrTagNotFoundForTransaction = errors.New("tag not found")
+
+	// ErrTagNotOwnedByUser is returned when the tag does not belong to the user.
+	ErrTagNotOwnedByUser = errors.New("tag does not belong to user")
+
+	// ErrInvalidSearchQuery is returned when the transaction search query has invalid syntax.
+	ErrInvalidSearchQuery = errors.New("invalid search query")
+
+	// ErrInvalidTransactionSort is returned when the sort field or order of a transaction listing is invalid.
+	ErrInvalidTransactionSort = errors.New("invalid transaction sort")
+
+	// ErrInvalidTransactionCursor is returned when a pagination cursor is malformed or belongs to another sort.
+	ErrInvalidTransactionCursor = errors.New("invalid transaction cursor")
+
+	// ErrInvalidAmountRange is returned when the minimum amount filter is greater than the maximum.
+	ErrInvalidAmountRange = errors.New("invalid amount range")
+
+	// ErrTransactionChangeNotFound is returned when a transaction change or operation is not in the history.
+	ErrTransactionChangeNotFound = errors.New("transaction change not found")
+
+	// ErrChangeNotRevertable is returned when a change cannot be reverted, e.g., it is not an update.
+	ErrChangeNotRevertable = errors.New("transaction change cannot be reverted")
+
+	// ErrTransactionChangedSince is returned when reverting a change that later changes have overwritten.
+	ErrTransactionChangedSince = errors.New("transaction has changed since")
+
+	// ErrInvalidBulkSelection is returned when a bulk update selects transactions by both IDs and a filter, or by neither.
+	ErrInvalidBulkSelection = errors.New("invalid bulk selection")
+
+	// ErrNoBulkChanges is returned when a bulk update does not change any field.
+	ErrNoBulkChanges = errors.New("bulk update has no changes")
+
+	// ErrInvalidNotesMode is returned when the notes mode of a bulk update is not append or replace.
+	ErrInvalidNotesMode = errors.New("invalid notes mode")
+
+	// ErrTooManyTransactionsSelected is returned when a bulk update selects more transactions than allowed.
+	ErrTooManyTransactionsSelected = errors.New("too many transactions selected")
+
+	// ErrInvalidExportFormat is returned when the export format is not supported.
+	ErrInvalidExportFormat = errors.New("invalid export format")
+
 	// Credit card import errors.
 
 	// ErrInvalidBillingCycle is returned when the billing cycle format is invalid.
@@ -61,6 +127,12 @@ var (
 	// ErrBillPaymentNotOwned is returned when bill payment does not belong to user.
 	ErrBillPaymentNotOwned = errors.New("bill payment does not belong to user")
 
+	// ErrNotCreditCardAccount is returned when card transactions are imported into an account that is not a credit card.
+	ErrNotCreditCardAccount = errors.New("account is not a credit card")
+
+	// ErrInvalidForecastMonths is returned when the number of bills to forecast is out of range.
+	ErrInvalidForecastMonths = errors.New("invalid forecast months")
+
 	// Reconciliation errors.
 
 	// ErrPendingNotFound is returned when no pending CC transactions exist for a billing cycle.
@@ -71,6 +143,25 @@ var (
 
 	// ErrAmountMismatch is returned when amount difference exceeds tolerance without force.
 	ErrAmountMismatch = errors.New("amount difference exceeds tolerance")
+
+	// Statement import errors.
+
+	// ErrInvalidStatementFile is returned when an uploaded statement file cannot be parsed.
+	ErrInvalidStatementFile = errors.New("invalid statement file")
+
+	// ErrEmptyStatement is returned when a statement file contains no transactions.
+	ErrEmptyStatement = errors.New("statement contains no transactions")
+
+	// ErrStatementFileTooLarge is returned when an uploaded statement file exceeds the size limit.
+	ErrStatementFileTooLarge = errors.New("statement file too large")
+
+	// ErrMissingStatementFile is returned when no statement file is uploaded.
+	ErrMissingStatementFile = errors.New("statement file is required")
+
+	// Duplicate detection errors.
+
+	// ErrSameTransactionPair is returned when a transaction is merged with or dismissed against itself.
+	ErrSameTransactionPair = errors.New("transaction and duplicate must be different")
 )
 
 // TransactionErrorCode defines error codes for transaction errors.
@@ -91,21 +182,55 @@ const (
 	ErrCodeMissingTransactionFields TransactionErrorCode = "TXN-010010"
 	ErrCodeEmptyTransactionIDs      TransactionErrorCode = "TXN-010011"
 	ErrCodeTransactionIDsNotFound   TransactionErrorCode = "TXN-010012"
+	ErrCodeTxnAccountNotFound       TransactionErrorCode = "TXN-010013"
+	ErrCodeTxnAccountNotOwned       TransactionErrorCode = "TXN-010014"
+	ErrCodeTransactionIsTransfer    TransactionErrorCode = "TXN-010015"
+	ErrCodeInvalidTxnCurrency       TransactionErrorCode = "TXN-010016"
+	ErrCodeTxnRateUnavailable       TransactionErrorCode = "TXN-010017"
+	ErrCodeInvalidTxnRate           TransactionErrorCode = "TXN-010018"
+	ErrCodeInvalidTxnSplits         TransactionErrorCode = "TXN-010019"
+	ErrCodeTransactionIsSplit       TransactionErrorCode = "TXN-010020"
+	ErrCodeTxnTagNotFound           TransactionErrorCode = "TXN-010021"
+	ErrCodeTxnTagNotOwned           TransactionErrorCode = "TXN-010022"
+	ErrCodeInvalidSearchQuery       TransactionErrorCode = "TXN-010023"
+	ErrCodeInvalidTxnSort           TransactionErrorCode = "TXN-010024"
+	ErrCodeInvalidTxnCursor         TransactionErrorCode = "TXN-010025"
+	ErrCodeInvalidAmountRange       TransactionErrorCode = "TXN-010026"
+	ErrCodeTxnChangeNotFound        TransactionErrorCode = "TXN-010027"
+	ErrCodeChangeNotRevertable      TransactionErrorCode = "TXN-010028"
+	ErrCodeTxnChangedSince          TransactionErrorCode = "TXN-010029"
+	ErrCodeInvalidBulkSelection     TransactionErrorCode = "TXN-010030"
+	ErrCodeNoBulkChanges            TransactionErrorCode = "TXN-010031"
+	ErrCodeInvalidNotesMode         TransactionErrorCode = "TXN-010032"
+	ErrCodeTooManyTxnsSelected      TransactionErrorCode = "TXN-010033"
+	ErrCodeInvalidExportFormat      TransactionErrorCode = "TXN-010034"
+	ErrCodeTxnCurrencyMismatch      TransactionErrorCode = "TXN-010035"
 
 	// Credit card import errors (02XXXX)
-	ErrCodeInvalidBillingCycle  TransactionErrorCode = "TXN-020001"
-	ErrCodeBillPaymentNotFound  TransactionErrorCode = "TXN-020002"
-	ErrCodeBillNotExpanded      TransactionErrorCode = "TXN-020003"
-	ErrCodeBillAlreadyExpanded  TransactionErrorCode = "TXN-020004"
-	ErrCodeNoPotentialMatches   TransactionErrorCode = "TXN-020005"
-	ErrCodeEmptyCCTransactions  TransactionErrorCode = "TXN-020006"
-	ErrCodeBillPaymentNotOwned  TransactionErrorCode = "TXN-020007"
+	ErrCodeInvalidBillingCycle   TransactionErrorCode = "TXN-020001"
+	ErrCodeBillPaymentNotFound   TransactionErrorCode = "TXN-020002"
+	ErrCodeBillNotExpanded       TransactionErrorCode = "TXN-020003"
+	ErrCodeBillAlreadyExpanded   TransactionErrorCode = "TXN-020004"
+	ErrCodeNoPotentialMatches    TransactionErrorCode = "TXN-020005"
+	ErrCodeEmptyCCTransactions   TransactionErrorCode = "TXN-020006"
+	ErrCodeBillPaymentNotOwned   TransactionErrorCode = "TXN-020007"
+	ErrCodeNotCreditCardAccount  TransactionErrorCode = "TXN-020008"
+	ErrCodeInvalidForecastMonths TransactionErrorCode = "TXN-020009"
 
 	// Reconciliation errors (03XXXX)
 	ErrCodePendingNotFound    TransactionErrorCode = "TXN-030001"
 	ErrCodeCycleAlreadyLinked TransactionErrorCode = "TXN-030002"
 	ErrCodeAmountMismatch     TransactionErrorCode = "TXN-030003"
 
+	// Statement import errors (04XXXX)
+	ErrCodeInvalidStatementFile  TransactionErrorCode = "TXN-040001"
+	ErrCodeEmptyStatement        TransactionErrorCode = "TXN-040002"
+	ErrCodeStatementFileTooLarge TransactionErrorCode = "TXN-040003"
+	ErrCodeMissingStatementFile  TransactionErrorCode = "TXN-040004"
+
+	// Duplicate detection errors (05XXXX)
+	ErrCodeSameTransactionPair TransactionErrorCode = "TXN-050001"
+
 	// Internal errors (99XXXX)
 	ErrCodeInternalError TransactionErrorCode = "TXN-990001"
 )
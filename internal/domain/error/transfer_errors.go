@@ -0,0 +1,84 @@
+// Package error defines domain-specific errors for the Finance Tracker application.
+package error
+
+import "errors"
+
+// Transfer domain errors.
+var (
+	// ErrTransferNotFound is returned when a transfer is not found in the system.
+	ErrTransferNotFound = errors.New("transfer not found")
+
+	// ErrNotAuthorizedTransfer is returned when the transfer does not belong to the user.
+	ErrNotAuthorizedTransfer = errors.New("not authorized to access transfer")
+
+	// ErrTransferAccountNotFound is returned when the source or destination account is not found.
+	ErrTransferAccountNotFound = errors.New("transfer account not found")
+
+	// ErrTransferAccountNotOwned is returned when the source or destination account does not belong to the user.
+	ErrTransferAccountNotOwned = errors.New("transfer account does not belong to user")
+
+	// ErrSameTransferAccount is returned when the source and destination accounts are the same.
+	ErrSameTransferAccount = errors.New("source and destination accounts must be different")
+
+	// ErrInvalidTransferAmount is returned when the transfer amount is not positive.
+	ErrInvalidTransferAmount = errors.New("invalid transfer amount")
+
+	// ErrTransferCurrencyMismatch is returned when the accounts of a transfer use different currencies.
+	ErrTransferCurrencyMismatch = errors.New("transfer accounts use different currencies")
+
+	// ErrTransferMissingFields is returned when required fields are missing.
+	ErrTransferMissingFields = errors.New("missing required fields")
+
+	// ErrTransactionNotConvertible is returned when a transaction cannot become a transfer
+	// (e.g., it is already a transfer, has no account or is an expanded bill).
+	ErrTransactionNotConvertible = errors.New("transaction cannot be converted to a transfer")
+)
+
+// TransferErrorCode defines error codes for transfer errors.
+// Format: TRF-XXYYYY where XX is category and YYYY is specific error.
+type TransferErrorCode string
+
+const (
+	// Validation errors (01XXXX)
+	ErrCodeTransferNotFound           TransferErrorCode = "TRF-010001"
+	ErrCodeNotAuthorizedTransfer      TransferErrorCode = "TRF-010002"
+	ErrCodeTransferAccountNotFound    TransferErrorCode = "TRF-010003"
+	ErrCodeTransferAccountNotOwned    TransferErrorCode = "TRF-010004"
+	ErrCodeSameTransferAccount        TransferErrorCode = "TRF-010005"
+	ErrCodeInvalidTransferAmount      TransferErrorCode = "TRF-010006"
+	ErrCodeTransferMissingFields      TransferErrorCode = "TRF-010007"
+	ErrCodeTransferDescriptionTooLong TransferErrorCode = "TRF-010008"
+	ErrCodeTransferNotesTooLong       TransferErrorCode = "TRF-010009"
+	ErrCodeTransactionNotConvertible  TransferErrorCode = "TRF-010010"
+	ErrCodeTransferTransactionMissing TransferErrorCode = "TRF-010011"
+	ErrCodeTransferCurrencyMismatch   TransferErrorCode = "TRF-010012"
+)
+
+// TransferError represents a transfer error with code and message.
+type TransferError struct {
+	Code    TransferErrorCode
+	Message string
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *TransferError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e *TransferError) Unwrap() error {
+	return e.Err
+}
+
+// NewTransferError creates a new TransferError with the given code and message.
+func NewTransferError(code TransferErrorCode, message string, err error) *TransferError {
+	return &TransferError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
@@ -0,0 +1,68 @@
+// Package error defines domain-specific errors for the Finance Tracker application.
+package error
+
+import "errors"
+
+// Trash domain errors.
+var (
+	// ErrTrashItemNotFound is returned when an item is not in the user's trash.
+	ErrTrashItemNotFound = errors.New("trash item not found")
+
+	// ErrInvalidTrashItemType is returned when the item type is not one kept in the trash.
+	ErrInvalidTrashItemType = errors.New("invalid trash item type")
+
+	// ErrEmptyTrashItems is returned when a restore request has no items.
+	ErrEmptyTrashItems = errors.New("trash items list cannot be empty")
+
+	// ErrInvalidReferenceMode is returned when the way to handle deleted references is unknown.
+	ErrInvalidReferenceMode = errors.New("invalid reference mode")
+
+	// ErrTrashReferenceDeleted is returned when a restored item refers to a category that is also in the trash.
+	ErrTrashReferenceDeleted = errors.New("referenced item is in the trash")
+
+	// ErrTrashRestoreConflict is returned when a restored item would duplicate an active one.
+	ErrTrashRestoreConflict = errors.New("restored item conflicts with an active item")
+)
+
+// TrashErrorCode defines error codes for trash errors.
+// Format: TRS-XXYYYY where XX is category and YYYY is specific error.
+type TrashErrorCode string
+
+const (
+	// Validation errors (01XXXX)
+	ErrCodeTrashItemNotFound     TrashErrorCode = "TRS-010001"
+	ErrCodeInvalidTrashItemType  TrashErrorCode = "TRS-010002"
+	ErrCodeEmptyTrashItems       TrashErrorCode = "TRS-010003"
+	ErrCodeInvalidReferenceMode  TrashErrorCode = "TRS-010004"
+	ErrCodeTrashReferenceDeleted TrashErrorCode = "TRS-010005"
+	ErrCodeTrashRestoreConflict  TrashErrorCode = "TRS-010006"
+)
+
+// TrashError represents a trash error with code and message.
+type TrashError struct {
+	Code    TrashErrorCode
+	Message string
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *TrashError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap returns the underlying error.
+func (e *TrashError) Unwrap() error {
+	return e.Err
+}
+
+// NewTrashError creates a new TrashError with the given code and message.
+func NewTrashError(code TrashErrorCode, message string, err error) *TrashError {
+	return &TrashError{
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
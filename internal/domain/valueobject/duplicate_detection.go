@@ -0,0 +1,199 @@
+// Package valueobject contains domain value objects for the Finance Tracker system.
+package valueobject
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// DuplicateDetectionConfig contains the configuration for duplicate transaction detection.
+type DuplicateDetectionConfig struct {
+	// Date window: transactions up to this many days apart can be duplicates
+	DateWindowDays int // 3 days
+
+	// Minimum normalized description similarity (0-1.0)
+	MinDescriptionSimilarity float64 // 0.7
+}
+
+// DefaultDuplicateDetectionConfig returns the default duplicate detection configuration.
+func DefaultDuplicateDetectionConfig() DuplicateDetectionConfig {
+	return DuplicateDetectionConfig{
+		DateWindowDays:           3,
+		MinDescriptionSimilarity: 0.7,
+	}
+}
+
+// DuplicateCandidate is an existing transaction that a new entry is compared against.
+type DuplicateCandidate struct {
+	ID          uuid.UUID
+	Date        time.Time
+	Description string
+	Amount      decimal.Decimal
+}
+
+// DuplicateMatch is a candidate that is likely the same transaction as the compared entry.
+type DuplicateMatch struct {
+	Candidate DuplicateCandidate
+	Score     float64 // For ranking multiple matches (0-1.0)
+}
+
+// Score compares two entries and reports whether they are likely duplicates.
+// Amounts must match exactly, sign included, so that an expense and its refund are never
+// duplicates; dates must fall within the window and descriptions must be similar.
+func (c DuplicateDetectionConfig) Score(
+	dateA time.Time, descriptionA string, amountA decimal.Decimal,
+	dateB time.Time, descriptionB string, amountB decimal.Decimal,
+) (float64, bool) {
+	if !amountA.Equal(amountB) {
+		return 0, false
+	}
+
+	daysDiff := math.Abs(truncateToDay(dateA).Sub(truncateToDay(dateB)).Hours() / 24)
+	if daysDiff > float64(c.DateWindowDays) {
+		return 0, false
+	}
+
+	similarity := DescriptionSimilarity(descriptionA, descriptionB)
+	if similarity < c.MinDescriptionSimilarity {
+		return 0, false
+	}
+
+	// Prefer closer descriptions and dates
+	dateScore := 1.0 - daysDiff/float64(c.DateWindowDays+1)
+	return (similarity * 0.7) + (dateScore * 0.3), true
+}
+
+// FindMatches returns the candidates that are likely duplicates of the entry, best match first.
+func (c DuplicateDetectionConfig) FindMatches(
+	date time.Time,
+	description string,
+	amount decimal.Decimal,
+	candidates []DuplicateCandidate,
+) []DuplicateMatch {
+	var matches []DuplicateMatch
+	for _, candidate := range candidates {
+		if score, ok := c.Score(date, description, amount, candidate.Date, candidate.Description, candidate.Amount); ok {
+			matches = append(matches, DuplicateMatch{
+				Candidate: candidate,
+				Score:     score,
+			})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	return matches
+}
+
+// NormalizeDescription lowercases a description, strips accents and punctuation,
+// and collapses whitespace so that "UBER *TRIP" and "Uber Trip" compare equal.
+func NormalizeDescription(description string) string {
+	var b strings.Builder
+	b.Grow(len(description))
+
+	lastSpace := true
+	for _, r := range strings.ToLower(description) {
+		r = removeAccent(r)
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			lastSpace = false
+		} else if !lastSpace {
+			b.WriteByte(' ')
+			lastSpace = true
+		}
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+// DescriptionSimilarity returns how similar two descriptions are (0-1.0), using character
+// bigrams of the normalized descriptions. A description fully contained in the other
+// (e.g., a bank truncating a merchant name) counts as highly similar.
+func DescriptionSimilarity(a, b string) float64 {
+	a = strings.ReplaceAll(NormalizeDescription(a), " ", "")
+	b = strings.ReplaceAll(NormalizeDescription(b), " ", "")
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+
+	bigramsA, bigramsB := bigrams(a), bigrams(b)
+	if len(bigramsA) == 0 || len(bigramsB) == 0 {
+		return 0
+	}
+
+	counts := make(map[string]int, len(bigramsA))
+	for _, bg := range bigramsA {
+		counts[bg]++
+	}
+	common := 0
+	for _, bg := range bigramsB {
+		if counts[bg] > 0 {
+			counts[bg]--
+			common++
+		}
+	}
+
+	dice := 2 * float64(common) / float64(len(bigramsA)+len(bigramsB))
+
+	// Containment only counts when the shorter description is meaningful on its own
+	shorter := len(bigramsA)
+	if len(bigramsB) < shorter {
+		shorter = len(bigramsB)
+	}
+	if shorter >= 4 && (strings.Contains(a, b) || strings.Contains(b, a)) {
+		return math.Max(dice, 0.9)
+	}
+
+	return dice
+}
+
+// bigrams returns the overlapping two-character sequences of s.
+func bigrams(s string) []string {
+	runes := []rune(s)
+	if len(runes) < 2 {
+		return nil
+	}
+	result := make([]string, 0, len(runes)-1)
+	for i := 0; i < len(runes)-1; i++ {
+		result = append(result, string(runes[i:i+2]))
+	}
+	return result
+}
+
+// truncateToDay drops the time component so that only calendar days are compared.
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// removeAccent maps common accented Latin letters (as used in Brazilian statements) to ASCII.
+func removeAccent(r rune) rune {
+	switch r {
+	case 'á', 'à', 'â', 'ã', 'ä':
+		return 'a'
+	case 'é', 'è', 'ê', 'ë':
+		return 'e'
+	case 'í', 'ì', 'î', 'ï':
+		return 'i'
+	case 'ó', 'ò', 'ô', 'õ', 'ö':
+		return 'o'
+	case 'ú', 'ù', 'û', 'ü':
+		return 'u'
+	case 'ç':
+		return 'c'
+	case 'ñ':
+		return 'n'
+	default:
+		return r
+	}
+}
@@ -0,0 +1,54 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDescriptionSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		min  float64
+		max  float64
+	}{
+		{"UBER *TRIP", "Uber Trip", 1, 1},
+		{"Padaria São João", "PADARIA SAO JOAO", 1, 1},
+		{"NETFLIX.COM", "Netflix.com Sao Paulo BR", 0.9, 1},
+		{"Supermercado Extra", "Posto Shell", 0, 0.3},
+		{"", "Anything", 0, 0},
+	}
+
+	for _, tt := range tests {
+		got := DescriptionSimilarity(tt.a, tt.b)
+		if got < tt.min || got > tt.max {
+			t.Errorf("DescriptionSimilarity(%q, %q) = %.2f, want between %.2f and %.2f", tt.a, tt.b, got, tt.min, tt.max)
+		}
+	}
+}
+
+func TestDuplicateDetectionConfig_Score(t *testing.T) {
+	config := DefaultDuplicateDetectionConfig()
+	day := time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC)
+	amount := decimal.RequireFromString("-42.90")
+
+	if _, ok := config.Score(day, "IFOOD *RESTAURANTE", amount, day.AddDate(0, 0, 2), "Ifood Restaurante", amount); !ok {
+		t.Error("expected same amount, close date and similar description to be a duplicate")
+	}
+	if _, ok := config.Score(day, "IFOOD *RESTAURANTE", amount, day.AddDate(0, 0, 1), "Estorno IFOOD *RESTAURANTE", amount.Neg()); ok {
+		t.Error("expected an expense and its refund not to be duplicates")
+	}
+	if _, ok := config.Score(day, "IFOOD *RESTAURANTE", amount, day.AddDate(0, 0, 4), "Ifood Restaurante", amount); ok {
+		t.Error("expected entries outside the date window not to be duplicates")
+	}
+	if _, ok := config.Score(day, "IFOOD *RESTAURANTE", amount, day, "Ifood Restaurante", decimal.RequireFromString("-42.91")); ok {
+		t.Error("expected different amounts not to be duplicates")
+	}
+
+	sameDay, _ := config.Score(day, "IFOOD", amount, day, "IFOOD", amount)
+	nextDay, _ := config.Score(day, "IFOOD", amount, day.AddDate(0, 0, 1), "IFOOD", amount)
+	if sameDay <= nextDay {
+		t.Errorf("expected same-day match to score higher (%.2f <= %.2f)", sameDay, nextDay)
+	}
+}
@@ -0,0 +1,146 @@
+// Package valueobject contains domain value objects for the Finance Tracker system.
+package valueobject
+
+import (
+	"regexp"
+	"strings"
+)
+
+// merchantPattern defines a pattern for extracting merchant base names from transaction descriptions.
+type merchantPattern struct {
+	pattern     *regexp.Regexp
+	extractFunc func(matches []string, desc string) string
+}
+
+// merchantPatterns contains patterns for common merchant descriptions.
+// Order matters - more specific patterns should come first.
+var merchantPatterns = []merchantPattern{
+	// Uber patterns: "UBER *TRIP", "UBER *EATS", etc.
+	{
+		pattern: regexp.MustCompile(`(?i)^UBER\s*\*`),
+		extractFunc: func(_ []string, _ string) string {
+			return "UBER"
+		},
+	},
+	// iFood patterns
+	{
+		pattern: regexp.MustCompile(`(?i)IFOOD`),
+		extractFunc: func(_ []string, _ string) string {
+			return "IFOOD"
+		},
+	},
+	// Rappi patterns
+	{
+		pattern: regexp.MustCompile(`(?i)RAPPI`),
+		extractFunc: func(_ []string, _ string) string {
+			return "RAPPI"
+		},
+	},
+	// Netflix patterns
+	{
+		pattern: regexp.MustCompile(`(?i)NETFLIX`),
+		extractFunc: func(_ []string, _ string) string {
+			return "NETFLIX"
+		},
+	},
+	// Spotify patterns
+	{
+		pattern: regexp.MustCompile(`(?i)SPOTIFY`),
+		extractFunc: func(_ []string, _ string) string {
+			return "SPOTIFY"
+		},
+	},
+	// Amazon patterns
+	{
+		pattern: regexp.MustCompile(`(?i)AMAZON`),
+		extractFunc: func(_ []string, _ string) string {
+			return "AMAZON"
+		},
+	},
+	// YouTube/Google patterns
+	{
+		pattern: regexp.MustCompile(`(?i)YOUTUBE`),
+		extractFunc: func(_ []string, _ string) string {
+			return "YOUTUBE"
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)^GOOGLE\s*\*`),
+		extractFunc: func(_ []string, _ string) string {
+			return "GOOGLE"
+		},
+	},
+	// MercadoLivre/MercadoPago patterns
+	{
+		pattern: regexp.MustCompile(`(?i)MERCADOLIVRE|MERCPAGO`),
+		extractFunc: func(_ []string, _ string) string {
+			return "MERCADOLIVRE"
+		},
+	},
+	// PIX patterns: "PAG*" prefix
+	{
+		pattern: regexp.MustCompile(`(?i)^PAG\*`),
+		extractFunc: func(_ []string, _ string) string {
+			return "PAG*PIX"
+		},
+	},
+	// PicPay patterns
+	{
+		pattern: regexp.MustCompile(`(?i)PICPAY`),
+		extractFunc: func(_ []string, _ string) string {
+			return "PICPAY"
+		},
+	},
+	// Nubank patterns
+	{
+		pattern: regexp.MustCompile(`(?i)NUBANK`),
+		extractFunc: func(_ []string, _ string) string {
+			return "NUBANK"
+		},
+	},
+	// PG * patterns: "PG *COMPANY"
+	{
+		pattern: regexp.MustCompile(`(?i)^PG\s*\*\s*(\w+)`),
+		extractFunc: func(matches []string, _ string) string {
+			if len(matches) > 1 {
+				return strings.ToUpper(matches[1])
+			}
+			return "PG*"
+		},
+	},
+	// Generic pattern: the first one or two words, so "PADARIA SAO JOSE" and
+	// "PADARIA SAO JOSE LTDA" share a key while "PADARIA CENTRAL" does not
+	{
+		pattern: regexp.MustCompile(`^(\p{L}+)(?:\s+(\p{L}+))?`),
+		extractFunc: func(matches []string, _ string) string {
+			if matches[2] != "" {
+				return matches[1] + " " + matches[2]
+			}
+			return matches[1]
+		},
+	},
+}
+
+// MerchantKey extracts a normalized merchant key from a transaction description, so the
+// different spellings banks use for the same merchant share a key (e.g., "IFD*IFOOD",
+// "IFOOD *RESTAURANTE X" and "iFood" are all "IFOOD"). Returns "" for an empty description.
+func MerchantKey(description string) string {
+	desc := strings.ToUpper(strings.TrimSpace(description))
+	if desc == "" {
+		return ""
+	}
+
+	for _, mp := range merchantPatterns {
+		matches := mp.pattern.FindStringSubmatch(desc)
+		if matches != nil {
+			return mp.extractFunc(matches, desc)
+		}
+	}
+
+	// Fallback: return first word
+	words := strings.Fields(desc)
+	if len(words) > 0 {
+		return words[0]
+	}
+	return desc
+}
@@ -0,0 +1,35 @@
+package valueobject
+
+import "testing"
+
+func TestMerchantKey(t *testing.T) {
+	tests := []struct {
+		description string
+		expected    string
+	}{
+		{"IFD*IFOOD", "IFOOD"},
+		{"IFOOD *RESTAURANTE X", "IFOOD"},
+		{"iFood", "IFOOD"},
+		{"UBER *TRIP HELP.UBER.COM", "UBER"},
+		{"Uber * Eats", "UBER"},
+		{"PG *SPOTIFYBR", "SPOTIFY"},
+		{"PG *LOJADOZE", "LOJADOZE"},
+		{"PAG*JoaoSilva", "PAG*PIX"},
+		{"  Netflix.com  ", "NETFLIX"},
+		{"PADARIA SAO JOSE", "PADARIA SAO"},
+		{"Padaria Sao Jose Ltda", "PADARIA SAO"},
+		{"AÇOUGUE BOM CORTE", "AÇOUGUE BOM"},
+		{"SHELL", "SHELL"},
+		{"123 POSTO", "123"},
+		{"", ""},
+		{"   ", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if got := MerchantKey(tt.description); got != tt.expected {
+				t.Errorf("MerchantKey(%q) = %q, want %q", tt.description, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Package valueobject contains domain value objects for the Finance Tracker system.
+package valueobject
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// StatementLine is a credit card statement line compared when a billing cycle is re-imported.
+type StatementLine struct {
+	Date        time.Time
+	Description string
+	Amount      decimal.Decimal
+}
+
+// StatementLineMatch pairs a recorded line with the line of the new statement for the same purchase.
+type StatementLineMatch struct {
+	RecordedIndex  int  // Index into the recorded lines
+	StatementIndex int  // Index into the new statement's lines
+	Changed        bool // True when the date, description or amount differ
+}
+
+// StatementDiff is the difference between the recorded lines of a billing cycle and a new statement.
+type StatementDiff struct {
+	Matches []StatementLineMatch // Lines present in both, in statement order
+	Added   []int                // Indexes of the statement lines not recorded yet
+	Removed []int                // Indexes of the recorded lines missing from the statement
+}
+
+// DiffStatement compares the recorded lines of a billing cycle with a corrected statement.
+// Identical lines are paired first; the remaining lines are paired when at least two of the
+// date (within the window), the amount and the description (similar enough) agree, best
+// pairs first, so a purchase whose date, amount or description was corrected is reported as
+// changed instead of removed and added again.
+func (c DuplicateDetectionConfig) DiffStatement(recorded, statement []StatementLine) StatementDiff {
+	recordedMatched := make([]bool, len(recorded))
+	statementMatched := make([]bool, len(statement))
+	var matches []StatementLineMatch
+
+	// Pair identical lines, in order, so repeated purchases keep their recorded transactions
+	for i, line := range statement {
+		for j, existing := range recorded {
+			if !recordedMatched[j] && sameStatementLine(line, existing) {
+				matches = append(matches, StatementLineMatch{RecordedIndex: j, StatementIndex: i})
+				recordedMatched[j] = true
+				statementMatched[i] = true
+				break
+			}
+		}
+	}
+
+	// Score the remaining pairs and keep the best ones
+	type candidatePair struct {
+		recordedIndex  int
+		statementIndex int
+		score          float64
+	}
+	var pairs []candidatePair
+	for i, line := range statement {
+		if statementMatched[i] {
+			continue
+		}
+		for j, existing := range recorded {
+			if recordedMatched[j] {
+				continue
+			}
+			if score, ok := c.scoreCorrection(line, existing); ok {
+				pairs = append(pairs, candidatePair{recordedIndex: j, statementIndex: i, score: score})
+			}
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool {
+		return pairs[i].score > pairs[j].score
+	})
+	for _, pair := range pairs {
+		if recordedMatched[pair.recordedIndex] || statementMatched[pair.statementIndex] {
+			continue
+		}
+		matches = append(matches, StatementLineMatch{
+			RecordedIndex:  pair.recordedIndex,
+			StatementIndex: pair.statementIndex,
+			Changed:        true,
+		})
+		recordedMatched[pair.recordedIndex] = true
+		statementMatched[pair.statementIndex] = true
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].StatementIndex < matches[j].StatementIndex
+	})
+
+	diff := StatementDiff{Matches: matches, Added: []int{}, Removed: []int{}}
+	for i, matched := range statementMatched {
+		if !matched {
+			diff.Added = append(diff.Added, i)
+		}
+	}
+	for j, matched := range recordedMatched {
+		if !matched {
+			diff.Removed = append(diff.Removed, j)
+		}
+	}
+	return diff
+}
+
+// scoreCorrection reports whether two different lines are likely the same purchase with a
+// corrected field, and how likely (0-1.0).
+func (c DuplicateDetectionConfig) scoreCorrection(a, b StatementLine) (float64, bool) {
+	daysDiff := math.Abs(truncateToDay(a.Date).Sub(truncateToDay(b.Date)).Hours() / 24)
+	similarity := DescriptionSimilarity(a.Description, b.Description)
+
+	sameDate := daysDiff <= float64(c.DateWindowDays)
+	sameAmount := a.Amount.Equal(b.Amount)
+	sameDescription := similarity >= c.MinDescriptionSimilarity
+
+	agreeing := 0
+	for _, agrees := range []bool{sameDate, sameAmount, sameDescription} {
+		if agrees {
+			agreeing++
+		}
+	}
+	if agreeing < 2 {
+		return 0, false
+	}
+
+	dateScore := math.Max(0, 1.0-daysDiff/float64(c.DateWindowDays+1))
+	amountScore := 0.0
+	if sameAmount {
+		amountScore = 1
+	}
+	return (similarity * 0.5) + (amountScore * 0.3) + (dateScore * 0.2), true
+}
+
+// sameStatementLine reports whether two lines have the same date, description and amount.
+func sameStatementLine(a, b StatementLine) bool {
+	return truncateToDay(a.Date).Equal(truncateToDay(b.Date)) &&
+		strings.TrimSpace(a.Description) == strings.TrimSpace(b.Description) &&
+		a.Amount.Equal(b.Amount)
+}
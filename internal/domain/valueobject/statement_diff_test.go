@@ -0,0 +1,95 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func statementLine(day int, description, amount string) StatementLine {
+	return StatementLine{
+		Date:        time.Date(2024, 11, day, 0, 0, 0, 0, time.UTC),
+		Description: description,
+		Amount:      decimal.RequireFromString(amount),
+	}
+}
+
+func TestDuplicateDetectionConfig_DiffStatement(t *testing.T) {
+	config := DefaultDuplicateDetectionConfig()
+
+	recorded := []StatementLine{
+		statementLine(5, "Mercado Extra", "150.00"),
+		statementLine(8, "Posto Shell", "200.00"),
+		statementLine(10, "Netflix", "39.90"),
+		statementLine(12, "Farmacia", "25.00"),
+	}
+	statement := []StatementLine{
+		statementLine(5, "Mercado Extra", "150.00"),    // unchanged
+		statementLine(8, "Posto Shell", "210.00"),      // amount corrected
+		statementLine(11, "NETFLIX.COM", "39.90"),      // date and description corrected
+		statementLine(15, "Livraria Cultura", "80.00"), // added
+	}
+
+	diff := config.DiffStatement(recorded, statement)
+
+	expected := []StatementLineMatch{
+		{RecordedIndex: 0, StatementIndex: 0, Changed: false},
+		{RecordedIndex: 1, StatementIndex: 1, Changed: true},
+		{RecordedIndex: 2, StatementIndex: 2, Changed: true},
+	}
+	if len(diff.Matches) != len(expected) {
+		t.Fatalf("Matches = %+v, want %+v", diff.Matches, expected)
+	}
+	for i := range expected {
+		if diff.Matches[i] != expected[i] {
+			t.Errorf("Matches[%d] = %+v, want %+v", i, diff.Matches[i], expected[i])
+		}
+	}
+	if len(diff.Added) != 1 || diff.Added[0] != 3 {
+		t.Errorf("Added = %v, want [3]", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0] != 3 {
+		t.Errorf("Removed = %v, want [3]", diff.Removed)
+	}
+}
+
+func TestDuplicateDetectionConfig_DiffStatement_RepeatedPurchases(t *testing.T) {
+	config := DefaultDuplicateDetectionConfig()
+
+	recorded := []StatementLine{
+		statementLine(5, "Uber Trip", "18.00"),
+		statementLine(5, "Uber Trip", "18.00"),
+	}
+	statement := []StatementLine{
+		statementLine(5, "Uber Trip", "18.00"),
+		statementLine(5, "Uber Trip", "18.00"),
+		statementLine(5, "Uber Trip", "18.00"),
+	}
+
+	diff := config.DiffStatement(recorded, statement)
+	if len(diff.Matches) != 2 || diff.Matches[0].Changed || diff.Matches[1].Changed {
+		t.Errorf("Matches = %+v, want the two recorded trips unchanged", diff.Matches)
+	}
+	if len(diff.Added) != 1 || diff.Added[0] != 2 {
+		t.Errorf("Added = %v, want [2]", diff.Added)
+	}
+	if len(diff.Removed) != 0 {
+		t.Errorf("Removed = %v, want none", diff.Removed)
+	}
+}
+
+func TestDuplicateDetectionConfig_DiffStatement_UnrelatedLines(t *testing.T) {
+	config := DefaultDuplicateDetectionConfig()
+
+	recorded := []StatementLine{statementLine(5, "Mercado Extra", "150.00")}
+	statement := []StatementLine{statementLine(20, "Posto Shell", "90.00")}
+
+	diff := config.DiffStatement(recorded, statement)
+	if len(diff.Matches) != 0 {
+		t.Errorf("Matches = %+v, want none", diff.Matches)
+	}
+	if len(diff.Added) != 1 || len(diff.Removed) != 1 {
+		t.Errorf("Added = %v, Removed = %v, want one of each", diff.Added, diff.Removed)
+	}
+}
@@ -0,0 +1,168 @@
+// Package valueobject contains domain value objects for the Finance Tracker system.
+package valueobject
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TransactionSortField is the key transactions are listed by.
+type TransactionSortField string
+
+const (
+	// TransactionSortByDate sorts by transaction date.
+	TransactionSortByDate TransactionSortField = "date"
+	// TransactionSortByAmount sorts by absolute amount, so large expenses and large incomes sort together.
+	TransactionSortByAmount TransactionSortField = "amount"
+	// TransactionSortByDescription sorts by description.
+	TransactionSortByDescription TransactionSortField = "description"
+	// TransactionSortByCategory sorts by category name; uncategorized and split transactions sort first.
+	TransactionSortByCategory TransactionSortField = "category"
+)
+
+// SortDirection is the direction of a sort.
+type SortDirection string
+
+const (
+	// SortAscending sorts from the smallest key.
+	SortAscending SortDirection = "asc"
+	// SortDescending sorts from the largest key.
+	SortDescending SortDirection = "desc"
+)
+
+// TransactionSort defines how transactions are ordered. Ties are broken by creation time and ID
+// in the same direction, so the order is total and stable across pages.
+type TransactionSort struct {
+	Field     TransactionSortField
+	Direction SortDirection
+}
+
+// DefaultTransactionSort returns the newest-first order used when no sort is requested.
+func DefaultTransactionSort() TransactionSort {
+	return TransactionSort{Field: TransactionSortByDate, Direction: SortDescending}
+}
+
+// ParseTransactionSort parses sort field and direction parameters. An empty field defaults to date,
+// and an empty direction defaults to descending for dates and amounts and ascending otherwise.
+func ParseTransactionSort(field, direction string) (TransactionSort, error) {
+	sort := TransactionSort{Field: TransactionSortField(field), Direction: SortDirection(direction)}
+	if sort.Field == "" {
+		sort.Field = TransactionSortByDate
+	}
+
+	switch sort.Field {
+	case TransactionSortByDate, TransactionSortByAmount:
+		if sort.Direction == "" {
+			sort.Direction = SortDescending
+		}
+	case TransactionSortByDescription, TransactionSortByCategory:
+		if sort.Direction == "" {
+			sort.Direction = SortAscending
+		}
+	default:
+		return TransactionSort{}, fmt.Errorf("invalid sort field %q; expected date, amount, description or category", field)
+	}
+
+	if sort.Direction != SortAscending && sort.Direction != SortDescending {
+		return TransactionSort{}, fmt.Errorf("invalid sort order %q; expected asc or desc", direction)
+	}
+	return sort, nil
+}
+
+// ErrInvalidTransactionCursor is returned when a pagination cursor cannot be decoded.
+var ErrInvalidTransactionCursor = errors.New("invalid cursor")
+
+// TransactionCursor marks the last transaction of a page, so the next page starts right after it.
+// Clients receive it as an opaque token.
+type TransactionCursor struct {
+	Sort      TransactionSort
+	Value     string // Sort key of the transaction: RFC 3339 date, absolute amount, description or category name
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// TransactionSortKeys are the values a transaction can be sorted by.
+type TransactionSortKeys struct {
+	Date         time.Time
+	Amount       decimal.Decimal
+	Description  string
+	CategoryName string // Empty for uncategorized and split transactions
+}
+
+// NewTransactionCursor returns the cursor of a transaction in the given sort.
+func NewTransactionCursor(sort TransactionSort, keys TransactionSortKeys, createdAt time.Time, id uuid.UUID) TransactionCursor {
+	var value string
+	switch sort.Field {
+	case TransactionSortByAmount:
+		value = keys.Amount.Abs().String()
+	case TransactionSortByDescription:
+		value = keys.Description
+	case TransactionSortByCategory:
+		value = keys.CategoryName
+	default:
+		value = keys.Date.Format(time.RFC3339Nano)
+	}
+	return TransactionCursor{Sort: sort, Value: value, CreatedAt: createdAt, ID: id}
+}
+
+// transactionCursorToken is the JSON form of a cursor inside its token.
+type transactionCursorToken struct {
+	Field     TransactionSortField `json:"f"`
+	Direction SortDirection        `json:"d"`
+	Value     string               `json:"v"`
+	CreatedAt time.Time            `json:"c"`
+	ID        uuid.UUID            `json:"i"`
+}
+
+// Encode returns the opaque token of the cursor.
+func (c TransactionCursor) Encode() string {
+	data, _ := json.Marshal(transactionCursorToken{
+		Field:     c.Sort.Field,
+		Direction: c.Sort.Direction,
+		Value:     c.Value,
+		CreatedAt: c.CreatedAt,
+		ID:        c.ID,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeTransactionCursor parses a token returned by Encode.
+func DecodeTransactionCursor(token string) (*TransactionCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidTransactionCursor
+	}
+
+	var decoded transactionCursorToken
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, ErrInvalidTransactionCursor
+	}
+
+	sort, err := ParseTransactionSort(string(decoded.Field), string(decoded.Direction))
+	if err != nil || decoded.Field == "" || decoded.Direction == "" || decoded.ID == uuid.Nil {
+		return nil, ErrInvalidTransactionCursor
+	}
+	switch sort.Field {
+	case TransactionSortByDate:
+		if _, err := time.Parse(time.RFC3339Nano, decoded.Value); err != nil {
+			return nil, ErrInvalidTransactionCursor
+		}
+	case TransactionSortByAmount:
+		if _, err := decimal.NewFromString(decoded.Value); err != nil {
+			return nil, ErrInvalidTransactionCursor
+		}
+	}
+
+	return &TransactionCursor{
+		Sort:      sort,
+		Value:     decoded.Value,
+		CreatedAt: decoded.CreatedAt,
+		ID:        decoded.ID,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package valueobject
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestParseTransactionSort(t *testing.T) {
+	tests := []struct {
+		field     string
+		direction string
+		expected  TransactionSort
+	}{
+		{"", "", DefaultTransactionSort()},
+		{"amount", "", TransactionSort{Field: TransactionSortByAmount, Direction: SortDescending}},
+		{"description", "", TransactionSort{Field: TransactionSortByDescription, Direction: SortAscending}},
+		{"category", "desc", TransactionSort{Field: TransactionSortByCategory, Direction: SortDescending}},
+		{"", "asc", TransactionSort{Field: TransactionSortByDate, Direction: SortAscending}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field+"/"+tt.direction, func(t *testing.T) {
+			sort, err := ParseTransactionSort(tt.field, tt.direction)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if sort != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, sort)
+			}
+		})
+	}
+
+	if _, err := ParseTransactionSort("payee", ""); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+	if _, err := ParseTransactionSort("date", "up"); err == nil {
+		t.Error("expected an error for an unknown direction")
+	}
+}
+
+func TestTransactionCursor_RoundTrip(t *testing.T) {
+	cursor := TransactionCursor{
+		Sort:      TransactionSort{Field: TransactionSortByAmount, Direction: SortAscending},
+		Value:     "49.9",
+		CreatedAt: time.Date(2025, 11, 5, 10, 30, 0, 123456000, time.UTC),
+		ID:        uuid.New(),
+	}
+
+	decoded, err := DecodeTransactionCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.Sort != cursor.Sort || decoded.Value != cursor.Value || decoded.ID != cursor.ID ||
+		!decoded.CreatedAt.Equal(cursor.CreatedAt) {
+		t.Errorf("expected %+v, got %+v", cursor, decoded)
+	}
+}
+
+func TestDecodeTransactionCursor_Invalid(t *testing.T) {
+	encode := func(json string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(json))
+	}
+	id := uuid.New().String()
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"not base64", "%%%"},
+		{"not json", encode("cursor")},
+		{"unknown field", encode(`{"f":"payee","d":"asc","v":"x","i":"` + id + `"}`)},
+		{"missing direction", encode(`{"f":"date","v":"2025-11-05T00:00:00Z","i":"` + id + `"}`)},
+		{"missing id", encode(`{"f":"description","d":"asc","v":"x"}`)},
+		{"invalid date", encode(`{"f":"date","d":"desc","v":"yesterday","i":"` + id + `"}`)},
+		{"invalid amount", encode(`{"f":"amount","d":"desc","v":"lots","i":"` + id + `"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeTransactionCursor(tt.token); err != ErrInvalidTransactionCursor {
+				t.Errorf("expected ErrInvalidTransactionCursor, got %v", err)
+			}
+		})
+	}
+}
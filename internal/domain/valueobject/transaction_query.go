@@ -0,0 +1,396 @@
+// Package valueobject contains domain value objects for the Finance Tracker system.
+package valueobject
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+const (
+	// MaxTransactionQueryLength is the maximum length of a search query, in characters.
+	MaxTransactionQueryLength = 500
+
+	// MaxTransactionQueryConditions is the maximum number of terms and filters in a search query.
+	MaxTransactionQueryConditions = 20
+)
+
+// TransactionQueryField is what a search query condition applies to.
+type TransactionQueryField string
+
+const (
+	TransactionQueryFieldText        TransactionQueryField = "text" // Description or notes
+	TransactionQueryFieldDescription TransactionQueryField = "description"
+	TransactionQueryFieldNotes       TransactionQueryField = "notes"
+	TransactionQueryFieldAmount      TransactionQueryField = "amount"
+	TransactionQueryFieldDate        TransactionQueryField = "date"
+	TransactionQueryFieldCategory    TransactionQueryField = "category"
+	TransactionQueryFieldAccount     TransactionQueryField = "account"
+	TransactionQueryFieldTag         TransactionQueryField = "tag"
+	TransactionQueryFieldType        TransactionQueryField = "type"
+)
+
+// transactionQueryFields maps the field names accepted in queries to their fields.
+var transactionQueryFields = map[string]TransactionQueryField{
+	"description": TransactionQueryFieldDescription,
+	"desc":        TransactionQueryFieldDescription,
+	"notes":       TransactionQueryFieldNotes,
+	"amount":      TransactionQueryFieldAmount,
+	"date":        TransactionQueryFieldDate,
+	"category":    TransactionQueryFieldCategory,
+	"account":     TransactionQueryFieldAccount,
+	"tag":         TransactionQueryFieldTag,
+	"type":        TransactionQueryFieldType,
+}
+
+// TransactionQueryOperator compares amounts and dates.
+type TransactionQueryOperator string
+
+const (
+	TransactionQueryOperatorEqual          TransactionQueryOperator = "="
+	TransactionQueryOperatorGreater        TransactionQueryOperator = ">"
+	TransactionQueryOperatorGreaterOrEqual TransactionQueryOperator = ">="
+	TransactionQueryOperatorLess           TransactionQueryOperator = "<"
+	TransactionQueryOperatorLessOrEqual    TransactionQueryOperator = "<="
+	TransactionQueryOperatorBetween        TransactionQueryOperator = ".." // Both bounds inclusive
+)
+
+// TransactionQueryCondition is a single term or filter of a search query.
+type TransactionQueryCondition struct {
+	Field    TransactionQueryField
+	Negated  bool   // The condition must not match
+	Text     string // Text to search for, or the category, account or tag name
+	Phrase   bool   // The text was quoted and must match as a phrase
+	Type     entity.TransactionType
+	Operator TransactionQueryOperator
+	Amount   decimal.Decimal // Compared against the absolute transaction amount
+	AmountTo decimal.Decimal // Upper bound of a between condition
+	Date     time.Time
+	DateTo   time.Time // Last day of a between condition
+}
+
+// TransactionQuery is a parsed transaction search query. All of its conditions must match.
+type TransactionQuery struct {
+	Conditions []TransactionQueryCondition
+}
+
+// IsEmpty returns true if the query has no conditions.
+func (q *TransactionQuery) IsEmpty() bool {
+	return q == nil || len(q.Conditions) == 0
+}
+
+// TransactionQuerySyntaxError describes why a search query could not be parsed.
+type TransactionQuerySyntaxError struct {
+	Position int // 1-based position of the offending character
+	Message  string
+}
+
+// Error implements the error interface.
+func (e *TransactionQuerySyntaxError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Position)
+}
+
+// ParseTransactionQuery parses a transaction search query.
+//
+// A query is a list of space-separated conditions that must all match:
+//   - words and "quoted phrases" search the description and notes, ignoring case and accents
+//   - field:value filters on a field, e.g. category:Mercado, account:"Nubank Card", tag:trip,
+//     type:expense, notes:"viagem" or description:uber
+//   - amount:>100, amount:<=50,00 or amount:100..200 compare the absolute amount
+//   - date:2024-11-05, date:>=2024-11-01, date:2024-11 or date:2024-10..2024-12 filter by date
+//   - a leading - negates a condition, e.g. -uber or -category:Transporte
+func ParseTransactionQuery(input string) (*TransactionQuery, error) {
+	runes := []rune(input)
+	if len(runes) > MaxTransactionQueryLength {
+		return nil, &TransactionQuerySyntaxError{
+			Position: MaxTransactionQueryLength + 1,
+			Message:  fmt.Sprintf("query must not exceed %d characters", MaxTransactionQueryLength),
+		}
+	}
+
+	p := &transactionQueryParser{runes: runes}
+	query := &TransactionQuery{}
+	for {
+		p.skipSpaces()
+		if p.done() {
+			return query, nil
+		}
+		if len(query.Conditions) == MaxTransactionQueryConditions {
+			return nil, p.errorAt(p.pos, fmt.Sprintf("query must not have more than %d conditions", MaxTransactionQueryConditions))
+		}
+
+		condition, err := p.parseCondition()
+		if err != nil {
+			return nil, err
+		}
+		query.Conditions = append(query.Conditions, condition)
+	}
+}
+
+// transactionQueryParser walks the runes of a query.
+type transactionQueryParser struct {
+	runes []rune
+	pos   int
+}
+
+func (p *transactionQueryParser) done() bool {
+	return p.pos >= len(p.runes)
+}
+
+func (p *transactionQueryParser) skipSpaces() {
+	for !p.done() && unicode.IsSpace(p.runes[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *transactionQueryParser) errorAt(pos int, message string) error {
+	return &TransactionQuerySyntaxError{Position: pos + 1, Message: message}
+}
+
+// parseCondition parses [-](field:value | "phrase" | word).
+func (p *transactionQueryParser) parseCondition() (TransactionQueryCondition, error) {
+	condition := TransactionQueryCondition{Field: TransactionQueryFieldText}
+
+	if p.runes[p.pos] == '-' {
+		condition.Negated = true
+		p.pos++
+		if p.done() || unicode.IsSpace(p.runes[p.pos]) {
+			return condition, p.errorAt(p.pos-1, "expected a word, phrase or filter after '-'")
+		}
+	}
+
+	start := p.pos
+	if name, ok := p.fieldName(); ok {
+		field, known := transactionQueryFields[strings.ToLower(name)]
+		if !known {
+			return condition, p.errorAt(start, fmt.Sprintf(
+				"unknown field %q; expected one of %s (quote text that contains ':')",
+				name, strings.Join(transactionQueryFieldNames(), ", ")))
+		}
+		p.pos += len([]rune(name)) + 1
+
+		valueStart := p.pos
+		value, phrase, err := p.value()
+		if err != nil {
+			return condition, err
+		}
+		if value == "" {
+			return condition, p.errorAt(valueStart, fmt.Sprintf("missing value for %q", name))
+		}
+
+		condition.Field = field
+		condition.Phrase = phrase
+		if err := condition.setValue(value); err != nil {
+			return condition, p.errorAt(valueStart, err.Error())
+		}
+		return condition, nil
+	}
+
+	value, phrase, err := p.value()
+	if err != nil {
+		return condition, err
+	}
+	condition.Text = value
+	condition.Phrase = phrase
+	return condition, nil
+}
+
+// fieldName returns the field name of a field:value condition at the current position.
+// Only ASCII letters form field names, so text like 12:30 is searched as is.
+func (p *transactionQueryParser) fieldName() (string, bool) {
+	end := p.pos
+	for end < len(p.runes) && p.runes[end] < unicode.MaxASCII && unicode.IsLetter(p.runes[end]) {
+		end++
+	}
+	if end == p.pos || end >= len(p.runes) || p.runes[end] != ':' {
+		return "", false
+	}
+	return string(p.runes[p.pos:end]), true
+}
+
+// value reads a quoted phrase or a word, which ends at the next space.
+func (p *transactionQueryParser) value() (string, bool, error) {
+	if p.done() || unicode.IsSpace(p.runes[p.pos]) {
+		return "", false, nil
+	}
+
+	if p.runes[p.pos] != '"' {
+		start := p.pos
+		for !p.done() && !unicode.IsSpace(p.runes[p.pos]) {
+			p.pos++
+		}
+		return string(p.runes[start:p.pos]), false, nil
+	}
+
+	quote := p.pos
+	p.pos++
+	start := p.pos
+	for !p.done() && p.runes[p.pos] != '"' {
+		p.pos++
+	}
+	if p.done() {
+		return "", false, p.errorAt(quote, "unterminated quote")
+	}
+	phrase := strings.TrimSpace(string(p.runes[start:p.pos]))
+	p.pos++
+
+	if phrase == "" {
+		return "", false, p.errorAt(quote, "empty quotes")
+	}
+	if !p.done() && !unicode.IsSpace(p.runes[p.pos]) {
+		return "", false, p.errorAt(p.pos, "expected a space after the closing quote")
+	}
+	return phrase, true, nil
+}
+
+// setValue parses the value of a field:value condition.
+func (c *TransactionQueryCondition) setValue(value string) error {
+	switch c.Field {
+	case TransactionQueryFieldAmount:
+		return c.setAmount(value)
+	case TransactionQueryFieldDate:
+		return c.setDate(value)
+	case TransactionQueryFieldType:
+		c.Type = entity.TransactionType(strings.ToLower(value))
+		switch c.Type {
+		case entity.TransactionTypeIncome, entity.TransactionTypeExpense, entity.TransactionTypeTransfer:
+			return nil
+		}
+		return fmt.Errorf("invalid type %q; expected income, expense or transfer", value)
+	default:
+		c.Text = value
+		return nil
+	}
+}
+
+// setAmount parses amount values such as 100, >100, <=50,90 or 100..200.
+func (c *TransactionQueryCondition) setAmount(value string) error {
+	operator, rest := splitQueryOperator(value)
+
+	if from, to, ok := strings.Cut(rest, ".."); ok {
+		if operator != "" {
+			return fmt.Errorf("a range cannot have a comparison operator")
+		}
+		amount, err := parseQueryAmount(from)
+		if err != nil {
+			return err
+		}
+		amountTo, err := parseQueryAmount(to)
+		if err != nil {
+			return err
+		}
+		if amount.GreaterThan(amountTo) {
+			return fmt.Errorf("amount range %q starts after it ends", value)
+		}
+		c.Operator, c.Amount, c.AmountTo = TransactionQueryOperatorBetween, amount, amountTo
+		return nil
+	}
+
+	amount, err := parseQueryAmount(rest)
+	if err != nil {
+		return err
+	}
+	c.Operator, c.Amount = operator, amount
+	if c.Operator == "" {
+		c.Operator = TransactionQueryOperatorEqual
+	}
+	return nil
+}
+
+// setDate parses date values such as 2024-11-05, >=2024-11-01, 2024-11 or 2024-10..2024-12.
+// A month stands for all of its days.
+func (c *TransactionQueryCondition) setDate(value string) error {
+	operator, rest := splitQueryOperator(value)
+
+	if from, to, ok := strings.Cut(rest, ".."); ok {
+		if operator != "" {
+			return fmt.Errorf("a range cannot have a comparison operator")
+		}
+		start, _, err := parseQueryDate(from)
+		if err != nil {
+			return err
+		}
+		_, end, err := parseQueryDate(to)
+		if err != nil {
+			return err
+		}
+		if start.After(end) {
+			return fmt.Errorf("date range %q starts after it ends", value)
+		}
+		c.Operator, c.Date, c.DateTo = TransactionQueryOperatorBetween, start, end
+		return nil
+	}
+
+	start, end, err := parseQueryDate(rest)
+	if err != nil {
+		return err
+	}
+
+	switch operator {
+	case "", TransactionQueryOperatorEqual:
+		c.Operator, c.Date, c.DateTo = TransactionQueryOperatorBetween, start, end
+	case TransactionQueryOperatorGreater, TransactionQueryOperatorLessOrEqual:
+		c.Operator, c.Date = operator, end
+	default:
+		c.Operator, c.Date = operator, start
+	}
+	return nil
+}
+
+// splitQueryOperator splits a leading comparison operator from a value.
+func splitQueryOperator(value string) (TransactionQueryOperator, string) {
+	for _, operator := range []TransactionQueryOperator{
+		TransactionQueryOperatorGreaterOrEqual,
+		TransactionQueryOperatorLessOrEqual,
+		TransactionQueryOperatorGreater,
+		TransactionQueryOperatorLess,
+		TransactionQueryOperatorEqual,
+	} {
+		if strings.HasPrefix(value, string(operator)) {
+			return operator, value[len(operator):]
+		}
+	}
+	return "", value
+}
+
+// parseQueryAmount parses an amount written with a dot or a comma as the decimal separator.
+func parseQueryAmount(value string) (decimal.Decimal, error) {
+	normalized := value
+	if !strings.Contains(normalized, ".") {
+		normalized = strings.Replace(normalized, ",", ".", 1)
+	}
+	amount, err := decimal.NewFromString(normalized)
+	if err != nil || normalized == "" {
+		return decimal.Zero, fmt.Errorf("invalid amount %q; use a number like 100 or 49,90", value)
+	}
+	return amount.Abs(), nil
+}
+
+// parseQueryDate parses a YYYY-MM-DD day or a YYYY-MM month, returning its first and last day.
+func parseQueryDate(value string) (time.Time, time.Time, error) {
+	if day, err := time.Parse("2006-01-02", value); err == nil {
+		return day, day, nil
+	}
+	if month, err := time.Parse("2006-01", value); err == nil {
+		return month, month.AddDate(0, 1, -1), nil
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("invalid date %q; use YYYY-MM-DD or YYYY-MM", value)
+}
+
+// transactionQueryFieldNames returns the sorted field names accepted in queries.
+func transactionQueryFieldNames() []string {
+	names := make([]string, 0, len(transactionQueryFields))
+	for name, field := range transactionQueryFields {
+		if string(field) == name {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,132 @@
+package valueobject
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+func TestParseTransactionQuery(t *testing.T) {
+	query, err := ParseTransactionQuery(`amount:>100 category:Mercado -uber notes:"viagem de férias" café`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(query.Conditions) != 5 {
+		t.Fatalf("expected 5 conditions, got %d: %+v", len(query.Conditions), query.Conditions)
+	}
+
+	amount := query.Conditions[0]
+	if amount.Field != TransactionQueryFieldAmount || amount.Operator != TransactionQueryOperatorGreater || !amount.Amount.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("unexpected amount condition %+v", amount)
+	}
+	category := query.Conditions[1]
+	if category.Field != TransactionQueryFieldCategory || category.Text != "Mercado" || category.Negated {
+		t.Errorf("unexpected category condition %+v", category)
+	}
+	negated := query.Conditions[2]
+	if negated.Field != TransactionQueryFieldText || negated.Text != "uber" || !negated.Negated {
+		t.Errorf("unexpected negated condition %+v", negated)
+	}
+	notes := query.Conditions[3]
+	if notes.Field != TransactionQueryFieldNotes || notes.Text != "viagem de férias" || !notes.Phrase {
+		t.Errorf("unexpected notes condition %+v", notes)
+	}
+	word := query.Conditions[4]
+	if word.Field != TransactionQueryFieldText || word.Text != "café" || word.Phrase {
+		t.Errorf("unexpected word condition %+v", word)
+	}
+}
+
+func TestParseTransactionQuery_Values(t *testing.T) {
+	day := func(value string) time.Time {
+		parsed, _ := time.Parse("2006-01-02", value)
+		return parsed
+	}
+
+	tests := []struct {
+		query    string
+		expected TransactionQueryCondition
+	}{
+		{"amount:49,90", TransactionQueryCondition{Field: TransactionQueryFieldAmount, Operator: TransactionQueryOperatorEqual, Amount: decimal.RequireFromString("49.90")}},
+		{"amount:<=-50", TransactionQueryCondition{Field: TransactionQueryFieldAmount, Operator: TransactionQueryOperatorLessOrEqual, Amount: decimal.NewFromInt(50)}},
+		{"amount:100..200", TransactionQueryCondition{Field: TransactionQueryFieldAmount, Operator: TransactionQueryOperatorBetween, Amount: decimal.NewFromInt(100), AmountTo: decimal.NewFromInt(200)}},
+		{"date:2024-11-05", TransactionQueryCondition{Field: TransactionQueryFieldDate, Operator: TransactionQueryOperatorBetween, Date: day("2024-11-05"), DateTo: day("2024-11-05")}},
+		{"date:2024-02", TransactionQueryCondition{Field: TransactionQueryFieldDate, Operator: TransactionQueryOperatorBetween, Date: day("2024-02-01"), DateTo: day("2024-02-29")}},
+		{"date:>2024-11", TransactionQueryCondition{Field: TransactionQueryFieldDate, Operator: TransactionQueryOperatorGreater, Date: day("2024-11-30")}},
+		{"date:<2024-11", TransactionQueryCondition{Field: TransactionQueryFieldDate, Operator: TransactionQueryOperatorLess, Date: day("2024-11-01")}},
+		{"date:2024-10..2024-12-15", TransactionQueryCondition{Field: TransactionQueryFieldDate, Operator: TransactionQueryOperatorBetween, Date: day("2024-10-01"), DateTo: day("2024-12-15")}},
+		{"TYPE:Income", TransactionQueryCondition{Field: TransactionQueryFieldType, Type: entity.TransactionTypeIncome}},
+		{"-account:\"Nubank Card\"", TransactionQueryCondition{Field: TransactionQueryFieldAccount, Negated: true, Text: "Nubank Card", Phrase: true}},
+		{"desc:ifood", TransactionQueryCondition{Field: TransactionQueryFieldDescription, Text: "ifood"}},
+		{"12:30", TransactionQueryCondition{Field: TransactionQueryFieldText, Text: "12:30"}},
+		{"pre-paid", TransactionQueryCondition{Field: TransactionQueryFieldText, Text: "pre-paid"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, err := ParseTransactionQuery(tt.query)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(query.Conditions) != 1 {
+				t.Fatalf("expected 1 condition, got %+v", query.Conditions)
+			}
+			got := query.Conditions[0]
+			if got.Field != tt.expected.Field || got.Negated != tt.expected.Negated || got.Text != tt.expected.Text ||
+				got.Phrase != tt.expected.Phrase || got.Type != tt.expected.Type || got.Operator != tt.expected.Operator ||
+				!got.Amount.Equal(tt.expected.Amount) || !got.AmountTo.Equal(tt.expected.AmountTo) ||
+				!got.Date.Equal(tt.expected.Date) || !got.DateTo.Equal(tt.expected.DateTo) {
+				t.Errorf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseTransactionQuery_Empty(t *testing.T) {
+	for _, input := range []string{"", "   "} {
+		query, err := ParseTransactionQuery(input)
+		if err != nil || !query.IsEmpty() {
+			t.Errorf("expected %q to parse to an empty query, got %+v, %v", input, query, err)
+		}
+	}
+}
+
+func TestParseTransactionQuery_Errors(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{`notes:"viagem`, "unterminated quote at position 7"},
+		{`colour:red`, `unknown field "colour"; expected one of account, amount, category, date, description, notes, tag, type (quote text that contains ':') at position 1`},
+		{`uber -`, "expected a word, phrase or filter after '-' at position 6"},
+		{`amount:`, `missing value for "amount" at position 8`},
+		{`amount:>abc`, `invalid amount "abc"; use a number like 100 or 49,90 at position 8`},
+		{`amount:>10..20`, "a range cannot have a comparison operator at position 8"},
+		{`amount:200..100`, `amount range "200..100" starts after it ends at position 8`},
+		{`date:05/11/2024`, `invalid date "05/11/2024"; use YYYY-MM-DD or YYYY-MM at position 6`},
+		{`type:refund`, `invalid type "refund"; expected income, expense or transfer at position 6`},
+		{`""`, "empty quotes at position 1"},
+		{`"uber"eats`, "expected a space after the closing quote at position 7"},
+		{strings.Repeat("a ", MaxTransactionQueryConditions+1), "query must not have more than 20 conditions at position 41"},
+		{strings.Repeat("a", MaxTransactionQueryLength+1), "query must not exceed 500 characters at position 501"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := ParseTransactionQuery(tt.query)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if _, ok := err.(*TransactionQuerySyntaxError); !ok {
+				t.Errorf("expected a TransactionQuerySyntaxError, got %T", err)
+			}
+			if !strings.HasPrefix(err.Error(), tt.expected) {
+				t.Errorf("expected error starting with %q, got %q", tt.expected, err.Error())
+			}
+		})
+	}
+}
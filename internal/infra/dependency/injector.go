@@ -7,22 +7,36 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/finance-tracker/backend/config"
+	"github.com/finance-tracker/backend/internal/application/adapter"
 	aicategorization "github.com/finance-tracker/backend/internal/application/usecase/ai_categorization"
+	"github.com/finance-tracker/backend/internal/application/usecase/account"
+	"github.com/finance-tracker/backend/internal/application/usecase/attachment"
 	"github.com/finance-tracker/backend/internal/application/usecase/auth"
 	"github.com/finance-tracker/backend/internal/application/usecase/category"
 	categoryrule "github.com/finance-tracker/backend/internal/application/usecase/category_rule"
 	creditcard "github.com/finance-tracker/backend/internal/application/usecase/credit_card"
 	"github.com/finance-tracker/backend/internal/application/usecase/dashboard"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
 	"github.com/finance-tracker/backend/internal/application/usecase/goal"
 	"github.com/finance-tracker/backend/internal/application/usecase/group"
+	importprofile "github.com/finance-tracker/backend/internal/application/usecase/import_profile"
+	"github.com/finance-tracker/backend/internal/application/usecase/installment"
+	"github.com/finance-tracker/backend/internal/application/usecase/merchant"
 	"github.com/finance-tracker/backend/internal/application/usecase/reconciliation"
+	recurringschedule "github.com/finance-tracker/backend/internal/application/usecase/recurring_schedule"
+	"github.com/finance-tracker/backend/internal/application/usecase/tag"
 	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
+	"github.com/finance-tracker/backend/internal/application/usecase/transfer"
+	"github.com/finance-tracker/backend/internal/application/usecase/trash"
 	"github.com/finance-tracker/backend/internal/infra/server/router"
 	"github.com/finance-tracker/backend/internal/integration/adapters"
 	"github.com/finance-tracker/backend/internal/integration/email"
 	"github.com/finance-tracker/backend/internal/integration/entrypoint/controller"
 	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
+	"github.com/finance-tracker/backend/internal/integration/export"
 	"github.com/finance-tracker/backend/internal/integration/persistence"
+	"github.com/finance-tracker/backend/internal/integration/statement"
+	"github.com/finance-tracker/backend/internal/integration/storage"
 )
 
 // Injector holds all application dependencies.
@@ -39,17 +53,38 @@ func NewInjector(cfg *config.Config, db *gorm.DB) *Injector {
 	tokenRepo := persistence.NewTokenRepository(db)
 	categoryRepo := persistence.NewCategoryRepository(db)
 	transactionRepo := persistence.NewTransactionRepository(db)
+	transactionChangeRepo := persistence.NewTransactionChangeRepository(db)
+	txManager := persistence.NewTxManager(db)
 	goalRepo := persistence.NewGoalRepository(db)
+	goalContributionRepo := persistence.NewGoalContributionRepository(db)
 	groupRepo := persistence.NewGroupRepository(db)
 	categoryRuleRepo := persistence.NewCategoryRuleRepository(db)
 	emailQueueRepo := persistence.NewEmailQueueRepository(db)
 	aiSuggestionRepo := persistence.NewAISuggestionRepository(db)
+	importProfileRepo := persistence.NewImportProfileRepository(db)
+	duplicateDismissalRepo := persistence.NewDuplicateDismissalRepository(db)
+	recurringScheduleRepo := persistence.NewRecurringScheduleRepository(db)
+	accountRepo := persistence.NewAccountRepository(db)
+	billingCycleOverrideRepo := persistence.NewBillingCycleOverrideRepository(db)
+	exchangeRateRepo := persistence.NewExchangeRateRepository(db)
+	tagRepo := persistence.NewTagRepository(db)
+	merchantRepo := persistence.NewMerchantRepository(db)
+	installmentPlanRepo := persistence.NewInstallmentPlanRepository(db)
+	attachmentRepo := persistence.NewAttachmentRepository(db)
+	trashRepo := persistence.NewTrashRepository(db)
 
 	// Create adapters/services
 	passwordService := adapters.NewPasswordService()
 	tokenService := adapters.NewTokenService(cfg.JWT.Secret, tokenRepo)
 	resetTokenService := adapters.NewPasswordResetTokenService(tokenRepo)
 	geminiService := adapters.NewGeminiService(cfg.AI.GeminiAPIKey)
+	csvParser := statement.NewCSVParser()
+	exchangeRateParser := statement.NewExchangeRateParser()
+	cardStatementParser := statement.NewCardStatementParser(statement.DefaultCardStatementLayouts()...)
+	currencyConverter := exchangerate.NewConverter(exchangeRateRepo, userRepo)
+	merchantResolver := merchant.NewResolver(merchantRepo)
+	installmentTracker := installment.NewTracker(installmentPlanRepo)
+	calendarLoader := account.NewCalendarLoader(billingCycleOverrideRepo)
 
 	// Create email service for queueing
 	emailService := email.NewService(emailQueueRepo, cfg.Email.AppBaseURL)
@@ -64,27 +99,66 @@ func NewInjector(cfg *config.Config, db *gorm.DB) *Injector {
 	logoutUseCase := auth.NewLogoutUserUseCase(tokenService)
 	forgotPasswordUseCase := auth.NewForgotPasswordUseCase(userRepo, resetTokenService, emailService, cfg.Email.AppBaseURL)
 	resetPasswordUseCase := auth.NewResetPasswordUseCase(userRepo, passwordService, resetTokenService)
-	deleteAccountUseCase := auth.NewDeleteAccountUseCase(userRepo, passwordService, tokenService)
+	deleteAccountUseCase := auth.NewDeleteAccountUseCase(userRepo, passwordService, tokenService, nil)
 
 	// Create category use cases
 	listCategoriesUseCase := category.NewListCategoriesUseCase(categoryRepo)
 	createCategoryUseCase := category.NewCreateCategoryUseCase(categoryRepo)
 	updateCategoryUseCase := category.NewUpdateCategoryUseCase(categoryRepo)
-	deleteCategoryUseCase := category.NewDeleteCategoryUseCase(categoryRepo)
+	deleteCategoryUseCase := category.NewDeleteCategoryUseCase(categoryRepo, transactionRepo, transactionChangeRepo)
 
 	// Create transaction use cases
-	listTransactionsUseCase := transaction.NewListTransactionsUseCase(transactionRepo)
-	createTransactionUseCase := transaction.NewCreateTransactionUseCase(transactionRepo, categoryRepo, categoryRuleRepo)
-	updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(transactionRepo, categoryRepo)
-	deleteTransactionUseCase := transaction.NewDeleteTransactionUseCase(transactionRepo)
-	bulkDeleteTransactionsUseCase := transaction.NewBulkDeleteTransactionsUseCase(transactionRepo)
-	bulkCategorizeTransactionsUseCase := transaction.NewBulkCategorizeTransactionsUseCase(transactionRepo, categoryRepo)
+	listTransactionsUseCase := transaction.NewListTransactionsUseCase(transactionRepo, accountRepo)
+	createTransactionUseCase := transaction.NewCreateTransactionUseCase(transactionRepo, transactionChangeRepo, categoryRepo, categoryRuleRepo, accountRepo, nil, currencyConverter, merchantResolver, calendarLoader)
+	updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(transactionRepo, transactionChangeRepo, categoryRepo, accountRepo, nil, currencyConverter)
+	deleteTransactionUseCase := transaction.NewDeleteTransactionUseCase(transactionRepo, transactionChangeRepo, nil)
+	bulkDeleteTransactionsUseCase := transaction.NewBulkDeleteTransactionsUseCase(transactionRepo, transactionChangeRepo, nil)
+	bulkCategorizeTransactionsUseCase := transaction.NewBulkCategorizeTransactionsUseCase(transactionRepo, transactionChangeRepo, categoryRepo)
+	listDuplicatesUseCase := transaction.NewListDuplicatesUseCase(transactionRepo, duplicateDismissalRepo)
+	mergeDuplicateUseCase := transaction.NewMergeDuplicateUseCase(transactionRepo, transactionChangeRepo, attachmentRepo, txManager)
+	dismissDuplicateUseCase := transaction.NewDismissDuplicateUseCase(transactionRepo, duplicateDismissalRepo)
+	splitTransactionUseCase := transaction.NewSplitTransactionUseCase(transactionRepo, transactionChangeRepo, categoryRepo, nil)
+	unsplitTransactionUseCase := transaction.NewUnsplitTransactionUseCase(transactionRepo, transactionChangeRepo, nil)
+	bulkTagTransactionsUseCase := transaction.NewBulkTagTransactionsUseCase(transactionRepo, transactionChangeRepo, tagRepo)
+	getTransactionHistoryUseCase := transaction.NewGetTransactionHistoryUseCase(transactionRepo, transactionChangeRepo)
+	revertTransactionChangeUseCase := transaction.NewRevertTransactionChangeUseCase(transactionRepo, transactionChangeRepo, nil)
+	revertTransactionOperationUseCase := transaction.NewRevertTransactionOperationUseCase(transactionRepo, transactionChangeRepo, nil)
+	bulkUpdateTransactionsUseCase := transaction.NewBulkUpdateTransactionsUseCase(transactionRepo, transactionChangeRepo, categoryRepo, currencyConverter, nil)
+	exportTransactionsUseCase := transaction.NewExportTransactionsUseCase(transactionRepo, userRepo, categoryRepo, accountRepo, map[adapter.ExportFormat]adapter.TransactionExporter{
+		adapter.ExportFormatCSV:  export.NewCSVExporter(),
+		adapter.ExportFormatXLSX: export.NewXLSXExporter(),
+		adapter.ExportFormatOFX:  export.NewOFXExporter(),
+	})
+	importStatementUseCase := transaction.NewImportStatementUseCase(transactionRepo, transactionChangeRepo, txManager, categoryRepo, categoryRuleRepo, nil, currencyConverter, merchantResolver, installmentTracker)
+	previewCSVImportUseCase := transaction.NewPreviewCSVImportUseCase(transactionRepo, categoryRepo, categoryRuleRepo, importProfileRepo, userRepo, csvParser)
+	importCSVUseCase := transaction.NewImportCSVUseCase(transactionRepo, transactionChangeRepo, txManager, categoryRepo, categoryRuleRepo, importProfileRepo, userRepo, csvParser, nil, currencyConverter, merchantResolver, installmentTracker)
+
+	// Create import profile use cases
+	listImportProfilesUseCase := importprofile.NewListImportProfilesUseCase(importProfileRepo)
+	createImportProfileUseCase := importprofile.NewCreateImportProfileUseCase(importProfileRepo)
+	updateImportProfileUseCase := importprofile.NewUpdateImportProfileUseCase(importProfileRepo)
+	deleteImportProfileUseCase := importprofile.NewDeleteImportProfileUseCase(importProfileRepo)
+
+	// Create recurring schedule use cases
+	listRecurringSchedulesUseCase := recurringschedule.NewListRecurringSchedulesUseCase(recurringScheduleRepo, categoryRepo)
+	getRecurringScheduleUseCase := recurringschedule.NewGetRecurringScheduleUseCase(recurringScheduleRepo, categoryRepo)
+	createRecurringScheduleUseCase := recurringschedule.NewCreateRecurringScheduleUseCase(recurringScheduleRepo, categoryRepo)
+	updateRecurringScheduleUseCase := recurringschedule.NewUpdateRecurringScheduleUseCase(recurringScheduleRepo, categoryRepo)
+	deleteRecurringScheduleUseCase := recurringschedule.NewDeleteRecurringScheduleUseCase(recurringScheduleRepo)
 
 	// Create credit card use cases
 	previewImportUseCase := creditcard.NewPreviewImportUseCase(transactionRepo)
-	importTransactionsUseCase := creditcard.NewImportTransactionsUseCase(transactionRepo, categoryRepo, categoryRuleRepo)
-	collapseExpansionUseCase := creditcard.NewCollapseExpansionUseCase(transactionRepo)
+	importTransactionsUseCase := creditcard.NewImportTransactionsUseCase(transactionRepo, transactionChangeRepo, txManager, categoryRepo, categoryRuleRepo, accountRepo, nil, currencyConverter, merchantResolver, installmentTracker, calendarLoader)
+	collapseExpansionUseCase := creditcard.NewCollapseExpansionUseCase(transactionRepo, transactionChangeRepo)
 	getStatusUseCase := creditcard.NewGetStatusUseCase(transactionRepo)
+	getForecastUseCase := creditcard.NewGetForecastUseCase(
+		transactionRepo,
+		accountRepo,
+		calendarLoader,
+		installment.NewGetCommitmentsUseCase(installmentPlanRepo, currencyConverter),
+		currencyConverter,
+	)
+	parseStatementPDFUseCase := creditcard.NewParseStatementPDFUseCase(accountRepo, calendarLoader, cardStatementParser)
 
 	// Create reconciliation repository and use cases
 	reconciliationRepo := persistence.NewReconciliationRepository(db)
@@ -95,12 +169,21 @@ func NewInjector(cfg *config.Config, db *gorm.DB) *Injector {
 	unlinkUseCase := reconciliation.NewUnlinkUseCase(reconciliationRepo)
 	triggerReconciliationUseCase := reconciliation.NewTriggerReconciliationUseCase(reconciliationRepo)
 
+	// Create journal export use case; bill payments are matched to billing cycles through reconciliation
+	exportJournalUseCase := transaction.NewExportJournalUseCase(transactionRepo, userRepo, categoryRepo, accountRepo, reconciliationRepo, map[adapter.JournalFormat]adapter.JournalExporter{
+		adapter.JournalFormatBeancount: export.NewBeancountExporter(),
+		adapter.JournalFormatHledger:   export.NewHledgerExporter(),
+	})
+
 	// Create goal use cases
-	listGoalsUseCase := goal.NewListGoalsUseCase(goalRepo, categoryRepo)
+	listGoalsUseCase := goal.NewListGoalsUseCase(goalRepo, categoryRepo, goalContributionRepo)
 	createGoalUseCase := goal.NewCreateGoalUseCase(goalRepo, categoryRepo)
-	getGoalUseCase := goal.NewGetGoalUseCase(goalRepo, categoryRepo)
+	getGoalUseCase := goal.NewGetGoalUseCase(goalRepo, categoryRepo, goalContributionRepo)
 	updateGoalUseCase := goal.NewUpdateGoalUseCase(goalRepo)
 	deleteGoalUseCase := goal.NewDeleteGoalUseCase(goalRepo)
+	listGoalContributionsUseCase := goal.NewListGoalContributionsUseCase(goalRepo, goalContributionRepo)
+	addGoalContributionUseCase := goal.NewAddGoalContributionUseCase(goalRepo, goalContributionRepo, transactionRepo)
+	deleteGoalContributionUseCase := goal.NewDeleteGoalContributionUseCase(goalRepo, goalContributionRepo)
 
 	// Create group use cases
 	createGroupUseCase := group.NewCreateGroupUseCase(groupRepo, userRepo)
@@ -116,7 +199,7 @@ func NewInjector(cfg *config.Config, db *gorm.DB) *Injector {
 
 	// Create category rule use cases
 	listCategoryRulesUseCase := categoryrule.NewListCategoryRulesUseCase(categoryRuleRepo)
-	createCategoryRuleUseCase := categoryrule.NewCreateCategoryRuleUseCase(categoryRuleRepo, categoryRepo, transactionRepo)
+	createCategoryRuleUseCase := categoryrule.NewCreateCategoryRuleUseCase(categoryRuleRepo, categoryRepo, transactionRepo, transactionChangeRepo)
 	updateCategoryRuleUseCase := categoryrule.NewUpdateCategoryRuleUseCase(categoryRuleRepo, categoryRepo)
 	deleteCategoryRuleUseCase := categoryrule.NewDeleteCategoryRuleUseCase(categoryRuleRepo)
 	reorderCategoryRulesUseCase := categoryrule.NewReorderCategoryRulesUseCase(categoryRuleRepo)
@@ -126,7 +209,7 @@ func NewInjector(cfg *config.Config, db *gorm.DB) *Injector {
 	aiGetStatusUseCase := aicategorization.NewGetStatusUseCase(transactionRepo, aiSuggestionRepo, processingTracker)
 	aiStartCategorizationUseCase := aicategorization.NewStartCategorizationUseCase(transactionRepo, categoryRepo, aiSuggestionRepo, geminiService, processingTracker)
 	aiGetSuggestionsUseCase := aicategorization.NewGetSuggestionsUseCase(aiSuggestionRepo)
-	aiApproveSuggestionUseCase := aicategorization.NewApproveSuggestionUseCase(aiSuggestionRepo, categoryRepo, transactionRepo, categoryRuleRepo)
+	aiApproveSuggestionUseCase := aicategorization.NewApproveSuggestionUseCase(aiSuggestionRepo, categoryRepo, transactionRepo, transactionChangeRepo, categoryRuleRepo)
 	aiRejectSuggestionUseCase := aicategorization.NewRejectSuggestionUseCase(aiSuggestionRepo, geminiService, transactionRepo, categoryRepo)
 	aiClearSuggestionsUseCase := aicategorization.NewClearSuggestionsUseCase(aiSuggestionRepo)
 
@@ -150,6 +233,7 @@ func NewInjector(cfg *config.Config, db *gorm.DB) *Injector {
 
 	userController := controller.NewUserController(
 		deleteAccountUseCase,
+		exchangerate.NewUpdateBaseCurrencyUseCase(userRepo, transactionRepo, transactionChangeRepo, currencyConverter),
 	)
 
 	categoryController := controller.NewCategoryController(
@@ -166,13 +250,114 @@ func NewInjector(cfg *config.Config, db *gorm.DB) *Injector {
 		deleteTransactionUseCase,
 		bulkDeleteTransactionsUseCase,
 		bulkCategorizeTransactionsUseCase,
+		listDuplicatesUseCase,
+		mergeDuplicateUseCase,
+		dismissDuplicateUseCase,
+		splitTransactionUseCase,
+		unsplitTransactionUseCase,
+		bulkTagTransactionsUseCase,
+		getTransactionHistoryUseCase,
+		revertTransactionChangeUseCase,
+		revertTransactionOperationUseCase,
+		bulkUpdateTransactionsUseCase,
+		exportTransactionsUseCase,
+		exportJournalUseCase,
+	)
+
+	importController := controller.NewImportController(
+		importStatementUseCase,
+		previewCSVImportUseCase,
+		importCSVUseCase,
+	)
+
+	importProfileController := controller.NewImportProfileController(
+		listImportProfilesUseCase,
+		createImportProfileUseCase,
+		updateImportProfileUseCase,
+		deleteImportProfileUseCase,
+	)
+
+	recurringScheduleController := controller.NewRecurringScheduleController(
+		listRecurringSchedulesUseCase,
+		getRecurringScheduleUseCase,
+		createRecurringScheduleUseCase,
+		updateRecurringScheduleUseCase,
+		deleteRecurringScheduleUseCase,
+	)
+
+	accountController := controller.NewAccountController(
+		account.NewListAccountsUseCase(accountRepo),
+		account.NewGetAccountUseCase(accountRepo),
+		account.NewCreateAccountUseCase(accountRepo),
+		account.NewUpdateAccountUseCase(accountRepo),
+		account.NewDeleteAccountUseCase(accountRepo, transactionRepo, transactionChangeRepo, nil),
+		account.NewListBillingCyclesUseCase(accountRepo, billingCycleOverrideRepo),
+		account.NewSetBillingCycleOverrideUseCase(accountRepo, billingCycleOverrideRepo),
+		account.NewDeleteBillingCycleOverrideUseCase(accountRepo, billingCycleOverrideRepo),
+		account.NewGetOpenBillUseCase(accountRepo, billingCycleOverrideRepo, currencyConverter),
+	)
+
+	transferController := controller.NewTransferController(
+		transfer.NewGetTransferUseCase(transactionRepo),
+		transfer.NewCreateTransferUseCase(transactionRepo, transactionChangeRepo, accountRepo),
+		transfer.NewUpdateTransferUseCase(transactionRepo, transactionChangeRepo, accountRepo),
+		transfer.NewDeleteTransferUseCase(transactionRepo, transactionChangeRepo, nil),
+		transfer.NewConvertTransactionUseCase(transactionRepo, transactionChangeRepo, accountRepo, nil),
+	)
+
+	exchangeRateController := controller.NewExchangeRateController(
+		exchangerate.NewListExchangeRatesUseCase(exchangeRateRepo, currencyConverter),
+		exchangerate.NewCreateExchangeRateUseCase(exchangeRateRepo),
+		exchangerate.NewDeleteExchangeRateUseCase(exchangeRateRepo),
+		exchangerate.NewImportExchangeRatesUseCase(exchangeRateRepo, exchangeRateParser),
+	)
+
+	tagController := controller.NewTagController(
+		tag.NewListTagsUseCase(tagRepo),
+		tag.NewCreateTagUseCase(tagRepo),
+		tag.NewUpdateTagUseCase(tagRepo),
+		tag.NewDeleteTagUseCase(tagRepo),
+	)
+
+	merchantController := controller.NewMerchantController(
+		merchant.NewListMerchantsUseCase(merchantRepo),
+		merchant.NewUpdateMerchantUseCase(merchantRepo, categoryRepo),
+		merchant.NewMergeMerchantsUseCase(merchantRepo),
+	)
+
+	installmentController := controller.NewInstallmentController(
+		installment.NewListPlansUseCase(installmentPlanRepo),
+		installment.NewGetPlanUseCase(installmentPlanRepo),
+		installment.NewGetCommitmentsUseCase(installmentPlanRepo, currencyConverter),
 	)
 
+	trashController := controller.NewTrashController(
+		trash.NewListTrashUseCase(trashRepo, cfg.Trash.RetentionDays),
+		trash.NewRestoreTrashItemsUseCase(trashRepo, transactionRepo, transactionChangeRepo, nil, cfg.Trash.RetentionDays),
+	)
+
+	// Attachment routes are disabled when the object storage cannot be created
+	var attachmentController *controller.AttachmentController
+	if objectStorage, err := newObjectStorage(cfg); err == nil {
+		thumbnailGenerator := storage.NewImageThumbnailGenerator(storage.DefaultThumbnailSize)
+		attachmentController = controller.NewAttachmentController(
+			attachment.NewListAttachmentsUseCase(attachmentRepo, transactionRepo, accountRepo),
+			attachment.NewUploadAttachmentUseCase(attachmentRepo, transactionRepo, accountRepo, objectStorage, thumbnailGenerator),
+			attachment.NewCreateUploadURLUseCase(attachmentRepo, transactionRepo, accountRepo, objectStorage, cfg.Attachments.URLExpiry),
+			attachment.NewCompleteUploadUseCase(attachmentRepo, objectStorage, thumbnailGenerator),
+			attachment.NewGetAttachmentUseCase(attachmentRepo, objectStorage, cfg.Attachments.URLExpiry),
+			attachment.NewGetAttachmentContentUseCase(attachmentRepo, objectStorage),
+			attachment.NewDeleteAttachmentUseCase(attachmentRepo, objectStorage),
+		)
+	}
+
 	creditCardController := controller.NewCreditCardController(
 		previewImportUseCase,
 		importTransactionsUseCase,
 		collapseExpansionUseCase,
 		getStatusUseCase,
+		getForecastUseCase,
+		parseStatementPDFUseCase,
 	)
 
 	reconciliationController := controller.NewReconciliationController(
@@ -190,6 +375,9 @@ func NewInjector(cfg *config.Config, db *gorm.DB) *Injector {
 		getGoalUseCase,
 		updateGoalUseCase,
 		deleteGoalUseCase,
+		listGoalContributionsUseCase,
+		addGoalContributionUseCase,
+		deleteGoalContributionUseCase,
 	)
 
 	groupController := controller.NewGroupController(
@@ -233,6 +421,8 @@ func NewInjector(cfg *config.Config, db *gorm.DB) *Injector {
 	getTrendsUseCase := dashboard.NewGetTrendsUseCase(dashboardRepo)
 	getCategoryBreakdownUseCase := dashboard.NewGetCategoryBreakdownUseCase(dashboardRepo)
 	getPeriodTransactionsUseCase := dashboard.NewGetPeriodTransactionsUseCase(dashboardRepo)
+	getTagBreakdownUseCase := dashboard.NewGetTagBreakdownUseCase(dashboardRepo)
+	getTopMerchantsUseCase := dashboard.NewGetTopMerchantsUseCase(dashboardRepo)
 
 	// Create dashboard controller
 	dashboardController := controller.NewDashboardController(
@@ -241,6 +431,8 @@ func NewInjector(cfg *config.Config, db *gorm.DB) *Injector {
 		getTrendsUseCase,
 		getCategoryBreakdownUseCase,
 		getPeriodTransactionsUseCase,
+		getTagBreakdownUseCase,
+		getTopMerchantsUseCase,
 	)
 
 	// Create middleware
@@ -254,7 +446,7 @@ func NewInjector(cfg *config.Config, db *gorm.DB) *Injector {
 	authMiddleware := middleware.NewAuthMiddleware(tokenService)
 
 	// Create router
-	r := router.NewRouter(healthController, authController, userController, categoryController, transactionController, creditCardController, reconciliationController, goalController, groupController, categoryRuleController, dashboardController, aiCategorizationController, loginRateLimiter, authMiddleware)
+	r := router.NewRouter(healthController, authController, userController, categoryController, transactionController, creditCardController, reconciliationController, goalController, groupController, categoryRuleController, dashboardController, aiCategorizationController, importController, importProfileController, recurringScheduleController, accountController, transferController, exchangeRateController, tagController, merchantController, installmentController, attachmentController, trashController, loginRateLimiter, authMiddleware)
 
 	return &Injector{
 		Config: cfg,
@@ -262,3 +454,18 @@ func NewInjector(cfg *config.Config, db *gorm.DB) *Injector {
 		Router: r,
 	}
 }
+
+// newObjectStorage creates the attachment object storage selected in the configuration.
+func newObjectStorage(cfg *config.Config) (adapter.ObjectStorage, error) {
+	if cfg.Attachments.Storage == "local" {
+		return storage.NewLocalStorage(cfg.Attachments.LocalDir)
+	}
+	return storage.NewS3Storage(storage.S3Config{
+		Endpoint:  cfg.MinIO.Endpoint,
+		AccessKey: cfg.MinIO.AccessKey,
+		SecretKey: cfg.MinIO.SecretKey,
+		Bucket:    cfg.MinIO.Bucket,
+		Region:    cfg.MinIO.Region,
+		UseSSL:    cfg.MinIO.UseSSL,
+	})
+}
@@ -23,6 +23,17 @@ type Router struct {
 	categoryRuleController     *controller.CategoryRuleController
 	dashboardController        *controller.DashboardController
 	aiCategorizationController *controller.AiCategorizationController
+	importController           *controller.ImportController
+	importProfileController    *controller.ImportProfileController
+	recurringController        *controller.RecurringScheduleController
+	accountController          *controller.AccountController
+	transferController         *controller.TransferController
+	exchangeRateController     *controller.ExchangeRateController
+	tagController              *controller.TagController
+	merchantController         *controller.MerchantController
+	installmentController      *controller.InstallmentController
+	attachmentController       *controller.AttachmentController
+	trashController            *controller.TrashController
 	loginRateLimiter           *middleware.RateLimiter
 	authMiddleware             *middleware.AuthMiddleware
 }
@@ -41,6 +52,17 @@ func NewRouter(
 	categoryRuleController *controller.CategoryRuleController,
 	dashboardController *controller.DashboardController,
 	aiCategorizationController *controller.AiCategorizationController,
+	importController *controller.ImportController,
+	importProfileController *controller.ImportProfileController,
+	recurringController *controller.RecurringScheduleController,
+	accountController *controller.AccountController,
+	transferController *controller.TransferController,
+	exchangeRateController *controller.ExchangeRateController,
+	tagController *controller.TagController,
+	merchantController *controller.MerchantController,
+	installmentController *controller.InstallmentController,
+	attachmentController *controller.AttachmentController,
+	trashController *controller.TrashController,
 	loginRateLimiter *middleware.RateLimiter,
 	authMiddleware *middleware.AuthMiddleware,
 ) *Router {
@@ -57,6 +79,17 @@ func NewRouter(
 		categoryRuleController:     categoryRuleController,
 		dashboardController:        dashboardController,
 		aiCategorizationController: aiCategorizationController,
+		importController:           importController,
+		importProfileController:    importProfileController,
+		recurringController:        recurringController,
+		accountController:          accountController,
+		transferController:         transferController,
+		exchangeRateController:     exchangeRateController,
+		tagController:              tagController,
+		merchantController:         merchantController,
+		installmentController:      installmentController,
+		attachmentController:       attachmentController,
+		trashController:            trashController,
 		loginRateLimiter:           loginRateLimiter,
 		authMiddleware:             authMiddleware,
 	}
@@ -127,6 +160,35 @@ func (r *Router) setupAPIRoutes() {
 				transactions.DELETE("/:id", r.transactionController.Delete)
 				transactions.POST("/bulk-delete", r.transactionController.BulkDelete)
 				transactions.POST("/bulk-categorize", r.transactionController.BulkCategorize)
+				transactions.POST("/bulk-tag", r.transactionController.BulkTag)
+				transactions.POST("/bulk-update", r.transactionController.BulkUpdate)
+				transactions.GET("/export", r.transactionController.Export)
+				transactions.GET("/export/journal", r.transactionController.ExportJournal)
+				transactions.GET("/duplicates", r.transactionController.ListDuplicates)
+				transactions.POST("/duplicates/merge", r.transactionController.MergeDuplicates)
+				transactions.POST("/duplicates/dismiss", r.transactionController.DismissDuplicate)
+				transactions.PUT("/:id/splits", r.transactionController.Split)
+				transactions.DELETE("/:id/splits", r.transactionController.Unsplit)
+				transactions.GET("/:id/history", r.transactionController.History)
+				transactions.POST("/changes/:changeId/revert", r.transactionController.RevertChange)
+				transactions.POST("/operations/:operationId/revert", r.transactionController.RevertOperation)
+
+				// Transaction attachment routes (nested under transactions)
+				if r.attachmentController != nil {
+					transactions.GET("/:id/attachments", r.attachmentController.ListTransactionAttachments)
+					transactions.POST("/:id/attachments", r.attachmentController.UploadTransactionAttachment)
+					transactions.POST("/:id/attachments/upload-url", r.attachmentController.CreateTransactionUploadURL)
+				}
+
+				// Statement file import routes (nested under transactions)
+				if r.importController != nil {
+					imports := transactions.Group("/import")
+					{
+						imports.POST("/ofx", r.importController.ImportOFX)
+						imports.POST("/csv/preview", r.importController.PreviewCSV)
+						imports.POST("/csv", r.importController.ImportCSV)
+					}
+				}
 
 				// Credit card import routes (nested under transactions)
 				if r.creditCardController != nil {
@@ -136,6 +198,8 @@ func (r *Router) setupAPIRoutes() {
 						creditCard.POST("/import", r.creditCardController.Import)
 						creditCard.POST("/collapse", r.creditCardController.Collapse)
 						creditCard.GET("/status", r.creditCardController.GetStatus)
+						creditCard.GET("/forecast", r.creditCardController.GetForecast)
+						creditCard.POST("/statement/pdf", r.creditCardController.ParseStatementPDF)
 
 						// Reconciliation routes (nested under credit-card)
 						if r.reconciliationController != nil {
@@ -160,6 +224,7 @@ func (r *Router) setupAPIRoutes() {
 			users.Use(r.authMiddleware.Authenticate())
 			{
 				users.DELETE("/me", r.userController.DeleteAccount)
+				users.PUT("/me/base-currency", r.userController.UpdateBaseCurrency)
 			}
 		}
 
@@ -173,6 +238,143 @@ func (r *Router) setupAPIRoutes() {
 				goals.GET("/:id", r.goalController.Get)
 				goals.PATCH("/:id", r.goalController.Update)
 				goals.DELETE("/:id", r.goalController.Delete)
+				goals.GET("/:id/contributions", r.goalController.ListContributions)
+				goals.POST("/:id/contributions", r.goalController.AddContribution)
+				goals.DELETE("/:id/contributions/:contribution_id", r.goalController.DeleteContribution)
+			}
+		}
+
+		// CSV import profile routes (require authentication)
+		if r.importProfileController != nil && r.authMiddleware != nil {
+			importProfiles := v1.Group("/import-profiles")
+			importProfiles.Use(r.authMiddleware.Authenticate())
+			{
+				importProfiles.GET("", r.importProfileController.List)
+				importProfiles.POST("", r.importProfileController.Create)
+				importProfiles.PATCH("/:id", r.importProfileController.Update)
+				importProfiles.DELETE("/:id", r.importProfileController.Delete)
+			}
+		}
+
+		// Recurring schedule routes (require authentication)
+		if r.recurringController != nil && r.authMiddleware != nil {
+			recurring := v1.Group("/recurring-schedules")
+			recurring.Use(r.authMiddleware.Authenticate())
+			{
+				recurring.GET("", r.recurringController.List)
+				recurring.POST("", r.recurringController.Create)
+				recurring.GET("/:id", r.recurringController.Get)
+				recurring.PATCH("/:id", r.recurringController.Update)
+				recurring.DELETE("/:id", r.recurringController.Delete)
+			}
+		}
+
+		// Account routes (require authentication)
+		if r.accountController != nil && r.authMiddleware != nil {
+			accounts := v1.Group("/accounts")
+			accounts.Use(r.authMiddleware.Authenticate())
+			{
+				accounts.GET("", r.accountController.List)
+				accounts.POST("", r.accountController.Create)
+				accounts.GET("/:id", r.accountController.Get)
+				accounts.PATCH("/:id", r.accountController.Update)
+				accounts.DELETE("/:id", r.accountController.Delete)
+
+				// Credit card billing cycle routes
+				accounts.GET("/:id/billing-cycles", r.accountController.ListBillingCycles)
+				accounts.PUT("/:id/billing-cycles/:cycle", r.accountController.SetBillingCycleOverride)
+				accounts.DELETE("/:id/billing-cycles/:cycle", r.accountController.DeleteBillingCycleOverride)
+				accounts.GET("/:id/open-bill", r.accountController.GetOpenBill)
+
+				// Account attachment routes (nested under accounts)
+				if r.attachmentController != nil {
+					accounts.GET("/:id/attachments", r.attachmentController.ListAccountAttachments)
+					accounts.POST("/:id/attachments", r.attachmentController.UploadAccountAttachment)
+					accounts.POST("/:id/attachments/upload-url", r.attachmentController.CreateAccountUploadURL)
+				}
+			}
+		}
+
+		// Attachment routes (require authentication)
+		if r.attachmentController != nil && r.authMiddleware != nil {
+			attachments := v1.Group("/attachments")
+			attachments.Use(r.authMiddleware.Authenticate())
+			{
+				attachments.GET("/:id", r.attachmentController.Get)
+				attachments.POST("/:id/complete", r.attachmentController.CompleteUpload)
+				attachments.GET("/:id/content", r.attachmentController.GetContent)
+				attachments.GET("/:id/thumbnail", r.attachmentController.GetThumbnail)
+				attachments.DELETE("/:id", r.attachmentController.Delete)
+			}
+		}
+
+		// Tag routes (require authentication)
+		if r.tagController != nil && r.authMiddleware != nil {
+			tags := v1.Group("/tags")
+			tags.Use(r.authMiddleware.Authenticate())
+			{
+				tags.GET("", r.tagController.List)
+				tags.POST("", r.tagController.Create)
+				tags.PATCH("/:id", r.tagController.Update)
+				tags.DELETE("/:id", r.tagController.Delete)
+			}
+		}
+
+		// Merchant routes (require authentication)
+		if r.merchantController != nil && r.authMiddleware != nil {
+			merchants := v1.Group("/merchants")
+			merchants.Use(r.authMiddleware.Authenticate())
+			{
+				merchants.GET("", r.merchantController.List)
+				merchants.PATCH("/:id", r.merchantController.Update)
+				merchants.POST("/:id/merge", r.merchantController.Merge)
+			}
+		}
+
+		// Installment plan routes (require authentication)
+		if r.installmentController != nil && r.authMiddleware != nil {
+			installments := v1.Group("/installments")
+			installments.Use(r.authMiddleware.Authenticate())
+			{
+				installments.GET("", r.installmentController.List)
+				installments.GET("/commitments", r.installmentController.GetCommitments)
+				installments.GET("/:id", r.installmentController.Get)
+			}
+		}
+
+		// Trash routes (require authentication)
+		if r.trashController != nil && r.authMiddleware != nil {
+			trash := v1.Group("/trash")
+			trash.Use(r.authMiddleware.Authenticate())
+			{
+				trash.GET("", r.trashController.List)
+				trash.POST("/restore", r.trashController.RestoreMany)
+				trash.POST("/:type/:id/restore", r.trashController.Restore)
+			}
+		}
+
+		// Transfer routes (require authentication)
+		if r.transferController != nil && r.authMiddleware != nil {
+			transfers := v1.Group("/transfers")
+			transfers.Use(r.authMiddleware.Authenticate())
+			{
+				transfers.POST("", r.transferController.Create)
+				transfers.POST("/convert", r.transferController.Convert)
+				transfers.GET("/:id", r.transferController.Get)
+				transfers.PATCH("/:id", r.transferController.Update)
+				transfers.DELETE("/:id", r.transferController.Delete)
+			}
+		}
+
+		// Exchange rate routes (require authentication)
+		if r.exchangeRateController != nil && r.authMiddleware != nil {
+			exchangeRates := v1.Group("/exchange-rates")
+			exchangeRates.Use(r.authMiddleware.Authenticate())
+			{
+				exchangeRates.GET("", r.exchangeRateController.List)
+				exchangeRates.POST("", r.exchangeRateController.Create)
+				exchangeRates.POST("/import", r.exchangeRateController.Import)
+				exchangeRates.DELETE("/:id", r.exchangeRateController.Delete)
 			}
 		}
 
@@ -227,6 +429,8 @@ func (r *Router) setupAPIRoutes() {
 				dashboard.GET("/data-range", r.dashboardController.GetDataRange)
 				dashboard.GET("/trends", r.dashboardController.GetTrends)
 				dashboard.GET("/category-breakdown", r.dashboardController.GetCategoryBreakdown)
+				dashboard.GET("/tag-breakdown", r.dashboardController.GetTagBreakdown)
+				dashboard.GET("/top-merchants", r.dashboardController.GetTopMerchants)
 				dashboard.GET("/period-transactions", r.dashboardController.GetPeriodTransactions)
 			}
 		}
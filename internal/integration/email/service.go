@@ -84,5 +84,82 @@ func (s *Service) QueueGroupInvitationEmail(ctx context.Context, input adapter.Q
 	return nil
 }
 
+// QueueRecurringReminderEmail queues a reminder about upcoming recurring transactions.
+func (s *Service) QueueRecurringReminderEmail(ctx context.Context, input adapter.QueueRecurringReminderInput) error {
+	subject := "Lancamentos recorrentes nos proximos dias - Finance Tracker"
+
+	items := make([]map[string]interface{}, len(input.Items))
+	for i, item := range input.Items {
+		items[i] = map[string]interface{}{
+			"description": item.Description,
+			"amount":      item.Amount,
+			"date":        item.Date,
+		}
+	}
+
+	templateData := map[string]interface{}{
+		"user_name": input.UserName,
+		"app_url":   s.appBaseURL,
+		"items":     items,
+	}
+
+	job := entity.NewEmailJob(
+		entity.TemplateRecurringReminder,
+		input.UserEmail,
+		input.UserName,
+		subject,
+		templateData,
+	)
+
+	if err := s.queue.Create(ctx, job); err != nil {
+		return domainerror.NewEmailError(
+			domainerror.ErrCodeEmailQueueFailed,
+			"failed to queue recurring reminder email",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// QueueGoalAlertEmail queues an alert about spending reaching a goal threshold.
+func (s *Service) QueueGoalAlertEmail(ctx context.Context, input adapter.QueueGoalAlertInput) error {
+	templateType := entity.TemplateGoal80Percent
+	subject := fmt.Sprintf("Voce atingiu %d%% da meta de %s - Finance Tracker", input.Percentage, input.CategoryName)
+	if input.Threshold == entity.GoalAlertThresholdExceeded {
+		templateType = entity.TemplateGoalExceeded
+		subject = fmt.Sprintf("Meta de %s ultrapassada - Finance Tracker", input.CategoryName)
+	}
+
+	templateData := map[string]interface{}{
+		"user_name":     input.UserName,
+		"category_name": input.CategoryName,
+		"limit_amount":  input.LimitAmount,
+		"spent_amount":  input.SpentAmount,
+		"percentage":    fmt.Sprintf("%d", input.Percentage),
+		"period_start":  input.PeriodStart,
+		"period_end":    input.PeriodEnd,
+		"app_url":       s.appBaseURL,
+	}
+
+	job := entity.NewEmailJob(
+		templateType,
+		input.UserEmail,
+		input.UserName,
+		subject,
+		templateData,
+	)
+
+	if err := s.queue.Create(ctx, job); err != nil {
+		return domainerror.NewEmailError(
+			domainerror.ErrCodeEmailQueueFailed,
+			"failed to queue goal alert email",
+			err,
+		)
+	}
+
+	return nil
+}
+
 // Ensure Service implements adapter.EmailService.
 var _ adapter.EmailService = (*Service)(nil)
@@ -87,3 +87,29 @@ type GroupInvitationData struct {
 	InviteURL    string
 	ExpiresIn    string
 }
+
+// RecurringReminderData contains data for recurring transactions reminder email template.
+type RecurringReminderData struct {
+	UserName string
+	AppURL   string
+	Items    []RecurringReminderItem
+}
+
+// RecurringReminderItem represents an upcoming recurring transaction in the reminder email.
+type RecurringReminderItem struct {
+	Description string
+	Amount      string
+	Date        string
+}
+
+// GoalAlertData contains data for goal threshold alert email templates.
+type GoalAlertData struct {
+	UserName     string
+	CategoryName string
+	LimitAmount  string
+	SpentAmount  string
+	Percentage   string
+	PeriodStart  string
+	PeriodEnd    string
+	AppURL       string
+}
@@ -169,6 +169,32 @@ func (w *Worker) renderTemplate(job *entity.EmailJob) (html string, text string,
 			InviteURL:    getString(job.TemplateData, "invite_url"),
 			ExpiresIn:    getString(job.TemplateData, "expires_in"),
 		}
+	case entity.TemplateRecurringReminder:
+		items := getMapSlice(job.TemplateData, "items")
+		reminderItems := make([]templates.RecurringReminderItem, len(items))
+		for i, item := range items {
+			reminderItems[i] = templates.RecurringReminderItem{
+				Description: getString(item, "description"),
+				Amount:      getString(item, "amount"),
+				Date:        getString(item, "date"),
+			}
+		}
+		data = templates.RecurringReminderData{
+			UserName: getString(job.TemplateData, "user_name"),
+			AppURL:   getString(job.TemplateData, "app_url"),
+			Items:    reminderItems,
+		}
+	case entity.TemplateGoalExceeded, entity.TemplateGoal80Percent:
+		data = templates.GoalAlertData{
+			UserName:     getString(job.TemplateData, "user_name"),
+			CategoryName: getString(job.TemplateData, "category_name"),
+			LimitAmount:  getString(job.TemplateData, "limit_amount"),
+			SpentAmount:  getString(job.TemplateData, "spent_amount"),
+			Percentage:   getString(job.TemplateData, "percentage"),
+			PeriodStart:  getString(job.TemplateData, "period_start"),
+			PeriodEnd:    getString(job.TemplateData, "period_end"),
+			AppURL:       getString(job.TemplateData, "app_url"),
+		}
 	default:
 		return "", "", domainerror.NewEmailError(
 			domainerror.ErrCodeInvalidTemplate,
@@ -216,6 +242,23 @@ func getString(data map[string]interface{}, key string) string {
 	return ""
 }
 
+// getMapSlice safely extracts a list of objects from a map.
+// Template data is stored as JSON, so lists come back as []interface{}.
+func getMapSlice(data map[string]interface{}, key string) []map[string]interface{} {
+	var result []map[string]interface{}
+	switch v := data[key].(type) {
+	case []map[string]interface{}:
+		return v
+	case []interface{}:
+		for _, item := range v {
+			if m, ok := item.(map[string]interface{}); ok {
+				result = append(result, m)
+			}
+		}
+	}
+	return result
+}
+
 // ProcessNow processes all pending emails immediately (useful for testing).
 func (w *Worker) ProcessNow(ctx context.Context) {
 	w.processBatch(ctx)
@@ -0,0 +1,497 @@
+// Package controller implements HTTP handlers for the API endpoints.
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/account"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/dto"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
+)
+
+// AccountController handles account endpoints.
+type AccountController struct {
+	listUseCase           *account.ListAccountsUseCase
+	getUseCase            *account.GetAccountUseCase
+	createUseCase         *account.CreateAccountUseCase
+	updateUseCase         *account.UpdateAccountUseCase
+	deleteUseCase         *account.DeleteAccountUseCase
+	listCyclesUseCase     *account.ListBillingCyclesUseCase
+	setOverrideUseCase    *account.SetBillingCycleOverrideUseCase
+	deleteOverrideUseCase *account.DeleteBillingCycleOverrideUseCase
+	openBillUseCase       *account.GetOpenBillUseCase
+}
+
+// NewAccountController creates a new account controller instance.
+func NewAccountController(
+	listUseCase *account.ListAccountsUseCase,
+	getUseCase *account.GetAccountUseCase,
+	createUseCase *account.CreateAccountUseCase,
+	updateUseCase *account.UpdateAccountUseCase,
+	deleteUseCase *account.DeleteAccountUseCase,
+	listCyclesUseCase *account.ListBillingCyclesUseCase,
+	setOverrideUseCase *account.SetBillingCycleOverrideUseCase,
+	deleteOverrideUseCase *account.DeleteBillingCycleOverrideUseCase,
+	openBillUseCase *account.GetOpenBillUseCase,
+) *AccountController {
+	return &AccountController{
+		listUseCase:           listUseCase,
+		getUseCase:            getUseCase,
+		createUseCase:         createUseCase,
+		updateUseCase:         updateUseCase,
+		deleteUseCase:         deleteUseCase,
+		listCyclesUseCase:     listCyclesUseCase,
+		setOverrideUseCase:    setOverrideUseCase,
+		deleteOverrideUseCase: deleteOverrideUseCase,
+		openBillUseCase:       openBillUseCase,
+	}
+}
+
+// List handles GET /accounts requests.
+func (c *AccountController) List(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.listUseCase.Execute(ctx.Request.Context(), account.ListAccountsInput{
+		UserID:          userID,
+		IncludeArchived: ctx.Query("include_archived") == "true",
+	})
+	if err != nil {
+		c.handleAccountError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToAccountListResponse(output.Accounts)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Get handles GET /accounts/:id requests.
+func (c *AccountController) Get(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse account ID from URL
+	accountID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid account ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.getUseCase.Execute(ctx.Request.Context(), account.GetAccountInput{
+		AccountID: accountID,
+		UserID:    userID,
+	})
+	if err != nil {
+		c.handleAccountError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToAccountResponse(output.Account)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create handles POST /accounts requests.
+func (c *AccountController) Create(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.CreateAccountRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body",
+			Code:  string(domainerror.ErrCodeAccountMissingFields),
+		})
+		return
+	}
+
+	// Build input
+	input := account.CreateAccountInput{
+		UserID:         userID,
+		Name:           req.Name,
+		Type:           entity.AccountType(req.Type),
+		Institution:    req.Institution,
+		Currency:       req.Currency,
+		OpeningBalance: decimal.NewFromFloat(req.OpeningBalance),
+		ClosingDay:     req.ClosingDay,
+		DueDay:         req.DueDay,
+	}
+
+	// Execute use case
+	output, err := c.createUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleAccountError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToAccountResponse(output.Account)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// Update handles PATCH /accounts/:id requests.
+func (c *AccountController) Update(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse account ID from URL
+	accountID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid account ID format",
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.UpdateAccountRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body",
+		})
+		return
+	}
+
+	// Build input
+	input := account.UpdateAccountInput{
+		AccountID:        accountID,
+		UserID:           userID,
+		Name:             req.Name,
+		Institution:      req.Institution,
+		Currency:         req.Currency,
+		ClosingDay:       req.ClosingDay,
+		DueDay:           req.DueDay,
+		ClearBillingDays: req.ClearBillingDays,
+		IsArchived:       req.IsArchived,
+	}
+	if req.Type != nil {
+		accountType := entity.AccountType(*req.Type)
+		input.Type = &accountType
+	}
+	if req.OpeningBalance != nil {
+		openingBalance := decimal.NewFromFloat(*req.OpeningBalance)
+		input.OpeningBalance = &openingBalance
+	}
+
+	// Execute use case
+	output, err := c.updateUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleAccountError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToAccountResponse(output.Account)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Delete handles DELETE /accounts/:id requests.
+func (c *AccountController) Delete(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse account ID from URL
+	accountID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid account ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	_, err = c.deleteUseCase.Execute(ctx.Request.Context(), account.DeleteAccountInput{
+		AccountID: accountID,
+		UserID:    userID,
+	})
+	if err != nil {
+		c.handleAccountError(ctx, err)
+		return
+	}
+
+	// Return no content on success
+	ctx.Status(http.StatusNoContent)
+}
+
+// ListBillingCycles handles GET /accounts/:id/billing-cycles requests.
+func (c *AccountController) ListBillingCycles(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse account ID from URL
+	accountID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid account ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.listCyclesUseCase.Execute(ctx.Request.Context(), account.ListBillingCyclesInput{
+		AccountID: accountID,
+		UserID:    userID,
+	})
+	if err != nil {
+		c.handleAccountError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToBillingCycleListResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// SetBillingCycleOverride handles PUT /accounts/:id/billing-cycles/:cycle requests.
+func (c *AccountController) SetBillingCycleOverride(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse account ID from URL
+	accountID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid account ID format",
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.SetBillingCycleOverrideRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body",
+		})
+		return
+	}
+
+	// Build input
+	input := account.SetBillingCycleOverrideInput{
+		AccountID:    accountID,
+		UserID:       userID,
+		BillingCycle: ctx.Param("cycle"),
+	}
+	if req.ClosingDate != nil {
+		closingDate, err := time.Parse("2006-01-02", *req.ClosingDate)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid closing_date format, expected YYYY-MM-DD",
+				Code:  string(domainerror.ErrCodeInvalidBillingOverride),
+			})
+			return
+		}
+		input.ClosingDate = &closingDate
+	}
+	if req.DueDate != nil {
+		dueDate, err := time.Parse("2006-01-02", *req.DueDate)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid due_date format, expected YYYY-MM-DD",
+				Code:  string(domainerror.ErrCodeInvalidBillingOverride),
+			})
+			return
+		}
+		input.DueDate = &dueDate
+	}
+
+	// Execute use case
+	output, err := c.setOverrideUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleAccountError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToBillingCycleResponse(output.Cycle)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// DeleteBillingCycleOverride handles DELETE /accounts/:id/billing-cycles/:cycle requests.
+func (c *AccountController) DeleteBillingCycleOverride(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse account ID from URL
+	accountID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid account ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	_, err = c.deleteOverrideUseCase.Execute(ctx.Request.Context(), account.DeleteBillingCycleOverrideInput{
+		AccountID:    accountID,
+		UserID:       userID,
+		BillingCycle: ctx.Param("cycle"),
+	})
+	if err != nil {
+		c.handleAccountError(ctx, err)
+		return
+	}
+
+	// Return no content on success
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetOpenBill handles GET /accounts/:id/open-bill requests.
+func (c *AccountController) GetOpenBill(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse account ID from URL
+	accountID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid account ID format",
+		})
+		return
+	}
+
+	// Parse the optional date the bill is open on
+	input := account.GetOpenBillInput{
+		AccountID: accountID,
+		UserID:    userID,
+	}
+	if dateStr := ctx.Query("date"); dateStr != "" {
+		date, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid date format, expected YYYY-MM-DD",
+			})
+			return
+		}
+		input.Date = date
+	}
+
+	// Execute use case
+	output, err := c.openBillUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleAccountError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToOpenBillResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// handleAccountError handles account errors and returns appropriate HTTP responses.
+func (c *AccountController) handleAccountError(ctx *gin.Context, err error) {
+	var accountErr *domainerror.AccountError
+	if errors.As(err, &accountErr) {
+		statusCode := c.getStatusCodeForAccountError(accountErr.Code)
+		ctx.JSON(statusCode, dto.ErrorResponse{
+			Error: accountErr.Message,
+			Code:  string(accountErr.Code),
+		})
+		return
+	}
+
+	// Generic server error
+	ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		Error: "An internal error occurred",
+	})
+}
+
+// getStatusCodeForAccountError maps account error codes to HTTP status codes.
+func (c *AccountController) getStatusCodeForAccountError(code domainerror.AccountErrorCode) int {
+	switch code {
+	case domainerror.ErrCodeAccountNotFound,
+		domainerror.ErrCodeBillingOverrideNotFound:
+		return http.StatusNotFound
+	case domainerror.ErrCodeAccountNameExists,
+		domainerror.ErrCodeAccountCurrencyInUse:
+		return http.StatusConflict
+	case domainerror.ErrCodeNotAuthorizedAccount:
+		return http.StatusForbidden
+	case domainerror.ErrCodeInvalidAccountType,
+		domainerror.ErrCodeInvalidAccountCurrency,
+		domainerror.ErrCodeInvalidBillingDays,
+		domainerror.ErrCodeAccountMissingFields,
+		domainerror.ErrCodeInvalidBillingOverride:
+		return http.StatusBadRequest
+	case domainerror.ErrCodeBillingDaysNotSet:
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,453 @@
+// Package controller implements HTTP handlers for the API endpoints.
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/attachment"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/dto"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
+)
+
+// AttachmentController handles attachment endpoints for transactions and accounts.
+type AttachmentController struct {
+	listUseCase            *attachment.ListAttachmentsUseCase
+	uploadUseCase          *attachment.UploadAttachmentUseCase
+	createUploadURLUseCase *attachment.CreateUploadURLUseCase
+	completeUploadUseCase  *attachment.CompleteUploadUseCase
+	getUseCase             *attachment.GetAttachmentUseCase
+	getContentUseCase      *attachment.GetAttachmentContentUseCase
+	deleteUseCase          *attachment.DeleteAttachmentUseCase
+}
+
+// NewAttachmentController creates a new attachment controller instance.
+func NewAttachmentController(
+	listUseCase *attachment.ListAttachmentsUseCase,
+	uploadUseCase *attachment.UploadAttachmentUseCase,
+	createUploadURLUseCase *attachment.CreateUploadURLUseCase,
+	completeUploadUseCase *attachment.CompleteUploadUseCase,
+	getUseCase *attachment.GetAttachmentUseCase,
+	getContentUseCase *attachment.GetAttachmentContentUseCase,
+	deleteUseCase *attachment.DeleteAttachmentUseCase,
+) *AttachmentController {
+	return &AttachmentController{
+		listUseCase:            listUseCase,
+		uploadUseCase:          uploadUseCase,
+		createUploadURLUseCase: createUploadURLUseCase,
+		completeUploadUseCase:  completeUploadUseCase,
+		getUseCase:             getUseCase,
+		getContentUseCase:      getContentUseCase,
+		deleteUseCase:          deleteUseCase,
+	}
+}
+
+// ListTransactionAttachments handles GET /transactions/:id/attachments requests.
+func (c *AttachmentController) ListTransactionAttachments(ctx *gin.Context) {
+	c.list(ctx, entity.AttachmentOwnerTransaction)
+}
+
+// UploadTransactionAttachment handles POST /transactions/:id/attachments requests.
+func (c *AttachmentController) UploadTransactionAttachment(ctx *gin.Context) {
+	c.upload(ctx, entity.AttachmentOwnerTransaction)
+}
+
+// CreateTransactionUploadURL handles POST /transactions/:id/attachments/upload-url requests.
+func (c *AttachmentController) CreateTransactionUploadURL(ctx *gin.Context) {
+	c.createUploadURL(ctx, entity.AttachmentOwnerTransaction)
+}
+
+// ListAccountAttachments handles GET /accounts/:id/attachments requests.
+func (c *AttachmentController) ListAccountAttachments(ctx *gin.Context) {
+	c.list(ctx, entity.AttachmentOwnerAccount)
+}
+
+// UploadAccountAttachment handles POST /accounts/:id/attachments requests.
+func (c *AttachmentController) UploadAccountAttachment(ctx *gin.Context) {
+	c.upload(ctx, entity.AttachmentOwnerAccount)
+}
+
+// CreateAccountUploadURL handles POST /accounts/:id/attachments/upload-url requests.
+func (c *AttachmentController) CreateAccountUploadURL(ctx *gin.Context) {
+	c.createUploadURL(ctx, entity.AttachmentOwnerAccount)
+}
+
+// list lists the attachments of the transaction or account in the URL.
+func (c *AttachmentController) list(ctx *gin.Context, ownerType entity.AttachmentOwnerType) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse owner ID from URL
+	ownerID, ok := parseAttachmentOwnerID(ctx, ownerType)
+	if !ok {
+		return
+	}
+
+	// Execute use case
+	output, err := c.listUseCase.Execute(ctx.Request.Context(), attachment.ListAttachmentsInput{
+		UserID:    userID,
+		OwnerType: ownerType,
+		OwnerID:   ownerID,
+	})
+	if err != nil {
+		c.handleAttachmentError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToAttachmentListResponse(output.Attachments)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// upload attaches the multipart "file" field to the transaction or account in the URL.
+func (c *AttachmentController) upload(ctx *gin.Context, ownerType entity.AttachmentOwnerType) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse owner ID from URL
+	ownerID, ok := parseAttachmentOwnerID(ctx, ownerType)
+	if !ok {
+		return
+	}
+
+	// Read uploaded file
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "A file is required in the 'file' field",
+			Code:  string(domainerror.ErrCodeAttachmentMissingFields),
+		})
+		return
+	}
+
+	if fileHeader.Size > attachment.MaxAttachmentSize {
+		ctx.JSON(http.StatusRequestEntityTooLarge, dto.ErrorResponse{
+			Error: fmt.Sprintf("file must not exceed %d MB", attachment.MaxAttachmentSize>>20),
+			Code:  string(domainerror.ErrCodeAttachmentTooLarge),
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Failed to read file",
+			Code:  string(domainerror.ErrCodeAttachmentMissingFields),
+		})
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, attachment.MaxAttachmentSize))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Failed to read file",
+			Code:  string(domainerror.ErrCodeAttachmentMissingFields),
+		})
+		return
+	}
+
+	// Build input
+	input := attachment.UploadAttachmentInput{
+		UserID:      userID,
+		OwnerType:   ownerType,
+		OwnerID:     ownerID,
+		FileName:    fileHeader.Filename,
+		ContentType: fileHeader.Header.Get("Content-Type"),
+		Content:     content,
+	}
+
+	// Execute use case
+	output, err := c.uploadUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleAttachmentError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToAttachmentResponse(output.Attachment)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// createUploadURL starts a direct upload to object storage for the transaction or account in the URL.
+func (c *AttachmentController) createUploadURL(ctx *gin.Context, ownerType entity.AttachmentOwnerType) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse owner ID from URL
+	ownerID, ok := parseAttachmentOwnerID(ctx, ownerType)
+	if !ok {
+		return
+	}
+
+	// Parse request body
+	var req dto.CreateAttachmentUploadURLRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body",
+			Code:  string(domainerror.ErrCodeAttachmentMissingFields),
+		})
+		return
+	}
+
+	// Build input
+	input := attachment.CreateUploadURLInput{
+		UserID:      userID,
+		OwnerType:   ownerType,
+		OwnerID:     ownerID,
+		FileName:    req.FileName,
+		ContentType: req.ContentType,
+		SizeBytes:   req.SizeBytes,
+	}
+
+	// Execute use case
+	output, err := c.createUploadURLUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleAttachmentError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToAttachmentUploadURLResponse(output.Attachment, output.UploadURL, output.ExpiresAt)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// CompleteUpload handles POST /attachments/:id/complete requests.
+func (c *AttachmentController) CompleteUpload(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse attachment ID from URL
+	attachmentID, ok := parseAttachmentID(ctx)
+	if !ok {
+		return
+	}
+
+	// Execute use case
+	output, err := c.completeUploadUseCase.Execute(ctx.Request.Context(), attachment.CompleteUploadInput{
+		AttachmentID: attachmentID,
+		UserID:       userID,
+	})
+	if err != nil {
+		c.handleAttachmentError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToAttachmentResponse(output.Attachment)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Get handles GET /attachments/:id requests.
+func (c *AttachmentController) Get(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse attachment ID from URL
+	attachmentID, ok := parseAttachmentID(ctx)
+	if !ok {
+		return
+	}
+
+	// Execute use case
+	output, err := c.getUseCase.Execute(ctx.Request.Context(), attachment.GetAttachmentInput{
+		AttachmentID: attachmentID,
+		UserID:       userID,
+	})
+	if err != nil {
+		c.handleAttachmentError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToAttachmentDetailResponse(output.Attachment, output.DownloadURL, output.ThumbnailURL, output.ExpiresAt)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// GetContent handles GET /attachments/:id/content requests.
+func (c *AttachmentController) GetContent(ctx *gin.Context) {
+	c.getContent(ctx, false)
+}
+
+// GetThumbnail handles GET /attachments/:id/thumbnail requests.
+func (c *AttachmentController) GetThumbnail(ctx *gin.Context) {
+	c.getContent(ctx, true)
+}
+
+// getContent streams the attachment's file or thumbnail.
+func (c *AttachmentController) getContent(ctx *gin.Context, thumbnail bool) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse attachment ID from URL
+	attachmentID, ok := parseAttachmentID(ctx)
+	if !ok {
+		return
+	}
+
+	// Execute use case
+	output, err := c.getContentUseCase.Execute(ctx.Request.Context(), attachment.GetAttachmentContentInput{
+		AttachmentID: attachmentID,
+		UserID:       userID,
+		Thumbnail:    thumbnail,
+	})
+	if err != nil {
+		c.handleAttachmentError(ctx, err)
+		return
+	}
+	defer output.Content.Close()
+
+	// Stream the file
+	ctx.DataFromReader(http.StatusOK, -1, output.ContentType, output.Content, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("inline", map[string]string{"filename": output.FileName}),
+	})
+}
+
+// Delete handles DELETE /attachments/:id requests.
+func (c *AttachmentController) Delete(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse attachment ID from URL
+	attachmentID, ok := parseAttachmentID(ctx)
+	if !ok {
+		return
+	}
+
+	// Execute use case
+	_, err := c.deleteUseCase.Execute(ctx.Request.Context(), attachment.DeleteAttachmentInput{
+		AttachmentID: attachmentID,
+		UserID:       userID,
+	})
+	if err != nil {
+		c.handleAttachmentError(ctx, err)
+		return
+	}
+
+	// Return no content on success
+	ctx.Status(http.StatusNoContent)
+}
+
+// parseAttachmentOwnerID parses the transaction or account ID from the URL.
+// It writes the error response and returns false when the ID is invalid.
+func parseAttachmentOwnerID(ctx *gin.Context, ownerType entity.AttachmentOwnerType) (uuid.UUID, bool) {
+	ownerID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: fmt.Sprintf("Invalid %s ID format", ownerType),
+		})
+		return uuid.Nil, false
+	}
+	return ownerID, true
+}
+
+// parseAttachmentID parses the attachment ID from the URL.
+// It writes the error response and returns false when the ID is invalid.
+func parseAttachmentID(ctx *gin.Context) (uuid.UUID, bool) {
+	attachmentID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid attachment ID format",
+		})
+		return uuid.Nil, false
+	}
+	return attachmentID, true
+}
+
+// handleAttachmentError handles attachment errors and returns appropriate HTTP responses.
+func (c *AttachmentController) handleAttachmentError(ctx *gin.Context, err error) {
+	var attachmentErr *domainerror.AttachmentError
+	if errors.As(err, &attachmentErr) {
+		statusCode := c.getStatusCodeForAttachmentError(attachmentErr.Code)
+		ctx.JSON(statusCode, dto.ErrorResponse{
+			Error: attachmentErr.Message,
+			Code:  string(attachmentErr.Code),
+		})
+		return
+	}
+
+	// Generic server error
+	ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		Error: "An internal error occurred",
+	})
+}
+
+// getStatusCodeForAttachmentError maps attachment error codes to HTTP status codes.
+func (c *AttachmentController) getStatusCodeForAttachmentError(code domainerror.AttachmentErrorCode) int {
+	switch code {
+	case domainerror.ErrCodeAttachmentNotFound,
+		domainerror.ErrCodeAttachmentOwnerNotFound:
+		return http.StatusNotFound
+	case domainerror.ErrCodeNotAuthorizedAttachment:
+		return http.StatusForbidden
+	case domainerror.ErrCodeAttachmentTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case domainerror.ErrCodeUnsupportedAttachmentType:
+		return http.StatusUnsupportedMediaType
+	case domainerror.ErrCodeAttachmentMissingFields:
+		return http.StatusBadRequest
+	case domainerror.ErrCodeAttachmentNotUploaded:
+		return http.StatusConflict
+	case domainerror.ErrCodePresignNotSupported:
+		return http.StatusNotImplemented
+	default:
+		return http.StatusInternalServerError
+	}
+}
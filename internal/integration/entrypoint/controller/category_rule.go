@@ -132,6 +132,9 @@ func (c *CategoryRuleController) Create(ctx *gin.Context) {
 	// Build response
 	response := dto.ToCategoryRuleResponse(output.Rule)
 	response.TransactionsUpdated = output.TransactionsUpdated
+	if output.OperationID != nil {
+		response.OperationID = output.OperationID.String()
+	}
 	ctx.JSON(http.StatusCreated, response)
 }
 
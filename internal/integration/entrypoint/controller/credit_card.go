@@ -3,7 +3,9 @@ package controller
 
 import (
 	"errors"
+	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -22,6 +24,8 @@ type CreditCardController struct {
 	importTransactionsUseCase *creditcard.ImportTransactionsUseCase
 	collapseExpansionUseCase  *creditcard.CollapseExpansionUseCase
 	getStatusUseCase          *creditcard.GetStatusUseCase
+	getForecastUseCase        *creditcard.GetForecastUseCase
+	parseStatementPDFUseCase  *creditcard.ParseStatementPDFUseCase
 }
 
 // NewCreditCardController creates a new credit card controller instance.
@@ -30,12 +34,16 @@ func NewCreditCardController(
 	importTransactionsUseCase *creditcard.ImportTransactionsUseCase,
 	collapseExpansionUseCase *creditcard.CollapseExpansionUseCase,
 	getStatusUseCase *creditcard.GetStatusUseCase,
+	getForecastUseCase *creditcard.GetForecastUseCase,
+	parseStatementPDFUseCase *creditcard.ParseStatementPDFUseCase,
 ) *CreditCardController {
 	return &CreditCardController{
 		previewImportUseCase:     previewImportUseCase,
 		importTransactionsUseCase: importTransactionsUseCase,
 		collapseExpansionUseCase:  collapseExpansionUseCase,
 		getStatusUseCase:          getStatusUseCase,
+		getForecastUseCase:        getForecastUseCase,
+		parseStatementPDFUseCase:  parseStatementPDFUseCase,
 	}
 }
 
@@ -78,6 +86,7 @@ func (c *CreditCardController) Preview(ctx *gin.Context) {
 			Amount:             decimal.NewFromFloat(txnDTO.Amount),
 			InstallmentCurrent: txnDTO.InstallmentCurrent,
 			InstallmentTotal:   txnDTO.InstallmentTotal,
+			Currency:           txnDTO.Currency,
 		}
 	}
 
@@ -86,6 +95,18 @@ func (c *CreditCardController) Preview(ctx *gin.Context) {
 		UserID:       userID,
 		BillingCycle: req.BillingCycle,
 		Transactions: transactions,
+		Reimport:     req.Reimport,
+	}
+
+	if req.AccountID != "" {
+		accountID, err := uuid.Parse(req.AccountID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid account ID format",
+			})
+			return
+		}
+		input.AccountID = &accountID
 	}
 
 	// Execute use case
@@ -120,6 +141,18 @@ func (c *CreditCardController) Preview(ctx *gin.Context) {
 		}
 	}
 
+	possibleDuplicates := make([]dto.PossibleDuplicateDTO, len(output.PossibleDuplicates))
+	for i, duplicate := range output.PossibleDuplicates {
+		possibleDuplicates[i] = dto.PossibleDuplicateDTO{
+			LineIndex:     duplicate.LineIndex,
+			TransactionID: duplicate.TransactionID.String(),
+			Date:          duplicate.Date.Format("2006-01-02"),
+			Description:   duplicate.Description,
+			Amount:        duplicate.Amount.String(),
+			Score:         duplicate.Score,
+		}
+	}
+
 	response := dto.ImportPreviewResponseDTO{
 		BillingCycle:          output.BillingCycle,
 		TotalTransactions:     output.TotalTransactions,
@@ -128,6 +161,8 @@ func (c *CreditCardController) Preview(ctx *gin.Context) {
 		TransactionsToImport:  transactionsToImport,
 		PaymentReceivedAmount: output.PaymentReceivedAmount.String(),
 		HasExistingImport:     output.HasExistingImport,
+		PossibleDuplicates:    possibleDuplicates,
+		Reimport:              dto.ToReimportDiffDTO(output.Reimport),
 	}
 
 	ctx.JSON(http.StatusOK, response)
@@ -167,6 +202,19 @@ func (c *CreditCardController) Import(ctx *gin.Context) {
 		billPaymentID = &parsed
 	}
 
+	// Parse account ID (optional - the card's closing day derives the billing cycles)
+	var accountID *uuid.UUID
+	if req.AccountID != "" {
+		parsed, err := uuid.Parse(req.AccountID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid account ID format",
+			})
+			return
+		}
+		accountID = &parsed
+	}
+
 	// Convert DTOs to use case input
 	transactions := make([]creditcard.CCTransactionInput, len(req.Transactions))
 	for i, txnDTO := range req.Transactions {
@@ -185,6 +233,7 @@ func (c *CreditCardController) Import(ctx *gin.Context) {
 			Amount:             decimal.NewFromFloat(txnDTO.Amount),
 			InstallmentCurrent: txnDTO.InstallmentCurrent,
 			InstallmentTotal:   txnDTO.InstallmentTotal,
+			Currency:           txnDTO.Currency,
 		}
 	}
 
@@ -192,9 +241,12 @@ func (c *CreditCardController) Import(ctx *gin.Context) {
 	input := creditcard.ImportTransactionsInput{
 		UserID:            userID,
 		BillingCycle:      req.BillingCycle,
+		AccountID:         accountID,
 		BillPaymentID:     billPaymentID,
 		Transactions:      transactions,
 		ApplyAutoCategory: req.ApplyAutoCategory,
+		SkipDuplicates:    req.SkipDuplicates,
+		Reimport:          req.Reimport,
 	}
 
 	// Execute use case
@@ -217,6 +269,10 @@ func (c *CreditCardController) Import(ctx *gin.Context) {
 			catIDStr := txn.CategoryID.String()
 			summary.CategoryID = &catIDStr
 		}
+		if txn.InstallmentPlanID != nil {
+			planIDStr := txn.InstallmentPlanID.String()
+			summary.InstallmentPlanID = &planIDStr
+		}
 		transactionSummaries[i] = summary
 	}
 
@@ -225,8 +281,13 @@ func (c *CreditCardController) Import(ctx *gin.Context) {
 		CategorizedCount:   output.CategorizedCount,
 		BillingCycle:       output.BillingCycle,
 		OriginalBillAmount: output.OriginalBillAmount.String(),
-		ImportedAt:         output.ImportedAt,
-		Transactions:       transactionSummaries,
+		ImportedAt:            output.ImportedAt,
+		Transactions:          transactionSummaries,
+		SkippedDuplicateCount: output.SkippedDuplicateCount,
+		InstallmentIssues:     dto.ToInstallmentIssueResponses(output.InstallmentIssues),
+		UpdatedCount:          output.UpdatedCount,
+		RemovedCount:          output.RemovedCount,
+		UnchangedCount:        output.UnchangedCount,
 	}
 
 	// Set bill payment ID if it exists
@@ -363,6 +424,144 @@ func (c *CreditCardController) GetStatus(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+// GetForecast handles GET /transactions/credit-card/forecast requests.
+func (c *CreditCardController) GetForecast(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse query parameters
+	input := creditcard.GetForecastInput{
+		UserID: userID,
+	}
+
+	if accountIDStr := ctx.Query("account_id"); accountIDStr != "" {
+		accountID, err := uuid.Parse(accountIDStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid account ID format",
+			})
+			return
+		}
+		input.AccountID = &accountID
+	}
+
+	if monthsStr := ctx.Query("months"); monthsStr != "" {
+		months, err := strconv.Atoi(monthsStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "months must be a number",
+				Code:  string(domainerror.ErrCodeInvalidForecastMonths),
+			})
+			return
+		}
+		input.Months = months
+	}
+
+	// Execute use case
+	output, err := c.getForecastUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleCreditCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ToCreditCardForecastDTO(output))
+}
+
+// ParseStatementPDF handles POST /transactions/credit-card/statement/pdf requests.
+// Accepts a multipart form with a statement (fatura) PDF in the "file" field and an optional
+// "account_id". Returns the lines ready for the preview and import endpoints; nothing is saved.
+func (c *CreditCardController) ParseStatementPDF(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Read uploaded file
+	data, ok := c.readStatementPDF(ctx)
+	if !ok {
+		return
+	}
+
+	input := creditcard.ParseStatementPDFInput{
+		UserID: userID,
+		Data:   data,
+	}
+
+	if accountIDStr := ctx.PostForm("account_id"); accountIDStr != "" {
+		accountID, err := uuid.Parse(accountIDStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid account ID format",
+			})
+			return
+		}
+		input.AccountID = &accountID
+	}
+
+	// Execute use case
+	output, err := c.parseStatementPDFUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleCreditCardError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.ToStatementPDFResponseDTO(output))
+}
+
+// readStatementPDF reads the uploaded "file" form field, enforcing MaxStatementFileSize.
+// It writes the error response and returns false when the file is missing or unreadable.
+func (c *CreditCardController) readStatementPDF(ctx *gin.Context) ([]byte, bool) {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "A statement file is required in the 'file' field",
+			Code:  string(domainerror.ErrCodeMissingStatementFile),
+		})
+		return nil, false
+	}
+
+	if fileHeader.Size > MaxStatementFileSize {
+		ctx.JSON(http.StatusRequestEntityTooLarge, dto.ErrorResponse{
+			Error: "Statement file must not exceed 5 MB",
+			Code:  string(domainerror.ErrCodeStatementFileTooLarge),
+		})
+		return nil, false
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Failed to read statement file",
+			Code:  string(domainerror.ErrCodeInvalidStatementFile),
+		})
+		return nil, false
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, MaxStatementFileSize))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Failed to read statement file",
+			Code:  string(domainerror.ErrCodeInvalidStatementFile),
+		})
+		return nil, false
+	}
+
+	return data, true
+}
+
 // handleCreditCardError handles credit card errors and returns appropriate HTTP responses.
 func (c *CreditCardController) handleCreditCardError(ctx *gin.Context, err error) {
 	var txnErr *domainerror.TransactionError
@@ -384,16 +583,25 @@ func (c *CreditCardController) handleCreditCardError(ctx *gin.Context, err error
 // getStatusCodeForCreditCardError maps credit card error codes to HTTP status codes.
 func (c *CreditCardController) getStatusCodeForCreditCardError(code domainerror.TransactionErrorCode) int {
 	switch code {
-	case domainerror.ErrCodeBillPaymentNotFound:
+	case domainerror.ErrCodeBillPaymentNotFound,
+		domainerror.ErrCodeTxnAccountNotFound:
 		return http.StatusNotFound
-	case domainerror.ErrCodeBillPaymentNotOwned:
+	case domainerror.ErrCodeBillPaymentNotOwned,
+		domainerror.ErrCodeTxnAccountNotOwned:
 		return http.StatusForbidden
 	case domainerror.ErrCodeInvalidBillingCycle,
+		domainerror.ErrCodeNotCreditCardAccount,
 		domainerror.ErrCodeEmptyCCTransactions,
 		domainerror.ErrCodeBillNotExpanded,
 		domainerror.ErrCodeBillAlreadyExpanded,
-		domainerror.ErrCodeNoPotentialMatches:
+		domainerror.ErrCodeNoPotentialMatches,
+		domainerror.ErrCodeInvalidTxnCurrency,
+		domainerror.ErrCodeInvalidForecastMonths,
+		domainerror.ErrCodeInvalidStatementFile,
+		domainerror.ErrCodeEmptyStatement:
 		return http.StatusBadRequest
+	case domainerror.ErrCodeTxnRateUnavailable:
+		return http.StatusUnprocessableEntity
 	default:
 		return http.StatusInternalServerError
 	}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -23,6 +24,8 @@ type DashboardController struct {
 	getTrendsUseCase               *dashboard.GetTrendsUseCase
 	getCategoryBreakdownUseCase    *dashboard.GetCategoryBreakdownUseCase
 	getPeriodTransactionsUseCase   *dashboard.GetPeriodTransactionsUseCase
+	getTagBreakdownUseCase         *dashboard.GetTagBreakdownUseCase
+	getTopMerchantsUseCase         *dashboard.GetTopMerchantsUseCase
 }
 
 // NewDashboardController creates a new dashboard controller instance.
@@ -32,6 +35,8 @@ func NewDashboardController(
 	getTrendsUseCase *dashboard.GetTrendsUseCase,
 	getCategoryBreakdownUseCase *dashboard.GetCategoryBreakdownUseCase,
 	getPeriodTransactionsUseCase *dashboard.GetPeriodTransactionsUseCase,
+	getTagBreakdownUseCase *dashboard.GetTagBreakdownUseCase,
+	getTopMerchantsUseCase *dashboard.GetTopMerchantsUseCase,
 ) *DashboardController {
 	return &DashboardController{
 		getCategoryTrendsUseCase:       getCategoryTrendsUseCase,
@@ -39,6 +44,8 @@ func NewDashboardController(
 		getTrendsUseCase:               getTrendsUseCase,
 		getCategoryBreakdownUseCase:    getCategoryBreakdownUseCase,
 		getPeriodTransactionsUseCase:   getPeriodTransactionsUseCase,
+		getTagBreakdownUseCase:         getTagBreakdownUseCase,
+		getTopMerchantsUseCase:         getTopMerchantsUseCase,
 	}
 }
 
@@ -122,6 +129,12 @@ func (c *DashboardController) GetCategoryTrends(ctx *gin.Context) {
 		topCategories = 8
 	}
 
+	// Parse optional account filter
+	accountIDs, ok := c.parseAccountIDs(ctx)
+	if !ok {
+		return
+	}
+
 	// Execute use case
 	input := dashboard.GetCategoryTrendsInput{
 		UserID:        userID,
@@ -129,6 +142,7 @@ func (c *DashboardController) GetCategoryTrends(ctx *gin.Context) {
 		EndDate:       endDate,
 		Granularity:   gran,
 		TopCategories: topCategories,
+		AccountIDs:    accountIDs,
 	}
 
 	output, err := c.getCategoryTrendsUseCase.Execute(ctx.Request.Context(), input)
@@ -142,6 +156,39 @@ func (c *DashboardController) GetCategoryTrends(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+// parseAccountIDs parses the optional comma-separated account_ids query parameter.
+// It writes a 400 response and returns false when an ID is malformed.
+func (c *DashboardController) parseAccountIDs(ctx *gin.Context) ([]uuid.UUID, bool) {
+	return c.parseIDsQuery(ctx, "account_ids")
+}
+
+// parseTagIDs parses the optional comma-separated tag_ids query parameter.
+// It writes a 400 response and returns false when an ID is malformed.
+func (c *DashboardController) parseTagIDs(ctx *gin.Context) ([]uuid.UUID, bool) {
+	return c.parseIDsQuery(ctx, "tag_ids")
+}
+
+// parseIDsQuery parses an optional comma-separated list of IDs from the named query parameter.
+func (c *DashboardController) parseIDsQuery(ctx *gin.Context, name string) ([]uuid.UUID, bool) {
+	idsStr := ctx.Query(name)
+	if idsStr == "" {
+		return nil, true
+	}
+
+	var ids []uuid.UUID
+	for _, idStr := range strings.Split(idsStr, ",") {
+		id, err := uuid.Parse(strings.TrimSpace(idStr))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid " + name + " format",
+			})
+			return nil, false
+		}
+		ids = append(ids, id)
+	}
+	return ids, true
+}
+
 // handleDashboardError handles dashboard errors and returns appropriate HTTP responses.
 func (c *DashboardController) handleDashboardError(ctx *gin.Context, err error) {
 	var dashErr *domainerror.DashboardError
@@ -168,7 +215,9 @@ func (c *DashboardController) getStatusCodeForDashboardError(code domainerror.Da
 		domainerror.ErrCodeInvalidDateRange,
 		domainerror.ErrCodeInvalidGranularity,
 		domainerror.ErrCodeMissingGranularity,
-		domainerror.ErrCodeInvalidDateFormat:
+		domainerror.ErrCodeInvalidDateFormat,
+		domainerror.ErrCodeInvalidSort,
+		domainerror.ErrCodeInvalidCursor:
 		return http.StatusBadRequest
 	default:
 		return http.StatusInternalServerError
@@ -276,12 +325,19 @@ func (c *DashboardController) GetTrends(ctx *gin.Context) {
 		return
 	}
 
+	// Parse optional account filter
+	accountIDs, ok := c.parseAccountIDs(ctx)
+	if !ok {
+		return
+	}
+
 	// Execute use case
 	input := dashboard.GetTrendsInput{
 		UserID:      userID,
 		StartDate:   startDate,
 		EndDate:     endDate,
 		Granularity: gran,
+		AccountIDs:  accountIDs,
 	}
 
 	output, err := c.getTrendsUseCase.Execute(ctx.Request.Context(), input)
@@ -347,11 +403,18 @@ func (c *DashboardController) GetCategoryBreakdown(ctx *gin.Context) {
 		return
 	}
 
+	// Parse optional account filter
+	accountIDs, ok := c.parseAccountIDs(ctx)
+	if !ok {
+		return
+	}
+
 	// Execute use case
 	input := dashboard.GetCategoryBreakdownInput{
-		UserID:    userID,
-		StartDate: startDate,
-		EndDate:   endDate,
+		UserID:     userID,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		AccountIDs: accountIDs,
 	}
 
 	output, err := c.getCategoryBreakdownUseCase.Execute(ctx.Request.Context(), input)
@@ -433,6 +496,16 @@ func (c *DashboardController) GetPeriodTransactions(ctx *gin.Context) {
 		categoryID = &catID
 	}
 
+	// Parse optional account and tag filters
+	accountIDs, ok := c.parseAccountIDs(ctx)
+	if !ok {
+		return
+	}
+	tagIDs, ok := c.parseTagIDs(ctx)
+	if !ok {
+		return
+	}
+
 	// Parse pagination
 	limit, err := strconv.Atoi(limitStr)
 	if err != nil || limit <= 0 {
@@ -450,6 +523,11 @@ func (c *DashboardController) GetPeriodTransactions(ctx *gin.Context) {
 		StartDate:  startDate,
 		EndDate:    endDate,
 		CategoryID: categoryID,
+		AccountIDs: accountIDs,
+		TagIDs:     tagIDs,
+		SortBy:     ctx.Query("sort_by"),
+		SortOrder:  ctx.Query("sort_order"),
+		Cursor:     ctx.Query("cursor"),
 		Limit:      limit,
 		Offset:     offset,
 	}
@@ -464,3 +542,167 @@ func (c *DashboardController) GetPeriodTransactions(ctx *gin.Context) {
 	response := dto.ToPeriodTransactionsResponse(output)
 	ctx.JSON(http.StatusOK, response)
 }
+
+// GetTagBreakdown handles GET /dashboard/tag-breakdown requests.
+func (c *DashboardController) GetTagBreakdown(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse query parameters
+	startDateStr := ctx.Query("start_date")
+	endDateStr := ctx.Query("end_date")
+
+	// Validate required parameters
+	if startDateStr == "" {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "start_date is required",
+			Code:  string(domainerror.ErrCodeMissingStartDate),
+		})
+		return
+	}
+
+	if endDateStr == "" {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "end_date is required",
+			Code:  string(domainerror.ErrCodeMissingEndDate),
+		})
+		return
+	}
+
+	// Validate and parse dates
+	startDate, err := time.Parse("2006-01-02", startDateStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid start_date format, expected YYYY-MM-DD",
+			Code:  string(domainerror.ErrCodeInvalidDateFormat),
+		})
+		return
+	}
+
+	endDate, err := time.Parse("2006-01-02", endDateStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid end_date format, expected YYYY-MM-DD",
+			Code:  string(domainerror.ErrCodeInvalidDateFormat),
+		})
+		return
+	}
+
+	// Parse optional account filter
+	accountIDs, ok := c.parseAccountIDs(ctx)
+	if !ok {
+		return
+	}
+
+	// Execute use case
+	input := dashboard.GetTagBreakdownInput{
+		UserID:     userID,
+		StartDate:  startDate,
+		EndDate:    endDate,
+		AccountIDs: accountIDs,
+	}
+
+	output, err := c.getTagBreakdownUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleDashboardError(ctx, err)
+		return
+	}
+
+	// Transform to response DTO
+	response := dto.ToTagBreakdownResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// GetTopMerchants handles GET /dashboard/top-merchants requests.
+func (c *DashboardController) GetTopMerchants(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse query parameters
+	startDateStr := ctx.Query("start_date")
+	endDateStr := ctx.Query("end_date")
+	granularity := ctx.DefaultQuery("granularity", string(dashboard.GranularityMonthly))
+	limitStr := ctx.DefaultQuery("limit", strconv.Itoa(dashboard.DefaultTopMerchants))
+
+	// Validate required parameters
+	if startDateStr == "" {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "start_date is required",
+			Code:  string(domainerror.ErrCodeMissingStartDate),
+		})
+		return
+	}
+
+	if endDateStr == "" {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "end_date is required",
+			Code:  string(domainerror.ErrCodeMissingEndDate),
+		})
+		return
+	}
+
+	// Validate and parse dates
+	startDate, err := time.Parse("2006-01-02", startDateStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid start_date format, expected YYYY-MM-DD",
+			Code:  string(domainerror.ErrCodeInvalidDateFormat),
+		})
+		return
+	}
+
+	endDate, err := time.Parse("2006-01-02", endDateStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid end_date format, expected YYYY-MM-DD",
+			Code:  string(domainerror.ErrCodeInvalidDateFormat),
+		})
+		return
+	}
+
+	// Parse limit
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit <= 0 {
+		limit = dashboard.DefaultTopMerchants
+	}
+
+	// Parse optional account filter
+	accountIDs, ok := c.parseAccountIDs(ctx)
+	if !ok {
+		return
+	}
+
+	// Execute use case
+	input := dashboard.GetTopMerchantsInput{
+		UserID:      userID,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Granularity: dashboard.Granularity(granularity),
+		Limit:       limit,
+		AccountIDs:  accountIDs,
+	}
+
+	output, err := c.getTopMerchantsUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleDashboardError(ctx, err)
+		return
+	}
+
+	// Transform to response DTO
+	response := dto.ToTopMerchantsResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
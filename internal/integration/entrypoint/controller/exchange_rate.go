@@ -0,0 +1,275 @@
+// Package controller implements HTTP handlers for the API endpoints.
+package controller
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/dto"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
+)
+
+// ExchangeRateController handles exchange rate endpoints.
+type ExchangeRateController struct {
+	listUseCase   *exchangerate.ListExchangeRatesUseCase
+	createUseCase *exchangerate.CreateExchangeRateUseCase
+	deleteUseCase *exchangerate.DeleteExchangeRateUseCase
+	importUseCase *exchangerate.ImportExchangeRatesUseCase
+}
+
+// NewExchangeRateController creates a new exchange rate controller instance.
+func NewExchangeRateController(
+	listUseCase *exchangerate.ListExchangeRatesUseCase,
+	createUseCase *exchangerate.CreateExchangeRateUseCase,
+	deleteUseCase *exchangerate.DeleteExchangeRateUseCase,
+	importUseCase *exchangerate.ImportExchangeRatesUseCase,
+) *ExchangeRateController {
+	return &ExchangeRateController{
+		listUseCase:   listUseCase,
+		createUseCase: createUseCase,
+		deleteUseCase: deleteUseCase,
+		importUseCase: importUseCase,
+	}
+}
+
+// List handles GET /exchange-rates requests.
+func (c *ExchangeRateController) List(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.listUseCase.Execute(ctx.Request.Context(), exchangerate.ListExchangeRatesInput{
+		UserID:   userID,
+		Currency: ctx.Query("currency"),
+	})
+	if err != nil {
+		c.handleExchangeRateError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToExchangeRateListResponse(output.BaseCurrency, output.ExchangeRates)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create handles POST /exchange-rates requests.
+// A rate already entered for the same pair and date is replaced.
+func (c *ExchangeRateController) Create(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.CreateExchangeRateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body: " + err.Error(),
+			Code:  string(domainerror.ErrCodeExchangeRateMissingFields),
+		})
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid date format. Use YYYY-MM-DD",
+			Code:  string(domainerror.ErrCodeExchangeRateMissingFields),
+		})
+		return
+	}
+
+	// Build input
+	input := exchangerate.CreateExchangeRateInput{
+		UserID:       userID,
+		FromCurrency: req.FromCurrency,
+		ToCurrency:   req.ToCurrency,
+		Rate:         decimal.NewFromFloat(req.Rate),
+		Date:         date,
+	}
+
+	// Execute use case
+	output, err := c.createUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleExchangeRateError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToExchangeRateResponse(output.ExchangeRate)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// Import handles POST /exchange-rates/import requests.
+// It expects a multipart form with the rate file in the "file" field.
+func (c *ExchangeRateController) Import(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Read uploaded file
+	data, ok := c.readExchangeRateFile(ctx)
+	if !ok {
+		return
+	}
+
+	// Execute use case
+	output, err := c.importUseCase.Execute(ctx.Request.Context(), exchangerate.ImportExchangeRatesInput{
+		UserID: userID,
+		Data:   data,
+	})
+	if err != nil {
+		c.handleExchangeRateError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToImportExchangeRatesResponse(output.ImportedCount, output.Errors)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// Delete handles DELETE /exchange-rates/:id requests.
+func (c *ExchangeRateController) Delete(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse exchange rate ID from URL
+	exchangeRateID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid exchange rate ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	_, err = c.deleteUseCase.Execute(ctx.Request.Context(), exchangerate.DeleteExchangeRateInput{
+		ExchangeRateID: exchangeRateID,
+		UserID:         userID,
+	})
+	if err != nil {
+		c.handleExchangeRateError(ctx, err)
+		return
+	}
+
+	// Return no content on success
+	ctx.Status(http.StatusNoContent)
+}
+
+// readExchangeRateFile reads the uploaded "file" form field, enforcing MaxStatementFileSize.
+// It writes the error response and returns false when the file is missing or unreadable.
+func (c *ExchangeRateController) readExchangeRateFile(ctx *gin.Context) ([]byte, bool) {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "An exchange rate file is required in the 'file' field",
+			Code:  string(domainerror.ErrCodeMissingExchangeRateFile),
+		})
+		return nil, false
+	}
+
+	if fileHeader.Size > MaxStatementFileSize {
+		ctx.JSON(http.StatusRequestEntityTooLarge, dto.ErrorResponse{
+			Error: "Exchange rate file must not exceed 5 MB",
+			Code:  string(domainerror.ErrCodeExchangeRateFileTooLarge),
+		})
+		return nil, false
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Failed to read exchange rate file",
+			Code:  string(domainerror.ErrCodeInvalidExchangeRateFile),
+		})
+		return nil, false
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, MaxStatementFileSize))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Failed to read exchange rate file",
+			Code:  string(domainerror.ErrCodeInvalidExchangeRateFile),
+		})
+		return nil, false
+	}
+
+	return data, true
+}
+
+// handleExchangeRateError handles exchange rate errors and returns appropriate HTTP responses.
+func (c *ExchangeRateController) handleExchangeRateError(ctx *gin.Context, err error) {
+	var rateErr *domainerror.ExchangeRateError
+	if errors.As(err, &rateErr) {
+		statusCode := getStatusCodeForExchangeRateError(rateErr.Code)
+		ctx.JSON(statusCode, dto.ErrorResponse{
+			Error: rateErr.Message,
+			Code:  string(rateErr.Code),
+		})
+		return
+	}
+
+	// Generic server error
+	ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		Error: "An internal error occurred",
+	})
+}
+
+// getStatusCodeForExchangeRateError maps exchange rate error codes to HTTP status codes.
+// It is shared with the user controller, whose base currency change reports missing rates.
+func getStatusCodeForExchangeRateError(code domainerror.ExchangeRateErrorCode) int {
+	switch code {
+	case domainerror.ErrCodeExchangeRateNotFound:
+		return http.StatusNotFound
+	case domainerror.ErrCodeNotAuthorizedExchangeRate:
+		return http.StatusForbidden
+	case domainerror.ErrCodeExchangeRateFileTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case domainerror.ErrCodeExchangeRateUnavailable:
+		return http.StatusUnprocessableEntity
+	case domainerror.ErrCodeInvalidCurrency,
+		domainerror.ErrCodeInvalidExchangeRate,
+		domainerror.ErrCodeSameCurrency,
+		domainerror.ErrCodeExchangeRateMissingFields,
+		domainerror.ErrCodeInvalidExchangeRateFile,
+		domainerror.ErrCodeEmptyExchangeRateFile,
+		domainerror.ErrCodeMissingExchangeRateFile:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
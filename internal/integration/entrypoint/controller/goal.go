@@ -4,6 +4,7 @@ package controller
 import (
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -22,6 +23,10 @@ type GoalController struct {
 	getUseCase    *goal.GetGoalUseCase
 	updateUseCase *goal.UpdateGoalUseCase
 	deleteUseCase *goal.DeleteGoalUseCase
+
+	listContributionsUseCase  *goal.ListGoalContributionsUseCase
+	addContributionUseCase    *goal.AddGoalContributionUseCase
+	deleteContributionUseCase *goal.DeleteGoalContributionUseCase
 }
 
 // NewGoalController creates a new goal controller instance.
@@ -31,13 +36,19 @@ func NewGoalController(
 	getUseCase *goal.GetGoalUseCase,
 	updateUseCase *goal.UpdateGoalUseCase,
 	deleteUseCase *goal.DeleteGoalUseCase,
+	listContributionsUseCase *goal.ListGoalContributionsUseCase,
+	addContributionUseCase *goal.AddGoalContributionUseCase,
+	deleteContributionUseCase *goal.DeleteGoalContributionUseCase,
 ) *GoalController {
 	return &GoalController{
-		listUseCase:   listUseCase,
-		createUseCase: createUseCase,
-		getUseCase:    getUseCase,
-		updateUseCase: updateUseCase,
-		deleteUseCase: deleteUseCase,
+		listUseCase:               listUseCase,
+		createUseCase:             createUseCase,
+		getUseCase:                getUseCase,
+		updateUseCase:             updateUseCase,
+		deleteUseCase:             deleteUseCase,
+		listContributionsUseCase:  listContributionsUseCase,
+		addContributionUseCase:    addContributionUseCase,
+		deleteContributionUseCase: deleteContributionUseCase,
 	}
 }
 
@@ -94,13 +105,23 @@ func (c *GoalController) Create(ctx *gin.Context) {
 		return
 	}
 
-	// Parse category ID
-	categoryID, err := uuid.Parse(req.CategoryID)
-	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
-			Error: "Invalid category ID format",
-			Code:  string(domainerror.ErrCodeMissingGoalFields),
-		})
+	// Parse category ID if provided (savings goals have none)
+	var categoryID uuid.UUID
+	if req.CategoryID != "" {
+		var err error
+		categoryID, err = uuid.Parse(req.CategoryID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid category ID format",
+				Code:  string(domainerror.ErrCodeMissingGoalFields),
+			})
+			return
+		}
+	}
+
+	// Parse target date if provided
+	targetDate, ok := c.parseOptionalDate(ctx, req.TargetDate, "target_date")
+	if !ok {
 		return
 	}
 
@@ -110,6 +131,12 @@ func (c *GoalController) Create(ctx *gin.Context) {
 		CategoryID:    categoryID,
 		LimitAmount:   req.LimitAmount,
 		AlertOnExceed: req.AlertOnExceed,
+		Name:          req.Name,
+		TargetAmount:  req.TargetAmount,
+		TargetDate:    targetDate,
+	}
+	if req.Kind != nil {
+		input.Kind = entity.GoalKind(*req.Kind)
 	}
 
 	// Convert period if provided
@@ -166,12 +193,7 @@ func (c *GoalController) Get(ctx *gin.Context) {
 	}
 
 	// Build response
-	response := dto.ToGoalResponse(output.Goal)
-	response.CurrentAmount = output.CurrentAmount
-	if output.Category != nil {
-		catResponse := dto.ToCategoryResponse(output.Category)
-		response.Category = &catResponse
-	}
+	response := dto.ToGoalResponseWithCategory(output.Goal)
 	ctx.JSON(http.StatusOK, response)
 }
 
@@ -206,12 +228,22 @@ func (c *GoalController) Update(ctx *gin.Context) {
 		return
 	}
 
+	// Parse target date if provided
+	targetDate, ok := c.parseOptionalDate(ctx, req.TargetDate, "target_date")
+	if !ok {
+		return
+	}
+
 	// Build input
 	input := goal.UpdateGoalInput{
-		GoalID:        goalID,
-		UserID:        userID,
-		LimitAmount:   req.LimitAmount,
-		AlertOnExceed: req.AlertOnExceed,
+		GoalID:          goalID,
+		UserID:          userID,
+		LimitAmount:     req.LimitAmount,
+		AlertOnExceed:   req.AlertOnExceed,
+		Name:            req.Name,
+		TargetAmount:    req.TargetAmount,
+		TargetDate:      targetDate,
+		ClearTargetDate: req.ClearTargetDate,
 	}
 
 	// Convert period if provided
@@ -271,6 +303,176 @@ func (c *GoalController) Delete(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
 
+// ListContributions handles GET /goals/:id/contributions requests.
+func (c *GoalController) ListContributions(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse goal ID from URL
+	goalID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid goal ID format",
+		})
+		return
+	}
+
+	// Build input
+	input := goal.ListGoalContributionsInput{
+		GoalID: goalID,
+		UserID: userID,
+	}
+
+	// Execute use case
+	output, err := c.listContributionsUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleGoalError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToGoalContributionListResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// AddContribution handles POST /goals/:id/contributions requests.
+func (c *GoalController) AddContribution(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse goal ID from URL
+	goalID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid goal ID format",
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.AddGoalContributionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body: " + err.Error(),
+			Code:  string(domainerror.ErrCodeMissingGoalFields),
+		})
+		return
+	}
+
+	// Parse date if provided
+	date, ok := c.parseOptionalDate(ctx, req.Date, "date")
+	if !ok {
+		return
+	}
+
+	// Build input
+	input := goal.AddGoalContributionInput{
+		GoalID: goalID,
+		UserID: userID,
+		Amount: req.Amount,
+		Date:   date,
+		Notes:  req.Notes,
+	}
+	if req.TransactionID != nil {
+		transactionID, err := uuid.Parse(*req.TransactionID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid transaction ID format",
+				Code:  string(domainerror.ErrCodeMissingGoalFields),
+			})
+			return
+		}
+		input.TransactionID = &transactionID
+	}
+
+	// Execute use case
+	output, err := c.addContributionUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleGoalError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToGoalContributionResponse(output.Contribution)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// DeleteContribution handles DELETE /goals/:id/contributions/:contribution_id requests.
+func (c *GoalController) DeleteContribution(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse goal and contribution IDs from URL
+	goalID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid goal ID format",
+		})
+		return
+	}
+	contributionID, err := uuid.Parse(ctx.Param("contribution_id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid contribution ID format",
+		})
+		return
+	}
+
+	// Build input
+	input := goal.DeleteGoalContributionInput{
+		GoalID:         goalID,
+		ContributionID: contributionID,
+		UserID:         userID,
+	}
+
+	// Execute use case
+	_, err = c.deleteContributionUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleGoalError(ctx, err)
+		return
+	}
+
+	// Return no content on success
+	ctx.Status(http.StatusNoContent)
+}
+
+// parseOptionalDate parses an optional YYYY-MM-DD date, responding with 400 when it is invalid.
+func (c *GoalController) parseOptionalDate(ctx *gin.Context, value *string, field string) (*time.Time, bool) {
+	if value == nil || *value == "" {
+		return nil, true
+	}
+	date, err := time.Parse("2006-01-02", *value)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid " + field + " format. Use YYYY-MM-DD",
+			Code:  string(domainerror.ErrCodeMissingGoalFields),
+		})
+		return nil, false
+	}
+	return &date, true
+}
+
 // handleGoalError handles goal errors and returns appropriate HTTP responses.
 func (c *GoalController) handleGoalError(ctx *gin.Context, err error) {
 	var goalErr *domainerror.GoalError
@@ -292,15 +494,23 @@ func (c *GoalController) handleGoalError(ctx *gin.Context, err error) {
 // getStatusCodeForGoalError maps goal error codes to HTTP status codes.
 func (c *GoalController) getStatusCodeForGoalError(code domainerror.GoalErrorCode) int {
 	switch code {
-	case domainerror.ErrCodeGoalNotFound, domainerror.ErrCodeGoalCategoryNotFound:
+	case domainerror.ErrCodeGoalNotFound,
+		domainerror.ErrCodeGoalCategoryNotFound,
+		domainerror.ErrCodeContributionNotFound,
+		domainerror.ErrCodeContributionTxNotFound:
 		return http.StatusNotFound
-	case domainerror.ErrCodeGoalAlreadyExists:
+	case domainerror.ErrCodeGoalAlreadyExists, domainerror.ErrCodeContributionAlreadyExists:
 		return http.StatusConflict
 	case domainerror.ErrCodeUnauthorizedGoalAccess, domainerror.ErrCodeCategoryDoesNotBelongUser:
 		return http.StatusForbidden
 	case domainerror.ErrCodeInvalidLimitAmount,
 		domainerror.ErrCodeInvalidGoalPeriod,
-		domainerror.ErrCodeMissingGoalFields:
+		domainerror.ErrCodeMissingGoalFields,
+		domainerror.ErrCodeInvalidGoalKind,
+		domainerror.ErrCodeInvalidTargetAmount,
+		domainerror.ErrCodeGoalNotSavings,
+		domainerror.ErrCodeInvalidContributionAmount,
+		domainerror.ErrCodeContributionNotesTooLong:
 		return http.StatusBadRequest
 	default:
 		return http.StatusInternalServerError
@@ -0,0 +1,392 @@
+// Package controller implements HTTP handlers for the API endpoints.
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/dto"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
+	"github.com/finance-tracker/backend/internal/integration/statement"
+)
+
+// MaxStatementFileSize is the maximum accepted size for uploaded statement files (5 MB).
+const MaxStatementFileSize = 5 << 20
+
+// ImportController handles bank statement file import endpoints.
+type ImportController struct {
+	importStatementUseCase  *transaction.ImportStatementUseCase
+	previewCSVImportUseCase *transaction.PreviewCSVImportUseCase
+	importCSVUseCase        *transaction.ImportCSVUseCase
+}
+
+// NewImportController creates a new import controller instance.
+func NewImportController(
+	importStatementUseCase *transaction.ImportStatementUseCase,
+	previewCSVImportUseCase *transaction.PreviewCSVImportUseCase,
+	importCSVUseCase *transaction.ImportCSVUseCase,
+) *ImportController {
+	return &ImportController{
+		importStatementUseCase:  importStatementUseCase,
+		previewCSVImportUseCase: previewCSVImportUseCase,
+		importCSVUseCase:        importCSVUseCase,
+	}
+}
+
+// ImportOFX handles POST /transactions/import/ofx requests.
+// Accepts a multipart form with an OFX 1.x/2.x (or QFX) file in the "file" field
+// and an optional "apply_auto_category" flag (defaults to true).
+func (c *ImportController) ImportOFX(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Read uploaded file
+	data, ok := c.readStatementFile(ctx)
+	if !ok {
+		return
+	}
+
+	// Parse apply_auto_category flag
+	applyAutoCategory, ok := c.parseApplyAutoCategory(ctx)
+	if !ok {
+		return
+	}
+
+	// Parse OFX statement
+	stmt, err := statement.ParseOFX(data)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: err.Error(),
+			Code:  string(domainerror.ErrCodeInvalidStatementFile),
+		})
+		return
+	}
+
+	// Convert statement entries to use case input
+	lines := make([]transaction.StatementLineInput, len(stmt.Transactions))
+	for i, txn := range stmt.Transactions {
+		lines[i] = transaction.StatementLineInput{
+			ExternalID:  stmt.ExternalID(txn),
+			Date:        txn.PostedAt,
+			Description: txn.Description(),
+			Amount:      txn.Amount,
+		}
+	}
+
+	// Execute use case
+	input := transaction.ImportStatementInput{
+		UserID:            userID,
+		Lines:             lines,
+		ApplyAutoCategory: applyAutoCategory,
+		Currency:          stmt.Currency,
+	}
+
+	output, err := c.importStatementUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleImportError(ctx, err)
+		return
+	}
+
+	// Build response
+	info := dto.StatementInfoResponse{
+		Format:      "ofx",
+		AccountType: string(stmt.AccountType),
+		BankID:      stmt.BankID,
+		AccountID:   stmt.AccountID,
+		Currency:    stmt.Currency,
+	}
+	if stmt.StartDate != nil {
+		startDate := stmt.StartDate.Format("2006-01-02")
+		info.StartDate = &startDate
+	}
+	if stmt.EndDate != nil {
+		endDate := stmt.EndDate.Format("2006-01-02")
+		info.EndDate = &endDate
+	}
+
+	response := dto.ToStatementImportResponse(info, output)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// PreviewCSV handles POST /transactions/import/csv/preview requests.
+// Accepts a multipart form with a CSV file in the "file" field and either a saved
+// "profile_id" or a one-off "mapping" (JSON). Nothing is saved.
+func (c *ImportController) PreviewCSV(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Read uploaded file
+	data, ok := c.readStatementFile(ctx)
+	if !ok {
+		return
+	}
+
+	// Parse mapping source and flags
+	source, ok := c.parseCSVImportSource(ctx)
+	if !ok {
+		return
+	}
+
+	applyAutoCategory, ok := c.parseApplyAutoCategory(ctx)
+	if !ok {
+		return
+	}
+
+	// Execute use case
+	input := transaction.PreviewCSVImportInput{
+		UserID:            userID,
+		Data:              data,
+		Source:            source,
+		ApplyAutoCategory: applyAutoCategory,
+	}
+
+	output, err := c.previewCSVImportUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleImportError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToCSVPreviewResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// ImportCSV handles POST /transactions/import/csv requests.
+// Accepts the same form as PreviewCSV and imports every parseable line that was not imported before.
+func (c *ImportController) ImportCSV(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Read uploaded file
+	data, ok := c.readStatementFile(ctx)
+	if !ok {
+		return
+	}
+
+	// Parse mapping source and flags
+	source, ok := c.parseCSVImportSource(ctx)
+	if !ok {
+		return
+	}
+
+	applyAutoCategory, ok := c.parseApplyAutoCategory(ctx)
+	if !ok {
+		return
+	}
+
+	// Execute use case
+	input := transaction.ImportCSVInput{
+		UserID:            userID,
+		Data:              data,
+		Source:            source,
+		ApplyAutoCategory: applyAutoCategory,
+		Currency:          ctx.PostForm("currency"),
+	}
+
+	output, err := c.importCSVUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleImportError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToCSVImportResponse(output)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// parseCSVImportSource reads the "profile_id" or "mapping" form fields.
+// It writes the error response and returns false when either value is malformed.
+func (c *ImportController) parseCSVImportSource(ctx *gin.Context) (transaction.CSVImportSource, bool) {
+	var source transaction.CSVImportSource
+
+	if profileIDStr := strings.TrimSpace(ctx.PostForm("profile_id")); profileIDStr != "" {
+		profileID, err := uuid.Parse(profileIDStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid profile ID format",
+			})
+			return source, false
+		}
+		source.ProfileID = &profileID
+	}
+
+	if mappingJSON := strings.TrimSpace(ctx.PostForm("mapping")); mappingJSON != "" {
+		var req dto.ColumnMappingRequest
+		if err := json.Unmarshal([]byte(mappingJSON), &req); err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid mapping, expected a JSON object",
+				Code:  string(domainerror.ErrCodeInvalidColumnMapping),
+			})
+			return source, false
+		}
+
+		mapping := &entity.ImportProfile{
+			Delimiter:         req.Delimiter,
+			HasHeader:         true,
+			SkipRows:          req.SkipRows,
+			DateColumn:        strings.TrimSpace(req.DateColumn),
+			DescriptionColumn: strings.TrimSpace(req.DescriptionColumn),
+			AmountColumn:      strings.TrimSpace(req.AmountColumn),
+			DebitColumn:       strings.TrimSpace(req.DebitColumn),
+			CreditColumn:      strings.TrimSpace(req.CreditColumn),
+			InstallmentColumn: strings.TrimSpace(req.InstallmentColumn),
+			DateFormat:        entity.DateFormat(req.DateFormat),
+			NumberFormat:      entity.NumberFormat(req.NumberFormat),
+			InvertAmounts:     req.InvertAmounts,
+		}
+		if req.HasHeader != nil {
+			mapping.HasHeader = *req.HasHeader
+		}
+		source.Mapping = mapping
+	}
+
+	return source, true
+}
+
+// parseApplyAutoCategory reads the optional "apply_auto_category" form field (defaults to true).
+// It writes the error response and returns false when the value is not a boolean.
+func (c *ImportController) parseApplyAutoCategory(ctx *gin.Context) (bool, bool) {
+	applyAutoCategory, err := strconv.ParseBool(ctx.DefaultPostForm("apply_auto_category", "true"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid apply_auto_category value, expected true or false",
+		})
+		return false, false
+	}
+	return applyAutoCategory, true
+}
+
+// readStatementFile reads the uploaded "file" form field, enforcing MaxStatementFileSize.
+// It writes the error response and returns false when the file is missing or invalid.
+func (c *ImportController) readStatementFile(ctx *gin.Context) ([]byte, bool) {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "A statement file is required in the 'file' field",
+			Code:  string(domainerror.ErrCodeMissingStatementFile),
+		})
+		return nil, false
+	}
+
+	if fileHeader.Size > MaxStatementFileSize {
+		ctx.JSON(http.StatusRequestEntityTooLarge, dto.ErrorResponse{
+			Error: "Statement file must not exceed 5 MB",
+			Code:  string(domainerror.ErrCodeStatementFileTooLarge),
+		})
+		return nil, false
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Failed to read statement file",
+			Code:  string(domainerror.ErrCodeInvalidStatementFile),
+		})
+		return nil, false
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, MaxStatementFileSize))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Failed to read statement file",
+			Code:  string(domainerror.ErrCodeInvalidStatementFile),
+		})
+		return nil, false
+	}
+
+	return data, true
+}
+
+// handleImportError handles import errors and returns appropriate HTTP responses.
+func (c *ImportController) handleImportError(ctx *gin.Context, err error) {
+	var txnErr *domainerror.TransactionError
+	if errors.As(err, &txnErr) {
+		statusCode := c.getStatusCodeForImportError(txnErr.Code)
+		ctx.JSON(statusCode, dto.ErrorResponse{
+			Error: txnErr.Message,
+			Code:  string(txnErr.Code),
+		})
+		return
+	}
+
+	var profileErr *domainerror.ImportProfileError
+	if errors.As(err, &profileErr) {
+		statusCode := c.getStatusCodeForImportProfileError(profileErr.Code)
+		ctx.JSON(statusCode, dto.ErrorResponse{
+			Error: profileErr.Message,
+			Code:  string(profileErr.Code),
+		})
+		return
+	}
+
+	// Generic server error
+	ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		Error: "An internal error occurred",
+	})
+}
+
+// getStatusCodeForImportError maps import error codes to HTTP status codes.
+func (c *ImportController) getStatusCodeForImportError(code domainerror.TransactionErrorCode) int {
+	switch code {
+	case domainerror.ErrCodeStatementFileTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case domainerror.ErrCodeInvalidStatementFile,
+		domainerror.ErrCodeEmptyStatement,
+		domainerror.ErrCodeMissingStatementFile,
+		domainerror.ErrCodeInvalidTxnCurrency:
+		return http.StatusBadRequest
+	case domainerror.ErrCodeTxnRateUnavailable:
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// getStatusCodeForImportProfileError maps mapping and profile error codes to HTTP status codes.
+func (c *ImportController) getStatusCodeForImportProfileError(code domainerror.ImportProfileErrorCode) int {
+	switch code {
+	case domainerror.ErrCodeImportProfileNotFound:
+		return http.StatusNotFound
+	case domainerror.ErrCodeNotAuthorizedImportProfile:
+		return http.StatusForbidden
+	case domainerror.ErrCodeInvalidColumnMapping,
+		domainerror.ErrCodeInvalidImportDateFormat,
+		domainerror.ErrCodeInvalidImportNumberFormat,
+		domainerror.ErrCodeInvalidDelimiter:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,256 @@
+// Package controller implements HTTP handlers for the API endpoints.
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	importprofile "github.com/finance-tracker/backend/internal/application/usecase/import_profile"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/dto"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
+)
+
+// ImportProfileController handles saved CSV import profile endpoints.
+type ImportProfileController struct {
+	listUseCase   *importprofile.ListImportProfilesUseCase
+	createUseCase *importprofile.CreateImportProfileUseCase
+	updateUseCase *importprofile.UpdateImportProfileUseCase
+	deleteUseCase *importprofile.DeleteImportProfileUseCase
+}
+
+// NewImportProfileController creates a new import profile controller instance.
+func NewImportProfileController(
+	listUseCase *importprofile.ListImportProfilesUseCase,
+	createUseCase *importprofile.CreateImportProfileUseCase,
+	updateUseCase *importprofile.UpdateImportProfileUseCase,
+	deleteUseCase *importprofile.DeleteImportProfileUseCase,
+) *ImportProfileController {
+	return &ImportProfileController{
+		listUseCase:   listUseCase,
+		createUseCase: createUseCase,
+		updateUseCase: updateUseCase,
+		deleteUseCase: deleteUseCase,
+	}
+}
+
+// List handles GET /import-profiles requests.
+func (c *ImportProfileController) List(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.listUseCase.Execute(ctx.Request.Context(), importprofile.ListImportProfilesInput{
+		UserID: userID,
+	})
+	if err != nil {
+		c.handleImportProfileError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToImportProfileListResponse(output.Profiles)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create handles POST /import-profiles requests.
+func (c *ImportProfileController) Create(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.CreateImportProfileRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body",
+		})
+		return
+	}
+
+	// Build input
+	input := importprofile.CreateImportProfileInput{
+		UserID:            userID,
+		Name:              req.Name,
+		Delimiter:         req.Delimiter,
+		HasHeader:         req.HasHeader,
+		SkipRows:          req.SkipRows,
+		DateColumn:        req.DateColumn,
+		DescriptionColumn: req.DescriptionColumn,
+		AmountColumn:      req.AmountColumn,
+		DebitColumn:       req.DebitColumn,
+		CreditColumn:      req.CreditColumn,
+		InstallmentColumn: req.InstallmentColumn,
+		DateFormat:        entity.DateFormat(req.DateFormat),
+		NumberFormat:      entity.NumberFormat(req.NumberFormat),
+		InvertAmounts:     req.InvertAmounts,
+	}
+
+	// Execute use case
+	output, err := c.createUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleImportProfileError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToImportProfileResponse(output.Profile)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// Update handles PATCH /import-profiles/:id requests.
+func (c *ImportProfileController) Update(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse profile ID from URL
+	profileID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid profile ID format",
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.UpdateImportProfileRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body",
+		})
+		return
+	}
+
+	// Build input
+	input := importprofile.UpdateImportProfileInput{
+		ProfileID:         profileID,
+		UserID:            userID,
+		Name:              req.Name,
+		Delimiter:         req.Delimiter,
+		HasHeader:         req.HasHeader,
+		SkipRows:          req.SkipRows,
+		DateColumn:        req.DateColumn,
+		DescriptionColumn: req.DescriptionColumn,
+		AmountColumn:      req.AmountColumn,
+		DebitColumn:       req.DebitColumn,
+		CreditColumn:      req.CreditColumn,
+		InstallmentColumn: req.InstallmentColumn,
+		InvertAmounts:     req.InvertAmounts,
+	}
+	if req.DateFormat != nil {
+		dateFormat := entity.DateFormat(*req.DateFormat)
+		input.DateFormat = &dateFormat
+	}
+	if req.NumberFormat != nil {
+		numberFormat := entity.NumberFormat(*req.NumberFormat)
+		input.NumberFormat = &numberFormat
+	}
+
+	// Execute use case
+	output, err := c.updateUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleImportProfileError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToImportProfileResponse(output.Profile)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Delete handles DELETE /import-profiles/:id requests.
+func (c *ImportProfileController) Delete(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse profile ID from URL
+	profileID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid profile ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	_, err = c.deleteUseCase.Execute(ctx.Request.Context(), importprofile.DeleteImportProfileInput{
+		ProfileID: profileID,
+		UserID:    userID,
+	})
+	if err != nil {
+		c.handleImportProfileError(ctx, err)
+		return
+	}
+
+	// Return no content on success
+	ctx.Status(http.StatusNoContent)
+}
+
+// handleImportProfileError handles import profile errors and returns appropriate HTTP responses.
+func (c *ImportProfileController) handleImportProfileError(ctx *gin.Context, err error) {
+	var profileErr *domainerror.ImportProfileError
+	if errors.As(err, &profileErr) {
+		statusCode := c.getStatusCodeForImportProfileError(profileErr.Code)
+		ctx.JSON(statusCode, dto.ErrorResponse{
+			Error: profileErr.Message,
+			Code:  string(profileErr.Code),
+		})
+		return
+	}
+
+	// Generic server error
+	ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		Error: "An internal error occurred",
+	})
+}
+
+// getStatusCodeForImportProfileError maps import profile error codes to HTTP status codes.
+func (c *ImportProfileController) getStatusCodeForImportProfileError(code domainerror.ImportProfileErrorCode) int {
+	switch code {
+	case domainerror.ErrCodeImportProfileNotFound:
+		return http.StatusNotFound
+	case domainerror.ErrCodeImportProfileNameExists:
+		return http.StatusConflict
+	case domainerror.ErrCodeNotAuthorizedImportProfile:
+		return http.StatusForbidden
+	case domainerror.ErrCodeInvalidColumnMapping,
+		domainerror.ErrCodeInvalidImportDateFormat,
+		domainerror.ErrCodeInvalidImportNumberFormat,
+		domainerror.ErrCodeInvalidDelimiter,
+		domainerror.ErrCodeImportProfileMissingFields:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,174 @@
+// Package controller implements HTTP handlers for the API endpoints.
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/installment"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/dto"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
+)
+
+// InstallmentController handles installment plan endpoints.
+type InstallmentController struct {
+	listUseCase        *installment.ListPlansUseCase
+	getUseCase         *installment.GetPlanUseCase
+	commitmentsUseCase *installment.GetCommitmentsUseCase
+}
+
+// NewInstallmentController creates a new installment controller instance.
+func NewInstallmentController(
+	listUseCase *installment.ListPlansUseCase,
+	getUseCase *installment.GetPlanUseCase,
+	commitmentsUseCase *installment.GetCommitmentsUseCase,
+) *InstallmentController {
+	return &InstallmentController{
+		listUseCase:        listUseCase,
+		getUseCase:         getUseCase,
+		commitmentsUseCase: commitmentsUseCase,
+	}
+}
+
+// List handles GET /installments requests.
+func (c *InstallmentController) List(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.listUseCase.Execute(ctx.Request.Context(), installment.ListPlansInput{
+		UserID:     userID,
+		ActiveOnly: ctx.Query("active") == "true",
+	})
+	if err != nil {
+		c.handleInstallmentError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToInstallmentPlanListResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Get handles GET /installments/:id requests.
+func (c *InstallmentController) Get(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse plan ID from URL
+	planID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid installment plan ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.getUseCase.Execute(ctx.Request.Context(), installment.GetPlanInput{
+		PlanID: planID,
+		UserID: userID,
+	})
+	if err != nil {
+		c.handleInstallmentError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToInstallmentPlanDetailResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// GetCommitments handles GET /installments/commitments requests.
+func (c *InstallmentController) GetCommitments(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse months
+	months := 0
+	if monthsStr := ctx.Query("months"); monthsStr != "" {
+		parsed, err := strconv.Atoi(monthsStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "months must be a number",
+				Code:  string(domainerror.ErrCodeInvalidCommitmentMonths),
+			})
+			return
+		}
+		months = parsed
+	}
+
+	// Execute use case
+	output, err := c.commitmentsUseCase.Execute(ctx.Request.Context(), installment.GetCommitmentsInput{
+		UserID:    userID,
+		FromCycle: ctx.Query("from"),
+		Months:    months,
+	})
+	if err != nil {
+		c.handleInstallmentError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToCommitmentsResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// handleInstallmentError handles installment errors and returns appropriate HTTP responses.
+func (c *InstallmentController) handleInstallmentError(ctx *gin.Context, err error) {
+	var installmentErr *domainerror.InstallmentError
+	if errors.As(err, &installmentErr) {
+		statusCode := c.getStatusCodeForInstallmentError(installmentErr.Code)
+		ctx.JSON(statusCode, dto.ErrorResponse{
+			Error: installmentErr.Message,
+			Code:  string(installmentErr.Code),
+		})
+		return
+	}
+
+	// Generic server error
+	ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		Error: "An internal error occurred",
+	})
+}
+
+// getStatusCodeForInstallmentError maps installment error codes to HTTP status codes.
+func (c *InstallmentController) getStatusCodeForInstallmentError(code domainerror.InstallmentErrorCode) int {
+	switch code {
+	case domainerror.ErrCodeInstallmentPlanNotFound:
+		return http.StatusNotFound
+	case domainerror.ErrCodeNotAuthorizedInstallmentPlan:
+		return http.StatusForbidden
+	case domainerror.ErrCodeInvalidCommitmentMonths,
+		domainerror.ErrCodeInvalidCommitmentStart:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,217 @@
+// Package controller implements HTTP handlers for the API endpoints.
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/merchant"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/dto"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
+)
+
+// MerchantController handles merchant endpoints.
+type MerchantController struct {
+	listUseCase   *merchant.ListMerchantsUseCase
+	updateUseCase *merchant.UpdateMerchantUseCase
+	mergeUseCase  *merchant.MergeMerchantsUseCase
+}
+
+// NewMerchantController creates a new merchant controller instance.
+func NewMerchantController(
+	listUseCase *merchant.ListMerchantsUseCase,
+	updateUseCase *merchant.UpdateMerchantUseCase,
+	mergeUseCase *merchant.MergeMerchantsUseCase,
+) *MerchantController {
+	return &MerchantController{
+		listUseCase:   listUseCase,
+		updateUseCase: updateUseCase,
+		mergeUseCase:  mergeUseCase,
+	}
+}
+
+// List handles GET /merchants requests.
+func (c *MerchantController) List(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.listUseCase.Execute(ctx.Request.Context(), merchant.ListMerchantsInput{
+		UserID: userID,
+	})
+	if err != nil {
+		c.handleMerchantError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToMerchantListResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Update handles PATCH /merchants/:id requests.
+func (c *MerchantController) Update(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse merchant ID from URL
+	merchantID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid merchant ID format",
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.UpdateMerchantRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body",
+			Code:  string(domainerror.ErrCodeMerchantMissingFields),
+		})
+		return
+	}
+
+	// Build input
+	input := merchant.UpdateMerchantInput{
+		MerchantID:           merchantID,
+		UserID:               userID,
+		Name:                 req.Name,
+		Aliases:              req.Aliases,
+		ClearDefaultCategory: req.ClearDefaultCategory,
+	}
+
+	if req.DefaultCategoryID != nil {
+		categoryID, err := uuid.Parse(*req.DefaultCategoryID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid default category ID format",
+			})
+			return
+		}
+		input.DefaultCategoryID = &categoryID
+	}
+
+	// Execute use case
+	output, err := c.updateUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleMerchantError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToMerchantResponse(output.Merchant)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Merge handles POST /merchants/:id/merge requests.
+func (c *MerchantController) Merge(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse merchant ID from URL
+	targetID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid merchant ID format",
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.MergeMerchantsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body",
+			Code:  string(domainerror.ErrCodeMerchantMissingFields),
+		})
+		return
+	}
+
+	sourceIDs := make([]uuid.UUID, len(req.MerchantIDs))
+	for i, idStr := range req.MerchantIDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid merchant ID format",
+			})
+			return
+		}
+		sourceIDs[i] = id
+	}
+
+	// Execute use case
+	output, err := c.mergeUseCase.Execute(ctx.Request.Context(), merchant.MergeMerchantsInput{
+		TargetID:  targetID,
+		UserID:    userID,
+		SourceIDs: sourceIDs,
+	})
+	if err != nil {
+		c.handleMerchantError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToMergeMerchantsResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// handleMerchantError handles merchant errors and returns appropriate HTTP responses.
+func (c *MerchantController) handleMerchantError(ctx *gin.Context, err error) {
+	var merchantErr *domainerror.MerchantError
+	if errors.As(err, &merchantErr) {
+		statusCode := c.getStatusCodeForMerchantError(merchantErr.Code)
+		ctx.JSON(statusCode, dto.ErrorResponse{
+			Error: merchantErr.Message,
+			Code:  string(merchantErr.Code),
+		})
+		return
+	}
+
+	// Generic server error
+	ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		Error: "An internal error occurred",
+	})
+}
+
+// getStatusCodeForMerchantError maps merchant error codes to HTTP status codes.
+func (c *MerchantController) getStatusCodeForMerchantError(code domainerror.MerchantErrorCode) int {
+	switch code {
+	case domainerror.ErrCodeMerchantNotFound:
+		return http.StatusNotFound
+	case domainerror.ErrCodeNotAuthorizedMerchant:
+		return http.StatusForbidden
+	case domainerror.ErrCodeMerchantMissingFields,
+		domainerror.ErrCodeInvalidMerchantMerge,
+		domainerror.ErrCodeMerchantCategoryNotFound:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,373 @@
+// Package controller implements HTTP handlers for the API endpoints.
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	recurringschedule "github.com/finance-tracker/backend/internal/application/usecase/recurring_schedule"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/dto"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
+)
+
+// RecurringScheduleController handles recurring transaction schedule endpoints.
+type RecurringScheduleController struct {
+	listUseCase   *recurringschedule.ListRecurringSchedulesUseCase
+	getUseCase    *recurringschedule.GetRecurringScheduleUseCase
+	createUseCase *recurringschedule.CreateRecurringScheduleUseCase
+	updateUseCase *recurringschedule.UpdateRecurringScheduleUseCase
+	deleteUseCase *recurringschedule.DeleteRecurringScheduleUseCase
+}
+
+// NewRecurringScheduleController creates a new recurring schedule controller instance.
+func NewRecurringScheduleController(
+	listUseCase *recurringschedule.ListRecurringSchedulesUseCase,
+	getUseCase *recurringschedule.GetRecurringScheduleUseCase,
+	createUseCase *recurringschedule.CreateRecurringScheduleUseCase,
+	updateUseCase *recurringschedule.UpdateRecurringScheduleUseCase,
+	deleteUseCase *recurringschedule.DeleteRecurringScheduleUseCase,
+) *RecurringScheduleController {
+	return &RecurringScheduleController{
+		listUseCase:   listUseCase,
+		getUseCase:    getUseCase,
+		createUseCase: createUseCase,
+		updateUseCase: updateUseCase,
+		deleteUseCase: deleteUseCase,
+	}
+}
+
+// List handles GET /recurring-schedules requests.
+func (c *RecurringScheduleController) List(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.listUseCase.Execute(ctx.Request.Context(), recurringschedule.ListRecurringSchedulesInput{
+		UserID: userID,
+	})
+	if err != nil {
+		c.handleRecurringScheduleError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToRecurringScheduleListResponse(output.Schedules)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Get handles GET /recurring-schedules/:id requests.
+func (c *RecurringScheduleController) Get(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse schedule ID from URL
+	scheduleID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid schedule ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.getUseCase.Execute(ctx.Request.Context(), recurringschedule.GetRecurringScheduleInput{
+		ScheduleID: scheduleID,
+		UserID:     userID,
+	})
+	if err != nil {
+		c.handleRecurringScheduleError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToRecurringScheduleResponse(output.Schedule)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create handles POST /recurring-schedules requests.
+func (c *RecurringScheduleController) Create(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.CreateRecurringScheduleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body: " + err.Error(),
+			Code:  string(domainerror.ErrCodeRecurringScheduleMissingFields),
+		})
+		return
+	}
+
+	// Parse dates
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		c.respondInvalidDate(ctx, "start_date")
+		return
+	}
+	endDate, ok := c.parseOptionalDate(ctx, req.EndDate, "end_date")
+	if !ok {
+		return
+	}
+
+	// Parse category ID if provided
+	categoryID, ok := c.parseOptionalCategoryID(ctx, req.CategoryID)
+	if !ok {
+		return
+	}
+
+	// Build input
+	input := recurringschedule.CreateRecurringScheduleInput{
+		UserID:         userID,
+		Description:    req.Description,
+		Amount:         decimal.NewFromFloat(req.Amount),
+		Type:           entity.TransactionType(req.Type),
+		CategoryID:     categoryID,
+		Notes:          req.Notes,
+		Frequency:      entity.RecurrenceFrequency(req.Frequency),
+		Interval:       req.Interval,
+		DayOfMonth:     req.DayOfMonth,
+		DayOfWeek:      req.DayOfWeek,
+		StartDate:      startDate,
+		EndDate:        endDate,
+		MaxOccurrences: req.MaxOccurrences,
+	}
+
+	// Execute use case
+	output, err := c.createUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleRecurringScheduleError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToRecurringScheduleResponse(output.Schedule)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// Update handles PATCH /recurring-schedules/:id requests.
+func (c *RecurringScheduleController) Update(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse schedule ID from URL
+	scheduleID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid schedule ID format",
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.UpdateRecurringScheduleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body: " + err.Error(),
+			Code:  string(domainerror.ErrCodeRecurringScheduleMissingFields),
+		})
+		return
+	}
+
+	// Parse dates
+	startDate, ok := c.parseOptionalDate(ctx, req.StartDate, "start_date")
+	if !ok {
+		return
+	}
+	endDate, ok := c.parseOptionalDate(ctx, req.EndDate, "end_date")
+	if !ok {
+		return
+	}
+
+	// Parse category ID if provided
+	categoryID, ok := c.parseOptionalCategoryID(ctx, req.CategoryID)
+	if !ok {
+		return
+	}
+
+	// Build input
+	input := recurringschedule.UpdateRecurringScheduleInput{
+		ScheduleID:          scheduleID,
+		UserID:              userID,
+		Description:         req.Description,
+		CategoryID:          categoryID,
+		ClearCategory:       req.ClearCategory,
+		Notes:               req.Notes,
+		Interval:            req.Interval,
+		DayOfMonth:          req.DayOfMonth,
+		DayOfWeek:           req.DayOfWeek,
+		StartDate:           startDate,
+		EndDate:             endDate,
+		ClearEndDate:        req.ClearEndDate,
+		MaxOccurrences:      req.MaxOccurrences,
+		ClearMaxOccurrences: req.ClearMaxOccurrences,
+		IsActive:            req.IsActive,
+	}
+	if req.Amount != nil {
+		amount := decimal.NewFromFloat(*req.Amount)
+		input.Amount = &amount
+	}
+	if req.Type != nil {
+		txnType := entity.TransactionType(*req.Type)
+		input.Type = &txnType
+	}
+	if req.Frequency != nil {
+		frequency := entity.RecurrenceFrequency(*req.Frequency)
+		input.Frequency = &frequency
+	}
+
+	// Execute use case
+	output, err := c.updateUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleRecurringScheduleError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToRecurringScheduleResponse(output.Schedule)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Delete handles DELETE /recurring-schedules/:id requests.
+func (c *RecurringScheduleController) Delete(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse schedule ID from URL
+	scheduleID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid schedule ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	_, err = c.deleteUseCase.Execute(ctx.Request.Context(), recurringschedule.DeleteRecurringScheduleInput{
+		ScheduleID: scheduleID,
+		UserID:     userID,
+	})
+	if err != nil {
+		c.handleRecurringScheduleError(ctx, err)
+		return
+	}
+
+	// Return no content on success
+	ctx.Status(http.StatusNoContent)
+}
+
+// parseOptionalDate parses an optional YYYY-MM-DD date, responding with 400 when it is invalid.
+func (c *RecurringScheduleController) parseOptionalDate(ctx *gin.Context, value *string, field string) (*time.Time, bool) {
+	if value == nil || *value == "" {
+		return nil, true
+	}
+	date, err := time.Parse("2006-01-02", *value)
+	if err != nil {
+		c.respondInvalidDate(ctx, field)
+		return nil, false
+	}
+	return &date, true
+}
+
+// parseOptionalCategoryID parses an optional category ID, responding with 400 when it is invalid.
+func (c *RecurringScheduleController) parseOptionalCategoryID(ctx *gin.Context, value *string) (*uuid.UUID, bool) {
+	if value == nil || *value == "" {
+		return nil, true
+	}
+	id, err := uuid.Parse(*value)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid category ID format",
+		})
+		return nil, false
+	}
+	return &id, true
+}
+
+// respondInvalidDate responds with 400 for a malformed date field.
+func (c *RecurringScheduleController) respondInvalidDate(ctx *gin.Context, field string) {
+	ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+		Error: "Invalid " + field + " format. Use YYYY-MM-DD",
+		Code:  string(domainerror.ErrCodeRecurringScheduleMissingFields),
+	})
+}
+
+// handleRecurringScheduleError handles recurring schedule errors and returns appropriate HTTP responses.
+func (c *RecurringScheduleController) handleRecurringScheduleError(ctx *gin.Context, err error) {
+	var scheduleErr *domainerror.RecurringScheduleError
+	if errors.As(err, &scheduleErr) {
+		statusCode := c.getStatusCodeForRecurringScheduleError(scheduleErr.Code)
+		ctx.JSON(statusCode, dto.ErrorResponse{
+			Error: scheduleErr.Message,
+			Code:  string(scheduleErr.Code),
+		})
+		return
+	}
+
+	// Generic server error
+	ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		Error: "An internal error occurred",
+	})
+}
+
+// getStatusCodeForRecurringScheduleError maps recurring schedule error codes to HTTP status codes.
+func (c *RecurringScheduleController) getStatusCodeForRecurringScheduleError(code domainerror.RecurringScheduleErrorCode) int {
+	switch code {
+	case domainerror.ErrCodeRecurringScheduleNotFound:
+		return http.StatusNotFound
+	case domainerror.ErrCodeNotAuthorizedRecurringSchedule:
+		return http.StatusForbidden
+	case domainerror.ErrCodeInvalidRecurrenceFrequency,
+		domainerror.ErrCodeInvalidRecurrenceInterval,
+		domainerror.ErrCodeInvalidRecurrenceDay,
+		domainerror.ErrCodeInvalidRecurrenceEnd,
+		domainerror.ErrCodeInvalidRecurringAmount,
+		domainerror.ErrCodeRecurringCategoryNotFound,
+		domainerror.ErrCodeRecurringScheduleMissingFields:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
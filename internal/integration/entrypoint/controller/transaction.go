@@ -3,6 +3,7 @@ package controller
 
 import (
 	"errors"
+	"mime"
 	"net/http"
 	"strconv"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 
+	"github.com/finance-tracker/backend/internal/application/adapter"
 	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
 	"github.com/finance-tracker/backend/internal/domain/entity"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
@@ -27,6 +29,18 @@ type TransactionController struct {
 	deleteUseCase        *transaction.DeleteTransactionUseCase
 	bulkDeleteUseCase    *transaction.BulkDeleteTransactionsUseCase
 	bulkCategorizeUseCase *transaction.BulkCategorizeTransactionsUseCase
+	listDuplicatesUseCase   *transaction.ListDuplicatesUseCase
+	mergeDuplicateUseCase   *transaction.MergeDuplicateUseCase
+	dismissDuplicateUseCase *transaction.DismissDuplicateUseCase
+	splitUseCase            *transaction.SplitTransactionUseCase
+	unsplitUseCase          *transaction.UnsplitTransactionUseCase
+	bulkTagUseCase          *transaction.BulkTagTransactionsUseCase
+	historyUseCase          *transaction.GetTransactionHistoryUseCase
+	revertChangeUseCase     *transaction.RevertTransactionChangeUseCase
+	revertOperationUseCase  *transaction.RevertTransactionOperationUseCase
+	bulkUpdateUseCase       *transaction.BulkUpdateTransactionsUseCase
+	exportUseCase           *transaction.ExportTransactionsUseCase
+	exportJournalUseCase    *transaction.ExportJournalUseCase
 }
 
 // NewTransactionController creates a new transaction controller instance.
@@ -37,6 +51,18 @@ func NewTransactionController(
 	deleteUseCase *transaction.DeleteTransactionUseCase,
 	bulkDeleteUseCase *transaction.BulkDeleteTransactionsUseCase,
 	bulkCategorizeUseCase *transaction.BulkCategorizeTransactionsUseCase,
+	listDuplicatesUseCase *transaction.ListDuplicatesUseCase,
+	mergeDuplicateUseCase *transaction.MergeDuplicateUseCase,
+	dismissDuplicateUseCase *transaction.DismissDuplicateUseCase,
+	splitUseCase *transaction.SplitTransactionUseCase,
+	unsplitUseCase *transaction.UnsplitTransactionUseCase,
+	bulkTagUseCase *transaction.BulkTagTransactionsUseCase,
+	historyUseCase *transaction.GetTransactionHistoryUseCase,
+	revertChangeUseCase *transaction.RevertTransactionChangeUseCase,
+	revertOperationUseCase *transaction.RevertTransactionOperationUseCase,
+	bulkUpdateUseCase *transaction.BulkUpdateTransactionsUseCase,
+	exportUseCase *transaction.ExportTransactionsUseCase,
+	exportJournalUseCase *transaction.ExportJournalUseCase,
 ) *TransactionController {
 	return &TransactionController{
 		listUseCase:          listUseCase,
@@ -45,6 +71,18 @@ func NewTransactionController(
 		deleteUseCase:        deleteUseCase,
 		bulkDeleteUseCase:    bulkDeleteUseCase,
 		bulkCategorizeUseCase: bulkCategorizeUseCase,
+		listDuplicatesUseCase:   listDuplicatesUseCase,
+		mergeDuplicateUseCase:   mergeDuplicateUseCase,
+		dismissDuplicateUseCase: dismissDuplicateUseCase,
+		splitUseCase:            splitUseCase,
+		unsplitUseCase:          unsplitUseCase,
+		bulkTagUseCase:          bulkTagUseCase,
+		historyUseCase:          historyUseCase,
+		revertChangeUseCase:     revertChangeUseCase,
+		revertOperationUseCase:  revertOperationUseCase,
+		bulkUpdateUseCase:       bulkUpdateUseCase,
+		exportUseCase:           exportUseCase,
+		exportJournalUseCase:    exportJournalUseCase,
 	}
 }
 
@@ -65,6 +103,117 @@ func (c *TransactionController) List(ctx *gin.Context) {
 		UserID: userID,
 	}
 
+	// Parse filters and sorting
+	parseTransactionFilterQuery(ctx, &input)
+
+	// Parse groupByDate flag
+	if groupByDateStr := ctx.Query("groupByDate"); groupByDateStr == "true" {
+		input.GroupByDate = true
+	}
+
+	// Parse pagination; a cursor from the previous page takes precedence over the page number
+	input.Cursor = ctx.Query("cursor")
+	if pageStr := ctx.Query("page"); pageStr != "" {
+		if page, err := strconv.Atoi(pageStr); err == nil {
+			input.Page = page
+		}
+	}
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		if limit, err := strconv.Atoi(limitStr); err == nil {
+			input.Limit = limit
+		}
+	}
+
+	// Execute use case
+	output, err := c.listUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		var txnErr *domainerror.TransactionError
+		if errors.As(err, &txnErr) {
+			c.handleTransactionError(ctx, err)
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error: "Failed to retrieve transactions",
+		})
+		return
+	}
+
+	// Build response
+	response := dto.ToTransactionListResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Export handles GET /transactions/export requests.
+func (c *TransactionController) Export(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse query parameters
+	input := transaction.ExportTransactionsInput{
+		Filter: transaction.ListTransactionsInput{UserID: userID},
+		Format: adapter.ExportFormat(ctx.Query("format")),
+	}
+	parseTransactionFilterQuery(ctx, &input.Filter)
+
+	// Execute use case
+	output, err := c.exportUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleTransactionError(ctx, err)
+		return
+	}
+
+	// Stream the file; once it has started, errors can only abort the download
+	ctx.Header("Content-Type", output.ContentType)
+	ctx.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": output.FileName}))
+	ctx.Status(http.StatusOK)
+	if err := output.Stream(ctx.Request.Context(), ctx.Writer); err != nil {
+		_ = ctx.Error(err)
+		ctx.Abort()
+	}
+}
+
+// ExportJournal handles GET /transactions/export/journal requests.
+func (c *TransactionController) ExportJournal(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.exportJournalUseCase.Execute(ctx.Request.Context(), transaction.ExportJournalInput{
+		UserID: userID,
+		Format: adapter.JournalFormat(ctx.Query("format")),
+	})
+	if err != nil {
+		c.handleTransactionError(ctx, err)
+		return
+	}
+
+	// Stream the journal; once it has started, errors can only abort the download
+	ctx.Header("Content-Type", output.ContentType)
+	ctx.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": output.FileName}))
+	ctx.Status(http.StatusOK)
+	if err := output.Stream(ctx.Request.Context(), ctx.Writer); err != nil {
+		_ = ctx.Error(err)
+		ctx.Abort()
+	}
+}
+
+// parseTransactionFilterQuery parses the filter and sort query parameters shared by the listing and the export.
+// Invalid values are ignored.
+func parseTransactionFilterQuery(ctx *gin.Context, input *transaction.ListTransactionsInput) {
 	// Parse date filters
 	if startDateStr := ctx.Query("startDate"); startDateStr != "" {
 		startDate, err := time.Parse("2006-01-02", startDateStr)
@@ -89,44 +238,55 @@ func (c *TransactionController) List(ctx *gin.Context) {
 		}
 	}
 
+	// Parse account filter (comma-separated); a single account also adds running balances
+	if accountIDsStr := ctx.Query("accountIds"); accountIDsStr != "" {
+		ids := strings.Split(accountIDsStr, ",")
+		for _, idStr := range ids {
+			if id, err := uuid.Parse(strings.TrimSpace(idStr)); err == nil {
+				input.AccountIDs = append(input.AccountIDs, id)
+			}
+		}
+	}
+
+	// Parse tag filter (comma-separated); matches transactions with any of the tags
+	if tagIDsStr := ctx.Query("tagIds"); tagIDsStr != "" {
+		ids := strings.Split(tagIDsStr, ",")
+		for _, idStr := range ids {
+			if id, err := uuid.Parse(strings.TrimSpace(idStr)); err == nil {
+				input.TagIDs = append(input.TagIDs, id)
+			}
+		}
+	}
+
 	// Parse type filter
 	if typeStr := ctx.Query("type"); typeStr != "" {
 		txnType := entity.TransactionType(typeStr)
 		input.Type = &txnType
 	}
 
-	// Parse search filter
+	// Parse search query (words, "phrases" and field:value filters)
 	input.Search = ctx.Query("search")
 
-	// Parse groupByDate flag
-	if groupByDateStr := ctx.Query("groupByDate"); groupByDateStr == "true" {
-		input.GroupByDate = true
-	}
-
-	// Parse pagination
-	if pageStr := ctx.Query("page"); pageStr != "" {
-		if page, err := strconv.Atoi(pageStr); err == nil {
-			input.Page = page
+	// Parse amount range filter (absolute amounts, inclusive)
+	if minAmountStr := ctx.Query("minAmount"); minAmountStr != "" {
+		if minAmount, err := decimal.NewFromString(minAmountStr); err == nil {
+			input.MinAmount = &minAmount
 		}
 	}
-	if limitStr := ctx.Query("limit"); limitStr != "" {
-		if limit, err := strconv.Atoi(limitStr); err == nil {
-			input.Limit = limit
+	if maxAmountStr := ctx.Query("maxAmount"); maxAmountStr != "" {
+		if maxAmount, err := decimal.NewFromString(maxAmountStr); err == nil {
+			input.MaxAmount = &maxAmount
 		}
 	}
 
-	// Execute use case
-	output, err := c.listUseCase.Execute(ctx.Request.Context(), input)
-	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error: "Failed to retrieve transactions",
-		})
-		return
+	// Parse uncategorized flag
+	if uncategorizedStr := ctx.Query("uncategorized"); uncategorizedStr == "true" {
+		input.UncategorizedOnly = true
 	}
 
-	// Build response
-	response := dto.ToTransactionListResponse(output)
-	ctx.JSON(http.StatusOK, response)
+	// Parse sorting (sortBy: date, amount, description or category; sortOrder: asc or desc)
+	input.SortBy = ctx.Query("sortBy")
+	input.SortOrder = ctx.Query("sortOrder")
 }
 
 // Create handles POST /transactions requests.
@@ -174,6 +334,19 @@ func (c *TransactionController) Create(ctx *gin.Context) {
 		categoryID = &id
 	}
 
+	// Parse account ID if provided
+	var accountID *uuid.UUID
+	if req.AccountID != nil && *req.AccountID != "" {
+		id, err := uuid.Parse(*req.AccountID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid account ID format",
+			})
+			return
+		}
+		accountID = &id
+	}
+
 	// Build input
 	input := transaction.CreateTransactionInput{
 		UserID:              userID,
@@ -182,10 +355,17 @@ func (c *TransactionController) Create(ctx *gin.Context) {
 		Amount:              decimal.NewFromFloat(req.Amount),
 		Type:                entity.TransactionType(req.Type),
 		CategoryID:          categoryID,
+		AccountID:           accountID,
 		Notes:               req.Notes,
 		IsRecurring:         req.IsRecurring,
 		BillingCycle:        req.BillingCycle,
 		IsCreditCardPayment: req.IsCreditCardPayment,
+		Currency:            req.Currency,
+	}
+
+	if req.ExchangeRate != nil {
+		rate := decimal.NewFromFloat(*req.ExchangeRate)
+		input.ExchangeRate = &rate
 	}
 
 	// Execute use case
@@ -197,6 +377,7 @@ func (c *TransactionController) Create(ctx *gin.Context) {
 
 	// Build response
 	response := dto.ToTransactionResponse(output.Transaction)
+	response.PossibleDuplicates = dto.ToDuplicateMatchResponses(output.PossibleDuplicates)
 	ctx.JSON(http.StatusCreated, response)
 }
 
@@ -236,6 +417,7 @@ func (c *TransactionController) Update(ctx *gin.Context) {
 		TransactionID: transactionID,
 		UserID:        userID,
 		ClearCategory: req.ClearCategory,
+		ClearAccount:  req.ClearAccount,
 	}
 
 	// Parse optional fields
@@ -276,6 +458,17 @@ func (c *TransactionController) Update(ctx *gin.Context) {
 		input.CategoryID = &id
 	}
 
+	if req.AccountID != nil && *req.AccountID != "" {
+		id, err := uuid.Parse(*req.AccountID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid account ID format",
+			})
+			return
+		}
+		input.AccountID = &id
+	}
+
 	if req.Notes != nil {
 		input.Notes = req.Notes
 	}
@@ -284,6 +477,15 @@ func (c *TransactionController) Update(ctx *gin.Context) {
 		input.IsRecurring = req.IsRecurring
 	}
 
+	if req.Currency != nil {
+		input.Currency = req.Currency
+	}
+
+	if req.ExchangeRate != nil {
+		rate := decimal.NewFromFloat(*req.ExchangeRate)
+		input.ExchangeRate = &rate
+	}
+
 	// Execute use case
 	output, err := c.updateUseCase.Execute(ctx.Request.Context(), input)
 	if err != nil {
@@ -437,6 +639,7 @@ func (c *TransactionController) BulkCategorize(ctx *gin.Context) {
 		TransactionIDs: transactionIDs,
 		CategoryID:     categoryID,
 		UserID:         userID,
+		IncludeSplit:   req.IncludeSplit,
 	}
 
 	// Execute use case
@@ -449,10 +652,594 @@ func (c *TransactionController) BulkCategorize(ctx *gin.Context) {
 	// Build response
 	response := dto.BulkCategorizeTransactionsResponse{
 		UpdatedCount: output.UpdatedCount,
+		OperationID:  output.OperationID.String(),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// BulkUpdate handles POST /transactions/bulk-update requests.
+func (c *TransactionController) BulkUpdate(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.BulkUpdateTransactionsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	// Parse transaction IDs
+	transactionIDs := make([]uuid.UUID, 0, len(req.IDs))
+	for _, idStr := range req.IDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid transaction ID format: " + idStr,
+			})
+			return
+		}
+		transactionIDs = append(transactionIDs, id)
+	}
+
+	// Build input
+	input := transaction.BulkUpdateTransactionsInput{
+		UserID:         userID,
+		TransactionIDs: transactionIDs,
+		DryRun:         req.DryRun,
+		Changes: transaction.BulkTransactionChanges{
+			DateShiftDays: req.Changes.DateShiftDays,
+			Notes:         req.Changes.Notes,
+			NotesMode:     transaction.NotesMode(req.Changes.NotesMode),
+			IsRecurring:   req.Changes.IsRecurring,
+			IsHidden:      req.Changes.IsHidden,
+			ClearCategory: req.Changes.ClearCategory,
+		},
+	}
+	if req.Changes.Type != nil {
+		txnType := entity.TransactionType(*req.Changes.Type)
+		input.Changes.Type = &txnType
+	}
+	if req.Changes.CategoryID != nil {
+		categoryID, err := uuid.Parse(*req.Changes.CategoryID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid category ID format",
+			})
+			return
+		}
+		input.Changes.CategoryID = &categoryID
+	}
+	if req.Filter != nil {
+		filter, errMessage := parseTransactionFilterRequest(userID, req.Filter)
+		if errMessage != "" {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: errMessage,
+			})
+			return
+		}
+		input.Filter = filter
+	}
+
+	// Execute use case
+	output, err := c.bulkUpdateUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleTransactionError(ctx, err)
+		return
 	}
+
+	// Build response
+	response := dto.ToBulkUpdateTransactionsResponse(output)
 	ctx.JSON(http.StatusOK, response)
 }
 
+// parseTransactionFilterRequest converts a filter request body to listing filters.
+// It returns an error message when a date or ID cannot be parsed.
+func parseTransactionFilterRequest(userID uuid.UUID, req *dto.TransactionFilterRequest) (*transaction.ListTransactionsInput, string) {
+	filter := &transaction.ListTransactionsInput{
+		UserID:            userID,
+		Search:            req.Search,
+		UncategorizedOnly: req.Uncategorized,
+	}
+
+	// Parse date filters
+	if req.StartDate != nil {
+		startDate, err := time.Parse("2006-01-02", *req.StartDate)
+		if err != nil {
+			return nil, "Invalid start date format. Use YYYY-MM-DD"
+		}
+		filter.StartDate = &startDate
+	}
+	if req.EndDate != nil {
+		endDate, err := time.Parse("2006-01-02", *req.EndDate)
+		if err != nil {
+			return nil, "Invalid end date format. Use YYYY-MM-DD"
+		}
+		filter.EndDate = &endDate
+	}
+
+	// Parse ID filters
+	for _, idStr := range req.CategoryIDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, "Invalid category ID format: " + idStr
+		}
+		filter.CategoryIDs = append(filter.CategoryIDs, id)
+	}
+	for _, idStr := range req.AccountIDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, "Invalid account ID format: " + idStr
+		}
+		filter.AccountIDs = append(filter.AccountIDs, id)
+	}
+	for _, idStr := range req.TagIDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, "Invalid tag ID format: " + idStr
+		}
+		filter.TagIDs = append(filter.TagIDs, id)
+	}
+
+	// Parse type filter
+	if req.Type != nil {
+		txnType := entity.TransactionType(*req.Type)
+		filter.Type = &txnType
+	}
+
+	// Parse amount range filter (absolute amounts, inclusive)
+	if req.MinAmount != nil {
+		minAmount := decimal.NewFromFloat(*req.MinAmount)
+		filter.MinAmount = &minAmount
+	}
+	if req.MaxAmount != nil {
+		maxAmount := decimal.NewFromFloat(*req.MaxAmount)
+		filter.MaxAmount = &maxAmount
+	}
+
+	return filter, ""
+}
+
+// BulkTag handles POST /transactions/bulk-tag requests.
+func (c *TransactionController) BulkTag(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.BulkTagTransactionsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	// Parse transaction IDs
+	transactionIDs := make([]uuid.UUID, 0, len(req.IDs))
+	for _, idStr := range req.IDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid transaction ID format: " + idStr,
+			})
+			return
+		}
+		transactionIDs = append(transactionIDs, id)
+	}
+
+	// Parse tag IDs
+	tagIDs := make([]uuid.UUID, 0, len(req.TagIDs))
+	for _, idStr := range req.TagIDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid tag ID format: " + idStr,
+			})
+			return
+		}
+		tagIDs = append(tagIDs, id)
+	}
+
+	// Build input
+	input := transaction.BulkTagTransactionsInput{
+		TransactionIDs: transactionIDs,
+		TagIDs:         tagIDs,
+		UserID:         userID,
+		Remove:         req.Remove,
+	}
+
+	// Execute use case
+	output, err := c.bulkTagUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleTransactionError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.BulkTagTransactionsResponse{
+		UpdatedCount: output.UpdatedCount,
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// ListDuplicates handles GET /transactions/duplicates requests.
+func (c *TransactionController) ListDuplicates(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse query parameters
+	input := transaction.ListDuplicatesInput{
+		UserID: userID,
+	}
+
+	// Parse date filters
+	if startDateStr := ctx.Query("startDate"); startDateStr != "" {
+		startDate, err := time.Parse("2006-01-02", startDateStr)
+		if err == nil {
+			input.StartDate = &startDate
+		}
+	}
+	if endDateStr := ctx.Query("endDate"); endDateStr != "" {
+		endDate, err := time.Parse("2006-01-02", endDateStr)
+		if err == nil {
+			input.EndDate = &endDate
+		}
+	}
+
+	// Execute use case
+	output, err := c.listDuplicatesUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleTransactionError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToDuplicateListResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// MergeDuplicates handles POST /transactions/duplicates/merge requests.
+func (c *TransactionController) MergeDuplicates(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.MergeDuplicateTransactionsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	// Parse transaction IDs
+	keepID, err := uuid.Parse(req.KeepID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid transaction ID format: " + req.KeepID,
+		})
+		return
+	}
+	duplicateID, err := uuid.Parse(req.DuplicateID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid transaction ID format: " + req.DuplicateID,
+		})
+		return
+	}
+
+	// Build input
+	input := transaction.MergeDuplicateInput{
+		UserID:      userID,
+		KeepID:      keepID,
+		DuplicateID: duplicateID,
+	}
+
+	// Execute use case
+	output, err := c.mergeDuplicateUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleTransactionError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.MergeDuplicateTransactionsResponse{
+		Transaction: dto.ToTransactionResponse(output.Transaction),
+		DeletedID:   output.DeletedID.String(),
+	}
+	ctx.JSON(http.StatusOK, response)
+}
+
+// DismissDuplicate handles POST /transactions/duplicates/dismiss requests.
+func (c *TransactionController) DismissDuplicate(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.DismissDuplicateTransactionsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body: " + err.Error(),
+		})
+		return
+	}
+
+	// Parse transaction IDs
+	transactionID, err := uuid.Parse(req.TransactionID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid transaction ID format: " + req.TransactionID,
+		})
+		return
+	}
+	duplicateID, err := uuid.Parse(req.DuplicateID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid transaction ID format: " + req.DuplicateID,
+		})
+		return
+	}
+
+	// Build input
+	input := transaction.DismissDuplicateInput{
+		UserID:        userID,
+		TransactionID: transactionID,
+		DuplicateID:   duplicateID,
+	}
+
+	// Execute use case
+	if _, err := c.dismissDuplicateUseCase.Execute(ctx.Request.Context(), input); err != nil {
+		c.handleTransactionError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// Split handles PUT /transactions/:id/splits requests.
+// The given lines replace any existing split lines of the transaction.
+func (c *TransactionController) Split(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse transaction ID from URL
+	transactionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid transaction ID format",
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.SplitTransactionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body: " + err.Error(),
+			Code:  string(domainerror.ErrCodeInvalidTxnSplits),
+		})
+		return
+	}
+
+	// Build input
+	input := transaction.SplitTransactionInput{
+		TransactionID: transactionID,
+		UserID:        userID,
+		Splits:        make([]transaction.SplitLineInput, len(req.Splits)),
+	}
+	for i, line := range req.Splits {
+		input.Splits[i] = transaction.SplitLineInput{
+			Amount: decimal.NewFromFloat(line.Amount),
+			Notes:  line.Notes,
+		}
+		if line.CategoryID != nil && *line.CategoryID != "" {
+			categoryID, err := uuid.Parse(*line.CategoryID)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+					Error: "Invalid category ID format",
+				})
+				return
+			}
+			input.Splits[i].CategoryID = &categoryID
+		}
+	}
+
+	// Execute use case
+	output, err := c.splitUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleTransactionError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToTransactionResponse(output.Transaction)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Unsplit handles DELETE /transactions/:id/splits requests.
+func (c *TransactionController) Unsplit(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse transaction ID from URL
+	transactionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid transaction ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	_, err = c.unsplitUseCase.Execute(ctx.Request.Context(), transaction.UnsplitTransactionInput{
+		TransactionID: transactionID,
+		UserID:        userID,
+	})
+	if err != nil {
+		c.handleTransactionError(ctx, err)
+		return
+	}
+
+	// Return no content on success
+	ctx.Status(http.StatusNoContent)
+}
+
+// History handles GET /transactions/:id/history requests.
+func (c *TransactionController) History(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse transaction ID from URL
+	transactionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid transaction ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.historyUseCase.Execute(ctx.Request.Context(), transaction.GetTransactionHistoryInput{
+		TransactionID: transactionID,
+		UserID:        userID,
+	})
+	if err != nil {
+		c.handleTransactionError(ctx, err)
+		return
+	}
+
+	// Build response
+	ctx.JSON(http.StatusOK, dto.ToTransactionHistoryResponse(output))
+}
+
+// RevertChange handles POST /transactions/changes/:changeId/revert requests.
+func (c *TransactionController) RevertChange(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse change ID from URL
+	changeID, err := uuid.Parse(ctx.Param("changeId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid change ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.revertChangeUseCase.Execute(ctx.Request.Context(), transaction.RevertTransactionChangeInput{
+		ChangeID: changeID,
+		UserID:   userID,
+	})
+	if err != nil {
+		c.handleTransactionError(ctx, err)
+		return
+	}
+
+	// Build response
+	ctx.JSON(http.StatusOK, dto.ToTransactionChangeResponse(output.Change))
+}
+
+// RevertOperation handles POST /transactions/operations/:operationId/revert requests.
+func (c *TransactionController) RevertOperation(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse operation ID from URL
+	operationID, err := uuid.Parse(ctx.Param("operationId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid operation ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.revertOperationUseCase.Execute(ctx.Request.Context(), transaction.RevertTransactionOperationInput{
+		OperationID: operationID,
+		UserID:      userID,
+	})
+	if err != nil {
+		c.handleTransactionError(ctx, err)
+		return
+	}
+
+	// Build response
+	ctx.JSON(http.StatusOK, dto.ToRevertTransactionOperationResponse(output))
+}
+
 // handleTransactionError handles transaction errors and returns appropriate HTTP responses.
 func (c *TransactionController) handleTransactionError(ctx *gin.Context, err error) {
 	var txnErr *domainerror.TransactionError
@@ -475,10 +1262,15 @@ func (c *TransactionController) handleTransactionError(ctx *gin.Context, err err
 func (c *TransactionController) getStatusCodeForTransactionError(code domainerror.TransactionErrorCode) int {
 	switch code {
 	case domainerror.ErrCodeTransactionNotFound,
-		domainerror.ErrCodeTxnCategoryNotFound:
+		domainerror.ErrCodeTxnCategoryNotFound,
+		domainerror.ErrCodeTxnAccountNotFound,
+		domainerror.ErrCodeTxnTagNotFound,
+		domainerror.ErrCodeTxnChangeNotFound:
 		return http.StatusNotFound
 	case domainerror.ErrCodeNotAuthorizedTransaction,
-		domainerror.ErrCodeTxnCategoryNotOwned:
+		domainerror.ErrCodeTxnCategoryNotOwned,
+		domainerror.ErrCodeTxnAccountNotOwned,
+		domainerror.ErrCodeTxnTagNotOwned:
 		return http.StatusForbidden
 	case domainerror.ErrCodeInvalidTransactionType,
 		domainerror.ErrCodeInvalidTransactionDate,
@@ -486,8 +1278,29 @@ func (c *TransactionController) getStatusCodeForTransactionError(code domainerro
 		domainerror.ErrCodeDescriptionTooLong,
 		domainerror.ErrCodeNotesTooLong,
 		domainerror.ErrCodeMissingTransactionFields,
-		domainerror.ErrCodeEmptyTransactionIDs:
+		domainerror.ErrCodeEmptyTransactionIDs,
+		domainerror.ErrCodeSameTransactionPair,
+		domainerror.ErrCodeInvalidTxnCurrency,
+		domainerror.ErrCodeTxnCurrencyMismatch,
+		domainerror.ErrCodeInvalidTxnRate,
+		domainerror.ErrCodeInvalidTxnSplits,
+		domainerror.ErrCodeInvalidSearchQuery,
+		domainerror.ErrCodeInvalidTxnSort,
+		domainerror.ErrCodeInvalidTxnCursor,
+		domainerror.ErrCodeInvalidAmountRange,
+		domainerror.ErrCodeInvalidBulkSelection,
+		domainerror.ErrCodeNoBulkChanges,
+		domainerror.ErrCodeInvalidNotesMode,
+		domainerror.ErrCodeTooManyTxnsSelected,
+		domainerror.ErrCodeInvalidExportFormat:
 		return http.StatusBadRequest
+	case domainerror.ErrCodeTransactionIsTransfer,
+		domainerror.ErrCodeTransactionIsSplit,
+		domainerror.ErrCodeChangeNotRevertable,
+		domainerror.ErrCodeTxnChangedSince:
+		return http.StatusConflict
+	case domainerror.ErrCodeTxnRateUnavailable:
+		return http.StatusUnprocessableEntity
 	default:
 		return http.StatusInternalServerError
 	}
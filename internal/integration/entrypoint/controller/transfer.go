@@ -0,0 +1,365 @@
+// Package controller implements HTTP handlers for the API endpoints.
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/transfer"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/dto"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
+)
+
+// TransferController handles transfer endpoints.
+type TransferController struct {
+	getUseCase     *transfer.GetTransferUseCase
+	createUseCase  *transfer.CreateTransferUseCase
+	updateUseCase  *transfer.UpdateTransferUseCase
+	deleteUseCase  *transfer.DeleteTransferUseCase
+	convertUseCase *transfer.ConvertTransactionUseCase
+}
+
+// NewTransferController creates a new transfer controller instance.
+func NewTransferController(
+	getUseCase *transfer.GetTransferUseCase,
+	createUseCase *transfer.CreateTransferUseCase,
+	updateUseCase *transfer.UpdateTransferUseCase,
+	deleteUseCase *transfer.DeleteTransferUseCase,
+	convertUseCase *transfer.ConvertTransactionUseCase,
+) *TransferController {
+	return &TransferController{
+		getUseCase:     getUseCase,
+		createUseCase:  createUseCase,
+		updateUseCase:  updateUseCase,
+		deleteUseCase:  deleteUseCase,
+		convertUseCase: convertUseCase,
+	}
+}
+
+// Get handles GET /transfers/:id requests.
+func (c *TransferController) Get(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse transfer ID from URL
+	transferID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid transfer ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.getUseCase.Execute(ctx.Request.Context(), transfer.GetTransferInput{
+		TransferID: transferID,
+		UserID:     userID,
+	})
+	if err != nil {
+		c.handleTransferError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToTransferResponse(output.Transfer)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create handles POST /transfers requests.
+func (c *TransferController) Create(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.CreateTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body: " + err.Error(),
+			Code:  string(domainerror.ErrCodeTransferMissingFields),
+		})
+		return
+	}
+
+	// Parse accounts and date
+	fromAccountID, err := uuid.Parse(req.FromAccountID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid source account ID format",
+		})
+		return
+	}
+
+	toAccountID, err := uuid.Parse(req.ToAccountID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid destination account ID format",
+		})
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid date format. Use YYYY-MM-DD",
+		})
+		return
+	}
+
+	// Build input
+	input := transfer.CreateTransferInput{
+		UserID:        userID,
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Date:          date,
+		Description:   req.Description,
+		Amount:        decimal.NewFromFloat(req.Amount),
+		Notes:         req.Notes,
+	}
+
+	// Execute use case
+	output, err := c.createUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleTransferError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToTransferResponse(output.Transfer)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// Update handles PATCH /transfers/:id requests.
+func (c *TransferController) Update(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse transfer ID from URL
+	transferID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid transfer ID format",
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.UpdateTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body",
+		})
+		return
+	}
+
+	// Build input
+	input := transfer.UpdateTransferInput{
+		TransferID:  transferID,
+		UserID:      userID,
+		Description: req.Description,
+		Notes:       req.Notes,
+	}
+	if req.FromAccountID != nil {
+		fromAccountID, err := uuid.Parse(*req.FromAccountID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid source account ID format",
+			})
+			return
+		}
+		input.FromAccountID = &fromAccountID
+	}
+	if req.ToAccountID != nil {
+		toAccountID, err := uuid.Parse(*req.ToAccountID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid destination account ID format",
+			})
+			return
+		}
+		input.ToAccountID = &toAccountID
+	}
+	if req.Date != nil {
+		date, err := time.Parse("2006-01-02", *req.Date)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid date format. Use YYYY-MM-DD",
+			})
+			return
+		}
+		input.Date = &date
+	}
+	if req.Amount != nil {
+		amount := decimal.NewFromFloat(*req.Amount)
+		input.Amount = &amount
+	}
+
+	// Execute use case
+	output, err := c.updateUseCase.Execute(ctx.Request.Context(), input)
+	if err != nil {
+		c.handleTransferError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToTransferResponse(output.Transfer)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Delete handles DELETE /transfers/:id requests.
+func (c *TransferController) Delete(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse transfer ID from URL
+	transferID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid transfer ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	_, err = c.deleteUseCase.Execute(ctx.Request.Context(), transfer.DeleteTransferInput{
+		TransferID: transferID,
+		UserID:     userID,
+	})
+	if err != nil {
+		c.handleTransferError(ctx, err)
+		return
+	}
+
+	// Return no content on success
+	ctx.Status(http.StatusNoContent)
+}
+
+// Convert handles POST /transfers/convert requests.
+// It turns an existing transaction (e.g., a credit card bill payment) into a transfer.
+func (c *TransferController) Convert(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.ConvertToTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body: " + err.Error(),
+			Code:  string(domainerror.ErrCodeTransferMissingFields),
+		})
+		return
+	}
+
+	transactionID, err := uuid.Parse(req.TransactionID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid transaction ID format",
+		})
+		return
+	}
+
+	counterAccountID, err := uuid.Parse(req.CounterAccountID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid account ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.convertUseCase.Execute(ctx.Request.Context(), transfer.ConvertTransactionInput{
+		TransactionID:    transactionID,
+		UserID:           userID,
+		CounterAccountID: counterAccountID,
+	})
+	if err != nil {
+		c.handleTransferError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToTransferResponse(output.Transfer)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// handleTransferError handles transfer errors and returns appropriate HTTP responses.
+func (c *TransferController) handleTransferError(ctx *gin.Context, err error) {
+	var transferErr *domainerror.TransferError
+	if errors.As(err, &transferErr) {
+		statusCode := c.getStatusCodeForTransferError(transferErr.Code)
+		ctx.JSON(statusCode, dto.ErrorResponse{
+			Error: transferErr.Message,
+			Code:  string(transferErr.Code),
+		})
+		return
+	}
+
+	// Generic server error
+	ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		Error: "An internal error occurred",
+	})
+}
+
+// getStatusCodeForTransferError maps transfer error codes to HTTP status codes.
+func (c *TransferController) getStatusCodeForTransferError(code domainerror.TransferErrorCode) int {
+	switch code {
+	case domainerror.ErrCodeTransferNotFound,
+		domainerror.ErrCodeTransferAccountNotFound,
+		domainerror.ErrCodeTransferTransactionMissing:
+		return http.StatusNotFound
+	case domainerror.ErrCodeNotAuthorizedTransfer,
+		domainerror.ErrCodeTransferAccountNotOwned:
+		return http.StatusForbidden
+	case domainerror.ErrCodeTransactionNotConvertible:
+		return http.StatusConflict
+	case domainerror.ErrCodeSameTransferAccount,
+		domainerror.ErrCodeInvalidTransferAmount,
+		domainerror.ErrCodeTransferMissingFields,
+		domainerror.ErrCodeTransferDescriptionTooLong,
+		domainerror.ErrCodeTransferNotesTooLong,
+		domainerror.ErrCodeTransferCurrencyMismatch:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
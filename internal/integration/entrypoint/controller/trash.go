@@ -0,0 +1,199 @@
+// Package controller implements HTTP handlers for the API endpoints.
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/trash"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/dto"
+	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
+)
+
+// TrashController handles trash endpoints.
+type TrashController struct {
+	listUseCase    *trash.ListTrashUseCase
+	restoreUseCase *trash.RestoreTrashItemsUseCase
+}
+
+// NewTrashController creates a new trash controller instance.
+func NewTrashController(
+	listUseCase *trash.ListTrashUseCase,
+	restoreUseCase *trash.RestoreTrashItemsUseCase,
+) *TrashController {
+	return &TrashController{
+		listUseCase:    listUseCase,
+		restoreUseCase: restoreUseCase,
+	}
+}
+
+// List handles GET /trash requests.
+func (c *TrashController) List(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse query parameters
+	limit := trash.DefaultTrashLimit
+	offset := 0
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 {
+			limit = l
+		}
+	}
+	if offsetStr := ctx.Query("offset"); offsetStr != "" {
+		if o, err := strconv.Atoi(offsetStr); err == nil && o >= 0 {
+			offset = o
+		}
+	}
+
+	// Execute use case
+	output, err := c.listUseCase.Execute(ctx.Request.Context(), trash.ListTrashInput{
+		UserID: userID,
+		Type:   ctx.Query("type"),
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		c.handleTrashError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToTrashListResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Restore handles POST /trash/:type/:id/restore requests.
+func (c *TrashController) Restore(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse item ID from URL
+	itemID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid item ID format",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := c.restoreUseCase.Execute(ctx.Request.Context(), trash.RestoreTrashItemsInput{
+		UserID:     userID,
+		Items:      []entity.TrashItemRef{{Type: entity.TrashItemType(ctx.Param("type")), ID: itemID}},
+		References: trash.ReferenceMode(ctx.Query("references")),
+	})
+	if err != nil {
+		c.handleTrashError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToRestoreTrashItemsResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// RestoreMany handles POST /trash/restore requests.
+func (c *TrashController) RestoreMany(ctx *gin.Context) {
+	// Get user ID from context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "User not authenticated",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	// Parse request body
+	var req dto.RestoreTrashItemsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body",
+		})
+		return
+	}
+
+	// Parse item IDs
+	items := make([]entity.TrashItemRef, len(req.Items))
+	for i, item := range req.Items {
+		itemID, err := uuid.Parse(item.ID)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error: "Invalid item ID format: " + item.ID,
+			})
+			return
+		}
+		items[i] = entity.TrashItemRef{Type: entity.TrashItemType(item.Type), ID: itemID}
+	}
+
+	// Execute use case
+	output, err := c.restoreUseCase.Execute(ctx.Request.Context(), trash.RestoreTrashItemsInput{
+		UserID:     userID,
+		Items:      items,
+		References: trash.ReferenceMode(req.References),
+	})
+	if err != nil {
+		c.handleTrashError(ctx, err)
+		return
+	}
+
+	// Build response
+	response := dto.ToRestoreTrashItemsResponse(output)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// handleTrashError handles trash errors and returns appropriate HTTP responses.
+func (c *TrashController) handleTrashError(ctx *gin.Context, err error) {
+	var trashErr *domainerror.TrashError
+	if errors.As(err, &trashErr) {
+		statusCode := c.getStatusCodeForTrashError(trashErr.Code)
+		ctx.JSON(statusCode, dto.ErrorResponse{
+			Error: trashErr.Message,
+			Code:  string(trashErr.Code),
+		})
+		return
+	}
+
+	// Generic server error
+	ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		Error: "An internal error occurred",
+	})
+}
+
+// getStatusCodeForTrashError maps trash error codes to HTTP status codes.
+func (c *TrashController) getStatusCodeForTrashError(code domainerror.TrashErrorCode) int {
+	switch code {
+	case domainerror.ErrCodeTrashItemNotFound:
+		return http.StatusNotFound
+	case domainerror.ErrCodeTrashReferenceDeleted,
+		domainerror.ErrCodeTrashRestoreConflict:
+		return http.StatusConflict
+	case domainerror.ErrCodeInvalidTrashItemType,
+		domainerror.ErrCodeEmptyTrashItems,
+		domainerror.ErrCodeInvalidReferenceMode:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
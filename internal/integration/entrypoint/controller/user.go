@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/finance-tracker/backend/internal/application/usecase/auth"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
 	"github.com/finance-tracker/backend/internal/integration/entrypoint/dto"
 	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
@@ -15,15 +16,18 @@ import (
 
 // UserController handles user management endpoints.
 type UserController struct {
-	deleteAccountUseCase *auth.DeleteAccountUseCase
+	deleteAccountUseCase      *auth.DeleteAccountUseCase
+	updateBaseCurrencyUseCase *exchangerate.UpdateBaseCurrencyUseCase
 }
 
 // NewUserController creates a new user controller instance.
 func NewUserController(
 	deleteAccountUseCase *auth.DeleteAccountUseCase,
+	updateBaseCurrencyUseCase *exchangerate.UpdateBaseCurrencyUseCase,
 ) *UserController {
 	return &UserController{
-		deleteAccountUseCase: deleteAccountUseCase,
+		deleteAccountUseCase:      deleteAccountUseCase,
+		updateBaseCurrencyUseCase: updateBaseCurrencyUseCase,
 	}
 }
 
@@ -64,6 +68,53 @@ func (c *UserController) DeleteAccount(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
 
+// UpdateBaseCurrency handles PUT /users/me/base-currency requests.
+// Existing transactions are converted into the new currency using the user's exchange rates.
+func (c *UserController) UpdateBaseCurrency(ctx *gin.Context) {
+	// Get user ID from auth context
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error: "Unauthorized",
+			Code:  string(domainerror.ErrCodeMissingToken),
+		})
+		return
+	}
+
+	var req dto.UpdateBaseCurrencyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error: "Invalid request body",
+			Code:  string(domainerror.ErrCodeExchangeRateMissingFields),
+		})
+		return
+	}
+
+	output, err := c.updateBaseCurrencyUseCase.Execute(ctx.Request.Context(), exchangerate.UpdateBaseCurrencyInput{
+		UserID:       userID,
+		BaseCurrency: req.BaseCurrency,
+	})
+	if err != nil {
+		var rateErr *domainerror.ExchangeRateError
+		if errors.As(err, &rateErr) {
+			ctx.JSON(getStatusCodeForExchangeRateError(rateErr.Code), dto.ErrorResponse{
+				Error: rateErr.Message,
+				Code:  string(rateErr.Code),
+			})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error: "An internal error occurred",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.BaseCurrencyResponse{
+		BaseCurrency:      output.BaseCurrency,
+		RecalculatedCount: output.RecalculatedCount,
+	})
+}
+
 // handleDeleteAccountError handles delete account errors and returns appropriate HTTP responses.
 func (c *UserController) handleDeleteAccountError(ctx *gin.Context, err error) {
 	var authErr *domainerror.AuthError
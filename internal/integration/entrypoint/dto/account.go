@@ -0,0 +1,198 @@
+// Package dto defines data transfer objects for API requests and responses.
+package dto
+
+import (
+	"time"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/account"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// CreateAccountRequest represents the request body for account creation.
+type CreateAccountRequest struct {
+	Name           string  `json:"name" binding:"required,min=1,max=100"`
+	Type           string  `json:"type" binding:"required"`
+	Institution    string  `json:"institution,omitempty" binding:"omitempty,max=100"`
+	Currency       string  `json:"currency,omitempty"` // ISO 4217 code, defaults to BRL
+	OpeningBalance float64 `json:"opening_balance,omitempty"`
+	ClosingDay     *int    `json:"closing_day,omitempty"` // Credit cards only
+	DueDay         *int    `json:"due_day,omitempty"`     // Credit cards only
+}
+
+// UpdateAccountRequest represents the request body for account update.
+type UpdateAccountRequest struct {
+	Name             *string  `json:"name,omitempty" binding:"omitempty,min=1,max=100"`
+	Type             *string  `json:"type,omitempty"`
+	Institution      *string  `json:"institution,omitempty" binding:"omitempty,max=100"`
+	Currency         *string  `json:"currency,omitempty"`
+	OpeningBalance   *float64 `json:"opening_balance,omitempty"`
+	ClosingDay       *int     `json:"closing_day,omitempty"`
+	DueDay           *int     `json:"due_day,omitempty"`
+	ClearBillingDays bool     `json:"clear_billing_days,omitempty"`
+	IsArchived       *bool    `json:"is_archived,omitempty"`
+}
+
+// SetBillingCycleOverrideRequest represents the request body for overriding the dates of a billing cycle.
+type SetBillingCycleOverrideRequest struct {
+	ClosingDate *string `json:"closing_date,omitempty"` // Format: "YYYY-MM-DD"
+	DueDate     *string `json:"due_date,omitempty"`     // Format: "YYYY-MM-DD"
+}
+
+// AccountResponse represents a single account in API responses.
+type AccountResponse struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Type           string    `json:"type"`
+	Institution    string    `json:"institution,omitempty"`
+	Currency       string    `json:"currency"`
+	OpeningBalance string    `json:"opening_balance"`
+	CurrentBalance string    `json:"current_balance"`
+	ClosingDay     *int      `json:"closing_day,omitempty"`
+	DueDay         *int      `json:"due_day,omitempty"`
+	IsArchived     bool      `json:"is_archived"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// AccountListResponse represents the response for listing accounts.
+type AccountListResponse struct {
+	Accounts []AccountResponse `json:"accounts"`
+}
+
+// ToAccountResponse converts an AccountOutput to an AccountResponse DTO.
+func ToAccountResponse(output *account.AccountOutput) AccountResponse {
+	a := output.Account
+	return AccountResponse{
+		ID:             a.ID.String(),
+		Name:           a.Name,
+		Type:           string(a.Type),
+		Institution:    a.Institution,
+		Currency:       a.Currency,
+		OpeningBalance: a.OpeningBalance.String(),
+		CurrentBalance: output.CurrentBalance.String(),
+		ClosingDay:     a.ClosingDay,
+		DueDay:         a.DueDay,
+		IsArchived:     a.IsArchived,
+		CreatedAt:      a.CreatedAt,
+		UpdatedAt:      a.UpdatedAt,
+	}
+}
+
+// ToAccountListResponse converts a list of AccountOutputs to an AccountListResponse DTO.
+func ToAccountListResponse(outputs []*account.AccountOutput) AccountListResponse {
+	accounts := make([]AccountResponse, len(outputs))
+	for i, output := range outputs {
+		accounts[i] = ToAccountResponse(output)
+	}
+	return AccountListResponse{
+		Accounts: accounts,
+	}
+}
+
+// BillingCycleResponse represents a credit card billing cycle in API responses.
+type BillingCycleResponse struct {
+	BillingCycle string  `json:"billing_cycle"`
+	StartDate    string  `json:"start_date"`   // First purchase date charged in the bill
+	ClosingDate  string  `json:"closing_date"` // Purchases from this date on go to the next bill
+	DueDate      *string `json:"due_date,omitempty"`
+	IsOverridden bool    `json:"is_overridden"`
+}
+
+// BillingCycleOverrideResponse represents a billing cycle override in API responses.
+type BillingCycleOverrideResponse struct {
+	BillingCycle string  `json:"billing_cycle"`
+	ClosingDate  *string `json:"closing_date,omitempty"`
+	DueDate      *string `json:"due_date,omitempty"`
+}
+
+// BillingCycleListResponse represents the response for listing a credit card's billing cycles.
+type BillingCycleListResponse struct {
+	Cycles    []BillingCycleResponse         `json:"cycles"`
+	Overrides []BillingCycleOverrideResponse `json:"overrides"`
+}
+
+// OpenBillResponse represents the running total of a credit card bill.
+type OpenBillResponse struct {
+	AccountID        string                        `json:"account_id"`
+	BillingCycle     string                        `json:"billing_cycle"`
+	StartDate        string                        `json:"start_date"`
+	ClosingDate      string                        `json:"closing_date"`
+	DueDate          *string                       `json:"due_date,omitempty"`
+	Currency         string                        `json:"currency"`
+	Total            string                        `json:"total"` // Charges minus refunds recorded so far
+	TransactionCount int                           `json:"transaction_count"`
+	Transactions     []OpenBillTransactionResponse `json:"transactions"`
+}
+
+// OpenBillTransactionResponse represents a card transaction charged in a bill.
+type OpenBillTransactionResponse struct {
+	ID          string `json:"id"`
+	Date        string `json:"date"`
+	Description string `json:"description"`
+	Amount      string `json:"amount"` // What the transaction adds to the bill, negative for refunds
+}
+
+// ToBillingCycleResponse converts a BillingCycleOutput to a BillingCycleResponse DTO.
+func ToBillingCycleResponse(output *account.BillingCycleOutput) BillingCycleResponse {
+	period := output.Period
+	return BillingCycleResponse{
+		BillingCycle: period.BillingCycle,
+		StartDate:    period.StartDate.Format("2006-01-02"),
+		ClosingDate:  period.ClosingDate.Format("2006-01-02"),
+		DueDate:      formatOptionalDate(period.DueDate),
+		IsOverridden: output.Override != nil,
+	}
+}
+
+// ToBillingCycleListResponse converts a ListBillingCyclesOutput to a BillingCycleListResponse DTO.
+func ToBillingCycleListResponse(output *account.ListBillingCyclesOutput) BillingCycleListResponse {
+	cycles := make([]BillingCycleResponse, len(output.Cycles))
+	for i, cycle := range output.Cycles {
+		cycles[i] = ToBillingCycleResponse(cycle)
+	}
+
+	overrides := make([]BillingCycleOverrideResponse, len(output.Overrides))
+	for i, override := range output.Overrides {
+		overrides[i] = toBillingCycleOverrideResponse(override)
+	}
+
+	return BillingCycleListResponse{
+		Cycles:    cycles,
+		Overrides: overrides,
+	}
+}
+
+// ToOpenBillResponse converts a GetOpenBillOutput to an OpenBillResponse DTO.
+func ToOpenBillResponse(output *account.GetOpenBillOutput) OpenBillResponse {
+	transactions := make([]OpenBillTransactionResponse, len(output.Transactions))
+	for i, txn := range output.Transactions {
+		transactions[i] = OpenBillTransactionResponse{
+			ID:          txn.ID.String(),
+			Date:        txn.Date.Format("2006-01-02"),
+			Description: txn.Description,
+			Amount:      txn.BillAmount().String(),
+		}
+	}
+
+	period := output.Period
+	return OpenBillResponse{
+		AccountID:        output.AccountID.String(),
+		BillingCycle:     period.BillingCycle,
+		StartDate:        period.StartDate.Format("2006-01-02"),
+		ClosingDate:      period.ClosingDate.Format("2006-01-02"),
+		DueDate:          formatOptionalDate(period.DueDate),
+		Currency:         output.Currency,
+		Total:            output.Total.String(),
+		TransactionCount: len(transactions),
+		Transactions:     transactions,
+	}
+}
+
+// toBillingCycleOverrideResponse converts a BillingCycleOverride entity to a BillingCycleOverrideResponse DTO.
+func toBillingCycleOverrideResponse(override *entity.BillingCycleOverride) BillingCycleOverrideResponse {
+	return BillingCycleOverrideResponse{
+		BillingCycle: override.BillingCycle,
+		ClosingDate:  formatOptionalDate(override.ClosingDate),
+		DueDate:      formatOptionalDate(override.DueDate),
+	}
+}
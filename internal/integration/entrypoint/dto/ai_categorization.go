@@ -119,6 +119,7 @@ type ApproveSuggestionResponse struct {
 	CategoryRulePattern   string `json:"category_rule_pattern,omitempty"`
 	TransactionsUpdated   int    `json:"transactions_updated"`
 	WasNewCategoryCreated bool   `json:"was_new_category_created"`
+	OperationID           string `json:"operation_id,omitempty"`
 }
 
 // RejectSuggestionResponse represents the response for rejecting a suggestion.
@@ -257,6 +258,7 @@ func ToApproveSuggestionResponse(output *aicategorization.ApproveSuggestionOutpu
 		CategoryRulePattern:   output.CategoryRulePattern,
 		TransactionsUpdated:   output.TransactionsUpdated,
 		WasNewCategoryCreated: output.WasNewCategoryCreated,
+		OperationID:           output.OperationID,
 	}
 }
 
@@ -0,0 +1,118 @@
+// Package dto defines data transfer objects for API requests and responses.
+package dto
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// CreateAttachmentUploadURLRequest represents the request body for starting a direct upload.
+type CreateAttachmentUploadURLRequest struct {
+	FileName    string `json:"file_name" binding:"required,max=255"`
+	ContentType string `json:"content_type" binding:"required"`
+	SizeBytes   int64  `json:"size_bytes" binding:"required,min=1"`
+}
+
+// AttachmentResponse represents a single attachment in API responses.
+type AttachmentResponse struct {
+	ID           string    `json:"id"`
+	OwnerType    string    `json:"owner_type"`
+	OwnerID      string    `json:"owner_id"`
+	FileName     string    `json:"file_name"`
+	ContentType  string    `json:"content_type"`
+	SizeBytes    int64     `json:"size_bytes"`
+	Checksum     string    `json:"checksum,omitempty"`
+	ImageWidth   *int      `json:"image_width,omitempty"`
+	ImageHeight  *int      `json:"image_height,omitempty"`
+	HasThumbnail bool      `json:"has_thumbnail"`
+	Status       string    `json:"status"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// AttachmentListResponse represents the response for listing attachments.
+type AttachmentListResponse struct {
+	Attachments []AttachmentResponse `json:"attachments"`
+}
+
+// AttachmentDetailResponse represents an attachment with temporary download URLs.
+// The URLs are omitted when the storage cannot presign them; use the content endpoints instead.
+type AttachmentDetailResponse struct {
+	AttachmentResponse
+	DownloadURL  string     `json:"download_url,omitempty"`
+	ThumbnailURL string     `json:"thumbnail_url,omitempty"`
+	URLExpiresAt *time.Time `json:"url_expires_at,omitempty"`
+}
+
+// AttachmentUploadURLResponse represents the response for starting a direct upload.
+type AttachmentUploadURLResponse struct {
+	Attachment   AttachmentResponse `json:"attachment"`
+	UploadURL    string             `json:"upload_url"`
+	UploadMethod string             `json:"upload_method"`
+	ExpiresAt    time.Time          `json:"expires_at"`
+}
+
+// ToAttachmentResponse converts an Attachment entity to an AttachmentResponse DTO.
+func ToAttachmentResponse(attachment *entity.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:           attachment.ID.String(),
+		OwnerType:    string(attachment.OwnerType),
+		OwnerID:      attachment.OwnerID.String(),
+		FileName:     attachment.FileName,
+		ContentType:  attachment.ContentType,
+		SizeBytes:    attachment.SizeBytes,
+		Checksum:     attachment.Checksum,
+		ImageWidth:   attachment.ImageWidth,
+		ImageHeight:  attachment.ImageHeight,
+		HasThumbnail: attachment.ThumbnailKey != "",
+		Status:       string(attachment.Status),
+		CreatedAt:    attachment.CreatedAt,
+		UpdatedAt:    attachment.UpdatedAt,
+	}
+}
+
+// ToAttachmentListResponse converts a list of Attachment entities to an AttachmentListResponse DTO.
+func ToAttachmentListResponse(attachments []*entity.Attachment) AttachmentListResponse {
+	responses := make([]AttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		responses[i] = ToAttachmentResponse(attachment)
+	}
+	return AttachmentListResponse{
+		Attachments: responses,
+	}
+}
+
+// ToAttachmentDetailResponse converts an attachment and its download URLs to an AttachmentDetailResponse DTO.
+func ToAttachmentDetailResponse(
+	attachment *entity.Attachment,
+	downloadURL string,
+	thumbnailURL string,
+	expiresAt time.Time,
+) AttachmentDetailResponse {
+	response := AttachmentDetailResponse{
+		AttachmentResponse: ToAttachmentResponse(attachment),
+		DownloadURL:        downloadURL,
+		ThumbnailURL:       thumbnailURL,
+	}
+	if !expiresAt.IsZero() {
+		response.URLExpiresAt = &expiresAt
+	}
+	return response
+}
+
+// ToAttachmentUploadURLResponse converts a pending attachment and its upload URL to an
+// AttachmentUploadURLResponse DTO.
+func ToAttachmentUploadURLResponse(
+	attachment *entity.Attachment,
+	uploadURL string,
+	expiresAt time.Time,
+) AttachmentUploadURLResponse {
+	return AttachmentUploadURLResponse{
+		Attachment:   ToAttachmentResponse(attachment),
+		UploadURL:    uploadURL,
+		UploadMethod: http.MethodPut,
+		ExpiresAt:    expiresAt,
+	}
+}
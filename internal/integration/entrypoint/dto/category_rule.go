@@ -54,6 +54,7 @@ type CategoryRuleResponse struct {
 	CreatedAt           time.Time `json:"created_at"`
 	UpdatedAt           time.Time `json:"updated_at"`
 	TransactionsUpdated int       `json:"transactions_updated,omitempty"`
+	OperationID         string    `json:"operation_id,omitempty"` // Reverts the categorization of existing transactions
 }
 
 // CategoryRuleListResponse represents the response for listing category rules.
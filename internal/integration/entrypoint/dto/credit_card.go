@@ -6,6 +6,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+
+	creditcard "github.com/finance-tracker/backend/internal/application/usecase/credit_card"
 )
 
 // CreditCardTransactionDTO represents a parsed credit card transaction line.
@@ -15,6 +17,7 @@ type CreditCardTransactionDTO struct {
 	Amount             float64 `json:"amount"`
 	InstallmentCurrent *int    `json:"installment_current,omitempty"`
 	InstallmentTotal   *int    `json:"installment_total,omitempty"`
+	Currency           string  `json:"currency,omitempty"`
 }
 
 // BillMatchDTO represents a potential match between CC payment and bank bill payment.
@@ -30,10 +33,22 @@ type BillMatchDTO struct {
 	MatchScore        float64 `json:"match_score"`
 }
 
+// PossibleDuplicateDTO represents an existing transaction that looks like a transaction to import.
+type PossibleDuplicateDTO struct {
+	LineIndex     int     `json:"line_index"` // Index into transactions_to_import
+	TransactionID string  `json:"transaction_id"`
+	Date          string  `json:"date"`
+	Description   string  `json:"description"`
+	Amount        string  `json:"amount"`
+	Score         float64 `json:"score"`
+}
+
 // ImportPreviewRequestDTO represents the request for previewing CC import.
 type ImportPreviewRequestDTO struct {
 	BillingCycle string                     `json:"billing_cycle" binding:"required"` // Format: "YYYY-MM"
+	AccountID    string                     `json:"account_id"`                       // Optional - card a re-import is compared with
 	Transactions []CreditCardTransactionDTO `json:"transactions" binding:"required,min=1"`
+	Reimport     bool                       `json:"reimport"` // Whether to compare with the transactions already imported
 }
 
 // ImportPreviewResponseDTO represents the response for CC import preview.
@@ -45,14 +60,37 @@ type ImportPreviewResponseDTO struct {
 	TransactionsToImport  []CreditCardTransactionDTO `json:"transactions_to_import"`
 	PaymentReceivedAmount string                     `json:"payment_received_amount"` // "Pagamento recebido" total
 	HasExistingImport     bool                       `json:"has_existing_import"`     // If billing cycle already imported
+	PossibleDuplicates    []PossibleDuplicateDTO     `json:"possible_duplicates"`     // Transactions that may already exist
+	Reimport              *ReimportDiffDTO           `json:"reimport,omitempty"`      // Difference with the imported cycle, for re-imports
+}
+
+// ReimportDiffDTO represents the difference between an imported billing cycle and a corrected statement.
+type ReimportDiffDTO struct {
+	Added          []int                     `json:"added"` // Indexes into transactions_to_import
+	Changed        []ReimportChangedLineDTO  `json:"changed"`
+	Removed        []CCTransactionSummaryDTO `json:"removed"`
+	UnchangedCount int                       `json:"unchanged_count"`
+}
+
+// ReimportChangedLineDTO represents an imported transaction whose line the corrected statement changed.
+type ReimportChangedLineDTO struct {
+	LineIndex           int      `json:"line_index"` // Index into transactions_to_import
+	TransactionID       string   `json:"transaction_id"`
+	ChangedFields       []string `json:"changed_fields"`
+	PreviousDate        string   `json:"previous_date"`
+	PreviousDescription string   `json:"previous_description"`
+	PreviousAmount      string   `json:"previous_amount"`
 }
 
 // ImportRequestDTO represents the request for importing CC transactions.
 type ImportRequestDTO struct {
-	BillingCycle      string                     `json:"billing_cycle" binding:"required"` // Format: "YYYY-MM"
-	BillPaymentID     string                     `json:"bill_payment_id"`                  // Optional - if empty, imports as standalone CC transactions
+	BillingCycle      string                     `json:"billing_cycle"`   // Format: "YYYY-MM" - optional when the card has a closing day
+	AccountID         string                     `json:"account_id"`      // Optional - credit card account the bill belongs to
+	BillPaymentID     string                     `json:"bill_payment_id"` // Optional - if empty, imports as standalone CC transactions
 	Transactions      []CreditCardTransactionDTO `json:"transactions" binding:"required,min=1"`
 	ApplyAutoCategory bool                       `json:"apply_auto_category"` // Whether to apply category rules
+	SkipDuplicates    bool                       `json:"skip_duplicates"`     // Whether to skip likely duplicates
+	Reimport          bool                       `json:"reimport"`            // Whether to apply only the difference with the imported cycle
 }
 
 // ImportResultDTO represents the result of CC import operation.
@@ -62,17 +100,23 @@ type ImportResultDTO struct {
 	BillPaymentID      string                       `json:"bill_payment_id"`
 	BillingCycle       string                       `json:"billing_cycle"`
 	OriginalBillAmount string                       `json:"original_bill_amount"`
-	ImportedAt         time.Time                    `json:"imported_at"`
-	Transactions       []ImportedTransactionSummary `json:"transactions"`
+	ImportedAt            time.Time                    `json:"imported_at"`
+	Transactions          []ImportedTransactionSummary `json:"transactions"`
+	SkippedDuplicateCount int                          `json:"skipped_duplicate_count"`
+	InstallmentIssues     []InstallmentIssueResponse   `json:"installment_issues"` // Missing or extra installments of the bill
+	UpdatedCount          int                          `json:"updated_count"`      // Re-imports only
+	RemovedCount          int                          `json:"removed_count"`      // Re-imports only
+	UnchangedCount        int                          `json:"unchanged_count"`    // Re-imports only
 }
 
 // ImportedTransactionSummary represents a summary of an imported transaction.
 type ImportedTransactionSummary struct {
-	ID          string  `json:"id"`
-	Date        string  `json:"date"`
-	Description string  `json:"description"`
-	Amount      string  `json:"amount"`
-	CategoryID  *string `json:"category_id,omitempty"`
+	ID                string  `json:"id"`
+	Date              string  `json:"date"`
+	Description       string  `json:"description"`
+	Amount            string  `json:"amount"`
+	CategoryID        *string `json:"category_id,omitempty"`
+	InstallmentPlanID *string `json:"installment_plan_id,omitempty"` // Plan the installment was grouped into
 }
 
 // CollapseRequestDTO represents the request for collapsing CC expansion.
@@ -167,3 +211,173 @@ func ToBillMatchDTO(
 		MatchScore:        matchScore,
 	}
 }
+
+// ToReimportDiffDTO converts a ReimportDiff to a ReimportDiffDTO.
+func ToReimportDiffDTO(diff *creditcard.ReimportDiff) *ReimportDiffDTO {
+	if diff == nil {
+		return nil
+	}
+
+	changed := make([]ReimportChangedLineDTO, len(diff.Changed))
+	for i, change := range diff.Changed {
+		changed[i] = ReimportChangedLineDTO{
+			LineIndex:           change.LineIndex,
+			TransactionID:       change.Existing.ID.String(),
+			ChangedFields:       change.Fields,
+			PreviousDate:        change.Existing.Date.Format("2006-01-02"),
+			PreviousDescription: change.Existing.Description,
+			PreviousAmount:      change.Existing.Amount.String(),
+		}
+	}
+
+	removed := make([]CCTransactionSummaryDTO, len(diff.Removed))
+	for i, txn := range diff.Removed {
+		removed[i] = ToCreditCardTransactionDTO(txn.ID, txn.Date, txn.Description, txn.Amount, txn.CategoryID, txn.IsHidden)
+	}
+
+	return &ReimportDiffDTO{
+		Added:          diff.Added,
+		Changed:        changed,
+		Removed:        removed,
+		UnchangedCount: diff.UnchangedCount,
+	}
+}
+
+// CreditCardForecastDTO represents the forecast of the next credit card bills.
+type CreditCardForecastDTO struct {
+	Currency          string                `json:"currency"`
+	OpenBillingCycle  string                `json:"open_billing_cycle"`
+	HistoryCycles     int                   `json:"history_cycles"`
+	AverageVariable   string                `json:"average_variable"`
+	VariableDeviation string                `json:"variable_deviation"`
+	Subscriptions     []CardSubscriptionDTO `json:"subscriptions"`
+	Bills             []BillForecastDTO     `json:"bills"`
+}
+
+// CardSubscriptionDTO represents a recurring charge detected on past bills.
+type CardSubscriptionDTO struct {
+	Description      string  `json:"description"`
+	MerchantID       *string `json:"merchant_id,omitempty"`
+	Amount           string  `json:"amount"`
+	LastBillingCycle string  `json:"last_billing_cycle"`
+	Occurrences      int     `json:"occurrences"`
+}
+
+// BillForecastDTO represents the projected amount of a credit card bill.
+type BillForecastDTO struct {
+	BillingCycle  string  `json:"billing_cycle"`
+	ClosingDate   *string `json:"closing_date,omitempty"`
+	DueDate       *string `json:"due_date,omitempty"`
+	Recorded      string  `json:"recorded"`
+	Installments  string  `json:"installments"`
+	Subscriptions string  `json:"subscriptions"`
+	Variable      string  `json:"variable"`
+	Committed     string  `json:"committed"`
+	Expected      string  `json:"expected"`
+	Low           string  `json:"low"`
+	High          string  `json:"high"`
+}
+
+// ToCreditCardForecastDTO converts a GetForecastOutput to a CreditCardForecastDTO.
+func ToCreditCardForecastDTO(output *creditcard.GetForecastOutput) CreditCardForecastDTO {
+	subscriptions := make([]CardSubscriptionDTO, len(output.Subscriptions))
+	for i, subscription := range output.Subscriptions {
+		subscriptions[i] = CardSubscriptionDTO{
+			Description:      subscription.Description,
+			Amount:           subscription.Amount.String(),
+			LastBillingCycle: subscription.LastBillingCycle,
+			Occurrences:      subscription.Occurrences,
+		}
+		if subscription.MerchantID != nil {
+			merchantID := subscription.MerchantID.String()
+			subscriptions[i].MerchantID = &merchantID
+		}
+	}
+
+	bills := make([]BillForecastDTO, len(output.Bills))
+	for i, bill := range output.Bills {
+		bills[i] = BillForecastDTO{
+			BillingCycle:  bill.BillingCycle,
+			ClosingDate:   formatOptionalDate(bill.ClosingDate),
+			DueDate:       formatOptionalDate(bill.DueDate),
+			Recorded:      bill.Recorded.String(),
+			Installments:  bill.Installments.String(),
+			Subscriptions: bill.Subscriptions.String(),
+			Variable:      bill.Variable.String(),
+			Committed:     bill.Committed.String(),
+			Expected:      bill.Expected.String(),
+			Low:           bill.Low.String(),
+			High:          bill.High.String(),
+		}
+	}
+
+	return CreditCardForecastDTO{
+		Currency:          output.Currency,
+		OpenBillingCycle:  output.OpenBillingCycle,
+		HistoryCycles:     output.HistoryCycles,
+		AverageVariable:   output.AverageVariable.String(),
+		VariableDeviation: output.VariableDeviation.String(),
+		Subscriptions:     subscriptions,
+		Bills:             bills,
+	}
+}
+
+// StatementPDFLineDTO represents a transaction line read from a statement PDF.
+// Date, description, amount and installments can be sent as is to the preview and import endpoints.
+type StatementPDFLineDTO struct {
+	Row                int     `json:"row"`
+	Date               string  `json:"date"`
+	Description        string  `json:"description"`
+	Amount             float64 `json:"amount"`
+	InstallmentCurrent *int    `json:"installment_current,omitempty"`
+	InstallmentTotal   *int    `json:"installment_total,omitempty"`
+	OriginalAmount     *string `json:"original_amount,omitempty"`   // International purchases only
+	OriginalCurrency   string  `json:"original_currency,omitempty"` // International purchases only
+	IsIOF              bool    `json:"is_iof"`
+}
+
+// StatementPDFResponseDTO represents a credit card statement read from a PDF file.
+type StatementPDFResponseDTO struct {
+	Issuer         string                       `json:"issuer"`
+	BillingCycle   string                       `json:"billing_cycle"`
+	DueDate        string                       `json:"due_date"`
+	StatementTotal *string                      `json:"statement_total,omitempty"`
+	LinesTotal     string                       `json:"lines_total"`
+	Transactions   []StatementPDFLineDTO        `json:"transactions"`
+	Errors         []StatementLineErrorResponse `json:"errors"`
+}
+
+// ToStatementPDFResponseDTO converts a statement read from a PDF to its response DTO.
+func ToStatementPDFResponseDTO(output *creditcard.ParseStatementPDFOutput) StatementPDFResponseDTO {
+	transactions := make([]StatementPDFLineDTO, len(output.Lines))
+	for i, line := range output.Lines {
+		transactions[i] = StatementPDFLineDTO{
+			Row:                line.Row,
+			Date:               line.Date.Format("2006-01-02"),
+			Description:        line.Description,
+			Amount:             line.Amount.InexactFloat64(),
+			InstallmentCurrent: line.InstallmentCurrent,
+			InstallmentTotal:   line.InstallmentTotal,
+			OriginalCurrency:   line.OriginalCurrency,
+			IsIOF:              line.IsIOF,
+		}
+		if line.OriginalAmount != nil {
+			originalAmount := line.OriginalAmount.String()
+			transactions[i].OriginalAmount = &originalAmount
+		}
+	}
+
+	response := StatementPDFResponseDTO{
+		Issuer:       output.Issuer,
+		BillingCycle: output.BillingCycle,
+		DueDate:      output.DueDate.Format("2006-01-02"),
+		LinesTotal:   output.LinesTotal.String(),
+		Transactions: transactions,
+		Errors:       toStatementLineErrorResponses(output.Errors),
+	}
+	if output.StatementTotal != nil {
+		statementTotal := output.StatementTotal.String()
+		response.StatementTotal = &statementTotal
+	}
+	return response
+}
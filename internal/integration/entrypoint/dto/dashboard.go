@@ -134,8 +134,9 @@ type TrendsResponse struct {
 
 // TrendsData represents the data section of trends response.
 type TrendsData struct {
-	Period TrendsPeriodResponse `json:"period"`
-	Trends []TrendPointResponse `json:"trends"`
+	Period   TrendsPeriodResponse `json:"period"`
+	Currency string               `json:"currency"`
+	Trends   []TrendPointResponse `json:"trends"`
 }
 
 // TrendsPeriodResponse represents the period information in trends response.
@@ -179,7 +180,8 @@ func ToTrendsResponse(output *dashboard.GetTrendsOutput) TrendsResponse {
 				EndDate:     output.Period.EndDate.Format("2006-01-02"),
 				Granularity: string(output.Period.Granularity),
 			},
-			Trends: trends,
+			Currency: output.Currency,
+			Trends:   trends,
 		},
 	}
 }
@@ -192,6 +194,7 @@ type CategoryBreakdownResponse struct {
 // CategoryBreakdownData represents the data section of category breakdown response.
 type CategoryBreakdownData struct {
 	Period        BreakdownPeriodResponse       `json:"period"`
+	Currency      string                        `json:"currency"`
 	TotalExpenses float64                       `json:"total_expenses"`
 	Categories    []CategoryBreakdownItemResponse `json:"categories"`
 }
@@ -239,12 +242,67 @@ func ToCategoryBreakdownResponse(output *dashboard.GetCategoryBreakdownOutput) C
 				EndDate:     output.Period.EndDate.Format("2006-01-02"),
 				PeriodLabel: output.Period.PeriodLabel,
 			},
+			Currency:      output.Currency,
 			TotalExpenses: totalExpenses,
 			Categories:    categories,
 		},
 	}
 }
 
+// TagBreakdownResponse represents the response for tag breakdown API.
+type TagBreakdownResponse struct {
+	Data TagBreakdownData `json:"data"`
+}
+
+// TagBreakdownData represents the data section of tag breakdown response.
+type TagBreakdownData struct {
+	Period        BreakdownPeriodResponse    `json:"period"`
+	Currency      string                     `json:"currency"`
+	TotalExpenses float64                    `json:"total_expenses"`
+	Tags          []TagBreakdownItemResponse `json:"tags"`
+}
+
+// TagBreakdownItemResponse represents a single tag in the breakdown response.
+type TagBreakdownItemResponse struct {
+	TagID            string  `json:"tag_id"`
+	TagName          string  `json:"tag_name"`
+	TagColor         string  `json:"tag_color"`
+	Amount           float64 `json:"amount"`
+	Percentage       float64 `json:"percentage"`
+	TransactionCount int     `json:"transaction_count"`
+}
+
+// ToTagBreakdownResponse converts a GetTagBreakdownOutput to TagBreakdownResponse DTO.
+func ToTagBreakdownResponse(output *dashboard.GetTagBreakdownOutput) TagBreakdownResponse {
+	totalExpenses, _ := output.TotalExpenses.Float64()
+
+	tags := make([]TagBreakdownItemResponse, len(output.Tags))
+	for i, t := range output.Tags {
+		amount, _ := t.Amount.Float64()
+		tags[i] = TagBreakdownItemResponse{
+			TagID:            t.TagID,
+			TagName:          t.TagName,
+			TagColor:         t.TagColor,
+			Amount:           amount,
+			Percentage:       t.Percentage,
+			TransactionCount: t.TransactionCount,
+		}
+	}
+
+	return TagBreakdownResponse{
+		Data: TagBreakdownData{
+			Period: BreakdownPeriodResponse{
+				StartDate:   output.Period.StartDate.Format("2006-01-02"),
+				EndDate:     output.Period.EndDate.Format("2006-01-02"),
+				PeriodLabel: output.Period.PeriodLabel,
+			},
+			Currency:      output.Currency,
+			TotalExpenses: totalExpenses,
+			Tags:          tags,
+		},
+	}
+}
+
 // PeriodTransactionsResponse represents the response for period transactions API.
 type PeriodTransactionsResponse struct {
 	Data PeriodTransactionsData `json:"data"`
@@ -253,6 +311,7 @@ type PeriodTransactionsResponse struct {
 // PeriodTransactionsData represents the data section of period transactions response.
 type PeriodTransactionsData struct {
 	Period       TransactionsPeriodResponse      `json:"period"`
+	Currency     string                          `json:"currency"`
 	Summary      TransactionSummaryResponse      `json:"summary"`
 	Transactions []PeriodTransactionItemResponse `json:"transactions"`
 	Pagination   DashboardPaginationResponse     `json:"pagination"`
@@ -291,6 +350,8 @@ type DashboardPaginationResponse struct {
 	Limit   int  `json:"limit"`
 	Offset  int  `json:"offset"`
 	HasMore bool `json:"has_more"`
+	// NextCursor is passed as the cursor parameter to fetch the next page; omitted on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // ToPeriodTransactionsResponse converts a GetPeriodTransactionsOutput to PeriodTransactionsResponse DTO.
@@ -321,6 +382,7 @@ func ToPeriodTransactionsResponse(output *dashboard.GetPeriodTransactionsOutput)
 				EndDate:     output.Period.EndDate.Format("2006-01-02"),
 				PeriodLabel: output.Period.PeriodLabel,
 			},
+			Currency: output.Currency,
 			Summary: TransactionSummaryResponse{
 				TotalIncome:      totalIncome,
 				TotalExpenses:    totalExpenses,
@@ -329,11 +391,103 @@ func ToPeriodTransactionsResponse(output *dashboard.GetPeriodTransactionsOutput)
 			},
 			Transactions: transactions,
 			Pagination: DashboardPaginationResponse{
-				Total:   output.Pagination.Total,
-				Limit:   output.Pagination.Limit,
-				Offset:  output.Pagination.Offset,
-				HasMore: output.Pagination.HasMore,
+				Total:      output.Pagination.Total,
+				Limit:      output.Pagination.Limit,
+				Offset:     output.Pagination.Offset,
+				HasMore:    output.Pagination.HasMore,
+				NextCursor: output.Pagination.NextCursor,
 			},
 		},
 	}
 }
+
+// TopMerchantsResponse represents the response for top merchants API.
+type TopMerchantsResponse struct {
+	Data TopMerchantsData `json:"data"`
+}
+
+// TopMerchantsData represents the data section of top merchants response.
+type TopMerchantsData struct {
+	Period        TrendPeriodResponse          `json:"period"`
+	Currency      string                       `json:"currency"`
+	TotalExpenses float64                      `json:"total_expenses"`
+	Merchants     []TopMerchantItemResponse    `json:"merchants"`
+	Trends        []MerchantTrendPointResponse `json:"trends"`
+}
+
+// TopMerchantItemResponse represents a single merchant in the top merchants response.
+type TopMerchantItemResponse struct {
+	MerchantID       string  `json:"merchant_id"`
+	MerchantName     string  `json:"merchant_name"`
+	Amount           float64 `json:"amount"`
+	Percentage       float64 `json:"percentage"`
+	TransactionCount int     `json:"transaction_count"`
+	AverageAmount    float64 `json:"average_amount"`
+}
+
+// MerchantTrendPointResponse represents the top merchants' spending in one period.
+type MerchantTrendPointResponse struct {
+	Date        string                         `json:"date"`
+	PeriodLabel string                         `json:"period_label"`
+	Merchants   []MerchantPeriodAmountResponse `json:"merchants"`
+}
+
+// MerchantPeriodAmountResponse represents a merchant's spending in one period.
+type MerchantPeriodAmountResponse struct {
+	MerchantID       string  `json:"merchant_id"`
+	Amount           float64 `json:"amount"`
+	TransactionCount int     `json:"transaction_count"`
+}
+
+// ToTopMerchantsResponse converts a GetTopMerchantsOutput to TopMerchantsResponse DTO.
+func ToTopMerchantsResponse(output *dashboard.GetTopMerchantsOutput) TopMerchantsResponse {
+	totalExpenses, _ := output.TotalExpenses.Float64()
+
+	// Convert merchants
+	merchants := make([]TopMerchantItemResponse, len(output.Merchants))
+	for i, m := range output.Merchants {
+		amount, _ := m.Amount.Float64()
+		averageAmount, _ := m.AverageAmount.Float64()
+		merchants[i] = TopMerchantItemResponse{
+			MerchantID:       m.MerchantID.String(),
+			MerchantName:     m.MerchantName,
+			Amount:           amount,
+			Percentage:       m.Percentage,
+			TransactionCount: m.TransactionCount,
+			AverageAmount:    averageAmount,
+		}
+	}
+
+	// Convert trends
+	trends := make([]MerchantTrendPointResponse, len(output.Trends))
+	for i, trend := range output.Trends {
+		amounts := make([]MerchantPeriodAmountResponse, len(trend.Merchants))
+		for j, m := range trend.Merchants {
+			amount, _ := m.Amount.Float64()
+			amounts[j] = MerchantPeriodAmountResponse{
+				MerchantID:       m.MerchantID.String(),
+				Amount:           amount,
+				TransactionCount: m.TransactionCount,
+			}
+		}
+		trends[i] = MerchantTrendPointResponse{
+			Date:        trend.Date.Format("2006-01-02"),
+			PeriodLabel: trend.PeriodLabel,
+			Merchants:   amounts,
+		}
+	}
+
+	return TopMerchantsResponse{
+		Data: TopMerchantsData{
+			Period: TrendPeriodResponse{
+				StartDate:   output.Period.StartDate.Format("2006-01-02"),
+				EndDate:     output.Period.EndDate.Format("2006-01-02"),
+				Granularity: string(output.Period.Granularity),
+			},
+			Currency:      output.Currency,
+			TotalExpenses: totalExpenses,
+			Merchants:     merchants,
+			Trends:        trends,
+		},
+	}
+}
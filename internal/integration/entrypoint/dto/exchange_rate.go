@@ -0,0 +1,86 @@
+// Package dto defines data transfer objects for API requests and responses.
+package dto
+
+import (
+	"time"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// CreateExchangeRateRequest represents the request body for entering an exchange rate.
+type CreateExchangeRateRequest struct {
+	FromCurrency string  `json:"from_currency" binding:"required"`
+	ToCurrency   string  `json:"to_currency" binding:"required"`
+	Rate         float64 `json:"rate" binding:"required"` // Value of one unit of from_currency in to_currency
+	Date         string  `json:"date" binding:"required"` // Format: "YYYY-MM-DD"
+}
+
+// UpdateBaseCurrencyRequest represents the request body for changing the user's base currency.
+type UpdateBaseCurrencyRequest struct {
+	BaseCurrency string `json:"base_currency" binding:"required"`
+}
+
+// ExchangeRateResponse represents an exchange rate in API responses.
+type ExchangeRateResponse struct {
+	ID           string    `json:"id"`
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	Rate         string    `json:"rate"`
+	Date         string    `json:"date"`
+	Source       string    `json:"source"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ExchangeRateListResponse represents the response for listing exchange rates.
+type ExchangeRateListResponse struct {
+	BaseCurrency  string                 `json:"base_currency"`
+	ExchangeRates []ExchangeRateResponse `json:"exchange_rates"`
+}
+
+// ImportExchangeRatesResponse represents the response for an exchange rate file import.
+type ImportExchangeRatesResponse struct {
+	ImportedCount int                          `json:"imported_count"`
+	Errors        []StatementLineErrorResponse `json:"errors"`
+}
+
+// BaseCurrencyResponse represents the response for a base currency change.
+type BaseCurrencyResponse struct {
+	BaseCurrency      string `json:"base_currency"`
+	RecalculatedCount int    `json:"recalculated_count"` // Number of currency and date pairs whose rate was recalculated
+}
+
+// ToExchangeRateResponse converts an ExchangeRate entity to an ExchangeRateResponse DTO.
+func ToExchangeRateResponse(rate *entity.ExchangeRate) ExchangeRateResponse {
+	return ExchangeRateResponse{
+		ID:           rate.ID.String(),
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Rate:         rate.Rate.String(),
+		Date:         rate.Date.Format("2006-01-02"),
+		Source:       string(rate.Source),
+		CreatedAt:    rate.CreatedAt,
+		UpdatedAt:    rate.UpdatedAt,
+	}
+}
+
+// ToExchangeRateListResponse converts exchange rate entities to an ExchangeRateListResponse DTO.
+func ToExchangeRateListResponse(baseCurrency string, rates []*entity.ExchangeRate) ExchangeRateListResponse {
+	response := ExchangeRateListResponse{
+		BaseCurrency:  baseCurrency,
+		ExchangeRates: make([]ExchangeRateResponse, len(rates)),
+	}
+	for i, rate := range rates {
+		response.ExchangeRates[i] = ToExchangeRateResponse(rate)
+	}
+	return response
+}
+
+// ToImportExchangeRatesResponse converts the import result to an ImportExchangeRatesResponse DTO.
+func ToImportExchangeRatesResponse(importedCount int, lineErrors []adapter.StatementLineError) ImportExchangeRatesResponse {
+	return ImportExchangeRatesResponse{
+		ImportedCount: importedCount,
+		Errors:        toStatementLineErrorResponses(lineErrors),
+	}
+}
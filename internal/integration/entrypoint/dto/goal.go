@@ -4,39 +4,85 @@ package dto
 import (
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/finance-tracker/backend/internal/application/usecase/goal"
 	"github.com/finance-tracker/backend/internal/domain/entity"
 )
 
 // CreateGoalRequest represents the request body for goal creation.
+// Spending limit goals (the default kind) require category_id and limit_amount;
+// savings goals require name and target_amount.
 type CreateGoalRequest struct {
-	CategoryID    string   `json:"category_id" binding:"required,uuid"`
-	LimitAmount   float64  `json:"limit_amount" binding:"required,gt=0"`
-	AlertOnExceed *bool    `json:"alert_on_exceed,omitempty"`
-	Period        *string  `json:"period,omitempty" binding:"omitempty,oneof=monthly weekly yearly"`
+	Kind          *string `json:"kind,omitempty" binding:"omitempty,oneof=spending_limit savings"`
+	CategoryID    string  `json:"category_id" binding:"omitempty,uuid"`
+	LimitAmount   float64 `json:"limit_amount" binding:"omitempty,gt=0"`
+	AlertOnExceed *bool   `json:"alert_on_exceed,omitempty"`
+	Period        *string `json:"period,omitempty" binding:"omitempty,oneof=monthly weekly yearly"`
+	Name          string  `json:"name,omitempty" binding:"omitempty,max=100"`
+	TargetAmount  float64 `json:"target_amount,omitempty" binding:"omitempty,gt=0"`
+	TargetDate    *string `json:"target_date,omitempty"`
 }
 
 // UpdateGoalRequest represents the request body for goal update.
 type UpdateGoalRequest struct {
-	LimitAmount   *float64 `json:"limit_amount,omitempty" binding:"omitempty,gt=0"`
-	AlertOnExceed *bool    `json:"alert_on_exceed,omitempty"`
-	Period        *string  `json:"period,omitempty" binding:"omitempty,oneof=monthly weekly yearly"`
+	LimitAmount     *float64 `json:"limit_amount,omitempty" binding:"omitempty,gt=0"`
+	AlertOnExceed   *bool    `json:"alert_on_exceed,omitempty"`
+	Period          *string  `json:"period,omitempty" binding:"omitempty,oneof=monthly weekly yearly"`
+	Name            *string  `json:"name,omitempty" binding:"omitempty,max=100"`
+	TargetAmount    *float64 `json:"target_amount,omitempty" binding:"omitempty,gt=0"`
+	TargetDate      *string  `json:"target_date,omitempty"`
+	ClearTargetDate bool     `json:"clear_target_date,omitempty"`
 }
 
 // GoalResponse represents a single goal in API responses.
 type GoalResponse struct {
-	ID            string            `json:"id"`
-	UserID        string            `json:"user_id"`
-	CategoryID    string            `json:"category_id"`
-	Category      *CategoryResponse `json:"category,omitempty"`
-	LimitAmount   float64           `json:"limit_amount"`
-	CurrentAmount float64           `json:"current_amount"`
-	AlertOnExceed bool              `json:"alert_on_exceed"`
-	Period        string            `json:"period"`
-	StartDate     *string           `json:"start_date,omitempty"`
-	EndDate       *string           `json:"end_date,omitempty"`
-	CreatedAt     time.Time         `json:"created_at"`
-	UpdatedAt     time.Time         `json:"updated_at"`
+	ID                      string            `json:"id"`
+	UserID                  string            `json:"user_id"`
+	Kind                    string            `json:"kind"`
+	CategoryID              string            `json:"category_id,omitempty"`
+	Category                *CategoryResponse `json:"category,omitempty"`
+	LimitAmount             float64           `json:"limit_amount"`
+	CurrentAmount           float64           `json:"current_amount"`
+	ProgressPercent         float64           `json:"progress_percent"`
+	AlertOnExceed           bool              `json:"alert_on_exceed"`
+	Period                  string            `json:"period,omitempty"`
+	StartDate               *string           `json:"start_date,omitempty"`
+	EndDate                 *string           `json:"end_date,omitempty"`
+	Name                    string            `json:"name,omitempty"`
+	TargetAmount            float64           `json:"target_amount,omitempty"`
+	TargetDate              *string           `json:"target_date,omitempty"`
+	ProjectedCompletionDate *string           `json:"projected_completion_date,omitempty"`
+	CreatedAt               time.Time         `json:"created_at"`
+	UpdatedAt               time.Time         `json:"updated_at"`
+}
+
+// AddGoalContributionRequest represents the request body for adding a contribution to a savings goal.
+// Either amount (manual deposit) or transaction_id must be provided.
+type AddGoalContributionRequest struct {
+	TransactionID *string  `json:"transaction_id,omitempty" binding:"omitempty,uuid"`
+	Amount        *float64 `json:"amount,omitempty" binding:"omitempty,gt=0"`
+	Date          *string  `json:"date,omitempty"`
+	Notes         string   `json:"notes,omitempty" binding:"omitempty,max=1000"`
+}
+
+// GoalContributionResponse represents a single savings goal contribution in API responses.
+type GoalContributionResponse struct {
+	ID            string    `json:"id"`
+	GoalID        string    `json:"goal_id"`
+	TransactionID *string   `json:"transaction_id,omitempty"`
+	Amount        float64   `json:"amount"`
+	Date          string    `json:"date"`
+	Notes         string    `json:"notes"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// GoalContributionListResponse represents the response for listing a savings goal's contributions.
+type GoalContributionListResponse struct {
+	Contributions           []GoalContributionResponse `json:"contributions"`
+	SavedAmount             float64                    `json:"saved_amount"`
+	ProgressPercent         float64                    `json:"progress_percent"`
+	ProjectedCompletionDate *string                    `json:"projected_completion_date,omitempty"`
 }
 
 // GoalListResponse represents the response for listing goals.
@@ -49,15 +95,22 @@ func ToGoalResponse(g *entity.Goal) GoalResponse {
 	response := GoalResponse{
 		ID:            g.ID.String(),
 		UserID:        g.UserID.String(),
-		CategoryID:    g.CategoryID.String(),
+		Kind:          string(g.Kind),
 		LimitAmount:   g.LimitAmount,
 		CurrentAmount: 0,
 		AlertOnExceed: g.AlertOnExceed,
 		Period:        string(g.Period),
+		Name:          g.Name,
+		TargetAmount:  g.TargetAmount,
+		TargetDate:    formatOptionalDate(g.TargetDate),
 		CreatedAt:     g.CreatedAt,
 		UpdatedAt:     g.UpdatedAt,
 	}
 
+	if g.CategoryID != uuid.Nil {
+		response.CategoryID = g.CategoryID.String()
+	}
+
 	if g.StartDate != nil {
 		dateStr := g.StartDate.Format("2006-01-02")
 		response.StartDate = &dateStr
@@ -74,15 +127,24 @@ func ToGoalResponse(g *entity.Goal) GoalResponse {
 // ToGoalResponseWithCategory converts a GoalOutput to a GoalResponse DTO with category.
 func ToGoalResponseWithCategory(output *goal.GoalOutput) GoalResponse {
 	response := GoalResponse{
-		ID:            output.ID.String(),
-		UserID:        output.UserID.String(),
-		CategoryID:    output.CategoryID.String(),
-		LimitAmount:   output.LimitAmount,
-		CurrentAmount: output.CurrentAmount,
-		AlertOnExceed: output.AlertOnExceed,
-		Period:        string(output.Period),
-		CreatedAt:     output.CreatedAt,
-		UpdatedAt:     output.UpdatedAt,
+		ID:                      output.ID.String(),
+		UserID:                  output.UserID.String(),
+		Kind:                    string(output.Kind),
+		LimitAmount:             output.LimitAmount,
+		CurrentAmount:           output.CurrentAmount,
+		ProgressPercent:         output.ProgressPercent,
+		AlertOnExceed:           output.AlertOnExceed,
+		Period:                  string(output.Period),
+		Name:                    output.Name,
+		TargetAmount:            output.TargetAmount,
+		TargetDate:              formatOptionalDate(output.TargetDate),
+		ProjectedCompletionDate: formatOptionalDate(output.ProjectedCompletionDate),
+		CreatedAt:               output.CreatedAt,
+		UpdatedAt:               output.UpdatedAt,
+	}
+
+	if output.CategoryID != uuid.Nil {
+		response.CategoryID = output.CategoryID.String()
 	}
 
 	if output.StartDate != nil {
@@ -113,3 +175,45 @@ func ToGoalListResponse(outputs []*goal.GoalOutput) GoalListResponse {
 		Goals: goals,
 	}
 }
+
+// ToGoalContributionResponse converts a domain GoalContribution entity to a GoalContributionResponse DTO.
+func ToGoalContributionResponse(c *entity.GoalContribution) GoalContributionResponse {
+	response := GoalContributionResponse{
+		ID:        c.ID.String(),
+		GoalID:    c.GoalID.String(),
+		Amount:    c.Amount,
+		Date:      c.Date.Format("2006-01-02"),
+		Notes:     c.Notes,
+		CreatedAt: c.CreatedAt,
+	}
+
+	if c.TransactionID != nil {
+		txID := c.TransactionID.String()
+		response.TransactionID = &txID
+	}
+
+	return response
+}
+
+// ToGoalContributionListResponse converts the contribution listing output to a GoalContributionListResponse.
+func ToGoalContributionListResponse(output *goal.ListGoalContributionsOutput) GoalContributionListResponse {
+	contributions := make([]GoalContributionResponse, len(output.Contributions))
+	for i, c := range output.Contributions {
+		contributions[i] = ToGoalContributionResponse(c)
+	}
+	return GoalContributionListResponse{
+		Contributions:           contributions,
+		SavedAmount:             output.Progress.SavedAmount,
+		ProgressPercent:         output.Progress.ProgressPercent,
+		ProjectedCompletionDate: formatOptionalDate(output.Progress.ProjectedCompletionDate),
+	}
+}
+
+// formatOptionalDate formats an optional date as YYYY-MM-DD.
+func formatOptionalDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	dateStr := date.Format("2006-01-02")
+	return &dateStr
+}
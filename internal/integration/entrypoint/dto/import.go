@@ -0,0 +1,145 @@
+// Package dto defines data transfer objects for API requests and responses.
+package dto
+
+import (
+	"time"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
+)
+
+// StatementInfoResponse represents account metadata read from an uploaded statement file.
+type StatementInfoResponse struct {
+	Format      string  `json:"format"`
+	AccountType string  `json:"account_type,omitempty"`
+	BankID      string  `json:"bank_id,omitempty"`
+	AccountID   string  `json:"account_id,omitempty"`
+	Currency    string  `json:"currency,omitempty"`
+	StartDate   *string `json:"start_date,omitempty"`
+	EndDate     *string `json:"end_date,omitempty"`
+}
+
+// StatementImportResponse represents the response for a statement file import.
+type StatementImportResponse struct {
+	Statement          StatementInfoResponse      `json:"statement"`
+	ImportedCount      int                        `json:"imported_count"`
+	SkippedCount       int                        `json:"skipped_count"`
+	CategorizedCount   int                        `json:"categorized_count"`
+	ImportedAt         time.Time                  `json:"imported_at"`
+	Transactions       []TransactionResponse      `json:"transactions"`
+	SkippedExternalIDs []string                   `json:"skipped_external_ids"`
+	InstallmentIssues  []InstallmentIssueResponse `json:"installment_issues"` // Extra installments of plans already recorded
+}
+
+// ToStatementImportResponse converts an ImportStatementOutput to a StatementImportResponse DTO.
+func ToStatementImportResponse(info StatementInfoResponse, output *transaction.ImportStatementOutput) StatementImportResponse {
+	transactions := make([]TransactionResponse, len(output.Transactions))
+	for i, txn := range output.Transactions {
+		transactions[i] = ToTransactionResponse(txn)
+	}
+
+	return StatementImportResponse{
+		Statement:          info,
+		ImportedCount:      output.ImportedCount,
+		SkippedCount:       output.SkippedCount,
+		CategorizedCount:   output.CategorizedCount,
+		ImportedAt:         output.ImportedAt,
+		Transactions:       transactions,
+		SkippedExternalIDs: output.SkippedExternalIDs,
+		InstallmentIssues:  ToInstallmentIssueResponses(output.InstallmentIssues),
+	}
+}
+
+// StatementLineErrorResponse represents a statement line that could not be parsed.
+type StatementLineErrorResponse struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+// CSVPreviewLineResponse represents a single parsed CSV line in the preview response.
+type CSVPreviewLineResponse struct {
+	Row                int                          `json:"row"`
+	ExternalID         string                       `json:"external_id"`
+	Date               string                       `json:"date"`
+	Description        string                       `json:"description"`
+	Amount             string                       `json:"amount"`
+	Type               string                       `json:"type"`
+	InstallmentCurrent *int                         `json:"installment_current,omitempty"`
+	InstallmentTotal   *int                         `json:"installment_total,omitempty"`
+	AlreadyImported    bool                         `json:"already_imported"`
+	SuggestedCategory  *TransactionCategoryResponse `json:"suggested_category,omitempty"`
+	PossibleDuplicates []DuplicateMatchResponse     `json:"possible_duplicates,omitempty"`
+}
+
+// CSVPreviewResponse represents the response for a CSV statement import preview.
+type CSVPreviewResponse struct {
+	Mapping              ImportProfileResponse        `json:"mapping"`
+	Lines                []CSVPreviewLineResponse     `json:"lines"`
+	Errors               []StatementLineErrorResponse `json:"errors"`
+	NewCount             int                          `json:"new_count"`
+	AlreadyImportedCount int                          `json:"already_imported_count"`
+}
+
+// CSVImportResponse represents the response for a CSV statement import.
+type CSVImportResponse struct {
+	StatementImportResponse
+	Errors []StatementLineErrorResponse `json:"errors"`
+}
+
+// ToCSVPreviewResponse converts a PreviewCSVImportOutput to a CSVPreviewResponse DTO.
+func ToCSVPreviewResponse(output *transaction.PreviewCSVImportOutput) CSVPreviewResponse {
+	lines := make([]CSVPreviewLineResponse, len(output.Lines))
+	for i, line := range output.Lines {
+		lines[i] = CSVPreviewLineResponse{
+			Row:                line.Row,
+			ExternalID:         line.ExternalID,
+			Date:               line.Date.Format("2006-01-02"),
+			Description:        line.Description,
+			Amount:             line.Amount.String(),
+			Type:               string(line.Type),
+			InstallmentCurrent: line.InstallmentCurrent,
+			InstallmentTotal:   line.InstallmentTotal,
+			AlreadyImported:    line.AlreadyImported,
+		}
+		if len(line.PossibleDuplicates) > 0 {
+			lines[i].PossibleDuplicates = ToDuplicateMatchResponses(line.PossibleDuplicates)
+		}
+		if line.SuggestedCategory != nil {
+			lines[i].SuggestedCategory = &TransactionCategoryResponse{
+				ID:    line.SuggestedCategory.ID.String(),
+				Name:  line.SuggestedCategory.Name,
+				Color: line.SuggestedCategory.Color,
+				Icon:  line.SuggestedCategory.Icon,
+				Type:  string(line.SuggestedCategory.Type),
+			}
+		}
+	}
+
+	return CSVPreviewResponse{
+		Mapping:              ToImportProfileResponse(output.Profile),
+		Lines:                lines,
+		Errors:               toStatementLineErrorResponses(output.Errors),
+		NewCount:             output.NewCount,
+		AlreadyImportedCount: output.AlreadyImportedCount,
+	}
+}
+
+// ToCSVImportResponse converts an ImportCSVOutput to a CSVImportResponse DTO.
+func ToCSVImportResponse(output *transaction.ImportCSVOutput) CSVImportResponse {
+	return CSVImportResponse{
+		StatementImportResponse: ToStatementImportResponse(StatementInfoResponse{Format: "csv"}, output.Result),
+		Errors:                  toStatementLineErrorResponses(output.Errors),
+	}
+}
+
+// toStatementLineErrorResponses converts parser line errors to response DTOs.
+func toStatementLineErrorResponses(lineErrors []adapter.StatementLineError) []StatementLineErrorResponse {
+	response := make([]StatementLineErrorResponse, len(lineErrors))
+	for i, lineErr := range lineErrors {
+		response[i] = StatementLineErrorResponse{
+			Row:     lineErr.Row,
+			Message: lineErr.Message,
+		}
+	}
+	return response
+}
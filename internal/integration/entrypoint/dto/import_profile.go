@@ -0,0 +1,106 @@
+// Package dto defines data transfer objects for API requests and responses.
+package dto
+
+import (
+	"time"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ColumnMappingRequest describes how to read a CSV statement.
+// Column references are header names, or zero-based indexes when has_header is false.
+type ColumnMappingRequest struct {
+	Delimiter         string `json:"delimiter,omitempty"`
+	HasHeader         *bool  `json:"has_header,omitempty"`
+	SkipRows          int    `json:"skip_rows,omitempty"`
+	DateColumn        string `json:"date_column"`
+	DescriptionColumn string `json:"description_column"`
+	AmountColumn      string `json:"amount_column,omitempty"`
+	DebitColumn       string `json:"debit_column,omitempty"`
+	CreditColumn      string `json:"credit_column,omitempty"`
+	InstallmentColumn string `json:"installment_column,omitempty"`
+	DateFormat        string `json:"date_format,omitempty"`
+	NumberFormat      string `json:"number_format,omitempty"`
+	InvertAmounts     bool   `json:"invert_amounts,omitempty"`
+}
+
+// CreateImportProfileRequest represents the request body for import profile creation.
+type CreateImportProfileRequest struct {
+	Name string `json:"name" binding:"required,min=1,max=100"`
+	ColumnMappingRequest
+}
+
+// UpdateImportProfileRequest represents the request body for import profile update.
+type UpdateImportProfileRequest struct {
+	Name              *string `json:"name,omitempty" binding:"omitempty,min=1,max=100"`
+	Delimiter         *string `json:"delimiter,omitempty"`
+	HasHeader         *bool   `json:"has_header,omitempty"`
+	SkipRows          *int    `json:"skip_rows,omitempty"`
+	DateColumn        *string `json:"date_column,omitempty"`
+	DescriptionColumn *string `json:"description_column,omitempty"`
+	AmountColumn      *string `json:"amount_column,omitempty"`
+	DebitColumn       *string `json:"debit_column,omitempty"`
+	CreditColumn      *string `json:"credit_column,omitempty"`
+	InstallmentColumn *string `json:"installment_column,omitempty"`
+	DateFormat        *string `json:"date_format,omitempty"`
+	NumberFormat      *string `json:"number_format,omitempty"`
+	InvertAmounts     *bool   `json:"invert_amounts,omitempty"`
+}
+
+// ImportProfileResponse represents a single import profile in API responses.
+type ImportProfileResponse struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	Delimiter         string    `json:"delimiter"`
+	HasHeader         bool      `json:"has_header"`
+	SkipRows          int       `json:"skip_rows"`
+	DateColumn        string    `json:"date_column"`
+	DescriptionColumn string    `json:"description_column"`
+	AmountColumn      string    `json:"amount_column,omitempty"`
+	DebitColumn       string    `json:"debit_column,omitempty"`
+	CreditColumn      string    `json:"credit_column,omitempty"`
+	InstallmentColumn string    `json:"installment_column,omitempty"`
+	DateFormat        string    `json:"date_format,omitempty"`
+	NumberFormat      string    `json:"number_format,omitempty"`
+	InvertAmounts     bool      `json:"invert_amounts"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// ImportProfileListResponse represents the response for listing import profiles.
+type ImportProfileListResponse struct {
+	Profiles []ImportProfileResponse `json:"profiles"`
+}
+
+// ToImportProfileResponse converts a domain ImportProfile to an ImportProfileResponse DTO.
+func ToImportProfileResponse(profile *entity.ImportProfile) ImportProfileResponse {
+	return ImportProfileResponse{
+		ID:                profile.ID.String(),
+		Name:              profile.Name,
+		Delimiter:         profile.Delimiter,
+		HasHeader:         profile.HasHeader,
+		SkipRows:          profile.SkipRows,
+		DateColumn:        profile.DateColumn,
+		DescriptionColumn: profile.DescriptionColumn,
+		AmountColumn:      profile.AmountColumn,
+		DebitColumn:       profile.DebitColumn,
+		CreditColumn:      profile.CreditColumn,
+		InstallmentColumn: profile.InstallmentColumn,
+		DateFormat:        string(profile.DateFormat),
+		NumberFormat:      string(profile.NumberFormat),
+		InvertAmounts:     profile.InvertAmounts,
+		CreatedAt:         profile.CreatedAt,
+		UpdatedAt:         profile.UpdatedAt,
+	}
+}
+
+// ToImportProfileListResponse converts a list of import profiles to an ImportProfileListResponse DTO.
+func ToImportProfileListResponse(profiles []*entity.ImportProfile) ImportProfileListResponse {
+	response := ImportProfileListResponse{
+		Profiles: make([]ImportProfileResponse, len(profiles)),
+	}
+	for i, profile := range profiles {
+		response.Profiles[i] = ToImportProfileResponse(profile)
+	}
+	return response
+}
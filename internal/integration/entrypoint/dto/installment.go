@@ -0,0 +1,200 @@
+// Package dto defines data transfer objects for API requests and responses.
+package dto
+
+import (
+	"time"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/installment"
+)
+
+// InstallmentPlanResponse represents a single installment plan in API responses.
+type InstallmentPlanResponse struct {
+	ID                    string    `json:"id"`
+	AccountID             *string   `json:"account_id,omitempty"`
+	Description           string    `json:"description"`
+	TotalInstallments     int       `json:"total_installments"`
+	InstallmentAmount     string    `json:"installment_amount"` // In the user's base currency
+	TotalAmount           string    `json:"total_amount"`
+	FirstBillingCycle     string    `json:"first_billing_cycle"`
+	LastBillingCycle      string    `json:"last_billing_cycle"`
+	MerchantID            *string   `json:"merchant_id,omitempty"`
+	RecordedInstallments  []int     `json:"recorded_installments"`
+	RemainingInstallments int       `json:"remaining_installments"`
+	RemainingAmount       string    `json:"remaining_amount"`
+	NextBillingCycle      *string   `json:"next_billing_cycle,omitempty"` // Omitted when all installments were charged
+	CreatedAt             time.Time `json:"created_at"`
+}
+
+// InstallmentPlanListResponse represents the response for listing installment plans.
+type InstallmentPlanListResponse struct {
+	Plans []InstallmentPlanResponse `json:"plans"`
+}
+
+// InstallmentResponse represents a recorded installment of a plan.
+type InstallmentResponse struct {
+	TransactionID     string `json:"transaction_id"`
+	InstallmentNumber int    `json:"installment_number"`
+	BillingCycle      string `json:"billing_cycle,omitempty"`
+	Date              string `json:"date"`
+	Description       string `json:"description"`
+	Amount            string `json:"amount"`
+}
+
+// InstallmentPlanDetailResponse represents an installment plan with its recorded installments.
+type InstallmentPlanDetailResponse struct {
+	InstallmentPlanResponse
+	Installments []InstallmentResponse `json:"installments"`
+}
+
+// InstallmentIssueResponse represents a missing or extra installment detected on import.
+type InstallmentIssueResponse struct {
+	Type              string  `json:"type"` // "missing" or "extra"
+	PlanID            string  `json:"plan_id"`
+	Description       string  `json:"description"`
+	InstallmentNumber int     `json:"installment_number"`
+	TotalInstallments int     `json:"total_installments"`
+	BillingCycle      string  `json:"billing_cycle"`
+	TransactionID     *string `json:"transaction_id,omitempty"` // Imported transaction of an extra installment
+}
+
+// CommitmentsResponse represents the outstanding installments of future bills.
+type CommitmentsResponse struct {
+	Currency       string                    `json:"currency"`
+	TotalCommitted string                    `json:"total_committed"`
+	Months         []CommitmentMonthResponse `json:"months"`
+}
+
+// CommitmentMonthResponse represents the part of a billing cycle's bill locked in by installments.
+type CommitmentMonthResponse struct {
+	BillingCycle     string                         `json:"billing_cycle"`
+	Amount           string                         `json:"amount"`
+	InstallmentCount int                            `json:"installment_count"`
+	Installments     []CommittedInstallmentResponse `json:"installments"`
+}
+
+// CommittedInstallmentResponse represents an outstanding installment charged in a billing cycle.
+type CommittedInstallmentResponse struct {
+	PlanID            string `json:"plan_id"`
+	Description       string `json:"description"`
+	InstallmentNumber int    `json:"installment_number"`
+	TotalInstallments int    `json:"total_installments"`
+	Amount            string `json:"amount"`
+}
+
+// ToInstallmentPlanResponse converts a PlanOutput to an InstallmentPlanResponse DTO.
+func ToInstallmentPlanResponse(output *installment.PlanOutput) InstallmentPlanResponse {
+	plan := output.Plan
+	response := InstallmentPlanResponse{
+		ID:                    plan.ID.String(),
+		Description:           plan.Description,
+		TotalInstallments:     plan.TotalInstallments,
+		InstallmentAmount:     plan.InstallmentAmount.String(),
+		TotalAmount:           plan.TotalAmount().String(),
+		FirstBillingCycle:     plan.FirstBillingCycle,
+		LastBillingCycle:      plan.LastBillingCycle(),
+		RecordedInstallments:  output.RecordedInstallments,
+		RemainingInstallments: output.RemainingInstallments,
+		RemainingAmount:       output.RemainingAmount.String(),
+		CreatedAt:             plan.CreatedAt,
+	}
+
+	if plan.AccountID != nil {
+		accountIDStr := plan.AccountID.String()
+		response.AccountID = &accountIDStr
+	}
+
+	if plan.MerchantID != nil {
+		merchantIDStr := plan.MerchantID.String()
+		response.MerchantID = &merchantIDStr
+	}
+
+	if output.NextBillingCycle != "" {
+		nextBillingCycle := output.NextBillingCycle
+		response.NextBillingCycle = &nextBillingCycle
+	}
+
+	return response
+}
+
+// ToInstallmentPlanListResponse converts a ListPlansOutput to an InstallmentPlanListResponse DTO.
+func ToInstallmentPlanListResponse(output *installment.ListPlansOutput) InstallmentPlanListResponse {
+	plans := make([]InstallmentPlanResponse, len(output.Plans))
+	for i, plan := range output.Plans {
+		plans[i] = ToInstallmentPlanResponse(plan)
+	}
+	return InstallmentPlanListResponse{
+		Plans: plans,
+	}
+}
+
+// ToInstallmentPlanDetailResponse converts a GetPlanOutput to an InstallmentPlanDetailResponse DTO.
+func ToInstallmentPlanDetailResponse(output *installment.GetPlanOutput) InstallmentPlanDetailResponse {
+	installments := make([]InstallmentResponse, 0, len(output.Installments))
+	for _, txn := range output.Installments {
+		response := InstallmentResponse{
+			TransactionID: txn.ID.String(),
+			BillingCycle:  txn.BillingCycle,
+			Date:          txn.Date.Format("2006-01-02"),
+			Description:   txn.Description,
+			Amount:        txn.Amount.String(),
+		}
+		if txn.InstallmentCurrent != nil {
+			response.InstallmentNumber = *txn.InstallmentCurrent
+		}
+		installments = append(installments, response)
+	}
+
+	return InstallmentPlanDetailResponse{
+		InstallmentPlanResponse: ToInstallmentPlanResponse(output.Plan),
+		Installments:            installments,
+	}
+}
+
+// ToInstallmentIssueResponses converts installment issues to InstallmentIssueResponse DTOs.
+func ToInstallmentIssueResponses(issues []installment.Issue) []InstallmentIssueResponse {
+	responses := make([]InstallmentIssueResponse, len(issues))
+	for i, issue := range issues {
+		responses[i] = InstallmentIssueResponse{
+			Type:              string(issue.Type),
+			PlanID:            issue.PlanID.String(),
+			Description:       issue.Description,
+			InstallmentNumber: issue.InstallmentNumber,
+			TotalInstallments: issue.TotalInstallments,
+			BillingCycle:      issue.BillingCycle,
+		}
+		if issue.TransactionID != nil {
+			transactionIDStr := issue.TransactionID.String()
+			responses[i].TransactionID = &transactionIDStr
+		}
+	}
+	return responses
+}
+
+// ToCommitmentsResponse converts a GetCommitmentsOutput to a CommitmentsResponse DTO.
+func ToCommitmentsResponse(output *installment.GetCommitmentsOutput) CommitmentsResponse {
+	months := make([]CommitmentMonthResponse, len(output.Months))
+	for i, month := range output.Months {
+		installments := make([]CommittedInstallmentResponse, len(month.Installments))
+		for j, committed := range month.Installments {
+			installments[j] = CommittedInstallmentResponse{
+				PlanID:            committed.PlanID.String(),
+				Description:       committed.Description,
+				InstallmentNumber: committed.InstallmentNumber,
+				TotalInstallments: committed.TotalInstallments,
+				Amount:            committed.Amount.String(),
+			}
+		}
+		months[i] = CommitmentMonthResponse{
+			BillingCycle:     month.BillingCycle,
+			Amount:           month.Amount.String(),
+			InstallmentCount: len(month.Installments),
+			Installments:     installments,
+		}
+	}
+
+	return CommitmentsResponse{
+		Currency:       output.Currency,
+		TotalCommitted: output.TotalCommitted.String(),
+		Months:         months,
+	}
+}
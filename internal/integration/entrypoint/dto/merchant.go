@@ -0,0 +1,89 @@
+// Package dto defines data transfer objects for API requests and responses.
+package dto
+
+import (
+	"time"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/merchant"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// UpdateMerchantRequest represents the request body for merchant update.
+type UpdateMerchantRequest struct {
+	Name                 *string   `json:"name,omitempty" binding:"omitempty,min=1,max=100"`
+	Aliases              *[]string `json:"aliases,omitempty"` // Replaces all aliases
+	DefaultCategoryID    *string   `json:"default_category_id,omitempty"`
+	ClearDefaultCategory bool      `json:"clear_default_category,omitempty"`
+}
+
+// MergeMerchantsRequest represents the request body for merging merchants into another.
+type MergeMerchantsRequest struct {
+	MerchantIDs []string `json:"merchant_ids" binding:"required,min=1"` // Merchants merged into the one in the URL
+}
+
+// MerchantResponse represents a single merchant in API responses.
+type MerchantResponse struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	Key               string    `json:"key"`
+	Aliases           []string  `json:"aliases"`
+	DefaultCategoryID *string   `json:"default_category_id,omitempty"`
+	TransactionCount  *int64    `json:"transaction_count,omitempty"` // Only set when listing
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// MerchantListResponse represents the response for listing merchants.
+type MerchantListResponse struct {
+	Merchants []MerchantResponse `json:"merchants"`
+}
+
+// MergeMerchantsResponse represents the response for merging merchants.
+type MergeMerchantsResponse struct {
+	Merchant   MerchantResponse `json:"merchant"`
+	MovedCount int64            `json:"moved_count"` // Number of transactions moved to the merchant
+}
+
+// ToMerchantResponse converts a Merchant entity to a MerchantResponse DTO.
+func ToMerchantResponse(m *entity.Merchant) MerchantResponse {
+	response := MerchantResponse{
+		ID:        m.ID.String(),
+		Name:      m.Name,
+		Key:       m.Key,
+		Aliases:   m.Aliases,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+
+	if response.Aliases == nil {
+		response.Aliases = []string{}
+	}
+
+	if m.DefaultCategoryID != nil {
+		categoryIDStr := m.DefaultCategoryID.String()
+		response.DefaultCategoryID = &categoryIDStr
+	}
+
+	return response
+}
+
+// ToMerchantListResponse converts a ListMerchantsOutput to a MerchantListResponse DTO.
+func ToMerchantListResponse(output *merchant.ListMerchantsOutput) MerchantListResponse {
+	responses := make([]MerchantResponse, len(output.Merchants))
+	for i, m := range output.Merchants {
+		responses[i] = ToMerchantResponse(m.Merchant)
+		transactionCount := m.TransactionCount
+		responses[i].TransactionCount = &transactionCount
+	}
+	return MerchantListResponse{
+		Merchants: responses,
+	}
+}
+
+// ToMergeMerchantsResponse converts a MergeMerchantsOutput to a MergeMerchantsResponse DTO.
+func ToMergeMerchantsResponse(output *merchant.MergeMerchantsOutput) MergeMerchantsResponse {
+	return MergeMerchantsResponse{
+		Merchant:   ToMerchantResponse(output.Merchant),
+		MovedCount: output.MovedCount,
+	}
+}
@@ -0,0 +1,140 @@
+// Package dto defines data transfer objects for API requests and responses.
+package dto
+
+import (
+	"time"
+
+	recurringschedule "github.com/finance-tracker/backend/internal/application/usecase/recurring_schedule"
+)
+
+// CreateRecurringScheduleRequest represents the request body for recurring schedule creation.
+type CreateRecurringScheduleRequest struct {
+	Description    string  `json:"description" binding:"required,min=1,max=255"`
+	Amount         float64 `json:"amount" binding:"required"`
+	Type           string  `json:"type" binding:"required,oneof=expense income"`
+	CategoryID     *string `json:"category_id,omitempty"`
+	Notes          string  `json:"notes,omitempty" binding:"omitempty,max=1000"`
+	Frequency      string  `json:"frequency" binding:"required,oneof=weekly monthly yearly"`
+	Interval       *int    `json:"interval,omitempty"`
+	DayOfMonth     *int    `json:"day_of_month,omitempty"`
+	DayOfWeek      *int    `json:"day_of_week,omitempty"` // 0 (Sunday) to 6 (Saturday)
+	StartDate      string  `json:"start_date" binding:"required"`
+	EndDate        *string `json:"end_date,omitempty"`
+	MaxOccurrences *int    `json:"max_occurrences,omitempty"`
+}
+
+// UpdateRecurringScheduleRequest represents the request body for recurring schedule update.
+type UpdateRecurringScheduleRequest struct {
+	Description         *string  `json:"description,omitempty" binding:"omitempty,min=1,max=255"`
+	Amount              *float64 `json:"amount,omitempty"`
+	Type                *string  `json:"type,omitempty" binding:"omitempty,oneof=expense income"`
+	CategoryID          *string  `json:"category_id,omitempty"`
+	ClearCategory       bool     `json:"clear_category,omitempty"`
+	Notes               *string  `json:"notes,omitempty" binding:"omitempty,max=1000"`
+	Frequency           *string  `json:"frequency,omitempty" binding:"omitempty,oneof=weekly monthly yearly"`
+	Interval            *int     `json:"interval,omitempty"`
+	DayOfMonth          *int     `json:"day_of_month,omitempty"`
+	DayOfWeek           *int     `json:"day_of_week,omitempty"`
+	StartDate           *string  `json:"start_date,omitempty"`
+	EndDate             *string  `json:"end_date,omitempty"`
+	ClearEndDate        bool     `json:"clear_end_date,omitempty"`
+	MaxOccurrences      *int     `json:"max_occurrences,omitempty"`
+	ClearMaxOccurrences bool     `json:"clear_max_occurrences,omitempty"`
+	IsActive            *bool    `json:"is_active,omitempty"`
+}
+
+// RecurringScheduleResponse represents a single recurring schedule in API responses.
+type RecurringScheduleResponse struct {
+	ID                  string            `json:"id"`
+	UserID              string            `json:"user_id"`
+	Description         string            `json:"description"`
+	Amount              string            `json:"amount"`
+	Type                string            `json:"type"`
+	CategoryID          *string           `json:"category_id,omitempty"`
+	Category            *CategoryResponse `json:"category,omitempty"`
+	Notes               string            `json:"notes"`
+	Frequency           string            `json:"frequency"`
+	Interval            int               `json:"interval"`
+	DayOfMonth          *int              `json:"day_of_month,omitempty"`
+	DayOfWeek           *int              `json:"day_of_week,omitempty"`
+	StartDate           string            `json:"start_date"`
+	EndDate             *string           `json:"end_date,omitempty"`
+	MaxOccurrences      *int              `json:"max_occurrences,omitempty"`
+	OccurrenceCount     int               `json:"occurrence_count"`
+	LastOccurrenceDate  *string           `json:"last_occurrence_date,omitempty"`
+	NextOccurrence      *string           `json:"next_occurrence,omitempty"` // Omitted once the schedule has ended
+	UpcomingOccurrences []string          `json:"upcoming_occurrences"`
+	IsActive            bool              `json:"is_active"`
+	CreatedAt           time.Time         `json:"created_at"`
+	UpdatedAt           time.Time         `json:"updated_at"`
+}
+
+// RecurringScheduleListResponse represents the response for listing recurring schedules.
+type RecurringScheduleListResponse struct {
+	Schedules []RecurringScheduleResponse `json:"schedules"`
+}
+
+// ToRecurringScheduleResponse converts a RecurringScheduleOutput to a RecurringScheduleResponse DTO.
+func ToRecurringScheduleResponse(output *recurringschedule.RecurringScheduleOutput) RecurringScheduleResponse {
+	response := RecurringScheduleResponse{
+		ID:                  output.ID.String(),
+		UserID:              output.UserID.String(),
+		Description:         output.Description,
+		Amount:              output.Amount.String(),
+		Type:                string(output.Type),
+		Notes:               output.Notes,
+		Frequency:           string(output.Frequency),
+		Interval:            output.Interval,
+		DayOfMonth:          output.DayOfMonth,
+		DayOfWeek:           output.DayOfWeek,
+		StartDate:           output.StartDate.Format("2006-01-02"),
+		MaxOccurrences:      output.MaxOccurrences,
+		OccurrenceCount:     output.OccurrenceCount,
+		UpcomingOccurrences: make([]string, len(output.UpcomingOccurrences)),
+		IsActive:            output.IsActive,
+		CreatedAt:           output.CreatedAt,
+		UpdatedAt:           output.UpdatedAt,
+	}
+
+	if output.CategoryID != nil {
+		categoryIDStr := output.CategoryID.String()
+		response.CategoryID = &categoryIDStr
+	}
+
+	if output.Category != nil {
+		catResponse := ToCategoryResponse(output.Category)
+		response.Category = &catResponse
+	}
+
+	if output.EndDate != nil {
+		dateStr := output.EndDate.Format("2006-01-02")
+		response.EndDate = &dateStr
+	}
+
+	if output.LastOccurrenceDate != nil {
+		dateStr := output.LastOccurrenceDate.Format("2006-01-02")
+		response.LastOccurrenceDate = &dateStr
+	}
+
+	if output.NextOccurrence != nil {
+		dateStr := output.NextOccurrence.Format("2006-01-02")
+		response.NextOccurrence = &dateStr
+	}
+
+	for i, date := range output.UpcomingOccurrences {
+		response.UpcomingOccurrences[i] = date.Format("2006-01-02")
+	}
+
+	return response
+}
+
+// ToRecurringScheduleListResponse converts a list of RecurringScheduleOutput to a RecurringScheduleListResponse.
+func ToRecurringScheduleListResponse(outputs []*recurringschedule.RecurringScheduleOutput) RecurringScheduleListResponse {
+	schedules := make([]RecurringScheduleResponse, len(outputs))
+	for i, output := range outputs {
+		schedules[i] = ToRecurringScheduleResponse(output)
+	}
+	return RecurringScheduleListResponse{
+		Schedules: schedules,
+	}
+}
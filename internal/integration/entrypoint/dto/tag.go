@@ -0,0 +1,56 @@
+// Package dto defines data transfer objects for API requests and responses.
+package dto
+
+import (
+	"time"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// CreateTagRequest represents the request body for tag creation.
+type CreateTagRequest struct {
+	Name  string `json:"name" binding:"required,min=1,max=50"`
+	Color string `json:"color,omitempty"` // Hex color, defaults to #64748B
+}
+
+// UpdateTagRequest represents the request body for tag update.
+type UpdateTagRequest struct {
+	Name  *string `json:"name,omitempty" binding:"omitempty,min=1,max=50"`
+	Color *string `json:"color,omitempty"`
+}
+
+// TagResponse represents a single tag in API responses.
+type TagResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TagListResponse represents the response for listing tags.
+type TagListResponse struct {
+	Tags []TagResponse `json:"tags"`
+}
+
+// ToTagResponse converts a Tag entity to a TagResponse DTO.
+func ToTagResponse(tag *entity.Tag) TagResponse {
+	return TagResponse{
+		ID:        tag.ID.String(),
+		Name:      tag.Name,
+		Color:     tag.Color,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
+
+// ToTagListResponse converts a list of Tag entities to a TagListResponse DTO.
+func ToTagListResponse(tags []*entity.Tag) TagListResponse {
+	responses := make([]TagResponse, len(tags))
+	for i, tag := range tags {
+		responses[i] = ToTagResponse(tag)
+	}
+	return TagListResponse{
+		Tags: responses,
+	}
+}
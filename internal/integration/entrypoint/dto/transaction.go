@@ -9,15 +9,18 @@ import (
 
 // CreateTransactionRequest represents the request body for transaction creation.
 type CreateTransactionRequest struct {
-	Date                string  `json:"date" binding:"required"`
-	Description         string  `json:"description" binding:"required,min=1,max=255"`
-	Amount              float64 `json:"amount" binding:"required"`
-	Type                string  `json:"type" binding:"required,oneof=expense income"`
-	CategoryID          *string `json:"category_id,omitempty"`
-	Notes               string  `json:"notes,omitempty" binding:"omitempty,max=1000"`
-	IsRecurring         bool    `json:"is_recurring,omitempty"`
-	BillingCycle        string  `json:"billing_cycle,omitempty"`         // Format: "YYYY-MM" (e.g., "2024-11")
-	IsCreditCardPayment bool    `json:"is_credit_card_payment,omitempty"`
+	Date                string   `json:"date" binding:"required"`
+	Description         string   `json:"description" binding:"required,min=1,max=255"`
+	Amount              float64  `json:"amount" binding:"required"`
+	Type                string   `json:"type" binding:"required,oneof=expense income"`
+	CategoryID          *string  `json:"category_id,omitempty"`
+	AccountID           *string  `json:"account_id,omitempty"`
+	Notes               string   `json:"notes,omitempty" binding:"omitempty,max=1000"`
+	IsRecurring         bool     `json:"is_recurring,omitempty"`
+	BillingCycle        string   `json:"billing_cycle,omitempty"` // Format: "YYYY-MM" (e.g., "2024-11")
+	IsCreditCardPayment bool     `json:"is_credit_card_payment,omitempty"`
+	Currency            string   `json:"currency,omitempty"`      // ISO 4217 code, defaults to the account's or user's base currency
+	ExchangeRate        *float64 `json:"exchange_rate,omitempty"` // Rate into the base currency, defaults to the user's rate table
 }
 
 // UpdateTransactionRequest represents the request body for transaction update.
@@ -28,8 +31,24 @@ type UpdateTransactionRequest struct {
 	Type          *string  `json:"type,omitempty" binding:"omitempty,oneof=expense income"`
 	CategoryID    *string  `json:"category_id,omitempty"`
 	ClearCategory bool     `json:"clear_category,omitempty"`
+	AccountID     *string  `json:"account_id,omitempty"`
+	ClearAccount  bool     `json:"clear_account,omitempty"`
 	Notes         *string  `json:"notes,omitempty" binding:"omitempty,max=1000"`
 	IsRecurring   *bool    `json:"is_recurring,omitempty"`
+	Currency      *string  `json:"currency,omitempty"`
+	ExchangeRate  *float64 `json:"exchange_rate,omitempty"`
+}
+
+// SplitTransactionRequest represents the request body for splitting a transaction across categories.
+type SplitTransactionRequest struct {
+	Splits []SplitLineRequest `json:"splits" binding:"required,min=2,dive"`
+}
+
+// SplitLineRequest represents a single split line in a split request.
+type SplitLineRequest struct {
+	Amount     float64 `json:"amount" binding:"required"`
+	CategoryID *string `json:"category_id,omitempty"`
+	Notes      string  `json:"notes,omitempty" binding:"omitempty,max=1000"`
 }
 
 // BulkDeleteTransactionsRequest represents the request body for bulk transaction deletion.
@@ -39,8 +58,63 @@ type BulkDeleteTransactionsRequest struct {
 
 // BulkCategorizeTransactionsRequest represents the request body for bulk transaction categorization.
 type BulkCategorizeTransactionsRequest struct {
-	IDs        []string `json:"ids" binding:"required,min=1"`
-	CategoryID string   `json:"category_id" binding:"required"`
+	IDs          []string `json:"ids" binding:"required,min=1"`
+	CategoryID   string   `json:"category_id" binding:"required"`
+	IncludeSplit bool     `json:"include_split,omitempty"` // Also categorize split transactions, removing their split lines
+}
+
+// BulkTagTransactionsRequest represents the request body for bulk transaction tagging.
+type BulkTagTransactionsRequest struct {
+	IDs    []string `json:"ids" binding:"required,min=1"`
+	TagIDs []string `json:"tag_ids" binding:"required,min=1"`
+	Remove bool     `json:"remove,omitempty"` // Detach the tags instead of attaching them
+}
+
+// BulkUpdateTransactionsRequest represents the request body for bulk transaction updates.
+// Transactions are selected either by ids or by filter.
+type BulkUpdateTransactionsRequest struct {
+	IDs     []string                      `json:"ids,omitempty"`
+	Filter  *TransactionFilterRequest     `json:"filter,omitempty"`
+	Changes BulkTransactionChangesRequest `json:"changes"`
+	DryRun  bool                          `json:"dry_run,omitempty"` // Only report what would be updated
+}
+
+// TransactionFilterRequest selects transactions with the same filters as the transaction listing.
+type TransactionFilterRequest struct {
+	StartDate     *string  `json:"start_date,omitempty"` // Format: "YYYY-MM-DD"
+	EndDate       *string  `json:"end_date,omitempty"`   // Format: "YYYY-MM-DD"
+	CategoryIDs   []string `json:"category_ids,omitempty"`
+	AccountIDs    []string `json:"account_ids,omitempty"`
+	TagIDs        []string `json:"tag_ids,omitempty"`
+	Type          *string  `json:"type,omitempty"`
+	Search        string   `json:"search,omitempty"`
+	MinAmount     *float64 `json:"min_amount,omitempty"`
+	MaxAmount     *float64 `json:"max_amount,omitempty"`
+	Uncategorized bool     `json:"uncategorized,omitempty"`
+}
+
+// BulkTransactionChangesRequest represents the changes applied by a bulk transaction update.
+type BulkTransactionChangesRequest struct {
+	DateShiftDays int     `json:"date_shift_days,omitempty"` // Negative values move dates back
+	Notes         *string `json:"notes,omitempty" binding:"omitempty,max=1000"`
+	NotesMode     string  `json:"notes_mode,omitempty"` // "replace" (default) or "append"
+	Type          *string `json:"type,omitempty" binding:"omitempty,oneof=expense income"`
+	IsRecurring   *bool   `json:"is_recurring,omitempty"`
+	IsHidden      *bool   `json:"is_hidden,omitempty"`
+	CategoryID    *string `json:"category_id,omitempty"`
+	ClearCategory bool    `json:"clear_category,omitempty"`
+}
+
+// MergeDuplicateTransactionsRequest represents the request body for merging duplicate transactions.
+type MergeDuplicateTransactionsRequest struct {
+	KeepID      string `json:"keep_id" binding:"required"`
+	DuplicateID string `json:"duplicate_id" binding:"required"`
+}
+
+// DismissDuplicateTransactionsRequest represents the request body for dismissing a duplicate pair.
+type DismissDuplicateTransactionsRequest struct {
+	TransactionID string `json:"transaction_id" binding:"required"`
+	DuplicateID   string `json:"duplicate_id" binding:"required"`
 }
 
 // TransactionCategoryResponse represents category information in transaction response.
@@ -52,6 +126,21 @@ type TransactionCategoryResponse struct {
 	Type  string `json:"type"`
 }
 
+// TransactionSplitResponse represents a split line in transaction responses.
+type TransactionSplitResponse struct {
+	ID         string  `json:"id"`
+	CategoryID *string `json:"category_id,omitempty"`
+	Amount     string  `json:"amount"`
+	Notes      string  `json:"notes"`
+}
+
+// TransactionTagResponse represents tag information in transaction responses.
+type TransactionTagResponse struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
 // TransactionResponse represents a single transaction in API responses.
 type TransactionResponse struct {
 	ID          string                       `json:"id"`
@@ -73,6 +162,55 @@ type TransactionResponse struct {
 	InstallmentCurrent     *int    `json:"installment_current,omitempty"`
 	InstallmentTotal       *int    `json:"installment_total,omitempty"`
 	CreditCardPaymentID    *string `json:"credit_card_payment_id,omitempty"` // ID of linked bill, set when CC transactions are linked
+	// Recurring schedule fields
+	RecurringScheduleID *string `json:"recurring_schedule_id,omitempty"` // ID of the schedule that generated this transaction
+	// Account fields
+	AccountID      *string `json:"account_id,omitempty"`
+	RunningBalance *string `json:"running_balance,omitempty"` // Account balance after this transaction, when listing a single account
+	// Transfer fields
+	TransferID *string `json:"transfer_id,omitempty"` // ID of the transfer this transaction is a leg of
+	// Currency fields
+	Currency     string `json:"currency"`
+	ExchangeRate string `json:"exchange_rate"` // Rate converting the amount into the user's base currency
+	BaseAmount   string `json:"base_amount"`   // Amount in the user's base currency
+	// Split fields
+	IsSplit bool                       `json:"is_split"`
+	Splits  []TransactionSplitResponse `json:"splits,omitempty"` // Lines attributing the amount to categories
+	// Tag fields
+	Tags []TransactionTagResponse `json:"tags,omitempty"`
+	// Merchant fields
+	MerchantID *string `json:"merchant_id,omitempty"` // ID of the merchant the transaction was made with
+	// Installment plan fields
+	InstallmentPlanID *string `json:"installment_plan_id,omitempty"` // ID of the plan grouping the installments of the purchase
+	// Duplicate detection, set on creation only
+	PossibleDuplicates []DuplicateMatchResponse `json:"possible_duplicates,omitempty"`
+}
+
+// DuplicateMatchResponse represents an existing transaction that is likely a duplicate.
+type DuplicateMatchResponse struct {
+	TransactionID string  `json:"transaction_id"`
+	Date          string  `json:"date"`
+	Description   string  `json:"description"`
+	Amount        string  `json:"amount"`
+	Score         float64 `json:"score"`
+}
+
+// DuplicatePairResponse represents two transactions that are likely the same entry.
+type DuplicatePairResponse struct {
+	Transaction TransactionResponse `json:"transaction"`
+	Duplicate   TransactionResponse `json:"duplicate"`
+	Score       float64             `json:"score"`
+}
+
+// DuplicateListResponse represents the response for listing likely duplicate transactions.
+type DuplicateListResponse struct {
+	Pairs []DuplicatePairResponse `json:"pairs"`
+}
+
+// MergeDuplicateTransactionsResponse represents the response for merging duplicate transactions.
+type MergeDuplicateTransactionsResponse struct {
+	Transaction TransactionResponse `json:"transaction"`
+	DeletedID   string              `json:"deleted_id"`
 }
 
 // TransactionPaginationResponse represents pagination information in API responses.
@@ -81,6 +219,8 @@ type TransactionPaginationResponse struct {
 	Limit      int   `json:"limit"`
 	Total      int64 `json:"total"`
 	TotalPages int   `json:"total_pages"`
+	// NextCursor is passed as the cursor parameter to fetch the next page; omitted on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // TransactionTotalsResponse represents aggregated totals in API responses.
@@ -104,7 +244,23 @@ type BulkDeleteTransactionsResponse struct {
 
 // BulkCategorizeTransactionsResponse represents the response for bulk transaction categorization.
 type BulkCategorizeTransactionsResponse struct {
-	UpdatedCount int64 `json:"updated_count"`
+	UpdatedCount int64  `json:"updated_count"`
+	OperationID  string `json:"operation_id"` // Reverts the whole categorization
+}
+
+// BulkTagTransactionsResponse represents the response for bulk transaction tagging.
+type BulkTagTransactionsResponse struct {
+	UpdatedCount int64 `json:"updated_count"` // Number of transaction-tag links created or removed
+}
+
+// BulkUpdateTransactionsResponse represents the response for bulk transaction updates.
+type BulkUpdateTransactionsResponse struct {
+	MatchedCount int64                 `json:"matched_count"`
+	UpdatedCount int64                 `json:"updated_count"`
+	SkippedCount int64                 `json:"skipped_count"` // Transfer legs, which are updated through their transfer
+	Sample       []TransactionResponse `json:"sample"`
+	DryRun       bool                  `json:"dry_run"`
+	OperationID  *string               `json:"operation_id,omitempty"` // Reverts the whole update
 }
 
 // ToTransactionResponse converts a TransactionOutput to a TransactionResponse DTO.
@@ -125,6 +281,10 @@ func ToTransactionResponse(txn *transaction.TransactionOutput) TransactionRespon
 		LinkedTransactionCount: txn.LinkedTransactionCount,
 		InstallmentCurrent:     txn.InstallmentCurrent,
 		InstallmentTotal:       txn.InstallmentTotal,
+		Currency:               txn.Currency,
+		ExchangeRate:           txn.ExchangeRate.String(),
+		BaseAmount:             txn.BaseAmount.String(),
+		IsSplit:                txn.IsSplit,
 	}
 
 	if txn.CategoryID != nil {
@@ -137,6 +297,36 @@ func ToTransactionResponse(txn *transaction.TransactionOutput) TransactionRespon
 		response.CreditCardPaymentID = &ccPaymentIDStr
 	}
 
+	if txn.RecurringScheduleID != nil {
+		scheduleIDStr := txn.RecurringScheduleID.String()
+		response.RecurringScheduleID = &scheduleIDStr
+	}
+
+	if txn.AccountID != nil {
+		accountIDStr := txn.AccountID.String()
+		response.AccountID = &accountIDStr
+	}
+
+	if txn.RunningBalance != nil {
+		runningBalanceStr := txn.RunningBalance.String()
+		response.RunningBalance = &runningBalanceStr
+	}
+
+	if txn.TransferID != nil {
+		transferIDStr := txn.TransferID.String()
+		response.TransferID = &transferIDStr
+	}
+
+	if txn.MerchantID != nil {
+		merchantIDStr := txn.MerchantID.String()
+		response.MerchantID = &merchantIDStr
+	}
+
+	if txn.InstallmentPlanID != nil {
+		installmentPlanIDStr := txn.InstallmentPlanID.String()
+		response.InstallmentPlanID = &installmentPlanIDStr
+	}
+
 	if txn.Category != nil {
 		response.Category = &TransactionCategoryResponse{
 			ID:    txn.Category.ID.String(),
@@ -147,9 +337,61 @@ func ToTransactionResponse(txn *transaction.TransactionOutput) TransactionRespon
 		}
 	}
 
+	for _, split := range txn.Splits {
+		splitResponse := TransactionSplitResponse{
+			ID:     split.ID.String(),
+			Amount: split.Amount.String(),
+			Notes:  split.Notes,
+		}
+		if split.CategoryID != nil {
+			categoryIDStr := split.CategoryID.String()
+			splitResponse.CategoryID = &categoryIDStr
+		}
+		response.Splits = append(response.Splits, splitResponse)
+	}
+
+	for _, tag := range txn.Tags {
+		response.Tags = append(response.Tags, TransactionTagResponse{
+			ID:    tag.ID.String(),
+			Name:  tag.Name,
+			Color: tag.Color,
+		})
+	}
+
 	return response
 }
 
+// ToDuplicateMatchResponses converts duplicate matches to DuplicateMatchResponse DTOs.
+func ToDuplicateMatchResponses(matches []*transaction.DuplicateMatchOutput) []DuplicateMatchResponse {
+	responses := make([]DuplicateMatchResponse, len(matches))
+	for i, match := range matches {
+		responses[i] = DuplicateMatchResponse{
+			TransactionID: match.TransactionID.String(),
+			Date:          match.Date.Format("2006-01-02"),
+			Description:   match.Description,
+			Amount:        match.Amount.String(),
+			Score:         match.Score,
+		}
+	}
+	return responses
+}
+
+// ToDuplicateListResponse converts a ListDuplicatesOutput to DuplicateListResponse.
+func ToDuplicateListResponse(output *transaction.ListDuplicatesOutput) DuplicateListResponse {
+	pairs := make([]DuplicatePairResponse, len(output.Pairs))
+	for i, pair := range output.Pairs {
+		pairs[i] = DuplicatePairResponse{
+			Transaction: ToTransactionResponse(pair.Transaction),
+			Duplicate:   ToTransactionResponse(pair.Duplicate),
+			Score:       pair.Score,
+		}
+	}
+
+	return DuplicateListResponse{
+		Pairs: pairs,
+	}
+}
+
 // ToTransactionListResponse converts a ListTransactionsOutput to TransactionListResponse.
 func ToTransactionListResponse(output *transaction.ListTransactionsOutput) TransactionListResponse {
 	transactions := make([]TransactionResponse, len(output.Transactions))
@@ -164,6 +406,7 @@ func ToTransactionListResponse(output *transaction.ListTransactionsOutput) Trans
 			Limit:      output.Pagination.Limit,
 			Total:      output.Pagination.Total,
 			TotalPages: output.Pagination.TotalPages,
+			NextCursor: output.Pagination.NextCursor,
 		},
 		Totals: TransactionTotalsResponse{
 			IncomeTotal:  output.Totals.IncomeTotal.String(),
@@ -172,3 +415,24 @@ func ToTransactionListResponse(output *transaction.ListTransactionsOutput) Trans
 		},
 	}
 }
+
+// ToBulkUpdateTransactionsResponse converts a BulkUpdateTransactionsOutput to a BulkUpdateTransactionsResponse DTO.
+func ToBulkUpdateTransactionsResponse(output *transaction.BulkUpdateTransactionsOutput) BulkUpdateTransactionsResponse {
+	sample := make([]TransactionResponse, len(output.Sample))
+	for i, txn := range output.Sample {
+		sample[i] = ToTransactionResponse(txn)
+	}
+
+	response := BulkUpdateTransactionsResponse{
+		MatchedCount: output.MatchedCount,
+		UpdatedCount: output.UpdatedCount,
+		SkippedCount: output.SkippedCount,
+		Sample:       sample,
+		DryRun:       output.DryRun,
+	}
+	if output.OperationID != nil {
+		operationID := output.OperationID.String()
+		response.OperationID = &operationID
+	}
+	return response
+}
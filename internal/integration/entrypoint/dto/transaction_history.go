@@ -0,0 +1,103 @@
+// Package dto defines data transfer objects for API requests and responses.
+package dto
+
+import (
+	"time"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
+)
+
+// TransactionFieldChangeResponse represents the value of a field before and after a change.
+type TransactionFieldChangeResponse struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before"` // Null when the field was empty
+	After  *string `json:"after"`  // Null when the field is empty
+}
+
+// TransactionChangeResponse represents a recorded change to a transaction in API responses.
+type TransactionChangeResponse struct {
+	ID            string                           `json:"id"`
+	TransactionID string                           `json:"transaction_id"`
+	ActorID       *string                          `json:"actor_id"` // Null for automated changes
+	Source        string                           `json:"source"`
+	Action        string                           `json:"action"`
+	OperationID   string                           `json:"operation_id"`
+	Fields        []TransactionFieldChangeResponse `json:"fields"`
+	Revertable    bool                             `json:"revertable"`
+	RevertedAt    *time.Time                       `json:"reverted_at,omitempty"`
+	RevertedByID  *string                          `json:"reverted_by_id,omitempty"` // Change recorded by the revert
+	CreatedAt     time.Time                        `json:"created_at"`
+}
+
+// TransactionHistoryResponse represents the response for getting the change history of a transaction.
+type TransactionHistoryResponse struct {
+	Changes []TransactionChangeResponse `json:"changes"`
+}
+
+// RevertTransactionOperationResponse represents the response for reverting an operation.
+type RevertTransactionOperationResponse struct {
+	OperationID   string                      `json:"operation_id"` // Operation of the recorded reverts
+	RevertedCount int                         `json:"reverted_count"`
+	SkippedCount  int                         `json:"skipped_count"`
+	Changes       []TransactionChangeResponse `json:"changes"`
+}
+
+// ToTransactionChangeResponse converts a TransactionChangeOutput to a TransactionChangeResponse DTO.
+func ToTransactionChangeResponse(change *transaction.TransactionChangeOutput) TransactionChangeResponse {
+	response := TransactionChangeResponse{
+		ID:            change.ID.String(),
+		TransactionID: change.TransactionID.String(),
+		Source:        string(change.Source),
+		Action:        string(change.Action),
+		OperationID:   change.OperationID.String(),
+		Fields:        make([]TransactionFieldChangeResponse, len(change.Fields)),
+		Revertable:    change.Revertable,
+		RevertedAt:    change.RevertedAt,
+		CreatedAt:     change.CreatedAt,
+	}
+
+	if change.ActorID != nil {
+		actorID := change.ActorID.String()
+		response.ActorID = &actorID
+	}
+	if change.RevertedByID != nil {
+		revertedByID := change.RevertedByID.String()
+		response.RevertedByID = &revertedByID
+	}
+	for i, field := range change.Fields {
+		response.Fields[i] = TransactionFieldChangeResponse{
+			Field:  field.Field,
+			Before: field.Before,
+			After:  field.After,
+		}
+	}
+
+	return response
+}
+
+// ToTransactionHistoryResponse converts a GetTransactionHistoryOutput to TransactionHistoryResponse.
+func ToTransactionHistoryResponse(output *transaction.GetTransactionHistoryOutput) TransactionHistoryResponse {
+	changes := make([]TransactionChangeResponse, len(output.Changes))
+	for i, change := range output.Changes {
+		changes[i] = ToTransactionChangeResponse(change)
+	}
+
+	return TransactionHistoryResponse{
+		Changes: changes,
+	}
+}
+
+// ToRevertTransactionOperationResponse converts a RevertTransactionOperationOutput to RevertTransactionOperationResponse.
+func ToRevertTransactionOperationResponse(output *transaction.RevertTransactionOperationOutput) RevertTransactionOperationResponse {
+	changes := make([]TransactionChangeResponse, len(output.Changes))
+	for i, change := range output.Changes {
+		changes[i] = ToTransactionChangeResponse(change)
+	}
+
+	return RevertTransactionOperationResponse{
+		OperationID:   output.OperationID.String(),
+		RevertedCount: output.RevertedCount,
+		SkippedCount:  output.SkippedCount,
+		Changes:       changes,
+	}
+}
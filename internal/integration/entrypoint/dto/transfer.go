@@ -0,0 +1,76 @@
+// Package dto defines data transfer objects for API requests and responses.
+package dto
+
+import (
+	"time"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/transfer"
+)
+
+// CreateTransferRequest represents the request body for transfer creation.
+type CreateTransferRequest struct {
+	FromAccountID string  `json:"from_account_id" binding:"required"`
+	ToAccountID   string  `json:"to_account_id" binding:"required"`
+	Date          string  `json:"date" binding:"required"`
+	Description   string  `json:"description" binding:"required,min=1,max=255"`
+	Amount        float64 `json:"amount" binding:"required"` // Amount moved; must be positive
+	Notes         string  `json:"notes,omitempty" binding:"omitempty,max=1000"`
+}
+
+// UpdateTransferRequest represents the request body for transfer update.
+type UpdateTransferRequest struct {
+	FromAccountID *string  `json:"from_account_id,omitempty"`
+	ToAccountID   *string  `json:"to_account_id,omitempty"`
+	Date          *string  `json:"date,omitempty"`
+	Description   *string  `json:"description,omitempty" binding:"omitempty,min=1,max=255"`
+	Amount        *float64 `json:"amount,omitempty"`
+	Notes         *string  `json:"notes,omitempty" binding:"omitempty,max=1000"`
+}
+
+// ConvertToTransferRequest represents the request body for converting a transaction into a transfer.
+type ConvertToTransferRequest struct {
+	TransactionID    string `json:"transaction_id" binding:"required"`
+	CounterAccountID string `json:"counter_account_id" binding:"required"` // Account on the other side of the transfer
+}
+
+// TransferResponse represents a transfer in API responses.
+type TransferResponse struct {
+	ID                string    `json:"id"`
+	FromAccountID     *string   `json:"from_account_id"`
+	ToAccountID       *string   `json:"to_account_id"`
+	FromTransactionID string    `json:"from_transaction_id"`
+	ToTransactionID   string    `json:"to_transaction_id"`
+	Date              string    `json:"date"`
+	Description       string    `json:"description"`
+	Amount            string    `json:"amount"`
+	Notes             string    `json:"notes"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// ToTransferResponse converts a TransferOutput to a TransferResponse DTO.
+func ToTransferResponse(output *transfer.TransferOutput) TransferResponse {
+	response := TransferResponse{
+		ID:                output.ID.String(),
+		FromTransactionID: output.FromTransactionID.String(),
+		ToTransactionID:   output.ToTransactionID.String(),
+		Date:              output.Date.Format("2006-01-02"),
+		Description:       output.Description,
+		Amount:            output.Amount.String(),
+		Notes:             output.Notes,
+		CreatedAt:         output.CreatedAt,
+		UpdatedAt:         output.UpdatedAt,
+	}
+
+	if output.FromAccountID != nil {
+		fromAccountIDStr := output.FromAccountID.String()
+		response.FromAccountID = &fromAccountIDStr
+	}
+
+	if output.ToAccountID != nil {
+		toAccountIDStr := output.ToAccountID.String()
+		response.ToAccountID = &toAccountIDStr
+	}
+
+	return response
+}
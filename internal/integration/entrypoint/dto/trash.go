@@ -0,0 +1,82 @@
+// Package dto defines data transfer objects for API requests and responses.
+package dto
+
+import (
+	"time"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/trash"
+)
+
+// TrashItemRefRequest identifies an item to restore from the trash.
+type TrashItemRefRequest struct {
+	Type string `json:"type" binding:"required"`
+	ID   string `json:"id" binding:"required"`
+}
+
+// RestoreTrashItemsRequest represents the request body for restoring several items from the trash.
+type RestoreTrashItemsRequest struct {
+	Items      []TrashItemRefRequest `json:"items"`
+	References string                `json:"references,omitempty"` // "restore" or "detach" categories that are also in the trash
+}
+
+// TrashItemResponse represents an item in the trash in API responses.
+type TrashItemResponse struct {
+	Type      string    `json:"type"`
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	DeletedAt time.Time `json:"deleted_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// TrashListResponse represents the response for listing the trash.
+type TrashListResponse struct {
+	Items         []TrashItemResponse `json:"items"`
+	Total         int64               `json:"total"`
+	Limit         int                 `json:"limit"`
+	Offset        int                 `json:"offset"`
+	RetentionDays int                 `json:"retention_days"`
+}
+
+// RestoreTrashItemsResponse represents the response for restoring items from the trash.
+type RestoreTrashItemsResponse struct {
+	Restored            []TrashItemResponse `json:"restored"`
+	DetachedCategoryIDs []string            `json:"detached_category_ids"`
+}
+
+// ToTrashItemResponses converts trash item outputs to TrashItemResponse DTOs.
+func ToTrashItemResponses(items []*trash.TrashItemOutput) []TrashItemResponse {
+	responses := make([]TrashItemResponse, len(items))
+	for i, item := range items {
+		responses[i] = TrashItemResponse{
+			Type:      string(item.Type),
+			ID:        item.ID.String(),
+			Name:      item.Name,
+			DeletedAt: item.DeletedAt,
+			ExpiresAt: item.ExpiresAt,
+		}
+	}
+	return responses
+}
+
+// ToTrashListResponse converts a ListTrashOutput to a TrashListResponse DTO.
+func ToTrashListResponse(output *trash.ListTrashOutput) TrashListResponse {
+	return TrashListResponse{
+		Items:         ToTrashItemResponses(output.Items),
+		Total:         output.Total,
+		Limit:         output.Limit,
+		Offset:        output.Offset,
+		RetentionDays: output.RetentionDays,
+	}
+}
+
+// ToRestoreTrashItemsResponse converts a RestoreTrashItemsOutput to a RestoreTrashItemsResponse DTO.
+func ToRestoreTrashItemsResponse(output *trash.RestoreTrashItemsOutput) RestoreTrashItemsResponse {
+	detachedCategoryIDs := make([]string, len(output.DetachedCategoryIDs))
+	for i, id := range output.DetachedCategoryIDs {
+		detachedCategoryIDs[i] = id.String()
+	}
+	return RestoreTrashItemsResponse{
+		Restored:            ToTrashItemResponses(output.Restored),
+		DetachedCategoryIDs: detachedCategoryIDs,
+	}
+}
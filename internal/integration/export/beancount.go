@@ -0,0 +1,101 @@
+package export
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+// beancountDateLayout is the date format of Beancount directives.
+const beancountDateLayout = "2006-01-02"
+
+// BeancountExporter implements the adapter.JournalExporter interface for Beancount ledgers.
+type BeancountExporter struct{}
+
+// NewBeancountExporter creates a new Beancount exporter.
+func NewBeancountExporter() *BeancountExporter {
+	return &BeancountExporter{}
+}
+
+// ContentType returns the MIME type of Beancount files.
+func (e *BeancountExporter) ContentType() string {
+	return "text/plain; charset=utf-8"
+}
+
+// FileExtension returns the extension of Beancount files.
+func (e *BeancountExporter) FileExtension() string {
+	return "beancount"
+}
+
+// NewWriter starts a Beancount ledger on w with the base currency as its operating currency.
+func (e *BeancountExporter) NewWriter(w io.Writer, options adapter.JournalExportOptions) (adapter.JournalWriter, error) {
+	writer := &beancountWriter{journalOutput{w: bufio.NewWriter(w), format: "Beancount"}}
+	writer.printf(";; Exported from Finance Tracker on %s\n\n", options.GeneratedAt.UTC().Format(time.RFC3339))
+	writer.printf("option \"title\" \"Finance Tracker\"\n")
+	writer.printf("option \"operating_currency\" %s\n\n", beancountString(options.BaseCurrency))
+	return writer, writer.err
+}
+
+// beancountWriter writes Beancount directives.
+type beancountWriter struct {
+	journalOutput
+}
+
+// Open writes an open directive.
+func (w *beancountWriter) Open(date time.Time, account string) error {
+	w.separate()
+	w.printf("%s open %s\n", date.Format(beancountDateLayout), account)
+	return w.err
+}
+
+// Price writes a price directive.
+func (w *beancountWriter) Price(date time.Time, commodity string, rate adapter.JournalAmount) error {
+	w.separate()
+	w.printf("%s price %s %s\n", date.Format(beancountDateLayout), commodity, journalRate(rate))
+	return w.err
+}
+
+// Entry writes a transaction with its ID and notes as metadata.
+func (w *beancountWriter) Entry(entry *adapter.JournalEntry) error {
+	header := entry.Date.Format(beancountDateLayout) + " * " + beancountString(singleLine(entry.Narration))
+	for _, name := range entry.Tags {
+		if tag := journalTag(name); tag != "" {
+			header += " #" + tag
+		}
+	}
+	w.printf("\n%s\n", header)
+	if entry.ID != uuid.Nil {
+		w.printf("  id: %s\n", beancountString(entry.ID.String()))
+	}
+	if entry.Notes != "" {
+		w.printf("  notes: %s\n", beancountString(singleLine(entry.Notes)))
+	}
+	for _, posting := range entry.Postings {
+		w.posting("  ", posting)
+	}
+	w.block = true
+	return w.err
+}
+
+// Balance writes a balance directive. Beancount checks balances at the start of a day,
+// so the directive is dated the day after.
+func (w *beancountWriter) Balance(date time.Time, account string, amount adapter.JournalAmount) error {
+	w.separate()
+	w.printf("%s balance %s  %s\n", date.AddDate(0, 0, 1).Format(beancountDateLayout), account, journalAmount(amount))
+	return w.err
+}
+
+// Close flushes the ledger.
+func (w *beancountWriter) Close() error {
+	return w.flush()
+}
+
+// beancountString quotes a string literal.
+func beancountString(text string) string {
+	return strconv.Quote(text)
+}
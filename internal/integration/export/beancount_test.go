@@ -0,0 +1,38 @@
+package export
+
+import "testing"
+
+func TestBeancountExporter_Golden(t *testing.T) {
+	data, err := writeJournal(NewBeancountExporter())
+	if err != nil {
+		t.Fatalf("export returned error: %v", err)
+	}
+
+	expected := `;; Exported from Finance Tracker on 2024-12-01T10:30:00Z
+
+option "title" "Finance Tracker"
+option "operating_currency" "BRL"
+
+2024-11-05 open Expenses:Alimentação
+2024-11-05 open Liabilities:Credit-Card:2024-11
+2024-11-05 open Assets:Wise
+2024-11-05 open Assets:Checking
+
+2024-11-05 * "Supermercado; Extra" #home #S-o-Paulo
+  id: "6f1c2a9e-8d2b-4c55-9b0e-3a7d5e4f1c20"
+  notes: "Weekly shopping"
+  Expenses:Alimentação                              1234.56 BRL
+  Liabilities:Credit-Card:2024-11                   -1234.56 BRL
+
+2024-11-07 price USD 5.5 BRL
+
+2024-11-07 * "Move to \"Wise\""
+  Assets:Checking                                   -550.00 BRL @@ 100.00 USD
+  Assets:Wise                                       100.00 USD
+
+2024-12-11 balance Liabilities:Credit-Card:2024-11  0.00 BRL
+`
+	if string(data) != expected {
+		t.Errorf("unexpected Beancount ledger:\n got:\n%s\nwant:\n%s", data, expected)
+	}
+}
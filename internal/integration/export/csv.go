@@ -0,0 +1,96 @@
+// Package export implements the file formats transactions are exported to (CSV, XLSX, OFX, Beancount, hledger).
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// csvHeader lists the columns of CSV exports.
+var csvHeader = []string{
+	"Date", "Description", "Amount", "Currency", "Base Amount", "Type", "Category", "Account", "Tags", "Notes",
+}
+
+// CSVExporter implements the adapter.TransactionExporter interface for CSV files.
+type CSVExporter struct{}
+
+// NewCSVExporter creates a new CSV exporter.
+func NewCSVExporter() *CSVExporter {
+	return &CSVExporter{}
+}
+
+// ContentType returns the MIME type of CSV files.
+func (e *CSVExporter) ContentType() string {
+	return "text/csv; charset=utf-8"
+}
+
+// FileExtension returns the extension of CSV files.
+func (e *CSVExporter) FileExtension() string {
+	return "csv"
+}
+
+// NewWriter starts a CSV file on w with the header row. Dates and amounts are written in the user's
+// formats; with the BR number format, columns are separated by semicolons because commas are decimals.
+func (e *CSVExporter) NewWriter(w io.Writer, options adapter.TransactionExportOptions) (adapter.TransactionExportWriter, error) {
+	writer := csv.NewWriter(w)
+	if options.NumberFormat == entity.NumberFormatBR {
+		writer.Comma = ';'
+	}
+	if err := writer.Write(csvHeader); err != nil {
+		return nil, fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	return &csvWriter{writer: writer, options: options}, nil
+}
+
+// csvWriter writes one CSV row per transaction.
+type csvWriter struct {
+	writer  *csv.Writer
+	options adapter.TransactionExportOptions
+}
+
+// Write appends a transaction row.
+func (w *csvWriter) Write(txn *adapter.ExportedTransaction) error {
+	t := txn.Transaction
+	record := []string{
+		t.Date.Format(w.options.DateFormat.Layout()),
+		t.Description,
+		w.options.NumberFormat.Format(t.Amount),
+		transactionCurrency(t, w.options.BaseCurrency),
+		w.options.NumberFormat.Format(t.BaseAmount()),
+		string(t.Type),
+		w.categoryName(txn),
+		txn.AccountName,
+		strings.Join(txn.Tags, ", "),
+		t.Notes,
+	}
+	if err := w.writer.Write(record); err != nil {
+		return fmt.Errorf("failed to write CSV row: %w", err)
+	}
+	return nil
+}
+
+// categoryName returns the category of the transaction, or the categories and amounts of its split lines.
+func (w *csvWriter) categoryName(txn *adapter.ExportedTransaction) string {
+	if len(txn.Splits) == 0 {
+		return txn.CategoryName
+	}
+	parts := make([]string, len(txn.Splits))
+	for i, split := range txn.Splits {
+		parts[i] = fmt.Sprintf("%s (%s)", splitCategoryName(split), w.options.NumberFormat.Format(split.Amount))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Close flushes the buffered rows.
+func (w *csvWriter) Close() error {
+	w.writer.Flush()
+	if err := w.writer.Error(); err != nil {
+		return fmt.Errorf("failed to flush CSV: %w", err)
+	}
+	return nil
+}
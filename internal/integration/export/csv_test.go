@@ -0,0 +1,72 @@
+package export
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+func TestCSVExporter_BRFormats(t *testing.T) {
+	data, err := writeAll(NewCSVExporter(), testOptions(entity.DateFormatDMY, entity.NumberFormatBR), testTransactions())
+	if err != nil {
+		t.Fatalf("export returned error: %v", err)
+	}
+
+	reader := csv.NewReader(strings.NewReader(string(data)))
+	reader.Comma = ';'
+	records, err := reader.ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read exported CSV: %v", err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("expected header and 3 rows, got %d", len(records))
+	}
+	if strings.Join(records[0], "|") != strings.Join(csvHeader, "|") {
+		t.Errorf("unexpected header: %v", records[0])
+	}
+
+	groceries := records[1]
+	expected := []string{
+		"05/11/2024", "Supermercado Extra", "-1.234,56", "BRL", "-1.234,56", "expense", "Food", "Checking",
+		"home, monthly", "Weekly shopping",
+	}
+	if strings.Join(groceries, "|") != strings.Join(expected, "|") {
+		t.Errorf("unexpected row:\n got %v\nwant %v", groceries, expected)
+	}
+
+	if records[2][6] != "Health (-45,00), Uncategorized (-15,00)" {
+		t.Errorf("expected split categories, got %q", records[2][6])
+	}
+
+	salary := records[3]
+	if salary[2] != "1.000,00" || salary[3] != "USD" || salary[4] != "5.500,00" {
+		t.Errorf("expected USD amount converted to 5.500,00 BRL, got %v", salary[2:5])
+	}
+}
+
+func TestCSVExporter_USFormats(t *testing.T) {
+	data, err := writeAll(NewCSVExporter(), testOptions(entity.DateFormatYMD, entity.NumberFormatUS), testTransactions())
+	if err != nil {
+		t.Fatalf("export returned error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if !strings.HasPrefix(lines[1], `2024-11-05,Supermercado Extra,"-1,234.56",BRL,`) {
+		t.Errorf("expected comma-separated row with quoted amount, got %q", lines[1])
+	}
+	if !strings.Contains(lines[3], `"Salary, November"`) {
+		t.Errorf("expected description with a comma to be quoted, got %q", lines[3])
+	}
+}
+
+func TestCSVExporter_Empty(t *testing.T) {
+	data, err := writeAll(NewCSVExporter(), testOptions(entity.DateFormatYMD, entity.NumberFormatUS), nil)
+	if err != nil {
+		t.Fatalf("export returned error: %v", err)
+	}
+	if string(data) != strings.Join(csvHeader, ",")+"\n" {
+		t.Errorf("expected only the header, got %q", data)
+	}
+}
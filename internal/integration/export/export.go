@@ -0,0 +1,36 @@
+// Package export implements the file formats transactions are exported to (CSV, XLSX, OFX, Beancount, hledger).
+package export
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// uncategorizedName labels amounts without a category.
+const uncategorizedName = "Uncategorized"
+
+// transactionCurrency returns the currency of the transaction amount; an empty currency is the base currency.
+func transactionCurrency(t *entity.Transaction, baseCurrency string) string {
+	if t.Currency != "" {
+		return t.Currency
+	}
+	return baseCurrency
+}
+
+// splitCategoryName returns the category of a split line, naming uncategorized lines.
+func splitCategoryName(split adapter.ExportedSplit) string {
+	if split.CategoryName == "" {
+		return uncategorizedName
+	}
+	return split.CategoryName
+}
+
+// splitBaseAmount converts a split line amount into the base currency with the transaction rate.
+func splitBaseAmount(t *entity.Transaction, amount decimal.Decimal) decimal.Decimal {
+	if t.ExchangeRate.IsZero() {
+		return amount
+	}
+	return amount.Mul(t.ExchangeRate).Round(2)
+}
@@ -0,0 +1,64 @@
+package export
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// testTransactions returns an expense, a split expense and an income in another currency.
+func testTransactions() []*adapter.ExportedTransaction {
+	groceries := entity.NewTransaction(uuid.New(), time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC),
+		"Supermercado Extra", decimal.RequireFromString("-1234.56"), entity.TransactionTypeExpense, nil, "Weekly shopping", false)
+
+	pharmacy := entity.NewTransaction(uuid.New(), time.Date(2024, 11, 6, 0, 0, 0, 0, time.UTC),
+		"Drogasil", decimal.RequireFromString("-60.00"), entity.TransactionTypeExpense, nil, "", false)
+	pharmacy.IsSplit = true
+
+	salary := entity.NewTransaction(uuid.New(), time.Date(2024, 11, 7, 0, 0, 0, 0, time.UTC),
+		"Salary, November", decimal.RequireFromString("1000.00"), entity.TransactionTypeIncome, nil, "", false)
+	salary.Currency = "USD"
+	salary.ExchangeRate = decimal.RequireFromString("5.5")
+
+	return []*adapter.ExportedTransaction{
+		{Transaction: groceries, CategoryName: "Food", AccountName: "Checking", Tags: []string{"home", "monthly"}},
+		{Transaction: pharmacy, Splits: []adapter.ExportedSplit{
+			{CategoryName: "Health", Amount: decimal.RequireFromString("-45.00")},
+			{Amount: decimal.RequireFromString("-15.00")},
+		}},
+		{Transaction: salary, CategoryName: "Salary"},
+	}
+}
+
+// testOptions returns export options with the given formats and a BRL base currency.
+func testOptions(dateFormat entity.DateFormat, numberFormat entity.NumberFormat) adapter.TransactionExportOptions {
+	return adapter.TransactionExportOptions{
+		DateFormat:   dateFormat,
+		NumberFormat: numberFormat,
+		BaseCurrency: "BRL",
+		GeneratedAt:  time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC),
+	}
+}
+
+// writeAll writes the transactions with the exporter and closes the writer.
+func writeAll(exporter adapter.TransactionExporter, options adapter.TransactionExportOptions, transactions []*adapter.ExportedTransaction) ([]byte, error) {
+	var buf bytes.Buffer
+	writer, err := exporter.NewWriter(&buf, options)
+	if err != nil {
+		return nil, err
+	}
+	for _, txn := range transactions {
+		if err := writer.Write(txn); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,111 @@
+package export
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+// hledgerDateLayout is the date format of hledger journals.
+const hledgerDateLayout = "2006-01-02"
+
+// hledgerIndent indents postings and comments of a transaction.
+const hledgerIndent = "    "
+
+// HledgerExporter implements the adapter.JournalExporter interface for hledger journals,
+// which ledger can read as well.
+type HledgerExporter struct{}
+
+// NewHledgerExporter creates a new hledger exporter.
+func NewHledgerExporter() *HledgerExporter {
+	return &HledgerExporter{}
+}
+
+// ContentType returns the MIME type of hledger journals.
+func (e *HledgerExporter) ContentType() string {
+	return "text/plain; charset=utf-8"
+}
+
+// FileExtension returns the extension of hledger journals.
+func (e *HledgerExporter) FileExtension() string {
+	return "journal"
+}
+
+// NewWriter starts an hledger journal on w.
+func (e *HledgerExporter) NewWriter(w io.Writer, options adapter.JournalExportOptions) (adapter.JournalWriter, error) {
+	writer := &hledgerWriter{journalOutput{w: bufio.NewWriter(w), format: "hledger"}}
+	writer.printf("; Exported from Finance Tracker on %s\n", options.GeneratedAt.UTC().Format(time.RFC3339))
+	writer.printf("; Base currency: %s\n\n", options.BaseCurrency)
+	return writer, writer.err
+}
+
+// hledgerWriter writes hledger directives and transactions.
+type hledgerWriter struct {
+	journalOutput
+}
+
+// Open declares an account. hledger accounts have no opening date.
+func (w *hledgerWriter) Open(_ time.Time, account string) error {
+	w.separate()
+	w.printf("account %s\n", account)
+	return w.err
+}
+
+// Price writes a market price directive.
+func (w *hledgerWriter) Price(date time.Time, commodity string, rate adapter.JournalAmount) error {
+	w.separate()
+	w.printf("P %s %s %s\n", date.Format(hledgerDateLayout), commodity, journalRate(rate))
+	return w.err
+}
+
+// Entry writes a cleared transaction with its ID and tags as tags of the transaction comment,
+// and its notes as a comment line.
+func (w *hledgerWriter) Entry(entry *adapter.JournalEntry) error {
+	var tags []string
+	if entry.ID != uuid.Nil {
+		tags = append(tags, "id:"+entry.ID.String())
+	}
+	for _, name := range entry.Tags {
+		if tag := journalTag(name); tag != "" {
+			tags = append(tags, tag+":")
+		}
+	}
+
+	header := entry.Date.Format(hledgerDateLayout) + " * " + hledgerText(entry.Narration)
+	if len(tags) > 0 {
+		header += "  ; " + strings.Join(tags, ", ")
+	}
+	w.printf("\n%s\n", header)
+	if entry.Notes != "" {
+		w.printf("%s; %s\n", hledgerIndent, hledgerText(entry.Notes))
+	}
+	for _, posting := range entry.Postings {
+		w.posting(hledgerIndent, posting)
+	}
+	w.block = true
+	return w.err
+}
+
+// Balance writes a transaction whose only posting asserts the balance of the account.
+// Assertions dated on a day hold after the postings read before them on that day.
+func (w *hledgerWriter) Balance(date time.Time, account string, amount adapter.JournalAmount) error {
+	w.printf("\n%s * Balance assertion\n", date.Format(hledgerDateLayout))
+	w.printf("%s%-*s  0 %s = %s\n", hledgerIndent, journalAccountWidth, account, amount.Currency, journalAmount(amount))
+	w.block = true
+	return w.err
+}
+
+// Close flushes the journal.
+func (w *hledgerWriter) Close() error {
+	return w.flush()
+}
+
+// hledgerText keeps text on one line and out of comments, which start at a semicolon.
+func hledgerText(text string) string {
+	return strings.ReplaceAll(singleLine(text), ";", ",")
+}
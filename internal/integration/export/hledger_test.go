@@ -0,0 +1,36 @@
+package export
+
+import "testing"
+
+func TestHledgerExporter_Golden(t *testing.T) {
+	data, err := writeJournal(NewHledgerExporter())
+	if err != nil {
+		t.Fatalf("export returned error: %v", err)
+	}
+
+	expected := `; Exported from Finance Tracker on 2024-12-01T10:30:00Z
+; Base currency: BRL
+
+account Expenses:Alimentação
+account Liabilities:Credit-Card:2024-11
+account Assets:Wise
+account Assets:Checking
+
+2024-11-05 * Supermercado, Extra  ; id:6f1c2a9e-8d2b-4c55-9b0e-3a7d5e4f1c20, home:, S-o-Paulo:
+    ; Weekly shopping
+    Expenses:Alimentação                              1234.56 BRL
+    Liabilities:Credit-Card:2024-11                   -1234.56 BRL
+
+P 2024-11-07 USD 5.5 BRL
+
+2024-11-07 * Move to "Wise"
+    Assets:Checking                                   -550.00 BRL @@ 100.00 USD
+    Assets:Wise                                       100.00 USD
+
+2024-12-10 * Balance assertion
+    Liabilities:Credit-Card:2024-11                   0 BRL = 0.00 BRL
+`
+	if string(data) != expected {
+		t.Errorf("unexpected hledger journal:\n got:\n%s\nwant:\n%s", data, expected)
+	}
+}
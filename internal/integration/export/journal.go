@@ -0,0 +1,88 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+// journalAccountWidth is the column postings are padded to, so amounts line up.
+const journalAccountWidth = 48
+
+// journalOutput writes lines of a plain-text journal, keeping the first error.
+type journalOutput struct {
+	w      *bufio.Writer
+	format string // Name of the syntax in error messages
+	block  bool   // The last line ended a multi-line directive
+	err    error
+}
+
+// separate leaves a blank line after a multi-line directive.
+func (o *journalOutput) separate() {
+	if o.block {
+		o.printf("\n")
+		o.block = false
+	}
+}
+
+// printf writes a formatted line unless a previous write failed.
+func (o *journalOutput) printf(format string, args ...any) {
+	if o.err != nil {
+		return
+	}
+	if _, err := fmt.Fprintf(o.w, format, args...); err != nil {
+		o.err = fmt.Errorf("failed to write %s journal: %w", o.format, err)
+	}
+}
+
+// flush writes the buffered lines.
+func (o *journalOutput) flush() error {
+	if o.err != nil {
+		return o.err
+	}
+	if err := o.w.Flush(); err != nil {
+		return fmt.Errorf("failed to write %s journal: %w", o.format, err)
+	}
+	return nil
+}
+
+// posting writes a posting line with the account padded so amounts line up.
+func (o *journalOutput) posting(indent string, posting adapter.JournalPosting) {
+	line := fmt.Sprintf("%s%-*s  %s", indent, journalAccountWidth, posting.Account, journalAmount(posting.Amount))
+	if posting.Price != nil {
+		line += " @@ " + journalAmount(*posting.Price)
+	}
+	o.printf("%s\n", line)
+}
+
+// journalAmount formats an amount with two decimal places and its currency.
+func journalAmount(amount adapter.JournalAmount) string {
+	return amount.Number.StringFixed(2) + " " + amount.Currency
+}
+
+// journalRate formats an exchange rate with all its decimal places and its currency.
+func journalRate(rate adapter.JournalAmount) string {
+	return rate.Number.String() + " " + rate.Currency
+}
+
+// singleLine joins the lines of a text with spaces.
+func singleLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// journalTag turns a tag name into a tag both syntaxes accept: ASCII letters, digits, dashes and underscores.
+func journalTag(name string) string {
+	var builder strings.Builder
+	for _, r := range strings.TrimSpace(name) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_'):
+			builder.WriteRune(r)
+		default:
+			builder.WriteRune('-')
+		}
+	}
+	return strings.Trim(builder.String(), "-")
+}
@@ -0,0 +1,74 @@
+package export
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+// testJournalEntryID is the ID of the grocery entry of the test journal.
+var testJournalEntryID = uuid.MustParse("6f1c2a9e-8d2b-4c55-9b0e-3a7d5e4f1c20")
+
+// brl returns an amount in BRL.
+func brl(number string) adapter.JournalAmount {
+	return adapter.JournalAmount{Number: decimal.RequireFromString(number), Currency: "BRL"}
+}
+
+// writeJournal writes a journal with an expense, a transfer between currencies and a balance assertion.
+func writeJournal(exporter adapter.JournalExporter) ([]byte, error) {
+	var buf bytes.Buffer
+	writer, err := exporter.NewWriter(&buf, adapter.JournalExportOptions{
+		BaseCurrency: "BRL",
+		GeneratedAt:  time.Date(2024, 12, 1, 10, 30, 0, 0, time.UTC),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	first := time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC)
+	for _, account := range []string{"Expenses:Alimentação", "Liabilities:Credit-Card:2024-11", "Assets:Wise", "Assets:Checking"} {
+		if err := writer.Open(first, account); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Entry(&adapter.JournalEntry{
+		Date:      first,
+		Narration: "Supermercado; Extra",
+		Notes:     "Weekly\nshopping",
+		ID:        testJournalEntryID,
+		Tags:      []string{"home", "São Paulo"},
+		Postings: []adapter.JournalPosting{
+			{Account: "Expenses:Alimentação", Amount: brl("1234.56")},
+			{Account: "Liabilities:Credit-Card:2024-11", Amount: brl("-1234.56")},
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	transferDate := time.Date(2024, 11, 7, 0, 0, 0, 0, time.UTC)
+	if err := writer.Price(transferDate, "USD", brl("5.5")); err != nil {
+		return nil, err
+	}
+	if err := writer.Entry(&adapter.JournalEntry{
+		Date:      transferDate,
+		Narration: `Move to "Wise"`,
+		Postings: []adapter.JournalPosting{
+			{Account: "Assets:Checking", Amount: brl("-550"), Price: &adapter.JournalAmount{Number: decimal.NewFromInt(100), Currency: "USD"}},
+			{Account: "Assets:Wise", Amount: adapter.JournalAmount{Number: decimal.NewFromInt(100), Currency: "USD"}},
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Balance(time.Date(2024, 12, 10, 0, 0, 0, 0, time.UTC), "Liabilities:Credit-Card:2024-11", brl("0")); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
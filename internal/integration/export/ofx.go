@@ -0,0 +1,221 @@
+// Package export implements the file formats transactions are exported to (CSV, XLSX, OFX, Beancount, hledger).
+package export
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ofxBankID identifies this application as the institution of exported statements.
+const ofxBankID = "FINANCE-TRACKER"
+
+// OFX field length limits.
+const (
+	ofxMaxNameLength = 32
+	ofxMaxMemoLength = 255
+)
+
+const ofxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="211" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+`
+
+// OFXExporter implements the adapter.TransactionExporter interface for OFX 2.1 bank statements,
+// which most personal finance tools can import.
+type OFXExporter struct{}
+
+// NewOFXExporter creates a new OFX exporter.
+func NewOFXExporter() *OFXExporter {
+	return &OFXExporter{}
+}
+
+// ContentType returns the MIME type of OFX files.
+func (e *OFXExporter) ContentType() string {
+	return "application/x-ofx"
+}
+
+// FileExtension returns the extension of OFX files.
+func (e *OFXExporter) FileExtension() string {
+	return "ofx"
+}
+
+// NewWriter starts an OFX statement on w. The statement is in the base currency; transactions
+// in other currencies carry their own currency and rate.
+func (e *OFXExporter) NewWriter(w io.Writer, options adapter.TransactionExportOptions) (adapter.TransactionExportWriter, error) {
+	return &ofxWriter{
+		w:       bufio.NewWriter(w),
+		options: options,
+		balance: decimal.Zero,
+	}, nil
+}
+
+// ofxWriter writes STMTTRN aggregates. The statement header is written with the first transaction,
+// so the period can start at it when the export is not filtered by date.
+type ofxWriter struct {
+	w       *bufio.Writer
+	options adapter.TransactionExportOptions
+	started bool
+	balance decimal.Decimal // Sum of the base amounts, reported as the ledger balance
+	err     error
+}
+
+// Write appends a transaction.
+func (w *ofxWriter) Write(txn *adapter.ExportedTransaction) error {
+	t := txn.Transaction
+	if !w.started {
+		w.start(&t.Date)
+	}
+
+	w.writeString("<STMTTRN>")
+	w.element("TRNTYPE", ofxTransactionType(t))
+	w.element("DTPOSTED", ofxDate(t.Date))
+	w.element("TRNAMT", t.Amount.StringFixed(2))
+	w.element("FITID", t.ID.String())
+	w.element("NAME", truncateRunes(t.Description, ofxMaxNameLength))
+	if memo := ofxMemo(t); memo != "" {
+		w.element("MEMO", truncateRunes(memo, ofxMaxMemoLength))
+	}
+	if currency := transactionCurrency(t, w.options.BaseCurrency); currency != w.options.BaseCurrency {
+		w.writeString("<CURRENCY>")
+		w.element("CURRATE", t.ExchangeRate.String())
+		w.element("CURSYM", currency)
+		w.writeString("</CURRENCY>")
+	}
+	w.writeString("</STMTTRN>\n")
+
+	w.balance = w.balance.Add(t.BaseAmount())
+	return w.err
+}
+
+// Close ends the transaction list with the ledger balance and flushes the statement.
+func (w *ofxWriter) Close() error {
+	if !w.started {
+		w.start(nil)
+	}
+
+	w.writeString("</BANKTRANLIST>")
+	w.writeString("<LEDGERBAL>")
+	w.element("BALAMT", w.balance.StringFixed(2))
+	w.element("DTASOF", ofxDateTime(w.options.GeneratedAt))
+	w.writeString("</LEDGERBAL>")
+	w.writeString("</STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>\n")
+
+	if w.err != nil {
+		return w.err
+	}
+	if err := w.w.Flush(); err != nil {
+		return fmt.Errorf("failed to write OFX: %w", err)
+	}
+	return nil
+}
+
+// start writes the sign-on response and the statement header up to the transaction list.
+// The period starts at the filter start date, or at the first transaction.
+func (w *ofxWriter) start(firstDate *time.Time) {
+	w.started = true
+
+	startDate := w.options.GeneratedAt
+	if w.options.StartDate != nil {
+		startDate = *w.options.StartDate
+	} else if firstDate != nil {
+		startDate = *firstDate
+	}
+	endDate := w.options.GeneratedAt
+	if w.options.EndDate != nil {
+		endDate = *w.options.EndDate
+	}
+
+	w.writeString(ofxHeader)
+	w.writeString("<OFX>\n")
+	w.writeString("<SIGNONMSGSRSV1><SONRS>")
+	w.writeString("<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>")
+	w.element("DTSERVER", ofxDateTime(w.options.GeneratedAt))
+	w.element("LANGUAGE", "ENG")
+	w.writeString("</SONRS></SIGNONMSGSRSV1>\n")
+	w.writeString("<BANKMSGSRSV1><STMTTRNRS>")
+	w.element("TRNUID", "0")
+	w.writeString("<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>")
+	w.writeString("<STMTRS>")
+	w.element("CURDEF", w.options.BaseCurrency)
+	w.writeString("<BANKACCTFROM>")
+	w.element("BANKID", ofxBankID)
+	w.element("ACCTID", "EXPORT")
+	w.element("ACCTTYPE", "CHECKING")
+	w.writeString("</BANKACCTFROM>\n")
+	w.writeString("<BANKTRANLIST>")
+	w.element("DTSTART", ofxDate(startDate))
+	w.element("DTEND", ofxDate(endDate))
+	w.writeString("\n")
+}
+
+// element writes a leaf element with its escaped value.
+func (w *ofxWriter) element(tag, value string) {
+	w.writeString("<" + tag + ">")
+	if w.err == nil {
+		if err := xml.EscapeText(w.w, []byte(value)); err != nil {
+			w.err = fmt.Errorf("failed to write OFX: %w", err)
+		}
+	}
+	w.writeString("</" + tag + ">")
+}
+
+// writeString writes raw markup unless a previous write failed.
+func (w *ofxWriter) writeString(text string) {
+	if w.err != nil {
+		return
+	}
+	if _, err := w.w.WriteString(text); err != nil {
+		w.err = fmt.Errorf("failed to write OFX: %w", err)
+	}
+}
+
+// ofxTransactionType returns the TRNTYPE of a transaction.
+func ofxTransactionType(t *entity.Transaction) string {
+	switch {
+	case t.Type == entity.TransactionTypeTransfer:
+		return "XFER"
+	case t.Amount.IsNegative():
+		return "DEBIT"
+	default:
+		return "CREDIT"
+	}
+}
+
+// ofxMemo returns the notes of the transaction, or its full description when NAME had to be truncated.
+func ofxMemo(t *entity.Transaction) string {
+	if t.Notes != "" {
+		return t.Notes
+	}
+	if utf8.RuneCountInString(t.Description) > ofxMaxNameLength {
+		return t.Description
+	}
+	return ""
+}
+
+// ofxDate formats a date as an OFX date (YYYYMMDD).
+func ofxDate(date time.Time) string {
+	return date.Format("20060102")
+}
+
+// ofxDateTime formats a time as an OFX datetime in UTC.
+func ofxDateTime(t time.Time) string {
+	return t.UTC().Format("20060102150405") + "[0:GMT]"
+}
+
+// truncateRunes shortens text to at most limit characters.
+func truncateRunes(text string, limit int) string {
+	text = strings.TrimSpace(text)
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+	return strings.TrimSpace(string([]rune(text)[:limit]))
+}
@@ -0,0 +1,100 @@
+package export
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	"github.com/finance-tracker/backend/internal/integration/statement"
+)
+
+func TestOFXExporter_RoundTrip(t *testing.T) {
+	transactions := testTransactions()
+	data, err := writeAll(NewOFXExporter(), testOptions(entity.DateFormatYMD, entity.NumberFormatUS), transactions)
+	if err != nil {
+		t.Fatalf("export returned error: %v", err)
+	}
+
+	// The exported file must be importable by the statement parser
+	parsed, err := statement.ParseOFX(data)
+	if err != nil {
+		t.Fatalf("failed to parse exported OFX: %v", err)
+	}
+	if parsed.BankID != ofxBankID || parsed.Currency != "BRL" {
+		t.Errorf("unexpected statement header: bank %q currency %q", parsed.BankID, parsed.Currency)
+	}
+	if parsed.StartDate == nil || !parsed.StartDate.Equal(time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected period to start at the first transaction, got %v", parsed.StartDate)
+	}
+	if len(parsed.Transactions) != 3 {
+		t.Fatalf("expected 3 transactions, got %d", len(parsed.Transactions))
+	}
+
+	groceries := parsed.Transactions[0]
+	if groceries.FITID != transactions[0].Transaction.ID.String() {
+		t.Errorf("expected the transaction ID as FITID, got %q", groceries.FITID)
+	}
+	if groceries.Type != "DEBIT" || !groceries.Amount.Equal(decimal.RequireFromString("-1234.56")) {
+		t.Errorf("unexpected type/amount: %s %s", groceries.Type, groceries.Amount)
+	}
+	if groceries.Name != "Supermercado Extra" || groceries.Memo != "Weekly shopping" {
+		t.Errorf("unexpected name/memo: %q %q", groceries.Name, groceries.Memo)
+	}
+
+	if parsed.Transactions[2].Type != "CREDIT" {
+		t.Errorf("expected income to be a CREDIT, got %s", parsed.Transactions[2].Type)
+	}
+	if !strings.Contains(string(data), "<CURRATE>5.5</CURRATE><CURSYM>USD</CURSYM>") {
+		t.Error("expected the foreign currency transaction to carry its currency and rate")
+	}
+
+	// The ledger balance is the sum of the base amounts
+	if !strings.Contains(string(data), "<BALAMT>4205.44</BALAMT>") {
+		t.Errorf("expected ledger balance 4205.44, got:\n%s", data)
+	}
+}
+
+func TestOFXExporter_TruncatesLongNames(t *testing.T) {
+	transactions := testTransactions()[:1]
+	transactions[0].Transaction.Description = "Pagamento de boleto & fatura do cartão de crédito"
+	transactions[0].Transaction.Notes = ""
+
+	data, err := writeAll(NewOFXExporter(), testOptions(entity.DateFormatYMD, entity.NumberFormatUS), transactions)
+	if err != nil {
+		t.Fatalf("export returned error: %v", err)
+	}
+
+	parsed, err := statement.ParseOFX(data)
+	if err != nil {
+		t.Fatalf("failed to parse exported OFX: %v", err)
+	}
+	txn := parsed.Transactions[0]
+	if txn.Name != "Pagamento de boleto & fatura do" {
+		t.Errorf("expected NAME truncated to 32 characters, got %q", txn.Name)
+	}
+	if txn.Memo != transactions[0].Transaction.Description {
+		t.Errorf("expected the full description in MEMO, got %q", txn.Memo)
+	}
+}
+
+func TestOFXExporter_Empty(t *testing.T) {
+	options := testOptions(entity.DateFormatYMD, entity.NumberFormatUS)
+	start := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	options.StartDate = &start
+
+	data, err := writeAll(NewOFXExporter(), options, nil)
+	if err != nil {
+		t.Fatalf("export returned error: %v", err)
+	}
+
+	parsed, err := statement.ParseOFX(data)
+	if err != nil {
+		t.Fatalf("failed to parse exported OFX: %v", err)
+	}
+	if len(parsed.Transactions) != 0 || parsed.StartDate == nil || !parsed.StartDate.Equal(start) {
+		t.Errorf("expected an empty statement starting at the filter date, got %+v", parsed)
+	}
+}
@@ -0,0 +1,404 @@
+// Package export implements the file formats transactions are exported to (CSV, XLSX, OFX, Beancount, hledger).
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// Cell styles defined in xlsxStyles, by index.
+const (
+	xlsxStyleDefault = 0
+	xlsxStyleDate    = 1
+	xlsxStyleAmount  = 2
+	xlsxStyleHeader  = 3
+)
+
+const xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet2.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`</Types>`
+
+const xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="Transactions" sheetId="1" r:id="rId1"/><sheet name="Summary" sheetId="2" r:id="rId2"/></sheets>` +
+	`</workbook>`
+
+const xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet2.xml"/>` +
+	`<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+// xlsxStyles defines the cell styles; the date format is filled in with the user's preference.
+const xlsxStyles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="%s"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="4">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="4" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
+
+const xlsxSheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+const xlsxSheetEnd = `</sheetData></worksheet>`
+
+// xlsxEpoch is day zero of Excel serial dates (accounting for the 1900 leap year bug).
+var xlsxEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// XLSXExporter implements the adapter.TransactionExporter interface for Excel workbooks.
+// Workbooks have a Transactions sheet and a Summary sheet with the totals of each category.
+type XLSXExporter struct{}
+
+// NewXLSXExporter creates a new XLSX exporter.
+func NewXLSXExporter() *XLSXExporter {
+	return &XLSXExporter{}
+}
+
+// ContentType returns the MIME type of Excel workbooks.
+func (e *XLSXExporter) ContentType() string {
+	return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+}
+
+// FileExtension returns the extension of Excel workbooks.
+func (e *XLSXExporter) FileExtension() string {
+	return "xlsx"
+}
+
+// NewWriter starts a workbook on w. The Transactions sheet is written as transactions arrive;
+// the Summary sheet is written on Close.
+func (e *XLSXExporter) NewWriter(w io.Writer, options adapter.TransactionExportOptions) (adapter.TransactionExportWriter, error) {
+	archive := zip.NewWriter(w)
+
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", fmt.Sprintf(xlsxStyles, xlsxDateFormatCode(options.DateFormat))},
+	}
+	for _, part := range parts {
+		if err := writeZipPart(archive, part.name, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	// The transactions sheet stays open until Close
+	sheet, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create XLSX sheet: %w", err)
+	}
+	writer := &xlsxWriter{
+		archive: archive,
+		sheet:   newXLSXSheetWriter(sheet),
+		options: options,
+		totals:  make(map[string]*xlsxCategoryTotals),
+	}
+
+	writer.sheet.start()
+	writer.sheet.row(
+		xlsxHeaderCells("Date", "Description", "Amount", "Currency", "Base Amount ("+options.BaseCurrency+")",
+			"Type", "Category", "Account", "Tags", "Notes")...,
+	)
+	if writer.sheet.err != nil {
+		return nil, writer.sheet.err
+	}
+	return writer, nil
+}
+
+// xlsxCategoryTotals accumulates the Summary sheet row of a category, in the base currency.
+type xlsxCategoryTotals struct {
+	count    int
+	income   decimal.Decimal
+	expenses decimal.Decimal
+}
+
+// xlsxWriter writes transactions to the Transactions sheet and accumulates the category totals.
+type xlsxWriter struct {
+	archive *zip.Writer
+	sheet   *xlsxSheetWriter
+	options adapter.TransactionExportOptions
+	totals  map[string]*xlsxCategoryTotals
+}
+
+// Write appends a transaction row.
+func (w *xlsxWriter) Write(txn *adapter.ExportedTransaction) error {
+	t := txn.Transaction
+
+	categoryName := txn.CategoryName
+	if len(txn.Splits) > 0 {
+		names := make([]string, len(txn.Splits))
+		for i, split := range txn.Splits {
+			names[i] = splitCategoryName(split)
+		}
+		categoryName = strings.Join(names, ", ")
+	}
+
+	w.sheet.row(
+		xlsxDateCell(t.Date),
+		xlsxStringCell(t.Description),
+		xlsxAmountCell(t.Amount),
+		xlsxStringCell(transactionCurrency(t, w.options.BaseCurrency)),
+		xlsxAmountCell(t.BaseAmount()),
+		xlsxStringCell(string(t.Type)),
+		xlsxStringCell(categoryName),
+		xlsxStringCell(txn.AccountName),
+		xlsxStringCell(strings.Join(txn.Tags, ", ")),
+		xlsxStringCell(t.Notes),
+	)
+
+	// Transfers move money between the user's own accounts, so they are left out of the summary
+	if t.Type != entity.TransactionTypeTransfer {
+		if len(txn.Splits) == 0 {
+			name := txn.CategoryName
+			if name == "" {
+				name = uncategorizedName
+			}
+			w.addToTotals(name, t.BaseAmount())
+		}
+		for _, split := range txn.Splits {
+			w.addToTotals(splitCategoryName(split), splitBaseAmount(t, split.Amount))
+		}
+	}
+
+	return w.sheet.err
+}
+
+// addToTotals adds an amount to the summary of a category.
+func (w *xlsxWriter) addToTotals(category string, amount decimal.Decimal) {
+	totals, ok := w.totals[category]
+	if !ok {
+		totals = &xlsxCategoryTotals{}
+		w.totals[category] = totals
+	}
+	totals.count++
+	if amount.IsNegative() {
+		totals.expenses = totals.expenses.Add(amount)
+	} else {
+		totals.income = totals.income.Add(amount)
+	}
+}
+
+// Close ends the Transactions sheet, writes the Summary sheet and finishes the workbook.
+func (w *xlsxWriter) Close() error {
+	w.sheet.end()
+	if w.sheet.err != nil {
+		return w.sheet.err
+	}
+
+	summary, err := w.archive.Create("xl/worksheets/sheet2.xml")
+	if err != nil {
+		return fmt.Errorf("failed to create XLSX sheet: %w", err)
+	}
+	sheet := newXLSXSheetWriter(summary)
+	sheet.start()
+	sheet.row(xlsxHeaderCells("Category", "Transactions", "Income ("+w.options.BaseCurrency+")",
+		"Expenses ("+w.options.BaseCurrency+")", "Net ("+w.options.BaseCurrency+")")...)
+
+	// Categories are sorted by name, with uncategorized amounts last
+	categories := make([]string, 0, len(w.totals))
+	for category := range w.totals {
+		categories = append(categories, category)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		if (categories[i] == uncategorizedName) != (categories[j] == uncategorizedName) {
+			return categories[j] == uncategorizedName
+		}
+		return categories[i] < categories[j]
+	})
+
+	var total xlsxCategoryTotals
+	for _, category := range categories {
+		totals := w.totals[category]
+		sheet.row(xlsxSummaryCells(category, totals)...)
+		total.count += totals.count
+		total.income = total.income.Add(totals.income)
+		total.expenses = total.expenses.Add(totals.expenses)
+	}
+	sheet.row(xlsxSummaryCells("Total", &total)...)
+	sheet.end()
+	if sheet.err != nil {
+		return sheet.err
+	}
+
+	if err := w.archive.Close(); err != nil {
+		return fmt.Errorf("failed to finish XLSX: %w", err)
+	}
+	return nil
+}
+
+// xlsxSummaryCells builds the Summary sheet row of a category.
+func xlsxSummaryCells(category string, totals *xlsxCategoryTotals) []xlsxCell {
+	return []xlsxCell{
+		xlsxStringCell(category),
+		xlsxNumberCell(strconv.Itoa(totals.count), xlsxStyleDefault),
+		xlsxAmountCell(totals.income),
+		xlsxAmountCell(totals.expenses),
+		xlsxAmountCell(totals.income.Add(totals.expenses)),
+	}
+}
+
+// xlsxCell is a worksheet cell: an inline string, or a number with a style.
+type xlsxCell struct {
+	text     string
+	isNumber bool
+	style    int
+}
+
+// xlsxStringCell builds a text cell.
+func xlsxStringCell(text string) xlsxCell {
+	return xlsxCell{text: text}
+}
+
+// xlsxNumberCell builds a numeric cell displayed with the style.
+func xlsxNumberCell(value string, style int) xlsxCell {
+	return xlsxCell{text: value, isNumber: true, style: style}
+}
+
+// xlsxAmountCell builds a cell for an amount, displayed with two decimals.
+func xlsxAmountCell(amount decimal.Decimal) xlsxCell {
+	return xlsxNumberCell(amount.String(), xlsxStyleAmount)
+}
+
+// xlsxDateCell writes the date as an Excel serial date, displayed in the user's date format.
+func xlsxDateCell(date time.Time) xlsxCell {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	serial := int(day.Sub(xlsxEpoch).Hours() / 24)
+	return xlsxNumberCell(strconv.Itoa(serial), xlsxStyleDate)
+}
+
+// xlsxHeaderCells builds the bold cells of a header row.
+func xlsxHeaderCells(titles ...string) []xlsxCell {
+	cells := make([]xlsxCell, len(titles))
+	for i, title := range titles {
+		cells[i] = xlsxCell{text: title, style: xlsxStyleHeader}
+	}
+	return cells
+}
+
+// xlsxSheetWriter writes worksheet XML row by row. The first error is kept and later writes are skipped.
+type xlsxSheetWriter struct {
+	w    *bufio.Writer
+	rows int
+	err  error
+}
+
+// newXLSXSheetWriter creates a sheet writer buffering writes to w.
+func newXLSXSheetWriter(w io.Writer) *xlsxSheetWriter {
+	return &xlsxSheetWriter{w: bufio.NewWriter(w)}
+}
+
+// start writes the beginning of the worksheet.
+func (s *xlsxSheetWriter) start() {
+	s.writeString(xlsxSheetStart)
+}
+
+// end writes the end of the worksheet and flushes it.
+func (s *xlsxSheetWriter) end() {
+	s.writeString(xlsxSheetEnd)
+	if s.err == nil {
+		if err := s.w.Flush(); err != nil {
+			s.err = fmt.Errorf("failed to write XLSX sheet: %w", err)
+		}
+	}
+}
+
+// row appends a row with the cells in consecutive columns.
+func (s *xlsxSheetWriter) row(cells ...xlsxCell) {
+	s.rows++
+	s.writeString(fmt.Sprintf(`<row r="%d">`, s.rows))
+	for i, cell := range cells {
+		ref := xlsxColumnName(i) + strconv.Itoa(s.rows)
+		switch {
+		case cell.isNumber:
+			s.writeString(fmt.Sprintf(`<c r="%s" s="%d"><v>%s</v></c>`, ref, cell.style, cell.text))
+		case cell.text == "" && cell.style == xlsxStyleDefault:
+			// Empty cells are left out
+		default:
+			s.writeString(fmt.Sprintf(`<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">`, ref, cell.style))
+			if s.err == nil {
+				if err := xml.EscapeText(s.w, []byte(cell.text)); err != nil {
+					s.err = fmt.Errorf("failed to write XLSX sheet: %w", err)
+				}
+			}
+			s.writeString(`</t></is></c>`)
+		}
+	}
+	s.writeString(`</row>`)
+}
+
+// writeString writes raw XML unless a previous write failed.
+func (s *xlsxSheetWriter) writeString(text string) {
+	if s.err != nil {
+		return
+	}
+	if _, err := s.w.WriteString(text); err != nil {
+		s.err = fmt.Errorf("failed to write XLSX sheet: %w", err)
+	}
+}
+
+// xlsxColumnName returns the letters of a 0-based column index (A, B, ..., Z, AA, ...).
+func xlsxColumnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
+
+// xlsxDateFormatCode returns the Excel number format for the user's date format.
+func xlsxDateFormatCode(format entity.DateFormat) string {
+	switch format {
+	case entity.DateFormatDMY:
+		return "dd/mm/yyyy"
+	case entity.DateFormatMDY:
+		return "mm/dd/yyyy"
+	default:
+		return "yyyy-mm-dd"
+	}
+}
+
+// writeZipPart writes a complete file to the archive.
+func writeZipPart(archive *zip.Writer, name, content string) error {
+	part, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to create XLSX part %s: %w", name, err)
+	}
+	if _, err := io.WriteString(part, content); err != nil {
+		return fmt.Errorf("failed to write XLSX part %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// testSheet is the part of a worksheet the tests read.
+type testSheet struct {
+	Rows []struct {
+		Cells []struct {
+			Ref    string `xml:"r,attr"`
+			Value  string `xml:"v"`
+			Inline string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// values returns the cell values of each row; text cells are returned as their inline string.
+func (s testSheet) values() [][]string {
+	rows := make([][]string, len(s.Rows))
+	for i, row := range s.Rows {
+		for _, cell := range row.Cells {
+			rows[i] = append(rows[i], cell.Value+cell.Inline)
+		}
+	}
+	return rows
+}
+
+// readWorkbook opens the exported workbook and returns its parts by name.
+func readWorkbook(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("exported workbook is not a zip archive: %v", err)
+	}
+	parts := make(map[string]string)
+	for _, file := range archive.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatalf("failed to open %s: %v", file.Name, err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file.Name, err)
+		}
+		parts[file.Name] = string(content)
+	}
+	return parts
+}
+
+// readSheet parses a worksheet part.
+func readSheet(t *testing.T, content string) [][]string {
+	t.Helper()
+	var sheet testSheet
+	if err := xml.Unmarshal([]byte(content), &sheet); err != nil {
+		t.Fatalf("failed to parse worksheet: %v", err)
+	}
+	return sheet.values()
+}
+
+func TestXLSXExporter_Workbook(t *testing.T) {
+	transactions := testTransactions()
+	transactions[0].Transaction.Description = "Pão & <queijo>"
+
+	data, err := writeAll(NewXLSXExporter(), testOptions(entity.DateFormatDMY, entity.NumberFormatBR), transactions)
+	if err != nil {
+		t.Fatalf("export returned error: %v", err)
+	}
+
+	parts := readWorkbook(t, data)
+	for _, name := range []string{
+		"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels",
+		"xl/styles.xml", "xl/worksheets/sheet1.xml", "xl/worksheets/sheet2.xml",
+	} {
+		if _, ok := parts[name]; !ok {
+			t.Errorf("missing workbook part %s", name)
+		}
+	}
+	if !strings.Contains(parts["xl/styles.xml"], `formatCode="dd/mm/yyyy"`) {
+		t.Error("expected dates to be displayed in the user's date format")
+	}
+
+	rows := readSheet(t, parts["xl/worksheets/sheet1.xml"])
+	if len(rows) != 4 {
+		t.Fatalf("expected header and 3 transaction rows, got %d", len(rows))
+	}
+	if rows[0][4] != "Base Amount (BRL)" {
+		t.Errorf("unexpected header: %v", rows[0])
+	}
+	groceries := rows[1]
+	expected := []string{"45601", "Pão & <queijo>", "-1234.56", "BRL", "-1234.56", "expense", "Food", "Checking", "home, monthly", "Weekly shopping"}
+	if strings.Join(groceries, "|") != strings.Join(expected, "|") {
+		t.Errorf("unexpected row:\n got %v\nwant %v", groceries, expected)
+	}
+}
+
+func TestXLSXExporter_SummaryByCategory(t *testing.T) {
+	data, err := writeAll(NewXLSXExporter(), testOptions(entity.DateFormatYMD, entity.NumberFormatUS), testTransactions())
+	if err != nil {
+		t.Fatalf("export returned error: %v", err)
+	}
+
+	rows := readSheet(t, readWorkbook(t, data)["xl/worksheets/sheet2.xml"])
+	expected := [][]string{
+		{"Category", "Transactions", "Income (BRL)", "Expenses (BRL)", "Net (BRL)"},
+		{"Food", "1", "0", "-1234.56", "-1234.56"},
+		{"Health", "1", "0", "-45", "-45"},
+		{"Salary", "1", "5500", "0", "5500"},
+		{"Uncategorized", "1", "0", "-15", "-15"},
+		{"Total", "4", "5500", "-1294.56", "4205.44"},
+	}
+	if len(rows) != len(expected) {
+		t.Fatalf("expected %d summary rows, got %d: %v", len(expected), len(rows), rows)
+	}
+	for i := range expected {
+		if strings.Join(rows[i], "|") != strings.Join(expected[i], "|") {
+			t.Errorf("summary row %d:\n got %v\nwant %v", i, rows[i], expected[i])
+		}
+	}
+}
+
+func TestXLSXColumnName(t *testing.T) {
+	tests := map[int]string{0: "A", 9: "J", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"}
+	for index, expected := range tests {
+		if got := xlsxColumnName(index); got != expected {
+			t.Errorf("xlsxColumnName(%d) = %q, want %q", index, got, expected)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// accountRepository implements the adapter.AccountRepository interface.
+type accountRepository struct {
+	db *gorm.DB
+}
+
+// NewAccountRepository creates a new account repository instance.
+func NewAccountRepository(db *gorm.DB) adapter.AccountRepository {
+	return &accountRepository{
+		db: db,
+	}
+}
+
+// Create creates a new account in the database.
+func (r *accountRepository) Create(ctx context.Context, account *entity.Account) error {
+	accountModel := model.AccountFromEntity(account)
+	result := r.db.WithContext(ctx).Create(accountModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// FindByID retrieves an account by its ID.
+func (r *accountRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Account, error) {
+	var accountModel model.AccountModel
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&accountModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domainerror.ErrAccountNotFound
+		}
+		return nil, result.Error
+	}
+	return accountModel.ToEntity(), nil
+}
+
+// FindByUser retrieves the user's accounts sorted by name.
+func (r *accountRepository) FindByUser(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]*entity.Account, error) {
+	var accountModels []model.AccountModel
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if !includeArchived {
+		query = query.Where("is_archived = ?", false)
+	}
+
+	result := query.Order("name ASC").Find(&accountModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	accounts := make([]*entity.Account, len(accountModels))
+	for i, am := range accountModels {
+		accounts[i] = am.ToEntity()
+	}
+	return accounts, nil
+}
+
+// ExistsByNameAndUser checks if the user already has an account with the given name (case-insensitive).
+func (r *accountRepository) ExistsByNameAndUser(
+	ctx context.Context,
+	name string,
+	userID uuid.UUID,
+	excludeID *uuid.UUID,
+) (bool, error) {
+	var count int64
+	query := r.db.WithContext(ctx).
+		Model(&model.AccountModel{}).
+		Where("user_id = ?", userID).
+		Where("LOWER(name) = ?", strings.ToLower(name))
+
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Update updates an existing account in the database.
+func (r *accountRepository) Update(ctx context.Context, account *entity.Account) error {
+	accountModel := model.AccountFromEntity(account)
+	result := r.db.WithContext(ctx).Save(accountModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// Delete soft-deletes an account and detaches its transactions, which are kept.
+func (r *accountRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&model.AccountModel{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerror.ErrAccountNotFound
+		}
+
+		return tx.Model(&model.TransactionModel{}).
+			Where("account_id = ?", id).
+			Update("account_id", nil).Error
+	})
+}
+
+// HasTransactions checks if any transaction is recorded in the account.
+func (r *accountRepository) HasTransactions(ctx context.Context, accountID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).
+		Model(&model.TransactionModel{}).
+		Where("account_id = ?", accountID).
+		Limit(1).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetTransactionTotals returns the sum of transaction amounts per account.
+func (r *accountRepository) GetTransactionTotals(ctx context.Context, accountIDs []uuid.UUID) (map[uuid.UUID]decimal.Decimal, error) {
+	totals := make(map[uuid.UUID]decimal.Decimal, len(accountIDs))
+	if len(accountIDs) == 0 {
+		return totals, nil
+	}
+
+	var results []struct {
+		AccountID uuid.UUID
+		Total     decimal.Decimal
+	}
+	err := r.db.WithContext(ctx).
+		Model(&model.TransactionModel{}).
+		Select("account_id, COALESCE(SUM(amount), 0) as total").
+		Where("account_id IN ?", accountIDs).
+		Group("account_id").
+		Scan(&results).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, res := range results {
+		totals[res.AccountID] = res.Total
+	}
+	return totals, nil
+}
+
+// GetRunningTotals returns the account's cumulative transaction amount at each of the given transactions.
+func (r *accountRepository) GetRunningTotals(
+	ctx context.Context,
+	accountID uuid.UUID,
+	transactionIDs []uuid.UUID,
+) (map[uuid.UUID]decimal.Decimal, error) {
+	totals := make(map[uuid.UUID]decimal.Decimal, len(transactionIDs))
+	if len(transactionIDs) == 0 {
+		return totals, nil
+	}
+
+	// The window runs over every transaction of the account, so the balance at a row
+	// does not depend on which page or filter selected it
+	query := `
+		SELECT id, running_total
+		FROM (
+			SELECT id, SUM(amount) OVER (ORDER BY date ASC, created_at ASC, id ASC) AS running_total
+			FROM transactions
+			WHERE account_id = ? AND deleted_at IS NULL
+		) balances
+		WHERE id IN ?
+	`
+
+	var results []struct {
+		ID           uuid.UUID
+		RunningTotal decimal.Decimal
+	}
+	if err := r.db.WithContext(ctx).Raw(query, accountID, transactionIDs).Scan(&results).Error; err != nil {
+		return nil, err
+	}
+
+	for _, res := range results {
+		totals[res.ID] = res.RunningTotal
+	}
+	return totals, nil
+}
+
+// FindBillTransactions retrieves the account's transactions charged in the billing cycle, oldest first.
+func (r *accountRepository) FindBillTransactions(ctx context.Context, accountID uuid.UUID, billingCycle string) ([]*entity.Transaction, error) {
+	var transactionModels []model.TransactionModel
+	result := r.db.WithContext(ctx).
+		Where("account_id = ? AND billing_cycle = ?", accountID, billingCycle).
+		Where("is_credit_card_payment = ? AND is_hidden = ?", false, false).
+		Order("date ASC, created_at ASC").
+		Find(&transactionModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, tm := range transactionModels {
+		transactions[i] = tm.ToEntity()
+	}
+	return transactions, nil
+}
@@ -0,0 +1,156 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// orphanedAttachmentSQL matches attachments whose owner or user no longer exists (or was soft-deleted),
+// and pending uploads that were never completed. Transactions in the trash keep their attachments
+// until they are purged, so they can be restored with them.
+const orphanedAttachmentSQL = `((owner_type = 'transaction' AND NOT EXISTS (
+		SELECT 1 FROM transactions t WHERE t.id = attachments.owner_id))
+	OR (owner_type = 'account' AND NOT EXISTS (
+		SELECT 1 FROM accounts a WHERE a.id = attachments.owner_id AND a.deleted_at IS NULL))
+	OR NOT EXISTS (
+		SELECT 1 FROM users u WHERE u.id = attachments.user_id AND u.deleted_at IS NULL)
+	OR (status = 'pending' AND created_at < ?))`
+
+// attachmentRepository implements the adapter.AttachmentRepository interface.
+type attachmentRepository struct {
+	db *gorm.DB
+}
+
+// NewAttachmentRepository creates a new attachment repository instance.
+func NewAttachmentRepository(db *gorm.DB) adapter.AttachmentRepository {
+	return &attachmentRepository{
+		db: db,
+	}
+}
+
+// Create creates a new attachment record in the database.
+func (r *attachmentRepository) Create(ctx context.Context, attachment *entity.Attachment) error {
+	attachmentModel := model.AttachmentFromEntity(attachment)
+	result := r.db.WithContext(ctx).Create(attachmentModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// FindByID retrieves an attachment by its ID.
+func (r *attachmentRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Attachment, error) {
+	var attachmentModel model.AttachmentModel
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&attachmentModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domainerror.ErrAttachmentNotFound
+		}
+		return nil, result.Error
+	}
+	return attachmentModel.ToEntity(), nil
+}
+
+// FindByOwner retrieves the uploaded attachments of a transaction or account, oldest first.
+func (r *attachmentRepository) FindByOwner(
+	ctx context.Context,
+	ownerType entity.AttachmentOwnerType,
+	ownerID uuid.UUID,
+) ([]*entity.Attachment, error) {
+	var attachmentModels []model.AttachmentModel
+	result := r.db.WithContext(ctx).
+		Where("owner_type = ? AND owner_id = ? AND status = ?", string(ownerType), ownerID, string(entity.AttachmentStatusUploaded)).
+		Order("created_at ASC").
+		Find(&attachmentModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return toAttachmentEntities(attachmentModels), nil
+}
+
+// FindOrphaned retrieves up to limit attachments whose transaction, account or user was deleted,
+// plus pending attachments created before pendingBefore.
+func (r *attachmentRepository) FindOrphaned(
+	ctx context.Context,
+	userID *uuid.UUID,
+	pendingBefore time.Time,
+	limit int,
+) ([]*entity.Attachment, error) {
+	query := r.db.WithContext(ctx).Where(orphanedAttachmentSQL, pendingBefore)
+	if userID != nil {
+		query = query.Where("user_id = ?", *userID)
+	}
+
+	var attachmentModels []model.AttachmentModel
+	result := query.Order("created_at ASC").Limit(limit).Find(&attachmentModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return toAttachmentEntities(attachmentModels), nil
+}
+
+// ReassignOwner moves all attachments of one transaction or account to another.
+func (r *attachmentRepository) ReassignOwner(
+	ctx context.Context,
+	ownerType entity.AttachmentOwnerType,
+	fromOwnerID uuid.UUID,
+	toOwnerID uuid.UUID,
+) error {
+	result := conn(ctx, r.db).
+		Model(&model.AttachmentModel{}).
+		Where("owner_type = ? AND owner_id = ?", string(ownerType), fromOwnerID).
+		Updates(map[string]interface{}{
+			"owner_id":   toOwnerID,
+			"updated_at": time.Now().UTC(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// Update updates an existing attachment record in the database.
+func (r *attachmentRepository) Update(ctx context.Context, attachment *entity.Attachment) error {
+	attachmentModel := model.AttachmentFromEntity(attachment)
+	result := r.db.WithContext(ctx).Save(attachmentModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerror.ErrAttachmentNotFound
+	}
+	return nil
+}
+
+// Delete removes an attachment record from the database.
+func (r *attachmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&model.AttachmentModel{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerror.ErrAttachmentNotFound
+	}
+	return nil
+}
+
+// toAttachmentEntities converts attachment models to domain entities.
+func toAttachmentEntities(attachmentModels []model.AttachmentModel) []*entity.Attachment {
+	attachments := make([]*entity.Attachment, len(attachmentModels))
+	for i, am := range attachmentModels {
+		attachments[i] = am.ToEntity()
+	}
+	return attachments
+}
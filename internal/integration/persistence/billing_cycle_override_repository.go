@@ -0,0 +1,78 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// billingCycleOverrideRepository implements the adapter.BillingCycleOverrideRepository interface.
+type billingCycleOverrideRepository struct {
+	db *gorm.DB
+}
+
+// NewBillingCycleOverrideRepository creates a new billing cycle override repository instance.
+func NewBillingCycleOverrideRepository(db *gorm.DB) adapter.BillingCycleOverrideRepository {
+	return &billingCycleOverrideRepository{
+		db: db,
+	}
+}
+
+// Upsert saves the override, replacing the dates of an existing override for the same account and cycle.
+func (r *billingCycleOverrideRepository) Upsert(ctx context.Context, override *entity.BillingCycleOverride) error {
+	overrideModel := model.BillingCycleOverrideFromEntity(override)
+	result := r.db.WithContext(ctx).Clauses(
+		clause.OnConflict{
+			Columns:   []clause.Column{{Name: "account_id"}, {Name: "billing_cycle"}},
+			DoUpdates: clause.AssignmentColumns([]string{"closing_date", "due_date", "updated_at"}),
+		},
+		clause.Returning{Columns: []clause.Column{{Name: "id"}, {Name: "created_at"}}},
+	).Create(overrideModel)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	override.ID = overrideModel.ID
+	override.CreatedAt = overrideModel.CreatedAt
+	return nil
+}
+
+// FindByAccount retrieves the overrides of an account, sorted by billing cycle.
+func (r *billingCycleOverrideRepository) FindByAccount(ctx context.Context, accountID uuid.UUID) ([]*entity.BillingCycleOverride, error) {
+	var overrideModels []model.BillingCycleOverrideModel
+	result := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID).
+		Order("billing_cycle ASC").
+		Find(&overrideModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	overrides := make([]*entity.BillingCycleOverride, len(overrideModels))
+	for i, om := range overrideModels {
+		overrides[i] = om.ToEntity()
+	}
+	return overrides, nil
+}
+
+// Delete removes the override of an account's billing cycle.
+func (r *billingCycleOverrideRepository) Delete(ctx context.Context, accountID uuid.UUID, billingCycle string) error {
+	result := r.db.WithContext(ctx).
+		Where("account_id = ? AND billing_cycle = ?", accountID, billingCycle).
+		Delete(&model.BillingCycleOverrideModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerror.ErrBillingCycleOverrideNotFound
+	}
+	return nil
+}
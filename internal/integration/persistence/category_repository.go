@@ -156,13 +156,14 @@ func (r *categoryRepository) GetTransactionStats(ctx context.Context, categoryID
 		return stats, nil
 	}
 
-	// Query transaction statistics grouped by category_id
+	// Query transaction statistics grouped by category_id, attributing split lines to their categories
 	var results []categoryStatsResult
 	query := r.db.WithContext(ctx).
-		Model(&model.TransactionModel{}).
-		Select("category_id, COUNT(*) as transaction_count, COALESCE(SUM(amount), 0) as period_total").
+		Table(categorizedTransactionsSQL+" AS t").
+		Select("category_id, COUNT(DISTINCT id) as transaction_count, COALESCE(SUM("+baseAmountSQL+"), 0) as period_total").
 		Where("category_id IN ?", categoryIDs).
 		Where("date >= ? AND date <= ?", startDate, endDate).
+		Where("deleted_at IS NULL").
 		Group("category_id")
 
 	if err := query.Scan(&results).Error; err != nil {
@@ -180,7 +181,7 @@ func (r *categoryRepository) GetTransactionStats(ctx context.Context, categoryID
 	return stats, nil
 }
 
-// OrphanTransactionsByCategory sets category_id to NULL for all transactions with the given category ID.
+// OrphanTransactionsByCategory sets category_id to NULL for all transactions and split lines with the given category ID.
 func (r *categoryRepository) OrphanTransactionsByCategory(ctx context.Context, categoryID uuid.UUID) error {
 	result := r.db.WithContext(ctx).
 		Model(&model.TransactionModel{}).
@@ -189,5 +190,13 @@ func (r *categoryRepository) OrphanTransactionsByCategory(ctx context.Context, c
 	if result.Error != nil {
 		return result.Error
 	}
+
+	result = r.db.WithContext(ctx).
+		Model(&model.TransactionSplitModel{}).
+		Where("category_id = ?", categoryID).
+		Update("category_id", nil)
+	if result.Error != nil {
+		return result.Error
+	}
 	return nil
 }
@@ -11,6 +11,8 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/finance-tracker/backend/internal/application/usecase/dashboard"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
 )
 
 // dashboardRepository implements the dashboard.DashboardRepository interface.
@@ -60,6 +62,7 @@ func (r *dashboardRepository) GetAggregatedTrends(
 	userID uuid.UUID,
 	startDate, endDate time.Time,
 	granularity dashboard.Granularity,
+	accountIDs []uuid.UUID,
 ) ([]dashboard.RawTrendData, error) {
 	// Determine the date_trunc interval based on granularity
 	var truncInterval string
@@ -74,6 +77,8 @@ func (r *dashboardRepository) GetAggregatedTrends(
 		truncInterval = "month"
 	}
 
+	accountClause, accountArgs := accountFilterClause("account_id", accountIDs)
+
 	var results []struct {
 		PeriodStart      time.Time       `gorm:"column:period_start"`
 		Income           decimal.Decimal `gorm:"column:income"`
@@ -83,21 +88,24 @@ func (r *dashboardRepository) GetAggregatedTrends(
 
 	query := fmt.Sprintf(`
 		SELECT
-			date_trunc('%s', date)::date as period_start,
-			SUM(CASE WHEN amount > 0 THEN amount ELSE 0 END) as income,
-			SUM(CASE WHEN amount < 0 THEN ABS(amount) ELSE 0 END) as expenses,
+			date_trunc('%[1]s', date)::date as period_start,
+			SUM(CASE WHEN amount > 0 THEN %[2]s ELSE 0 END) as income,
+			SUM(CASE WHEN amount < 0 THEN ABS(%[2]s) ELSE 0 END) as expenses,
 			COUNT(*) as transaction_count
 		FROM transactions
 		WHERE user_id = ?
 			AND date >= ?
 			AND date <= ?
+			AND type <> 'transfer'
 			AND deleted_at IS NULL
-		GROUP BY date_trunc('%s', date)
+			%[3]s
+		GROUP BY date_trunc('%[1]s', date)
 		ORDER BY period_start
-	`, truncInterval, truncInterval)
+	`, truncInterval, baseAmountSQL, accountClause)
 
+	args := append([]interface{}{userID, startDate, endDate}, accountArgs...)
 	err := r.db.WithContext(ctx).
-		Raw(query, userID, startDate, endDate).
+		Raw(query, args...).
 		Scan(&results).Error
 
 	if err != nil {
@@ -122,6 +130,7 @@ func (r *dashboardRepository) GetCategoryBreakdown(
 	ctx context.Context,
 	userID uuid.UUID,
 	startDate, endDate time.Time,
+	accountIDs []uuid.UUID,
 ) ([]dashboard.RawCategoryBreakdown, decimal.Decimal, error) {
 	var results []struct {
 		CategoryID       *uuid.UUID      `gorm:"column:category_id"`
@@ -132,27 +141,32 @@ func (r *dashboardRepository) GetCategoryBreakdown(
 		TransactionCount int             `gorm:"column:transaction_count"`
 	}
 
-	query := `
+	accountClause, accountArgs := accountFilterClause("t.account_id", accountIDs)
+
+	query := fmt.Sprintf(`
 		SELECT
 			t.category_id,
 			c.name as category_name,
 			c.color as category_color,
 			c.icon as category_icon,
-			SUM(ABS(t.amount)) as amount,
-			COUNT(*) as transaction_count
-		FROM transactions t
+			SUM(ABS(ROUND(t.amount * t.exchange_rate, 2))) as amount,
+			COUNT(DISTINCT t.id) as transaction_count
+		FROM %s AS t
 		LEFT JOIN categories c ON t.category_id = c.id AND c.deleted_at IS NULL
 		WHERE t.user_id = ?
 			AND t.date >= ?
 			AND t.date <= ?
 			AND t.amount < 0
+			AND t.type <> 'transfer'
 			AND t.deleted_at IS NULL
+			%s
 		GROUP BY t.category_id, c.name, c.color, c.icon
 		ORDER BY amount DESC
-	`
+	`, categorizedTransactionsSQL, accountClause)
 
+	args := append([]interface{}{userID, startDate, endDate}, accountArgs...)
 	err := r.db.WithContext(ctx).
-		Raw(query, userID, startDate, endDate).
+		Raw(query, args...).
 		Scan(&results).Error
 
 	if err != nil {
@@ -177,12 +191,137 @@ func (r *dashboardRepository) GetCategoryBreakdown(
 	return breakdown, totalExpenses, nil
 }
 
+// GetTagBreakdown returns spending per tag for a period.
+func (r *dashboardRepository) GetTagBreakdown(
+	ctx context.Context,
+	userID uuid.UUID,
+	startDate, endDate time.Time,
+	accountIDs []uuid.UUID,
+) ([]dashboard.RawTagBreakdown, error) {
+	var results []struct {
+		TagID            uuid.UUID       `gorm:"column:tag_id"`
+		TagName          string          `gorm:"column:tag_name"`
+		TagColor         string          `gorm:"column:tag_color"`
+		Amount           decimal.Decimal `gorm:"column:amount"`
+		TransactionCount int             `gorm:"column:transaction_count"`
+	}
+
+	accountClause, accountArgs := accountFilterClause("t.account_id", accountIDs)
+
+	query := fmt.Sprintf(`
+		SELECT
+			tg.id as tag_id,
+			tg.name as tag_name,
+			tg.color as tag_color,
+			SUM(ABS(ROUND(t.amount * t.exchange_rate, 2))) as amount,
+			COUNT(*) as transaction_count
+		FROM transaction_tags tt
+		JOIN tags tg ON tt.tag_id = tg.id AND tg.deleted_at IS NULL
+		JOIN transactions t ON tt.transaction_id = t.id
+		WHERE t.user_id = ?
+			AND t.date >= ?
+			AND t.date <= ?
+			AND t.amount < 0
+			AND t.type <> 'transfer'
+			AND t.deleted_at IS NULL
+			%s
+		GROUP BY tg.id, tg.name, tg.color
+		ORDER BY amount DESC
+	`, accountClause)
+
+	args := append([]interface{}{userID, startDate, endDate}, accountArgs...)
+	err := r.db.WithContext(ctx).
+		Raw(query, args...).
+		Scan(&results).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag breakdown: %w", err)
+	}
+
+	breakdown := make([]dashboard.RawTagBreakdown, len(results))
+	for i, res := range results {
+		breakdown[i] = dashboard.RawTagBreakdown{
+			TagID:            res.TagID,
+			TagName:          res.TagName,
+			TagColor:         res.TagColor,
+			Amount:           res.Amount,
+			TransactionCount: res.TransactionCount,
+		}
+	}
+
+	return breakdown, nil
+}
+
+// GetMerchantSpending returns spending per merchant and day for a period.
+func (r *dashboardRepository) GetMerchantSpending(
+	ctx context.Context,
+	userID uuid.UUID,
+	startDate, endDate time.Time,
+	accountIDs []uuid.UUID,
+) ([]dashboard.RawMerchantSpending, error) {
+	var results []struct {
+		MerchantID       uuid.UUID       `gorm:"column:merchant_id"`
+		MerchantName     string          `gorm:"column:merchant_name"`
+		Date             time.Time       `gorm:"column:date"`
+		Amount           decimal.Decimal `gorm:"column:amount"`
+		TransactionCount int             `gorm:"column:transaction_count"`
+	}
+
+	accountClause, accountArgs := accountFilterClause("t.account_id", accountIDs)
+
+	query := fmt.Sprintf(`
+		SELECT
+			m.id as merchant_id,
+			m.name as merchant_name,
+			t.date as date,
+			SUM(ABS(ROUND(t.amount * t.exchange_rate, 2))) as amount,
+			COUNT(*) as transaction_count
+		FROM transactions t
+		JOIN merchants m ON t.merchant_id = m.id AND m.deleted_at IS NULL
+		WHERE t.user_id = ?
+			AND t.date >= ?
+			AND t.date <= ?
+			AND t.amount < 0
+			AND t.type <> 'transfer'
+			AND t.deleted_at IS NULL
+			%s
+		GROUP BY m.id, m.name, t.date
+		ORDER BY t.date ASC
+	`, accountClause)
+
+	args := append([]interface{}{userID, startDate, endDate}, accountArgs...)
+	err := r.db.WithContext(ctx).
+		Raw(query, args...).
+		Scan(&results).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get merchant spending: %w", err)
+	}
+
+	spending := make([]dashboard.RawMerchantSpending, len(results))
+	for i, res := range results {
+		spending[i] = dashboard.RawMerchantSpending{
+			MerchantID:       res.MerchantID,
+			MerchantName:     res.MerchantName,
+			Date:             res.Date,
+			Amount:           res.Amount,
+			TransactionCount: res.TransactionCount,
+		}
+	}
+
+	return spending, nil
+}
+
 // GetTransactionsByPeriod returns transactions for a specific period.
 func (r *dashboardRepository) GetTransactionsByPeriod(
 	ctx context.Context,
 	userID uuid.UUID,
 	startDate, endDate time.Time,
 	categoryID *uuid.UUID,
+	accountIDs []uuid.UUID,
+	tagIDs []uuid.UUID,
+	sort valueobject.TransactionSort,
+	cursor *valueobject.TransactionCursor,
 	limit, offset int,
 ) ([]dashboard.PeriodTransaction, int, error) {
 	var results []struct {
@@ -194,6 +333,7 @@ func (r *dashboardRepository) GetTransactionsByPeriod(
 		CategoryName  *string         `gorm:"column:category_name"`
 		CategoryColor *string         `gorm:"column:category_color"`
 		CategoryIcon  *string         `gorm:"column:category_icon"`
+		CreatedAt     time.Time       `gorm:"column:created_at"`
 	}
 
 	// Build base query
@@ -207,17 +347,32 @@ func (r *dashboardRepository) GetTransactionsByPeriod(
 			t.category_id,
 			c.name as category_name,
 			c.color as category_color,
-			c.icon as category_icon
+			c.icon as category_icon,
+			t.created_at
 		`).
 		Joins("LEFT JOIN categories c ON t.category_id = c.id AND c.deleted_at IS NULL").
 		Where("t.user_id = ?", userID).
 		Where("t.date >= ?", startDate).
 		Where("t.date <= ?", endDate).
+		Where("t.type <> 'transfer'").
 		Where("t.deleted_at IS NULL")
 
-	// Apply optional category filter
+	// Apply optional category filter, matching split transactions with a line in the category
 	if categoryID != nil {
-		baseQuery = baseQuery.Where("t.category_id = ?", *categoryID)
+		baseQuery = baseQuery.Where(
+			"(t.category_id = ? OR t.id IN (SELECT transaction_id FROM transaction_splits WHERE category_id = ?))",
+			*categoryID, *categoryID,
+		)
+	}
+
+	// Apply optional account filter
+	if len(accountIDs) > 0 {
+		baseQuery = baseQuery.Where("t.account_id IN ?", accountIDs)
+	}
+
+	// Apply optional tag filter
+	if len(tagIDs) > 0 {
+		baseQuery = baseQuery.Where("t.id IN (SELECT transaction_id FROM transaction_tags WHERE tag_id IN ?)", tagIDs)
 	}
 
 	// Count total
@@ -227,11 +382,16 @@ func (r *dashboardRepository) GetTransactionsByPeriod(
 		return nil, 0, fmt.Errorf("failed to count transactions: %w", countErr)
 	}
 
-	// Get paginated results
-	err := baseQuery.
-		Order("t.date DESC, t.created_at DESC").
+	// Get paginated results, after the cursor transaction when there is one
+	pageQuery, err := applyTransactionSort(baseQuery, "t", sort, cursor)
+	if err != nil {
+		return nil, 0, err
+	}
+	if cursor == nil {
+		pageQuery = pageQuery.Offset(offset)
+	}
+	err = pageQuery.
 		Limit(limit).
-		Offset(offset).
 		Scan(&results).Error
 
 	if err != nil {
@@ -249,6 +409,7 @@ func (r *dashboardRepository) GetTransactionsByPeriod(
 			CategoryName:  res.CategoryName,
 			CategoryColor: res.CategoryColor,
 			CategoryIcon:  res.CategoryIcon,
+			CreatedAt:     res.CreatedAt,
 		}
 	}
 
@@ -260,6 +421,8 @@ func (r *dashboardRepository) GetPeriodSummary(
 	ctx context.Context,
 	userID uuid.UUID,
 	startDate, endDate time.Time,
+	accountIDs []uuid.UUID,
+	tagIDs []uuid.UUID,
 ) (*dashboard.PeriodSummary, error) {
 	var result struct {
 		TotalIncome      decimal.Decimal `gorm:"column:total_income"`
@@ -268,21 +431,29 @@ func (r *dashboardRepository) GetPeriodSummary(
 		TransactionCount int             `gorm:"column:transaction_count"`
 	}
 
-	query := `
+	accountClause, accountArgs := accountFilterClause("account_id", accountIDs)
+	tagClause, tagArgs := tagFilterClause("id", tagIDs)
+
+	query := fmt.Sprintf(`
 		SELECT
-			COALESCE(SUM(CASE WHEN amount > 0 THEN amount ELSE 0 END), 0) as total_income,
-			COALESCE(SUM(CASE WHEN amount < 0 THEN ABS(amount) ELSE 0 END), 0) as total_expenses,
-			COALESCE(SUM(amount), 0) as balance,
+			COALESCE(SUM(CASE WHEN amount > 0 THEN %[1]s ELSE 0 END), 0) as total_income,
+			COALESCE(SUM(CASE WHEN amount < 0 THEN ABS(%[1]s) ELSE 0 END), 0) as total_expenses,
+			COALESCE(SUM(%[1]s), 0) as balance,
 			COUNT(*) as transaction_count
 		FROM transactions
 		WHERE user_id = ?
 			AND date >= ?
 			AND date <= ?
+			AND type <> 'transfer'
 			AND deleted_at IS NULL
-	`
+			%[2]s
+			%[3]s
+	`, baseAmountSQL, accountClause, tagClause)
 
+	args := append([]interface{}{userID, startDate, endDate}, accountArgs...)
+	args = append(args, tagArgs...)
 	err := r.db.WithContext(ctx).
-		Raw(query, userID, startDate, endDate).
+		Raw(query, args...).
 		Scan(&result).Error
 
 	if err != nil {
@@ -296,3 +467,40 @@ func (r *dashboardRepository) GetPeriodSummary(
 		TransactionCount: result.TransactionCount,
 	}, nil
 }
+
+// GetBaseCurrency returns the currency the user's amounts are aggregated in.
+func (r *dashboardRepository) GetBaseCurrency(ctx context.Context, userID uuid.UUID) (string, error) {
+	var baseCurrency string
+	err := r.db.WithContext(ctx).
+		Table("users").
+		Select("base_currency").
+		Where("id = ?", userID).
+		Scan(&baseCurrency).Error
+
+	if err != nil {
+		return "", fmt.Errorf("failed to get base currency: %w", err)
+	}
+
+	if baseCurrency == "" {
+		return entity.DefaultCurrency, nil
+	}
+	return baseCurrency, nil
+}
+
+// accountFilterClause returns an extra WHERE condition (and its arguments) restricting the
+// query to the given accounts. No condition is returned when accountIDs is empty.
+func accountFilterClause(column string, accountIDs []uuid.UUID) (string, []interface{}) {
+	if len(accountIDs) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("AND %s IN ?", column), []interface{}{accountIDs}
+}
+
+// tagFilterClause returns an extra WHERE condition (and its arguments) restricting the query
+// to transactions with any of the given tags. No condition is returned when tagIDs is empty.
+func tagFilterClause(column string, tagIDs []uuid.UUID) (string, []interface{}) {
+	if len(tagIDs) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("AND %s IN (SELECT transaction_id FROM transaction_tags WHERE tag_id IN ?)", column), []interface{}{tagIDs}
+}
@@ -0,0 +1,71 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// duplicateDismissalRepository implements the adapter.DuplicateDismissalRepository interface.
+type duplicateDismissalRepository struct {
+	db *gorm.DB
+}
+
+// NewDuplicateDismissalRepository creates a new duplicate dismissal repository instance.
+func NewDuplicateDismissalRepository(db *gorm.DB) adapter.DuplicateDismissalRepository {
+	return &duplicateDismissalRepository{
+		db: db,
+	}
+}
+
+// Create records that a transaction pair is not a duplicate.
+func (r *duplicateDismissalRepository) Create(ctx context.Context, dismissal *entity.DuplicateDismissal) error {
+	dismissalModel := model.DuplicateDismissalFromEntity(dismissal)
+	result := r.db.WithContext(ctx).Create(dismissalModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// ExistsByPair checks if the pair was already dismissed.
+func (r *duplicateDismissalRepository) ExistsByPair(
+	ctx context.Context,
+	transactionID uuid.UUID,
+	duplicateID uuid.UUID,
+) (bool, error) {
+	first, second := entity.OrderedTransactionPair(transactionID, duplicateID)
+
+	var count int64
+	result := r.db.WithContext(ctx).
+		Model(&model.DuplicateDismissalModel{}).
+		Where("transaction_id = ? AND duplicate_id = ?", first, second).
+		Count(&count)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return count > 0, nil
+}
+
+// FindByUser retrieves all dismissed pairs for a user.
+func (r *duplicateDismissalRepository) FindByUser(ctx context.Context, userID uuid.UUID) ([]*entity.DuplicateDismissal, error) {
+	var dismissalModels []model.DuplicateDismissalModel
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Find(&dismissalModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	dismissals := make([]*entity.DuplicateDismissal, len(dismissalModels))
+	for i, dm := range dismissalModels {
+		dismissals[i] = dm.ToEntity()
+	}
+	return dismissals, nil
+}
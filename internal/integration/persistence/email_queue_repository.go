@@ -30,7 +30,7 @@ func NewEmailQueueRepository(db *gorm.DB) adapter.EmailQueueRepository {
 // Create adds a new email job to the queue.
 func (r *emailQueueRepository) Create(ctx context.Context, job *entity.EmailJob) error {
 	emailModel := model.EmailQueueModelFromEntity(job)
-	result := r.db.WithContext(ctx).Create(emailModel)
+	result := conn(ctx, r.db).Create(emailModel)
 	if result.Error != nil {
 		return domainerror.NewEmailError(
 			domainerror.ErrCodeEmailQueueFailed,
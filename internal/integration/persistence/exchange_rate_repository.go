@@ -0,0 +1,125 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// exchangeRateRepository implements the adapter.ExchangeRateRepository interface.
+type exchangeRateRepository struct {
+	db *gorm.DB
+}
+
+// NewExchangeRateRepository creates a new exchange rate repository instance.
+func NewExchangeRateRepository(db *gorm.DB) adapter.ExchangeRateRepository {
+	return &exchangeRateRepository{
+		db: db,
+	}
+}
+
+// Upsert saves the rates, replacing the rate of existing entries for the same user, pair and date.
+func (r *exchangeRateRepository) Upsert(ctx context.Context, rates []*entity.ExchangeRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, rate := range rates {
+			rateModel := model.ExchangeRateFromEntity(rate)
+			result := tx.Clauses(
+				clause.OnConflict{
+					Columns:   []clause.Column{{Name: "user_id"}, {Name: "from_currency"}, {Name: "to_currency"}, {Name: "date"}},
+					DoUpdates: clause.AssignmentColumns([]string{"rate", "source", "updated_at"}),
+				},
+				clause.Returning{Columns: []clause.Column{{Name: "id"}, {Name: "created_at"}}},
+			).Create(rateModel)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			rate.ID = rateModel.ID
+			rate.CreatedAt = rateModel.CreatedAt
+		}
+		return nil
+	})
+}
+
+// FindByID retrieves an exchange rate by its ID.
+func (r *exchangeRateRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ExchangeRate, error) {
+	var rateModel model.ExchangeRateModel
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&rateModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domainerror.ErrExchangeRateNotFound
+		}
+		return nil, result.Error
+	}
+	return rateModel.ToEntity(), nil
+}
+
+// FindByUser retrieves the user's rates, newest first, optionally restricted to a currency.
+func (r *exchangeRateRepository) FindByUser(ctx context.Context, userID uuid.UUID, currency string) ([]*entity.ExchangeRate, error) {
+	var rateModels []model.ExchangeRateModel
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if currency != "" {
+		query = query.Where("from_currency = ? OR to_currency = ?", currency, currency)
+	}
+
+	result := query.Order("date DESC, from_currency ASC, to_currency ASC").Find(&rateModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	rates := make([]*entity.ExchangeRate, len(rateModels))
+	for i, rm := range rateModels {
+		rates[i] = rm.ToEntity()
+	}
+	return rates, nil
+}
+
+// FindLatest retrieves the most recent rate on or before date between the two currencies, in either direction.
+func (r *exchangeRateRepository) FindLatest(
+	ctx context.Context,
+	userID uuid.UUID,
+	fromCurrency, toCurrency string,
+	date time.Time,
+) (*entity.ExchangeRate, error) {
+	var rateModel model.ExchangeRateModel
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Where("(from_currency = ? AND to_currency = ?) OR (from_currency = ? AND to_currency = ?)",
+			fromCurrency, toCurrency, toCurrency, fromCurrency).
+		Where("date <= ?", date).
+		Order("date DESC").
+		First(&rateModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domainerror.ErrExchangeRateUnavailable
+		}
+		return nil, result.Error
+	}
+	return rateModel.ToEntity(), nil
+}
+
+// Delete removes an exchange rate from the database.
+func (r *exchangeRateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.ExchangeRateModel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerror.ErrExchangeRateNotFound
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// goalAlertRepository implements the adapter.GoalAlertRepository interface.
+type goalAlertRepository struct {
+	db *gorm.DB
+}
+
+// NewGoalAlertRepository creates a new goal alert repository instance.
+func NewGoalAlertRepository(db *gorm.DB) adapter.GoalAlertRepository {
+	return &goalAlertRepository{
+		db: db,
+	}
+}
+
+// CreateIfNotExists records the alert unless one already exists for the same goal,
+// threshold and period. The unique index makes concurrent evaluations safe.
+func (r *goalAlertRepository) CreateIfNotExists(ctx context.Context, alert *entity.GoalAlert) (bool, error) {
+	alertModel := model.GoalAlertFromEntity(alert)
+	result := conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "goal_id"}, {Name: "threshold"}, {Name: "period_start"}},
+			DoNothing: true,
+		}).
+		Create(alertModel)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
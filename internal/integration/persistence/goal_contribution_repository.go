@@ -0,0 +1,90 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// goalContributionRepository implements the adapter.GoalContributionRepository interface.
+type goalContributionRepository struct {
+	db *gorm.DB
+}
+
+// NewGoalContributionRepository creates a new goal contribution repository instance.
+func NewGoalContributionRepository(db *gorm.DB) adapter.GoalContributionRepository {
+	return &goalContributionRepository{
+		db: db,
+	}
+}
+
+// Create creates a new contribution in the database.
+func (r *goalContributionRepository) Create(ctx context.Context, contribution *entity.GoalContribution) error {
+	contributionModel := model.GoalContributionFromEntity(contribution)
+	result := r.db.WithContext(ctx).Create(contributionModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// FindByID retrieves a contribution by its ID.
+func (r *goalContributionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.GoalContribution, error) {
+	var contributionModel model.GoalContributionModel
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&contributionModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domainerror.ErrGoalContributionNotFound
+		}
+		return nil, result.Error
+	}
+	return contributionModel.ToEntity(), nil
+}
+
+// FindByGoalID retrieves all contributions for a goal, oldest first.
+func (r *goalContributionRepository) FindByGoalID(ctx context.Context, goalID uuid.UUID) ([]*entity.GoalContribution, error) {
+	var contributionModels []model.GoalContributionModel
+	result := r.db.WithContext(ctx).
+		Where("goal_id = ?", goalID).
+		Order("date ASC, created_at ASC").
+		Find(&contributionModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	contributions := make([]*entity.GoalContribution, len(contributionModels))
+	for i, cm := range contributionModels {
+		contributions[i] = cm.ToEntity()
+	}
+	return contributions, nil
+}
+
+// ExistsByGoalAndTransaction checks if the transaction already contributes to the goal.
+func (r *goalContributionRepository) ExistsByGoalAndTransaction(ctx context.Context, goalID, transactionID uuid.UUID) (bool, error) {
+	var count int64
+	result := r.db.WithContext(ctx).
+		Model(&model.GoalContributionModel{}).
+		Where("goal_id = ? AND transaction_id = ?", goalID, transactionID).
+		Count(&count)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return count > 0, nil
+}
+
+// Delete removes a contribution from the database (soft delete).
+func (r *goalContributionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&model.GoalContributionModel{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
@@ -107,7 +107,7 @@ func (r *goalRepository) ExistsByUserAndCategory(ctx context.Context, userID, ca
 	var count int64
 	result := r.db.WithContext(ctx).
 		Model(&model.GoalModel{}).
-		Where("user_id = ? AND category_id = ?", userID, categoryID).
+		Where("user_id = ? AND category_id = ? AND kind = ?", userID, categoryID, entity.GoalKindSpendingLimit).
 		Count(&count)
 	if result.Error != nil {
 		return false, result.Error
@@ -119,13 +119,15 @@ func (r *goalRepository) ExistsByUserAndCategory(ctx context.Context, userID, ca
 func (r *goalRepository) GetCurrentSpending(ctx context.Context, categoryID uuid.UUID, startDate, endDate time.Time) (float64, error) {
 	var total float64
 
-	// Query transactions for this category within the date range
+	// Query transactions for this category within the date range, counting split lines in the category
 	// Only count expense transactions (negative amounts or expense type categories)
 	result := r.db.WithContext(ctx).
-		Model(&model.TransactionModel{}).
-		Select("COALESCE(SUM(ABS(amount)), 0)").
+		Table(categorizedTransactionsSQL+" AS t").
+		Select("COALESCE(SUM(ABS("+baseAmountSQL+")), 0)").
 		Where("category_id = ?", categoryID).
+		Where("type <> ?", string(entity.TransactionTypeTransfer)).
 		Where("date >= ? AND date <= ?", startDate, endDate).
+		Where("deleted_at IS NULL").
 		Scan(&total)
 
 	if result.Error != nil {
@@ -134,3 +136,21 @@ func (r *goalRepository) GetCurrentSpending(ctx context.Context, categoryID uuid
 
 	return total, nil
 }
+
+// FindWithAlertsEnabled retrieves all spending limit goals with alert on exceed enabled.
+func (r *goalRepository) FindWithAlertsEnabled(ctx context.Context) ([]*entity.Goal, error) {
+	var goalModels []model.GoalModel
+	result := r.db.WithContext(ctx).
+		Where("alert_on_exceed = ? AND kind = ?", true, entity.GoalKindSpendingLimit).
+		Order("user_id ASC").
+		Find(&goalModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	goals := make([]*entity.Goal, len(goalModels))
+	for i, gm := range goalModels {
+		goals[i] = gm.ToEntity()
+	}
+	return goals, nil
+}
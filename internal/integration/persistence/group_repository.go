@@ -420,7 +420,7 @@ func (r *groupRepository) getGroupCategoryBreakdown(ctx context.Context, groupID
 			COALESCE(c.name, 'Sem categoria') as category_name,
 			COALESCE(c.color, '#9CA3AF') as category_color,
 			COALESCE(SUM(ABS(t.amount)), 0) as amount
-		FROM transactions t
+		FROM ` + categorizedTransactionsSQL + ` AS t
 		LEFT JOIN categories c ON c.id = t.category_id
 		WHERE t.user_id IN (SELECT user_id FROM group_members WHERE group_id = ?)
 		  AND t.type = 'expense'
@@ -571,6 +571,7 @@ func (r *groupRepository) getGroupRecentTransactions(ctx context.Context, groupI
 		FROM transactions t
 		LEFT JOIN categories c ON c.id = t.category_id
 		WHERE t.user_id IN (SELECT user_id FROM group_members WHERE group_id = ?)
+		  AND t.type <> 'transfer'
 		  AND t.deleted_at IS NULL
 		ORDER BY t.date DESC, t.created_at DESC
 		LIMIT ?
@@ -0,0 +1,114 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// importProfileRepository implements the adapter.ImportProfileRepository interface.
+type importProfileRepository struct {
+	db *gorm.DB
+}
+
+// NewImportProfileRepository creates a new import profile repository instance.
+func NewImportProfileRepository(db *gorm.DB) adapter.ImportProfileRepository {
+	return &importProfileRepository{
+		db: db,
+	}
+}
+
+// Create creates a new import profile in the database.
+func (r *importProfileRepository) Create(ctx context.Context, profile *entity.ImportProfile) error {
+	profileModel := model.ImportProfileFromEntity(profile)
+	result := r.db.WithContext(ctx).Create(profileModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// FindByID retrieves an import profile by its ID.
+func (r *importProfileRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.ImportProfile, error) {
+	var profileModel model.ImportProfileModel
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&profileModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domainerror.ErrImportProfileNotFound
+		}
+		return nil, result.Error
+	}
+	return profileModel.ToEntity(), nil
+}
+
+// FindByUser retrieves all import profiles for a user, sorted by name.
+func (r *importProfileRepository) FindByUser(ctx context.Context, userID uuid.UUID) ([]*entity.ImportProfile, error) {
+	var profileModels []model.ImportProfileModel
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("name ASC").
+		Find(&profileModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	profiles := make([]*entity.ImportProfile, len(profileModels))
+	for i, pm := range profileModels {
+		profiles[i] = pm.ToEntity()
+	}
+	return profiles, nil
+}
+
+// ExistsByNameAndUser checks if the user already has a profile with the given name (case-insensitive).
+func (r *importProfileRepository) ExistsByNameAndUser(
+	ctx context.Context,
+	name string,
+	userID uuid.UUID,
+	excludeID *uuid.UUID,
+) (bool, error) {
+	var count int64
+	query := r.db.WithContext(ctx).
+		Model(&model.ImportProfileModel{}).
+		Where("user_id = ?", userID).
+		Where("LOWER(name) = ?", strings.ToLower(name))
+
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Update updates an existing import profile in the database.
+func (r *importProfileRepository) Update(ctx context.Context, profile *entity.ImportProfile) error {
+	profileModel := model.ImportProfileFromEntity(profile)
+	result := r.db.WithContext(ctx).Save(profileModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// Delete soft-deletes an import profile from the database.
+func (r *importProfileRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&model.ImportProfileModel{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerror.ErrImportProfileNotFound
+	}
+	return nil
+}
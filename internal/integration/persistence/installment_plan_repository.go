@@ -0,0 +1,134 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// installmentPlanRepository implements the adapter.InstallmentPlanRepository interface.
+type installmentPlanRepository struct {
+	db *gorm.DB
+}
+
+// NewInstallmentPlanRepository creates a new installment plan repository instance.
+func NewInstallmentPlanRepository(db *gorm.DB) adapter.InstallmentPlanRepository {
+	return &installmentPlanRepository{
+		db: db,
+	}
+}
+
+// Create creates a new installment plan in the database.
+func (r *installmentPlanRepository) Create(ctx context.Context, plan *entity.InstallmentPlan) error {
+	planModel := model.InstallmentPlanFromEntity(plan)
+	result := conn(ctx, r.db).Create(planModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// FindByID retrieves an installment plan by its ID.
+func (r *installmentPlanRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.InstallmentPlan, error) {
+	var planModel model.InstallmentPlanModel
+	result := conn(ctx, r.db).Where("id = ?", id).First(&planModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domainerror.ErrInstallmentPlanNotFound
+		}
+		return nil, result.Error
+	}
+	return planModel.ToEntity(), nil
+}
+
+// FindByUser retrieves the user's installment plans, oldest purchase first.
+func (r *installmentPlanRepository) FindByUser(ctx context.Context, userID uuid.UUID) ([]*entity.InstallmentPlan, error) {
+	var planModels []model.InstallmentPlanModel
+	result := conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("first_billing_cycle ASC, description ASC").
+		Find(&planModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	plans := make([]*entity.InstallmentPlan, len(planModels))
+	for i, pm := range planModels {
+		plans[i] = pm.ToEntity()
+	}
+	return plans, nil
+}
+
+// FindByAccount retrieves the user's installment plans of a credit card, oldest purchase first.
+func (r *installmentPlanRepository) FindByAccount(ctx context.Context, userID uuid.UUID, accountID *uuid.UUID) ([]*entity.InstallmentPlan, error) {
+	query := conn(ctx, r.db).Where("user_id = ?", userID)
+	if accountID != nil {
+		query = query.Where("account_id = ?", *accountID)
+	} else {
+		query = query.Where("account_id IS NULL")
+	}
+
+	var planModels []model.InstallmentPlanModel
+	result := query.
+		Order("first_billing_cycle ASC, description ASC").
+		Find(&planModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	plans := make([]*entity.InstallmentPlan, len(planModels))
+	for i, pm := range planModels {
+		plans[i] = pm.ToEntity()
+	}
+	return plans, nil
+}
+
+// FindRecordedInstallments returns the installment numbers recorded by the user's transactions
+// for each plan, in ascending order.
+func (r *installmentPlanRepository) FindRecordedInstallments(ctx context.Context, userID uuid.UUID) (map[uuid.UUID][]int, error) {
+	var rows []struct {
+		InstallmentPlanID  uuid.UUID
+		InstallmentCurrent int
+	}
+	result := conn(ctx, r.db).
+		Model(&model.TransactionModel{}).
+		Select("installment_plan_id, installment_current").
+		Where("user_id = ? AND installment_plan_id IS NOT NULL AND installment_current IS NOT NULL", userID).
+		Order("installment_current ASC").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	recorded := make(map[uuid.UUID][]int)
+	for _, row := range rows {
+		recorded[row.InstallmentPlanID] = append(recorded[row.InstallmentPlanID], row.InstallmentCurrent)
+	}
+	return recorded, nil
+}
+
+// FindInstallments retrieves the transactions of a plan, ordered by installment number.
+func (r *installmentPlanRepository) FindInstallments(ctx context.Context, planID uuid.UUID) ([]*entity.Transaction, error) {
+	var transactionModels []model.TransactionModel
+	result := conn(ctx, r.db).
+		Where("installment_plan_id = ?", planID).
+		Order("installment_current ASC, date ASC").
+		Find(&transactionModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, tm := range transactionModels {
+		transactions[i] = tm.ToEntity()
+	}
+	return transactions, nil
+}
@@ -0,0 +1,148 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// merchantRepository implements the adapter.MerchantRepository interface.
+type merchantRepository struct {
+	db *gorm.DB
+}
+
+// NewMerchantRepository creates a new merchant repository instance.
+func NewMerchantRepository(db *gorm.DB) adapter.MerchantRepository {
+	return &merchantRepository{
+		db: db,
+	}
+}
+
+// CreateOrGet creates a new merchant in the database, or returns the user's merchant with the same key.
+func (r *merchantRepository) CreateOrGet(ctx context.Context, merchant *entity.Merchant) (*entity.Merchant, error) {
+	merchantModel := model.MerchantFromEntity(merchant)
+	result := conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:     []clause.Column{{Name: "user_id"}, {Name: "key"}},
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+			DoNothing:   true,
+		}).
+		Create(merchantModel)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return merchant, nil
+	}
+
+	var existing model.MerchantModel
+	result = conn(ctx, r.db).
+		Where("user_id = ? AND key = ?", merchant.UserID, merchant.Key).
+		First(&existing)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return existing.ToEntity(), nil
+}
+
+// FindByID retrieves a merchant by its ID.
+func (r *merchantRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Merchant, error) {
+	var merchantModel model.MerchantModel
+	result := conn(ctx, r.db).Where("id = ?", id).First(&merchantModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domainerror.ErrMerchantNotFound
+		}
+		return nil, result.Error
+	}
+	return merchantModel.ToEntity(), nil
+}
+
+// FindByUser retrieves the user's merchants sorted by name.
+func (r *merchantRepository) FindByUser(ctx context.Context, userID uuid.UUID) ([]*entity.Merchant, error) {
+	var merchantModels []model.MerchantModel
+	result := conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("name ASC").
+		Find(&merchantModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	merchants := make([]*entity.Merchant, len(merchantModels))
+	for i, mm := range merchantModels {
+		merchants[i] = mm.ToEntity()
+	}
+	return merchants, nil
+}
+
+// CountTransactions returns the number of the user's transactions assigned to each merchant.
+func (r *merchantRepository) CountTransactions(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]int64, error) {
+	var rows []struct {
+		MerchantID uuid.UUID
+		Count      int64
+	}
+	result := conn(ctx, r.db).
+		Model(&model.TransactionModel{}).
+		Select("merchant_id, COUNT(*) AS count").
+		Where("user_id = ? AND merchant_id IS NOT NULL", userID).
+		Group("merchant_id").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	counts := make(map[uuid.UUID]int64, len(rows))
+	for _, row := range rows {
+		counts[row.MerchantID] = row.Count
+	}
+	return counts, nil
+}
+
+// Update updates an existing merchant in the database.
+func (r *merchantRepository) Update(ctx context.Context, merchant *entity.Merchant) error {
+	merchantModel := model.MerchantFromEntity(merchant)
+	result := conn(ctx, r.db).Save(merchantModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// Merge saves the target merchant, moves the transactions of the source merchants to it and
+// soft-deletes the sources, atomically.
+func (r *merchantRepository) Merge(ctx context.Context, target *entity.Merchant, sourceIDs []uuid.UUID) (int64, error) {
+	var moved int64
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(model.MerchantFromEntity(target)).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&model.TransactionModel{}).
+			Where("user_id = ? AND merchant_id IN ?", target.UserID, sourceIDs).
+			Updates(map[string]interface{}{
+				"merchant_id": target.ID,
+				"updated_at":  time.Now().UTC(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		moved = result.RowsAffected
+
+		return tx.Delete(&model.MerchantModel{}, "id IN ?", sourceIDs).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return moved, nil
+}
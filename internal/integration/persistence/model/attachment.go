@@ -0,0 +1,96 @@
+// Package model defines database models for persistence layer.
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// AttachmentModel represents the attachments table in the database.
+type AttachmentModel struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID       uuid.UUID `gorm:"type:uuid;not null;index"`
+	OwnerType    string    `gorm:"type:varchar(20);not null;index:idx_attachments_owner"`
+	OwnerID      uuid.UUID `gorm:"type:uuid;not null;index:idx_attachments_owner"`
+	FileName     string    `gorm:"type:varchar(255);not null"`
+	ContentType  string    `gorm:"type:varchar(100);not null"`
+	SizeBytes    int64     `gorm:"not null;default:0"`
+	Checksum     *string   `gorm:"type:varchar(64)"`
+	StorageKey   string    `gorm:"type:varchar(500);not null"`
+	ThumbnailKey *string   `gorm:"type:varchar(500)"`
+	ImageWidth   *int
+	ImageHeight  *int
+	Status       string    `gorm:"type:varchar(20);not null;default:'pending'"`
+	CreatedAt    time.Time `gorm:"not null"`
+	UpdatedAt    time.Time `gorm:"not null"`
+}
+
+// TableName returns the table name for the AttachmentModel.
+func (AttachmentModel) TableName() string {
+	return "attachments"
+}
+
+// ToEntity converts an AttachmentModel to a domain Attachment entity.
+func (m *AttachmentModel) ToEntity() *entity.Attachment {
+	var checksum string
+	if m.Checksum != nil {
+		checksum = *m.Checksum
+	}
+
+	var thumbnailKey string
+	if m.ThumbnailKey != nil {
+		thumbnailKey = *m.ThumbnailKey
+	}
+
+	return &entity.Attachment{
+		ID:           m.ID,
+		UserID:       m.UserID,
+		OwnerType:    entity.AttachmentOwnerType(m.OwnerType),
+		OwnerID:      m.OwnerID,
+		FileName:     m.FileName,
+		ContentType:  m.ContentType,
+		SizeBytes:    m.SizeBytes,
+		Checksum:     checksum,
+		StorageKey:   m.StorageKey,
+		ThumbnailKey: thumbnailKey,
+		ImageWidth:   m.ImageWidth,
+		ImageHeight:  m.ImageHeight,
+		Status:       entity.AttachmentStatus(m.Status),
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+// AttachmentFromEntity creates an AttachmentModel from a domain Attachment entity.
+func AttachmentFromEntity(attachment *entity.Attachment) *AttachmentModel {
+	var checksum *string
+	if attachment.Checksum != "" {
+		checksum = &attachment.Checksum
+	}
+
+	var thumbnailKey *string
+	if attachment.ThumbnailKey != "" {
+		thumbnailKey = &attachment.ThumbnailKey
+	}
+
+	return &AttachmentModel{
+		ID:           attachment.ID,
+		UserID:       attachment.UserID,
+		OwnerType:    string(attachment.OwnerType),
+		OwnerID:      attachment.OwnerID,
+		FileName:     attachment.FileName,
+		ContentType:  attachment.ContentType,
+		SizeBytes:    attachment.SizeBytes,
+		Checksum:     checksum,
+		StorageKey:   attachment.StorageKey,
+		ThumbnailKey: thumbnailKey,
+		ImageWidth:   attachment.ImageWidth,
+		ImageHeight:  attachment.ImageHeight,
+		Status:       string(attachment.Status),
+		CreatedAt:    attachment.CreatedAt,
+		UpdatedAt:    attachment.UpdatedAt,
+	}
+}
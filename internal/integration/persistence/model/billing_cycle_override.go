@@ -0,0 +1,52 @@
+// Package model defines database models for persistence layer.
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// BillingCycleOverrideModel represents the billing_cycle_overrides table in the database.
+type BillingCycleOverrideModel struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	AccountID    uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_billing_cycle_overrides_account_cycle"`
+	BillingCycle string     `gorm:"type:varchar(7);not null;uniqueIndex:idx_billing_cycle_overrides_account_cycle"`
+	ClosingDate  *time.Time `gorm:"type:date"`
+	DueDate      *time.Time `gorm:"type:date"`
+	CreatedAt    time.Time  `gorm:"not null"`
+	UpdatedAt    time.Time  `gorm:"not null"`
+}
+
+// TableName returns the table name for the BillingCycleOverrideModel.
+func (BillingCycleOverrideModel) TableName() string {
+	return "billing_cycle_overrides"
+}
+
+// ToEntity converts a BillingCycleOverrideModel to a domain BillingCycleOverride entity.
+func (m *BillingCycleOverrideModel) ToEntity() *entity.BillingCycleOverride {
+	return &entity.BillingCycleOverride{
+		ID:           m.ID,
+		AccountID:    m.AccountID,
+		BillingCycle: m.BillingCycle,
+		ClosingDate:  m.ClosingDate,
+		DueDate:      m.DueDate,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+// BillingCycleOverrideFromEntity creates a BillingCycleOverrideModel from a domain BillingCycleOverride entity.
+func BillingCycleOverrideFromEntity(override *entity.BillingCycleOverride) *BillingCycleOverrideModel {
+	return &BillingCycleOverrideModel{
+		ID:           override.ID,
+		AccountID:    override.AccountID,
+		BillingCycle: override.BillingCycle,
+		ClosingDate:  override.ClosingDate,
+		DueDate:      override.DueDate,
+		CreatedAt:    override.CreatedAt,
+		UpdatedAt:    override.UpdatedAt,
+	}
+}
@@ -0,0 +1,46 @@
+// Package model defines database models for persistence layer.
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// DuplicateDismissalModel represents the transaction_duplicate_dismissals table in the database.
+type DuplicateDismissalModel struct {
+	ID            uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID        uuid.UUID `gorm:"type:uuid;not null;index"`
+	TransactionID uuid.UUID `gorm:"type:uuid;not null"`
+	DuplicateID   uuid.UUID `gorm:"type:uuid;not null"`
+	CreatedAt     time.Time `gorm:"not null"`
+}
+
+// TableName returns the table name for the DuplicateDismissalModel.
+func (DuplicateDismissalModel) TableName() string {
+	return "transaction_duplicate_dismissals"
+}
+
+// ToEntity converts a DuplicateDismissalModel to a domain DuplicateDismissal entity.
+func (m *DuplicateDismissalModel) ToEntity() *entity.DuplicateDismissal {
+	return &entity.DuplicateDismissal{
+		ID:            m.ID,
+		UserID:        m.UserID,
+		TransactionID: m.TransactionID,
+		DuplicateID:   m.DuplicateID,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+// DuplicateDismissalFromEntity converts a domain DuplicateDismissal entity to a DuplicateDismissalModel.
+func DuplicateDismissalFromEntity(d *entity.DuplicateDismissal) *DuplicateDismissalModel {
+	return &DuplicateDismissalModel{
+		ID:            d.ID,
+		UserID:        d.UserID,
+		TransactionID: d.TransactionID,
+		DuplicateID:   d.DuplicateID,
+		CreatedAt:     d.CreatedAt,
+	}
+}
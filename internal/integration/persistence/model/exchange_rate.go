@@ -0,0 +1,59 @@
+// Package model defines database models for persistence layer.
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ExchangeRateModel represents the exchange_rates table in the database.
+type ExchangeRateModel struct {
+	ID           uuid.UUID       `gorm:"type:uuid;primaryKey"`
+	UserID       uuid.UUID       `gorm:"type:uuid;not null;uniqueIndex:idx_exchange_rates_user_pair_date"`
+	FromCurrency string          `gorm:"type:varchar(3);not null;uniqueIndex:idx_exchange_rates_user_pair_date"`
+	ToCurrency   string          `gorm:"type:varchar(3);not null;uniqueIndex:idx_exchange_rates_user_pair_date"`
+	Date         time.Time       `gorm:"type:date;not null;uniqueIndex:idx_exchange_rates_user_pair_date"`
+	Rate         decimal.Decimal `gorm:"type:decimal(18,8);not null"`
+	Source       string          `gorm:"type:varchar(10);not null;default:'manual'"`
+	CreatedAt    time.Time       `gorm:"not null"`
+	UpdatedAt    time.Time       `gorm:"not null"`
+}
+
+// TableName returns the table name for the ExchangeRateModel.
+func (ExchangeRateModel) TableName() string {
+	return "exchange_rates"
+}
+
+// ToEntity converts an ExchangeRateModel to a domain ExchangeRate entity.
+func (m *ExchangeRateModel) ToEntity() *entity.ExchangeRate {
+	return &entity.ExchangeRate{
+		ID:           m.ID,
+		UserID:       m.UserID,
+		FromCurrency: m.FromCurrency,
+		ToCurrency:   m.ToCurrency,
+		Rate:         m.Rate,
+		Date:         m.Date,
+		Source:       entity.ExchangeRateSource(m.Source),
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+// ExchangeRateFromEntity creates an ExchangeRateModel from a domain ExchangeRate entity.
+func ExchangeRateFromEntity(rate *entity.ExchangeRate) *ExchangeRateModel {
+	return &ExchangeRateModel{
+		ID:           rate.ID,
+		UserID:       rate.UserID,
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Rate:         rate.Rate,
+		Date:         rate.Date,
+		Source:       string(rate.Source),
+		CreatedAt:    rate.CreatedAt,
+		UpdatedAt:    rate.UpdatedAt,
+	}
+}
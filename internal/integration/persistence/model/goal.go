@@ -14,12 +14,16 @@ import (
 type GoalModel struct {
 	ID            uuid.UUID      `gorm:"type:uuid;primaryKey"`
 	UserID        uuid.UUID      `gorm:"type:uuid;not null;index"`
-	CategoryID    uuid.UUID      `gorm:"type:uuid;not null;index"`
+	Kind          string         `gorm:"type:varchar(20);not null;default:'spending_limit'"`
+	CategoryID    *uuid.UUID     `gorm:"type:uuid;index"` // Null for savings goals
 	LimitAmount   float64        `gorm:"type:decimal(15,2);not null"`
 	AlertOnExceed bool           `gorm:"not null;default:true"`
-	Period        string         `gorm:"type:varchar(20);not null;default:'monthly'"`
+	Period        *string        `gorm:"type:varchar(20)"` // Null for savings goals
 	StartDate     *time.Time     `gorm:"type:date"`
 	EndDate       *time.Time     `gorm:"type:date"`
+	Name          string         `gorm:"type:varchar(100)"`
+	TargetAmount  float64        `gorm:"type:decimal(15,2);not null;default:0"`
+	TargetDate    *time.Time     `gorm:"type:date"`
 	CreatedAt     time.Time      `gorm:"not null"`
 	UpdatedAt     time.Time      `gorm:"not null"`
 	DeletedAt     gorm.DeletedAt `gorm:"index"` // Soft-delete support
@@ -37,18 +41,37 @@ func (m *GoalModel) ToEntity() *entity.Goal {
 		deletedAt = &m.DeletedAt.Time
 	}
 
+	var categoryID uuid.UUID
+	if m.CategoryID != nil {
+		categoryID = *m.CategoryID
+	}
+
+	var period entity.GoalPeriod
+	if m.Period != nil {
+		period = entity.GoalPeriod(*m.Period)
+	}
+
+	kind := entity.GoalKind(m.Kind)
+	if kind == "" {
+		kind = entity.GoalKindSpendingLimit
+	}
+
 	return &entity.Goal{
 		ID:            m.ID,
 		UserID:        m.UserID,
-		CategoryID:    m.CategoryID,
+		Kind:          kind,
+		CategoryID:    categoryID,
 		LimitAmount:   m.LimitAmount,
 		AlertOnExceed: m.AlertOnExceed,
-		Period:        entity.GoalPeriod(m.Period),
+		Period:        period,
 		StartDate:     m.StartDate,
 		EndDate:       m.EndDate,
 		CreatedAt:     m.CreatedAt,
 		UpdatedAt:     m.UpdatedAt,
 		DeletedAt:     deletedAt,
+		Name:          m.Name,
+		TargetAmount:  m.TargetAmount,
+		TargetDate:    m.TargetDate,
 	}
 }
 
@@ -59,15 +82,36 @@ func GoalFromEntity(goal *entity.Goal) *GoalModel {
 		deletedAt = gorm.DeletedAt{Time: *goal.DeletedAt, Valid: true}
 	}
 
+	var categoryID *uuid.UUID
+	if goal.CategoryID != uuid.Nil {
+		id := goal.CategoryID
+		categoryID = &id
+	}
+
+	var period *string
+	if goal.Period != "" {
+		p := string(goal.Period)
+		period = &p
+	}
+
+	kind := goal.Kind
+	if kind == "" {
+		kind = entity.GoalKindSpendingLimit
+	}
+
 	return &GoalModel{
 		ID:            goal.ID,
 		UserID:        goal.UserID,
-		CategoryID:    goal.CategoryID,
+		Kind:          string(kind),
+		CategoryID:    categoryID,
 		LimitAmount:   goal.LimitAmount,
 		AlertOnExceed: goal.AlertOnExceed,
-		Period:        string(goal.Period),
+		Period:        period,
 		StartDate:     goal.StartDate,
 		EndDate:       goal.EndDate,
+		Name:          goal.Name,
+		TargetAmount:  goal.TargetAmount,
+		TargetDate:    goal.TargetDate,
 		CreatedAt:     goal.CreatedAt,
 		UpdatedAt:     goal.UpdatedAt,
 		DeletedAt:     deletedAt,
@@ -0,0 +1,55 @@
+// Package model defines database models for persistence layer.
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// GoalAlertModel represents the goal_alerts table in the database.
+type GoalAlertModel struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	GoalID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_goal_alerts_period,priority:1"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	Threshold   string    `gorm:"type:varchar(20);not null;uniqueIndex:idx_goal_alerts_period,priority:2"`
+	PeriodStart time.Time `gorm:"type:date;not null;uniqueIndex:idx_goal_alerts_period,priority:3"`
+	SpentAmount float64   `gorm:"type:decimal(15,2);not null"`
+	LimitAmount float64   `gorm:"type:decimal(15,2);not null"`
+	CreatedAt   time.Time `gorm:"not null"`
+}
+
+// TableName returns the table name for the GoalAlertModel.
+func (GoalAlertModel) TableName() string {
+	return "goal_alerts"
+}
+
+// ToEntity converts a GoalAlertModel to a domain GoalAlert entity.
+func (m *GoalAlertModel) ToEntity() *entity.GoalAlert {
+	return &entity.GoalAlert{
+		ID:          m.ID,
+		GoalID:      m.GoalID,
+		UserID:      m.UserID,
+		Threshold:   entity.GoalAlertThreshold(m.Threshold),
+		PeriodStart: m.PeriodStart,
+		SpentAmount: m.SpentAmount,
+		LimitAmount: m.LimitAmount,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+// GoalAlertFromEntity converts a domain GoalAlert entity to a GoalAlertModel.
+func GoalAlertFromEntity(a *entity.GoalAlert) *GoalAlertModel {
+	return &GoalAlertModel{
+		ID:          a.ID,
+		GoalID:      a.GoalID,
+		UserID:      a.UserID,
+		Threshold:   string(a.Threshold),
+		PeriodStart: a.PeriodStart,
+		SpentAmount: a.SpentAmount,
+		LimitAmount: a.LimitAmount,
+		CreatedAt:   a.CreatedAt,
+	}
+}
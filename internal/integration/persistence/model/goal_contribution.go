@@ -0,0 +1,72 @@
+// Package model defines database models for persistence layer.
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// GoalContributionModel represents the goal_contributions table in the database.
+type GoalContributionModel struct {
+	ID            uuid.UUID      `gorm:"type:uuid;primaryKey"`
+	GoalID        uuid.UUID      `gorm:"type:uuid;not null;index"`
+	UserID        uuid.UUID      `gorm:"type:uuid;not null;index"`
+	TransactionID *uuid.UUID     `gorm:"type:uuid;index"`
+	Amount        float64        `gorm:"type:decimal(15,2);not null"`
+	Date          time.Time      `gorm:"type:date;not null"`
+	Notes         string         `gorm:"type:text"`
+	CreatedAt     time.Time      `gorm:"not null"`
+	UpdatedAt     time.Time      `gorm:"not null"`
+	DeletedAt     gorm.DeletedAt `gorm:"index"` // Soft-delete support
+}
+
+// TableName returns the table name for the GoalContributionModel.
+func (GoalContributionModel) TableName() string {
+	return "goal_contributions"
+}
+
+// ToEntity converts a GoalContributionModel to a domain GoalContribution entity.
+func (m *GoalContributionModel) ToEntity() *entity.GoalContribution {
+	var deletedAt *time.Time
+	if m.DeletedAt.Valid {
+		deletedAt = &m.DeletedAt.Time
+	}
+
+	return &entity.GoalContribution{
+		ID:            m.ID,
+		GoalID:        m.GoalID,
+		UserID:        m.UserID,
+		TransactionID: m.TransactionID,
+		Amount:        m.Amount,
+		Date:          m.Date,
+		Notes:         m.Notes,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+		DeletedAt:     deletedAt,
+	}
+}
+
+// GoalContributionFromEntity creates a GoalContributionModel from a domain GoalContribution entity.
+func GoalContributionFromEntity(contribution *entity.GoalContribution) *GoalContributionModel {
+	var deletedAt gorm.DeletedAt
+	if contribution.DeletedAt != nil {
+		deletedAt = gorm.DeletedAt{Time: *contribution.DeletedAt, Valid: true}
+	}
+
+	return &GoalContributionModel{
+		ID:            contribution.ID,
+		GoalID:        contribution.GoalID,
+		UserID:        contribution.UserID,
+		TransactionID: contribution.TransactionID,
+		Amount:        contribution.Amount,
+		Date:          contribution.Date,
+		Notes:         contribution.Notes,
+		CreatedAt:     contribution.CreatedAt,
+		UpdatedAt:     contribution.UpdatedAt,
+		DeletedAt:     deletedAt,
+	}
+}
@@ -0,0 +1,96 @@
+// Package model defines database models for persistence layer.
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ImportProfileModel represents the import_profiles table in the database.
+type ImportProfileModel struct {
+	ID                uuid.UUID      `gorm:"type:uuid;primaryKey"`
+	UserID            uuid.UUID      `gorm:"type:uuid;not null;index"`
+	Name              string         `gorm:"type:varchar(100);not null"`
+	Delimiter         string         `gorm:"type:varchar(2)"`
+	HasHeader         bool           `gorm:"not null;default:true"`
+	SkipRows          int            `gorm:"not null;default:0"`
+	DateColumn        string         `gorm:"type:varchar(100);not null"`
+	DescriptionColumn string         `gorm:"type:varchar(100);not null"`
+	AmountColumn      string         `gorm:"type:varchar(100)"`
+	DebitColumn       string         `gorm:"type:varchar(100)"`
+	CreditColumn      string         `gorm:"type:varchar(100)"`
+	InstallmentColumn string         `gorm:"type:varchar(100)"`
+	DateFormat        string         `gorm:"type:varchar(20)"`
+	NumberFormat      string         `gorm:"type:varchar(5)"`
+	InvertAmounts     bool           `gorm:"not null;default:false"`
+	CreatedAt         time.Time      `gorm:"not null"`
+	UpdatedAt         time.Time      `gorm:"not null"`
+	DeletedAt         gorm.DeletedAt `gorm:"index"` // Soft-delete support
+}
+
+// TableName returns the table name for the ImportProfileModel.
+func (ImportProfileModel) TableName() string {
+	return "import_profiles"
+}
+
+// ToEntity converts an ImportProfileModel to a domain ImportProfile entity.
+func (m *ImportProfileModel) ToEntity() *entity.ImportProfile {
+	var deletedAt *time.Time
+	if m.DeletedAt.Valid {
+		deletedAt = &m.DeletedAt.Time
+	}
+
+	return &entity.ImportProfile{
+		ID:                m.ID,
+		UserID:            m.UserID,
+		Name:              m.Name,
+		Delimiter:         m.Delimiter,
+		HasHeader:         m.HasHeader,
+		SkipRows:          m.SkipRows,
+		DateColumn:        m.DateColumn,
+		DescriptionColumn: m.DescriptionColumn,
+		AmountColumn:      m.AmountColumn,
+		DebitColumn:       m.DebitColumn,
+		CreditColumn:      m.CreditColumn,
+		InstallmentColumn: m.InstallmentColumn,
+		DateFormat:        entity.DateFormat(m.DateFormat),
+		NumberFormat:      entity.NumberFormat(m.NumberFormat),
+		InvertAmounts:     m.InvertAmounts,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+		DeletedAt:         deletedAt,
+	}
+}
+
+// ImportProfileFromEntity creates an ImportProfileModel from a domain ImportProfile entity.
+func ImportProfileFromEntity(profile *entity.ImportProfile) *ImportProfileModel {
+	var deletedAt gorm.DeletedAt
+	if profile.DeletedAt != nil {
+		deletedAt = gorm.DeletedAt{Time: *profile.DeletedAt, Valid: true}
+	}
+
+	return &ImportProfileModel{
+		ID:                profile.ID,
+		UserID:            profile.UserID,
+		Name:              profile.Name,
+		Delimiter:         profile.Delimiter,
+		HasHeader:         profile.HasHeader,
+		SkipRows:          profile.SkipRows,
+		DateColumn:        profile.DateColumn,
+		DescriptionColumn: profile.DescriptionColumn,
+		AmountColumn:      profile.AmountColumn,
+		DebitColumn:       profile.DebitColumn,
+		CreditColumn:      profile.CreditColumn,
+		InstallmentColumn: profile.InstallmentColumn,
+		DateFormat:        string(profile.DateFormat),
+		NumberFormat:      string(profile.NumberFormat),
+		InvertAmounts:     profile.InvertAmounts,
+		CreatedAt:         profile.CreatedAt,
+		UpdatedAt:         profile.UpdatedAt,
+		DeletedAt:         deletedAt,
+	}
+}
@@ -0,0 +1,76 @@
+// Package model defines database models for persistence layer.
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// InstallmentPlanModel represents the installment_plans table in the database.
+type InstallmentPlanModel struct {
+	ID                uuid.UUID       `gorm:"type:uuid;primaryKey"`
+	UserID            uuid.UUID       `gorm:"type:uuid;not null;index"`
+	AccountID         *uuid.UUID      `gorm:"type:uuid;index"`
+	Description       string          `gorm:"type:varchar(255);not null"`
+	TotalInstallments int             `gorm:"type:integer;not null"`
+	InstallmentAmount decimal.Decimal `gorm:"type:decimal(12,2);not null"`
+	FirstBillingCycle string          `gorm:"type:varchar(7);not null"`
+	MerchantID        *uuid.UUID      `gorm:"type:uuid"`
+	CreatedAt         time.Time       `gorm:"not null"`
+	UpdatedAt         time.Time       `gorm:"not null"`
+	DeletedAt         gorm.DeletedAt  `gorm:"index"` // Soft-delete support
+}
+
+// TableName returns the table name for the InstallmentPlanModel.
+func (InstallmentPlanModel) TableName() string {
+	return "installment_plans"
+}
+
+// ToEntity converts an InstallmentPlanModel to a domain InstallmentPlan entity.
+func (m *InstallmentPlanModel) ToEntity() *entity.InstallmentPlan {
+	var deletedAt *time.Time
+	if m.DeletedAt.Valid {
+		deletedAt = &m.DeletedAt.Time
+	}
+
+	return &entity.InstallmentPlan{
+		ID:                m.ID,
+		UserID:            m.UserID,
+		AccountID:         m.AccountID,
+		Description:       m.Description,
+		TotalInstallments: m.TotalInstallments,
+		InstallmentAmount: m.InstallmentAmount,
+		FirstBillingCycle: m.FirstBillingCycle,
+		MerchantID:        m.MerchantID,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+		DeletedAt:         deletedAt,
+	}
+}
+
+// InstallmentPlanFromEntity creates an InstallmentPlanModel from a domain InstallmentPlan entity.
+func InstallmentPlanFromEntity(plan *entity.InstallmentPlan) *InstallmentPlanModel {
+	var deletedAt gorm.DeletedAt
+	if plan.DeletedAt != nil {
+		deletedAt = gorm.DeletedAt{Time: *plan.DeletedAt, Valid: true}
+	}
+
+	return &InstallmentPlanModel{
+		ID:                plan.ID,
+		UserID:            plan.UserID,
+		AccountID:         plan.AccountID,
+		Description:       plan.Description,
+		TotalInstallments: plan.TotalInstallments,
+		InstallmentAmount: plan.InstallmentAmount,
+		FirstBillingCycle: plan.FirstBillingCycle,
+		MerchantID:        plan.MerchantID,
+		CreatedAt:         plan.CreatedAt,
+		UpdatedAt:         plan.UpdatedAt,
+		DeletedAt:         deletedAt,
+	}
+}
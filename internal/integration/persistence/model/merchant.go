@@ -0,0 +1,76 @@
+// Package model defines database models for persistence layer.
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// MerchantModel represents the merchants table in the database.
+type MerchantModel struct {
+	ID                uuid.UUID      `gorm:"type:uuid;primaryKey"`
+	UserID            uuid.UUID      `gorm:"type:uuid;not null;index;uniqueIndex:idx_merchants_user_id_key,where:deleted_at IS NULL"`
+	Name              string         `gorm:"type:varchar(100);not null"`
+	Key               string         `gorm:"type:varchar(100);not null;uniqueIndex:idx_merchants_user_id_key,where:deleted_at IS NULL"`
+	Aliases           pq.StringArray `gorm:"type:text[]"`
+	DefaultCategoryID *uuid.UUID     `gorm:"type:uuid"`
+	CreatedAt         time.Time      `gorm:"not null"`
+	UpdatedAt         time.Time      `gorm:"not null"`
+	DeletedAt         gorm.DeletedAt `gorm:"index"` // Soft-delete support
+}
+
+// TableName returns the table name for the MerchantModel.
+func (MerchantModel) TableName() string {
+	return "merchants"
+}
+
+// ToEntity converts a MerchantModel to a domain Merchant entity.
+func (m *MerchantModel) ToEntity() *entity.Merchant {
+	var deletedAt *time.Time
+	if m.DeletedAt.Valid {
+		deletedAt = &m.DeletedAt.Time
+	}
+
+	aliases := make([]string, len(m.Aliases))
+	copy(aliases, m.Aliases)
+
+	return &entity.Merchant{
+		ID:                m.ID,
+		UserID:            m.UserID,
+		Name:              m.Name,
+		Key:               m.Key,
+		Aliases:           aliases,
+		DefaultCategoryID: m.DefaultCategoryID,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+		DeletedAt:         deletedAt,
+	}
+}
+
+// MerchantFromEntity creates a MerchantModel from a domain Merchant entity.
+func MerchantFromEntity(merchant *entity.Merchant) *MerchantModel {
+	var deletedAt gorm.DeletedAt
+	if merchant.DeletedAt != nil {
+		deletedAt = gorm.DeletedAt{Time: *merchant.DeletedAt, Valid: true}
+	}
+
+	aliases := make(pq.StringArray, len(merchant.Aliases))
+	copy(aliases, merchant.Aliases)
+
+	return &MerchantModel{
+		ID:                merchant.ID,
+		UserID:            merchant.UserID,
+		Name:              merchant.Name,
+		Key:               merchant.Key,
+		Aliases:           aliases,
+		DefaultCategoryID: merchant.DefaultCategoryID,
+		CreatedAt:         merchant.CreatedAt,
+		UpdatedAt:         merchant.UpdatedAt,
+		DeletedAt:         deletedAt,
+	}
+}
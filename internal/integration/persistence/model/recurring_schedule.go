@@ -0,0 +1,109 @@
+// Package model defines database models for persistence layer.
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// RecurringScheduleModel represents the recurring_schedules table in the database.
+type RecurringScheduleModel struct {
+	ID                 uuid.UUID       `gorm:"type:uuid;primaryKey"`
+	UserID             uuid.UUID       `gorm:"type:uuid;not null;index"`
+	Description        string          `gorm:"type:varchar(255);not null"`
+	Amount             decimal.Decimal `gorm:"type:decimal(15,2);not null"`
+	Type               string          `gorm:"type:varchar(10);not null"`
+	CategoryID         *uuid.UUID      `gorm:"type:uuid;index"`
+	Notes              string          `gorm:"type:text"`
+	Frequency          string          `gorm:"type:varchar(10);not null"`
+	Interval           int             `gorm:"column:interval_count;not null;default:1"`
+	DayOfMonth         *int            `gorm:"type:integer"`
+	DayOfWeek          *int            `gorm:"type:integer"`
+	StartDate          time.Time       `gorm:"type:date;not null"`
+	EndDate            *time.Time      `gorm:"type:date"`
+	MaxOccurrences     *int            `gorm:"type:integer"`
+	OccurrenceCount    int             `gorm:"not null;default:0"`
+	LastOccurrenceDate *time.Time      `gorm:"type:date"`
+	NextOccurrence     *time.Time      `gorm:"type:date;index"`
+	LastRemindedFor    *time.Time      `gorm:"type:date"`
+	IsActive           bool            `gorm:"not null;default:true"`
+	CreatedAt          time.Time       `gorm:"not null"`
+	UpdatedAt          time.Time       `gorm:"not null"`
+	DeletedAt          gorm.DeletedAt  `gorm:"index"` // Soft-delete support
+}
+
+// TableName returns the table name for the RecurringScheduleModel.
+func (RecurringScheduleModel) TableName() string {
+	return "recurring_schedules"
+}
+
+// ToEntity converts a RecurringScheduleModel to a domain RecurringSchedule entity.
+func (m *RecurringScheduleModel) ToEntity() *entity.RecurringSchedule {
+	var deletedAt *time.Time
+	if m.DeletedAt.Valid {
+		deletedAt = &m.DeletedAt.Time
+	}
+
+	return &entity.RecurringSchedule{
+		ID:                 m.ID,
+		UserID:             m.UserID,
+		Description:        m.Description,
+		Amount:             m.Amount,
+		Type:               entity.TransactionType(m.Type),
+		CategoryID:         m.CategoryID,
+		Notes:              m.Notes,
+		Frequency:          entity.RecurrenceFrequency(m.Frequency),
+		Interval:           m.Interval,
+		DayOfMonth:         m.DayOfMonth,
+		DayOfWeek:          m.DayOfWeek,
+		StartDate:          m.StartDate,
+		EndDate:            m.EndDate,
+		MaxOccurrences:     m.MaxOccurrences,
+		OccurrenceCount:    m.OccurrenceCount,
+		LastOccurrenceDate: m.LastOccurrenceDate,
+		NextOccurrence:     m.NextOccurrence,
+		LastRemindedFor:    m.LastRemindedFor,
+		IsActive:           m.IsActive,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+		DeletedAt:          deletedAt,
+	}
+}
+
+// RecurringScheduleFromEntity creates a RecurringScheduleModel from a domain RecurringSchedule entity.
+func RecurringScheduleFromEntity(schedule *entity.RecurringSchedule) *RecurringScheduleModel {
+	var deletedAt gorm.DeletedAt
+	if schedule.DeletedAt != nil {
+		deletedAt = gorm.DeletedAt{Time: *schedule.DeletedAt, Valid: true}
+	}
+
+	return &RecurringScheduleModel{
+		ID:                 schedule.ID,
+		UserID:             schedule.UserID,
+		Description:        schedule.Description,
+		Amount:             schedule.Amount,
+		Type:               string(schedule.Type),
+		CategoryID:         schedule.CategoryID,
+		Notes:              schedule.Notes,
+		Frequency:          string(schedule.Frequency),
+		Interval:           schedule.Interval,
+		DayOfMonth:         schedule.DayOfMonth,
+		DayOfWeek:          schedule.DayOfWeek,
+		StartDate:          schedule.StartDate,
+		EndDate:            schedule.EndDate,
+		MaxOccurrences:     schedule.MaxOccurrences,
+		OccurrenceCount:    schedule.OccurrenceCount,
+		LastOccurrenceDate: schedule.LastOccurrenceDate,
+		NextOccurrence:     schedule.NextOccurrence,
+		LastRemindedFor:    schedule.LastRemindedFor,
+		IsActive:           schedule.IsActive,
+		CreatedAt:          schedule.CreatedAt,
+		UpdatedAt:          schedule.UpdatedAt,
+		DeletedAt:          deletedAt,
+	}
+}
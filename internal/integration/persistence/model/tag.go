@@ -0,0 +1,78 @@
+// Package model defines database models for persistence layer.
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// TagModel represents the tags table in the database.
+type TagModel struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID      `gorm:"type:uuid;not null;index"`
+	Name      string         `gorm:"type:varchar(50);not null"`
+	Color     string         `gorm:"type:varchar(7);not null;default:'#64748B'"`
+	CreatedAt time.Time      `gorm:"not null"`
+	UpdatedAt time.Time      `gorm:"not null"`
+	DeletedAt gorm.DeletedAt `gorm:"index"` // Soft-delete support
+}
+
+// TableName returns the table name for the TagModel.
+func (TagModel) TableName() string {
+	return "tags"
+}
+
+// ToEntity converts a TagModel to a domain Tag entity.
+func (m *TagModel) ToEntity() *entity.Tag {
+	var deletedAt *time.Time
+	if m.DeletedAt.Valid {
+		deletedAt = &m.DeletedAt.Time
+	}
+
+	return &entity.Tag{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Name:      m.Name,
+		Color:     m.Color,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+		DeletedAt: deletedAt,
+	}
+}
+
+// TagFromEntity creates a TagModel from a domain Tag entity.
+func TagFromEntity(tag *entity.Tag) *TagModel {
+	var deletedAt gorm.DeletedAt
+	if tag.DeletedAt != nil {
+		deletedAt = gorm.DeletedAt{Time: *tag.DeletedAt, Valid: true}
+	}
+
+	return &TagModel{
+		ID:        tag.ID,
+		UserID:    tag.UserID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+		DeletedAt: deletedAt,
+	}
+}
+
+// TransactionTagModel represents the transaction_tags table linking transactions to their tags.
+type TransactionTagModel struct {
+	TransactionID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TagID         uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+	CreatedAt     time.Time `gorm:"not null"`
+
+	// Relationships (not loaded by default, use Preload)
+	Tag *TagModel `gorm:"foreignKey:TagID;references:ID"`
+}
+
+// TableName returns the table name for the TransactionTagModel.
+func (TransactionTagModel) TableName() string {
+	return "transaction_tags"
+}
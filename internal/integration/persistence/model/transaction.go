@@ -14,7 +14,7 @@ import (
 // TransactionModel represents the transactions table in the database.
 type TransactionModel struct {
 	ID          uuid.UUID       `gorm:"type:uuid;primaryKey"`
-	UserID      uuid.UUID       `gorm:"type:uuid;not null;index"`
+	UserID      uuid.UUID       `gorm:"type:uuid;not null;index;uniqueIndex:idx_transactions_user_external_id,where:external_id IS NOT NULL"`
 	Date        time.Time       `gorm:"type:date;not null;index"`
 	Description string          `gorm:"type:varchar(255);not null"`
 	Amount      decimal.Decimal `gorm:"type:decimal(15,2);not null"`
@@ -36,11 +36,39 @@ type TransactionModel struct {
 	InstallmentCurrent  *int            `gorm:"type:integer"`
 	InstallmentTotal    *int            `gorm:"type:integer"`
 	IsHidden            bool            `gorm:"default:false"`
+	IsManualCardEntry   bool            `gorm:"not null;default:false"`
+
+	// Statement import fields
+	ExternalID *string `gorm:"type:varchar(255);uniqueIndex:idx_transactions_user_external_id,where:external_id IS NOT NULL"`
+
+	// Recurring schedule fields
+	RecurringScheduleID *uuid.UUID `gorm:"type:uuid;index"`
+
+	// Account fields
+	AccountID *uuid.UUID `gorm:"type:uuid;index"`
+
+	// Transfer fields
+	TransferID *uuid.UUID `gorm:"type:uuid;index"`
+
+	// Currency fields
+	Currency     string          `gorm:"type:varchar(3);not null;default:'BRL'"`
+	ExchangeRate decimal.Decimal `gorm:"type:decimal(18,8);not null;default:1"`
+
+	// Split fields
+	IsSplit bool `gorm:"not null;default:false"`
+
+	// Merchant fields
+	MerchantID *uuid.UUID `gorm:"type:uuid;index"`
+
+	// Installment plan fields
+	InstallmentPlanID *uuid.UUID `gorm:"type:uuid;index"`
 
 	// Relationships (not loaded by default, use Preload)
 	Category          *CategoryModel     `gorm:"foreignKey:CategoryID;references:ID"`
 	User              *UserModel         `gorm:"foreignKey:UserID;references:ID"`
 	CreditCardPayment *TransactionModel  `gorm:"foreignKey:CreditCardPaymentID;references:ID"`
+	Splits            []TransactionSplitModel `gorm:"foreignKey:TransactionID;references:ID"`
+	TransactionTags   []TransactionTagModel   `gorm:"foreignKey:TransactionID;references:ID"`
 }
 
 // TableName returns the table name for the TransactionModel.
@@ -55,6 +83,20 @@ func (m *TransactionModel) ToEntity() *entity.Transaction {
 		deletedAt = &m.DeletedAt.Time
 	}
 
+	// Split lines are only present when preloaded
+	var splits []*entity.TransactionSplit
+	for i := range m.Splits {
+		splits = append(splits, m.Splits[i].ToEntity())
+	}
+
+	// Tags are only present when preloaded; links to deleted tags are not loaded
+	var tags []*entity.Tag
+	for i := range m.TransactionTags {
+		if m.TransactionTags[i].Tag != nil {
+			tags = append(tags, m.TransactionTags[i].Tag.ToEntity())
+		}
+	}
+
 	return &entity.Transaction{
 		ID:          m.ID,
 		UserID:      m.UserID,
@@ -78,6 +120,27 @@ func (m *TransactionModel) ToEntity() *entity.Transaction {
 		InstallmentCurrent:  m.InstallmentCurrent,
 		InstallmentTotal:    m.InstallmentTotal,
 		IsHidden:            m.IsHidden,
+		IsManualCardEntry:   m.IsManualCardEntry,
+		// Statement import fields
+		ExternalID: m.ExternalID,
+		// Recurring schedule fields
+		RecurringScheduleID: m.RecurringScheduleID,
+		// Account fields
+		AccountID: m.AccountID,
+		// Transfer fields
+		TransferID: m.TransferID,
+		// Currency fields
+		Currency:     m.Currency,
+		ExchangeRate: m.ExchangeRate,
+		// Split fields
+		IsSplit: m.IsSplit,
+		Splits:  splits,
+		// Tag fields
+		Tags: tags,
+		// Merchant fields
+		MerchantID: m.MerchantID,
+		// Installment plan fields
+		InstallmentPlanID: m.InstallmentPlanID,
 	}
 }
 
@@ -101,6 +164,16 @@ func TransactionFromEntity(transaction *entity.Transaction) *TransactionModel {
 		deletedAt = gorm.DeletedAt{Time: *transaction.DeletedAt, Valid: true}
 	}
 
+	// Transactions without a snapshot are stored in the default currency at par
+	currency := transaction.Currency
+	if currency == "" {
+		currency = entity.DefaultCurrency
+	}
+	exchangeRate := transaction.ExchangeRate
+	if exchangeRate.IsZero() {
+		exchangeRate = decimal.NewFromInt(1)
+	}
+
 	return &TransactionModel{
 		ID:          transaction.ID,
 		UserID:      transaction.UserID,
@@ -124,5 +197,23 @@ func TransactionFromEntity(transaction *entity.Transaction) *TransactionModel {
 		InstallmentCurrent:  transaction.InstallmentCurrent,
 		InstallmentTotal:    transaction.InstallmentTotal,
 		IsHidden:            transaction.IsHidden,
+		IsManualCardEntry:   transaction.IsManualCardEntry,
+		// Statement import fields
+		ExternalID: transaction.ExternalID,
+		// Recurring schedule fields
+		RecurringScheduleID: transaction.RecurringScheduleID,
+		// Account fields
+		AccountID: transaction.AccountID,
+		// Transfer fields
+		TransferID: transaction.TransferID,
+		// Currency fields
+		Currency:     currency,
+		ExchangeRate: exchangeRate,
+		// Split fields
+		IsSplit: transaction.IsSplit,
+		// Merchant fields
+		MerchantID: transaction.MerchantID,
+		// Installment plan fields
+		InstallmentPlanID: transaction.InstallmentPlanID,
 	}
 }
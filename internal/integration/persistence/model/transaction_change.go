@@ -0,0 +1,93 @@
+// Package model defines database models for persistence layer.
+package model
+
+import (
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// transactionFieldChangeJSON is the JSON form of a field change in the fields column.
+type transactionFieldChangeJSON struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before"`
+	After  *string `json:"after"`
+}
+
+// TransactionChangeModel represents the transaction_changes table in the database.
+type TransactionChangeModel struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	TransactionID uuid.UUID  `gorm:"type:uuid;not null;index"`
+	UserID        uuid.UUID  `gorm:"type:uuid;not null;index"`
+	ActorID       *uuid.UUID `gorm:"type:uuid"`
+	Source        string     `gorm:"type:varchar(20);not null"`
+	Action        string     `gorm:"type:varchar(20);not null"`
+	OperationID   uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Fields        string     `gorm:"type:jsonb;not null;default:'[]'"`
+	RevertedAt    *time.Time
+	RevertedByID  *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt     time.Time  `gorm:"not null"`
+}
+
+// TableName returns the table name for the TransactionChangeModel.
+func (TransactionChangeModel) TableName() string {
+	return "transaction_changes"
+}
+
+// ToEntity converts a TransactionChangeModel to a domain TransactionChange entity.
+func (m *TransactionChangeModel) ToEntity() *entity.TransactionChange {
+	var fieldsJSON []transactionFieldChangeJSON
+	if err := json.Unmarshal([]byte(m.Fields), &fieldsJSON); err != nil {
+		slog.Warn("Failed to unmarshal transaction change fields", "error", err, "id", m.ID)
+	}
+
+	fields := make([]entity.TransactionFieldChange, len(fieldsJSON))
+	for i, field := range fieldsJSON {
+		fields[i] = entity.TransactionFieldChange{Field: field.Field, Before: field.Before, After: field.After}
+	}
+
+	return &entity.TransactionChange{
+		ID:            m.ID,
+		TransactionID: m.TransactionID,
+		UserID:        m.UserID,
+		ActorID:       m.ActorID,
+		Source:        entity.TransactionChangeSource(m.Source),
+		Action:        entity.TransactionChangeAction(m.Action),
+		OperationID:   m.OperationID,
+		Fields:        fields,
+		RevertedAt:    m.RevertedAt,
+		RevertedByID:  m.RevertedByID,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+// TransactionChangeFromEntity creates a TransactionChangeModel from a domain TransactionChange entity.
+func TransactionChangeFromEntity(change *entity.TransactionChange) *TransactionChangeModel {
+	fieldsJSON := make([]transactionFieldChangeJSON, len(change.Fields))
+	for i, field := range change.Fields {
+		fieldsJSON[i] = transactionFieldChangeJSON{Field: field.Field, Before: field.Before, After: field.After}
+	}
+	fields, err := json.Marshal(fieldsJSON)
+	if err != nil {
+		slog.Error("Failed to marshal transaction change fields", "error", err, "id", change.ID)
+		fields = []byte("[]")
+	}
+
+	return &TransactionChangeModel{
+		ID:            change.ID,
+		TransactionID: change.TransactionID,
+		UserID:        change.UserID,
+		ActorID:       change.ActorID,
+		Source:        string(change.Source),
+		Action:        string(change.Action),
+		OperationID:   change.OperationID,
+		Fields:        string(fields),
+		RevertedAt:    change.RevertedAt,
+		RevertedByID:  change.RevertedByID,
+		CreatedAt:     change.CreatedAt,
+	}
+}
@@ -0,0 +1,53 @@
+// Package model defines database models for persistence layer.
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// TransactionSplitModel represents the transaction_splits table in the database.
+type TransactionSplitModel struct {
+	ID            uuid.UUID       `gorm:"type:uuid;primaryKey"`
+	TransactionID uuid.UUID       `gorm:"type:uuid;not null;index"`
+	CategoryID    *uuid.UUID      `gorm:"type:uuid;index"`
+	Amount        decimal.Decimal `gorm:"type:decimal(15,2);not null"`
+	Notes         string          `gorm:"type:text"`
+	CreatedAt     time.Time       `gorm:"not null"`
+	UpdatedAt     time.Time       `gorm:"not null"`
+}
+
+// TableName returns the table name for the TransactionSplitModel.
+func (TransactionSplitModel) TableName() string {
+	return "transaction_splits"
+}
+
+// ToEntity converts a TransactionSplitModel to a domain TransactionSplit entity.
+func (m *TransactionSplitModel) ToEntity() *entity.TransactionSplit {
+	return &entity.TransactionSplit{
+		ID:            m.ID,
+		TransactionID: m.TransactionID,
+		CategoryID:    m.CategoryID,
+		Amount:        m.Amount,
+		Notes:         m.Notes,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+// TransactionSplitFromEntity creates a TransactionSplitModel from a domain TransactionSplit entity.
+func TransactionSplitFromEntity(split *entity.TransactionSplit) *TransactionSplitModel {
+	return &TransactionSplitModel{
+		ID:            split.ID,
+		TransactionID: split.TransactionID,
+		CategoryID:    split.CategoryID,
+		Amount:        split.Amount,
+		Notes:         split.Notes,
+		CreatedAt:     split.CreatedAt,
+		UpdatedAt:     split.UpdatedAt,
+	}
+}
@@ -22,6 +22,7 @@ type UserModel struct {
 	EmailNotifications bool           `gorm:"default:true"`
 	GoalAlerts         bool           `gorm:"default:true"`
 	RecurringReminders bool           `gorm:"default:true"`
+	BaseCurrency       string         `gorm:"type:varchar(3);not null;default:'BRL'"`
 	TermsAcceptedAt    time.Time      `gorm:"not null"`
 	CreatedAt          time.Time      `gorm:"not null"`
 	UpdatedAt          time.Time      `gorm:"not null"`
@@ -51,6 +52,7 @@ func (m *UserModel) ToEntity() *entity.User {
 		EmailNotifications: m.EmailNotifications,
 		GoalAlerts:         m.GoalAlerts,
 		RecurringReminders: m.RecurringReminders,
+		BaseCurrency:       m.BaseCurrency,
 		TermsAcceptedAt:    m.TermsAcceptedAt,
 		CreatedAt:          m.CreatedAt,
 		UpdatedAt:          m.UpdatedAt,
@@ -76,6 +78,7 @@ func FromEntity(user *entity.User) *UserModel {
 		EmailNotifications: user.EmailNotifications,
 		GoalAlerts:         user.GoalAlerts,
 		RecurringReminders: user.RecurringReminders,
+		BaseCurrency:       user.BaseCurrency,
 		TermsAcceptedAt:    user.TermsAcceptedAt,
 		CreatedAt:          user.CreatedAt,
 		UpdatedAt:          user.UpdatedAt,
@@ -0,0 +1,167 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// recurringScheduleRepository implements the adapter.RecurringScheduleRepository interface.
+type recurringScheduleRepository struct {
+	db *gorm.DB
+}
+
+// NewRecurringScheduleRepository creates a new recurring schedule repository instance.
+func NewRecurringScheduleRepository(db *gorm.DB) adapter.RecurringScheduleRepository {
+	return &recurringScheduleRepository{
+		db: db,
+	}
+}
+
+// Create creates a new recurring schedule in the database.
+func (r *recurringScheduleRepository) Create(ctx context.Context, schedule *entity.RecurringSchedule) error {
+	scheduleModel := model.RecurringScheduleFromEntity(schedule)
+	result := r.db.WithContext(ctx).Create(scheduleModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// FindByID retrieves a recurring schedule by its ID.
+func (r *recurringScheduleRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.RecurringSchedule, error) {
+	var scheduleModel model.RecurringScheduleModel
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&scheduleModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domainerror.ErrRecurringScheduleNotFound
+		}
+		return nil, result.Error
+	}
+	return scheduleModel.ToEntity(), nil
+}
+
+// FindByUser retrieves all recurring schedules for a user, ordered by next occurrence (ended last).
+func (r *recurringScheduleRepository) FindByUser(ctx context.Context, userID uuid.UUID) ([]*entity.RecurringSchedule, error) {
+	var scheduleModels []model.RecurringScheduleModel
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("next_occurrence ASC NULLS LAST").
+		Order("description ASC").
+		Find(&scheduleModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return toRecurringScheduleEntities(scheduleModels), nil
+}
+
+// Update updates an existing recurring schedule in the database.
+func (r *recurringScheduleRepository) Update(ctx context.Context, schedule *entity.RecurringSchedule) error {
+	scheduleModel := model.RecurringScheduleFromEntity(schedule)
+	result := r.db.WithContext(ctx).Save(scheduleModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// Delete soft-deletes a recurring schedule from the database.
+func (r *recurringScheduleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&model.RecurringScheduleModel{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainerror.ErrRecurringScheduleNotFound
+	}
+	return nil
+}
+
+// FindDue retrieves active schedules whose next occurrence is on or before the given date.
+func (r *recurringScheduleRepository) FindDue(ctx context.Context, asOf time.Time, limit int) ([]*entity.RecurringSchedule, error) {
+	var scheduleModels []model.RecurringScheduleModel
+	result := r.db.WithContext(ctx).
+		Where("is_active = ?", true).
+		Where("next_occurrence IS NOT NULL AND next_occurrence <= ?", asOf).
+		Order("next_occurrence ASC").
+		Limit(limit).
+		Find(&scheduleModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return toRecurringScheduleEntities(scheduleModels), nil
+}
+
+// FindPendingReminders retrieves active schedules with a next occurrence in the date range
+// that have not been reminded for that occurrence yet.
+func (r *recurringScheduleRepository) FindPendingReminders(ctx context.Context, from, to time.Time) ([]*entity.RecurringSchedule, error) {
+	var scheduleModels []model.RecurringScheduleModel
+	result := r.db.WithContext(ctx).
+		Where("is_active = ?", true).
+		Where("next_occurrence BETWEEN ? AND ?", from, to).
+		Where("last_reminded_for IS NULL OR last_reminded_for <> next_occurrence").
+		Order("user_id ASC").
+		Order("next_occurrence ASC").
+		Find(&scheduleModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return toRecurringScheduleEntities(scheduleModels), nil
+}
+
+// SaveOccurrence creates the materialized transaction and saves the advanced schedule atomically,
+// provided the schedule's next occurrence is still the one materialized. Otherwise it returns
+// ErrRecurringOccurrenceAlreadyProcessed and creates nothing.
+func (r *recurringScheduleRepository) SaveOccurrence(
+	ctx context.Context,
+	schedule *entity.RecurringSchedule,
+	occurrence time.Time,
+	transaction *entity.Transaction,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Claim the occurrence first, so a concurrent run that already advanced the schedule wins
+		result := tx.Model(&model.RecurringScheduleModel{}).
+			Where("id = ? AND next_occurrence = ?", schedule.ID, occurrence).
+			Select("*").
+			Omit("id", "created_at").
+			Updates(model.RecurringScheduleFromEntity(schedule))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerror.ErrRecurringOccurrenceAlreadyProcessed
+		}
+
+		return tx.Create(model.TransactionFromEntity(transaction)).Error
+	})
+}
+
+// MarkReminded records that the user was reminded of the schedule's next occurrence,
+// unless the next occurrence changed in the meantime.
+func (r *recurringScheduleRepository) MarkReminded(ctx context.Context, id uuid.UUID, occurrence time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&model.RecurringScheduleModel{}).
+		Where("id = ? AND next_occurrence = ?", id, occurrence).
+		Update("last_reminded_for", occurrence).Error
+}
+
+// toRecurringScheduleEntities converts recurring schedule models to entities.
+func toRecurringScheduleEntities(scheduleModels []model.RecurringScheduleModel) []*entity.RecurringSchedule {
+	schedules := make([]*entity.RecurringSchedule, len(scheduleModels))
+	for i, sm := range scheduleModels {
+		schedules[i] = sm.ToEntity()
+	}
+	return schedules
+}
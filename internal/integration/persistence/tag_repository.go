@@ -0,0 +1,182 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// tagRepository implements the adapter.TagRepository interface.
+type tagRepository struct {
+	db *gorm.DB
+}
+
+// NewTagRepository creates a new tag repository instance.
+func NewTagRepository(db *gorm.DB) adapter.TagRepository {
+	return &tagRepository{
+		db: db,
+	}
+}
+
+// Create creates a new tag in the database.
+func (r *tagRepository) Create(ctx context.Context, tag *entity.Tag) error {
+	tagModel := model.TagFromEntity(tag)
+	result := r.db.WithContext(ctx).Create(tagModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// FindByID retrieves a tag by its ID.
+func (r *tagRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Tag, error) {
+	var tagModel model.TagModel
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&tagModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domainerror.ErrTagNotFound
+		}
+		return nil, result.Error
+	}
+	return tagModel.ToEntity(), nil
+}
+
+// FindByUser retrieves the user's tags sorted by name.
+func (r *tagRepository) FindByUser(ctx context.Context, userID uuid.UUID) ([]*entity.Tag, error) {
+	var tagModels []model.TagModel
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("name ASC").
+		Find(&tagModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	tags := make([]*entity.Tag, len(tagModels))
+	for i, tm := range tagModels {
+		tags[i] = tm.ToEntity()
+	}
+	return tags, nil
+}
+
+// ExistsByNameAndUser checks if the user already has a tag with the given name (case-insensitive).
+func (r *tagRepository) ExistsByNameAndUser(
+	ctx context.Context,
+	name string,
+	userID uuid.UUID,
+	excludeID *uuid.UUID,
+) (bool, error) {
+	var count int64
+	query := r.db.WithContext(ctx).
+		Model(&model.TagModel{}).
+		Where("user_id = ?", userID).
+		Where("LOWER(name) = ?", strings.ToLower(name))
+
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Update updates an existing tag in the database.
+func (r *tagRepository) Update(ctx context.Context, tag *entity.Tag) error {
+	tagModel := model.TagFromEntity(tag)
+	result := r.db.WithContext(ctx).Save(tagModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// Delete soft-deletes a tag and removes it from its transactions, which are kept.
+func (r *tagRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&model.TagModel{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerror.ErrTagNotFound
+		}
+
+		return tx.Where("tag_id = ?", id).Delete(&model.TransactionTagModel{}).Error
+	})
+}
+
+// AddToTransactions attaches the tags to the user's transactions, skipping links that already exist.
+func (r *tagRepository) AddToTransactions(
+	ctx context.Context,
+	transactionIDs []uuid.UUID,
+	tagIDs []uuid.UUID,
+	userID uuid.UUID,
+) (int64, error) {
+	var ownedIDs []uuid.UUID
+	if err := r.db.WithContext(ctx).
+		Model(&model.TransactionModel{}).
+		Where("id IN ? AND user_id = ?", transactionIDs, userID).
+		Pluck("id", &ownedIDs).Error; err != nil {
+		return 0, err
+	}
+	if len(ownedIDs) == 0 || len(tagIDs) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now().UTC()
+	links := make([]model.TransactionTagModel, 0, len(ownedIDs)*len(tagIDs))
+	for _, transactionID := range ownedIDs {
+		for _, tagID := range tagIDs {
+			links = append(links, model.TransactionTagModel{
+				TransactionID: transactionID,
+				TagID:         tagID,
+				CreatedAt:     now,
+			})
+		}
+	}
+
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "transaction_id"}, {Name: "tag_id"}},
+			DoNothing: true,
+		}).
+		Create(&links)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// RemoveFromTransactions detaches the tags from the user's transactions.
+func (r *tagRepository) RemoveFromTransactions(
+	ctx context.Context,
+	transactionIDs []uuid.UUID,
+	tagIDs []uuid.UUID,
+	userID uuid.UUID,
+) (int64, error) {
+	ownedIDs := r.db.WithContext(ctx).
+		Model(&model.TransactionModel{}).
+		Select("id").
+		Where("id IN ? AND user_id = ?", transactionIDs, userID)
+
+	result := r.db.WithContext(ctx).
+		Where("tag_id IN ? AND transaction_id IN (?)", tagIDs, ownedIDs).
+		Delete(&model.TransactionTagModel{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,128 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// transactionChangeBatchSize is the number of changes inserted per statement.
+const transactionChangeBatchSize = 500
+
+// transactionChangeRepository implements the adapter.TransactionChangeRepository interface.
+type transactionChangeRepository struct {
+	db *gorm.DB
+}
+
+// NewTransactionChangeRepository creates a new transaction change repository instance.
+func NewTransactionChangeRepository(db *gorm.DB) adapter.TransactionChangeRepository {
+	return &transactionChangeRepository{
+		db: db,
+	}
+}
+
+// CreateMany records changes in a single database transaction.
+func (r *transactionChangeRepository) CreateMany(ctx context.Context, changes []*entity.TransactionChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	changeModels := make([]*model.TransactionChangeModel, len(changes))
+	for i, change := range changes {
+		changeModels[i] = model.TransactionChangeFromEntity(change)
+	}
+	return conn(ctx, r.db).CreateInBatches(changeModels, transactionChangeBatchSize).Error
+}
+
+// FindByID retrieves a change by its ID.
+func (r *transactionChangeRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.TransactionChange, error) {
+	var changeModel model.TransactionChangeModel
+	result := conn(ctx, r.db).Where("id = ?", id).First(&changeModel)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domainerror.ErrTransactionChangeNotFound
+		}
+		return nil, result.Error
+	}
+	return changeModel.ToEntity(), nil
+}
+
+// FindByTransaction retrieves the changes of a transaction, newest first.
+func (r *transactionChangeRepository) FindByTransaction(ctx context.Context, transactionID uuid.UUID) ([]*entity.TransactionChange, error) {
+	var changeModels []model.TransactionChangeModel
+	result := conn(ctx, r.db).
+		Where("transaction_id = ?", transactionID).
+		Order("created_at DESC, id DESC").
+		Find(&changeModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return toTransactionChanges(changeModels), nil
+}
+
+// FindByOperation retrieves the changes an operation made to the user's transactions.
+func (r *transactionChangeRepository) FindByOperation(
+	ctx context.Context,
+	operationID uuid.UUID,
+	userID uuid.UUID,
+) ([]*entity.TransactionChange, error) {
+	var changeModels []model.TransactionChangeModel
+	result := conn(ctx, r.db).
+		Where("operation_id = ? AND user_id = ?", operationID, userID).
+		Order("created_at, id").
+		Find(&changeModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return toTransactionChanges(changeModels), nil
+}
+
+// SaveRevert saves the reverted transaction, records the revert and stores the revert fields of the
+// reverted change in a single database transaction.
+func (r *transactionChangeRepository) SaveRevert(
+	ctx context.Context,
+	transaction *entity.Transaction,
+	revert *entity.TransactionChange,
+	reverted *entity.TransactionChange,
+) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(model.TransactionFromEntity(transaction)).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(model.TransactionChangeFromEntity(revert)).Error; err != nil {
+			return err
+		}
+
+		// Guard against a concurrent revert of the same change
+		result := tx.Model(&model.TransactionChangeModel{}).
+			Where("id = ? AND reverted_at IS NULL", reverted.ID).
+			Updates(map[string]interface{}{
+				"reverted_at":    reverted.RevertedAt,
+				"reverted_by_id": reverted.RevertedByID,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerror.ErrChangeNotRevertable
+		}
+		return nil
+	})
+}
+
+// toTransactionChanges converts change models to entities.
+func toTransactionChanges(changeModels []model.TransactionChangeModel) []*entity.TransactionChange {
+	changes := make([]*entity.TransactionChange, len(changeModels))
+	for i, cm := range changeModels {
+		changes[i] = cm.ToEntity()
+	}
+	return changes
+}
@@ -5,19 +5,44 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/finance-tracker/backend/internal/application/adapter"
 	"github.com/finance-tracker/backend/internal/domain/entity"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
 	"github.com/finance-tracker/backend/internal/integration/persistence/model"
 )
 
+// baseAmountSQL converts a transaction amount into the user's base currency using the
+// exchange rate snapshotted on the transaction.
+const baseAmountSQL = "ROUND(amount * exchange_rate, 2)"
+
+// categorizedTransactionsSQL is a derived table of the amounts attributed to categories: one row per
+// line of a split transaction and one row per other transaction. Rows keep the transaction ID and
+// columns, with category_id and amount taken from the split line when there is one.
+const categorizedTransactionsSQL = `(
+	SELECT
+		t.id, t.user_id, t.date, t.description, t.type, t.account_id, t.exchange_rate,
+		t.is_hidden, t.created_at, t.deleted_at,
+		COALESCE(s.category_id, t.category_id) AS category_id,
+		COALESCE(s.amount, t.amount) AS amount
+	FROM transactions t
+	LEFT JOIN transaction_splits s ON s.transaction_id = t.id
+)`
+
+// splitCategoryFilterSQL matches transactions in the given categories, including split transactions
+// with a line in one of them. It takes the category IDs twice.
+const splitCategoryFilterSQL = "category_id IN ? OR id IN (SELECT transaction_id FROM transaction_splits WHERE category_id IN ?)"
+
+// tagFilterSQL matches transactions with any of the given tags.
+const tagFilterSQL = "id IN (SELECT transaction_id FROM transaction_tags WHERE tag_id IN ?)"
+
 // transactionRepository implements the adapter.TransactionRepository interface.
 type transactionRepository struct {
 	db *gorm.DB
@@ -33,7 +58,7 @@ func NewTransactionRepository(db *gorm.DB) adapter.TransactionRepository {
 // Create creates a new transaction in the database.
 func (r *transactionRepository) Create(ctx context.Context, transaction *entity.Transaction) error {
 	transactionModel := model.TransactionFromEntity(transaction)
-	result := r.db.WithContext(ctx).Create(transactionModel)
+	result := conn(ctx, r.db).Create(transactionModel)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -43,7 +68,7 @@ func (r *transactionRepository) Create(ctx context.Context, transaction *entity.
 // FindByID retrieves a transaction by its ID.
 func (r *transactionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Transaction, error) {
 	var transactionModel model.TransactionModel
-	result := r.db.WithContext(ctx).Where("id = ?", id).First(&transactionModel)
+	result := conn(ctx, r.db).Preload("Splits").Preload("TransactionTags.Tag").Where("id = ?", id).First(&transactionModel)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, domainerror.ErrTransactionNotFound
@@ -53,11 +78,36 @@ func (r *transactionRepository) FindByID(ctx context.Context, id uuid.UUID) (*en
 	return transactionModel.ToEntity(), nil
 }
 
+// FindByIDs retrieves the user's transactions with the given IDs, with their split lines and tags.
+func (r *transactionRepository) FindByIDs(ctx context.Context, ids []uuid.UUID, userID uuid.UUID) ([]*entity.Transaction, error) {
+	if len(ids) == 0 {
+		return []*entity.Transaction{}, nil
+	}
+
+	var transactionModels []model.TransactionModel
+	result := conn(ctx, r.db).
+		Preload("Splits").
+		Preload("TransactionTags.Tag").
+		Where("id IN ? AND user_id = ?", ids, userID).
+		Find(&transactionModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, tm := range transactionModels {
+		transactions[i] = tm.ToEntity()
+	}
+	return transactions, nil
+}
+
 // FindByIDWithCategory retrieves a transaction with its category by ID.
 func (r *transactionRepository) FindByIDWithCategory(ctx context.Context, id uuid.UUID) (*entity.TransactionWithCategory, error) {
 	var transactionModel model.TransactionModel
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Preload("Category").
+		Preload("Splits").
+		Preload("TransactionTags.Tag").
 		Where("id = ?", id).
 		First(&transactionModel)
 	if result.Error != nil {
@@ -72,7 +122,7 @@ func (r *transactionRepository) FindByIDWithCategory(ctx context.Context, id uui
 // FindByUser retrieves all transactions for a given user.
 func (r *transactionRepository) FindByUser(ctx context.Context, userID uuid.UUID) ([]*entity.Transaction, error) {
 	var transactionModels []model.TransactionModel
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Where("user_id = ?", userID).
 		Order("date DESC, created_at DESC").
 		Find(&transactionModels)
@@ -89,27 +139,7 @@ func (r *transactionRepository) FindByUser(ctx context.Context, userID uuid.UUID
 
 // FindByFilter retrieves transactions based on filter criteria with pagination.
 func (r *transactionRepository) FindByFilter(ctx context.Context, filter adapter.TransactionFilter, pagination adapter.TransactionPagination) (*adapter.TransactionListResult, error) {
-	query := r.db.WithContext(ctx).Model(&model.TransactionModel{})
-
-	// Apply filters
-	query = query.Where("user_id = ?", filter.UserID)
-
-	if filter.StartDate != nil {
-		query = query.Where("date >= ?", filter.StartDate)
-	}
-	if filter.EndDate != nil {
-		query = query.Where("date <= ?", filter.EndDate)
-	}
-	if len(filter.CategoryIDs) > 0 {
-		query = query.Where("category_id IN ?", filter.CategoryIDs)
-	}
-	if filter.Type != nil {
-		query = query.Where("type = ?", string(*filter.Type))
-	}
-	if filter.Search != "" {
-		searchPattern := "%" + strings.ToLower(filter.Search) + "%"
-		query = query.Where("LOWER(description) LIKE ?", searchPattern)
-	}
+	query := r.filterQuery(ctx, filter)
 
 	// Get total count
 	var total int64
@@ -125,18 +155,37 @@ func (r *transactionRepository) FindByFilter(ctx context.Context, filter adapter
 		totalPages = 1
 	}
 
-	// Fetch transactions with category preloaded
+	// Sort, then continue after the cursor transaction (keyset pagination) or skip to the page (offset pagination)
+	sort := pagination.Sort
+	if sort.Field == "" {
+		sort = valueobject.DefaultTransactionSort()
+	}
+	query, err := applyTransactionSort(query, "transactions", sort, pagination.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if pagination.Cursor == nil {
+		query = query.Offset(offset)
+	}
+
+	// Fetch transactions with category, split lines and tags preloaded, plus one more to know if there is a next page
 	var transactionModels []model.TransactionModel
 	result := query.
 		Preload("Category").
-		Order("date DESC, created_at DESC").
-		Offset(offset).
-		Limit(pagination.Limit).
+		Preload("Splits").
+		Preload("TransactionTags.Tag").
+		Limit(pagination.Limit + 1).
 		Find(&transactionModels)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 
+	var nextCursor *valueobject.TransactionCursor
+	if len(transactionModels) > pagination.Limit {
+		transactionModels = transactionModels[:pagination.Limit]
+		nextCursor = transactionModelCursor(sort, &transactionModels[len(transactionModels)-1])
+	}
+
 	// Convert to entities
 	transactions := make([]*entity.TransactionWithCategory, len(transactionModels))
 	expandedBillIDs := []uuid.UUID{}
@@ -155,7 +204,7 @@ func (r *transactionRepository) FindByFilter(ctx context.Context, filter adapter
 			BillID uuid.UUID `gorm:"column:credit_card_payment_id"`
 			Count  int       `gorm:"column:count"`
 		}
-		if err := r.db.WithContext(ctx).Model(&model.TransactionModel{}).
+		if err := conn(ctx, r.db).Model(&model.TransactionModel{}).
 			Select("credit_card_payment_id, COUNT(*) as count").
 			Where("credit_card_payment_id IN ?", expandedBillIDs).
 			Where("is_hidden = ?", false).
@@ -181,12 +230,121 @@ func (r *transactionRepository) FindByFilter(ctx context.Context, filter adapter
 		Page:         pagination.Page,
 		Limit:        pagination.Limit,
 		TotalPages:   totalPages,
+		NextCursor:   nextCursor,
 	}, nil
 }
 
+// filterQuery returns a query for the transactions matching the filter.
+func (r *transactionRepository) filterQuery(ctx context.Context, filter adapter.TransactionFilter) *gorm.DB {
+	query := conn(ctx, r.db).Model(&model.TransactionModel{})
+
+	// Apply filters
+	query = query.Where("user_id = ?", filter.UserID)
+
+	if filter.StartDate != nil {
+		query = query.Where("date >= ?", filter.StartDate)
+	}
+	if filter.EndDate != nil {
+		query = query.Where("date <= ?", filter.EndDate)
+	}
+	if len(filter.CategoryIDs) > 0 {
+		query = query.Where(splitCategoryFilterSQL, filter.CategoryIDs, filter.CategoryIDs)
+	}
+	if len(filter.AccountIDs) > 0 {
+		query = query.Where("account_id IN ?", filter.AccountIDs)
+	}
+	if len(filter.TagIDs) > 0 {
+		query = query.Where(tagFilterSQL, filter.TagIDs)
+	}
+	if filter.Type != nil {
+		query = query.Where("type = ?", string(*filter.Type))
+	}
+	if filter.Query != nil {
+		query = applyTransactionQuery(conn(ctx, r.db), query, filter.UserID, filter.Query)
+	}
+	return applyTransactionAttributeFilters(conn(ctx, r.db), query, filter)
+}
+
+// FindIDsByFilter retrieves the IDs of up to limit transactions matching the filter, newest first,
+// with the total count of matching transactions.
+func (r *transactionRepository) FindIDsByFilter(ctx context.Context, filter adapter.TransactionFilter, limit int) ([]uuid.UUID, int64, error) {
+	query := r.filterQuery(ctx, filter)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var ids []uuid.UUID
+	if err := query.Order("date DESC, created_at DESC").Limit(limit).Pluck("id", &ids).Error; err != nil {
+		return nil, 0, err
+	}
+	return ids, total, nil
+}
+
+// StreamByFilter calls fn with consecutive batches of up to batchSize transactions matching the filter,
+// in the sort order, with their category, split lines and tags. Only one batch is loaded at a time.
+func (r *transactionRepository) StreamByFilter(
+	ctx context.Context,
+	filter adapter.TransactionFilter,
+	sort valueobject.TransactionSort,
+	batchSize int,
+	fn func([]*entity.TransactionWithCategory) error,
+) error {
+	// Batches continue after the last transaction of the previous one (keyset pagination)
+	var cursor *valueobject.TransactionCursor
+	for {
+		query, err := applyTransactionSort(r.filterQuery(ctx, filter), "transactions", sort, cursor)
+		if err != nil {
+			return err
+		}
+
+		var transactionModels []model.TransactionModel
+		if err := query.
+			Preload("Category").
+			Preload("Splits").
+			Preload("TransactionTags.Tag").
+			Limit(batchSize).
+			Find(&transactionModels).Error; err != nil {
+			return err
+		}
+		if len(transactionModels) == 0 {
+			return nil
+		}
+
+		transactions := make([]*entity.TransactionWithCategory, len(transactionModels))
+		for i := range transactionModels {
+			transactions[i] = transactionModels[i].ToEntityWithCategory()
+		}
+		if err := fn(transactions); err != nil {
+			return err
+		}
+
+		if len(transactionModels) < batchSize {
+			return nil
+		}
+		cursor = transactionModelCursor(sort, &transactionModels[len(transactionModels)-1])
+	}
+}
+
+// transactionModelCursor builds the cursor positioned after the transaction in the sort order.
+func transactionModelCursor(sort valueobject.TransactionSort, m *model.TransactionModel) *valueobject.TransactionCursor {
+	keys := valueobject.TransactionSortKeys{Date: m.Date, Amount: m.Amount, Description: m.Description}
+	if m.Category != nil {
+		keys.CategoryName = m.Category.Name
+	}
+	cursor := valueobject.NewTransactionCursor(sort, keys, m.CreatedAt, m.ID)
+	return &cursor
+}
+
 // GetTotals calculates totals for transactions based on filter criteria.
 func (r *transactionRepository) GetTotals(ctx context.Context, filter adapter.TransactionFilter) (*adapter.TransactionTotals, error) {
-	query := r.db.WithContext(ctx).Model(&model.TransactionModel{})
+	query := conn(ctx, r.db).Model(&model.TransactionModel{})
+
+	// With a category filter, only the split lines in those categories count towards the totals
+	if len(filter.CategoryIDs) > 0 {
+		query = conn(ctx, r.db).Table(categorizedTransactionsSQL + " AS t").Where("deleted_at IS NULL")
+	}
 
 	// Apply filters
 	query = query.Where("user_id = ?", filter.UserID)
@@ -200,13 +358,19 @@ func (r *transactionRepository) GetTotals(ctx context.Context, filter adapter.Tr
 	if len(filter.CategoryIDs) > 0 {
 		query = query.Where("category_id IN ?", filter.CategoryIDs)
 	}
+	if len(filter.AccountIDs) > 0 {
+		query = query.Where("account_id IN ?", filter.AccountIDs)
+	}
+	if len(filter.TagIDs) > 0 {
+		query = query.Where(tagFilterSQL, filter.TagIDs)
+	}
 	if filter.Type != nil {
 		query = query.Where("type = ?", string(*filter.Type))
 	}
-	if filter.Search != "" {
-		searchPattern := "%" + strings.ToLower(filter.Search) + "%"
-		query = query.Where("LOWER(description) LIKE ?", searchPattern)
+	if filter.Query != nil {
+		query = applyTransactionQuery(conn(ctx, r.db), query, filter.UserID, filter.Query)
 	}
+	query = applyTransactionAttributeFilters(conn(ctx, r.db), query, filter)
 
 	// Calculate income total
 	var incomeTotal decimal.Decimal
@@ -214,7 +378,7 @@ func (r *transactionRepository) GetTotals(ctx context.Context, filter adapter.Tr
 	var incomeResult struct {
 		Total decimal.Decimal
 	}
-	incomeQuery.Select("COALESCE(SUM(amount), 0) as total").Scan(&incomeResult)
+	incomeQuery.Select("COALESCE(SUM(" + baseAmountSQL + "), 0) as total").Scan(&incomeResult)
 	incomeTotal = incomeResult.Total
 
 	// Calculate expense total
@@ -223,7 +387,7 @@ func (r *transactionRepository) GetTotals(ctx context.Context, filter adapter.Tr
 	var expenseResult struct {
 		Total decimal.Decimal
 	}
-	expenseQuery.Select("COALESCE(SUM(amount), 0) as total").Scan(&expenseResult)
+	expenseQuery.Select("COALESCE(SUM(" + baseAmountSQL + "), 0) as total").Scan(&expenseResult)
 	expenseTotal = expenseResult.Total
 
 	// Calculate net total
@@ -236,19 +400,55 @@ func (r *transactionRepository) GetTotals(ctx context.Context, filter adapter.Tr
 	}, nil
 }
 
+// applyTransactionAttributeFilters restricts a transaction query by amount range and category state.
+// Like search queries, they are matched on the transactions table, so they apply to whole
+// transactions even when the outer query reads split lines.
+func applyTransactionAttributeFilters(db *gorm.DB, query *gorm.DB, filter adapter.TransactionFilter) *gorm.DB {
+	if filter.MinAmount == nil && filter.MaxAmount == nil && !filter.UncategorizedOnly {
+		return query
+	}
+
+	matching := db.Model(&model.TransactionModel{}).Select("id").Where("user_id = ?", filter.UserID)
+	if filter.MinAmount != nil {
+		matching = matching.Where("ABS(amount) >= CAST(? AS NUMERIC)", filter.MinAmount.Abs().String())
+	}
+	if filter.MaxAmount != nil {
+		matching = matching.Where("ABS(amount) <= CAST(? AS NUMERIC)", filter.MaxAmount.Abs().String())
+	}
+	if filter.UncategorizedOnly {
+		matching = matching.
+			Where("category_id IS NULL AND is_split = ?", false).
+			Where("type <> ?", string(entity.TransactionTypeTransfer))
+	}
+
+	return query.Where("id IN (?)", matching)
+}
+
 // Update updates an existing transaction in the database.
 func (r *transactionRepository) Update(ctx context.Context, transaction *entity.Transaction) error {
 	transactionModel := model.TransactionFromEntity(transaction)
-	result := r.db.WithContext(ctx).Save(transactionModel)
+	result := conn(ctx, r.db).Save(transactionModel)
 	if result.Error != nil {
 		return result.Error
 	}
 	return nil
 }
 
+// UpdateMany updates existing transactions in a single database transaction.
+func (r *transactionRepository) UpdateMany(ctx context.Context, transactions []*entity.Transaction) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, transaction := range transactions {
+			if err := tx.Save(model.TransactionFromEntity(transaction)).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // Delete soft-deletes a transaction from the database.
 func (r *transactionRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&model.TransactionModel{}, "id = ?", id)
+	result := conn(ctx, r.db).Delete(&model.TransactionModel{}, "id = ?", id)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -259,8 +459,15 @@ func (r *transactionRepository) Delete(ctx context.Context, id uuid.UUID) error
 func (r *transactionRepository) BulkDelete(ctx context.Context, ids []uuid.UUID, userID uuid.UUID) (int64, error) {
 	// Use transaction to ensure atomicity
 	var deletedCount int64
-	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		result := tx.Where("id IN ? AND user_id = ?", ids, userID).Delete(&model.TransactionModel{})
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Transfers are deleted as a unit, so include the other leg of any selected transfer
+		transferIDs := tx.Model(&model.TransactionModel{}).
+			Select("transfer_id").
+			Where("id IN ? AND user_id = ? AND transfer_id IS NOT NULL", ids, userID)
+
+		result := tx.Where("user_id = ?", userID).
+			Where("id IN ? OR transfer_id IN (?)", ids, transferIDs).
+			Delete(&model.TransactionModel{})
 		if result.Error != nil {
 			return result.Error
 		}
@@ -274,16 +481,37 @@ func (r *transactionRepository) BulkDelete(ctx context.Context, ids []uuid.UUID,
 }
 
 // BulkUpdateCategory updates the category for multiple transactions.
-func (r *transactionRepository) BulkUpdateCategory(ctx context.Context, ids []uuid.UUID, categoryID uuid.UUID, userID uuid.UUID) (int64, error) {
+// Split transactions are skipped unless includeSplit is set, in which case their split lines are removed.
+func (r *transactionRepository) BulkUpdateCategory(
+	ctx context.Context,
+	ids []uuid.UUID,
+	categoryID uuid.UUID,
+	userID uuid.UUID,
+	includeSplit bool,
+) (int64, error) {
 	// Use transaction to ensure atomicity
 	var updatedCount int64
-	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		result := tx.Model(&model.TransactionModel{}).
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Transfers are neither income nor expense, so they never get a category
+		query := tx.Model(&model.TransactionModel{}).
 			Where("id IN ? AND user_id = ?", ids, userID).
-			Updates(map[string]interface{}{
-				"category_id": categoryID,
-				"updated_at":  time.Now().UTC(),
-			})
+			Where("type <> ?", string(entity.TransactionTypeTransfer))
+
+		if includeSplit {
+			if err := tx.
+				Where("transaction_id IN (?)", query.Session(&gorm.Session{}).Select("id")).
+				Delete(&model.TransactionSplitModel{}).Error; err != nil {
+				return err
+			}
+		} else {
+			query = query.Where("is_split = ?", false)
+		}
+
+		result := query.Updates(map[string]interface{}{
+			"category_id": categoryID,
+			"is_split":    false,
+			"updated_at":  time.Now().UTC(),
+		})
 		if result.Error != nil {
 			return result.Error
 		}
@@ -299,7 +527,7 @@ func (r *transactionRepository) BulkUpdateCategory(ctx context.Context, ids []uu
 // ExistsByIDAndUser checks if a transaction exists for a given ID and user.
 func (r *transactionRepository) ExistsByIDAndUser(ctx context.Context, id uuid.UUID, userID uuid.UUID) (bool, error) {
 	var count int64
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Model(&model.TransactionModel{}).
 		Where("id = ? AND user_id = ?", id, userID).
 		Count(&count)
@@ -312,7 +540,7 @@ func (r *transactionRepository) ExistsByIDAndUser(ctx context.Context, id uuid.U
 // ExistsAllByIDsAndUser checks if all transactions exist for the given IDs and user.
 func (r *transactionRepository) ExistsAllByIDsAndUser(ctx context.Context, ids []uuid.UUID, userID uuid.UUID) (bool, error) {
 	var count int64
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Model(&model.TransactionModel{}).
 		Where("id IN ? AND user_id = ?", ids, userID).
 		Count(&count)
@@ -329,39 +557,39 @@ func (r *transactionRepository) BulkUpdateCategoryByPattern(
 	categoryID uuid.UUID,
 	ownerType entity.OwnerType,
 	ownerID uuid.UUID,
-) (int, error) {
-	var result *gorm.DB
+) ([]uuid.UUID, error) {
 	now := time.Now().UTC()
 
+	// Update and return the matching transactions in one statement, so a transaction categorized
+	// in the meantime is neither overwritten nor reported
+	var updatedModels []model.TransactionModel
+	query := conn(ctx, r.db).Model(&updatedModels).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}})
 	if ownerType == entity.OwnerTypeUser {
 		// For user: update transactions belonging to user that have no category
-		result = r.db.WithContext(ctx).
-			Model(&model.TransactionModel{}).
-			Where("user_id = ?", ownerID).
-			Where("category_id IS NULL").
-			Where("description ~* ?", pattern).
-			Updates(map[string]interface{}{
-				"category_id": categoryID,
-				"updated_at":  now,
-			})
+		query = query.Where("user_id = ?", ownerID)
 	} else {
 		// For group: update transactions belonging to any group member that have no category
-		result = r.db.WithContext(ctx).
-			Model(&model.TransactionModel{}).
-			Where("user_id IN (SELECT user_id FROM group_members WHERE group_id = ? AND deleted_at IS NULL)", ownerID).
-			Where("category_id IS NULL").
-			Where("description ~* ?", pattern).
-			Updates(map[string]interface{}{
-				"category_id": categoryID,
-				"updated_at":  now,
-			})
+		query = query.Where("user_id IN (SELECT user_id FROM group_members WHERE group_id = ? AND deleted_at IS NULL)", ownerID)
 	}
-
+	result := query.
+		Where("category_id IS NULL AND is_split = ?", false).
+		Where("type <> ?", string(entity.TransactionTypeTransfer)).
+		Where("description ~* ?", pattern).
+		Updates(map[string]interface{}{
+			"category_id": categoryID,
+			"updated_at":  now,
+		})
 	if result.Error != nil {
-		return 0, result.Error
+		return nil, result.Error
 	}
 
-	return int(result.RowsAffected), nil
+	updatedIDs := make([]uuid.UUID, len(updatedModels))
+	for i, tm := range updatedModels {
+		updatedIDs[i] = tm.ID
+	}
+
+	return updatedIDs, nil
 }
 
 // Credit card import methods
@@ -379,7 +607,7 @@ func (r *transactionRepository) FindPotentialBillPayments(
 
 	// Search for bill payment patterns - typically "Pagamento de fatura" or similar
 	// These are expense transactions (negative amounts in the bank statement)
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Where("user_id = ?", userID).
 		Where("date >= ? AND date <= ?", startDate, endDate).
 		Where("type = ?", string(entity.TransactionTypeExpense)).
@@ -407,7 +635,7 @@ func (r *transactionRepository) GetLinkedTransactions(
 ) ([]*entity.Transaction, error) {
 	var transactionModels []model.TransactionModel
 
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Where("credit_card_payment_id = ?", billPaymentID).
 		Order("date DESC, created_at DESC").
 		Find(&transactionModels)
@@ -424,6 +652,27 @@ func (r *transactionRepository) GetLinkedTransactions(
 	return transactions, nil
 }
 
+// getLinkedCardTransactions retrieves the transactions linked to a bill payment, only those of
+// the credit card when an account is given.
+func (r *transactionRepository) getLinkedCardTransactions(
+	ctx context.Context,
+	billPaymentID uuid.UUID,
+	accountID *uuid.UUID,
+) ([]*entity.Transaction, error) {
+	transactions, err := r.GetLinkedTransactions(ctx, billPaymentID)
+	if err != nil || accountID == nil {
+		return transactions, err
+	}
+
+	onCard := make([]*entity.Transaction, 0, len(transactions))
+	for _, txn := range transactions {
+		if txn.AccountID != nil && *txn.AccountID == *accountID {
+			onCard = append(onCard, txn)
+		}
+	}
+	return onCard, nil
+}
+
 // BulkCreateCCTransactions creates multiple CC transactions in a single operation.
 // It also updates the bill payment (zeroing amount, setting expanded_at, billing_cycle, etc.).
 func (r *transactionRepository) BulkCreateCCTransactions(
@@ -433,7 +682,7 @@ func (r *transactionRepository) BulkCreateCCTransactions(
 	originalAmount decimal.Decimal,
 	billingCycle string,
 ) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		now := time.Now().UTC()
 
 		// Create all CC transactions
@@ -470,7 +719,7 @@ func (r *transactionRepository) BulkCreateStandaloneCCTransactions(
 	ctx context.Context,
 	transactions []*entity.Transaction,
 ) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		// Create all CC transactions without updating any bill payment
 		for _, txn := range transactions {
 			transactionModel := model.TransactionFromEntity(txn)
@@ -491,7 +740,7 @@ func (r *transactionRepository) ExpandBillPayment(
 ) error {
 	now := time.Now().UTC()
 
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Model(&model.TransactionModel{}).
 		Where("id = ?", billPaymentID).
 		Updates(map[string]interface{}{
@@ -511,7 +760,7 @@ func (r *transactionRepository) ExpandBillPayment(
 
 // CollapseExpansion deletes all linked CC transactions and restores the bill payment.
 func (r *transactionRepository) CollapseExpansion(ctx context.Context, billPaymentID uuid.UUID) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		now := time.Now().UTC()
 
 		// First, get the original amount from the bill payment
@@ -553,12 +802,31 @@ func (r *transactionRepository) GetCreditCardStatus(
 	ctx context.Context,
 	userID uuid.UUID,
 	billingCycle string,
+	accountID *uuid.UUID,
 ) (*adapter.CreditCardStatus, error) {
+	// Limit card transactions to the card, and bills to those with transactions on it
+	onCard := func(db *gorm.DB) *gorm.DB {
+		if accountID == nil {
+			return db
+		}
+		return db.Where("account_id = ?", *accountID)
+	}
+	billOnCard := func(db *gorm.DB) *gorm.DB {
+		if accountID == nil {
+			return db
+		}
+		return db.Where(
+			"EXISTS (SELECT 1 FROM transactions card_txn WHERE card_txn.credit_card_payment_id = transactions.id AND card_txn.account_id = ?)",
+			*accountID,
+		)
+	}
+
 	// Find bill payment for this billing cycle (by billing_cycle field or date range)
 	var billPayment model.TransactionModel
 
 	// First try to find by explicit billing_cycle
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
+		Scopes(billOnCard).
 		Where("user_id = ?", userID).
 		Where("is_credit_card_payment = ?", true).
 		Where("billing_cycle = ?", billingCycle).
@@ -572,7 +840,8 @@ func (r *transactionRepository) GetCreditCardStatus(
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		// Look for any expanded bill with linked transactions in this billing cycle
 		var linkedTxn model.TransactionModel
-		result = r.db.WithContext(ctx).
+		result = conn(ctx, r.db).
+			Scopes(onCard).
 			Where("user_id = ?", userID).
 			Where("billing_cycle = ?", billingCycle).
 			Where("credit_card_payment_id IS NOT NULL").
@@ -582,7 +851,8 @@ func (r *transactionRepository) GetCreditCardStatus(
 			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 				// Check for standalone CC transactions (no linked bill payment)
 				var standaloneTxns []model.TransactionModel
-				standaloneResult := r.db.WithContext(ctx).
+				standaloneResult := conn(ctx, r.db).
+					Scopes(onCard).
 					Where("user_id = ?", userID).
 					Where("billing_cycle = ?", billingCycle).
 					Where("credit_card_payment_id IS NULL").
@@ -625,13 +895,13 @@ func (r *transactionRepository) GetCreditCardStatus(
 
 		// Found linked transaction, get the bill payment
 		if linkedTxn.CreditCardPaymentID != nil {
-			if err := r.db.WithContext(ctx).
+			if err := conn(ctx, r.db).
 				Where("id = ?", *linkedTxn.CreditCardPaymentID).
 				First(&billPayment).Error; err != nil {
 				if errors.Is(err, gorm.ErrRecordNotFound) {
 					// Bill payment was deleted but linked transactions still exist
 					// Return these as orphaned CC transactions
-					linkedTxns, linkedErr := r.GetLinkedTransactions(ctx, *linkedTxn.CreditCardPaymentID)
+					linkedTxns, linkedErr := r.getLinkedCardTransactions(ctx, *linkedTxn.CreditCardPaymentID, accountID)
 					if linkedErr != nil {
 						// If we can't get linked transactions, return empty status
 						return &adapter.CreditCardStatus{
@@ -653,7 +923,7 @@ func (r *transactionRepository) GetCreditCardStatus(
 	}
 
 	// Get linked transactions
-	linkedTransactions, err := r.GetLinkedTransactions(ctx, billPayment.ID)
+	linkedTransactions, err := r.getLinkedCardTransactions(ctx, billPayment.ID, accountID)
 	if err != nil {
 		return nil, err
 	}
@@ -679,7 +949,7 @@ func (r *transactionRepository) GetCreditCardStatus(
 func (r *transactionRepository) IsBillExpanded(ctx context.Context, billPaymentID uuid.UUID) (bool, error) {
 	var billPayment model.TransactionModel
 
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Select("expanded_at").
 		Where("id = ?", billPaymentID).
 		First(&billPayment)
@@ -702,7 +972,7 @@ func (r *transactionRepository) FindBillPaymentByID(
 ) (*entity.Transaction, error) {
 	var transactionModel model.TransactionModel
 
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Where("id = ? AND user_id = ?", id, userID).
 		First(&transactionModel)
 
@@ -725,7 +995,7 @@ func (r *transactionRepository) FindMostRecentCCBillingCycle(
 	var billingCycle string
 
 	// Find the most recent billing cycle from transactions that have a billing_cycle set
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Model(&model.TransactionModel{}).
 		Select("billing_cycle").
 		Where("user_id = ?", userID).
@@ -743,6 +1013,98 @@ func (r *transactionRepository) FindMostRecentCCBillingCycle(
 	return billingCycle, nil
 }
 
+// FindImportedCCTransactions retrieves the statement lines imported into a billing cycle.
+func (r *transactionRepository) FindImportedCCTransactions(
+	ctx context.Context,
+	userID uuid.UUID,
+	billingCycle string,
+	accountID *uuid.UUID,
+) ([]*entity.Transaction, error) {
+	var transactionModels []model.TransactionModel
+
+	query := conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Where("billing_cycle = ?", billingCycle).
+		Where("is_credit_card_payment = ?", false).
+		Where("is_manual_card_entry = ?", false)
+	if accountID != nil {
+		query = query.Where("account_id = ?", *accountID)
+	}
+
+	result := query.
+		Preload("Splits").
+		Preload("TransactionTags.Tag").
+		Order("date ASC, created_at ASC").
+		Find(&transactionModels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, tm := range transactionModels {
+		transactions[i] = tm.ToEntity()
+	}
+
+	return transactions, nil
+}
+
+// ApplyCCReimport applies the difference between a billing cycle and its corrected statement.
+func (r *transactionRepository) ApplyCCReimport(ctx context.Context, reimport *adapter.CCReimport) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, txn := range reimport.Added {
+			if err := tx.Create(model.TransactionFromEntity(txn)).Error; err != nil {
+				return err
+			}
+		}
+
+		// Save the changed transactions and replace their split lines, rescaled to the new amount
+		for _, txn := range reimport.Changed {
+			if err := tx.Save(model.TransactionFromEntity(txn)).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("transaction_id = ?", txn.ID).Delete(&model.TransactionSplitModel{}).Error; err != nil {
+				return err
+			}
+			for _, split := range txn.Splits {
+				if err := tx.Create(model.TransactionSplitFromEntity(split)).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(reimport.RemovedIDs) > 0 {
+			if err := tx.Where("id IN ?", reimport.RemovedIDs).Delete(&model.TransactionModel{}).Error; err != nil {
+				return err
+			}
+		}
+
+		// The bill now stands for the corrected statement
+		if reimport.BillPaymentID != nil {
+			result := tx.Model(&model.TransactionModel{}).
+				Where("id = ?", *reimport.BillPaymentID).
+				Updates(map[string]interface{}{
+					"original_amount": reimport.OriginalBillAmount,
+					"updated_at":      time.Now().UTC(),
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+		}
+
+		if len(reimport.Changes) > 0 {
+			changeModels := make([]*model.TransactionChangeModel, len(reimport.Changes))
+			for i, change := range reimport.Changes {
+				changeModels[i] = model.TransactionChangeFromEntity(change)
+			}
+			if err := tx.CreateInBatches(changeModels, transactionChangeBatchSize).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 // GetExpensesByDateRange returns all expense transactions for a user
 // within the specified date range, including category info.
 // Only returns transactions with a category assigned.
@@ -751,6 +1113,7 @@ func (r *transactionRepository) GetExpensesByDateRange(
 	userID uuid.UUID,
 	startDate time.Time,
 	endDate time.Time,
+	accountIDs []uuid.UUID,
 ) ([]*entity.ExpenseWithCategory, error) {
 	var results []struct {
 		ID            uuid.UUID
@@ -763,15 +1126,16 @@ func (r *transactionRepository) GetExpensesByDateRange(
 		CategoryColor string
 	}
 
-	// Query expenses with category info, filtering for expense type and valid categories
-	err := r.db.WithContext(ctx).
-		Table("transactions t").
+	// Query expenses with category info, filtering for expense type and valid categories.
+	// Split transactions yield one row per split line.
+	query := conn(ctx, r.db).
+		Table(categorizedTransactionsSQL+" AS t").
 		Select(`
 			t.id,
 			t.user_id,
 			t.date,
 			t.description,
-			ABS(t.amount) as amount,
+			ABS(ROUND(t.amount * t.exchange_rate, 2)) as amount,
 			t.category_id,
 			c.name as category_name,
 			c.color as category_color
@@ -783,7 +1147,13 @@ func (r *transactionRepository) GetExpensesByDateRange(
 		Where("t.date <= ?", endDate).
 		Where("t.deleted_at IS NULL").
 		Where("t.category_id IS NOT NULL").
-		Where("t.is_hidden = ?", false).
+		Where("t.is_hidden = ?", false)
+
+	if len(accountIDs) > 0 {
+		query = query.Where("t.account_id IN ?", accountIDs)
+	}
+
+	err := query.
 		Order("t.date ASC").
 		Scan(&results).Error
 
@@ -812,10 +1182,11 @@ func (r *transactionRepository) GetExpensesByDateRange(
 // CountUncategorizedByUser counts all transactions for a user that have no category assigned.
 func (r *transactionRepository) CountUncategorizedByUser(ctx context.Context, userID uuid.UUID) (int, error) {
 	var count int64
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Model(&model.TransactionModel{}).
 		Where("user_id = ?", userID).
-		Where("category_id IS NULL").
+		Where("category_id IS NULL AND is_split = ?", false).
+		Where("type <> ?", string(entity.TransactionTypeTransfer)).
 		Count(&count)
 
 	if result.Error != nil {
@@ -824,3 +1195,233 @@ func (r *transactionRepository) CountUncategorizedByUser(ctx context.Context, us
 
 	return int(count), nil
 }
+
+// FindExistingExternalIDs returns the subset of the given external IDs that already exist for the user.
+func (r *transactionRepository) FindExistingExternalIDs(
+	ctx context.Context,
+	userID uuid.UUID,
+	externalIDs []string,
+) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	if len(externalIDs) == 0 {
+		return existing, nil
+	}
+
+	var found []string
+	result := conn(ctx, r.db).
+		Unscoped().
+		Model(&model.TransactionModel{}).
+		Where("user_id = ?", userID).
+		Where("external_id IN ?", externalIDs).
+		Pluck("external_id", &found)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find existing external IDs: %w", result.Error)
+	}
+
+	for _, id := range found {
+		existing[id] = true
+	}
+
+	return existing, nil
+}
+
+// BulkCreate creates multiple transactions in a single database transaction.
+func (r *transactionRepository) BulkCreate(ctx context.Context, transactions []*entity.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, txn := range transactions {
+			transactionModel := model.TransactionFromEntity(txn)
+			if err := tx.Create(transactionModel).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FindDuplicateCandidates returns the user's transactions within the date range that can be compared for duplicates.
+func (r *transactionRepository) FindDuplicateCandidates(
+	ctx context.Context,
+	userID uuid.UUID,
+	startDate time.Time,
+	endDate time.Time,
+) ([]*entity.Transaction, error) {
+	var transactionModels []model.TransactionModel
+
+	// Bill payments are zeroed when expanded and hidden entries mirror other transactions,
+	// so neither can be a meaningful duplicate
+	result := conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Where("date >= ? AND date <= ?", startDate, endDate).
+		Where("is_credit_card_payment = ?", false).
+		Where("is_hidden = ?", false).
+		Where("type <> ?", string(entity.TransactionTypeTransfer)).
+		Order("date ASC, created_at ASC").
+		Find(&transactionModels)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find duplicate candidates: %w", result.Error)
+	}
+
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, tm := range transactionModels {
+		transactions[i] = tm.ToEntity()
+	}
+
+	return transactions, nil
+}
+
+// MergeDuplicate saves the kept transaction and soft-deletes the duplicate in a single database transaction.
+func (r *transactionRepository) MergeDuplicate(ctx context.Context, keep *entity.Transaction, duplicateID uuid.UUID) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(model.TransactionFromEntity(keep)).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ? AND user_id = ?", duplicateID, keep.UserID).Delete(&model.TransactionModel{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerror.ErrTransactionNotFound
+		}
+
+		return nil
+	})
+}
+
+// FindByTransferID retrieves the legs of a transfer, outgoing leg first.
+func (r *transactionRepository) FindByTransferID(ctx context.Context, transferID uuid.UUID) ([]*entity.Transaction, error) {
+	var transactionModels []model.TransactionModel
+	result := conn(ctx, r.db).
+		Where("transfer_id = ?", transferID).
+		Order("amount ASC").
+		Find(&transactionModels)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find transfer legs: %w", result.Error)
+	}
+
+	transactions := make([]*entity.Transaction, len(transactionModels))
+	for i, tm := range transactionModels {
+		transactions[i] = tm.ToEntity()
+	}
+
+	return transactions, nil
+}
+
+// SaveTransfer creates or updates both legs of a transfer in a single database transaction.
+func (r *transactionRepository) SaveTransfer(ctx context.Context, transfer *entity.Transfer) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, leg := range transfer.Legs() {
+			if err := tx.Save(model.TransactionFromEntity(leg)).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// DeleteTransfer soft-deletes both legs of a transfer in a single database transaction.
+func (r *transactionRepository) DeleteTransfer(ctx context.Context, transferID uuid.UUID, userID uuid.UUID) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("transfer_id = ? AND user_id = ?", transferID, userID).Delete(&model.TransactionModel{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domainerror.ErrTransferNotFound
+		}
+
+		return nil
+	})
+}
+
+// FindExchangeRateSnapshots returns the distinct currency and date pairs of the user's transactions.
+func (r *transactionRepository) FindExchangeRateSnapshots(ctx context.Context, userID uuid.UUID) ([]entity.ExchangeRateSnapshot, error) {
+	var results []struct {
+		Currency string    `gorm:"column:currency"`
+		Date     time.Time `gorm:"column:date"`
+	}
+
+	err := conn(ctx, r.db).
+		Unscoped().
+		Model(&model.TransactionModel{}).
+		Distinct("currency", "date").
+		Where("user_id = ?", userID).
+		Order("currency, date").
+		Scan(&results).Error
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]entity.ExchangeRateSnapshot, len(results))
+	for i, res := range results {
+		snapshots[i] = entity.ExchangeRateSnapshot{
+			Currency: res.Currency,
+			Date:     res.Date,
+		}
+	}
+	return snapshots, nil
+}
+
+// UpdateBaseCurrency sets the user's base currency and the exchange rate of the user's transactions
+// of each snapshot's currency and date.
+func (r *transactionRepository) UpdateBaseCurrency(ctx context.Context, userID uuid.UUID, baseCurrency string, snapshots []entity.ExchangeRateSnapshot) error {
+	now := time.Now().UTC()
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.UserModel{}).
+			Where("id = ?", userID).
+			Updates(map[string]interface{}{
+				"base_currency": baseCurrency,
+				"updated_at":    now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		for _, snapshot := range snapshots {
+			result := tx.Unscoped().
+				Model(&model.TransactionModel{}).
+				Where("user_id = ? AND currency = ? AND date = ?", userID, snapshot.Currency, snapshot.Date).
+				Updates(map[string]interface{}{
+					"exchange_rate": snapshot.Rate,
+					"updated_at":    now,
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+		}
+		return nil
+	})
+}
+
+// SaveSplits replaces the split lines of a transaction and saves its split flag and category
+// in a single database transaction.
+func (r *transactionRepository) SaveSplits(ctx context.Context, transaction *entity.Transaction) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("transaction_id = ?", transaction.ID).Delete(&model.TransactionSplitModel{}).Error; err != nil {
+			return err
+		}
+
+		for _, split := range transaction.Splits {
+			if err := tx.Create(model.TransactionSplitFromEntity(split)).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&model.TransactionModel{}).
+			Where("id = ?", transaction.ID).
+			Updates(map[string]interface{}{
+				"is_split":    transaction.IsSplit,
+				"category_id": transaction.CategoryID,
+				"updated_at":  transaction.UpdatedAt,
+			}).Error
+	})
+}
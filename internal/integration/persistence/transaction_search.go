@@ -0,0 +1,152 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// searchVectorSQL is the full-text vector of a transaction: its description (weight A) and notes
+// (weight B), unaccented and stemmed with the Portuguese dictionary. See migration 000029.
+const searchVectorSQL = "search_vector"
+
+// Full-text vectors restricted to the description or the notes.
+const (
+	descriptionVectorSQL = "ts_filter(search_vector, '{a}')"
+	notesVectorSQL       = "ts_filter(search_vector, '{b}')"
+)
+
+// Full-text queries of a single word, matched as a prefix, and of a phrase. An empty query
+// (e.g., a stop word such as "de") matches every transaction instead of none.
+const (
+	wordQuerySQL   = "to_tsquery('portuguese', f_unaccent(?) || ':*')"
+	phraseQuerySQL = "phraseto_tsquery('portuguese', f_unaccent(?))"
+)
+
+// Names of categories, accounts and tags are compared ignoring case, and also accents on Postgres.
+const (
+	nameMatchSQL         = "LOWER(name) = LOWER(?)"
+	unaccentNameMatchSQL = "f_unaccent(LOWER(name)) = f_unaccent(LOWER(?))"
+)
+
+// applyTransactionQuery restricts a transaction query to the transactions matching a search query.
+// The conditions are matched in a subquery on the transactions table, so they apply to whole
+// transactions even when the outer query reads split lines.
+func applyTransactionQuery(db *gorm.DB, query *gorm.DB, userID uuid.UUID, search *valueobject.TransactionQuery) *gorm.DB {
+	if search.IsEmpty() {
+		return query
+	}
+
+	// Full-text search relies on Postgres; other databases (e.g., in tests) fall back to substring matching
+	postgres := db.Dialector.Name() == "postgres"
+
+	matching := db.Model(&model.TransactionModel{}).Select("id").Where("user_id = ?", userID)
+	for _, condition := range search.Conditions {
+		conditionSQL, args := transactionQueryConditionSQL(condition, postgres)
+		if condition.Negated {
+			conditionSQL = "NOT " + conditionSQL
+		}
+		matching = matching.Where(conditionSQL, args...)
+	}
+
+	return query.Where("id IN (?)", matching)
+}
+
+// transactionQueryConditionSQL returns the parenthesized SQL condition and its arguments.
+func transactionQueryConditionSQL(condition valueobject.TransactionQueryCondition, postgres bool) (string, []interface{}) {
+	nameMatch := nameMatchSQL
+	if postgres {
+		nameMatch = unaccentNameMatchSQL
+	}
+
+	switch condition.Field {
+	case valueobject.TransactionQueryFieldAmount:
+		// Amounts are bound as text, so they are cast for a numeric comparison on every database
+		return comparisonSQL("ABS(amount)", "CAST(? AS NUMERIC)", condition.Operator, condition.Amount, condition.AmountTo)
+	case valueobject.TransactionQueryFieldDate:
+		// Dates are compared by day, whatever the time of the stored date
+		nextDay := condition.Date.AddDate(0, 0, 1)
+		switch condition.Operator {
+		case valueobject.TransactionQueryOperatorBetween:
+			return "(date >= ? AND date < ?)", []interface{}{condition.Date, condition.DateTo.AddDate(0, 0, 1)}
+		case valueobject.TransactionQueryOperatorGreater:
+			return "(date >= ?)", []interface{}{nextDay}
+		case valueobject.TransactionQueryOperatorLessOrEqual:
+			return "(date < ?)", []interface{}{nextDay}
+		default:
+			return comparisonSQL("date", "?", condition.Operator, condition.Date, nil)
+		}
+	case valueobject.TransactionQueryFieldType:
+		return "(type = ?)", []interface{}{string(condition.Type)}
+	case valueobject.TransactionQueryFieldCategory:
+		categoryIDs := "SELECT id FROM categories WHERE " + nameMatch
+		return fmt.Sprintf(
+			"((category_id IS NOT NULL AND category_id IN (%s)) OR id IN (SELECT transaction_id FROM transaction_splits WHERE category_id IN (%s)))",
+			categoryIDs, categoryIDs,
+		), []interface{}{condition.Text, condition.Text}
+	case valueobject.TransactionQueryFieldAccount:
+		return "(account_id IS NOT NULL AND account_id IN (SELECT id FROM accounts WHERE " + nameMatch + "))",
+			[]interface{}{condition.Text}
+	case valueobject.TransactionQueryFieldTag:
+		return "(id IN (SELECT transaction_id FROM transaction_tags WHERE tag_id IN (SELECT id FROM tags WHERE " + nameMatch + ")))",
+			[]interface{}{condition.Text}
+	default:
+		return textConditionSQL(condition, postgres)
+	}
+}
+
+// textConditionSQL matches words and phrases in the description, the notes or both.
+func textConditionSQL(condition valueobject.TransactionQueryCondition, postgres bool) (string, []interface{}) {
+	if !postgres {
+		pattern := "%" + strings.ToLower(condition.Text) + "%"
+		switch condition.Field {
+		case valueobject.TransactionQueryFieldDescription:
+			return "(LOWER(description) LIKE ?)", []interface{}{pattern}
+		case valueobject.TransactionQueryFieldNotes:
+			return "(LOWER(COALESCE(notes, '')) LIKE ?)", []interface{}{pattern}
+		default:
+			return "(LOWER(description) LIKE ? OR LOWER(COALESCE(notes, '')) LIKE ?)", []interface{}{pattern, pattern}
+		}
+	}
+
+	vector := searchVectorSQL
+	switch condition.Field {
+	case valueobject.TransactionQueryFieldDescription:
+		vector = descriptionVectorSQL
+	case valueobject.TransactionQueryFieldNotes:
+		vector = notesVectorSQL
+	}
+
+	tsQuery := phraseQuerySQL
+	if !condition.Phrase && isSearchWord(condition.Text) {
+		tsQuery = wordQuerySQL
+	}
+	return fmt.Sprintf("(numnode(%s) = 0 OR %s @@ %s)", tsQuery, vector, tsQuery),
+		[]interface{}{condition.Text, condition.Text}
+}
+
+// comparisonSQL compares a column with a value placeholder, or with an inclusive range for between conditions.
+func comparisonSQL(column, placeholder string, operator valueobject.TransactionQueryOperator, value interface{}, valueTo interface{}) (string, []interface{}) {
+	if operator == valueobject.TransactionQueryOperatorBetween {
+		return fmt.Sprintf("(%s BETWEEN %s AND %s)", column, placeholder, placeholder), []interface{}{value, valueTo}
+	}
+	return fmt.Sprintf("(%s %s %s)", column, operator, placeholder), []interface{}{value}
+}
+
+// isSearchWord returns true if the text only has letters and digits, so it is safe to use
+// as a to_tsquery prefix term.
+func isSearchWord(text string) bool {
+	for _, r := range text {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return text != ""
+}
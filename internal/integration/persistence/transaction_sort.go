@@ -0,0 +1,70 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/domain/valueobject"
+)
+
+// transactionSortKeySQL returns the SQL expression transactions of the given table (or alias) are sorted by.
+// Categories are looked up in a subquery so the expression does not depend on the joins of the query.
+func transactionSortKeySQL(field valueobject.TransactionSortField, table string) string {
+	switch field {
+	case valueobject.TransactionSortByAmount:
+		return "ABS(" + table + ".amount)"
+	case valueobject.TransactionSortByDescription:
+		return table + ".description"
+	case valueobject.TransactionSortByCategory:
+		return fmt.Sprintf(
+			"COALESCE((SELECT sc.name FROM categories sc WHERE sc.id = %s.category_id AND sc.deleted_at IS NULL), '')",
+			table,
+		)
+	default:
+		return table + ".date"
+	}
+}
+
+// applyTransactionSort orders a transaction query by the sort key, breaking ties by creation time and ID
+// so the order is the same on every page. With a cursor, only transactions after it are kept.
+func applyTransactionSort(
+	query *gorm.DB,
+	table string,
+	sort valueobject.TransactionSort,
+	cursor *valueobject.TransactionCursor,
+) (*gorm.DB, error) {
+	sortKey := transactionSortKeySQL(sort.Field, table)
+	direction := "DESC"
+	comparison := "<"
+	if sort.Direction == valueobject.SortAscending {
+		direction = "ASC"
+		comparison = ">"
+	}
+	query = query.Order(fmt.Sprintf("%[1]s %[3]s, %[2]s.created_at %[3]s, %[2]s.id %[3]s", sortKey, table, direction))
+
+	if cursor == nil {
+		return query, nil
+	}
+
+	// Amounts are bound as text, so they are cast for a numeric comparison on every database
+	var value interface{} = cursor.Value
+	placeholder := "?"
+	switch sort.Field {
+	case valueobject.TransactionSortByDate:
+		date, err := time.Parse(time.RFC3339Nano, cursor.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date in cursor: %w", err)
+		}
+		value = date
+	case valueobject.TransactionSortByAmount:
+		placeholder = "CAST(? AS NUMERIC)"
+	}
+
+	return query.Where(
+		fmt.Sprintf("(%s, %s.created_at, %s.id) %s (%s, ?, ?)", sortKey, table, table, comparison, placeholder),
+		value, cursor.CreatedAt, cursor.ID,
+	), nil
+}
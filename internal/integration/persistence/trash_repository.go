@@ -0,0 +1,450 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+)
+
+// trashItemTypes lists the types kept in the trash, in the order they are listed on equal deletion times.
+var trashItemTypes = []entity.TrashItemType{
+	entity.TrashItemTypeTransaction,
+	entity.TrashItemTypeCategory,
+	entity.TrashItemTypeGoal,
+	entity.TrashItemTypeCategoryRule,
+}
+
+// purgeableCategorySQL matches categories no live goal or rule refers to. Goals and rules are removed
+// with their category by ON DELETE CASCADE, so a category still in use stays in the trash.
+const purgeableCategorySQL = `NOT EXISTS (
+		SELECT 1 FROM goals g WHERE g.category_id = categories.id AND g.deleted_at IS NULL)
+	AND NOT EXISTS (
+		SELECT 1 FROM category_rules cr WHERE cr.category_id = categories.id AND cr.deleted_at IS NULL)`
+
+// trashReferenceResult represents a row of a deleted reference query.
+type trashReferenceResult struct {
+	ItemID       uuid.UUID
+	CategoryID   uuid.UUID
+	CategoryName string
+}
+
+// trashRepository implements the adapter.TrashRepository interface.
+type trashRepository struct {
+	db *gorm.DB
+}
+
+// NewTrashRepository creates a new trash repository instance.
+func NewTrashRepository(db *gorm.DB) adapter.TrashRepository {
+	return &trashRepository{
+		db: db,
+	}
+}
+
+// List retrieves the user's trash, most recently deleted first, with the total count.
+// Each type is queried separately and merged, as each lives in its own table.
+func (r *trashRepository) List(
+	ctx context.Context,
+	userID uuid.UUID,
+	itemType *entity.TrashItemType,
+	limit, offset int,
+) ([]*entity.TrashItem, int64, error) {
+	itemTypes := trashItemTypes
+	if itemType != nil {
+		itemTypes = []entity.TrashItemType{*itemType}
+	}
+
+	var total int64
+	var items []*entity.TrashItem
+	for _, t := range itemTypes {
+		var count int64
+		if err := r.trashQuery(ctx, t, userID).Count(&count).Error; err != nil {
+			return nil, 0, err
+		}
+		total += count
+		if count == 0 {
+			continue
+		}
+
+		// The requested page is within the first offset+limit items of each type
+		typeItems, err := r.findItems(ctx, t, r.trashQuery(ctx, t, userID).
+			Order("deleted_at DESC").
+			Limit(offset+limit))
+		if err != nil {
+			return nil, 0, err
+		}
+		items = append(items, typeItems...)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+	if offset >= len(items) {
+		return []*entity.TrashItem{}, total, nil
+	}
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end], total, nil
+}
+
+// FindByRefs retrieves the referenced items that are in the user's trash; others are left out.
+func (r *trashRepository) FindByRefs(ctx context.Context, userID uuid.UUID, refs []entity.TrashItemRef) ([]*entity.TrashItem, error) {
+	var items []*entity.TrashItem
+	for t, ids := range groupTrashRefs(refs) {
+		typeItems, err := r.findItems(ctx, t, r.trashQuery(ctx, t, userID).Where("id IN ?", ids))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, typeItems...)
+	}
+	return items, nil
+}
+
+// FindDeletedReferences retrieves the categories in the trash that the items refer to.
+// Transactions refer to categories directly and through their splits; goals and rules cannot exist without them.
+func (r *trashRepository) FindDeletedReferences(ctx context.Context, refs []entity.TrashItemRef) ([]*entity.TrashReference, error) {
+	db := r.db.WithContext(ctx)
+	grouped := groupTrashRefs(refs)
+	var references []*entity.TrashReference
+	seen := make(map[[2]uuid.UUID]bool)
+	add := func(itemType entity.TrashItemType, results []trashReferenceResult, detachable bool) {
+		for _, result := range results {
+			key := [2]uuid.UUID{result.ItemID, result.CategoryID}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			references = append(references, &entity.TrashReference{
+				Item:         entity.TrashItemRef{Type: itemType, ID: result.ItemID},
+				CategoryID:   result.CategoryID,
+				CategoryName: result.CategoryName,
+				Detachable:   detachable,
+			})
+		}
+	}
+
+	if ids := grouped[entity.TrashItemTypeTransaction]; len(ids) > 0 {
+		transactionIDs, err := r.withTransferLegs(db, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		var results []trashReferenceResult
+		if err := db.Table("transactions t").
+			Select("t.id AS item_id, c.id AS category_id, c.name AS category_name").
+			Joins("JOIN categories c ON c.id = t.category_id").
+			Where("t.id IN ? AND c.deleted_at IS NOT NULL", transactionIDs).
+			Order("c.name ASC").
+			Scan(&results).Error; err != nil {
+			return nil, err
+		}
+		add(entity.TrashItemTypeTransaction, results, true)
+
+		results = nil
+		if err := db.Table("transaction_splits s").
+			Select("s.transaction_id AS item_id, c.id AS category_id, c.name AS category_name").
+			Joins("JOIN categories c ON c.id = s.category_id").
+			Where("s.transaction_id IN ? AND c.deleted_at IS NOT NULL", transactionIDs).
+			Order("c.name ASC").
+			Scan(&results).Error; err != nil {
+			return nil, err
+		}
+		add(entity.TrashItemTypeTransaction, results, true)
+	}
+
+	for t, table := range map[entity.TrashItemType]string{
+		entity.TrashItemTypeGoal:         "goals",
+		entity.TrashItemTypeCategoryRule: "category_rules",
+	} {
+		ids := grouped[t]
+		if len(ids) == 0 {
+			continue
+		}
+
+		var results []trashReferenceResult
+		if err := db.Table(table+" i").
+			Select("i.id AS item_id, c.id AS category_id, c.name AS category_name").
+			Joins("JOIN categories c ON c.id = i.category_id").
+			Where("i.id IN ? AND c.deleted_at IS NOT NULL", ids).
+			Order("c.name ASC").
+			Scan(&results).Error; err != nil {
+			return nil, err
+		}
+		add(t, results, false)
+	}
+
+	return references, nil
+}
+
+// FindConflicts retrieves the items that would duplicate an active item if restored:
+// categories whose name is in use, goals for a category that has an active goal and rules whose pattern is in use.
+func (r *trashRepository) FindConflicts(ctx context.Context, refs []entity.TrashItemRef) ([]*entity.TrashItem, error) {
+	db := r.db.WithContext(ctx)
+	grouped := groupTrashRefs(refs)
+	var conflicts []*entity.TrashItem
+
+	if ids := grouped[entity.TrashItemTypeCategory]; len(ids) > 0 {
+		items, err := r.findItems(ctx, entity.TrashItemTypeCategory, db.Unscoped().
+			Model(&model.CategoryModel{}).
+			Where("id IN ?", ids).
+			Where(`EXISTS (SELECT 1 FROM categories a WHERE a.owner_type = categories.owner_type
+				AND a.owner_id = categories.owner_id AND a.name = categories.name AND a.deleted_at IS NULL)`))
+		if err != nil {
+			return nil, err
+		}
+		conflicts = append(conflicts, items...)
+	}
+
+	if ids := grouped[entity.TrashItemTypeGoal]; len(ids) > 0 {
+		items, err := r.findItems(ctx, entity.TrashItemTypeGoal, db.Unscoped().
+			Model(&model.GoalModel{}).
+			Where("id IN ?", ids).
+			Where(`EXISTS (SELECT 1 FROM goals a WHERE a.user_id = goals.user_id
+				AND a.category_id = goals.category_id AND a.deleted_at IS NULL)`))
+		if err != nil {
+			return nil, err
+		}
+		conflicts = append(conflicts, items...)
+	}
+
+	if ids := grouped[entity.TrashItemTypeCategoryRule]; len(ids) > 0 {
+		items, err := r.findItems(ctx, entity.TrashItemTypeCategoryRule, db.Unscoped().
+			Model(&model.CategoryRuleModel{}).
+			Where("id IN ?", ids).
+			Where(`EXISTS (SELECT 1 FROM category_rules a WHERE a.owner_type = category_rules.owner_type
+				AND a.owner_id = category_rules.owner_id AND a.pattern = category_rules.pattern AND a.deleted_at IS NULL)`))
+		if err != nil {
+			return nil, err
+		}
+		conflicts = append(conflicts, items...)
+	}
+
+	return conflicts, nil
+}
+
+// Restore restores the items in a single database transaction. Both legs of a transfer are restored together.
+// References to the detached categories and to deleted accounts are cleared from restored transactions.
+// Returns the IDs of the restored transactions, transfer legs included.
+func (r *trashRepository) Restore(ctx context.Context, userID uuid.UUID, refs []entity.TrashItemRef, detachCategoryIDs []uuid.UUID) ([]uuid.UUID, error) {
+	grouped := groupTrashRefs(refs)
+	var restoredIDs []uuid.UUID
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Categories first, so restored items never refer to a deleted one
+		if ids := grouped[entity.TrashItemTypeCategory]; len(ids) > 0 {
+			if err := tx.Unscoped().Model(&model.CategoryModel{}).
+				Where("id IN ? AND owner_type = ? AND owner_id = ?", ids, string(entity.OwnerTypeUser), userID).
+				Update("deleted_at", nil).Error; err != nil {
+				return err
+			}
+		}
+
+		if ids := grouped[entity.TrashItemTypeTransaction]; len(ids) > 0 {
+			transactionIDs, err := r.withTransferLegs(tx, ids)
+			if err != nil {
+				return err
+			}
+			if err := tx.Unscoped().Model(&model.TransactionModel{}).
+				Where("id IN ? AND user_id = ?", transactionIDs, userID).
+				Update("deleted_at", nil).Error; err != nil {
+				return err
+			}
+			restoredIDs = transactionIDs
+
+			if len(detachCategoryIDs) > 0 {
+				if err := tx.Model(&model.TransactionModel{}).
+					Where("id IN ? AND category_id IN ?", transactionIDs, detachCategoryIDs).
+					Update("category_id", nil).Error; err != nil {
+					return err
+				}
+				if err := tx.Model(&model.TransactionSplitModel{}).
+					Where("transaction_id IN ? AND category_id IN ?", transactionIDs, detachCategoryIDs).
+					Update("category_id", nil).Error; err != nil {
+					return err
+				}
+			}
+
+			// Deleted accounts cannot be restored, so their transactions become unassigned
+			if err := tx.Model(&model.TransactionModel{}).
+				Where("id IN ? AND account_id IN (SELECT id FROM accounts WHERE deleted_at IS NOT NULL)", transactionIDs).
+				Update("account_id", nil).Error; err != nil {
+				return err
+			}
+		}
+
+		if ids := grouped[entity.TrashItemTypeGoal]; len(ids) > 0 {
+			if err := tx.Unscoped().Model(&model.GoalModel{}).
+				Where("id IN ? AND user_id = ?", ids, userID).
+				Update("deleted_at", nil).Error; err != nil {
+				return err
+			}
+		}
+
+		if ids := grouped[entity.TrashItemTypeCategoryRule]; len(ids) > 0 {
+			if err := tx.Unscoped().Model(&model.CategoryRuleModel{}).
+				Where("id IN ? AND owner_type = ? AND owner_id = ?", ids, string(entity.OwnerTypeUser), userID).
+				Update("deleted_at", nil).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restoredIDs, nil
+}
+
+// Purge permanently deletes every item that was deleted before the given time and returns how many were deleted.
+// Dependent rows (splits, tags, goal alerts, etc.) are removed by the foreign keys' ON DELETE CASCADE.
+// Categories still used by live goals or rules are kept until those are deleted too.
+func (r *trashRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var purged int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Categories last, as transactions, goals and rules refer to them
+		for _, m := range []interface{}{
+			&model.TransactionModel{},
+			&model.CategoryRuleModel{},
+			&model.GoalModel{},
+			&model.CategoryModel{},
+		} {
+			query := tx.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore)
+			if _, ok := m.(*model.CategoryModel); ok {
+				query = query.Where(purgeableCategorySQL)
+			}
+			result := query.Delete(m)
+			if result.Error != nil {
+				return result.Error
+			}
+			purged += result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// trashQuery returns a query for the user's soft-deleted items of the given type.
+func (r *trashRepository) trashQuery(ctx context.Context, itemType entity.TrashItemType, userID uuid.UUID) *gorm.DB {
+	db := r.db.WithContext(ctx).Unscoped()
+	switch itemType {
+	case entity.TrashItemTypeCategory:
+		db = db.Model(&model.CategoryModel{}).
+			Where("owner_type = ? AND owner_id = ?", string(entity.OwnerTypeUser), userID)
+	case entity.TrashItemTypeGoal:
+		db = db.Model(&model.GoalModel{}).Where("user_id = ?", userID)
+	case entity.TrashItemTypeCategoryRule:
+		db = db.Model(&model.CategoryRuleModel{}).
+			Where("owner_type = ? AND owner_id = ?", string(entity.OwnerTypeUser), userID)
+	default:
+		db = db.Model(&model.TransactionModel{}).Where("user_id = ?", userID)
+	}
+	return db.Where("deleted_at IS NOT NULL")
+}
+
+// findItems runs a query on the table of the given type and converts the rows to trash items.
+func (r *trashRepository) findItems(ctx context.Context, itemType entity.TrashItemType, query *gorm.DB) ([]*entity.TrashItem, error) {
+	var items []*entity.TrashItem
+	switch itemType {
+	case entity.TrashItemTypeCategory:
+		var categoryModels []model.CategoryModel
+		if err := query.Find(&categoryModels).Error; err != nil {
+			return nil, err
+		}
+		for _, m := range categoryModels {
+			items = append(items, newTrashItem(itemType, m.ID, m.Name, m.DeletedAt))
+		}
+	case entity.TrashItemTypeGoal:
+		var goalModels []model.GoalModel
+		if err := query.Find(&goalModels).Error; err != nil {
+			return nil, err
+		}
+
+		// Spending limits are named after their category, which may be in the trash as well
+		var categoryIDs []uuid.UUID
+		for _, m := range goalModels {
+			if m.Name == "" && m.CategoryID != nil {
+				categoryIDs = append(categoryIDs, *m.CategoryID)
+			}
+		}
+		categoryNames := make(map[uuid.UUID]string)
+		if len(categoryIDs) > 0 {
+			var categoryModels []model.CategoryModel
+			if err := r.db.WithContext(ctx).Unscoped().
+				Where("id IN ?", categoryIDs).
+				Find(&categoryModels).Error; err != nil {
+				return nil, err
+			}
+			for _, c := range categoryModels {
+				categoryNames[c.ID] = c.Name
+			}
+		}
+
+		for _, m := range goalModels {
+			name := m.Name
+			if name == "" && m.CategoryID != nil {
+				name = categoryNames[*m.CategoryID]
+			}
+			items = append(items, newTrashItem(itemType, m.ID, name, m.DeletedAt))
+		}
+	case entity.TrashItemTypeCategoryRule:
+		var ruleModels []model.CategoryRuleModel
+		if err := query.Find(&ruleModels).Error; err != nil {
+			return nil, err
+		}
+		for _, m := range ruleModels {
+			items = append(items, newTrashItem(itemType, m.ID, m.Pattern, m.DeletedAt))
+		}
+	default:
+		var transactionModels []model.TransactionModel
+		if err := query.Find(&transactionModels).Error; err != nil {
+			return nil, err
+		}
+		for _, m := range transactionModels {
+			items = append(items, newTrashItem(itemType, m.ID, m.Description, m.DeletedAt))
+		}
+	}
+	return items, nil
+}
+
+// withTransferLegs returns the transaction IDs together with the other legs of their transfers.
+func (r *trashRepository) withTransferLegs(db *gorm.DB, ids []uuid.UUID) ([]uuid.UUID, error) {
+	var legIDs []uuid.UUID
+	if err := db.Unscoped().Model(&model.TransactionModel{}).
+		Where("transfer_id IN (SELECT transfer_id FROM transactions WHERE id IN ? AND transfer_id IS NOT NULL)", ids).
+		Where("id NOT IN ?", ids).
+		Pluck("id", &legIDs).Error; err != nil {
+		return nil, err
+	}
+	return append(append([]uuid.UUID{}, ids...), legIDs...), nil
+}
+
+// groupTrashRefs groups the referenced IDs by item type.
+func groupTrashRefs(refs []entity.TrashItemRef) map[entity.TrashItemType][]uuid.UUID {
+	grouped := make(map[entity.TrashItemType][]uuid.UUID)
+	for _, ref := range refs {
+		grouped[ref.Type] = append(grouped[ref.Type], ref.ID)
+	}
+	return grouped
+}
+
+// newTrashItem creates a trash item from a soft-deleted row.
+func newTrashItem(itemType entity.TrashItemType, id uuid.UUID, name string, deletedAt gorm.DeletedAt) *entity.TrashItem {
+	return &entity.TrashItem{
+		Type:      itemType,
+		ID:        id,
+		Name:      name,
+		DeletedAt: deletedAt.Time,
+	}
+}
@@ -0,0 +1,44 @@
+// Package persistence implements repository interfaces for database operations.
+package persistence
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+// txKey is the context key of the running database transaction.
+type txKey struct{}
+
+// txManager implements the adapter.TxManager interface.
+type txManager struct {
+	db *gorm.DB
+}
+
+// NewTxManager creates a new transaction manager instance.
+func NewTxManager(db *gorm.DB) adapter.TxManager {
+	return &txManager{
+		db: db,
+	}
+}
+
+// WithinTx runs fn in a database transaction carried by the context passed to it.
+func (m *txManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// conn returns the database transaction running in ctx, or db when there is none. Repositories
+// that take part in transactions started by the TxManager use it instead of db.WithContext.
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
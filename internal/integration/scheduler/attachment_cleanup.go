@@ -0,0 +1,125 @@
+// Package scheduler provides background jobs that run periodically.
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/attachment"
+)
+
+// AttachmentCleanupScheduler purges orphaned attachments periodically and whenever a user
+// deletes transactions or accounts. Notifications are coalesced per user and handled in the
+// scheduler loop, so object storage latency never slows down the request that triggered them.
+type AttachmentCleanupScheduler struct {
+	purgeUseCase *attachment.PurgeOrphanedAttachmentsUseCase
+	interval     time.Duration
+
+	mu      sync.Mutex
+	pending map[uuid.UUID]struct{}
+	wake    chan struct{}
+}
+
+// AttachmentCleanupSchedulerConfig holds configuration for the attachment cleanup scheduler.
+type AttachmentCleanupSchedulerConfig struct {
+	Interval time.Duration
+}
+
+// DefaultAttachmentCleanupSchedulerConfig returns the default attachment cleanup scheduler configuration.
+func DefaultAttachmentCleanupSchedulerConfig() AttachmentCleanupSchedulerConfig {
+	return AttachmentCleanupSchedulerConfig{
+		Interval: time.Hour,
+	}
+}
+
+// NewAttachmentCleanupScheduler creates a new attachment cleanup scheduler.
+func NewAttachmentCleanupScheduler(
+	purgeUseCase *attachment.PurgeOrphanedAttachmentsUseCase,
+	config AttachmentCleanupSchedulerConfig,
+) *AttachmentCleanupScheduler {
+	return &AttachmentCleanupScheduler{
+		purgeUseCase: purgeUseCase,
+		interval:     config.Interval,
+		pending:      make(map[uuid.UUID]struct{}),
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+// NotifyOwnersDeleted schedules the cleanup of the user's orphaned attachments.
+func (s *AttachmentCleanupScheduler) NotifyOwnersDeleted(userID uuid.UUID) {
+	s.mu.Lock()
+	s.pending[userID] = struct{}{}
+	s.mu.Unlock()
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start begins the scheduler loop. It blocks until the context is cancelled.
+func (s *AttachmentCleanupScheduler) Start(ctx context.Context) {
+	slog.Info("Attachment cleanup scheduler started",
+		"interval", s.interval,
+	)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	// Purge immediately on start, then on ticker
+	s.run(ctx, nil)
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("Attachment cleanup scheduler shutting down")
+			return
+		case <-ticker.C:
+			s.run(ctx, nil)
+		case <-s.wake:
+			for _, userID := range s.takePending() {
+				userID := userID
+				s.run(ctx, &userID)
+			}
+		}
+	}
+}
+
+// takePending returns and clears the users waiting for a cleanup.
+func (s *AttachmentCleanupScheduler) takePending() []uuid.UUID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	userIDs := make([]uuid.UUID, 0, len(s.pending))
+	for userID := range s.pending {
+		userIDs = append(userIDs, userID)
+	}
+	s.pending = make(map[uuid.UUID]struct{})
+	return userIDs
+}
+
+// run performs a single purge, for one user or for everyone when userID is nil.
+func (s *AttachmentCleanupScheduler) run(ctx context.Context, userID *uuid.UUID) {
+	output, err := s.purgeUseCase.Execute(ctx, attachment.PurgeOrphanedAttachmentsInput{
+		UserID: userID,
+		Now:    time.Now().UTC(),
+	})
+	if err != nil {
+		slog.Error("Failed to purge orphaned attachments", "error", err)
+		return
+	}
+	if output.PurgedCount > 0 || output.FailedCount > 0 {
+		slog.Info("Purged orphaned attachments",
+			"purged", output.PurgedCount,
+			"failed", output.FailedCount,
+		)
+	}
+}
+
+// Ensure AttachmentCleanupScheduler implements adapter.AttachmentCleanupNotifier.
+var _ adapter.AttachmentCleanupNotifier = (*AttachmentCleanupScheduler)(nil)
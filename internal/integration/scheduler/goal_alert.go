@@ -0,0 +1,122 @@
+// Package scheduler provides background jobs that run periodically.
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/goal"
+)
+
+// GoalAlertScheduler evaluates spending goals periodically and whenever a user's
+// transactions change. Notifications are coalesced per user and handled in the
+// scheduler loop, so they never slow down the request that triggered them.
+type GoalAlertScheduler struct {
+	evaluateUseCase *goal.EvaluateGoalAlertsUseCase
+	interval        time.Duration
+
+	mu      sync.Mutex
+	pending map[uuid.UUID]struct{}
+	wake    chan struct{}
+}
+
+// GoalAlertSchedulerConfig holds configuration for the goal alert scheduler.
+type GoalAlertSchedulerConfig struct {
+	Interval time.Duration
+}
+
+// DefaultGoalAlertSchedulerConfig returns the default goal alert scheduler configuration.
+func DefaultGoalAlertSchedulerConfig() GoalAlertSchedulerConfig {
+	return GoalAlertSchedulerConfig{
+		Interval: time.Hour,
+	}
+}
+
+// NewGoalAlertScheduler creates a new goal alert scheduler.
+func NewGoalAlertScheduler(evaluateUseCase *goal.EvaluateGoalAlertsUseCase, config GoalAlertSchedulerConfig) *GoalAlertScheduler {
+	return &GoalAlertScheduler{
+		evaluateUseCase: evaluateUseCase,
+		interval:        config.Interval,
+		pending:         make(map[uuid.UUID]struct{}),
+		wake:            make(chan struct{}, 1),
+	}
+}
+
+// NotifyTransactionsChanged schedules a goal evaluation for the user.
+func (s *GoalAlertScheduler) NotifyTransactionsChanged(userID uuid.UUID) {
+	s.mu.Lock()
+	s.pending[userID] = struct{}{}
+	s.mu.Unlock()
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start begins the scheduler loop. It blocks until the context is cancelled.
+func (s *GoalAlertScheduler) Start(ctx context.Context) {
+	slog.Info("Goal alert scheduler started",
+		"interval", s.interval,
+	)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	// Evaluate all goals immediately on start, then on ticker
+	s.run(ctx, nil)
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("Goal alert scheduler shutting down")
+			return
+		case <-ticker.C:
+			s.run(ctx, nil)
+		case <-s.wake:
+			for _, userID := range s.takePending() {
+				userID := userID
+				s.run(ctx, &userID)
+			}
+		}
+	}
+}
+
+// takePending returns and clears the users waiting for an evaluation.
+func (s *GoalAlertScheduler) takePending() []uuid.UUID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	userIDs := make([]uuid.UUID, 0, len(s.pending))
+	for userID := range s.pending {
+		userIDs = append(userIDs, userID)
+	}
+	s.pending = make(map[uuid.UUID]struct{})
+	return userIDs
+}
+
+// run performs a single evaluation, for one user or for everyone when userID is nil.
+func (s *GoalAlertScheduler) run(ctx context.Context, userID *uuid.UUID) {
+	output, err := s.evaluateUseCase.Execute(ctx, goal.EvaluateGoalAlertsInput{
+		UserID: userID,
+		Now:    time.Now().UTC(),
+	})
+	if err != nil {
+		slog.Error("Failed to evaluate goal alerts", "error", err)
+		return
+	}
+	if output.AlertsQueued > 0 {
+		slog.Info("Queued goal alerts",
+			"goals_evaluated", output.GoalsEvaluated,
+			"alerts_queued", output.AlertsQueued,
+		)
+	}
+}
+
+// Ensure GoalAlertScheduler implements adapter.GoalAlertNotifier.
+var _ adapter.GoalAlertNotifier = (*GoalAlertScheduler)(nil)
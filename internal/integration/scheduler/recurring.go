@@ -0,0 +1,88 @@
+// Package scheduler provides background jobs that run periodically.
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	recurringschedule "github.com/finance-tracker/backend/internal/application/usecase/recurring_schedule"
+)
+
+// RecurringScheduler periodically materializes recurring schedules into transactions
+// and queues reminder emails about upcoming ones.
+type RecurringScheduler struct {
+	processUseCase *recurringschedule.ProcessRecurringSchedulesUseCase
+	interval       time.Duration
+	lookaheadDays  int
+	reminderDays   int
+}
+
+// RecurringSchedulerConfig holds configuration for the recurring scheduler.
+type RecurringSchedulerConfig struct {
+	Interval      time.Duration
+	LookaheadDays int
+	ReminderDays  int
+}
+
+// DefaultRecurringSchedulerConfig returns the default recurring scheduler configuration.
+func DefaultRecurringSchedulerConfig() RecurringSchedulerConfig {
+	return RecurringSchedulerConfig{
+		Interval:      time.Hour,
+		LookaheadDays: 0,
+		ReminderDays:  3,
+	}
+}
+
+// NewRecurringScheduler creates a new recurring scheduler.
+func NewRecurringScheduler(processUseCase *recurringschedule.ProcessRecurringSchedulesUseCase, config RecurringSchedulerConfig) *RecurringScheduler {
+	return &RecurringScheduler{
+		processUseCase: processUseCase,
+		interval:       config.Interval,
+		lookaheadDays:  config.LookaheadDays,
+		reminderDays:   config.ReminderDays,
+	}
+}
+
+// Start begins the scheduler loop. It blocks until the context is cancelled.
+func (s *RecurringScheduler) Start(ctx context.Context) {
+	slog.Info("Recurring scheduler started",
+		"interval", s.interval,
+		"lookahead_days", s.lookaheadDays,
+		"reminder_days", s.reminderDays,
+	)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	// Process immediately on start, then on ticker
+	s.run(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("Recurring scheduler shutting down")
+			return
+		case <-ticker.C:
+			s.run(ctx)
+		}
+	}
+}
+
+// run performs a single scheduler run.
+func (s *RecurringScheduler) run(ctx context.Context) {
+	output, err := s.processUseCase.Execute(ctx, recurringschedule.ProcessRecurringSchedulesInput{
+		Now:           time.Now().UTC(),
+		LookaheadDays: s.lookaheadDays,
+		ReminderDays:  s.reminderDays,
+	})
+	if err != nil {
+		slog.Error("Failed to process recurring schedules", "error", err)
+	}
+	if output != nil && (output.GeneratedCount > 0 || output.RemindersQueued > 0) {
+		slog.Info("Processed recurring schedules",
+			"generated", output.GeneratedCount,
+			"reminders_queued", output.RemindersQueued,
+		)
+	}
+}
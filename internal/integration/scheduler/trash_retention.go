@@ -0,0 +1,79 @@
+// Package scheduler provides background jobs that run periodically.
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/finance-tracker/backend/internal/application/usecase/trash"
+)
+
+// TrashRetentionScheduler periodically purges items that have been in the trash longer than the retention period.
+type TrashRetentionScheduler struct {
+	purgeUseCase  *trash.PurgeTrashUseCase
+	interval      time.Duration
+	retentionDays int
+}
+
+// TrashRetentionSchedulerConfig holds configuration for the trash retention scheduler.
+type TrashRetentionSchedulerConfig struct {
+	Interval      time.Duration
+	RetentionDays int
+}
+
+// DefaultTrashRetentionSchedulerConfig returns the default trash retention scheduler configuration.
+func DefaultTrashRetentionSchedulerConfig() TrashRetentionSchedulerConfig {
+	return TrashRetentionSchedulerConfig{
+		Interval:      time.Hour,
+		RetentionDays: trash.DefaultRetentionDays,
+	}
+}
+
+// NewTrashRetentionScheduler creates a new trash retention scheduler.
+func NewTrashRetentionScheduler(purgeUseCase *trash.PurgeTrashUseCase, config TrashRetentionSchedulerConfig) *TrashRetentionScheduler {
+	return &TrashRetentionScheduler{
+		purgeUseCase:  purgeUseCase,
+		interval:      config.Interval,
+		retentionDays: config.RetentionDays,
+	}
+}
+
+// Start begins the scheduler loop. It blocks until the context is cancelled.
+func (s *TrashRetentionScheduler) Start(ctx context.Context) {
+	slog.Info("Trash retention scheduler started",
+		"interval", s.interval,
+		"retention_days", s.retentionDays,
+	)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	// Purge immediately on start, then on ticker
+	s.run(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("Trash retention scheduler shutting down")
+			return
+		case <-ticker.C:
+			s.run(ctx)
+		}
+	}
+}
+
+// run performs a single purge.
+func (s *TrashRetentionScheduler) run(ctx context.Context) {
+	output, err := s.purgeUseCase.Execute(ctx, trash.PurgeTrashInput{
+		Now:           time.Now().UTC(),
+		RetentionDays: s.retentionDays,
+	})
+	if err != nil {
+		slog.Error("Failed to purge trash", "error", err)
+		return
+	}
+	if output.PurgedCount > 0 {
+		slog.Info("Purged trash", "purged", output.PurgedCount)
+	}
+}
@@ -0,0 +1,98 @@
+package statement
+
+import "regexp"
+
+// Issuer identifiers of the built-in credit card statement layouts.
+const (
+	CardIssuerNubank   = "nubank"
+	CardIssuerItau     = "itau"
+	CardIssuerBradesco = "bradesco"
+	CardIssuerInter    = "inter"
+	CardIssuerC6       = "c6"
+)
+
+// DefaultCardStatementLayouts returns the built-in layouts of the major Brazilian card issuers.
+func DefaultCardStatementLayouts() []CardStatementLayout {
+	return []CardStatementLayout{
+		NubankLayout(),
+		ItauLayout(),
+		BradescoLayout(),
+		InterLayout(),
+		C6Layout(),
+	}
+}
+
+// NubankLayout reads Nubank statements: "15 OUT Mercado R$ 150,00", credits prefixed with a minus sign,
+// installments as "Parcela 2/10" and the original amount of international purchases on the next line.
+func NubankLayout() CardStatementLayout {
+	return &regexCardLayout{
+		issuer:      CardIssuerNubank,
+		detect:      regexp.MustCompile(`(?i)nu pagamentos s\.?a`),
+		dueDate:     regexp.MustCompile(`(?i)vencimento:?\s*(?P<day>\d{2}) (?P<month>[a-z]{3}) (?P<year>\d{4})`),
+		total:       regexp.MustCompile(`(?i)total a pagar:?\s*R\$\s*([\d.]+,\d{2})`),
+		line:        regexp.MustCompile(`^(?P<day>\d{2}) (?P<month>[A-Z]{3}) (?P<description>.+?) (?P<credit>[−-])?R\$ ?(?P<amount>[\d.]+,\d{2})$`),
+		foreign:     regexp.MustCompile(`^(?P<currency>[A-Z]{3}) (?P<original>[\d.]+,\d{2})$`),
+		installment: regexp.MustCompile(`(?i)parcela (\d{1,2})/(\d{1,2})`),
+		payment:     regexp.MustCompile(`(?i)^pagamento recebido`),
+	}
+}
+
+// ItauLayout reads Itaú (Itaucard) statements: "10/10 MERCADO EXTRA 150,00", credits with a leading
+// minus sign, installments as a "02/10" suffix and international purchases as "9,99 USD 55,14".
+// Installments of the next bills, listed after the current ones, are not read.
+func ItauLayout() CardStatementLayout {
+	return &regexCardLayout{
+		issuer:      CardIssuerItau,
+		detect:      regexp.MustCompile(`(?i)itaucard|ita[uú] unibanco`),
+		dueDate:     regexp.MustCompile(`(?i)vencimento:?\s*(?P<day>\d{2})/(?P<month>\d{2})/(?P<year>\d{4})`),
+		total:       regexp.MustCompile(`(?i)total desta fatura:?\s*(?:R\$)?\s*([\d.]+,\d{2})`),
+		line:        regexp.MustCompile(`^(?P<day>\d{2})/(?P<month>\d{2}) (?P<description>.+?)(?: (?P<original>[\d.]+,\d{2}) (?P<currency>[A-Z]{3}))? (?P<credit>-)?(?P<amount>[\d.]+,\d{2})$`),
+		installment: regexp.MustCompile(`\b(\d{2})/(\d{2})$`),
+		payment:     regexp.MustCompile(`(?i)^pagamento efetuado`),
+		stop:        regexp.MustCompile(`(?i)^compras parceladas - pr[oó]ximas faturas`),
+	}
+}
+
+// BradescoLayout reads Bradesco statements: "05/11 MERCADO EXTRA 150,00", credits with a trailing
+// minus sign, installments as "PARC 02/10" and international purchases as "USD 9,99 55,14".
+func BradescoLayout() CardStatementLayout {
+	return &regexCardLayout{
+		issuer:      CardIssuerBradesco,
+		detect:      regexp.MustCompile(`(?i)bradesco cart[oõ]es|bradescard`),
+		dueDate:     regexp.MustCompile(`(?i)vencimento:?\s*(?P<day>\d{2})/(?P<month>\d{2})/(?P<year>\d{4})`),
+		total:       regexp.MustCompile(`(?i)total da fatura:?\s*(?:R\$)?\s*([\d.]+,\d{2})`),
+		line:        regexp.MustCompile(`^(?P<day>\d{2})/(?P<month>\d{2}) (?P<description>.+?)(?: (?P<currency>[A-Z]{3}) (?P<original>[\d.]+,\d{2}))? (?P<amount>[\d.]+,\d{2})(?P<credit>-)?$`),
+		installment: regexp.MustCompile(`(?i)\bparc\.? ?(\d{2})/(\d{2})`),
+		payment:     regexp.MustCompile(`(?i)^pagto\.? por deb|^pagamento`),
+	}
+}
+
+// InterLayout reads Banco Inter statements: "05 de out. 2024 MERCADO EXTRA R$ 150,00" (dates with the
+// year), credits prefixed with a plus sign, installments as "(Parcela 02 de 10)" and international
+// purchases as "SPOTIFY (USD 9,99)".
+func InterLayout() CardStatementLayout {
+	return &regexCardLayout{
+		issuer:      CardIssuerInter,
+		detect:      regexp.MustCompile(`(?i)banco inter|inter&co`),
+		dueDate:     regexp.MustCompile(`(?i)data de vencimento:?\s*(?P<day>\d{2})/(?P<month>\d{2})/(?P<year>\d{4})`),
+		total:       regexp.MustCompile(`(?i)total da sua fatura:?\s*R\$\s*([\d.]+,\d{2})`),
+		line:        regexp.MustCompile(`^(?P<day>\d{2}) de (?P<month>[a-z]{3})\.? (?P<year>\d{4}) (?P<description>.+?)(?: \((?P<currency>[A-Z]{3}) (?P<original>[\d.]+,\d{2})\))? (?P<credit>\+ )?R\$ (?P<amount>[\d.]+,\d{2})$`),
+		installment: regexp.MustCompile(`(?i)\(parcela (\d{1,2}) de (\d{1,2})\)`),
+		payment:     regexp.MustCompile(`(?i)^pagamento on ?line|^pagto`),
+	}
+}
+
+// C6Layout reads C6 Bank statements: "05 dez MERCADO EXTRA 150,00", credits with a leading minus sign,
+// installments as "Parcela 2/10" and the original amount of international purchases on the next line.
+func C6Layout() CardStatementLayout {
+	return &regexCardLayout{
+		issuer:      CardIssuerC6,
+		detect:      regexp.MustCompile(`(?i)c6 bank|c6 carbon|banco c6`),
+		dueDate:     regexp.MustCompile(`(?i)vencimento:?\s*(?P<day>\d{2}) de (?P<month>[a-zç]+) de (?P<year>\d{4})`),
+		total:       regexp.MustCompile(`(?i)valor da fatura:?\s*R\$\s*([\d.]+,\d{2})`),
+		line:        regexp.MustCompile(`^(?P<day>\d{2}) (?P<month>[a-z]{3}) (?P<description>.+?) (?P<credit>-)?(?P<amount>[\d.]+,\d{2})$`),
+		foreign:     regexp.MustCompile(`^Valor original: (?P<currency>[A-Z]{3}) (?P<original>[\d.]+,\d{2})`),
+		installment: regexp.MustCompile(`(?i)parcela (\d{1,2})/(\d{1,2})`),
+		payment:     regexp.MustCompile(`(?i)^inclus[aã]o de pagamento`),
+	}
+}
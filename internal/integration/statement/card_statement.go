@@ -0,0 +1,281 @@
+package statement
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+var (
+	// ErrInvalidCardStatement is returned when the text does not look like a credit card statement of the layout.
+	ErrInvalidCardStatement = errors.New("invalid credit card statement")
+	// ErrUnsupportedCardIssuer is returned when no layout recognizes the statement.
+	ErrUnsupportedCardIssuer = errors.New("unsupported credit card statement layout")
+)
+
+// PaymentReceivedDescription is the description reported for the bill payments listed in a statement,
+// so that they are recognized as "Pagamento recebido" entries whatever the issuer prints.
+const PaymentReceivedDescription = "Pagamento recebido"
+
+// cardIOFRegex matches IOF (tax on international purchases) lines.
+var cardIOFRegex = regexp.MustCompile(`(?i)\biof\b`)
+
+// cardStatementMonths maps the Portuguese month abbreviations (and month name prefixes) to months.
+var cardStatementMonths = map[string]time.Month{
+	"jan": time.January, "fev": time.February, "mar": time.March, "abr": time.April,
+	"mai": time.May, "jun": time.June, "jul": time.July, "ago": time.August,
+	"set": time.September, "out": time.October, "nov": time.November, "dez": time.December,
+}
+
+// CardStatementLayout reads the statements (faturas) of one credit card issuer.
+// Implementations are registered with NewCardStatementParser.
+type CardStatementLayout interface {
+	// Issuer returns the identifier of the issuer, such as "nubank".
+	Issuer() string
+	// Detect reports whether the statement text was produced by the issuer.
+	Detect(text string) bool
+	// Parse reads the statement text. The returned statement's Issuer is filled in by the parser.
+	Parse(text string) (*adapter.ParsedCardStatement, error)
+}
+
+// cardStatementParser implements the adapter.CardStatementParser interface.
+type cardStatementParser struct {
+	layouts []CardStatementLayout
+}
+
+// NewCardStatementParser creates a new PDF credit card statement parser instance.
+// Layouts are tried in order; the first one that detects the statement reads it.
+func NewCardStatementParser(layouts ...CardStatementLayout) adapter.CardStatementParser {
+	return &cardStatementParser{layouts: layouts}
+}
+
+// ParsePDF extracts the statement text and reads it with the layout of the issuer that produced it.
+func (p *cardStatementParser) ParsePDF(data []byte) (*adapter.ParsedCardStatement, error) {
+	text, err := ExtractPDFText(data)
+	if err != nil {
+		return nil, err
+	}
+	return p.parseText(text)
+}
+
+// parseText reads extracted statement text.
+func (p *cardStatementParser) parseText(text string) (*adapter.ParsedCardStatement, error) {
+	for _, layout := range p.layouts {
+		if !layout.Detect(text) {
+			continue
+		}
+		parsed, err := layout.Parse(text)
+		if err != nil {
+			return nil, err
+		}
+		parsed.Issuer = layout.Issuer()
+		return parsed, nil
+	}
+	return nil, ErrUnsupportedCardIssuer
+}
+
+// regexCardLayout is a CardStatementLayout described by regular expressions, one transaction per line.
+//
+// The line expression uses the named groups "day", "month" (number or Portuguese abbreviation),
+// "description" and "amount" (Brazilian format), and optionally "year", "credit" (non-empty for
+// payments and refunds), and "currency" and "original" for international purchases. The due date
+// expression uses "day", "month" and "year".
+type regexCardLayout struct {
+	issuer      string
+	detect      *regexp.Regexp
+	dueDate     *regexp.Regexp
+	total       *regexp.Regexp // First group is the bill total
+	line        *regexp.Regexp
+	foreign     *regexp.Regexp // Line after an international purchase, with "currency" and "original"
+	installment *regexp.Regexp // Groups are the current and total installments
+	payment     *regexp.Regexp // Description of bill payments
+	stop        *regexp.Regexp // Line after which transactions are no longer listed (next bills)
+}
+
+// Issuer returns the identifier of the issuer.
+func (l *regexCardLayout) Issuer() string {
+	return l.issuer
+}
+
+// Detect reports whether the statement text was produced by the issuer.
+func (l *regexCardLayout) Detect(text string) bool {
+	return l.detect.MatchString(text)
+}
+
+// Parse reads the statement text.
+func (l *regexCardLayout) Parse(text string) (*adapter.ParsedCardStatement, error) {
+	dueMatch := namedGroups(l.dueDate, text)
+	if dueMatch == nil {
+		return nil, fmt.Errorf("%w: due date not found", ErrInvalidCardStatement)
+	}
+	dueDate, err := statementDate(dueMatch["day"], dueMatch["month"], dueMatch["year"], time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid due date", ErrInvalidCardStatement)
+	}
+
+	parsed := &adapter.ParsedCardStatement{
+		DueDate: dueDate,
+		Lines:   []adapter.ParsedCardStatementLine{},
+		Errors:  []adapter.StatementLineError{},
+	}
+	if l.total != nil {
+		if match := l.total.FindStringSubmatch(text); match != nil {
+			if total, err := parseStatementAmount(match[1]); err == nil {
+				parsed.Total = &total
+			}
+		}
+	}
+
+	lastLineRow := 0
+	for i, raw := range strings.Split(text, "\n") {
+		row := i + 1
+		content := strings.TrimSpace(raw)
+		if l.stop != nil && l.stop.MatchString(content) {
+			break
+		}
+
+		// Original currency amount printed below an international purchase
+		if l.foreign != nil && lastLineRow == row-1 {
+			if match := namedGroups(l.foreign, content); match != nil {
+				if original, err := parseStatementAmount(match["original"]); err == nil {
+					last := &parsed.Lines[len(parsed.Lines)-1]
+					last.OriginalAmount = &original
+					last.OriginalCurrency = match["currency"]
+				}
+				continue
+			}
+		}
+
+		match := namedGroups(l.line, content)
+		if match == nil {
+			continue
+		}
+		line, err := l.parseLine(row, match, dueDate)
+		if err != nil {
+			parsed.Errors = append(parsed.Errors, adapter.StatementLineError{Row: row, Message: err.Error()})
+			continue
+		}
+		parsed.Lines = append(parsed.Lines, *line)
+		lastLineRow = row
+	}
+
+	return parsed, nil
+}
+
+// parseLine builds a statement line from the groups matched by the line expression.
+func (l *regexCardLayout) parseLine(row int, match map[string]string, dueDate time.Time) (*adapter.ParsedCardStatementLine, error) {
+	date, err := statementDate(match["day"], match["month"], match["year"], dueDate)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseStatementAmount(match["amount"])
+	if err != nil {
+		return nil, err
+	}
+
+	line := &adapter.ParsedCardStatementLine{
+		Row:         row,
+		Date:        date,
+		Description: strings.TrimSpace(match["description"]),
+		Amount:      amount,
+		IsIOF:       cardIOFRegex.MatchString(match["description"]),
+	}
+	if match["credit"] != "" {
+		line.Amount = amount.Neg()
+	}
+	if l.payment.MatchString(line.Description) {
+		line.Description = PaymentReceivedDescription
+		line.Amount = amount.Neg()
+	}
+
+	if installment := l.installment.FindStringSubmatch(line.Description); installment != nil {
+		current, _ := strconv.Atoi(installment[1])
+		total, _ := strconv.Atoi(installment[2])
+		if current >= 1 && current <= total {
+			line.InstallmentCurrent = &current
+			line.InstallmentTotal = &total
+		}
+	}
+
+	if match["original"] != "" {
+		original, err := parseStatementAmount(match["original"])
+		if err != nil {
+			return nil, err
+		}
+		line.OriginalAmount = &original
+		line.OriginalCurrency = match["currency"]
+	}
+
+	return line, nil
+}
+
+// namedGroups returns the named groups of the first match of the expression, or nil.
+func namedGroups(re *regexp.Regexp, text string) map[string]string {
+	match := re.FindStringSubmatch(text)
+	if match == nil {
+		return nil
+	}
+	groups := make(map[string]string, len(match))
+	for i, name := range re.SubexpNames() {
+		if name != "" {
+			groups[name] = match[i]
+		}
+	}
+	return groups
+}
+
+// statementDate builds a statement date. Without a year, the date is the last one on or
+// before the due date, as purchases are listed in the months before the bill is due.
+func statementDate(dayText, monthText, yearText string, dueDate time.Time) (time.Time, error) {
+	day, err := strconv.Atoi(dayText)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid day %q", dayText)
+	}
+
+	var month time.Month
+	if number, err := strconv.Atoi(monthText); err == nil {
+		month = time.Month(number)
+	} else if runes := []rune(strings.ToLower(monthText)); len(runes) >= 3 {
+		month = cardStatementMonths[string(runes[:3])]
+	}
+	if month < time.January || month > time.December {
+		return time.Time{}, fmt.Errorf("invalid month %q", monthText)
+	}
+
+	year := dueDate.Year()
+	if yearText != "" {
+		if year, err = strconv.Atoi(yearText); err != nil {
+			return time.Time{}, fmt.Errorf("invalid year %q", yearText)
+		}
+		if year < 100 {
+			year += 2000
+		}
+	} else if month > dueDate.Month() || (month == dueDate.Month() && day > dueDate.Day()) {
+		year--
+	}
+
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day {
+		return time.Time{}, fmt.Errorf("invalid date %s/%s", dayText, monthText)
+	}
+	return date, nil
+}
+
+// parseStatementAmount parses an amount in the Brazilian format ("1.234,56"), without sign.
+func parseStatementAmount(text string) (decimal.Decimal, error) {
+	cleaned := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(text), "R$"))
+	cleaned = strings.ReplaceAll(cleaned, ".", "")
+	cleaned = strings.Replace(cleaned, ",", ".", 1)
+	amount, err := decimal.NewFromString(cleaned)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid amount %q", text)
+	}
+	return amount, nil
+}
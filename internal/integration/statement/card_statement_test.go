@@ -0,0 +1,136 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	creditcard "github.com/finance-tracker/backend/internal/application/usecase/credit_card"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of the statement layouts")
+
+var cardIssuers = []string{CardIssuerNubank, CardIssuerItau, CardIssuerBradesco, CardIssuerInter, CardIssuerC6}
+
+func readCardSample(t *testing.T, issuer string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "card", issuer+".txt"))
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	return string(data)
+}
+
+// TestCardStatementParser_Golden compares the parsed sample statement of each issuer layout with its
+// golden file. Run with -update to rewrite the golden files after an intended change.
+func TestCardStatementParser_Golden(t *testing.T) {
+	parser := &cardStatementParser{layouts: DefaultCardStatementLayouts()}
+	paymentReceivedRegex := regexp.MustCompile(creditcard.PaymentReceivedPattern)
+
+	for _, issuer := range cardIssuers {
+		t.Run(issuer, func(t *testing.T) {
+			parsed, err := parser.parseText(readCardSample(t, issuer))
+			if err != nil {
+				t.Fatalf("parseText() error = %v", err)
+			}
+			if parsed.Issuer != issuer {
+				t.Errorf("Issuer = %q, want %q", parsed.Issuer, issuer)
+			}
+
+			// Bill payments must be recognized by the import as "Pagamento recebido" entries
+			payments := 0
+			for _, line := range parsed.Lines {
+				if paymentReceivedRegex.MatchString(line.Description) {
+					payments++
+					if !line.Amount.IsNegative() {
+						t.Errorf("payment at row %d has amount %s, want negative", line.Row, line.Amount)
+					}
+				}
+			}
+			if payments != 1 {
+				t.Errorf("found %d payment received lines, want 1", payments)
+			}
+
+			got, err := json.MarshalIndent(parsed, "", "  ")
+			if err != nil {
+				t.Fatalf("failed to marshal statement: %v", err)
+			}
+			got = append(got, '\n')
+
+			goldenPath := filepath.Join("testdata", "card", issuer+".golden.json")
+			if *updateGolden {
+				if err := os.WriteFile(goldenPath, got, 0o644); err != nil {
+					t.Fatalf("failed to update golden file: %v", err)
+				}
+			}
+			want, err := os.ReadFile(goldenPath)
+			if err != nil {
+				t.Fatalf("failed to read golden file: %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("parsed statement does not match %s:\n%s", goldenPath, got)
+			}
+		})
+	}
+}
+
+func TestCardStatementLayouts_DetectOnlyOwnIssuer(t *testing.T) {
+	for _, issuer := range cardIssuers {
+		text := readCardSample(t, issuer)
+		for _, layout := range DefaultCardStatementLayouts() {
+			if detected := layout.Detect(text); detected != (layout.Issuer() == issuer) {
+				t.Errorf("%s layout Detect(%s sample) = %v", layout.Issuer(), issuer, detected)
+			}
+		}
+	}
+}
+
+func TestCardStatementParser_ParsePDF(t *testing.T) {
+	text := readCardSample(t, CardIssuerC6)
+
+	// Draw each line of the sample with its own text object, as statement generators do
+	var content strings.Builder
+	for i, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		var encoded bytes.Buffer
+		for _, r := range line {
+			encoded.WriteByte(byte(r)) // The sample only uses Latin-1 characters
+		}
+		escaped := strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`).Replace(encoded.String())
+		fmt.Fprintf(&content, "BT /F1 9 Tf 40 %d Td (%s) Tj ET\n", 800-12*i, escaped)
+	}
+	data := buildTestPDF(helveticaFont, true, content.String())
+
+	parser := NewCardStatementParser(DefaultCardStatementLayouts()...)
+	fromPDF, err := parser.ParsePDF(data)
+	if err != nil {
+		t.Fatalf("ParsePDF() error = %v", err)
+	}
+	fromText, err := parser.(*cardStatementParser).parseText(text)
+	if err != nil {
+		t.Fatalf("parseText() error = %v", err)
+	}
+
+	got, _ := json.Marshal(fromPDF)
+	want, _ := json.Marshal(fromText)
+	if !bytes.Equal(got, want) {
+		t.Errorf("ParsePDF() = %s, want %s", got, want)
+	}
+}
+
+func TestCardStatementParser_Errors(t *testing.T) {
+	parser := NewCardStatementParser(DefaultCardStatementLayouts()...).(*cardStatementParser)
+
+	if _, err := parser.parseText("Extrato da conta corrente\n05/11 PIX RECEBIDO 100,00"); !errors.Is(err, ErrUnsupportedCardIssuer) {
+		t.Errorf("parseText(unknown issuer) error = %v, want %v", err, ErrUnsupportedCardIssuer)
+	}
+	if _, err := parser.parseText("C6 Bank\n05 dez MERCADO EXTRA 150,00"); !errors.Is(err, ErrInvalidCardStatement) {
+		t.Errorf("parseText(missing due date) error = %v, want %v", err, ErrInvalidCardStatement)
+	}
+}
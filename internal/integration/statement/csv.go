@@ -0,0 +1,395 @@
+package statement
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ErrInvalidCSV is returned when the file cannot be read as CSV or does not match the column mapping.
+var ErrInvalidCSV = errors.New("invalid CSV file")
+
+// utf8BOM is the byte order mark some spreadsheet tools prepend to CSV exports.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+var (
+	// csvInstallmentRegex matches installment info in a dedicated column, such as "1/3" or "02 / 12".
+	csvInstallmentRegex = regexp.MustCompile(`(\d{1,2})\s*/\s*(\d{1,2})`)
+
+	// csvDescriptionInstallmentRegex matches installment info embedded in a description (e.g., "Loja - Parcela 1/3").
+	csvDescriptionInstallmentRegex = regexp.MustCompile(`(?i)parcela\s*(\d{1,2})\s*/\s*(\d{1,2})`)
+)
+
+// csvDateLayouts maps the user date formats to Go layouts (separators are normalized to "/" or "-").
+var csvDateLayouts = map[entity.DateFormat]string{
+	entity.DateFormatDMY: "2/1/2006",
+	entity.DateFormatMDY: "1/2/2006",
+	entity.DateFormatYMD: "2006-1-2",
+}
+
+// csvParser implements the adapter.CSVStatementParser interface.
+type csvParser struct{}
+
+// NewCSVParser creates a new CSV statement parser instance.
+func NewCSVParser() adapter.CSVStatementParser {
+	return &csvParser{}
+}
+
+// csvColumns holds the resolved zero-based indexes of the mapped columns (-1 when unmapped).
+type csvColumns struct {
+	date        int
+	description int
+	amount      int
+	debit       int
+	credit      int
+	installment int
+}
+
+// ParseCSV parses the file using the profile's layout, column mapping and formats.
+func (p *csvParser) ParseCSV(data []byte, profile *entity.ImportProfile) (*adapter.ParsedStatement, error) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+	if !utf8.Valid(data) {
+		data = latin1ToUTF8(data)
+	}
+
+	// Drop leading lines (bank name, account info, etc.) before the table starts
+	content := string(data)
+	skipped := 0
+	for i := 0; i < profile.SkipRows && content != ""; i++ {
+		idx := strings.IndexByte(content, '\n')
+		if idx < 0 {
+			content = ""
+		} else {
+			content = content[idx+1:]
+		}
+		skipped++
+	}
+
+	delimiter := profile.Delimiter
+	if delimiter == "" {
+		delimiter = detectDelimiter(content)
+	}
+
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.Comma, _ = utf8.DecodeRuneInString(delimiter)
+	reader.LazyQuotes = true
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := readCSVRecords(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCSV, err)
+	}
+
+	var header []string
+	firstDataRecord := 0
+	if profile.HasHeader {
+		if len(records) == 0 {
+			return nil, fmt.Errorf("%w: header row not found", ErrInvalidCSV)
+		}
+		header = records[0].fields
+		firstDataRecord = 1
+	}
+
+	columns, err := resolveCSVColumns(profile, header)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &adapter.ParsedStatement{
+		Lines:  []adapter.ParsedStatementLine{},
+		Errors: []adapter.StatementLineError{},
+	}
+	occurrences := make(map[string]int)
+
+	for _, record := range records[firstDataRecord:] {
+		row := record.line + skipped
+		if isBlankRecord(record.fields) {
+			continue
+		}
+
+		line, err := buildCSVLine(record.fields, columns, profile)
+		if err != nil {
+			result.Errors = append(result.Errors, adapter.StatementLineError{
+				Row:     row,
+				Message: err.Error(),
+			})
+			continue
+		}
+
+		line.Row = row
+		line.ExternalID = csvExternalID(line, occurrences)
+		result.Lines = append(result.Lines, line)
+	}
+
+	return result, nil
+}
+
+// csvRecord is a CSV record with the 1-based line number where it starts.
+type csvRecord struct {
+	line   int
+	fields []string
+}
+
+// readCSVRecords reads all records along with their starting line numbers.
+func readCSVRecords(reader *csv.Reader) ([]csvRecord, error) {
+	var records []csvRecord
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, csvRecord{line: line, fields: fields})
+	}
+}
+
+// detectDelimiter picks the most frequent candidate delimiter in the first line.
+func detectDelimiter(content string) string {
+	firstLine := content
+	if idx := strings.IndexByte(content, '\n'); idx >= 0 {
+		firstLine = content[:idx]
+	}
+
+	best, bestCount := ",", 0
+	for _, candidate := range []string{",", ";", "\t"} {
+		if count := strings.Count(firstLine, candidate); count > bestCount {
+			best, bestCount = candidate, count
+		}
+	}
+	return best
+}
+
+// resolveCSVColumns maps the profile's column references to indexes.
+// References are matched against the header (case-insensitive) or parsed as zero-based indexes.
+func resolveCSVColumns(profile *entity.ImportProfile, header []string) (csvColumns, error) {
+	resolve := func(ref string) (int, error) {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			return -1, nil
+		}
+		for i, name := range header {
+			if strings.EqualFold(strings.TrimSpace(name), ref) {
+				return i, nil
+			}
+		}
+		if idx, err := strconv.Atoi(ref); err == nil && idx >= 0 {
+			return idx, nil
+		}
+		return -1, fmt.Errorf("%w: column %q not found", ErrInvalidCSV, ref)
+	}
+
+	var columns csvColumns
+	var err error
+	if columns.date, err = resolve(profile.DateColumn); err != nil {
+		return columns, err
+	}
+	if columns.description, err = resolve(profile.DescriptionColumn); err != nil {
+		return columns, err
+	}
+	if columns.amount, err = resolve(profile.AmountColumn); err != nil {
+		return columns, err
+	}
+	if columns.debit, err = resolve(profile.DebitColumn); err != nil {
+		return columns, err
+	}
+	if columns.credit, err = resolve(profile.CreditColumn); err != nil {
+		return columns, err
+	}
+	if columns.installment, err = resolve(profile.InstallmentColumn); err != nil {
+		return columns, err
+	}
+
+	if columns.date < 0 || columns.description < 0 {
+		return columns, fmt.Errorf("%w: date and description columns are required", ErrInvalidCSV)
+	}
+	if columns.amount < 0 && columns.debit < 0 && columns.credit < 0 {
+		return columns, fmt.Errorf("%w: an amount or debit/credit column is required", ErrInvalidCSV)
+	}
+
+	return columns, nil
+}
+
+// buildCSVLine converts a CSV record into a parsed statement line.
+func buildCSVLine(fields []string, columns csvColumns, profile *entity.ImportProfile) (adapter.ParsedStatementLine, error) {
+	var line adapter.ParsedStatementLine
+
+	field := func(idx int) string {
+		if idx < 0 || idx >= len(fields) {
+			return ""
+		}
+		return strings.TrimSpace(fields[idx])
+	}
+
+	date, err := parseCSVDate(field(columns.date), profile.DateFormat)
+	if err != nil {
+		return line, err
+	}
+
+	description := strings.Join(strings.Fields(field(columns.description)), " ")
+	if description == "" {
+		return line, errors.New("description is empty")
+	}
+
+	var amount decimal.Decimal
+	if columns.amount >= 0 {
+		amount, err = parseCSVAmount(field(columns.amount), profile.NumberFormat)
+		if err != nil {
+			return line, err
+		}
+	} else {
+		debitValue, creditValue := field(columns.debit), field(columns.credit)
+		if debitValue == "" && creditValue == "" {
+			return line, errors.New("debit and credit are both empty")
+		}
+		if debitValue != "" {
+			debit, err := parseCSVAmount(debitValue, profile.NumberFormat)
+			if err != nil {
+				return line, err
+			}
+			amount = amount.Sub(debit.Abs())
+		}
+		if creditValue != "" {
+			credit, err := parseCSVAmount(creditValue, profile.NumberFormat)
+			if err != nil {
+				return line, err
+			}
+			amount = amount.Add(credit.Abs())
+		}
+	}
+
+	if profile.InvertAmounts {
+		amount = amount.Neg()
+	}
+	if amount.IsZero() {
+		return line, errors.New("amount is zero")
+	}
+
+	// Installment info comes from its own column or, failing that, the description
+	installmentRegex, installmentSource := csvInstallmentRegex, field(columns.installment)
+	if columns.installment < 0 {
+		installmentRegex, installmentSource = csvDescriptionInstallmentRegex, description
+	}
+	if matches := installmentRegex.FindStringSubmatch(installmentSource); matches != nil {
+		current, _ := strconv.Atoi(matches[1])
+		total, _ := strconv.Atoi(matches[2])
+		if current >= 1 && total > 1 && current <= total {
+			line.InstallmentCurrent = &current
+			line.InstallmentTotal = &total
+		}
+	}
+
+	line.Date = date
+	line.Description = description
+	line.Amount = amount
+
+	return line, nil
+}
+
+// parseCSVDate parses a date in the given format, ignoring any time component.
+func parseCSVDate(value string, format entity.DateFormat) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, errors.New("date is empty")
+	}
+
+	layout, ok := csvDateLayouts[format]
+	if !ok {
+		return time.Time{}, fmt.Errorf("unsupported date format %q", format)
+	}
+
+	if idx := strings.IndexAny(value, " T"); idx > 0 {
+		value = value[:idx]
+	}
+
+	separator := "/"
+	if format == entity.DateFormatYMD {
+		separator = "-"
+	}
+	value = strings.NewReplacer("/", separator, "-", separator, ".", separator).Replace(value)
+
+	date, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected %s", value, format)
+	}
+	return date, nil
+}
+
+// parseCSVAmount parses a monetary value in the given number format.
+// Currency symbols and spaces are ignored; values in parentheses are negative.
+func parseCSVAmount(value string, format entity.NumberFormat) (decimal.Decimal, error) {
+	original := value
+	negative := false
+
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = value[1 : len(value)-1]
+	}
+
+	value = strings.Map(func(r rune) rune {
+		if (r >= '0' && r <= '9') || r == '-' || r == '+' || r == ',' || r == '.' {
+			return r
+		}
+		return -1
+	}, value)
+
+	switch format {
+	case entity.NumberFormatBR:
+		value = strings.ReplaceAll(value, ".", "")
+		value = strings.Replace(value, ",", ".", 1)
+	case entity.NumberFormatUS:
+		value = strings.ReplaceAll(value, ",", "")
+	default:
+		return decimal.Zero, fmt.Errorf("unsupported number format %q", format)
+	}
+
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid amount %q", strings.TrimSpace(original))
+	}
+	if negative {
+		amount = amount.Neg()
+	}
+	return amount, nil
+}
+
+// csvExternalID derives a stable identifier from the line contents so that re-importing
+// the same file is idempotent. Identical lines within a file are told apart by occurrence.
+func csvExternalID(line adapter.ParsedStatementLine, occurrences map[string]int) string {
+	key := strings.Join([]string{
+		line.Date.Format("2006-01-02"),
+		strings.ToLower(line.Description),
+		line.Amount.StringFixed(2),
+	}, "|")
+
+	occurrences[key]++
+	sum := sha256.Sum256([]byte(key + "|" + strconv.Itoa(occurrences[key])))
+	return "csv:" + hex.EncodeToString(sum[:])[:32]
+}
+
+// isBlankRecord reports whether every field of the record is empty.
+func isBlankRecord(fields []string) bool {
+	for _, f := range fields {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+	return true
+}
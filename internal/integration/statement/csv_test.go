@@ -0,0 +1,144 @@
+package statement
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+const nubankCSV = "date,title,amount\n" +
+	"2024-11-02,Supermercado Extra,152.37\n" +
+	"2024-11-03,Loja Online - Parcela 2/6,89.90\n" +
+	"2024-11-05,Pagamento recebido,-500.00\n" +
+	"2024-11-05,Supermercado Extra,152.37\n"
+
+const itauCSV = "Extrato Conta Corrente\n" +
+	"Agência 0001 Conta 12345-6\n" +
+	"Data;Lançamento;Débito;Crédito\n" +
+	"05/11/2024;PIX TRANSF JOAO;1.250,00;\n" +
+	"06/11/2024;SALARIO;;8.432,10\n" +
+	"07/11/2024;TARIFA;abc;\n"
+
+func TestParseCSV_SignedAmountColumn(t *testing.T) {
+	profile := &entity.ImportProfile{
+		HasHeader:         true,
+		DateColumn:        "date",
+		DescriptionColumn: "Title",
+		AmountColumn:      "amount",
+		DateFormat:        entity.DateFormatYMD,
+		NumberFormat:      entity.NumberFormatUS,
+		InvertAmounts:     true,
+	}
+
+	stmt, err := NewCSVParser().ParseCSV([]byte(nubankCSV), profile)
+	if err != nil {
+		t.Fatalf("ParseCSV returned error: %v", err)
+	}
+
+	if len(stmt.Lines) != 4 || len(stmt.Errors) != 0 {
+		t.Fatalf("expected 4 lines and no errors, got %d lines and %v", len(stmt.Lines), stmt.Errors)
+	}
+
+	first := stmt.Lines[0]
+	if first.Row != 2 || first.Date.Format("2006-01-02") != "2024-11-02" {
+		t.Errorf("unexpected first line row/date: %d %s", first.Row, first.Date)
+	}
+	if !first.Amount.Equal(decimal.RequireFromString("-152.37")) {
+		t.Errorf("expected inverted amount -152.37, got %s", first.Amount)
+	}
+
+	installment := stmt.Lines[1]
+	if installment.InstallmentCurrent == nil || *installment.InstallmentCurrent != 2 || *installment.InstallmentTotal != 6 {
+		t.Errorf("expected installment 2/6, got %v/%v", installment.InstallmentCurrent, installment.InstallmentTotal)
+	}
+
+	if !stmt.Lines[2].Amount.Equal(decimal.RequireFromString("500")) {
+		t.Errorf("expected payment amount 500, got %s", stmt.Lines[2].Amount)
+	}
+
+	// Identical lines must still get distinct, stable external IDs
+	if first.ExternalID == stmt.Lines[3].ExternalID {
+		t.Errorf("duplicate lines share external ID %q", first.ExternalID)
+	}
+	again, _ := NewCSVParser().ParseCSV([]byte(nubankCSV), profile)
+	if again.Lines[0].ExternalID != first.ExternalID {
+		t.Errorf("external ID is not stable across parses")
+	}
+}
+
+func TestParseCSV_DebitCreditColumnsWithSkipRows(t *testing.T) {
+	profile := &entity.ImportProfile{
+		HasHeader:         true,
+		SkipRows:          2,
+		DateColumn:        "Data",
+		DescriptionColumn: "Lançamento",
+		DebitColumn:       "Débito",
+		CreditColumn:      "Crédito",
+		DateFormat:        entity.DateFormatDMY,
+		NumberFormat:      entity.NumberFormatBR,
+	}
+
+	stmt, err := NewCSVParser().ParseCSV([]byte(itauCSV), profile)
+	if err != nil {
+		t.Fatalf("ParseCSV returned error: %v", err)
+	}
+
+	if len(stmt.Lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(stmt.Lines))
+	}
+	if !stmt.Lines[0].Amount.Equal(decimal.RequireFromString("-1250")) {
+		t.Errorf("expected debit -1250, got %s", stmt.Lines[0].Amount)
+	}
+	if !stmt.Lines[1].Amount.Equal(decimal.RequireFromString("8432.10")) {
+		t.Errorf("expected credit 8432.10, got %s", stmt.Lines[1].Amount)
+	}
+
+	if len(stmt.Errors) != 1 || stmt.Errors[0].Row != 6 {
+		t.Fatalf("expected one error on row 6, got %v", stmt.Errors)
+	}
+}
+
+func TestParseCSV_ColumnIndexesWithoutHeader(t *testing.T) {
+	data := []byte("03/15/2024\tCoffee Shop\t(4.50)\n03/16/2024\tRefund\t$1,020.00\n")
+	profile := &entity.ImportProfile{
+		DateColumn:        "0",
+		DescriptionColumn: "1",
+		AmountColumn:      "2",
+		DateFormat:        entity.DateFormatMDY,
+		NumberFormat:      entity.NumberFormatUS,
+	}
+
+	stmt, err := NewCSVParser().ParseCSV(data, profile)
+	if err != nil {
+		t.Fatalf("ParseCSV returned error: %v", err)
+	}
+
+	if len(stmt.Lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d (errors: %v)", len(stmt.Lines), stmt.Errors)
+	}
+	if !stmt.Lines[0].Amount.Equal(decimal.RequireFromString("-4.50")) {
+		t.Errorf("expected -4.50, got %s", stmt.Lines[0].Amount)
+	}
+	if !stmt.Lines[1].Amount.Equal(decimal.RequireFromString("1020")) {
+		t.Errorf("expected 1020, got %s", stmt.Lines[1].Amount)
+	}
+}
+
+func TestParseCSV_UnknownColumn(t *testing.T) {
+	profile := &entity.ImportProfile{
+		HasHeader:         true,
+		DateColumn:        "posted",
+		DescriptionColumn: "title",
+		AmountColumn:      "amount",
+		DateFormat:        entity.DateFormatYMD,
+		NumberFormat:      entity.NumberFormatUS,
+	}
+
+	_, err := NewCSVParser().ParseCSV([]byte(nubankCSV), profile)
+	if !errors.Is(err, ErrInvalidCSV) {
+		t.Fatalf("expected ErrInvalidCSV, got %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/domain/entity"
+)
+
+// ErrInvalidExchangeRateFile is returned when the file cannot be read or lacks the required columns.
+var ErrInvalidExchangeRateFile = errors.New("invalid exchange rate file")
+
+// exchangeRateColumnNames lists the accepted header names for each exchange rate column.
+var exchangeRateColumnNames = map[string][]string{
+	"date": {"date", "data"},
+	"from": {"from", "from_currency", "base"},
+	"to":   {"to", "to_currency", "quote"},
+	"rate": {"rate", "taxa", "value"},
+}
+
+// exchangeRateParser implements the adapter.ExchangeRateFileParser interface.
+type exchangeRateParser struct{}
+
+// NewExchangeRateParser creates a new exchange rate file parser instance.
+func NewExchangeRateParser() adapter.ExchangeRateFileParser {
+	return &exchangeRateParser{}
+}
+
+// ParseExchangeRates parses a delimited file whose header names the date, from, to and rate columns
+// (e.g., "date,from,to,rate"). Dates are YYYY-MM-DD or DD/MM/YYYY; rates accept "." or "," as
+// decimal separator.
+func (p *exchangeRateParser) ParseExchangeRates(data []byte) (*adapter.ParsedExchangeRateFile, error) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+	if !utf8.Valid(data) {
+		data = latin1ToUTF8(data)
+	}
+	content := string(data)
+
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.Comma, _ = utf8.DecodeRuneInString(detectDelimiter(content))
+	reader.LazyQuotes = true
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := readCSVRecords(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExchangeRateFile, err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: header row not found", ErrInvalidExchangeRateFile)
+	}
+
+	columns, err := resolveExchangeRateColumns(records[0].fields)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &adapter.ParsedExchangeRateFile{
+		Rates:  []adapter.ParsedExchangeRate{},
+		Errors: []adapter.StatementLineError{},
+	}
+
+	for _, record := range records[1:] {
+		if isBlankRecord(record.fields) {
+			continue
+		}
+
+		rate, err := buildExchangeRate(record.fields, columns)
+		if err != nil {
+			result.Errors = append(result.Errors, adapter.StatementLineError{
+				Row:     record.line,
+				Message: err.Error(),
+			})
+			continue
+		}
+
+		rate.Row = record.line
+		result.Rates = append(result.Rates, rate)
+	}
+
+	return result, nil
+}
+
+// resolveExchangeRateColumns finds the index of each required column in the header (case-insensitive).
+func resolveExchangeRateColumns(header []string) (map[string]int, error) {
+	columns := make(map[string]int, len(exchangeRateColumnNames))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for column, aliases := range exchangeRateColumnNames {
+			for _, alias := range aliases {
+				if name == alias {
+					columns[column] = i
+				}
+			}
+		}
+	}
+
+	for _, column := range []string{"date", "from", "to", "rate"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("%w: column %q not found in header", ErrInvalidExchangeRateFile, column)
+		}
+	}
+	return columns, nil
+}
+
+// buildExchangeRate converts a record into a parsed exchange rate.
+func buildExchangeRate(fields []string, columns map[string]int) (adapter.ParsedExchangeRate, error) {
+	field := func(column string) string {
+		idx := columns[column]
+		if idx >= len(fields) {
+			return ""
+		}
+		return strings.TrimSpace(fields[idx])
+	}
+
+	date, err := parseExchangeRateDate(field("date"))
+	if err != nil {
+		return adapter.ParsedExchangeRate{}, err
+	}
+
+	rate, err := parseExchangeRateValue(field("rate"))
+	if err != nil {
+		return adapter.ParsedExchangeRate{}, err
+	}
+
+	return adapter.ParsedExchangeRate{
+		Date:         date,
+		FromCurrency: strings.ToUpper(field("from")),
+		ToCurrency:   strings.ToUpper(field("to")),
+		Rate:         rate,
+	}, nil
+}
+
+// parseExchangeRateDate parses a YYYY-MM-DD or DD/MM/YYYY date.
+func parseExchangeRateDate(value string) (time.Time, error) {
+	format := entity.DateFormatDMY
+	if len(value) >= 5 && value[4] == '-' {
+		format = entity.DateFormatYMD
+	}
+
+	date, err := parseCSVDate(value, format)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD or DD/MM/YYYY", value)
+	}
+	return date, nil
+}
+
+// parseExchangeRateValue parses a rate written with "." or "," as decimal separator.
+func parseExchangeRateValue(value string) (decimal.Decimal, error) {
+	format := entity.NumberFormatUS
+	if strings.Contains(value, ",") && !strings.Contains(value, ".") {
+		format = entity.NumberFormatBR
+	}
+
+	rate, err := parseCSVAmount(value, format)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid rate %q", value)
+	}
+	return rate, nil
+}
@@ -0,0 +1,74 @@
+package statement
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+const ratesCSV = "Date,From,To,Rate\n" +
+	"2024-11-04,USD,BRL,5.7841\n" +
+	"2024-11-05,usd,brl,5.7402\n" +
+	"\n" +
+	"2024-11-06,EUR,BRL,abc\n"
+
+const ratesSemicolonCSV = "data;base;quote;taxa\n" +
+	"04/11/2024;USD;BRL;5,7841\n" +
+	"31/02/2024;EUR;BRL;6,20\n"
+
+func TestParseExchangeRates_CommaDelimited(t *testing.T) {
+	file, err := NewExchangeRateParser().ParseExchangeRates([]byte(ratesCSV))
+	if err != nil {
+		t.Fatalf("ParseExchangeRates returned error: %v", err)
+	}
+
+	if len(file.Rates) != 2 || len(file.Errors) != 1 {
+		t.Fatalf("expected 2 rates and 1 error, got %d rates and %v", len(file.Rates), file.Errors)
+	}
+
+	first := file.Rates[0]
+	if first.Row != 2 || first.Date.Format("2006-01-02") != "2024-11-04" {
+		t.Errorf("unexpected first rate row/date: %d %s", first.Row, first.Date)
+	}
+	if first.FromCurrency != "USD" || first.ToCurrency != "BRL" {
+		t.Errorf("expected USD to BRL, got %s to %s", first.FromCurrency, first.ToCurrency)
+	}
+	if !first.Rate.Equal(decimal.RequireFromString("5.7841")) {
+		t.Errorf("expected rate 5.7841, got %s", first.Rate)
+	}
+
+	if file.Rates[1].FromCurrency != "USD" {
+		t.Errorf("expected currency codes to be upper-cased, got %s", file.Rates[1].FromCurrency)
+	}
+
+	if file.Errors[0].Row != 5 {
+		t.Errorf("expected error on row 5, got %d", file.Errors[0].Row)
+	}
+}
+
+func TestParseExchangeRates_SemicolonDelimitedWithCommaDecimals(t *testing.T) {
+	file, err := NewExchangeRateParser().ParseExchangeRates([]byte(ratesSemicolonCSV))
+	if err != nil {
+		t.Fatalf("ParseExchangeRates returned error: %v", err)
+	}
+
+	if len(file.Rates) != 1 || len(file.Errors) != 1 {
+		t.Fatalf("expected 1 rate and 1 error, got %d rates and %v", len(file.Rates), file.Errors)
+	}
+
+	rate := file.Rates[0]
+	if rate.Date.Format("2006-01-02") != "2024-11-04" {
+		t.Errorf("expected date 2024-11-04, got %s", rate.Date)
+	}
+	if !rate.Rate.Equal(decimal.RequireFromString("5.7841")) {
+		t.Errorf("expected rate 5.7841, got %s", rate.Rate)
+	}
+}
+
+func TestParseExchangeRates_MissingColumn(t *testing.T) {
+	_, err := NewExchangeRateParser().ParseExchangeRates([]byte("date,from,rate\n2024-11-04,USD,5.78\n"))
+	if !errors.Is(err, ErrInvalidExchangeRateFile) {
+		t.Fatalf("expected ErrInvalidExchangeRateFile, got %v", err)
+	}
+}
@@ -0,0 +1,212 @@
+// Package statement implements parsers for bank statement files (OFX, CSV, PDF) and exchange rate files.
+package statement
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrInvalidOFX is returned when the file is not a parseable OFX/QFX document.
+var ErrInvalidOFX = errors.New("invalid OFX file")
+
+// OFXAccountType identifies whether the statement comes from a bank or credit card account.
+type OFXAccountType string
+
+const (
+	OFXAccountTypeBank       OFXAccountType = "bank"
+	OFXAccountTypeCreditCard OFXAccountType = "credit_card"
+)
+
+// OFXTransaction represents a single STMTTRN entry of an OFX statement.
+type OFXTransaction struct {
+	FITID    string          // Financial institution transaction ID, unique within the account
+	Type     string          // TRNTYPE (DEBIT, CREDIT, PAYMENT, ...)
+	PostedAt time.Time       // DTPOSTED, truncated to the date
+	Amount   decimal.Decimal // TRNAMT, negative for debits
+	Name     string
+	Memo     string
+	CheckNum string
+}
+
+// Description returns the best human-readable description for the transaction.
+// Brazilian banks frequently leave NAME empty and put the merchant in MEMO.
+func (t OFXTransaction) Description() string {
+	name := strings.TrimSpace(t.Name)
+	memo := strings.TrimSpace(t.Memo)
+
+	switch {
+	case name == "":
+		return memo
+	case memo == "" || strings.EqualFold(name, memo):
+		return name
+	default:
+		return name + " - " + memo
+	}
+}
+
+// OFXStatement represents a parsed OFX statement for a single account.
+type OFXStatement struct {
+	AccountType  OFXAccountType
+	BankID       string
+	AccountID    string
+	Currency     string
+	StartDate    *time.Time
+	EndDate      *time.Time
+	Transactions []OFXTransaction
+}
+
+// ExternalID builds a stable identifier for a transaction, namespaced by bank and account
+// so that FITIDs from different institutions do not collide.
+func (s *OFXStatement) ExternalID(t OFXTransaction) string {
+	return fmt.Sprintf("ofx:%s:%s:%s", s.BankID, s.AccountID, t.FITID)
+}
+
+var (
+	// ofxStmtTrnRegex matches STMTTRN aggregates. Aggregates are always closed in both OFX 1.x and 2.x.
+	ofxStmtTrnRegex = regexp.MustCompile(`(?is)<STMTTRN>(.*?)</STMTTRN>`)
+	// ofxElementRegex matches a leaf element and its value. In OFX 1.x (SGML) leaf elements are not closed,
+	// so the value runs until the next tag.
+	ofxElementRegex = regexp.MustCompile(`(?is)<([A-Z0-9.]+)>([^<]*)`)
+	// ofxDateRegex extracts the date portion of an OFX datetime (YYYYMMDD[HHMMSS[.XXX]][[gmt:tz]]).
+	ofxDateRegex = regexp.MustCompile(`^(\d{8})`)
+)
+
+// ParseOFX parses an OFX 1.x (SGML) or 2.x (XML) document, including QFX files.
+// Files that are not valid UTF-8 are decoded as Latin-1, which is what most Brazilian banks emit.
+func ParseOFX(data []byte) (*OFXStatement, error) {
+	if !utf8.Valid(data) {
+		data = latin1ToUTF8(data)
+	}
+	content := string(data)
+
+	upper := strings.ToUpper(content)
+	ofxStart := strings.Index(upper, "<OFX>")
+	if ofxStart < 0 {
+		return nil, fmt.Errorf("%w: missing <OFX> root element", ErrInvalidOFX)
+	}
+	body := content[ofxStart:]
+	upperBody := upper[ofxStart:]
+
+	statement := &OFXStatement{
+		AccountType: OFXAccountTypeBank,
+	}
+	if strings.Contains(upperBody, "<CCSTMTRS>") || strings.Contains(upperBody, "<CCACCTFROM>") {
+		statement.AccountType = OFXAccountTypeCreditCard
+	}
+
+	// Statement-level fields live outside STMTTRN aggregates
+	header := ofxStmtTrnRegex.ReplaceAllString(body, "")
+	headerValues := parseOFXElements(header)
+	statement.BankID = headerValues["BANKID"]
+	statement.AccountID = headerValues["ACCTID"]
+	statement.Currency = strings.ToUpper(headerValues["CURDEF"])
+	if v, ok := headerValues["DTSTART"]; ok {
+		if d, err := parseOFXDate(v); err == nil {
+			statement.StartDate = &d
+		}
+	}
+	if v, ok := headerValues["DTEND"]; ok {
+		if d, err := parseOFXDate(v); err == nil {
+			statement.EndDate = &d
+		}
+	}
+
+	matches := ofxStmtTrnRegex.FindAllStringSubmatch(body, -1)
+	statement.Transactions = make([]OFXTransaction, 0, len(matches))
+	for i, match := range matches {
+		values := parseOFXElements(match[1])
+
+		txn, err := buildOFXTransaction(values)
+		if err != nil {
+			return nil, fmt.Errorf("%w: transaction %d: %v", ErrInvalidOFX, i+1, err)
+		}
+		statement.Transactions = append(statement.Transactions, txn)
+	}
+
+	return statement, nil
+}
+
+// buildOFXTransaction converts the element values of a STMTTRN aggregate into an OFXTransaction.
+func buildOFXTransaction(values map[string]string) (OFXTransaction, error) {
+	fitID := values["FITID"]
+	if fitID == "" {
+		return OFXTransaction{}, errors.New("missing FITID")
+	}
+
+	postedAt, err := parseOFXDate(values["DTPOSTED"])
+	if err != nil {
+		return OFXTransaction{}, fmt.Errorf("invalid DTPOSTED: %v", err)
+	}
+
+	amount, err := parseOFXAmount(values["TRNAMT"])
+	if err != nil {
+		return OFXTransaction{}, fmt.Errorf("invalid TRNAMT: %v", err)
+	}
+
+	return OFXTransaction{
+		FITID:    fitID,
+		Type:     strings.ToUpper(values["TRNTYPE"]),
+		PostedAt: postedAt,
+		Amount:   amount,
+		Name:     values["NAME"],
+		Memo:     values["MEMO"],
+		CheckNum: values["CHECKNUM"],
+	}, nil
+}
+
+// parseOFXElements extracts leaf element values keyed by upper-cased tag name.
+// When a tag appears more than once, the first occurrence wins.
+func parseOFXElements(fragment string) map[string]string {
+	values := make(map[string]string)
+	for _, m := range ofxElementRegex.FindAllStringSubmatch(fragment, -1) {
+		tag := strings.ToUpper(m[1])
+		value := strings.TrimSpace(html.UnescapeString(m[2]))
+		if value == "" {
+			continue
+		}
+		if _, exists := values[tag]; !exists {
+			values[tag] = value
+		}
+	}
+	return values
+}
+
+// parseOFXDate parses the date portion of an OFX datetime value.
+func parseOFXDate(value string) (time.Time, error) {
+	m := ofxDateRegex.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return time.Time{}, fmt.Errorf("unrecognized date %q", value)
+	}
+	return time.Parse("20060102", m[1])
+}
+
+// parseOFXAmount parses a TRNAMT value. Some banks use a comma as the decimal separator.
+func parseOFXAmount(value string) (decimal.Decimal, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return decimal.Zero, errors.New("empty amount")
+	}
+	if strings.Contains(value, ",") && !strings.Contains(value, ".") {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+	value = strings.TrimPrefix(value, "+")
+	return decimal.NewFromString(value)
+}
+
+// latin1ToUTF8 converts ISO-8859-1 encoded bytes to UTF-8.
+func latin1ToUTF8(data []byte) []byte {
+	var buf bytes.Buffer
+	buf.Grow(len(data) + len(data)/4)
+	for _, b := range data {
+		buf.WriteRune(rune(b))
+	}
+	return buf.Bytes()
+}
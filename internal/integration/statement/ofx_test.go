@@ -0,0 +1,179 @@
+package statement
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+const sgmlOFX = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+SECURITY:NONE
+ENCODING:USASCII
+CHARSET:1252
+COMPRESSION:NONE
+OLDFILEUID:NONE
+NEWFILEUID:NONE
+
+<OFX>
+<SIGNONMSGSRSV1>
+<SONRS>
+<STATUS>
+<CODE>0
+<SEVERITY>INFO
+</STATUS>
+<DTSERVER>20241105120000[-3:BRT]
+<LANGUAGE>POR
+</SONRS>
+</SIGNONMSGSRSV1>
+<BANKMSGSRSV1>
+<STMTTRNRS>
+<TRNUID>1
+<STMTRS>
+<CURDEF>BRL
+<BANKACCTFROM>
+<BANKID>0260
+<ACCTID>12345-6
+<ACCTTYPE>CHECKING
+</BANKACCTFROM>
+<BANKTRANLIST>
+<DTSTART>20241001000000[-3:BRT]
+<DTEND>20241031000000[-3:BRT]
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20241003000000[-3:BRT]
+<TRNAMT>-45,90
+<FITID>abc-001
+<MEMO>Compra no debito - PADARIA &amp; CAFE
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20241005
+<TRNAMT>5000.00
+<FITID>abc-002
+<NAME>SALARIO
+<MEMO>SALARIO
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS>
+</STMTTRNRS>
+</BANKMSGSRSV1>
+</OFX>
+`
+
+const xmlOFX = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+  <CREDITCARDMSGSRSV1>
+    <CCSTMTTRNRS>
+      <TRNUID>1</TRNUID>
+      <CCSTMTRS>
+        <CURDEF>BRL</CURDEF>
+        <CCACCTFROM>
+          <ACCTID>5555</ACCTID>
+        </CCACCTFROM>
+        <BANKTRANLIST>
+          <STMTTRN>
+            <TRNTYPE>DEBIT</TRNTYPE>
+            <DTPOSTED>20241110</DTPOSTED>
+            <TRNAMT>-120.50</TRNAMT>
+            <FITID>cc-1</FITID>
+            <NAME>Mercado Pão de Açúcar</NAME>
+            <MEMO>Parcela 1/3</MEMO>
+          </STMTTRN>
+        </BANKTRANLIST>
+      </CCSTMTRS>
+    </CCSTMTTRNRS>
+  </CREDITCARDMSGSRSV1>
+</OFX>
+`
+
+func TestParseOFX_SGML(t *testing.T) {
+	stmt, err := ParseOFX([]byte(sgmlOFX))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if stmt.AccountType != OFXAccountTypeBank {
+		t.Errorf("expected bank account type, got %q", stmt.AccountType)
+	}
+	if stmt.BankID != "0260" || stmt.AccountID != "12345-6" || stmt.Currency != "BRL" {
+		t.Errorf("unexpected account info: %+v", stmt)
+	}
+	if stmt.StartDate == nil || stmt.StartDate.Format("2006-01-02") != "2024-10-01" {
+		t.Errorf("unexpected start date: %v", stmt.StartDate)
+	}
+	if len(stmt.Transactions) != 2 {
+		t.Fatalf("expected 2 transactions, got %d", len(stmt.Transactions))
+	}
+
+	debit := stmt.Transactions[0]
+	if debit.Amount.String() != "-45.9" {
+		t.Errorf("expected amount -45.9, got %s", debit.Amount)
+	}
+	if debit.PostedAt.Format("2006-01-02") != "2024-10-03" {
+		t.Errorf("unexpected posted date: %v", debit.PostedAt)
+	}
+	if got := debit.Description(); got != "Compra no debito - PADARIA & CAFE" {
+		t.Errorf("unexpected description: %q", got)
+	}
+
+	credit := stmt.Transactions[1]
+	if got := credit.Description(); got != "SALARIO" {
+		t.Errorf("expected NAME and MEMO to collapse, got %q", got)
+	}
+	if got := stmt.ExternalID(credit); got != "ofx:0260:12345-6:abc-002" {
+		t.Errorf("unexpected external ID: %q", got)
+	}
+}
+
+func TestParseOFX_XML(t *testing.T) {
+	stmt, err := ParseOFX([]byte(xmlOFX))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if stmt.AccountType != OFXAccountTypeCreditCard {
+		t.Errorf("expected credit card account type, got %q", stmt.AccountType)
+	}
+	if len(stmt.Transactions) != 1 {
+		t.Fatalf("expected 1 transaction, got %d", len(stmt.Transactions))
+	}
+	if got := stmt.Transactions[0].Description(); got != "Mercado Pão de Açúcar - Parcela 1/3" {
+		t.Errorf("unexpected description: %q", got)
+	}
+}
+
+func TestParseOFX_Latin1(t *testing.T) {
+	// "débito" encoded as ISO-8859-1, as emitted by most Brazilian banks
+	data := bytes.Replace([]byte(sgmlOFX), []byte("debito"), []byte("d\xe9bito"), 1)
+
+	stmt, err := ParseOFX(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := stmt.Transactions[0].Memo; got != "Compra no débito - PADARIA & CAFE" {
+		t.Errorf("unexpected memo: %q", got)
+	}
+}
+
+func TestParseOFX_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "not an OFX file", data: "date,description,amount\n2024-01-01,foo,10"},
+		{name: "missing FITID", data: "<OFX><STMTTRN><DTPOSTED>20240101<TRNAMT>-1.00</STMTTRN></OFX>"},
+		{name: "invalid amount", data: "<OFX><STMTTRN><FITID>1<DTPOSTED>20240101<TRNAMT>abc</STMTTRN></OFX>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseOFX([]byte(tt.data))
+			if !errors.Is(err, ErrInvalidOFX) {
+				t.Errorf("expected ErrInvalidOFX, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,1010 @@
+package statement
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+var (
+	// ErrInvalidPDF is returned when the file is not a readable PDF document.
+	ErrInvalidPDF = errors.New("invalid PDF file")
+	// ErrEncryptedPDF is returned for password-protected PDF documents, which cannot be read.
+	ErrEncryptedPDF = errors.New("password-protected PDF files are not supported")
+	// ErrPDFPageTreeLoop is returned when the page tree of a PDF document contains a node twice.
+	ErrPDFPageTreeLoop = errors.New("PDF page tree contains a loop")
+	// ErrPDFTooLarge is returned when the page tree or the inflated streams of a PDF document exceed their limits.
+	ErrPDFTooLarge = errors.New("PDF file is too large to read")
+)
+
+const (
+	// pdfMaxDepth bounds reference chains, page trees and nested form XObjects.
+	pdfMaxDepth = 32
+	// pdfMaxPageTreeNodes bounds the number of page tree nodes walked in a document.
+	pdfMaxPageTreeNodes = 10000
+	// pdfMaxDecodedSize bounds the data inflated from the streams of a document, in bytes.
+	pdfMaxDecodedSize = 32 << 20
+	// pdfLineTolerance is the maximum vertical distance between text runs of the same line.
+	pdfLineTolerance = 2.0
+	// pdfKerningSpace is the TJ adjustment (thousandths of an em) wide enough to stand for a space.
+	pdfKerningSpace = 200.0
+)
+
+var (
+	// pdfObjectRegex matches the header of an indirect object ("12 0 obj").
+	pdfObjectRegex = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+	// pdfEncryptRegex matches the /Encrypt entry of a trailer or cross-reference stream.
+	pdfEncryptRegex = regexp.MustCompile(`/Encrypt\s*(\d+\s+\d+\s+R|<<)`)
+)
+
+// winAnsiHighChars maps the WinAnsiEncoding bytes that differ from Latin-1.
+var winAnsiHighChars = map[byte]rune{
+	0x80: '€', 0x82: '‚', 0x83: 'ƒ', 0x84: '„', 0x85: '…', 0x86: '†', 0x87: '‡', 0x88: 'ˆ',
+	0x89: '‰', 0x8A: 'Š', 0x8B: '‹', 0x8C: 'Œ', 0x8E: 'Ž', 0x91: '‘', 0x92: '’', 0x93: '“',
+	0x94: '”', 0x95: '•', 0x96: '–', 0x97: '—', 0x98: '˜', 0x99: '™', 0x9A: 'š', 0x9B: '›',
+	0x9C: 'œ', 0x9E: 'ž', 0x9F: 'Ÿ',
+}
+
+// PDF object model: numbers are float64, strings []byte, booleans bool and null nil.
+type (
+	pdfName    string
+	pdfKeyword string
+	pdfArray   []any
+	pdfDict    map[pdfName]any
+	pdfRef     struct{ num int }
+	pdfStream  struct {
+		dict pdfDict
+		raw  []byte
+	}
+)
+
+// ExtractPDFText returns the text of a PDF document, pages in order and one line per row of text.
+// Only the text drawn by the page content streams is returned; images are not read (no OCR).
+func ExtractPDFText(data []byte) (string, error) {
+	if !bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte("%PDF-")) {
+		return "", ErrInvalidPDF
+	}
+	if pdfEncryptRegex.Match(data) {
+		return "", ErrEncryptedPDF
+	}
+
+	doc := loadPDFDocument(data)
+	pages, err := doc.pages()
+	if err != nil {
+		return "", err
+	}
+	if len(pages) == 0 {
+		return "", ErrInvalidPDF
+	}
+
+	var lines []string
+	for _, page := range pages {
+		extractor := &pdfTextExtractor{doc: doc, fonts: map[int]*pdfFont{}}
+		resources, _ := doc.resolve(page["Resources"]).(pdfDict)
+		for _, content := range doc.contentStreams(page["Contents"]) {
+			extractor.run(content, resources, pdfIdentity, 0)
+		}
+		if doc.err != nil {
+			return "", doc.err
+		}
+		lines = append(lines, extractor.lines()...)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// pdfDocument holds the indirect objects of a PDF file.
+type pdfDocument struct {
+	objects       map[int]any
+	decodedBudget int   // Bytes streams may still inflate to
+	err           error // Set once the document exceeds a limit, failing the extraction
+}
+
+// loadPDFDocument reads every indirect object of the file, including the ones packed in object streams.
+// Objects are found by scanning for their headers rather than through the cross-reference table,
+// so files with a damaged table are still read; later definitions (incremental updates) win.
+func loadPDFDocument(data []byte) *pdfDocument {
+	doc := &pdfDocument{objects: map[int]any{}, decodedBudget: pdfMaxDecodedSize}
+
+	end := 0
+	for _, match := range pdfObjectRegex.FindAllSubmatchIndex(data, -1) {
+		// Skip headers that are actually part of the previous object's stream data
+		if match[0] < end {
+			continue
+		}
+		num, err := strconv.Atoi(string(data[match[2]:match[3]]))
+		if err != nil {
+			continue
+		}
+		lexer := &pdfLexer{data: data, pos: match[1]}
+		value, err := lexer.readObject()
+		if err != nil {
+			continue
+		}
+		if dict, ok := value.(pdfDict); ok {
+			if raw, ok := lexer.readStreamData(dict); ok {
+				value = &pdfStream{dict: dict, raw: raw}
+			}
+		}
+		doc.objects[num] = value
+		end = lexer.pos
+	}
+
+	// Unpack object streams (PDF 1.5+), without overriding objects defined directly
+	for _, value := range doc.objects {
+		stream, ok := value.(*pdfStream)
+		if !ok || stream.dict["Type"] != pdfName("ObjStm") {
+			continue
+		}
+		doc.unpackObjectStream(stream)
+	}
+	return doc
+}
+
+// unpackObjectStream adds the objects packed in an object stream.
+func (d *pdfDocument) unpackObjectStream(stream *pdfStream) {
+	data, err := d.decode(stream)
+	if err != nil {
+		return
+	}
+	count, _ := d.resolve(stream.dict["N"]).(float64)
+	first, _ := d.resolve(stream.dict["First"]).(float64)
+
+	header := &pdfLexer{data: data}
+	for i := 0; i < int(count); i++ {
+		num, ok1 := header.next()
+		offset, ok2 := header.next()
+		objectNum, isNum := num.(float64)
+		objectOffset, isOffset := offset.(float64)
+		if !ok1 || !ok2 || !isNum || !isOffset {
+			return
+		}
+		if _, exists := d.objects[int(objectNum)]; exists {
+			continue
+		}
+		pos := int(first) + int(objectOffset)
+		if pos < 0 || pos >= len(data) {
+			continue
+		}
+		lexer := &pdfLexer{data: data, pos: pos}
+		if value, err := lexer.readObject(); err == nil {
+			d.objects[int(objectNum)] = value
+		}
+	}
+}
+
+// resolve follows indirect references.
+func (d *pdfDocument) resolve(value any) any {
+	for depth := 0; depth < pdfMaxDepth; depth++ {
+		ref, ok := value.(pdfRef)
+		if !ok {
+			return value
+		}
+		value = d.objects[ref.num]
+	}
+	return nil
+}
+
+// decode returns the decoded data of a stream. Inflated data counts against the document's
+// budget, so a stream crafted to inflate to a huge size (a zip bomb) fails the extraction.
+func (d *pdfDocument) decode(stream *pdfStream) ([]byte, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	var filters []pdfName
+	switch filter := d.resolve(stream.dict["Filter"]).(type) {
+	case pdfName:
+		filters = []pdfName{filter}
+	case pdfArray:
+		for _, f := range filter {
+			if name, ok := d.resolve(f).(pdfName); ok {
+				filters = append(filters, name)
+			}
+		}
+	}
+
+	data := stream.raw
+	for _, filter := range filters {
+		switch filter {
+		case "FlateDecode", "Fl":
+			reader, err := zlib.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			decoded, err := io.ReadAll(io.LimitReader(reader, int64(d.decodedBudget)+1))
+			if len(decoded) > d.decodedBudget {
+				d.err = ErrPDFTooLarge
+				return nil, d.err
+			}
+			// Keep what was inflated from streams with a truncated or corrupt end
+			if err != nil && len(decoded) == 0 {
+				return nil, err
+			}
+			d.decodedBudget -= len(decoded)
+			data = decoded
+		case "ASCIIHexDecode", "AHx":
+			cleaned := strings.Map(func(r rune) rune {
+				if isPDFSpace(byte(r)) {
+					return -1
+				}
+				return r
+			}, strings.TrimSuffix(strings.TrimSpace(string(data)), ">"))
+			if len(cleaned)%2 == 1 {
+				cleaned += "0"
+			}
+			decoded, err := hex.DecodeString(cleaned)
+			if err != nil {
+				return nil, err
+			}
+			data = decoded
+		default:
+			return nil, errors.New("unsupported PDF stream filter " + string(filter))
+		}
+	}
+	return data, nil
+}
+
+// pages returns the page dictionaries in document order, with inherited resources resolved.
+func (d *pdfDocument) pages() ([]pdfDict, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	tree := &pdfPageTree{visited: map[int]bool{}}
+	for _, value := range d.objects {
+		catalog, ok := value.(pdfDict)
+		if !ok || catalog["Type"] != pdfName("Catalog") {
+			continue
+		}
+		clear(tree.visited)
+		if err := d.walkPages(catalog["Pages"], nil, 0, tree); err != nil {
+			return nil, err
+		}
+		if len(tree.pages) > 0 {
+			return tree.pages, nil
+		}
+	}
+
+	var pages []pdfDict
+
+	// Without a usable catalog, fall back to the page objects in object number order
+	var nums []int
+	for num, value := range d.objects {
+		if page, ok := value.(pdfDict); ok && page["Type"] == pdfName("Page") {
+			nums = append(nums, num)
+		}
+	}
+	sort.Ints(nums)
+	for _, num := range nums {
+		pages = append(pages, d.objects[num].(pdfDict))
+	}
+	return pages, nil
+}
+
+// pdfPageTree collects the pages of a page tree while it is walked.
+type pdfPageTree struct {
+	visited map[int]bool // Object numbers of the nodes walked in the current tree
+	nodes   int          // Nodes walked in the document, at most pdfMaxPageTreeNodes
+	pages   []pdfDict
+}
+
+// walkPages appends the pages of a page tree node. A node reached twice (a /Kids loop) and
+// trees larger than pdfMaxPageTreeNodes fail the walk.
+func (d *pdfDocument) walkPages(node any, resources any, depth int, tree *pdfPageTree) error {
+	if ref, ok := node.(pdfRef); ok {
+		if tree.visited[ref.num] {
+			return ErrPDFPageTreeLoop
+		}
+		tree.visited[ref.num] = true
+	}
+	tree.nodes++
+	if tree.nodes > pdfMaxPageTreeNodes {
+		return ErrPDFTooLarge
+	}
+
+	dict, ok := d.resolve(node).(pdfDict)
+	if !ok || depth > pdfMaxDepth {
+		return nil
+	}
+	if own, ok := dict["Resources"]; ok {
+		resources = own
+	}
+
+	if dict["Type"] == pdfName("Pages") {
+		kids, _ := d.resolve(dict["Kids"]).(pdfArray)
+		for _, kid := range kids {
+			if err := d.walkPages(kid, resources, depth+1, tree); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	page := pdfDict{}
+	for key, value := range dict {
+		page[key] = value
+	}
+	page["Resources"] = resources
+	tree.pages = append(tree.pages, page)
+	return nil
+}
+
+// contentStreams returns the decoded content streams of a page.
+func (d *pdfDocument) contentStreams(contents any) [][]byte {
+	var streams [][]byte
+	switch value := d.resolve(contents).(type) {
+	case *pdfStream:
+		if data, err := d.decode(value); err == nil {
+			streams = append(streams, data)
+		}
+	case pdfArray:
+		for _, item := range value {
+			streams = append(streams, d.contentStreams(item)...)
+		}
+	}
+	return streams
+}
+
+// pdfLexer tokenizes PDF objects and content streams.
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+func isPDFSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r' || b == '\f' || b == 0
+}
+
+func isPDFDelimiter(b byte) bool {
+	return strings.IndexByte("()<>[]{}/%", b) >= 0
+}
+
+// skipSpace skips whitespace and comments.
+func (l *pdfLexer) skipSpace() {
+	for l.pos < len(l.data) {
+		switch b := l.data[l.pos]; {
+		case isPDFSpace(b):
+			l.pos++
+		case b == '%':
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// next returns the next token: a number, name, string, or keyword (including "[", "]", "<<" and ">>").
+func (l *pdfLexer) next() (any, bool) {
+	l.skipSpace()
+	if l.pos >= len(l.data) {
+		return nil, false
+	}
+
+	b := l.data[l.pos]
+	switch {
+	case b == '/':
+		l.pos++
+		start := l.pos
+		for l.pos < len(l.data) && !isPDFSpace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+			l.pos++
+		}
+		return pdfName(decodePDFName(l.data[start:l.pos])), true
+	case b == '(':
+		return l.readLiteralString(), true
+	case b == '<':
+		if l.pos+1 < len(l.data) && l.data[l.pos+1] == '<' {
+			l.pos += 2
+			return pdfKeyword("<<"), true
+		}
+		return l.readHexString(), true
+	case b == '>':
+		if l.pos+1 < len(l.data) && l.data[l.pos+1] == '>' {
+			l.pos += 2
+			return pdfKeyword(">>"), true
+		}
+		l.pos++
+		return pdfKeyword(">"), true
+	case b == '[' || b == ']' || b == '{' || b == '}' || b == ')':
+		l.pos++
+		return pdfKeyword(string(b)), true
+	}
+
+	start := l.pos
+	for l.pos < len(l.data) && !isPDFSpace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	token := string(l.data[start:l.pos])
+	if number, err := strconv.ParseFloat(token, 64); err == nil {
+		return number, true
+	}
+	return pdfKeyword(token), true
+}
+
+// readObject reads a complete object, resolving arrays, dictionaries and "N G R" references.
+func (l *pdfLexer) readObject() (any, error) {
+	token, ok := l.next()
+	if !ok {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return l.completeObject(token, 0)
+}
+
+// completeObject builds the object that starts with the given token.
+func (l *pdfLexer) completeObject(token any, depth int) (any, error) {
+	if depth > pdfMaxDepth {
+		return nil, ErrInvalidPDF
+	}
+
+	switch value := token.(type) {
+	case float64:
+		// Look ahead for an indirect reference
+		saved := l.pos
+		gen, ok1 := l.next()
+		keyword, ok2 := l.next()
+		if _, isNum := gen.(float64); ok1 && ok2 && isNum && keyword == pdfKeyword("R") {
+			return pdfRef{num: int(value)}, nil
+		}
+		l.pos = saved
+		return value, nil
+	case pdfKeyword:
+		switch value {
+		case "[":
+			array := pdfArray{}
+			for {
+				item, ok := l.next()
+				if !ok {
+					return nil, io.ErrUnexpectedEOF
+				}
+				if item == pdfKeyword("]") {
+					return array, nil
+				}
+				object, err := l.completeObject(item, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				array = append(array, object)
+			}
+		case "<<":
+			dict := pdfDict{}
+			for {
+				key, ok := l.next()
+				if !ok {
+					return nil, io.ErrUnexpectedEOF
+				}
+				if key == pdfKeyword(">>") {
+					return dict, nil
+				}
+				name, isName := key.(pdfName)
+				if !isName {
+					return nil, ErrInvalidPDF
+				}
+				item, ok := l.next()
+				if !ok {
+					return nil, io.ErrUnexpectedEOF
+				}
+				object, err := l.completeObject(item, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				dict[name] = object
+			}
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+	}
+	return token, nil
+}
+
+// readStreamData reads the data of the stream that follows a dictionary, if any.
+func (l *pdfLexer) readStreamData(dict pdfDict) ([]byte, bool) {
+	l.skipSpace()
+	if !bytes.HasPrefix(l.data[l.pos:], []byte("stream")) {
+		return nil, false
+	}
+	start := l.pos + len("stream")
+	if start < len(l.data) && l.data[start] == '\r' {
+		start++
+	}
+	if start < len(l.data) && l.data[start] == '\n' {
+		start++
+	}
+
+	// Trust /Length when it is direct and consistent, otherwise look for the end marker
+	if length, ok := dict["Length"].(float64); ok {
+		end := start + int(length)
+		if end <= len(l.data) && bytes.HasPrefix(bytes.TrimLeft(l.data[end:], " \t\r\n"), []byte("endstream")) {
+			l.pos = end
+			return l.data[start:end], true
+		}
+	}
+	end := bytes.Index(l.data[start:], []byte("endstream"))
+	if end < 0 {
+		return nil, false
+	}
+	l.pos = start + end
+	return bytes.TrimRight(l.data[start:start+end], "\r\n"), true
+}
+
+// readLiteralString reads a "(...)" string, handling escapes and balanced parentheses.
+func (l *pdfLexer) readLiteralString() []byte {
+	l.pos++ // opening parenthesis
+	var out []byte
+	depth := 1
+	for l.pos < len(l.data) {
+		b := l.data[l.pos]
+		l.pos++
+		switch b {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return out
+			}
+		case '\\':
+			if l.pos >= len(l.data) {
+				return out
+			}
+			escaped := l.data[l.pos]
+			l.pos++
+			switch escaped {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b':
+				out = append(out, '\b')
+			case 'f':
+				out = append(out, '\f')
+			case '\r':
+				// Line continuation
+				if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+			case '\n':
+				// Line continuation
+			default:
+				if escaped >= '0' && escaped <= '7' {
+					value := int(escaped - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						value = value*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					out = append(out, byte(value))
+				} else {
+					out = append(out, escaped)
+				}
+			}
+			continue
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+// readHexString reads a "<...>" string.
+func (l *pdfLexer) readHexString() []byte {
+	l.pos++ // opening angle bracket
+	var digits []byte
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		if !isPDFSpace(l.data[l.pos]) {
+			digits = append(digits, l.data[l.pos])
+		}
+		l.pos++
+	}
+	l.pos++ // closing angle bracket
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	out := make([]byte, len(digits)/2)
+	if _, err := hex.Decode(out, digits); err != nil {
+		return nil
+	}
+	return out
+}
+
+// skipInlineImage skips the binary data of an inline image, up to its "EI" operator.
+func (l *pdfLexer) skipInlineImage() {
+	for l.pos+2 < len(l.data) {
+		if isPDFSpace(l.data[l.pos]) && l.data[l.pos+1] == 'E' && l.data[l.pos+2] == 'I' &&
+			(l.pos+3 == len(l.data) || isPDFSpace(l.data[l.pos+3])) {
+			l.pos += 3
+			return
+		}
+		l.pos++
+	}
+	l.pos = len(l.data)
+}
+
+// decodePDFName decodes the "#xx" escapes of a name.
+func decodePDFName(raw []byte) string {
+	if bytes.IndexByte(raw, '#') < 0 {
+		return string(raw)
+	}
+	var out []byte
+	for i := 0; i < len(raw); i++ {
+		if raw[i] == '#' && i+2 < len(raw) {
+			if value, err := strconv.ParseUint(string(raw[i+1:i+3]), 16, 8); err == nil {
+				out = append(out, byte(value))
+				i += 2
+				continue
+			}
+		}
+		out = append(out, raw[i])
+	}
+	return string(out)
+}
+
+// pdfFont decodes the strings shown with a font.
+type pdfFont struct {
+	codeLength int               // Bytes per character code
+	toUnicode  map[uint32]string // From the font's ToUnicode CMap, if any
+}
+
+// decodeText converts a shown string to text.
+func (f *pdfFont) decodeText(raw []byte) string {
+	var sb strings.Builder
+	for i := 0; i+f.codeLength <= len(raw); i += f.codeLength {
+		var code uint32
+		for _, b := range raw[i : i+f.codeLength] {
+			code = code<<8 | uint32(b)
+		}
+		if text, ok := f.toUnicode[code]; ok {
+			sb.WriteString(text)
+			continue
+		}
+		if f.codeLength == 1 {
+			if r, ok := winAnsiHighChars[byte(code)]; ok {
+				sb.WriteRune(r)
+			} else {
+				sb.WriteRune(rune(code))
+			}
+		}
+	}
+	return sb.String()
+}
+
+// loadFont reads the encoding information of a font dictionary.
+func (d *pdfDocument) loadFont(value any) *pdfFont {
+	font := &pdfFont{codeLength: 1}
+	dict, ok := d.resolve(value).(pdfDict)
+	if !ok {
+		return font
+	}
+	if dict["Subtype"] == pdfName("Type0") {
+		font.codeLength = 2
+	}
+	if stream, ok := d.resolve(dict["ToUnicode"]).(*pdfStream); ok {
+		if data, err := d.decode(stream); err == nil {
+			font.toUnicode, font.codeLength = parseToUnicodeCMap(data, font.codeLength)
+		}
+	}
+	return font
+}
+
+// parseToUnicodeCMap reads the bfchar and bfrange mappings of a ToUnicode CMap,
+// returning them with the code length declared by its codespace ranges.
+func parseToUnicodeCMap(data []byte, codeLength int) (map[uint32]string, int) {
+	mapping := map[uint32]string{}
+	lexer := &pdfLexer{data: data}
+	var operands []any
+
+	for {
+		token, ok := lexer.next()
+		if !ok {
+			return mapping, codeLength
+		}
+		keyword, isKeyword := token.(pdfKeyword)
+		if !isKeyword || keyword == "[" {
+			// Operands, including the destination arrays of bfrange entries
+			object, err := lexer.completeObject(token, 0)
+			if err != nil {
+				return mapping, codeLength
+			}
+			operands = append(operands, object)
+			continue
+		}
+
+		switch keyword {
+		case "endcodespacerange":
+			if len(operands) > 0 {
+				if code, ok := operands[0].([]byte); ok && len(code) > 0 {
+					codeLength = len(code)
+				}
+			}
+		case "endbfchar":
+			for i := 0; i+1 < len(operands); i += 2 {
+				src, ok1 := operands[i].([]byte)
+				dst, ok2 := operands[i+1].([]byte)
+				if ok1 && ok2 {
+					mapping[cmapCode(src)] = decodeUTF16BE(dst)
+				}
+			}
+		case "endbfrange":
+			for i := 0; i+2 < len(operands); i += 3 {
+				low, ok1 := operands[i].([]byte)
+				high, ok2 := operands[i+1].([]byte)
+				if !ok1 || !ok2 {
+					continue
+				}
+				switch dst := operands[i+2].(type) {
+				case []byte:
+					base := []rune(decodeUTF16BE(dst))
+					if len(base) == 0 {
+						continue
+					}
+					for code := cmapCode(low); code <= cmapCode(high) && code-cmapCode(low) < 0x10000; code++ {
+						offset := rune(code - cmapCode(low))
+						mapping[code] = string(base[:len(base)-1]) + string(base[len(base)-1]+offset)
+					}
+				case pdfArray:
+					for j, item := range dst {
+						if text, ok := item.([]byte); ok {
+							mapping[cmapCode(low)+uint32(j)] = decodeUTF16BE(text)
+						}
+					}
+				}
+			}
+		}
+		operands = operands[:0]
+	}
+}
+
+// cmapCode converts the bytes of a character code to a number.
+func cmapCode(raw []byte) uint32 {
+	var code uint32
+	for _, b := range raw {
+		code = code<<8 | uint32(b)
+	}
+	return code
+}
+
+// decodeUTF16BE decodes the UTF-16BE text of a ToUnicode destination.
+func decodeUTF16BE(raw []byte) string {
+	units := make([]uint16, len(raw)/2)
+	for i := range units {
+		units[i] = uint16(raw[2*i])<<8 | uint16(raw[2*i+1])
+	}
+	return string(utf16.Decode(units))
+}
+
+// pdfMatrix is a transformation matrix [a b c d e f].
+type pdfMatrix [6]float64
+
+var pdfIdentity = pdfMatrix{1, 0, 0, 1, 0, 0}
+
+// multiply returns m × n.
+func (m pdfMatrix) multiply(n pdfMatrix) pdfMatrix {
+	return pdfMatrix{
+		m[0]*n[0] + m[1]*n[2],
+		m[0]*n[1] + m[1]*n[3],
+		m[2]*n[0] + m[3]*n[2],
+		m[2]*n[1] + m[3]*n[3],
+		m[4]*n[0] + m[5]*n[2] + n[4],
+		m[4]*n[1] + m[5]*n[3] + n[5],
+	}
+}
+
+// pdfTextRun is a piece of text drawn at a position of the page.
+type pdfTextRun struct {
+	x, y float64
+	text string
+}
+
+// pdfTextExtractor interprets content streams, collecting the text runs of a page.
+type pdfTextExtractor struct {
+	doc   *pdfDocument
+	fonts map[int]*pdfFont // Fonts already loaded, by object number
+	runs  []pdfTextRun
+}
+
+// font returns the font of a font resource, loading it once per indirect object.
+func (e *pdfTextExtractor) font(value any) *pdfFont {
+	ref, isRef := value.(pdfRef)
+	if isRef {
+		if font, ok := e.fonts[ref.num]; ok {
+			return font
+		}
+	}
+	font := e.doc.loadFont(value)
+	if isRef {
+		e.fonts[ref.num] = font
+	}
+	return font
+}
+
+// run interprets a content stream drawn with the given resources and transformation.
+func (e *pdfTextExtractor) run(content []byte, resources pdfDict, ctm pdfMatrix, depth int) {
+	if depth > pdfMaxDepth {
+		return
+	}
+
+	var (
+		lexer      = &pdfLexer{data: content}
+		operands   []any
+		ctmStack   []pdfMatrix
+		tm, tlm    = pdfIdentity, pdfIdentity
+		leading    float64
+		font       = &pdfFont{codeLength: 1}
+		positioned = true // Whether the next shown text starts a new run
+	)
+	fontDicts, _ := e.doc.resolve(resources["Font"]).(pdfDict)
+	xObjects, _ := e.doc.resolve(resources["XObject"]).(pdfDict)
+
+	numberAt := func(i int) float64 {
+		if i < len(operands) {
+			if value, ok := operands[i].(float64); ok {
+				return value
+			}
+		}
+		return 0
+	}
+	moveLine := func(tx, ty float64) {
+		tlm = pdfMatrix{1, 0, 0, 1, tx, ty}.multiply(tlm)
+		tm = tlm
+		positioned = true
+	}
+	show := func(text string) {
+		if text == "" {
+			return
+		}
+		if !positioned && len(e.runs) > 0 {
+			e.runs[len(e.runs)-1].text += text
+			return
+		}
+		origin := tm.multiply(ctm)
+		e.runs = append(e.runs, pdfTextRun{x: origin[4], y: origin[5], text: text})
+		positioned = false
+	}
+
+	for {
+		token, ok := lexer.next()
+		if !ok {
+			return
+		}
+		keyword, isKeyword := token.(pdfKeyword)
+		if !isKeyword || keyword == "[" || keyword == "<<" {
+			object, err := lexer.completeObject(token, 0)
+			if err != nil {
+				return
+			}
+			operands = append(operands, object)
+			continue
+		}
+
+		switch keyword {
+		case "q":
+			ctmStack = append(ctmStack, ctm)
+		case "Q":
+			if len(ctmStack) > 0 {
+				ctm = ctmStack[len(ctmStack)-1]
+				ctmStack = ctmStack[:len(ctmStack)-1]
+			}
+		case "cm":
+			ctm = pdfMatrix{numberAt(0), numberAt(1), numberAt(2), numberAt(3), numberAt(4), numberAt(5)}.multiply(ctm)
+			positioned = true
+		case "BT":
+			tm, tlm = pdfIdentity, pdfIdentity
+			positioned = true
+		case "Tf":
+			if len(operands) > 0 {
+				if name, ok := operands[0].(pdfName); ok {
+					font = e.font(fontDicts[name])
+				}
+			}
+		case "TL":
+			leading = numberAt(0)
+		case "Td":
+			moveLine(numberAt(0), numberAt(1))
+		case "TD":
+			leading = -numberAt(1)
+			moveLine(numberAt(0), numberAt(1))
+		case "Tm":
+			tm = pdfMatrix{numberAt(0), numberAt(1), numberAt(2), numberAt(3), numberAt(4), numberAt(5)}
+			tlm = tm
+			positioned = true
+		case "T*":
+			moveLine(0, -leading)
+		case "Tj":
+			if raw, ok := lastOperand(operands).([]byte); ok {
+				show(font.decodeText(raw))
+			}
+		case "'", "\"":
+			moveLine(0, -leading)
+			if raw, ok := lastOperand(operands).([]byte); ok {
+				show(font.decodeText(raw))
+			}
+		case "TJ":
+			items, _ := lastOperand(operands).(pdfArray)
+			var sb strings.Builder
+			for _, item := range items {
+				switch value := item.(type) {
+				case []byte:
+					sb.WriteString(font.decodeText(value))
+				case float64:
+					if value <= -pdfKerningSpace {
+						sb.WriteString(" ")
+					}
+				}
+			}
+			show(sb.String())
+		case "Do":
+			name, _ := lastOperand(operands).(pdfName)
+			form, ok := e.doc.resolve(xObjects[name]).(*pdfStream)
+			if ok && form.dict["Subtype"] == pdfName("Form") {
+				formResources, ok := e.doc.resolve(form.dict["Resources"]).(pdfDict)
+				if !ok {
+					formResources = resources
+				}
+				formMatrix := pdfIdentity
+				if values, ok := e.doc.resolve(form.dict["Matrix"]).(pdfArray); ok && len(values) == 6 {
+					for i, value := range values {
+						formMatrix[i], _ = value.(float64)
+					}
+				}
+				if data, err := e.doc.decode(form); err == nil {
+					e.run(data, formResources, formMatrix.multiply(ctm), depth+1)
+				}
+				positioned = true
+			}
+		case "ID":
+			lexer.skipInlineImage()
+		}
+		operands = operands[:0]
+	}
+}
+
+// lastOperand returns the last operand of an operator, or nil.
+func lastOperand(operands []any) any {
+	if len(operands) == 0 {
+		return nil
+	}
+	return operands[len(operands)-1]
+}
+
+// lines groups the text runs into lines, top to bottom and left to right.
+func (e *pdfTextExtractor) lines() []string {
+	runs := e.runs
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].y > runs[j].y })
+
+	var lines []string
+	var current []pdfTextRun
+	flush := func() {
+		if len(current) == 0 {
+			return
+		}
+		sort.SliceStable(current, func(i, j int) bool { return current[i].x < current[j].x })
+		parts := make([]string, len(current))
+		for i, run := range current {
+			parts[i] = run.text
+		}
+		if line := strings.Join(strings.Fields(strings.Join(parts, " ")), " "); line != "" {
+			lines = append(lines, line)
+		}
+		current = nil
+	}
+	for _, run := range runs {
+		if len(current) > 0 && math.Abs(current[0].y-run.y) > pdfLineTolerance {
+			flush()
+		}
+		current = append(current, run)
+	}
+	flush()
+	return lines
+}
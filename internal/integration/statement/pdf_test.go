@@ -0,0 +1,154 @@
+package statement
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// helveticaFont is a simple font dictionary using WinAnsiEncoding.
+const helveticaFont = "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>"
+
+// writeTestPDF writes a PDF whose objects are numbered from 1 in order; object 1 must be the catalog.
+func writeTestPDF(objects ...string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+// testStream builds a stream object, optionally compressed with FlateDecode.
+func testStream(data string, compress bool) string {
+	if !compress {
+		return fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(data), data)
+	}
+	var buf bytes.Buffer
+	writer := zlib.NewWriter(&buf)
+	writer.Write([]byte(data))
+	writer.Close()
+	return fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", buf.Len(), buf.String())
+}
+
+// buildTestPDF builds a document with one page per content stream, drawing with font /F1.
+func buildTestPDF(font string, compress bool, contents ...string) []byte {
+	objects := []string{"<< /Type /Catalog /Pages 2 0 R >>", "", font}
+	var kids []string
+	for _, content := range contents {
+		page := len(objects) + 1
+		kids = append(kids, fmt.Sprintf("%d 0 R", page))
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] /Contents %d 0 R >>", page+1),
+			testStream(content, compress),
+		)
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d /Resources << /Font << /F1 3 0 R >> >> >>",
+		strings.Join(kids, " "), len(kids))
+	return writeTestPDF(objects...)
+}
+
+func TestExtractPDFText(t *testing.T) {
+	// Columns drawn separately on the same row, Latin-1 text, TJ kerning and leading-based lines
+	firstPage := `BT /F1 10 Tf
+72 800 Td (Fatura do cart\343o) Tj
+0 -14 Td (15/10) Tj
+60 0 Td (MERCADO EXTRA) Tj
+200 0 Td (150,00) Tj
+ET
+BT /F1 10 Tf 72 772 Td [(Total) -250 (a) -300 (pa) 20 (gar)] TJ ET`
+	secondPage := `BT /F1 10 Tf 14 TL 72 800 Td (Transa\347\365es) Tj T* (Linha seguinte) Tj ET`
+
+	for _, compress := range []bool{false, true} {
+		data := buildTestPDF(helveticaFont, compress, firstPage, secondPage)
+
+		text, err := ExtractPDFText(data)
+		if err != nil {
+			t.Fatalf("ExtractPDFText() error = %v", err)
+		}
+
+		want := "Fatura do cartão\n15/10 MERCADO EXTRA 150,00\nTotal a pagar\nTransações\nLinha seguinte"
+		if text != want {
+			t.Errorf("ExtractPDFText(compress=%v) = %q, want %q", compress, text, want)
+		}
+	}
+}
+
+func TestExtractPDFText_ToUnicodeCMap(t *testing.T) {
+	cmap := `/CIDInit /ProcSet findresource begin
+begincmap
+1 begincodespacerange <0000> <FFFF> endcodespacerange
+2 beginbfchar <0001> <0050> <0002> <0069> endbfchar
+1 beginbfrange <0003> <0004> [<0078> <00E7>] endbfrange
+endcmap`
+	data := writeTestPDF(
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /Resources << /Font << /F1 5 0 R >> >> /Contents 4 0 R >>",
+		testStream("BT /F1 12 Tf 1 0 0 1 72 700 Tm <0001000200030004> Tj ET", true),
+		"<< /Type /Font /Subtype /Type0 /BaseFont /Custom /Encoding /Identity-H /ToUnicode 6 0 R >>",
+		testStream(cmap, false),
+	)
+
+	text, err := ExtractPDFText(data)
+	if err != nil {
+		t.Fatalf("ExtractPDFText() error = %v", err)
+	}
+	if text != "Pixç" {
+		t.Errorf("ExtractPDFText() = %q, want %q", text, "Pixç")
+	}
+}
+
+func TestExtractPDFText_Invalid(t *testing.T) {
+	encrypted := bytes.Replace(
+		buildTestPDF(helveticaFont, false, "BT /F1 10 Tf (x) Tj ET"),
+		[]byte("/Root 1 0 R"), []byte("/Root 1 0 R /Encrypt 9 0 R"), 1,
+	)
+	cyclicKids := writeTestPDF(
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [2 0 R] /Count 1 >>",
+	)
+	cyclicGrandkids := writeTestPDF(
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Pages /Parent 2 0 R /Kids [2 0 R] /Count 1 >>",
+	)
+	oversizedTree := writeTestPDF(
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>",
+			strings.Repeat("<< /Type /Page >> ", pdfMaxPageTreeNodes), pdfMaxPageTreeNodes),
+	)
+	zipBomb := buildTestPDF(helveticaFont, true, "BT /F1 10 Tf (x) Tj ET"+strings.Repeat(" ", pdfMaxDecodedSize))
+
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{name: "not a PDF", data: []byte("Data,Valor\n15/10,150.00\n"), want: ErrInvalidPDF},
+		{name: "no pages", data: writeTestPDF("<< /Type /Catalog >>"), want: ErrInvalidPDF},
+		{name: "password-protected", data: encrypted, want: ErrEncryptedPDF},
+		{name: "page tree listing itself as a kid", data: cyclicKids, want: ErrPDFPageTreeLoop},
+		{name: "page tree looping through a kid", data: cyclicGrandkids, want: ErrPDFPageTreeLoop},
+		{name: "page tree with too many nodes", data: oversizedTree, want: ErrPDFTooLarge},
+		{name: "content stream inflating beyond the limit", data: zipBomb, want: ErrPDFTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ExtractPDFText(tt.data); !errors.Is(err, tt.want) {
+				t.Errorf("ExtractPDFText() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,408 @@
+// Package storage provides object storage for attachments, backed by S3-compatible
+// services (e.g., MinIO or AWS S3) or by the local filesystem.
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+	domainerror "github.com/finance-tracker/backend/internal/domain/error"
+)
+
+const (
+	// signingAlgorithm is the AWS Signature Version 4 algorithm name.
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+
+	// unsignedPayload lets uploads be streamed without hashing the body first.
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+
+	// maxPresignExpiry is the longest expiry S3 accepts for presigned URLs.
+	maxPresignExpiry = 7 * 24 * time.Hour
+
+	// emptyPayloadHash is the SHA-256 of an empty body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// S3Config holds the configuration of an S3-compatible object storage.
+type S3Config struct {
+	Endpoint  string // Host and optional port, e.g. "localhost:9000"
+	AccessKey string
+	SecretKey string
+	Bucket    string
+	Region    string
+	UseSSL    bool
+}
+
+// S3Storage implements the adapter.ObjectStorage interface for S3-compatible services.
+// Objects are addressed path-style (endpoint/bucket/key), which MinIO and AWS S3 both accept.
+type S3Storage struct {
+	client   *http.Client
+	endpoint *url.URL
+	bucket   string
+	signer   signer
+	now      func() time.Time
+}
+
+// NewS3Storage creates a new S3-compatible object storage client.
+func NewS3Storage(config S3Config) (*S3Storage, error) {
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, fmt.Errorf("object storage endpoint and bucket are required")
+	}
+
+	scheme := "http"
+	if config.UseSSL {
+		scheme = "https"
+	}
+
+	endpoint, err := url.Parse(scheme + "://" + config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object storage endpoint: %w", err)
+	}
+
+	region := config.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	return &S3Storage{
+		client:   &http.Client{Timeout: 60 * time.Second},
+		endpoint: endpoint,
+		bucket:   config.Bucket,
+		signer: signer{
+			accessKey: config.AccessKey,
+			secretKey: config.SecretKey,
+			region:    region,
+		},
+		now: time.Now,
+	}, nil
+}
+
+// EnsureBucket creates the bucket if it does not exist yet.
+func (s *S3Storage) EnsureBucket(ctx context.Context) error {
+	resp, err := s.do(ctx, http.MethodHead, s.bucketURL(), nil, 0, "")
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode != http.StatusNotFound:
+		return fmt.Errorf("failed to check bucket %s: unexpected status %d", s.bucket, resp.StatusCode)
+	}
+
+	resp, err = s.do(ctx, http.MethodPut, s.bucketURL(), nil, 0, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return unexpectedStatus(resp, "create bucket "+s.bucket)
+	}
+	return nil
+}
+
+// Put stores the content under the key, replacing any existing object.
+func (s *S3Storage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	resp, err := s.do(ctx, http.MethodPut, s.objectURL(key), content, size, contentType)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return unexpectedStatus(resp, "put object "+key)
+	}
+	return nil
+}
+
+// Get opens the object stored under the key.
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := s.do(ctx, http.MethodGet, s.objectURL(key), nil, 0, "")
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, domainerror.ErrObjectNotFound
+	default:
+		defer resp.Body.Close()
+		return nil, unexpectedStatus(resp, "get object "+key)
+	}
+}
+
+// Stat returns information about the object stored under the key.
+func (s *S3Storage) Stat(ctx context.Context, key string) (*adapter.ObjectInfo, error) {
+	resp, err := s.do(ctx, http.MethodHead, s.objectURL(key), nil, 0, "")
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return &adapter.ObjectInfo{
+			Size:        resp.ContentLength,
+			ContentType: resp.Header.Get("Content-Type"),
+		}, nil
+	case http.StatusNotFound:
+		return nil, domainerror.ErrObjectNotFound
+	default:
+		return nil, fmt.Errorf("failed to stat object %s: unexpected status %d", key, resp.StatusCode)
+	}
+}
+
+// Delete removes the object stored under the key. Deleting a missing object is not an error.
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, s.objectURL(key), nil, 0, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return unexpectedStatus(resp, "delete object "+key)
+	}
+	return nil
+}
+
+// PresignPut returns a URL the client can upload the object to with a PUT request.
+func (s *S3Storage) PresignPut(ctx context.Context, key string, contentType string, expiry time.Duration) (string, error) {
+	return s.signer.presign(http.MethodPut, s.objectURL(key), clampExpiry(expiry), s.now()), nil
+}
+
+// PresignGet returns a URL the client can download the object from.
+func (s *S3Storage) PresignGet(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	return s.signer.presign(http.MethodGet, s.objectURL(key), clampExpiry(expiry), s.now()), nil
+}
+
+// do sends a signed request to the object storage.
+func (s *S3Storage) do(
+	ctx context.Context,
+	method string,
+	target *url.URL,
+	body io.Reader,
+	size int64,
+	contentType string,
+) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build object storage request: %w", err)
+	}
+
+	payloadHash := emptyPayloadHash
+	if body != nil {
+		req.ContentLength = size
+		payloadHash = unsignedPayload
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	s.signer.sign(req, payloadHash, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("object storage request failed: %w", err)
+	}
+	return resp, nil
+}
+
+// bucketURL returns the URL of the bucket.
+func (s *S3Storage) bucketURL() *url.URL {
+	u := *s.endpoint
+	u.Path = "/" + s.bucket
+	return &u
+}
+
+// objectURL returns the path-style URL of the object stored under the key.
+func (s *S3Storage) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	u.Path = "/" + s.bucket + "/" + strings.TrimPrefix(key, "/")
+	u.RawPath = "/" + s.bucket + "/" + uriEncode(strings.TrimPrefix(key, "/"), false)
+	return &u
+}
+
+// signer signs requests with AWS Signature Version 4.
+type signer struct {
+	accessKey string
+	secretKey string
+	region    string
+}
+
+// sign adds the x-amz-date, x-amz-content-sha256 and Authorization headers to the request.
+func (s signer) sign(req *http.Request, payloadHash string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		lower := strings.ToLower(name)
+		if lower == "content-type" || lower == "range" || strings.HasPrefix(lower, "x-amz-") {
+			headers[lower] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI(req.URL),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := s.scope(now)
+	signature := s.signature(now, s.stringToSign(amzDate, scope, canonicalRequest))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, s.accessKey, scope, signedHeaders, signature))
+}
+
+// presign returns the URL with a query string signature that is valid for expiry.
+func (s signer) presign(method string, target *url.URL, expiry time.Duration, now time.Time) string {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	scope := s.scope(now)
+
+	query := target.Query()
+	query.Set("X-Amz-Algorithm", signingAlgorithm)
+	query.Set("X-Amz-Credential", s.accessKey+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expiry.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		canonicalURI(target),
+		canonicalQuery(query),
+		"host:" + target.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+
+	signature := s.signature(now, s.stringToSign(amzDate, scope, canonicalRequest))
+
+	signed := *target
+	signed.RawQuery = canonicalQuery(query) + "&X-Amz-Signature=" + signature
+	return signed.String()
+}
+
+// scope returns the credential scope for the day.
+func (s signer) scope(now time.Time) string {
+	return now.Format("20060102") + "/" + s.region + "/s3/aws4_request"
+}
+
+// stringToSign builds the string to sign from the canonical request.
+func (s signer) stringToSign(amzDate, scope, canonicalRequest string) string {
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	return signingAlgorithm + "\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(hash[:])
+}
+
+// signature derives the day's signing key and signs the string.
+func (s signer) signature(now time.Time, stringToSign string) string {
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), now.Format("20060102"))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// hmacSHA256 computes the HMAC-SHA256 of the data with the key.
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// canonicalURI returns the URI-encoded path of the URL.
+func canonicalURI(u *url.URL) string {
+	if u.Path == "" {
+		return "/"
+	}
+	return uriEncode(u.Path, false)
+}
+
+// canonicalQuery returns the query parameters sorted by name and URI-encoded.
+func canonicalQuery(query url.Values) string {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		values := append([]string(nil), query[name]...)
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(name, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode percent-encodes everything but unreserved characters, as Signature Version 4 requires.
+// Slashes are kept unless encodeSlash is set.
+func uriEncode(value string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9',
+			c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// clampExpiry keeps presigned URL expiries within what S3 accepts.
+func clampExpiry(expiry time.Duration) time.Duration {
+	if expiry <= 0 || expiry > maxPresignExpiry {
+		return maxPresignExpiry
+	}
+	return expiry
+}
+
+// unexpectedStatus builds an error from a failed object storage response.
+func unexpectedStatus(resp *http.Response, operation string) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("failed to %s: unexpected status %d: %s", operation, resp.StatusCode, strings.TrimSpace(string(body)))
+}
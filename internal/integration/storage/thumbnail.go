@@ -0,0 +1,139 @@
+// Package storage provides object storage for attachments, backed by S3-compatible
+// services (e.g., MinIO or AWS S3) or by the local filesystem.
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // Register GIF decoding
+	"image/jpeg"
+	_ "image/png" // Register PNG decoding
+
+	"github.com/finance-tracker/backend/internal/application/adapter"
+)
+
+const (
+	// DefaultThumbnailSize is the default length of the longest side of thumbnails, in pixels.
+	DefaultThumbnailSize = 256
+
+	// maxThumbnailSourcePixels guards against decoding huge (or maliciously crafted) images.
+	maxThumbnailSourcePixels = 50_000_000
+
+	thumbnailJPEGQuality = 80
+)
+
+// thumbnailContentTypes lists the image types thumbnails can be generated for.
+var thumbnailContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// ImageThumbnailGenerator implements the adapter.ThumbnailGenerator interface with the
+// standard library image decoders. Thumbnails are JPEG images.
+type ImageThumbnailGenerator struct {
+	size int
+}
+
+// NewImageThumbnailGenerator creates a thumbnail generator whose thumbnails fit in a
+// size x size square.
+func NewImageThumbnailGenerator(size int) *ImageThumbnailGenerator {
+	if size <= 0 {
+		size = DefaultThumbnailSize
+	}
+	return &ImageThumbnailGenerator{size: size}
+}
+
+// Generate returns a thumbnail of the image, or nil when thumbnails are not supported
+// for the content type.
+func (g *ImageThumbnailGenerator) Generate(content []byte, contentType string) (*adapter.Thumbnail, error) {
+	if !thumbnailContentTypes[contentType] {
+		return nil, nil
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxThumbnailSourcePixels {
+		return nil, fmt.Errorf("image dimensions %dx%d not supported", config.Width, config.Height)
+	}
+
+	source, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, g.scale(source), &jpeg.Options{Quality: thumbnailJPEGQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	return &adapter.Thumbnail{
+		Content:      buf.Bytes(),
+		ContentType:  "image/jpeg",
+		SourceWidth:  config.Width,
+		SourceHeight: config.Height,
+	}, nil
+}
+
+// scale shrinks the image to fit the thumbnail size, averaging the source pixels each
+// thumbnail pixel covers. Images that already fit are only flattened onto white.
+func (g *ImageThumbnailGenerator) scale(source image.Image) image.Image {
+	bounds := source.Bounds()
+	width, height := thumbnailDimensions(bounds.Dx(), bounds.Dy(), g.size)
+
+	// Flatten transparency onto white, since JPEG has no alpha channel
+	flat := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(flat, flat.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), source, bounds.Min, draw.Over)
+
+	if width == bounds.Dx() && height == bounds.Dy() {
+		return flat
+	}
+
+	thumbnail := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := y * bounds.Dy() / height
+		y1 := max((y+1)*bounds.Dy()/height, y0+1)
+
+		for x := 0; x < width; x++ {
+			x0 := x * bounds.Dx() / width
+			x1 := max((x+1)*bounds.Dx()/width, x0+1)
+
+			var r, gr, b, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					offset := flat.PixOffset(sx, sy)
+					r += uint64(flat.Pix[offset])
+					gr += uint64(flat.Pix[offset+1])
+					b += uint64(flat.Pix[offset+2])
+					count++
+				}
+			}
+
+			thumbnail.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / count),
+				G: uint8(gr / count),
+				B: uint8(b / count),
+				A: 0xff,
+			})
+		}
+	}
+	return thumbnail
+}
+
+// thumbnailDimensions fits width x height in a size x size square, keeping the aspect ratio.
+func thumbnailDimensions(width, height, size int) (int, int) {
+	if width <= size && height <= size {
+		return width, height
+	}
+
+	if width >= height {
+		return size, max(height*size/width, 1)
+	}
+	return max(width*size/height, 1), size
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: 200, G: 40, B: 40, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestImageThumbnailGenerator_Generate(t *testing.T) {
+	generator := NewImageThumbnailGenerator(100)
+
+	thumbnail, err := generator.Generate(encodePNG(t, 400, 200), "image/png")
+	if err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+	if thumbnail.SourceWidth != 400 || thumbnail.SourceHeight != 200 || thumbnail.ContentType != "image/jpeg" {
+		t.Errorf("unexpected thumbnail metadata: %dx%d %s", thumbnail.SourceWidth, thumbnail.SourceHeight, thumbnail.ContentType)
+	}
+
+	decoded, err := jpeg.Decode(bytes.NewReader(thumbnail.Content))
+	if err != nil {
+		t.Fatalf("thumbnail is not a JPEG: %v", err)
+	}
+	if bounds := decoded.Bounds(); bounds.Dx() != 100 || bounds.Dy() != 50 {
+		t.Errorf("thumbnail size = %dx%d, want 100x50", bounds.Dx(), bounds.Dy())
+	}
+
+	r, g, b, _ := decoded.At(50, 25).RGBA()
+	if r>>8 < 180 || g>>8 > 70 || b>>8 > 70 {
+		t.Errorf("thumbnail color = (%d, %d, %d), want close to (200, 40, 40)", r>>8, g>>8, b>>8)
+	}
+}
+
+func TestImageThumbnailGenerator_UnsupportedContent(t *testing.T) {
+	generator := NewImageThumbnailGenerator(DefaultThumbnailSize)
+
+	thumbnail, err := generator.Generate([]byte("%PDF-1.4"), "application/pdf")
+	if thumbnail != nil || err != nil {
+		t.Errorf("expected no thumbnail for PDFs, got %v, %v", thumbnail, err)
+	}
+
+	if _, err := generator.Generate([]byte("not an image"), "image/png"); err == nil {
+		t.Error("expected an error for a corrupt image")
+	}
+}
+
+func TestThumbnailDimensions(t *testing.T) {
+	tests := []struct {
+		width, height, wantWidth, wantHeight int
+	}{
+		{400, 200, 100, 50},
+		{200, 400, 50, 100},
+		{80, 60, 80, 60},
+		{1000, 1, 100, 1},
+	}
+
+	for _, tt := range tests {
+		if w, h := thumbnailDimensions(tt.width, tt.height, 100); w != tt.wantWidth || h != tt.wantHeight {
+			t.Errorf("thumbnailDimensions(%d, %d) = %dx%d, want %dx%d", tt.width, tt.height, w, h, tt.wantWidth, tt.wantHeight)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+-- Migration: Remove external_id from transactions
+
+DROP INDEX IF EXISTS idx_transactions_user_external_id;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS external_id;
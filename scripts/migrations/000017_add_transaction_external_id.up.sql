@@ -0,0 +1,11 @@
+-- Migration: Add external_id to transactions for statement imports
+-- Purpose: Store the bank-provided identifier (OFX FITID) so re-importing a statement is idempotent
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS external_id VARCHAR(255);
+
+-- Unique per user, including soft-deleted rows, so deleted imports are not resurrected on re-import
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_user_external_id
+ON transactions (user_id, external_id)
+WHERE external_id IS NOT NULL;
+
+COMMENT ON COLUMN transactions.external_id IS 'Bank-provided identifier (e.g., ofx:<bank>:<account>:<fitid>) used to de-duplicate imports';
@@ -0,0 +1,7 @@
+-- Migration: Drop import_profiles table
+
+DROP INDEX IF EXISTS idx_import_profiles_user_name;
+DROP INDEX IF EXISTS idx_import_profiles_deleted_at;
+DROP INDEX IF EXISTS idx_import_profiles_user_id;
+
+DROP TABLE IF EXISTS import_profiles;
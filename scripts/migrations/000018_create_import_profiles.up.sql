@@ -0,0 +1,41 @@
+-- Migration: Create import_profiles table
+-- Purpose: Saved per-user CSV column mappings so a bank export can be re-imported with one click
+
+CREATE TABLE IF NOT EXISTS import_profiles (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+
+    -- File layout
+    delimiter VARCHAR(2),
+    has_header BOOLEAN NOT NULL DEFAULT TRUE,
+    skip_rows INTEGER NOT NULL DEFAULT 0,
+
+    -- Column mapping (header names or zero-based indexes)
+    date_column VARCHAR(100) NOT NULL,
+    description_column VARCHAR(100) NOT NULL,
+    amount_column VARCHAR(100),
+    debit_column VARCHAR(100),
+    credit_column VARCHAR(100),
+    installment_column VARCHAR(100),
+
+    -- Parsing options (NULL/empty falls back to the user's preferences)
+    date_format VARCHAR(20),
+    number_format VARCHAR(5),
+    invert_amounts BOOLEAN NOT NULL DEFAULT FALSE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT chk_import_profiles_skip_rows CHECK (skip_rows >= 0)
+);
+
+CREATE INDEX idx_import_profiles_user_id ON import_profiles(user_id);
+CREATE INDEX idx_import_profiles_deleted_at ON import_profiles(deleted_at);
+CREATE UNIQUE INDEX idx_import_profiles_user_name ON import_profiles(user_id, LOWER(name))
+    WHERE deleted_at IS NULL;
+
+COMMENT ON TABLE import_profiles IS 'Saved CSV column mappings for statement imports';
+COMMENT ON COLUMN import_profiles.date_column IS 'Header name or zero-based index of the date column';
+COMMENT ON COLUMN import_profiles.invert_amounts IS 'True when the file lists expenses as positive values';
@@ -0,0 +1,6 @@
+-- Migration: Drop transaction_duplicate_dismissals table
+
+DROP INDEX IF EXISTS idx_duplicate_dismissals_pair;
+DROP INDEX IF EXISTS idx_duplicate_dismissals_user_id;
+
+DROP TABLE IF EXISTS transaction_duplicate_dismissals;
@@ -0,0 +1,19 @@
+-- Migration: Create transaction_duplicate_dismissals table
+-- Purpose: Remember transaction pairs the user confirmed are not duplicates
+
+CREATE TABLE IF NOT EXISTS transaction_duplicate_dismissals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    transaction_id UUID NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    duplicate_id UUID NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_duplicate_dismissals_order CHECK (transaction_id::text < duplicate_id::text)
+);
+
+CREATE INDEX idx_duplicate_dismissals_user_id ON transaction_duplicate_dismissals(user_id);
+CREATE UNIQUE INDEX idx_duplicate_dismissals_pair
+    ON transaction_duplicate_dismissals(transaction_id, duplicate_id);
+
+COMMENT ON TABLE transaction_duplicate_dismissals IS 'Transaction pairs reviewed by the user and confirmed as not duplicates';
+COMMENT ON COLUMN transaction_duplicate_dismissals.transaction_id IS 'Lower of the two transaction IDs (canonical order)';
@@ -0,0 +1,11 @@
+-- Migration: Drop recurring_schedules table
+
+DROP INDEX IF EXISTS idx_transactions_recurring_schedule_date;
+ALTER TABLE transactions DROP COLUMN IF EXISTS recurring_schedule_id;
+
+DROP INDEX IF EXISTS idx_recurring_schedules_next_occurrence;
+DROP INDEX IF EXISTS idx_recurring_schedules_deleted_at;
+DROP INDEX IF EXISTS idx_recurring_schedules_category_id;
+DROP INDEX IF EXISTS idx_recurring_schedules_user_id;
+
+DROP TABLE IF EXISTS recurring_schedules;
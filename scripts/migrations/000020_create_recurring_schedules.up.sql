@@ -0,0 +1,61 @@
+-- Migration: Create recurring_schedules table
+-- Purpose: Repeating transactions (rent, salary, subscriptions) materialized by the background scheduler
+
+CREATE TABLE IF NOT EXISTS recurring_schedules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    description VARCHAR(255) NOT NULL,
+    amount DECIMAL(15,2) NOT NULL,
+    type VARCHAR(10) NOT NULL,
+    category_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+    notes TEXT,
+
+    -- Recurrence rule
+    frequency VARCHAR(10) NOT NULL,
+    interval_count INTEGER NOT NULL DEFAULT 1,
+    day_of_month INTEGER,
+    day_of_week INTEGER,
+    start_date DATE NOT NULL,
+    end_date DATE,
+    max_occurrences INTEGER,
+
+    -- Progress
+    occurrence_count INTEGER NOT NULL DEFAULT 0,
+    last_occurrence_date DATE,
+    next_occurrence DATE,
+    last_reminded_for DATE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT chk_recurring_schedules_type CHECK (type IN ('expense', 'income')),
+    CONSTRAINT chk_recurring_schedules_frequency CHECK (frequency IN ('weekly', 'monthly', 'yearly')),
+    CONSTRAINT chk_recurring_schedules_interval CHECK (interval_count >= 1),
+    CONSTRAINT chk_recurring_schedules_day_of_month CHECK (day_of_month IS NULL OR day_of_month BETWEEN 1 AND 31),
+    CONSTRAINT chk_recurring_schedules_day_of_week CHECK (day_of_week IS NULL OR day_of_week BETWEEN 0 AND 6),
+    CONSTRAINT chk_recurring_schedules_max_occurrences CHECK (max_occurrences IS NULL OR max_occurrences >= 1)
+);
+
+CREATE INDEX idx_recurring_schedules_user_id ON recurring_schedules(user_id);
+CREATE INDEX idx_recurring_schedules_category_id ON recurring_schedules(category_id);
+CREATE INDEX idx_recurring_schedules_deleted_at ON recurring_schedules(deleted_at);
+-- Scheduler lookup: active schedules by next occurrence
+CREATE INDEX idx_recurring_schedules_next_occurrence ON recurring_schedules(next_occurrence)
+    WHERE is_active = TRUE AND deleted_at IS NULL;
+
+-- Link generated transactions to their schedule
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS recurring_schedule_id UUID
+    REFERENCES recurring_schedules(id) ON DELETE SET NULL;
+
+-- One transaction per schedule and date, so concurrent scheduler runs cannot double-post
+CREATE UNIQUE INDEX IF NOT EXISTS idx_transactions_recurring_schedule_date
+ON transactions (recurring_schedule_id, date)
+WHERE recurring_schedule_id IS NOT NULL;
+
+COMMENT ON TABLE recurring_schedules IS 'Repeating transactions materialized by the recurring scheduler';
+COMMENT ON COLUMN recurring_schedules.day_of_month IS 'Monthly/yearly day (1-31), clamped to the last day of shorter months';
+COMMENT ON COLUMN recurring_schedules.day_of_week IS 'Weekly day (0 = Sunday, 6 = Saturday)';
+COMMENT ON COLUMN recurring_schedules.next_occurrence IS 'Next date to materialize; NULL once the schedule has ended';
+COMMENT ON COLUMN transactions.recurring_schedule_id IS 'Recurring schedule that generated this transaction';
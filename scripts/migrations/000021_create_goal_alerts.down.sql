@@ -0,0 +1,6 @@
+-- Migration: Drop goal_alerts table
+
+DROP INDEX IF EXISTS idx_goal_alerts_period;
+DROP INDEX IF EXISTS idx_goal_alerts_user_id;
+
+DROP TABLE IF EXISTS goal_alerts;
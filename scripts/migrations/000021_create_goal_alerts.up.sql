@@ -0,0 +1,21 @@
+-- Migration: Create goal_alerts table
+-- Purpose: Record goal threshold alerts so each threshold fires once per period
+
+CREATE TABLE IF NOT EXISTS goal_alerts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    goal_id UUID NOT NULL REFERENCES goals(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    threshold VARCHAR(20) NOT NULL,
+    period_start DATE NOT NULL,
+    spent_amount DECIMAL(15,2) NOT NULL,
+    limit_amount DECIMAL(15,2) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_goal_alerts_threshold CHECK (threshold IN ('80_percent', 'exceeded'))
+);
+
+CREATE INDEX idx_goal_alerts_user_id ON goal_alerts(user_id);
+CREATE UNIQUE INDEX idx_goal_alerts_period ON goal_alerts(goal_id, threshold, period_start);
+
+COMMENT ON TABLE goal_alerts IS 'Goal threshold alerts already sent, one per goal, threshold and period';
+COMMENT ON COLUMN goal_alerts.period_start IS 'First day of the goal period the alert belongs to';
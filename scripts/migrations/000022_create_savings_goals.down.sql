@@ -0,0 +1,19 @@
+-- Migration: Drop goal_contributions table and savings goal columns
+
+DROP INDEX IF EXISTS idx_goal_contributions_transaction;
+DROP INDEX IF EXISTS idx_goal_contributions_deleted_at;
+DROP INDEX IF EXISTS idx_goal_contributions_user_id;
+DROP INDEX IF EXISTS idx_goal_contributions_goal_id;
+
+DROP TABLE IF EXISTS goal_contributions;
+
+DELETE FROM goals WHERE kind = 'savings';
+
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS chk_goals_kind;
+ALTER TABLE goals ALTER COLUMN period SET NOT NULL;
+ALTER TABLE goals ALTER COLUMN category_id SET NOT NULL;
+
+ALTER TABLE goals DROP COLUMN IF EXISTS target_date;
+ALTER TABLE goals DROP COLUMN IF EXISTS target_amount;
+ALTER TABLE goals DROP COLUMN IF EXISTS name;
+ALTER TABLE goals DROP COLUMN IF EXISTS kind;
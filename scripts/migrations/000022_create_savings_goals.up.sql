@@ -0,0 +1,47 @@
+-- Migration: Add savings goals and goal_contributions table
+-- Purpose: Support savings targets alongside spending limits, funded by manual deposits or linked transactions
+
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS kind VARCHAR(20) NOT NULL DEFAULT 'spending_limit';
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS name VARCHAR(100);
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS target_amount DECIMAL(15,2) NOT NULL DEFAULT 0;
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS target_date DATE;
+
+-- Savings goals are not tied to a category or period
+ALTER TABLE goals ALTER COLUMN category_id DROP NOT NULL;
+ALTER TABLE goals ALTER COLUMN period DROP NOT NULL;
+
+ALTER TABLE goals ADD CONSTRAINT chk_goals_kind CHECK (
+    (kind = 'spending_limit' AND category_id IS NOT NULL AND period IS NOT NULL)
+    OR (kind = 'savings' AND name IS NOT NULL AND target_amount > 0)
+);
+
+COMMENT ON COLUMN goals.kind IS 'Goal kind: spending_limit (per category) or savings (target amount)';
+COMMENT ON COLUMN goals.name IS 'Name of the savings goal (e.g., Emergency fund)';
+COMMENT ON COLUMN goals.target_amount IS 'Amount to save for savings goals';
+COMMENT ON COLUMN goals.target_date IS 'Optional deadline for savings goals';
+
+CREATE TABLE IF NOT EXISTS goal_contributions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    goal_id UUID NOT NULL REFERENCES goals(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    transaction_id UUID REFERENCES transactions(id) ON DELETE SET NULL,
+    amount DECIMAL(15,2) NOT NULL,
+    date DATE NOT NULL,
+    notes TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT chk_goal_contributions_amount CHECK (amount > 0)
+);
+
+CREATE INDEX idx_goal_contributions_goal_id ON goal_contributions(goal_id, date);
+CREATE INDEX idx_goal_contributions_user_id ON goal_contributions(user_id);
+CREATE INDEX idx_goal_contributions_deleted_at ON goal_contributions(deleted_at);
+
+-- A transaction can only count once towards the same goal
+CREATE UNIQUE INDEX idx_goal_contributions_transaction ON goal_contributions(goal_id, transaction_id)
+    WHERE transaction_id IS NOT NULL AND deleted_at IS NULL;
+
+COMMENT ON TABLE goal_contributions IS 'Deposits towards savings goals, manual or linked to a transaction';
+COMMENT ON COLUMN goal_contributions.transaction_id IS 'Transaction this contribution comes from, null for manual deposits';
@@ -0,0 +1,10 @@
+-- Migration: Drop accounts table
+
+DROP INDEX IF EXISTS idx_transactions_account_date;
+ALTER TABLE transactions DROP COLUMN IF EXISTS account_id;
+
+DROP INDEX IF EXISTS idx_accounts_user_name;
+DROP INDEX IF EXISTS idx_accounts_deleted_at;
+DROP INDEX IF EXISTS idx_accounts_user_id;
+
+DROP TABLE IF EXISTS accounts;
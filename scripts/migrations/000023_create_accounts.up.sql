@@ -0,0 +1,45 @@
+-- Migration: Create accounts table
+-- Purpose: First-class accounts (checking, savings, credit cards, wallets) that transactions belong to
+
+CREATE TABLE IF NOT EXISTS accounts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    institution VARCHAR(100),
+    currency VARCHAR(3) NOT NULL DEFAULT 'BRL',
+    opening_balance DECIMAL(15,2) NOT NULL DEFAULT 0,
+
+    -- Credit card billing cycle
+    closing_day INTEGER,
+    due_day INTEGER,
+
+    is_archived BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT chk_accounts_type CHECK (type IN ('checking', 'savings', 'credit_card', 'wallet')),
+    CONSTRAINT chk_accounts_closing_day CHECK (closing_day IS NULL OR closing_day BETWEEN 1 AND 31),
+    CONSTRAINT chk_accounts_due_day CHECK (due_day IS NULL OR due_day BETWEEN 1 AND 31)
+);
+
+CREATE INDEX idx_accounts_user_id ON accounts(user_id);
+CREATE INDEX idx_accounts_deleted_at ON accounts(deleted_at);
+CREATE UNIQUE INDEX idx_accounts_user_name ON accounts(user_id, LOWER(name))
+    WHERE deleted_at IS NULL;
+
+-- Link transactions to the account they belong to
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS account_id UUID
+    REFERENCES accounts(id) ON DELETE SET NULL;
+
+-- Running balances and account filters scan an account's transactions in date order
+CREATE INDEX IF NOT EXISTS idx_transactions_account_date
+ON transactions (account_id, date, created_at)
+WHERE account_id IS NOT NULL AND deleted_at IS NULL;
+
+COMMENT ON TABLE accounts IS 'User accounts (checking, savings, credit card, wallet) that transactions belong to';
+COMMENT ON COLUMN accounts.opening_balance IS 'Balance before the first tracked transaction';
+COMMENT ON COLUMN accounts.closing_day IS 'Credit card statement closing day (1-31)';
+COMMENT ON COLUMN accounts.due_day IS 'Credit card payment due day (1-31)';
+COMMENT ON COLUMN transactions.account_id IS 'Account the transaction belongs to';
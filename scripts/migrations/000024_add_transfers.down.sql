@@ -0,0 +1,12 @@
+-- Migration: Remove transfers between accounts
+
+-- Transfer legs cannot be represented without the transfer type
+DELETE FROM transactions WHERE type = 'transfer';
+
+DROP INDEX IF EXISTS idx_transactions_transfer_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS transfer_id;
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_type;
+ALTER TABLE transactions ALTER COLUMN type TYPE category_type USING type::category_type;
+
+COMMENT ON COLUMN transactions.type IS 'expense or income (derived from amount sign)';
@@ -0,0 +1,17 @@
+-- Migration: Add transfers between accounts
+-- Purpose: Transfers are stored as two transaction legs sharing a transfer_id and use their own
+-- 'transfer' type so they never count as income or expense
+
+-- The type column reused the category_type enum, which cannot hold 'transfer'
+ALTER TABLE transactions ALTER COLUMN type TYPE VARCHAR(10) USING type::text;
+ALTER TABLE transactions ADD CONSTRAINT chk_transactions_type
+    CHECK (type IN ('expense', 'income', 'transfer'));
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS transfer_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_transfer_id
+ON transactions (transfer_id)
+WHERE transfer_id IS NOT NULL;
+
+COMMENT ON COLUMN transactions.type IS 'expense, income or transfer';
+COMMENT ON COLUMN transactions.transfer_id IS 'Shared by both legs of a transfer between accounts';
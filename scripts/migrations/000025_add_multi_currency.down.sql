@@ -0,0 +1,10 @@
+-- Migration: Remove multi-currency support
+
+DROP INDEX IF EXISTS idx_exchange_rates_user_pair_date;
+DROP TABLE IF EXISTS exchange_rates;
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_exchange_rate;
+ALTER TABLE transactions DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE transactions DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE users DROP COLUMN IF EXISTS base_currency;
@@ -0,0 +1,35 @@
+-- Migration: Add multi-currency support
+-- Purpose: Transactions keep the currency they were made in plus a snapshot of the rate into the
+-- user's base currency, so reports can sum them in a single currency
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS base_currency VARCHAR(3) NOT NULL DEFAULT 'BRL';
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'BRL';
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS exchange_rate DECIMAL(18,8) NOT NULL DEFAULT 1;
+ALTER TABLE transactions ADD CONSTRAINT chk_transactions_exchange_rate CHECK (exchange_rate > 0);
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    from_currency VARCHAR(3) NOT NULL,
+    to_currency VARCHAR(3) NOT NULL,
+    date DATE NOT NULL,
+    rate DECIMAL(18,8) NOT NULL,
+    source VARCHAR(10) NOT NULL DEFAULT 'manual',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_exchange_rates_rate CHECK (rate > 0),
+    CONSTRAINT chk_exchange_rates_pair CHECK (from_currency <> to_currency),
+    CONSTRAINT chk_exchange_rates_source CHECK (source IN ('manual', 'file'))
+);
+
+-- One rate per pair and day; lookups take the latest rate on or before a date
+CREATE UNIQUE INDEX IF NOT EXISTS idx_exchange_rates_user_pair_date
+ON exchange_rates (user_id, from_currency, to_currency, date);
+
+COMMENT ON TABLE exchange_rates IS 'Per-user exchange rates, entered manually or loaded from a file';
+COMMENT ON COLUMN exchange_rates.rate IS 'Value of one unit of from_currency in to_currency';
+COMMENT ON COLUMN users.base_currency IS 'Currency reports are converted into';
+COMMENT ON COLUMN transactions.currency IS 'ISO 4217 code of the amount';
+COMMENT ON COLUMN transactions.exchange_rate IS 'Rate into the user''s base currency, snapshotted when the transaction was recorded';
@@ -0,0 +1,7 @@
+-- Migration: Remove split transactions
+
+DROP INDEX IF EXISTS idx_transaction_splits_category_id;
+DROP INDEX IF EXISTS idx_transaction_splits_transaction_id;
+DROP TABLE IF EXISTS transaction_splits;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS is_split;
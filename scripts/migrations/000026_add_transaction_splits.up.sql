@@ -0,0 +1,24 @@
+-- Migration: Add split transactions
+-- Purpose: A transaction can be split into lines (amount + category + notes) that add up to its
+-- amount, so a single receipt is attributed to several categories in reports and goals
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS is_split BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS transaction_splits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transaction_id UUID NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    category_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+    amount DECIMAL(15,2) NOT NULL,
+    notes TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_transaction_splits_amount CHECK (amount <> 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_transaction_id ON transaction_splits(transaction_id);
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_category_id ON transaction_splits(category_id);
+
+COMMENT ON TABLE transaction_splits IS 'Lines of a split transaction, each attributed to its own category';
+COMMENT ON COLUMN transaction_splits.amount IS 'Part of the transaction amount, in the transaction currency';
+COMMENT ON COLUMN transactions.is_split IS 'True when the amount is attributed to categories through transaction_splits';
@@ -0,0 +1,10 @@
+-- Migration: Drop tags
+
+DROP INDEX IF EXISTS idx_transaction_tags_tag_id;
+DROP TABLE IF EXISTS transaction_tags;
+
+DROP INDEX IF EXISTS idx_tags_user_name;
+DROP INDEX IF EXISTS idx_tags_deleted_at;
+DROP INDEX IF EXISTS idx_tags_user_id;
+
+DROP TABLE IF EXISTS tags;
@@ -0,0 +1,32 @@
+-- Migration: Create tags
+-- Purpose: User-owned tags with a many-to-many link to transactions, for cross-cutting labels
+-- (e.g., "vacation-2026", "reimbursable") that categories cannot express
+
+CREATE TABLE IF NOT EXISTS tags (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    color VARCHAR(7) NOT NULL DEFAULT '#64748B',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_tags_user_id ON tags(user_id);
+CREATE INDEX idx_tags_deleted_at ON tags(deleted_at);
+CREATE UNIQUE INDEX idx_tags_user_name ON tags(user_id, LOWER(name))
+    WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS transaction_tags (
+    transaction_id UUID NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (transaction_id, tag_id)
+);
+
+-- Tag filters and the tag breakdown look up transactions by tag
+CREATE INDEX IF NOT EXISTS idx_transaction_tags_tag_id ON transaction_tags(tag_id);
+
+COMMENT ON TABLE tags IS 'User-defined labels that can be attached to many transactions';
+COMMENT ON TABLE transaction_tags IS 'Links between transactions and their tags';
@@ -0,0 +1,6 @@
+-- Migration: Drop attachments table
+
+DROP INDEX IF EXISTS idx_attachments_owner;
+DROP INDEX IF EXISTS idx_attachments_user_id;
+
+DROP TABLE IF EXISTS attachments;
@@ -0,0 +1,32 @@
+-- Migration: Create attachments table
+-- Purpose: Receipts and documents kept next to transactions and accounts. Files live in
+-- S3-compatible object storage; this table holds their metadata and storage keys
+
+CREATE TABLE IF NOT EXISTS attachments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    owner_type VARCHAR(20) NOT NULL,
+    owner_id UUID NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    checksum VARCHAR(64),
+    storage_key VARCHAR(500) NOT NULL,
+    thumbnail_key VARCHAR(500),
+    image_width INTEGER,
+    image_height INTEGER,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_attachments_owner_type CHECK (owner_type IN ('transaction', 'account')),
+    CONSTRAINT chk_attachments_status CHECK (status IN ('pending', 'uploaded'))
+);
+
+CREATE INDEX idx_attachments_user_id ON attachments(user_id);
+CREATE INDEX idx_attachments_owner ON attachments(owner_type, owner_id);
+
+COMMENT ON TABLE attachments IS 'Files attached to transactions and accounts, stored in object storage';
+COMMENT ON COLUMN attachments.owner_id IS 'ID of the transaction or account, depending on owner_type';
+COMMENT ON COLUMN attachments.checksum IS 'Hex SHA-256 of the file';
+COMMENT ON COLUMN attachments.status IS 'pending until the file has been uploaded to object storage';
@@ -0,0 +1,7 @@
+-- Migration: Remove full-text search from transactions
+
+DROP INDEX IF EXISTS idx_transactions_search_vector;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS search_vector;
+
+DROP FUNCTION IF EXISTS f_unaccent(text);
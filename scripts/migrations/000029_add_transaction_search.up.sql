@@ -0,0 +1,23 @@
+-- Migration: Add full-text search to transactions
+-- Purpose: Accent-insensitive search over transaction descriptions and notes, with Portuguese
+-- stemming, backing the search query syntax of the transaction list
+
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- unaccent() is only STABLE, so it cannot be used in generated columns or indexes. Naming the
+-- dictionary explicitly makes the result fixed, which is what this wrapper declares
+CREATE OR REPLACE FUNCTION f_unaccent(text)
+RETURNS text
+LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT
+AS $$ SELECT public.unaccent('public.unaccent', $1) $$;
+
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('portuguese', f_unaccent(COALESCE(description, ''))), 'A') ||
+        setweight(to_tsvector('portuguese', f_unaccent(COALESCE(notes, ''))), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_search_vector ON transactions USING GIN (search_vector);
+
+COMMENT ON FUNCTION f_unaccent(text) IS 'Immutable unaccent() usable in generated columns and indexes';
+COMMENT ON COLUMN transactions.search_vector IS 'Unaccented full-text vector of description (weight A) and notes (weight B)';
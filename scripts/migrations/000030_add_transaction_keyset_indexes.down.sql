@@ -0,0 +1,4 @@
+-- Migration: Remove keyset pagination indexes from transactions
+
+DROP INDEX IF EXISTS idx_transactions_user_amount_keyset;
+DROP INDEX IF EXISTS idx_transactions_user_date_keyset;
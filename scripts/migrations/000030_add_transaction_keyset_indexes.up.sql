@@ -0,0 +1,11 @@
+-- Migration: Add keyset pagination indexes to transactions
+-- Purpose: Transaction listings are ordered by a sort key, then created_at and id, and continue after
+-- a cursor. These indexes serve that order for the date and amount sorts without scanning earlier pages
+
+CREATE INDEX IF NOT EXISTS idx_transactions_user_date_keyset
+    ON transactions(user_id, date DESC, created_at DESC, id DESC)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_user_amount_keyset
+    ON transactions(user_id, ABS(amount), created_at, id)
+    WHERE deleted_at IS NULL;
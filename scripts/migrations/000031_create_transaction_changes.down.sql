@@ -0,0 +1,7 @@
+-- Migration: Drop transaction_changes table
+
+DROP INDEX IF EXISTS idx_transaction_changes_operation_id;
+DROP INDEX IF EXISTS idx_transaction_changes_user_id;
+DROP INDEX IF EXISTS idx_transaction_changes_transaction_id;
+
+DROP TABLE IF EXISTS transaction_changes;
@@ -0,0 +1,30 @@
+-- Migration: Create transaction_changes table
+-- Purpose: Append-only history of every mutation to a transaction (who, from which feature,
+-- and the before/after value of each changed field), so changes can be audited and reverted
+
+CREATE TABLE IF NOT EXISTS transaction_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    transaction_id UUID NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    source VARCHAR(20) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    operation_id UUID NOT NULL,
+    fields JSONB NOT NULL DEFAULT '[]',
+    reverted_at TIMESTAMP WITH TIME ZONE,
+    reverted_by_id UUID REFERENCES transaction_changes(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_transaction_changes_source CHECK (source IN ('manual', 'bulk', 'rule', 'ai', 'import', 'revert')),
+    CONSTRAINT chk_transaction_changes_action CHECK (action IN ('create', 'update', 'delete'))
+);
+
+CREATE INDEX idx_transaction_changes_transaction_id ON transaction_changes(transaction_id, created_at DESC);
+CREATE INDEX idx_transaction_changes_user_id ON transaction_changes(user_id);
+CREATE INDEX idx_transaction_changes_operation_id ON transaction_changes(operation_id);
+
+COMMENT ON TABLE transaction_changes IS 'Append-only history of transaction mutations; only the revert columns are ever updated';
+COMMENT ON COLUMN transaction_changes.actor_id IS 'User who made the change, NULL for automated changes';
+COMMENT ON COLUMN transaction_changes.operation_id IS 'Shared by the changes of one operation (e.g., a bulk categorize), which are reverted together';
+COMMENT ON COLUMN transaction_changes.fields IS 'Array of {field, before, after} for the changed fields; values are text, NULL when empty';
+COMMENT ON COLUMN transaction_changes.reverted_by_id IS 'Change recorded when this change was reverted';
@@ -0,0 +1,10 @@
+-- Migration: Drop merchants
+
+DROP INDEX IF EXISTS idx_transactions_merchant_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS merchant_id;
+
+DROP INDEX IF EXISTS idx_merchants_user_id_key;
+DROP INDEX IF EXISTS idx_merchants_deleted_at;
+DROP INDEX IF EXISTS idx_merchants_user_id;
+
+DROP TABLE IF EXISTS merchants;
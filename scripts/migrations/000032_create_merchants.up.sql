@@ -0,0 +1,34 @@
+-- Migration: Create merchants
+-- Purpose: User-owned merchants with a canonical name, aliases and a default category, so the
+-- different spellings banks use for the same business (e.g., "IFD*IFOOD", "IFOOD *RESTAURANTE X")
+-- roll up to one merchant in reports
+
+CREATE TABLE IF NOT EXISTS merchants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    key VARCHAR(100) NOT NULL,
+    aliases TEXT[] NOT NULL DEFAULT '{}',
+    default_category_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_merchants_user_id ON merchants(user_id);
+CREATE INDEX idx_merchants_deleted_at ON merchants(deleted_at);
+
+-- Imports running at the same time must not create the same merchant twice; merged merchants
+-- are soft-deleted and keep their key
+CREATE UNIQUE INDEX idx_merchants_user_id_key ON merchants(user_id, key) WHERE deleted_at IS NULL;
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS merchant_id UUID REFERENCES merchants(id) ON DELETE SET NULL;
+
+-- The top merchants dashboard groups the user's transactions by merchant
+CREATE INDEX IF NOT EXISTS idx_transactions_merchant_id ON transactions(merchant_id);
+
+COMMENT ON TABLE merchants IS 'Businesses the user transacts with, recognized from transaction descriptions';
+COMMENT ON COLUMN merchants.key IS 'Normalized merchant key of the descriptions the merchant was created from (e.g., IFOOD)';
+COMMENT ON COLUMN merchants.aliases IS 'Other keys (e.g., of merged merchants) or description fragments that belong to the merchant';
+COMMENT ON COLUMN merchants.default_category_id IS 'Category given to the merchant''s new uncategorized transactions';
+COMMENT ON COLUMN transactions.merchant_id IS 'Merchant the transaction was made with, NULL when not recognized';
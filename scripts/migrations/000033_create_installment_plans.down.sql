@@ -0,0 +1,10 @@
+-- Migration: Drop installment plans
+
+DROP INDEX IF EXISTS idx_transactions_installment_plan_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS installment_plan_id;
+
+DROP INDEX IF EXISTS idx_installment_plans_deleted_at;
+DROP INDEX IF EXISTS idx_installment_plans_account_id;
+DROP INDEX IF EXISTS idx_installment_plans_user_id;
+
+DROP TABLE IF EXISTS installment_plans;
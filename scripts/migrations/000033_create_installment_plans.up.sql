@@ -0,0 +1,32 @@
+-- Migration: Create installment plans
+-- Purpose: Group the installments of a purchase ("Parcela 1/3", "Parcela 2/3", ...) recorded in
+-- different credit card bills, so outstanding installments can be projected onto future bills
+
+CREATE TABLE IF NOT EXISTS installment_plans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    account_id UUID REFERENCES accounts(id) ON DELETE SET NULL,
+    description VARCHAR(255) NOT NULL,
+    total_installments INTEGER NOT NULL CHECK (total_installments > 1),
+    installment_amount DECIMAL(12,2) NOT NULL,
+    first_billing_cycle VARCHAR(7) NOT NULL,
+    merchant_id UUID REFERENCES merchants(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_installment_plans_user_id ON installment_plans(user_id);
+CREATE INDEX idx_installment_plans_account_id ON installment_plans(account_id);
+CREATE INDEX idx_installment_plans_deleted_at ON installment_plans(deleted_at);
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS installment_plan_id UUID REFERENCES installment_plans(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_installment_plan_id ON transactions(installment_plan_id);
+
+COMMENT ON TABLE installment_plans IS 'Purchases paid in installments across several credit card bills';
+COMMENT ON COLUMN installment_plans.account_id IS 'Credit card the purchase was made with, NULL when imported without a card';
+COMMENT ON COLUMN installment_plans.description IS 'Purchase description without the installment marker (e.g., "Loja X" for "Loja X - Parcela 1/3")';
+COMMENT ON COLUMN installment_plans.installment_amount IS 'Amount of each installment in the user''s base currency';
+COMMENT ON COLUMN installment_plans.first_billing_cycle IS 'Billing cycle (YYYY-MM) of the first installment';
+COMMENT ON COLUMN transactions.installment_plan_id IS 'Installment plan the transaction is an installment of, NULL for single payments';
@@ -0,0 +1,7 @@
+-- Migration: Drop billing cycle overrides
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS is_manual_card_entry;
+
+DROP INDEX IF EXISTS idx_billing_cycle_overrides_account_cycle;
+
+DROP TABLE IF EXISTS billing_cycle_overrides;
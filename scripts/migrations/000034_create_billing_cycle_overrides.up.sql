@@ -0,0 +1,23 @@
+-- Migration: Create billing cycle overrides
+-- Purpose: Move the closing or due date of a single credit card bill (e.g., when the regular day
+-- falls on a holiday), and mark card purchases entered in the app, which keep the app's sign
+
+CREATE TABLE IF NOT EXISTS billing_cycle_overrides (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    billing_cycle VARCHAR(7) NOT NULL,
+    closing_date DATE,
+    due_date DATE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_billing_cycle_overrides_account_cycle ON billing_cycle_overrides(account_id, billing_cycle);
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS is_manual_card_entry BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON TABLE billing_cycle_overrides IS 'Closing and due dates of credit card bills that differ from the card''s regular days';
+COMMENT ON COLUMN billing_cycle_overrides.billing_cycle IS 'Billing cycle (YYYY-MM) the override applies to';
+COMMENT ON COLUMN billing_cycle_overrides.closing_date IS 'Closing date of the bill, NULL keeps the card''s closing day';
+COMMENT ON COLUMN billing_cycle_overrides.due_date IS 'Due date of the bill, NULL keeps the card''s due day';
+COMMENT ON COLUMN transactions.is_manual_card_entry IS 'True for card transactions entered in the app, negative for purchases unlike statement imports';
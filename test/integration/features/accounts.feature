@@ -0,0 +1,187 @@
+# Finance Tracker - Accounts Management Feature
+
+@all @accounts
+Feature: Accounts Management
+  As a user
+  I want to track my bank accounts, credit cards and wallets
+  So that I can see balances per account and filter transactions by account
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+
+  @success @list
+  Scenario: List accounts when none exist
+    When I send a "GET" request to "/api/v1/accounts"
+    Then the response status should be 200
+    And the response should be JSON
+    And the response should contain "accounts"
+
+  @success @create
+  Scenario: Create checking account with opening balance
+    When I send a "POST" request to "/api/v1/accounts" with body:
+      """
+      {
+        "name": "Main checking",
+        "type": "checking",
+        "institution": "Nubank",
+        "opening_balance": 1500.50
+      }
+      """
+    Then the response status should be 201
+    And the response should be JSON
+    And the response field "type" should be "checking"
+    And the response field "currency" should be "BRL"
+    And the response field "current_balance" should be "1500.5"
+    And the db should contain 1 objects in the "accounts" table
+
+  @success @create
+  Scenario: Create credit card account with billing days
+    When I send a "POST" request to "/api/v1/accounts" with body:
+      """
+      {
+        "name": "Visa",
+        "type": "credit_card",
+        "closing_day": 3,
+        "due_day": 10
+      }
+      """
+    Then the response status should be 201
+    And the response field "closing_day" should be "3"
+    And the response field "due_day" should be "10"
+
+  @failure @create @validation
+  Scenario: Validation error for invalid account type
+    When I send a "POST" request to "/api/v1/accounts" with body:
+      """
+      {
+        "name": "Broker",
+        "type": "investment"
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "ACC-010004"
+
+  @failure @create @validation
+  Scenario: Validation error for billing days on a checking account
+    When I send a "POST" request to "/api/v1/accounts" with body:
+      """
+      {
+        "name": "Main checking",
+        "type": "checking",
+        "closing_day": 3
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "ACC-010006"
+
+  @failure @create @validation
+  Scenario: Validation error for invalid currency
+    When I send a "POST" request to "/api/v1/accounts" with body:
+      """
+      {
+        "name": "Travel wallet",
+        "type": "wallet",
+        "currency": "dollars"
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "ACC-010005"
+
+  @failure @get
+  Scenario: Get non-existent account
+    When I send a "GET" request to "/api/v1/accounts/00000000-0000-0000-0000-000000000001"
+    Then the response status should be 404
+    And the response field "code" should be "ACC-010001"
+
+  @failure @transactions
+  Scenario: Cannot create transaction with unknown account
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-20",
+        "description": "Groceries",
+        "amount": -150.00,
+        "type": "expense",
+        "account_id": "00000000-0000-0000-0000-000000000001"
+      }
+      """
+    Then the response status should be 404
+    And the response field "code" should be "TXN-010013"
+
+  @failure @transactions
+  Scenario: Cannot create a transaction in a currency other than its account's
+    Given an account exists with name "Checking" and type "checking"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-20",
+        "description": "Hotel",
+        "amount": -200.00,
+        "type": "expense",
+        "currency": "USD",
+        "exchange_rate": 5.0,
+        "account_id": "{{account_id:Checking}}"
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010035"
+    And the db should contain 0 objects in the "transactions" table
+
+  @failure @transactions
+  Scenario: Cannot change the currency of a transaction away from its account's
+    Given an account exists with name "Checking" and type "checking"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-20",
+        "description": "Groceries",
+        "amount": -150.00,
+        "type": "expense",
+        "account_id": "{{account_id:Checking}}"
+      }
+      """
+    Then the response status should be 201
+    And the response field "currency" should be "BRL"
+    When I send a "PATCH" request to "/api/v1/transactions/{{transaction_id}}" with body:
+      """
+      {
+        "currency": "USD",
+        "exchange_rate": 5.0
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010035"
+    When I send a "GET" request to "/api/v1/accounts/{{account_id:Checking}}"
+    Then the response status should be 200
+    And the response field "current_balance" should be "-150"
+
+  @failure @update
+  Scenario: Cannot change the currency of an account with transactions
+    Given an account exists with name "Checking" and type "checking"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-20",
+        "description": "Groceries",
+        "amount": -150.00,
+        "type": "expense",
+        "account_id": "{{account_id:Checking}}"
+      }
+      """
+    Then the response status should be 201
+    When I send a "PATCH" request to "/api/v1/accounts/{{account_id:Checking}}" with body:
+      """
+      {
+        "currency": "USD"
+      }
+      """
+    Then the response status should be 409
+    And the response field "code" should be "ACC-010011"
+
+  @failure @unauthorized
+  Scenario: Cannot access accounts without authentication
+    Given the header is empty
+    When I send a "GET" request to "/api/v1/accounts"
+    Then the response status should be 401
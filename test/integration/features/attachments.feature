@@ -0,0 +1,125 @@
+# Finance Tracker - Attachments Feature
+
+@all @attachments
+Feature: Attachments
+  As a user
+  I want to attach receipts and documents to transactions and accounts
+  So that I can keep the proof of my spending next to the records it belongs to
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Hotel",
+        "amount": -400.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+
+  @success @upload
+  Scenario: Upload an image receipt and list the transaction attachments
+    When I upload a 600x300 PNG image "receipt.png" to "/api/v1/transactions/{{transaction_id}}/attachments"
+    Then the response status should be 201
+    And the response should be JSON
+    And the response field "owner_type" should be "transaction"
+    And the response field "owner_id" should be "{{transaction_id}}"
+    And the response field "file_name" should be "receipt.png"
+    And the response field "content_type" should be "image/png"
+    And the response field "status" should be "uploaded"
+    And the response field "image_width" should be "600"
+    And the response field "image_height" should be "300"
+    And the response field "has_thumbnail" should be "true"
+    And the response field "checksum" should exist
+    And the db should contain 1 objects in the "attachments" table
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/attachments"
+    Then the response status should be 200
+    And the response field "attachments.0.id" should be "{{attachment_id}}"
+    And the response field "attachments.0.file_name" should be "receipt.png"
+    When I send a "GET" request to "/api/v1/attachments/{{attachment_id}}/thumbnail"
+    Then the response status should be 200
+    And the response header "Content-Type" should be "image/jpeg"
+
+  @success @download
+  Scenario: Download an uploaded document
+    When I upload a file "invoice.pdf" with content type "application/pdf" and content "%PDF-1.4 invoice" to "/api/v1/transactions/{{transaction_id}}/attachments"
+    Then the response status should be 201
+    And the response field "has_thumbnail" should be "false"
+    When I send a "GET" request to "/api/v1/attachments/{{attachment_id}}"
+    Then the response status should be 200
+    And the response field "file_name" should be "invoice.pdf"
+    And the response field "size_bytes" should be "16"
+    When I send a "GET" request to "/api/v1/attachments/{{attachment_id}}/content"
+    Then the response status should be 200
+    And the response header "Content-Type" should be "application/pdf"
+    And the response header "Content-Disposition" should be "inline; filename=invoice.pdf"
+    And the response body should be "%PDF-1.4 invoice"
+
+  @success @delete
+  Scenario: Delete an attachment
+    When I upload a file "invoice.pdf" with content type "application/pdf" and content "%PDF-1.4 invoice" to "/api/v1/transactions/{{transaction_id}}/attachments"
+    Then the response status should be 201
+    When I send a "DELETE" request to "/api/v1/attachments/{{attachment_id}}"
+    Then the response status should be 204
+    And the db should contain 0 objects in the "attachments" table
+    When I send a "GET" request to "/api/v1/attachments/{{attachment_id}}"
+    Then the response status should be 404
+    And the response field "code" should be "ATT-010001"
+
+  @success @cleanup
+  Scenario: Attachments are removed after their transaction is purged from the trash
+    When I upload a file "invoice.pdf" with content type "application/pdf" and content "%PDF-1.4 invoice" to "/api/v1/transactions/{{transaction_id}}/attachments"
+    Then the response status should be 201
+    When I send a "DELETE" request to "/api/v1/transactions/{{transaction_id}}"
+    Then the response status should be 204
+    And the db should contain 1 objects in the "attachments" table
+    When the attachment cleanup job runs
+    Then the db should contain 1 objects in the "attachments" table
+    When the trash retention job runs with a retention of 0 days
+    And the attachment cleanup job runs
+    Then the db should contain 0 objects in the "attachments" table
+
+  @success @account
+  Scenario: Attach a statement to an account
+    Given an account exists with name "Checking" and type "checking"
+    When I upload a file "statement.pdf" with content type "application/pdf" and content "%PDF-1.4 statement" to "/api/v1/accounts/{{account_id:Checking}}/attachments"
+    Then the response status should be 201
+    And the response field "owner_type" should be "account"
+    And the response field "owner_id" should be "{{account_id:Checking}}"
+    When I send a "GET" request to "/api/v1/accounts/{{account_id:Checking}}/attachments"
+    Then the response status should be 200
+    And the response field "attachments.0.file_name" should be "statement.pdf"
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/attachments"
+    Then the response status should be 200
+    And the response field "attachments" should be "[]"
+
+  @failure @upload
+  Scenario: Cannot attach an unsupported file type
+    When I upload a file "notes.txt" with content type "text/plain" and content "some notes" to "/api/v1/transactions/{{transaction_id}}/attachments"
+    Then the response status should be 415
+    And the response field "code" should be "ATT-010005"
+    And the db should contain 0 objects in the "attachments" table
+
+  @failure @upload
+  Scenario: Cannot attach a file to a transaction that does not exist
+    When I upload a file "invoice.pdf" with content type "application/pdf" and content "%PDF-1.4 invoice" to "/api/v1/transactions/00000000-0000-0000-0000-000000000001/attachments"
+    Then the response status should be 404
+    And the response field "code" should be "ATT-010003"
+
+  @failure @upload-url
+  Scenario: Direct upload URLs are not available with local storage
+    When I send a "POST" request to "/api/v1/transactions/{{transaction_id}}/attachments/upload-url" with body:
+      """
+      {
+        "file_name": "receipt.jpg",
+        "content_type": "image/jpeg",
+        "size_bytes": 2048
+      }
+      """
+    Then the response status should be 501
+    And the response field "code" should be "ATT-020001"
+    And the db should contain 0 objects in the "attachments" table
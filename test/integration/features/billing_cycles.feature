@@ -0,0 +1,184 @@
+# Finance Tracker - Credit Card Billing Cycles Feature
+
+@all @billing-cycles
+Feature: Credit Card Billing Cycles
+  As a user
+  I want my card's closing and due days to decide which bill each purchase is charged in
+  So that I can follow the open bill before its statement is imported
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And an account exists with name "Card" and type "credit_card"
+    And an account exists with name "Checking" and type "checking"
+    When I send a "PATCH" request to "/api/v1/accounts/{{account_id:Card}}" with body:
+      """
+      {
+        "closing_day": 25,
+        "due_day": 5
+      }
+      """
+    Then the response status should be 200
+
+  @success @import
+  Scenario: Imported purchases are charged in the bill of their purchase date
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "account_id": "{{account_id:Card}}",
+        "transactions": [
+          {"date": "2024-11-05", "description": "Mercado", "amount": 100.00},
+          {"date": "2024-11-25", "description": "Farmacia", "amount": 40.00},
+          {"date": "2024-10-10", "description": "Loja X - Parcela 2/3", "amount": 300.00, "installment_current": 2, "installment_total": 3}
+        ]
+      }
+      """
+    Then the response status should be 201
+    And the response field "billing_cycle" should be "2024-11"
+    When I send a "GET" request to "/api/v1/accounts/{{account_id:Card}}/open-bill?date=2024-11-10"
+    Then the response status should be 200
+    And the response field "billing_cycle" should be "2024-11"
+    And the response field "start_date" should be "2024-10-25"
+    And the response field "closing_date" should be "2024-11-25"
+    And the response field "due_date" should be "2024-12-05"
+    And the response field "total" should be "400"
+    And the response field "transaction_count" should be "2"
+    And the response field "transactions.0.description" should be "Loja X - Parcela 2/3"
+    When I send a "GET" request to "/api/v1/accounts/{{account_id:Card}}/open-bill?date=2024-11-25"
+    Then the response status should be 200
+    And the response field "billing_cycle" should be "2024-12"
+    And the response field "total" should be "40"
+
+  @success @manual
+  Scenario: Manual card purchases add up in the open bill
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-26",
+        "description": "Restaurante",
+        "amount": -80.00,
+        "type": "expense",
+        "account_id": "{{account_id:Card}}"
+      }
+      """
+    Then the response status should be 201
+    And the response field "billing_cycle" should be "2024-12"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-12-02",
+        "description": "Estorno Restaurante",
+        "amount": 30.00,
+        "type": "income",
+        "account_id": "{{account_id:Card}}"
+      }
+      """
+    Then the response status should be 201
+    And the response field "billing_cycle" should be "2024-12"
+    When I send a "GET" request to "/api/v1/accounts/{{account_id:Card}}/open-bill?date=2024-12-10"
+    Then the response status should be 200
+    And the response field "billing_cycle" should be "2024-12"
+    And the response field "total" should be "50"
+    And the response field "currency" should be "BRL"
+    And the response field "transactions.0.amount" should be "80"
+    And the response field "transactions.1.amount" should be "-30"
+
+  @success @manual
+  Scenario: Transactions on other accounts are not assigned a billing cycle
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-26",
+        "description": "Padaria",
+        "amount": -15.00,
+        "type": "expense",
+        "account_id": "{{account_id:Checking}}"
+      }
+      """
+    Then the response status should be 201
+    And the response field "billing_cycle" should not exist
+
+  @success @overrides
+  Scenario: An override postpones the closing date of a single bill
+    When I send a "PUT" request to "/api/v1/accounts/{{account_id:Card}}/billing-cycles/2024-12" with body:
+      """
+      {
+        "closing_date": "2024-12-27",
+        "due_date": "2025-01-06"
+      }
+      """
+    Then the response status should be 200
+    And the response field "billing_cycle" should be "2024-12"
+    And the response field "start_date" should be "2024-11-25"
+    And the response field "closing_date" should be "2024-12-27"
+    And the response field "due_date" should be "2025-01-06"
+    And the response field "is_overridden" should be "true"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-12-26",
+        "description": "Presente",
+        "amount": -120.00,
+        "type": "expense",
+        "account_id": "{{account_id:Card}}"
+      }
+      """
+    Then the response status should be 201
+    And the response field "billing_cycle" should be "2024-12"
+    When I send a "GET" request to "/api/v1/accounts/{{account_id:Card}}/billing-cycles"
+    Then the response status should be 200
+    And the response field "cycles.5.billing_cycle" should exist
+    And the response field "cycles.6" should not exist
+    And the response field "overrides.0.billing_cycle" should be "2024-12"
+    And the response field "overrides.0.closing_date" should be "2024-12-27"
+    When I send a "DELETE" request to "/api/v1/accounts/{{account_id:Card}}/billing-cycles/2024-12"
+    Then the response status should be 204
+    When I send a "DELETE" request to "/api/v1/accounts/{{account_id:Card}}/billing-cycles/2024-12"
+    Then the response status should be 404
+    And the response field "code" should be "ACC-010010"
+
+  @failure @overrides
+  Scenario: Cannot move a closing date more than a week
+    When I send a "PUT" request to "/api/v1/accounts/{{account_id:Card}}/billing-cycles/2024-12" with body:
+      """
+      {
+        "closing_date": "2024-12-10"
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "ACC-010009"
+
+  @failure @open-bill
+  Scenario: Cannot get the open bill of an account without a closing day
+    When I send a "GET" request to "/api/v1/accounts/{{account_id:Checking}}/open-bill"
+    Then the response status should be 422
+    And the response field "code" should be "ACC-010008"
+
+  @failure @import
+  Scenario: Cannot import without a billing cycle into a card without closing day
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "transactions": [
+          {"date": "2024-11-05", "description": "Mercado", "amount": 100.00}
+        ]
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TXN-020001"
+
+  @failure @import
+  Scenario: Cannot import card transactions into an account that is not a credit card
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "account_id": "{{account_id:Checking}}",
+        "transactions": [
+          {"date": "2024-11-05", "description": "Mercado", "amount": 100.00}
+        ]
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TXN-020008"
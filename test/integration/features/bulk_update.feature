@@ -0,0 +1,245 @@
+# Finance Tracker - Bulk Update Feature
+
+@all @bulk-update
+Feature: Bulk Transaction Update
+  As a user cleaning up many transactions at once
+  I want to change dates, notes, types, flags and categories of a selection of transactions
+  So that I do not have to edit them one by one
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And a category exists with name "Travel" and type "expense"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-05",
+        "description": "Hotel booking",
+        "amount": -240.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-06",
+        "description": "Train ticket",
+        "amount": -35.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+
+  @success @dry-run
+  Scenario: A dry run reports the affected transactions without changing them
+    When I send a "POST" request to "/api/v1/transactions/bulk-update" with body:
+      """
+      {
+        "ids": {{transaction_ids}},
+        "changes": {
+          "notes": "Lisbon trip",
+          "category_id": "{{category_id:Travel}}"
+        },
+        "dry_run": true
+      }
+      """
+    Then the response status should be 200
+    And the response field "dry_run" should be "true"
+    And the response field "matched_count" should be "2"
+    And the response field "updated_count" should be "2"
+    And the response field "sample.0.notes" should be "Lisbon trip"
+    And the response field "sample.0.category.name" should be "Travel"
+    And the response field "operation_id" should not exist
+    When I send a "GET" request to "/api/v1/transactions?uncategorized=true"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "2"
+
+  @success
+  Scenario: Update transactions selected by ID
+    When I send a "POST" request to "/api/v1/transactions/bulk-update" with body:
+      """
+      {
+        "ids": {{transaction_ids}},
+        "changes": {
+          "notes": "Lisbon trip",
+          "is_recurring": true,
+          "category_id": "{{category_id:Travel}}"
+        }
+      }
+      """
+    Then the response status should be 200
+    And the response field "dry_run" should be "false"
+    And the response field "updated_count" should be "2"
+    And the response field "operation_id" should exist
+    When I send a "GET" request to "/api/v1/transactions"
+    Then the response status should be 200
+    And the response field "transactions.0.notes" should be "Lisbon trip"
+    And the response field "transactions.0.is_recurring" should be "true"
+    And the response field "transactions.1.category.name" should be "Travel"
+
+  @success
+  Scenario: Update transactions selected by a filter
+    When I send a "POST" request to "/api/v1/transactions/bulk-update" with body:
+      """
+      {
+        "filter": {
+          "search": "hotel"
+        },
+        "changes": {
+          "type": "income"
+        }
+      }
+      """
+    Then the response status should be 200
+    And the response field "matched_count" should be "1"
+    And the response field "sample.0.description" should be "Hotel booking"
+    And the response field "sample.0.type" should be "income"
+    When I send a "GET" request to "/api/v1/transactions?type=income"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "1"
+
+  @success
+  Scenario: Shift the dates of transactions
+    When I send a "POST" request to "/api/v1/transactions/bulk-update" with body:
+      """
+      {
+        "filter": {
+          "start_date": "2024-11-06",
+          "end_date": "2024-11-06"
+        },
+        "changes": {
+          "date_shift_days": -10
+        }
+      }
+      """
+    Then the response status should be 200
+    And the response field "updated_count" should be "1"
+    And the response field "sample.0.date" should be "2024-10-27"
+
+  @success
+  Scenario: Append notes to transactions
+    When I send a "POST" request to "/api/v1/transactions/bulk-update" with body:
+      """
+      {
+        "ids": {{transaction_ids}},
+        "changes": {
+          "notes": "Reimbursable",
+          "notes_mode": "append"
+        }
+      }
+      """
+    Then the response status should be 200
+    And the response field "sample.0.notes" should be "Reimbursable"
+
+  @success
+  Scenario: Transfer legs are skipped
+    Given an account exists with name "Checking" and type "checking"
+    And an account exists with name "Savings" and type "savings"
+    When I send a "POST" request to "/api/v1/transfers" with body:
+      """
+      {
+        "from_account_id": "{{account_id:Checking}}",
+        "to_account_id": "{{account_id:Savings}}",
+        "date": "2024-11-07",
+        "description": "Savings",
+        "amount": 100.00
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/bulk-update" with body:
+      """
+      {
+        "filter": {
+          "start_date": "2024-11-01"
+        },
+        "changes": {
+          "is_hidden": true
+        }
+      }
+      """
+    Then the response status should be 200
+    And the response field "matched_count" should be "4"
+    And the response field "updated_count" should be "2"
+    And the response field "skipped_count" should be "2"
+
+  @success @revert
+  Scenario: A bulk update can be reverted as one operation
+    When I send a "POST" request to "/api/v1/transactions/bulk-update" with body:
+      """
+      {
+        "ids": {{transaction_ids}},
+        "changes": {
+          "category_id": "{{category_id:Travel}}"
+        }
+      }
+      """
+    Then the response status should be 200
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    And the response field "changes.0.source" should be "bulk"
+    When I send a "POST" request to "/api/v1/transactions/operations/{{operation_id}}/revert"
+    Then the response status should be 200
+    And the response field "reverted_count" should be "2"
+    When I send a "GET" request to "/api/v1/transactions?uncategorized=true"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "2"
+
+  @error
+  Scenario: Cannot bulk update without changes
+    When I send a "POST" request to "/api/v1/transactions/bulk-update" with body:
+      """
+      {
+        "ids": {{transaction_ids}},
+        "changes": {}
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010031"
+
+  @error
+  Scenario: Cannot select transactions by ID and filter at once
+    When I send a "POST" request to "/api/v1/transactions/bulk-update" with body:
+      """
+      {
+        "ids": {{transaction_ids}},
+        "filter": {
+          "search": "hotel"
+        },
+        "changes": {
+          "is_recurring": true
+        }
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010030"
+
+  @error
+  Scenario: Cannot bulk update with an unknown notes mode
+    When I send a "POST" request to "/api/v1/transactions/bulk-update" with body:
+      """
+      {
+        "ids": {{transaction_ids}},
+        "changes": {
+          "notes": "Lisbon trip",
+          "notes_mode": "prepend"
+        }
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010032"
+
+  @error
+  Scenario: Cannot bulk update transactions that do not exist
+    When I send a "POST" request to "/api/v1/transactions/bulk-update" with body:
+      """
+      {
+        "ids": ["00000000-0000-0000-0000-000000000001"],
+        "changes": {
+          "is_recurring": true
+        }
+      }
+      """
+    Then the response status should be 404
+    And the response field "code" should be "TXN-010004"
@@ -0,0 +1,200 @@
+# Finance Tracker - Credit Card Bill Forecast Feature
+
+@all @cc-forecast
+Feature: Credit Card Bill Forecast
+  As a user
+  I want to know what my next credit card bills will be before their statements close
+  So that I can plan for them
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+
+  @success
+  Scenario: Forecast combines recorded purchases, installments, subscriptions and past spending
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "{{billing_cycle:-3}}",
+        "transactions": [
+          {"date": "2024-01-05", "description": "Netflix", "amount": 39.90},
+          {"date": "2024-01-08", "description": "Mercado", "amount": 200.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "{{billing_cycle:-2}}",
+        "transactions": [
+          {"date": "2024-02-05", "description": "Netflix", "amount": 39.90},
+          {"date": "2024-02-08", "description": "Mercado", "amount": 300.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "{{billing_cycle:-1}}",
+        "transactions": [
+          {"date": "2024-03-05", "description": "Netflix", "amount": 39.90},
+          {"date": "2024-03-08", "description": "Mercado", "amount": 400.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "{{billing_cycle:0}}",
+        "transactions": [
+          {"date": "2024-04-02", "description": "Loja X - Parcela 1/3", "amount": 150.00, "installment_current": 1, "installment_total": 3},
+          {"date": "2024-04-08", "description": "Mercado", "amount": 100.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/transactions/credit-card/forecast"
+    Then the response status should be 200
+    And the response field "currency" should be "BRL"
+    And the response field "open_billing_cycle" should be "{{billing_cycle:0}}"
+    And the response field "history_cycles" should be "3"
+    And the response field "average_variable" should be "300"
+    And the response field "variable_deviation" should be "81.65"
+    And the response field "subscriptions.0.description" should be "Netflix"
+    And the response field "subscriptions.0.amount" should be "39.9"
+    And the response field "subscriptions.0.occurrences" should be "3"
+    And the response field "subscriptions.1" should not exist
+    And the response field "bills.0.billing_cycle" should be "{{billing_cycle:0}}"
+    And the response field "bills.0.recorded" should be "250"
+    And the response field "bills.0.installments" should be "0"
+    And the response field "bills.0.subscriptions" should be "39.9"
+    And the response field "bills.0.variable" should be "200"
+    And the response field "bills.0.committed" should be "250"
+    And the response field "bills.0.expected" should be "489.9"
+    And the response field "bills.0.low" should be "408.25"
+    And the response field "bills.0.high" should be "571.55"
+    And the response field "bills.1.billing_cycle" should be "{{billing_cycle:1}}"
+    And the response field "bills.1.recorded" should be "0"
+    And the response field "bills.1.installments" should be "150"
+    And the response field "bills.1.variable" should be "300"
+    And the response field "bills.1.expected" should be "489.9"
+    And the response field "bills.2.installments" should be "150"
+    And the response field "bills.3" should not exist
+
+  @success
+  Scenario: A subscription already charged in the open bill is not expected again
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "{{billing_cycle:-3}}",
+        "transactions": [{"date": "2024-01-05", "description": "Academia", "amount": 99.00}]
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "{{billing_cycle:-2}}",
+        "transactions": [{"date": "2024-02-05", "description": "Academia", "amount": 99.00}]
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "{{billing_cycle:-1}}",
+        "transactions": [{"date": "2024-03-05", "description": "Academia", "amount": 99.00}]
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "{{billing_cycle:0}}",
+        "transactions": [{"date": "2024-04-05", "description": "ACADEMIA", "amount": 99.00}]
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/transactions/credit-card/forecast?months=2"
+    Then the response status should be 200
+    And the response field "variable_deviation" should be "0"
+    And the response field "bills.0.recorded" should be "99"
+    And the response field "bills.0.subscriptions" should be "0"
+    And the response field "bills.0.expected" should be "99"
+    And the response field "bills.1.subscriptions" should be "99"
+    And the response field "bills.1.low" should be "99"
+    And the response field "bills.2" should not exist
+
+  @success
+  Scenario: The card's closing day decides the open bill
+    Given an account exists with name "Card" and type "credit_card"
+    When I send a "PATCH" request to "/api/v1/accounts/{{account_id:Card}}" with body:
+      """
+      {
+        "closing_day": 25,
+        "due_day": 5
+      }
+      """
+    Then the response status should be 200
+    When I send a "GET" request to "/api/v1/transactions/credit-card/forecast?account_id={{account_id:Card}}&months=1"
+    Then the response status should be 200
+    And the response field "history_cycles" should be "0"
+    And the response field "subscriptions" should be "[]"
+    And the response field "bills.0.closing_date" should exist
+    And the response field "bills.0.due_date" should exist
+    And the response field "bills.0.expected" should be "0"
+    And the response field "bills.1" should not exist
+
+  @success
+  Scenario: A card's forecast leaves out the bills and installments of other cards
+    Given an account exists with name "Visa" and type "credit_card"
+    And an account exists with name "Master" and type "credit_card"
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "{{billing_cycle:0}}",
+        "account_id": "{{account_id:Visa}}",
+        "transactions": [
+          {"date": "2024-04-02", "description": "Loja X - Parcela 1/3", "amount": 150.00, "installment_current": 1, "installment_total": 3},
+          {"date": "2024-04-08", "description": "Mercado", "amount": 100.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "{{billing_cycle:0}}",
+        "account_id": "{{account_id:Master}}",
+        "transactions": [
+          {"date": "2024-04-10", "description": "Livraria", "amount": 60.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/transactions/credit-card/forecast?account_id={{account_id:Master}}&months=2"
+    Then the response status should be 200
+    And the response field "bills.0.recorded" should be "60"
+    And the response field "bills.0.installments" should be "0"
+    And the response field "bills.1.installments" should be "0"
+    When I send a "GET" request to "/api/v1/transactions/credit-card/forecast?account_id={{account_id:Visa}}&months=2"
+    Then the response status should be 200
+    And the response field "bills.0.recorded" should be "250"
+    And the response field "bills.1.installments" should be "150"
+
+  @failure
+  Scenario: Cannot forecast more bills than allowed
+    When I send a "GET" request to "/api/v1/transactions/credit-card/forecast?months=13"
+    Then the response status should be 400
+    And the response field "code" should be "TXN-020009"
+
+  @failure
+  Scenario: Cannot forecast the bills of an account that is not a credit card
+    Given an account exists with name "Checking" and type "checking"
+    When I send a "GET" request to "/api/v1/transactions/credit-card/forecast?account_id={{account_id:Checking}}"
+    Then the response status should be 400
+    And the response field "code" should be "TXN-020008"
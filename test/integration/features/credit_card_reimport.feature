@@ -0,0 +1,205 @@
+# Finance Tracker - Credit Card Bill Re-import Feature
+
+@all @cc-reimport
+Feature: Credit Card Bill Re-import
+  As a user
+  I want to re-import a corrected credit card statement for a billing cycle I already imported
+  So that only the lines that changed are updated and my categories and notes are kept
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And a category exists with name "Groceries" and type "expense"
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "transactions": [
+          {"date": "2024-11-05", "description": "Mercado Extra", "amount": 150.00},
+          {"date": "2024-11-08", "description": "Posto Shell", "amount": 200.00},
+          {"date": "2024-11-10", "description": "Netflix", "amount": 39.90},
+          {"date": "2024-11-12", "description": "Farmacia", "amount": 25.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "PATCH" request to "/api/v1/transactions/{{transaction_id}}" with body:
+      """
+      {
+        "category_id": "{{category_id}}",
+        "notes": "weekly groceries"
+      }
+      """
+    Then the response status should be 200
+
+  @success
+  Scenario: Re-import applies only the differences and keeps categories and notes
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "reimport": true,
+        "transactions": [
+          {"date": "2024-11-05", "description": "Mercado Extra", "amount": 150.00},
+          {"date": "2024-11-08", "description": "Posto Shell", "amount": 210.00},
+          {"date": "2024-11-11", "description": "NETFLIX.COM", "amount": 39.90},
+          {"date": "2024-11-15", "description": "Livraria Cultura", "amount": 80.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    And the response field "imported_count" should be "1"
+    And the response field "updated_count" should be "2"
+    And the response field "removed_count" should be "1"
+    And the response field "unchanged_count" should be "1"
+    And the response field "transactions.0.description" should be "Livraria Cultura"
+    When I send a "GET" request to "/api/v1/transactions?search=mercado"
+    Then the response status should be 200
+    And the response field "transactions.0.description" should be "Mercado Extra"
+    And the response field "transactions.0.category.name" should be "Groceries"
+    And the response field "transactions.0.notes" should be "weekly groceries"
+    When I send a "GET" request to "/api/v1/transactions?search=farmacia"
+    Then the response status should be 200
+    And the response field "transactions.0" should not exist
+    When I send a "GET" request to "/api/v1/transactions?search=netflix"
+    Then the response status should be 200
+    And the response field "transactions.0.description" should be "NETFLIX.COM"
+    And the response field "transactions.0.date" should be "2024-11-11"
+    And the response field "transactions.1" should not exist
+
+  @success
+  Scenario: Re-importing the same statement changes nothing
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "reimport": true,
+        "transactions": [
+          {"date": "2024-11-05", "description": "Mercado Extra", "amount": 150.00},
+          {"date": "2024-11-08", "description": "Posto Shell", "amount": 200.00},
+          {"date": "2024-11-10", "description": "Netflix", "amount": 39.90},
+          {"date": "2024-11-12", "description": "Farmacia", "amount": 25.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    And the response field "imported_count" should be "0"
+    And the response field "updated_count" should be "0"
+    And the response field "removed_count" should be "0"
+    And the response field "unchanged_count" should be "4"
+
+  @success
+  Scenario: A hidden line is not imported again
+    When I send a "POST" request to "/api/v1/transactions/bulk-update" with body:
+      """
+      {
+        "ids": ["{{transaction_id}}"],
+        "changes": {
+          "is_hidden": true
+        }
+      }
+      """
+    Then the response status should be 200
+    And the response field "updated_count" should be "1"
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "reimport": true,
+        "transactions": [
+          {"date": "2024-11-05", "description": "Mercado Extra", "amount": 150.00},
+          {"date": "2024-11-08", "description": "Posto Shell", "amount": 200.00},
+          {"date": "2024-11-10", "description": "Netflix", "amount": 39.90},
+          {"date": "2024-11-12", "description": "Farmacia", "amount": 25.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    And the response field "imported_count" should be "0"
+    And the response field "unchanged_count" should be "4"
+    And the db should contain 1 objects in "transactions" with the values
+      """
+      {"description": "Mercado Extra"}
+      """
+
+  @success
+  Scenario: Re-import rescales split lines, updates the bill amount and records the history
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-01-10",
+        "description": "Pagamento de fatura",
+        "amount": -300.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-12",
+        "bill_payment_id": "{{transaction_id}}",
+        "transactions": [
+          {"date": "2024-12-05", "description": "Supermercado Dia", "amount": 200.00},
+          {"date": "2024-12-09", "description": "Padaria Real", "amount": 100.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "PUT" request to "/api/v1/transactions/{{transaction_id}}/splits" with body:
+      """
+      {
+        "splits": [
+          {"amount": 150.00, "category_id": "{{category_id}}"},
+          {"amount": 50.00}
+        ]
+      }
+      """
+    Then the response status should be 200
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-12",
+        "reimport": true,
+        "transactions": [
+          {"date": "2024-12-05", "description": "Supermercado Dia", "amount": 220.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    And the response field "updated_count" should be "1"
+    And the response field "removed_count" should be "1"
+    When I send a "GET" request to "/api/v1/transactions?search=supermercado"
+    Then the response status should be 200
+    And the response field "transactions.0.amount" should be "220"
+    And the response field "transactions.0.splits.0.amount" should be "165"
+    And the response field "transactions.0.splits.1.amount" should be "55"
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    And the response field "changes.0.action" should be "update"
+    And the response field "changes.0.source" should be "import"
+    And the response field "changes.0.fields.0.field" should be "amount"
+    And the response field "changes.0.fields.0.before" should be "200"
+    And the response field "changes.0.fields.0.after" should be "220"
+    And the db should contain 1 objects in "transaction_changes" with the values
+      """
+      {"action": "delete", "source": "import"}
+      """
+    When I send a "GET" request to "/api/v1/transactions/credit-card/status?billing_cycle=2024-12"
+    Then the response status should be 200
+    And the response field "original_amount" should be "220"
+
+  @failure
+  Scenario: Cannot re-import without a billing cycle
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "reimport": true,
+        "transactions": [
+          {"date": "2024-11-05", "description": "Mercado Extra", "amount": 150.00}
+        ]
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TXN-020001"
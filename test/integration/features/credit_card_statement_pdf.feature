@@ -0,0 +1,88 @@
+# Finance Tracker - Credit Card Statement PDF Feature
+
+@all @cc-statement-pdf
+Feature: Credit Card Statement PDF
+  As a user
+  I want to upload the PDF statement (fatura) downloaded from my card issuer
+  So that I do not have to type its transactions before importing them
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+
+  @success
+  Scenario: Read a Nubank statement with payments, installments, international purchases and IOF
+    When I upload a PDF statement "nubank.pdf" to "/api/v1/transactions/credit-card/statement/pdf" with text:
+      """
+      Nu Pagamentos S.A. - Instituição de Pagamento
+      Data de vencimento: 20 NOV 2024
+      Total a pagar R$ 324,54
+      TRANSAÇÕES DE 13 OUT A 13 NOV VALORES EM R$
+      14 OUT Pagamento recebido -R$ 980,00
+      15 OUT Mercado Extra R$ 150,00
+      18 OUT Magazine Luiza - Parcela 2/10 R$ 89,90
+      22 OUT Spotify R$ 55,14
+      USD 9,99
+      22 OUT IOF de "Spotify" R$ 2,40
+      25 OUT Estorno - Mercado Extra -R$ 30,00
+      30 FEV Padaria Real R$ 12,00
+      02 NOV Posto Ipiranga R$ 57,10
+      """
+    Then the response status should be 200
+    And the response field "issuer" should be "nubank"
+    And the response field "billing_cycle" should be "2024-11"
+    And the response field "due_date" should be "2024-11-20"
+    And the response field "statement_total" should be "324.54"
+    And the response field "lines_total" should be "324.54"
+    And the response field "transactions.0.description" should be "Pagamento recebido"
+    And the response field "transactions.0.amount" should be "-980"
+    And the response field "transactions.0.date" should be "2024-10-14"
+    And the response field "transactions.2.installment_current" should be "2"
+    And the response field "transactions.2.installment_total" should be "10"
+    And the response field "transactions.3.original_amount" should be "9.99"
+    And the response field "transactions.3.original_currency" should be "USD"
+    And the response field "transactions.4.is_iof" should be "true"
+    And the response field "transactions.5.amount" should be "-30"
+    And the response field "transactions.6.description" should be "Posto Ipiranga"
+    And the response field "transactions.7" should not exist
+    And the response field "errors.0.row" should be "12"
+
+  @success
+  Scenario: Read an Itaú statement without the installments of the next bills
+    When I upload a PDF statement "itau.pdf" to "/api/v1/transactions/credit-card/statement/pdf" with text:
+      """
+      Itaucard Mastercard Platinum
+      Vencimento: 15/01/2025
+      Total desta fatura R$ 239,90
+      08/12 PAGAMENTO EFETUADO -1.200,00
+      20/12 MERCADO EXTRA 150,00
+      12/10 MAGAZINE LUIZA 04/10 89,90
+      Compras parceladas - próximas faturas
+      12/10 MAGAZINE LUIZA 05/10 89,90
+      """
+    Then the response status should be 200
+    And the response field "issuer" should be "itau"
+    And the response field "billing_cycle" should be "2025-01"
+    And the response field "transactions.0.description" should be "Pagamento recebido"
+    And the response field "transactions.1.date" should be "2024-12-20"
+    And the response field "transactions.2.date" should be "2024-10-12"
+    And the response field "transactions.2.installment_current" should be "4"
+    And the response field "transactions.3" should not exist
+    And the response field "lines_total" should be "239.9"
+
+  @failure
+  Scenario: Cannot read a statement from an unsupported issuer
+    When I upload a PDF statement "extrato.pdf" to "/api/v1/transactions/credit-card/statement/pdf" with text:
+      """
+      Extrato da conta corrente
+      05/11 PIX RECEBIDO 100,00
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TXN-040001"
+
+  @failure
+  Scenario: Cannot read a file that is not a PDF
+    When I upload a file "fatura.pdf" with content type "application/pdf" and content "Data,Valor" to "/api/v1/transactions/credit-card/statement/pdf"
+    Then the response status should be 400
+    And the response field "code" should be "TXN-040001"
@@ -0,0 +1,212 @@
+# Finance Tracker - CSV Statement Import Feature
+
+@all @csv-import
+Feature: CSV Statement Import
+  As a user
+  I want to import the CSV statements exported by my bank using a saved column layout
+  So that I do not have to describe the file every time I import it
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And a category exists with name "Groceries" and type "expense"
+    When I send a "POST" request to "/api/v1/category-rules" with body:
+      """
+      {
+        "pattern": "MERCADO",
+        "category_id": "{{category_id:Groceries}}"
+      }
+      """
+    Then the response status should be 201
+
+  # ============================================
+  # IMPORT PROFILES
+  # ============================================
+
+  @success @import-profile
+  Scenario: Create, list, update and delete an import profile
+    When I send a "POST" request to "/api/v1/import-profiles" with body:
+      """
+      {
+        "name": "Banco Exemplo",
+        "delimiter": ";",
+        "date_column": "Data",
+        "description_column": "Historico",
+        "amount_column": "Valor",
+        "date_format": "DD/MM/YYYY",
+        "number_format": "BR"
+      }
+      """
+    Then the response status should be 201
+    And the response field "name" should be "Banco Exemplo"
+    And the response field "delimiter" should be ";"
+    And the response field "has_header" should be "true"
+    And the response field "date_format" should be "DD/MM/YYYY"
+    When I send a "GET" request to "/api/v1/import-profiles"
+    Then the response status should be 200
+    And the response field "profiles.0.name" should be "Banco Exemplo"
+    When I send a "PATCH" request to "/api/v1/import-profiles/{{import_profile_id}}" with body:
+      """
+      {
+        "name": "Banco Exemplo PJ",
+        "invert_amounts": true
+      }
+      """
+    Then the response status should be 200
+    And the response field "name" should be "Banco Exemplo PJ"
+    And the response field "invert_amounts" should be "true"
+    And the response field "amount_column" should be "Valor"
+    When I send a "DELETE" request to "/api/v1/import-profiles/{{import_profile_id}}"
+    Then the response status should be 204
+    When I send a "GET" request to "/api/v1/import-profiles"
+    Then the response status should be 200
+    And the response field "profiles.0" should not exist
+
+  @failure @import-profile
+  Scenario: Cannot create two import profiles with the same name
+    When I send a "POST" request to "/api/v1/import-profiles" with body:
+      """
+      {
+        "name": "Banco Exemplo",
+        "date_column": "Data",
+        "description_column": "Historico",
+        "amount_column": "Valor"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/import-profiles" with body:
+      """
+      {
+        "name": "Banco Exemplo",
+        "date_column": "Date",
+        "description_column": "Memo",
+        "amount_column": "Amount"
+      }
+      """
+    Then the response status should be 409
+    And the response field "code" should be "IMP-010002"
+
+  @failure @import-profile
+  Scenario: Cannot create an import profile without an amount column
+    When I send a "POST" request to "/api/v1/import-profiles" with body:
+      """
+      {
+        "name": "Banco Exemplo",
+        "date_column": "Data",
+        "description_column": "Historico"
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "IMP-010004"
+
+  @failure @import-profile
+  Scenario: Cannot update an import profile that does not exist
+    When I send a "PATCH" request to "/api/v1/import-profiles/00000000-0000-0000-0000-000000000001" with body:
+      """
+      {
+        "name": "Banco Exemplo"
+      }
+      """
+    Then the response status should be 404
+    And the response field "code" should be "IMP-010001"
+
+  # ============================================
+  # CSV PREVIEW
+  # ============================================
+
+  @success @csv-preview
+  Scenario: Preview a CSV statement with an ad-hoc column mapping
+    Given the next upload sends the form field "mapping" with value:
+      """
+      {
+        "delimiter": ";",
+        "date_column": "Data",
+        "description_column": "Historico",
+        "amount_column": "Valor",
+        "date_format": "DD/MM/YYYY",
+        "number_format": "BR"
+      }
+      """
+    When I upload a file "extrato.csv" with content type "text/csv" to "/api/v1/transactions/import/csv/preview" with content:
+      """
+      Data;Historico;Valor
+      03/10/2024;MERCADO EXTRA;-150,00
+      05/10/2024;SALARIO;5.000,00
+      """
+    Then the response status should be 200
+    And the response field "new_count" should be "2"
+    And the response field "already_imported_count" should be "0"
+    And the response field "lines.0.date" should be "2024-10-03"
+    And the response field "lines.0.description" should be "MERCADO EXTRA"
+    And the response field "lines.0.amount" should be "-150"
+    And the response field "lines.0.type" should be "expense"
+    And the response field "lines.0.suggested_category.name" should be "Groceries"
+    And the response field "lines.1.amount" should be "5000"
+    And the response field "lines.1.type" should be "income"
+    And the db should contain 0 objects in the "transactions" table
+
+  @failure @csv-preview
+  Scenario: Cannot preview a CSV statement without a profile or a mapping
+    When I upload a file "extrato.csv" with content type "text/csv" and content "Data;Historico;Valor" to "/api/v1/transactions/import/csv/preview"
+    Then the response status should be 400
+
+  # ============================================
+  # CSV IMPORT
+  # ============================================
+
+  @success @csv
+  Scenario: Import a CSV statement with a saved profile and skip lines already imported
+    When I send a "POST" request to "/api/v1/import-profiles" with body:
+      """
+      {
+        "name": "Banco Exemplo",
+        "delimiter": ";",
+        "date_column": "Data",
+        "description_column": "Historico",
+        "amount_column": "Valor",
+        "date_format": "DD/MM/YYYY",
+        "number_format": "BR"
+      }
+      """
+    Then the response status should be 201
+    Given the next upload sends the form field "profile_id" with value "{{import_profile_id}}"
+    When I upload a file "extrato.csv" with content type "text/csv" to "/api/v1/transactions/import/csv" with content:
+      """
+      Data;Historico;Valor
+      03/10/2024;MERCADO EXTRA;-150,00
+      05/10/2024;SALARIO;5.000,00
+      """
+    Then the response status should be 201
+    And the response field "statement.format" should be "csv"
+    And the response field "imported_count" should be "2"
+    And the response field "categorized_count" should be "1"
+    And the response field "transactions.0.description" should be "MERCADO EXTRA"
+    And the response field "transactions.0.category.name" should be "Groceries"
+    And the response field "transactions.1.description" should be "SALARIO"
+    And the response field "transactions.1.category" should not exist
+    And the db should contain 2 objects in the "transactions" table
+    Given the next upload sends the form field "profile_id" with value "{{import_profile_id}}"
+    When I upload a file "extrato.csv" with content type "text/csv" to "/api/v1/transactions/import/csv" with content:
+      """
+      Data;Historico;Valor
+      03/10/2024;MERCADO EXTRA;-150,00
+      05/10/2024;SALARIO;5.000,00
+      10/10/2024;NETFLIX;-39,90
+      """
+    Then the response status should be 201
+    And the response field "imported_count" should be "1"
+    And the response field "skipped_count" should be "2"
+    And the response field "transactions.0.description" should be "NETFLIX"
+    And the db should contain 3 objects in the "transactions" table
+
+  @failure @csv
+  Scenario: Cannot import a CSV statement with a profile that does not exist
+    Given the next upload sends the form field "profile_id" with value "00000000-0000-0000-0000-000000000001"
+    When I upload a file "extrato.csv" with content type "text/csv" to "/api/v1/transactions/import/csv" with content:
+      """
+      Data;Historico;Valor
+      03/10/2024;MERCADO EXTRA;-150,00
+      """
+    Then the response status should be 404
+    And the response field "code" should be "IMP-010001"
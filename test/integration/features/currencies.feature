@@ -0,0 +1,238 @@
+# Finance Tracker - Currencies Feature
+
+@all @currencies
+Feature: Multi-Currency Transactions
+  As a user
+  I want to record transactions in foreign currencies
+  So that my reports are converted into my base currency
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+
+  @success @create
+  Scenario: Enter an exchange rate
+    When I send a "POST" request to "/api/v1/exchange-rates" with body:
+      """
+      {
+        "from_currency": "usd",
+        "to_currency": "BRL",
+        "rate": 5.5,
+        "date": "2024-11-01"
+      }
+      """
+    Then the response status should be 201
+    And the response should be JSON
+    And the response field "from_currency" should be "USD"
+    And the response field "to_currency" should be "BRL"
+    And the response field "rate" should be "5.5"
+    And the response field "source" should be "manual"
+    And the db should contain 1 objects in the "exchange_rates" table
+
+  @success @list
+  Scenario: List exchange rates with the base currency
+    When I send a "POST" request to "/api/v1/exchange-rates" with body:
+      """
+      {
+        "from_currency": "USD",
+        "to_currency": "BRL",
+        "rate": 5.5,
+        "date": "2024-11-01"
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/exchange-rates?currency=USD"
+    Then the response status should be 200
+    And the response field "base_currency" should be "BRL"
+    And the response field "exchange_rates.0.from_currency" should be "USD"
+
+  @success @create
+  Scenario: Foreign currency transaction snapshots the exchange rate
+    When I send a "POST" request to "/api/v1/exchange-rates" with body:
+      """
+      {
+        "from_currency": "USD",
+        "to_currency": "BRL",
+        "rate": 5.5,
+        "date": "2024-11-01"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Conference ticket",
+        "amount": -100.00,
+        "type": "expense",
+        "currency": "USD"
+      }
+      """
+    Then the response status should be 201
+    And the response field "currency" should be "USD"
+    And the response field "exchange_rate" should be "5.5"
+    And the response field "base_amount" should be "-550"
+
+  @success @create
+  Scenario: Explicit exchange rate overrides the rate table
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Hotel",
+        "amount": -200.00,
+        "type": "expense",
+        "currency": "EUR",
+        "exchange_rate": 6.1
+      }
+      """
+    Then the response status should be 201
+    And the response field "exchange_rate" should be "6.1"
+    And the response field "base_amount" should be "-1220"
+
+  @success @dashboard
+  Scenario: Dashboard totals are converted into the base currency
+    When I send a "POST" request to "/api/v1/exchange-rates" with body:
+      """
+      {
+        "from_currency": "USD",
+        "to_currency": "BRL",
+        "rate": 5.5,
+        "date": "2024-11-01"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Conference ticket",
+        "amount": -100.00,
+        "type": "expense",
+        "currency": "USD"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-16",
+        "description": "Groceries",
+        "amount": -50.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/dashboard/period-transactions?start_date=2024-11-01&end_date=2024-11-30"
+    Then the response status should be 200
+    And the response field "data.currency" should be "BRL"
+    And the response field "data.summary.total_expenses" should be "600"
+
+  @success @update
+  Scenario: Change the base currency
+    When I send a "POST" request to "/api/v1/exchange-rates" with body:
+      """
+      {
+        "from_currency": "USD",
+        "to_currency": "BRL",
+        "rate": 5,
+        "date": "2024-11-01"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Groceries",
+        "amount": -50.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "PUT" request to "/api/v1/users/me/base-currency" with body:
+      """
+      {
+        "base_currency": "USD"
+      }
+      """
+    Then the response status should be 200
+    And the response field "base_currency" should be "USD"
+    And the response field "recalculated_count" should be "1"
+    When I send a "GET" request to "/api/v1/dashboard/period-transactions?start_date=2024-11-01&end_date=2024-11-30"
+    Then the response status should be 200
+    And the response field "data.currency" should be "USD"
+    And the response field "data.summary.total_expenses" should be "10"
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    And the response field "changes.0.action" should be "update"
+    And the response field "changes.0.fields.0.field" should be "exchange_rate"
+    And the response field "changes.0.fields.0.before" should be "1"
+    And the response field "changes.0.fields.0.after" should be "0.2"
+
+  @failure @create
+  Scenario: Cannot create a foreign currency transaction without a rate
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Conference ticket",
+        "amount": -100.00,
+        "type": "expense",
+        "currency": "USD"
+      }
+      """
+    Then the response status should be 422
+    And the response field "code" should be "TXN-010017"
+    And the db should contain 0 objects in the "transactions" table
+
+  @failure @update
+  Scenario: Cannot change the base currency without rates for existing transactions
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Groceries",
+        "amount": -50.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "PUT" request to "/api/v1/users/me/base-currency" with body:
+      """
+      {
+        "base_currency": "EUR"
+      }
+      """
+    Then the response status should be 422
+    And the response field "code" should be "FXR-010007"
+
+  @failure @validation
+  Scenario: Cannot enter an exchange rate with an invalid currency
+    When I send a "POST" request to "/api/v1/exchange-rates" with body:
+      """
+      {
+        "from_currency": "DOLLAR",
+        "to_currency": "BRL",
+        "rate": 5.5,
+        "date": "2024-11-01"
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "FXR-010003"
+
+  @success @delete
+  Scenario: Delete an exchange rate
+    When I send a "POST" request to "/api/v1/exchange-rates" with body:
+      """
+      {
+        "from_currency": "USD",
+        "to_currency": "BRL",
+        "rate": 5.5,
+        "date": "2024-11-01"
+      }
+      """
+    Then the response status should be 201
+    When I send a "DELETE" request to "/api/v1/exchange-rates/{{transaction_id}}"
+    Then the response status should be 204
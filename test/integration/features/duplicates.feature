@@ -0,0 +1,261 @@
+# Finance Tracker - Duplicate Transactions Feature
+
+@all @duplicates
+Feature: Duplicate Transactions
+  As a user
+  I want to review the transactions that look like the same entry
+  So that I can merge them without losing their category, notes or receipts
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And a category exists with name "Food" and type "expense"
+
+  # ============================================
+  # CANDIDATE DETECTION
+  # ============================================
+
+  @success @list
+  Scenario: List transactions with the same amount, a close date and a similar description
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-05",
+        "description": "IFOOD *RESTAURANTE",
+        "amount": -42.90,
+        "type": "expense",
+        "category_id": "{{category_id:Food}}"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-07",
+        "description": "Ifood Restaurante",
+        "amount": -42.90,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    And the response field "possible_duplicates.0.transaction_id" should be "{{transaction_id:0}}"
+    When I send a "GET" request to "/api/v1/transactions/duplicates?startDate=2025-11-01&endDate=2025-11-30"
+    Then the response status should be 200
+    And the response field "pairs.0.transaction.id" should be "{{transaction_id:0}}"
+    And the response field "pairs.0.duplicate.id" should be "{{transaction_id:1}}"
+    And the response field "pairs.1" should not exist
+
+  @success @list
+  Scenario: An expense and its refund are not listed as duplicates
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-05",
+        "description": "IFOOD *RESTAURANTE",
+        "amount": -42.90,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-06",
+        "description": "IFOOD *RESTAURANTE",
+        "amount": 42.90,
+        "type": "income"
+      }
+      """
+    Then the response status should be 201
+    And the response field "possible_duplicates" should not exist
+    When I send a "GET" request to "/api/v1/transactions/duplicates?startDate=2025-11-01&endDate=2025-11-30"
+    Then the response status should be 200
+    And the response field "pairs.0" should not exist
+
+  @success @list
+  Scenario: Transactions further apart than the date window are not listed as duplicates
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-05",
+        "description": "IFOOD *RESTAURANTE",
+        "amount": -42.90,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-20",
+        "description": "IFOOD *RESTAURANTE",
+        "amount": -42.90,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/transactions/duplicates?startDate=2025-11-01&endDate=2025-11-30"
+    Then the response status should be 200
+    And the response field "pairs.0" should not exist
+
+  # ============================================
+  # MERGE
+  # ============================================
+
+  @success @merge
+  Scenario: Merge a duplicate into the transaction that is kept
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-05",
+        "description": "IFOOD *RESTAURANTE",
+        "amount": -42.90,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-06",
+        "description": "Ifood Restaurante",
+        "amount": -42.90,
+        "type": "expense",
+        "category_id": "{{category_id:Food}}",
+        "notes": "Dinner with friends"
+      }
+      """
+    Then the response status should be 201
+    When I upload a 600x300 PNG image "receipt.png" to "/api/v1/transactions/{{transaction_id:1}}/attachments"
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/duplicates/merge" with body:
+      """
+      {
+        "keep_id": "{{transaction_id:0}}",
+        "duplicate_id": "{{transaction_id:1}}"
+      }
+      """
+    Then the response status should be 200
+    And the response field "transaction.id" should be "{{transaction_id:0}}"
+    And the response field "transaction.category_id" should be "{{category_id:Food}}"
+    And the response field "transaction.notes" should be "Dinner with friends"
+    And the response field "deleted_id" should be "{{transaction_id:1}}"
+    And the db should contain 1 objects in "attachments" with the values
+      """
+      {"owner_id": "{{transaction_id:0}}"}
+      """
+    And the db should contain 1 objects in "transaction_changes" with the values
+      """
+      {"transaction_id": "{{transaction_id:0}}", "action": "update"}
+      """
+    And the db should contain 1 objects in "transaction_changes" with the values
+      """
+      {"transaction_id": "{{transaction_id:1}}", "action": "delete"}
+      """
+    When I send a "GET" request to "/api/v1/transactions/duplicates?startDate=2025-11-01&endDate=2025-11-30"
+    Then the response status should be 200
+    And the response field "pairs.0" should not exist
+
+  @failure @merge
+  Scenario: Cannot merge a transaction with itself
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-05",
+        "description": "IFOOD *RESTAURANTE",
+        "amount": -42.90,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/duplicates/merge" with body:
+      """
+      {
+        "keep_id": "{{transaction_id}}",
+        "duplicate_id": "{{transaction_id}}"
+      }
+      """
+    Then the response status should be 400
+    And the db should contain 1 objects in the "transactions" table
+
+  @failure @merge
+  Scenario: Cannot merge a transfer leg
+    Given an account exists with name "Checking" and type "checking"
+    And an account exists with name "Savings" and type "savings"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-05",
+        "description": "Monthly savings",
+        "amount": -500.00,
+        "type": "expense",
+        "account_id": "{{account_id:Checking}}"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transfers" with body:
+      """
+      {
+        "from_account_id": "{{account_id:Checking}}",
+        "to_account_id": "{{account_id:Savings}}",
+        "date": "2025-11-05",
+        "description": "Monthly savings",
+        "amount": 500.00
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/duplicates/merge" with body:
+      """
+      {
+        "keep_id": "{{transaction_id:0}}",
+        "duplicate_id": "{{transfer_leg_id}}"
+      }
+      """
+    Then the response status should be 409
+    And the response field "code" should be "TXN-010015"
+    And the db should contain 3 objects in the "transactions" table
+
+  @failure @merge
+  Scenario: Cannot merge a split transaction
+    Given a category exists with name "Pharmacy" and type "expense"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-05",
+        "description": "Supermarket",
+        "amount": -100.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-06",
+        "description": "Supermarket",
+        "amount": -100.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "PUT" request to "/api/v1/transactions/{{transaction_id:1}}/splits" with body:
+      """
+      {
+        "splits": [
+          {"amount": -70.00, "category_id": "{{category_id:Food}}"},
+          {"amount": -30.00, "category_id": "{{category_id:Pharmacy}}"}
+        ]
+      }
+      """
+    Then the response status should be 200
+    When I send a "POST" request to "/api/v1/transactions/duplicates/merge" with body:
+      """
+      {
+        "keep_id": "{{transaction_id:0}}",
+        "duplicate_id": "{{transaction_id:1}}"
+      }
+      """
+    Then the response status should be 409
+    And the response field "code" should be "TXN-010020"
+    And the db should contain 2 objects in the "transaction_splits" table
@@ -0,0 +1,92 @@
+# Finance Tracker - Transaction Export Feature
+
+@all @export
+Feature: Transaction Export
+  As a user who wants to use my data in other tools
+  I want to export my transactions to CSV, Excel and OFX files
+  So that I can analyze them in spreadsheets or import them elsewhere
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And a category exists with name "Food" and type "expense"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-05",
+        "description": "Grocery store",
+        "amount": -84.20,
+        "type": "expense",
+        "category_id": "{{category_id:Food}}",
+        "notes": "Weekly shopping"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-10",
+        "description": "Salary",
+        "amount": 5000.00,
+        "type": "income"
+      }
+      """
+    Then the response status should be 201
+
+  @success @csv
+  Scenario: Export transactions to CSV
+    When I send a "GET" request to "/api/v1/transactions/export?format=csv"
+    Then the response status should be 200
+    And the response header "Content-Type" should be "text/csv; charset=utf-8"
+    And the response body should contain "Date,Description,Amount,Currency,Base Amount,Type,Category,Account,Tags,Notes"
+    And the response body should contain "2024-11-05,Grocery store,-84.20,BRL,-84.20,expense,Food,,,Weekly shopping"
+    And the response body should contain "2024-11-10,Salary,"
+
+  @success @csv
+  Scenario: CSV is the default export format
+    When I send a "GET" request to "/api/v1/transactions/export"
+    Then the response status should be 200
+    And the response header "Content-Type" should be "text/csv; charset=utf-8"
+
+  @success @csv
+  Scenario: Exports honor the listing filters
+    When I send a "GET" request to "/api/v1/transactions/export?type=income&startDate=2024-11-01&endDate=2024-11-30"
+    Then the response status should be 200
+    And the response body should contain "Salary"
+    And the response body should not contain "Grocery store"
+
+  @success @csv
+  Scenario: Exports honor the search query
+    When I send a "GET" request to "/api/v1/transactions/export?search=grocery"
+    Then the response status should be 200
+    And the response body should contain "Grocery store"
+    And the response body should not contain "Salary"
+
+  @success @xlsx
+  Scenario: Export transactions to Excel
+    When I send a "GET" request to "/api/v1/transactions/export?format=xlsx"
+    Then the response status should be 200
+    And the response header "Content-Type" should be "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+    And the response body should contain "xl/worksheets/sheet2.xml"
+
+  @success @ofx
+  Scenario: Export transactions to OFX
+    When I send a "GET" request to "/api/v1/transactions/export?format=ofx"
+    Then the response status should be 200
+    And the response header "Content-Type" should be "application/x-ofx"
+    And the response body should contain "<TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20241105</DTPOSTED><TRNAMT>-84.20</TRNAMT>"
+    And the response body should contain "<NAME>Salary</NAME>"
+    And the response body should contain "<BALAMT>4915.80</BALAMT>"
+
+  @error
+  Scenario: Cannot export to an unknown format
+    When I send a "GET" request to "/api/v1/transactions/export?format=pdf"
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010034"
+
+  @error
+  Scenario: Cannot export with an invalid search query
+    When I send a "GET" request to "/api/v1/transactions/export?search=notes%3A%22weekly"
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010023"
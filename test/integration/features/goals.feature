@@ -288,6 +288,175 @@ Feature: Goals (Spending Limits) Management
     And the response should be JSON
     And the response field "code" should be "GOL-010001"
 
+  # ============================================
+  # SAVINGS GOAL SCENARIOS
+  # ============================================
+
+  @success @create @savings
+  Scenario: Create savings goal with target amount
+    When I send a "POST" request to "/api/v1/goals" with body:
+      """
+      {
+        "kind": "savings",
+        "name": "Emergency fund",
+        "target_amount": 20000.00,
+        "target_date": "2027-06-30"
+      }
+      """
+    Then the response status should be 201
+    And the response should be JSON
+    And the response field "kind" should be "savings"
+    And the response field "name" should be "Emergency fund"
+    And the response field "target_date" should be "2027-06-30"
+    And the db should contain 1 objects in the "goals" table
+
+  @failure @create @savings @validation
+  Scenario: Validation error for savings goal without name
+    When I send a "POST" request to "/api/v1/goals" with body:
+      """
+      {
+        "kind": "savings",
+        "target_amount": 20000.00
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "GOL-010008"
+
+  @failure @create @savings @validation
+  Scenario: Validation error for savings goal with a category
+    Given a category exists with name "Food" and type "expense"
+    When I send a "POST" request to "/api/v1/goals" with body:
+      """
+      {
+        "kind": "savings",
+        "name": "Emergency fund",
+        "target_amount": 20000.00,
+        "category_id": "{{category_id}}"
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "GOL-010009"
+
+  @success @contributions @savings
+  Scenario: A contribution linked to a foreign currency transaction counts its base currency amount
+    When I send a "POST" request to "/api/v1/exchange-rates" with body:
+      """
+      {
+        "from_currency": "USD",
+        "to_currency": "BRL",
+        "rate": 5,
+        "date": "2024-11-01"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Transfer to brokerage",
+        "amount": -100.00,
+        "type": "expense",
+        "currency": "USD"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/goals" with body:
+      """
+      {
+        "kind": "savings",
+        "name": "Emergency fund",
+        "target_amount": 20000.00
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/goals/{{transaction_id:2}}/contributions" with body:
+      """
+      {
+        "transaction_id": "{{transaction_id:1}}"
+      }
+      """
+    Then the response status should be 201
+    And the response field "amount" should be "500"
+    And the response field "date" should be "2024-11-15"
+
+  @failure @contributions @savings
+  Scenario: Cannot add contributions to a spending limit goal
+    Given a category exists with name "Food" and type "expense"
+    And a goal exists for category "Food" with limit "500.00"
+    When I send a "POST" request to "/api/v1/goals/{{goal_id}}/contributions" with body:
+      """
+      {
+        "amount": 100.00
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "GOL-010011"
+
+  # ============================================
+  # ALERT SCENARIOS
+  # ============================================
+
+  @success @alerts
+  Scenario: A goal alert email is queued once per reached threshold
+    Given a category exists with name "Food" and type "expense"
+    And a goal exists for category "Food" with limit "100.00"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "{{billing_cycle:0}}-01",
+        "description": "Supermarket",
+        "amount": -85.00,
+        "type": "expense",
+        "category_id": "{{category_id:Food}}"
+      }
+      """
+    Then the response status should be 201
+    When goal alerts are evaluated
+    And goal alerts are evaluated
+    Then the db should contain 1 objects in "email_queue" with the values
+      """
+      {"recipient_email": "test@example.com", "template_type": "goal_80_percent"}
+      """
+    And the db should contain 1 objects in the "goal_alerts" table
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "{{billing_cycle:0}}-01",
+        "description": "Restaurant",
+        "amount": -30.00,
+        "type": "expense",
+        "category_id": "{{category_id:Food}}"
+      }
+      """
+    Then the response status should be 201
+    When goal alerts are evaluated
+    And goal alerts are evaluated
+    Then the db should contain 1 objects in "email_queue" with the values
+      """
+      {"recipient_email": "test@example.com", "template_type": "goal_exceeded"}
+      """
+    And the db should contain 2 objects in the "email_queue" table
+    And the db should contain 2 objects in the "goal_alerts" table
+
+  @success @alerts
+  Scenario: No goal alert email is queued below the first threshold
+    Given a category exists with name "Food" and type "expense"
+    And a goal exists for category "Food" with limit "100.00"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "{{billing_cycle:0}}-01",
+        "description": "Supermarket",
+        "amount": -50.00,
+        "type": "expense",
+        "category_id": "{{category_id:Food}}"
+      }
+      """
+    Then the response status should be 201
+    When goal alerts are evaluated
+    Then the db should contain 0 objects in the "email_queue" table
+    And the db should contain 0 objects in the "goal_alerts" table
+
   # ============================================
   # AUTHENTICATION SCENARIOS
   # ============================================
@@ -0,0 +1,218 @@
+# Finance Tracker - Installment Plans Feature
+
+@all @installments
+Feature: Installment Plans
+  As a user
+  I want the installments of a purchase grouped across credit card bills
+  So that I know how much of my upcoming bills is already committed
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+
+  @success @grouping
+  Scenario: Installments imported in different bills are grouped into one plan
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "transactions": [
+          {"date": "2024-11-05", "description": "Loja X - Parcela 1/3", "amount": 100.00, "installment_current": 1, "installment_total": 3},
+          {"date": "2024-11-08", "description": "Mercado", "amount": 50.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    And the response field "transactions.0.installment_plan_id" should exist
+    And the response field "transactions.1.installment_plan_id" should not exist
+    And the response field "installment_issues" should be "[]"
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-12",
+        "transactions": [
+          {"date": "2024-11-05", "description": "Loja X - Parcela 2/3", "amount": 100.00, "installment_current": 2, "installment_total": 3}
+        ]
+      }
+      """
+    Then the response status should be 201
+    And the response field "installment_issues" should be "[]"
+    When I send a "GET" request to "/api/v1/installments"
+    Then the response status should be 200
+    And the response field "plans.0.description" should be "Loja X"
+    And the response field "plans.0.total_installments" should be "3"
+    And the response field "plans.0.first_billing_cycle" should be "2024-11"
+    And the response field "plans.0.recorded_installments" should be "[1 2]"
+    And the response field "plans.0.remaining_installments" should be "1"
+    And the response field "plans.0.remaining_amount" should be "100"
+    And the response field "plans.0.next_billing_cycle" should be "2025-01"
+    And the response field "plans.1" should not exist
+    When I send a "GET" request to "/api/v1/installments/{{installment_plan_id}}"
+    Then the response status should be 200
+    And the response field "installments.0.installment_number" should be "1"
+    And the response field "installments.1.installment_number" should be "2"
+    And the response field "installments.1.billing_cycle" should be "2024-12"
+
+  @success @issues
+  Scenario: A bill without an expected installment reports it as missing
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "transactions": [
+          {"date": "2024-11-05", "description": "Loja X - Parcela 1/3", "amount": 100.00, "installment_current": 1, "installment_total": 3}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-12",
+        "transactions": [
+          {"date": "2024-12-02", "description": "Mercado", "amount": 50.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    And the response field "installment_issues.0.type" should be "missing"
+    And the response field "installment_issues.0.description" should be "Loja X"
+    And the response field "installment_issues.0.installment_number" should be "2"
+    And the response field "installment_issues.0.billing_cycle" should be "2024-12"
+
+  @success @issues
+  Scenario: An installment imported twice is reported as extra
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "transactions": [
+          {"date": "2024-11-05", "description": "Loja X - Parcela 1/3", "amount": 100.00, "installment_current": 1, "installment_total": 3}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "transactions": [
+          {"date": "2024-11-05", "description": "LOJA X - PARCELA 1/3", "amount": 100.00, "installment_current": 1, "installment_total": 3}
+        ]
+      }
+      """
+    Then the response status should be 201
+    And the response field "installment_issues.0.type" should be "extra"
+    And the response field "installment_issues.0.installment_number" should be "1"
+    And the response field "installment_issues.0.transaction_id" should exist
+    And the response field "installment_issues.1" should not exist
+
+  @success @issues
+  Scenario: Identical purchases in one bill get a plan each
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "transactions": [
+          {"date": "2024-11-05", "description": "Loja X - Parcela 1/3", "amount": 100.00, "installment_current": 1, "installment_total": 3},
+          {"date": "2024-11-05", "description": "Loja X - Parcela 1/3", "amount": 100.00, "installment_current": 1, "installment_total": 3}
+        ]
+      }
+      """
+    Then the response status should be 201
+    And the response field "installment_issues" should be "[]"
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-12",
+        "transactions": [
+          {"date": "2024-11-05", "description": "Loja X - Parcela 2/3", "amount": 100.00, "installment_current": 2, "installment_total": 3},
+          {"date": "2024-11-05", "description": "Loja X - Parcela 2/3", "amount": 100.00, "installment_current": 2, "installment_total": 3}
+        ]
+      }
+      """
+    Then the response status should be 201
+    And the response field "installment_issues" should be "[]"
+    When I send a "GET" request to "/api/v1/installments"
+    Then the response status should be 200
+    And the response field "plans.0.recorded_installments" should be "[1 2]"
+    And the response field "plans.1.recorded_installments" should be "[1 2]"
+    And the response field "plans.2" should not exist
+
+  @success @issues
+  Scenario: Installments of another card are not reported as missing
+    Given an account exists with name "Visa" and type "credit_card"
+    And an account exists with name "Master" and type "credit_card"
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "account_id": "{{account_id:Visa}}",
+        "transactions": [
+          {"date": "2024-11-05", "description": "Loja X - Parcela 1/3", "amount": 100.00, "installment_current": 1, "installment_total": 3}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-12",
+        "account_id": "{{account_id:Master}}",
+        "transactions": [
+          {"date": "2024-12-02", "description": "Mercado", "amount": 50.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    And the response field "installment_issues" should be "[]"
+    When I send a "GET" request to "/api/v1/installments"
+    Then the response status should be 200
+    And the response field "plans.0.account_id" should be "{{account_id:Visa}}"
+
+  @success @commitments
+  Scenario: Future commitments add up the outstanding installments of each bill
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "transactions": [
+          {"date": "2024-11-05", "description": "Loja X - Parcela 1/3", "amount": 100.00, "installment_current": 1, "installment_total": 3},
+          {"date": "2024-10-20", "description": "Notebook - Parcela 2/4", "amount": 300.00, "installment_current": 2, "installment_total": 4}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/installments/commitments?from=2024-12&months=3"
+    Then the response status should be 200
+    And the response field "currency" should be "BRL"
+    And the response field "total_committed" should be "800"
+    And the response field "months.0.billing_cycle" should be "2024-12"
+    And the response field "months.0.amount" should be "400"
+    And the response field "months.0.installment_count" should be "2"
+    And the response field "months.0.installments.0.description" should be "Notebook"
+    And the response field "months.0.installments.0.installment_number" should be "3"
+    And the response field "months.1.billing_cycle" should be "2025-01"
+    And the response field "months.1.amount" should be "400"
+    And the response field "months.2.billing_cycle" should be "2025-02"
+    And the response field "months.2.amount" should be "0"
+    And the response field "months.2.installments" should be "[]"
+
+  @failure @commitments
+  Scenario: Cannot project commitments beyond the maximum horizon
+    When I send a "GET" request to "/api/v1/installments/commitments?months=48"
+    Then the response status should be 400
+    And the response field "code" should be "INS-010003"
+
+  @failure @commitments
+  Scenario: Cannot project commitments from an invalid billing cycle
+    When I send a "GET" request to "/api/v1/installments/commitments?from=12-2024"
+    Then the response status should be 400
+    And the response field "code" should be "INS-010004"
+
+  @failure @plans
+  Scenario: Cannot get an installment plan that does not exist
+    When I send a "GET" request to "/api/v1/installments/00000000-0000-0000-0000-000000000001"
+    Then the response status should be 404
+    And the response field "code" should be "INS-010001"
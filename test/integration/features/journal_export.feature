@@ -0,0 +1,169 @@
+# Finance Tracker - Journal Export Feature
+
+@all @journal-export
+Feature: Plain-Text Accounting Export
+  As a user who double-checks my finances in Beancount or hledger
+  I want to export my ledger as a plain-text accounting journal
+  So that I can reconcile it with my own tools
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And a category exists with name "Food" and type "expense"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-05",
+        "description": "Grocery store",
+        "amount": -84.20,
+        "type": "expense",
+        "category_id": "{{category_id:Food}}",
+        "notes": "Weekly shopping"
+      }
+      """
+    Then the response status should be 201
+
+  @success @beancount
+  Scenario: Export the ledger as a Beancount file
+    When I send a "GET" request to "/api/v1/transactions/export/journal?format=beancount"
+    Then the response status should be 200
+    And the response header "Content-Type" should be "text/plain; charset=utf-8"
+    And the response body should contain "option "
+    And the response body should contain "2024-11-05 open Expenses:Food"
+    And the response body should contain "2024-11-05 open Assets:Unassigned"
+    And the response body should contain "Grocery store"
+    And the response body should contain "84.20 BRL"
+    And the response body should contain "-84.20 BRL"
+
+  @success @beancount
+  Scenario: Beancount is the default journal format
+    When I send a "GET" request to "/api/v1/transactions/export/journal"
+    Then the response status should be 200
+    And the response body should contain "2024-11-05 open Expenses:Food"
+
+  @success @hledger
+  Scenario: Export the ledger as an hledger journal
+    When I send a "GET" request to "/api/v1/transactions/export/journal?format=hledger"
+    Then the response status should be 200
+    And the response body should contain "account Expenses:Food"
+    And the response body should contain "2024-11-05 * Grocery store"
+    And the response body should contain "; Weekly shopping"
+    And the response body should not contain "open Expenses:Food"
+
+  @success @transfer
+  Scenario: Both legs of a transfer are exported as one entry
+    Given an account exists with name "Checking" and type "checking"
+    And an account exists with name "Savings" and type "savings"
+    When I send a "POST" request to "/api/v1/transfers" with body:
+      """
+      {
+        "from_account_id": "{{account_id:Checking}}",
+        "to_account_id": "{{account_id:Savings}}",
+        "date": "2024-11-07",
+        "description": "Monthly savings",
+        "amount": 100.00
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/transactions/export/journal?format=hledger"
+    Then the response status should be 200
+    And the response body should contain "2024-11-07 * Monthly savings"
+    And the response body should contain "account Assets:Checking"
+    And the response body should contain "account Assets:Savings"
+    And the response body should not contain "Equity:Transfers"
+
+  @success @credit-card
+  Scenario: A reconciled billing cycle gets a balance assertion
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-12-10",
+        "description": "Pagamento de fatura",
+        "amount": -500.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "bill_payment_id": "{{transaction_id}}",
+        "transactions": [
+          {"date": "2024-11-12", "description": "Restaurant", "amount": 300.00},
+          {"date": "2024-11-20", "description": "Pharmacy", "amount": 200.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/transactions/export/journal?format=beancount"
+    Then the response status should be 200
+    And the response body should contain "open Liabilities:Credit-Card:2024-11"
+    And the response body should contain "-300.00 BRL"
+    And the response body should contain "500.00 BRL"
+    And the response body should contain "2024-12-11 balance Liabilities:Credit-Card:2024-11  0.00 BRL"
+    When I send a "GET" request to "/api/v1/transactions/export/journal?format=hledger"
+    Then the response status should be 200
+    And the response body should contain "2024-12-10 * Balance assertion"
+
+  @success @credit-card
+  Scenario: Cards sharing a billing cycle keep separate liabilities
+    Given an account exists with name "Visa" and type "credit_card"
+    And an account exists with name "Master" and type "credit_card"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-12-10",
+        "description": "Pagamento fatura Visa",
+        "amount": -300.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "account_id": "{{account_id:Visa}}",
+        "bill_payment_id": "{{transaction_id}}",
+        "transactions": [
+          {"date": "2024-11-12", "description": "Restaurant", "amount": 300.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-12-12",
+        "description": "Pagamento fatura Master",
+        "amount": -80.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/credit-card/import" with body:
+      """
+      {
+        "billing_cycle": "2024-11",
+        "account_id": "{{account_id:Master}}",
+        "bill_payment_id": "{{transaction_id}}",
+        "transactions": [
+          {"date": "2024-11-18", "description": "Bookstore", "amount": 80.00}
+        ]
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/transactions/export/journal?format=beancount"
+    Then the response status should be 200
+    And the response body should contain "open Liabilities:Visa:2024-11"
+    And the response body should contain "open Liabilities:Master:2024-11"
+    And the response body should contain "balance Liabilities:Visa:2024-11  0.00 BRL"
+    And the response body should contain "balance Liabilities:Master:2024-11  0.00 BRL"
+
+  @error
+  Scenario: Cannot export a journal in an unknown format
+    When I send a "GET" request to "/api/v1/transactions/export/journal?format=gnucash"
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010034"
@@ -0,0 +1,189 @@
+# Finance Tracker - Merchants Feature
+
+@all @merchants
+Feature: Merchants
+  As a user
+  I want my transactions grouped by the merchant they were made at
+  So that I can see where my money goes regardless of how the bank spells it
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+
+  @success @normalization
+  Scenario: Different spellings of a merchant are recognized as one merchant
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-10",
+        "description": "IFD*IFOOD",
+        "amount": -45.90,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    And the response should contain "merchant_id"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-12",
+        "description": "IFOOD *RESTAURANTE X",
+        "amount": -32.50,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "iFood",
+        "amount": -21.60,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/merchants"
+    Then the response status should be 200
+    And the response field "merchants.0.name" should be "Ifood"
+    And the response field "merchants.0.key" should be "IFOOD"
+    And the response field "merchants.0.transaction_count" should be "3"
+    And the response field "merchants.1" should not exist
+
+  @success @rename
+  Scenario: Rename a merchant and add an alias
+    Given a merchant exists with name "Padaria" and key "PADARIA SAO"
+    When I send a "PATCH" request to "/api/v1/merchants/{{merchant_id:Padaria}}" with body:
+      """
+      {
+        "name": "Padaria São Jorge",
+        "aliases": ["PAD SJORGE"]
+      }
+      """
+    Then the response status should be 200
+    And the response field "name" should be "Padaria São Jorge"
+    And the response field "aliases.0" should be "PAD SJORGE"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-10",
+        "description": "PAD SJORGE 0042",
+        "amount": -12.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    And the response field "merchant_id" should be "{{merchant_id:Padaria}}"
+
+  @success @category
+  Scenario: New transactions get the default category of their merchant
+    Given a category exists with name "Delivery" and type "expense"
+    And a merchant exists with name "Ifood" and key "IFOOD"
+    When I send a "PATCH" request to "/api/v1/merchants/{{merchant_id:Ifood}}" with body:
+      """
+      {
+        "default_category_id": "{{category_id:Delivery}}"
+      }
+      """
+    Then the response status should be 200
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-10",
+        "description": "IFD*IFOOD",
+        "amount": -45.90,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    And the response field "merchant_id" should be "{{merchant_id:Ifood}}"
+    And the response field "category_id" should be "{{category_id:Delivery}}"
+
+  @success @merge
+  Scenario: Merge merchants moves their transactions
+    Given a merchant exists with name "Uber" and key "UBER"
+    And a merchant exists with name "Uber Trip" and key "UBERTRIP"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-10",
+        "description": "UBERTRIP 1234",
+        "amount": -18.40,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    And the response field "merchant_id" should be "{{merchant_id:Uber Trip}}"
+    When I send a "POST" request to "/api/v1/merchants/{{merchant_id:Uber}}/merge" with body:
+      """
+      {
+        "merchant_ids": ["{{merchant_id:Uber Trip}}"]
+      }
+      """
+    Then the response status should be 200
+    And the response field "moved_count" should be "1"
+    And the response field "merchant.aliases.0" should be "UBERTRIP"
+    When I send a "GET" request to "/api/v1/merchants"
+    Then the response status should be 200
+    And the response field "merchants.0.name" should be "Uber"
+    And the response field "merchants.0.transaction_count" should be "1"
+    And the response field "merchants.1" should not exist
+
+  @failure @merge
+  Scenario: Cannot merge a merchant into itself
+    Given a merchant exists with name "Uber" and key "UBER"
+    When I send a "POST" request to "/api/v1/merchants/{{merchant_id:Uber}}/merge" with body:
+      """
+      {
+        "merchant_ids": ["{{merchant_id:Uber}}"]
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "MER-010004"
+
+  @failure @rename
+  Scenario: Cannot update a merchant that does not exist
+    When I send a "PATCH" request to "/api/v1/merchants/00000000-0000-0000-0000-000000000001" with body:
+      """
+      {
+        "name": "Ghost"
+      }
+      """
+    Then the response status should be 404
+    And the response field "code" should be "MER-010001"
+
+  @success @dashboard
+  Scenario: Top merchants ranks merchants by spending
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-10",
+        "description": "IFD*IFOOD",
+        "amount": -45.90,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-12",
+        "description": "UBER *TRIP",
+        "amount": -100.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/dashboard/top-merchants?start_date=2024-11-01&end_date=2024-11-30"
+    Then the response status should be 200
+    And the response field "data.period.granularity" should be "monthly"
+    And the response field "data.merchants.0.merchant_name" should be "Uber"
+    And the response field "data.merchants.0.transaction_count" should be "1"
+    And the response field "data.merchants.1.merchant_name" should be "Ifood"
+
+  @failure @dashboard
+  Scenario: Top merchants rejects an unsupported granularity
+    When I send a "GET" request to "/api/v1/dashboard/top-merchants?start_date=2024-11-01&end_date=2024-11-30&granularity=daily"
+    Then the response status should be 400
+    And the response field "code" should be "DSH-010004"
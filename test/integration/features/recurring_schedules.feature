@@ -0,0 +1,151 @@
+# Finance Tracker - Recurring Schedules Feature
+
+@all @recurring
+Feature: Recurring Schedules
+  As a user with rent, salary and subscriptions
+  I want to describe my recurring transactions once
+  So that they are entered for me on every occurrence
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And a category exists with name "Housing" and type "expense"
+
+  # ============================================
+  # CRUD
+  # ============================================
+
+  @success @create
+  Scenario: Create a monthly schedule
+    When I send a "POST" request to "/api/v1/recurring-schedules" with body:
+      """
+      {
+        "description": "Rent",
+        "amount": 1500.00,
+        "type": "expense",
+        "category_id": "{{category_id:Housing}}",
+        "frequency": "monthly",
+        "start_date": "2099-01-10"
+      }
+      """
+    Then the response status should be 201
+    And the response field "description" should be "Rent"
+    And the response field "frequency" should be "monthly"
+    And the response field "interval" should be "1"
+    And the response field "day_of_month" should be "10"
+    And the response field "category.name" should be "Housing"
+    And the response field "next_occurrence" should be "2099-01-10"
+    And the response field "upcoming_occurrences.1" should be "2099-02-10"
+    And the response field "occurrence_count" should be "0"
+    And the db should contain 1 objects in the "recurring_schedules" table
+
+  @success @list
+  Scenario: List, update and delete a schedule
+    When I send a "POST" request to "/api/v1/recurring-schedules" with body:
+      """
+      {
+        "description": "Rent",
+        "amount": 1500.00,
+        "type": "expense",
+        "frequency": "monthly",
+        "start_date": "2099-01-10"
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/recurring-schedules"
+    Then the response status should be 200
+    And the response field "schedules.0.description" should be "Rent"
+    When I send a "PATCH" request to "/api/v1/recurring-schedules/{{recurring_schedule_id}}" with body:
+      """
+      {
+        "description": "Apartment rent",
+        "day_of_month": 5
+      }
+      """
+    Then the response status should be 200
+    And the response field "description" should be "Apartment rent"
+    And the response field "next_occurrence" should be "2099-02-05"
+    When I send a "GET" request to "/api/v1/recurring-schedules/{{recurring_schedule_id}}"
+    Then the response status should be 200
+    And the response field "description" should be "Apartment rent"
+    When I send a "DELETE" request to "/api/v1/recurring-schedules/{{recurring_schedule_id}}"
+    Then the response status should be 204
+    When I send a "GET" request to "/api/v1/recurring-schedules/{{recurring_schedule_id}}"
+    Then the response status should be 404
+    And the response field "code" should be "REC-010001"
+
+  @failure @create
+  Scenario: Cannot create a schedule with an invalid day of month
+    When I send a "POST" request to "/api/v1/recurring-schedules" with body:
+      """
+      {
+        "description": "Rent",
+        "amount": 1500.00,
+        "type": "expense",
+        "frequency": "monthly",
+        "day_of_month": 32,
+        "start_date": "2099-01-10"
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "REC-010005"
+    And the db should contain 0 objects in the "recurring_schedules" table
+
+  @failure @get
+  Scenario: Cannot get a schedule that does not exist
+    When I send a "GET" request to "/api/v1/recurring-schedules/00000000-0000-0000-0000-000000000001"
+    Then the response status should be 404
+    And the response field "code" should be "REC-010001"
+
+  # ============================================
+  # MATERIALIZATION
+  # ============================================
+
+  @success @scheduler
+  Scenario: The scheduler enters every due occurrence once
+    When I send a "POST" request to "/api/v1/recurring-schedules" with body:
+      """
+      {
+        "description": "Rent",
+        "amount": 1500.00,
+        "type": "expense",
+        "category_id": "{{category_id:Housing}}",
+        "frequency": "monthly",
+        "start_date": "2099-01-10"
+      }
+      """
+    Then the response status should be 201
+    When the recurring scheduler runs on "2099-03-15"
+    Then the db should contain 3 objects in "transactions" with the values
+      """
+      {"description": "Rent", "recurring_schedule_id": "{{recurring_schedule_id}}", "category_id": "{{category_id:Housing}}"}
+      """
+    When I send a "GET" request to "/api/v1/recurring-schedules/{{recurring_schedule_id}}"
+    Then the response status should be 200
+    And the response field "occurrence_count" should be "3"
+    And the response field "last_occurrence_date" should be "2099-03-10"
+    And the response field "next_occurrence" should be "2099-04-10"
+    When the recurring scheduler runs on "2099-03-15"
+    Then the db should contain 3 objects in the "transactions" table
+
+  @success @scheduler
+  Scenario: A schedule with a maximum number of occurrences ends after the last one
+    When I send a "POST" request to "/api/v1/recurring-schedules" with body:
+      """
+      {
+        "description": "Laptop",
+        "amount": 300.00,
+        "type": "expense",
+        "frequency": "monthly",
+        "start_date": "2099-01-10",
+        "max_occurrences": 2
+      }
+      """
+    Then the response status should be 201
+    When the recurring scheduler runs on "2099-06-15"
+    Then the db should contain 2 objects in the "transactions" table
+    When I send a "GET" request to "/api/v1/recurring-schedules/{{recurring_schedule_id}}"
+    Then the response status should be 200
+    And the response field "occurrence_count" should be "2"
+    And the response field "next_occurrence" should not exist
@@ -0,0 +1,228 @@
+# Finance Tracker - Split Transactions Feature
+
+@all @splits
+Feature: Split Transactions
+  As a user
+  I want to split a transaction across several categories
+  So that a single receipt is attributed to each category it covers
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And a category exists with name "Groceries" and type "expense"
+    And a category exists with name "Pharmacy" and type "expense"
+
+  @success @split
+  Scenario: Split a transaction across categories
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Supermarket",
+        "amount": -100.00,
+        "type": "expense",
+        "category_id": "{{category_id:Groceries}}"
+      }
+      """
+    Then the response status should be 201
+    When I send a "PUT" request to "/api/v1/transactions/{{transaction_id}}/splits" with body:
+      """
+      {
+        "splits": [
+          {"amount": -70.00, "category_id": "{{category_id:Groceries}}", "notes": "Food"},
+          {"amount": -30.00, "category_id": "{{category_id:Pharmacy}}"}
+        ]
+      }
+      """
+    Then the response status should be 200
+    And the response should be JSON
+    And the response field "is_split" should be "true"
+    And the response field "splits.0.amount" should be "-70"
+    And the response field "splits.0.notes" should be "Food"
+    And the response field "splits.1.category_id" should be "{{category_id:Pharmacy}}"
+    And the db should contain 2 objects in the "transaction_splits" table
+
+  @success @dashboard
+  Scenario: Category breakdown attributes split amounts to their categories
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Supermarket",
+        "amount": -100.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "PUT" request to "/api/v1/transactions/{{transaction_id}}/splits" with body:
+      """
+      {
+        "splits": [
+          {"amount": -70.00, "category_id": "{{category_id:Groceries}}"},
+          {"amount": -30.00, "category_id": "{{category_id:Pharmacy}}"}
+        ]
+      }
+      """
+    Then the response status should be 200
+    When I send a "GET" request to "/api/v1/dashboard/category-breakdown?period=custom&start_date=2024-11-01&end_date=2024-11-30"
+    Then the response status should be 200
+    And the response field "data.total_expenses" should be "100"
+    And the response field "data.categories.0.category_name" should be "Groceries"
+    And the response field "data.categories.0.amount" should be "70"
+    And the response field "data.categories.1.category_name" should be "Pharmacy"
+    And the response field "data.categories.1.amount" should be "30"
+
+  @success @list
+  Scenario: Filtering by category includes split transactions
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Supermarket",
+        "amount": -100.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "PUT" request to "/api/v1/transactions/{{transaction_id}}/splits" with body:
+      """
+      {
+        "splits": [
+          {"amount": -70.00, "category_id": "{{category_id:Groceries}}"},
+          {"amount": -30.00, "category_id": "{{category_id:Pharmacy}}"}
+        ]
+      }
+      """
+    Then the response status should be 200
+    When I send a "GET" request to "/api/v1/transactions?categoryIds={{category_id:Pharmacy}}"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "1"
+    And the response field "transactions.0.splits.1.amount" should be "-30"
+    And the response field "totals.expense_total" should be "-30"
+
+  @success @bulk @categorize
+  Scenario: Bulk categorize leaves split transactions alone unless included
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Supermarket",
+        "amount": -100.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "PUT" request to "/api/v1/transactions/{{transaction_id}}/splits" with body:
+      """
+      {
+        "splits": [
+          {"amount": -70.00, "category_id": "{{category_id:Groceries}}"},
+          {"amount": -30.00, "category_id": "{{category_id:Pharmacy}}"}
+        ]
+      }
+      """
+    Then the response status should be 200
+    When I send a "POST" request to "/api/v1/transactions/bulk-categorize" with body:
+      """
+      {
+        "ids": ["{{transaction_id}}"],
+        "category_id": "{{category_id:Groceries}}"
+      }
+      """
+    Then the response status should be 200
+    And the response field "updated_count" should be "0"
+    And the db should contain 2 objects in the "transaction_splits" table
+    When I send a "POST" request to "/api/v1/transactions/bulk-categorize" with body:
+      """
+      {
+        "ids": ["{{transaction_id}}"],
+        "category_id": "{{category_id:Groceries}}",
+        "include_split": true
+      }
+      """
+    Then the response status should be 200
+    And the response field "updated_count" should be "1"
+    And the db should contain 0 objects in the "transaction_splits" table
+
+  @success @unsplit
+  Scenario: Remove the split lines of a transaction
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Supermarket",
+        "amount": -100.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "PUT" request to "/api/v1/transactions/{{transaction_id}}/splits" with body:
+      """
+      {
+        "splits": [
+          {"amount": -70.00, "category_id": "{{category_id:Groceries}}"},
+          {"amount": -30.00}
+        ]
+      }
+      """
+    Then the response status should be 200
+    When I send a "DELETE" request to "/api/v1/transactions/{{transaction_id}}/splits"
+    Then the response status should be 204
+    And the db should contain 0 objects in the "transaction_splits" table
+
+  @failure @validation
+  Scenario: Cannot split a transaction into lines that do not add up to its amount
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Supermarket",
+        "amount": -100.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "PUT" request to "/api/v1/transactions/{{transaction_id}}/splits" with body:
+      """
+      {
+        "splits": [
+          {"amount": -70.00, "category_id": "{{category_id:Groceries}}"},
+          {"amount": -20.00, "category_id": "{{category_id:Pharmacy}}"}
+        ]
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010019"
+    And the db should contain 0 objects in the "transaction_splits" table
+
+  @failure @update
+  Scenario: Cannot change the amount of a split transaction directly
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Supermarket",
+        "amount": -100.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "PUT" request to "/api/v1/transactions/{{transaction_id}}/splits" with body:
+      """
+      {
+        "splits": [
+          {"amount": -70.00, "category_id": "{{category_id:Groceries}}"},
+          {"amount": -30.00, "category_id": "{{category_id:Pharmacy}}"}
+        ]
+      }
+      """
+    Then the response status should be 200
+    When I send a "PATCH" request to "/api/v1/transactions/{{transaction_id}}" with body:
+      """
+      {
+        "amount": -120.00
+      }
+      """
+    Then the response status should be 409
+    And the response field "code" should be "TXN-010020"
@@ -0,0 +1,176 @@
+# Finance Tracker - Bank Statement Import Feature
+
+@all @statement-import
+Feature: Bank Statement Import
+  As a user
+  I want to upload the OFX statement downloaded from my bank
+  So that its transactions are created and categorized without typing them
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And a category exists with name "Groceries" and type "expense"
+    When I send a "POST" request to "/api/v1/category-rules" with body:
+      """
+      {
+        "pattern": "MERCADO",
+        "category_id": "{{category_id:Groceries}}"
+      }
+      """
+    Then the response status should be 201
+
+  @success @ofx
+  Scenario: Import an OFX statement and categorize its transactions
+    When I upload a file "extrato.ofx" with content type "application/x-ofx" to "/api/v1/transactions/import/ofx" with content:
+      """
+      OFXHEADER:100
+      DATA:OFXSGML
+      VERSION:102
+      SECURITY:NONE
+      ENCODING:USASCII
+      CHARSET:1252
+      COMPRESSION:NONE
+      OLDFILEUID:NONE
+      NEWFILEUID:NONE
+
+      <OFX>
+      <BANKMSGSRSV1>
+      <STMTTRNRS>
+      <TRNUID>1
+      <STMTRS>
+      <CURDEF>BRL
+      <BANKACCTFROM>
+      <BANKID>0260
+      <ACCTID>12345-6
+      <ACCTTYPE>CHECKING
+      </BANKACCTFROM>
+      <BANKTRANLIST>
+      <DTSTART>20241001000000[-3:BRT]
+      <DTEND>20241031000000[-3:BRT]
+      <STMTTRN>
+      <TRNTYPE>DEBIT
+      <DTPOSTED>20241003000000[-3:BRT]
+      <TRNAMT>-150,00
+      <FITID>abc-001
+      <MEMO>MERCADO EXTRA
+      </STMTTRN>
+      <STMTTRN>
+      <TRNTYPE>CREDIT
+      <DTPOSTED>20241005
+      <TRNAMT>5000.00
+      <FITID>abc-002
+      <NAME>SALARIO
+      </STMTTRN>
+      <STMTTRN>
+      <TRNTYPE>DEBIT
+      <DTPOSTED>20241008
+      <TRNAMT>-12.50
+      <FITID>abc-003
+      <MEMO>PADARIA REAL
+      </STMTTRN>
+      </BANKTRANLIST>
+      </STMTRS>
+      </STMTTRNRS>
+      </BANKMSGSRSV1>
+      </OFX>
+      """
+    Then the response status should be 201
+    And the response field "statement.format" should be "ofx"
+    And the response field "statement.bank_id" should be "0260"
+    And the response field "statement.currency" should be "BRL"
+    And the response field "imported_count" should be "3"
+    And the response field "categorized_count" should be "1"
+    And the response field "transactions.0.description" should be "MERCADO EXTRA"
+    And the response field "transactions.0.date" should be "2024-10-03"
+    And the response field "transactions.0.amount" should be "-150"
+    And the response field "transactions.0.type" should be "expense"
+    And the response field "transactions.0.category.name" should be "Groceries"
+    And the response field "transactions.1.description" should be "SALARIO"
+    And the response field "transactions.1.type" should be "income"
+    And the response field "transactions.1.category" should not exist
+    And the response field "transactions.2.description" should be "PADARIA REAL"
+    And the response field "transactions.2.category" should not exist
+    And the db should contain 3 objects in the "transactions" table
+
+  @success @ofx
+  Scenario: Re-importing an OFX statement skips the transactions already imported
+    When I upload a file "extrato.ofx" with content type "application/x-ofx" to "/api/v1/transactions/import/ofx" with content:
+      """
+      <?xml version="1.0" encoding="UTF-8"?>
+      <?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+      <OFX>
+        <BANKMSGSRSV1>
+          <STMTTRNRS>
+            <TRNUID>1</TRNUID>
+            <STMTRS>
+              <CURDEF>BRL</CURDEF>
+              <BANKACCTFROM>
+                <BANKID>0260</BANKID>
+                <ACCTID>12345-6</ACCTID>
+                <ACCTTYPE>CHECKING</ACCTTYPE>
+              </BANKACCTFROM>
+              <BANKTRANLIST>
+                <STMTTRN>
+                  <TRNTYPE>DEBIT</TRNTYPE>
+                  <DTPOSTED>20241003</DTPOSTED>
+                  <TRNAMT>-150.00</TRNAMT>
+                  <FITID>abc-001</FITID>
+                  <NAME>MERCADO EXTRA</NAME>
+                </STMTTRN>
+              </BANKTRANLIST>
+            </STMTRS>
+          </STMTTRNRS>
+        </BANKMSGSRSV1>
+      </OFX>
+      """
+    Then the response status should be 201
+    And the response field "imported_count" should be "1"
+    When I upload a file "extrato.ofx" with content type "application/x-ofx" to "/api/v1/transactions/import/ofx" with content:
+      """
+      <?xml version="1.0" encoding="UTF-8"?>
+      <?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+      <OFX>
+        <BANKMSGSRSV1>
+          <STMTTRNRS>
+            <TRNUID>1</TRNUID>
+            <STMTRS>
+              <CURDEF>BRL</CURDEF>
+              <BANKACCTFROM>
+                <BANKID>0260</BANKID>
+                <ACCTID>12345-6</ACCTID>
+                <ACCTTYPE>CHECKING</ACCTTYPE>
+              </BANKACCTFROM>
+              <BANKTRANLIST>
+                <STMTTRN>
+                  <TRNTYPE>DEBIT</TRNTYPE>
+                  <DTPOSTED>20241003</DTPOSTED>
+                  <TRNAMT>-150.00</TRNAMT>
+                  <FITID>abc-001</FITID>
+                  <NAME>MERCADO EXTRA</NAME>
+                </STMTTRN>
+                <STMTTRN>
+                  <TRNTYPE>DEBIT</TRNTYPE>
+                  <DTPOSTED>20241010</DTPOSTED>
+                  <TRNAMT>-39.90</TRNAMT>
+                  <FITID>abc-004</FITID>
+                  <NAME>NETFLIX</NAME>
+                </STMTTRN>
+              </BANKTRANLIST>
+            </STMTRS>
+          </STMTTRNRS>
+        </BANKMSGSRSV1>
+      </OFX>
+      """
+    Then the response status should be 201
+    And the response field "imported_count" should be "1"
+    And the response field "skipped_count" should be "1"
+    And the response field "transactions.0.description" should be "NETFLIX"
+    And the response field "transactions.0.category" should not exist
+    And the db should contain 2 objects in the "transactions" table
+
+  @failure @ofx
+  Scenario: Cannot import a file that is not an OFX statement
+    When I upload a file "extrato.csv" with content type "text/csv" and content "Data,Valor" to "/api/v1/transactions/import/ofx"
+    Then the response status should be 400
+    And the response field "code" should be "TXN-040001"
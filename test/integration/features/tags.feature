@@ -0,0 +1,214 @@
+# Finance Tracker - Tags Feature
+
+@all @tags
+Feature: Tags
+  As a user
+  I want to label transactions with tags
+  So that I can track cross-cutting spending like a trip or reimbursable expenses
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+
+  @success @create
+  Scenario: Create, rename and delete a tag
+    When I send a "POST" request to "/api/v1/tags" with body:
+      """
+      {
+        "name": "vacation-2026",
+        "color": "#F59E0B"
+      }
+      """
+    Then the response status should be 201
+    And the response should be JSON
+    And the response field "name" should be "vacation-2026"
+    And the response field "color" should be "#F59E0B"
+    When I send a "PATCH" request to "/api/v1/tags/{{transaction_id}}" with body:
+      """
+      {
+        "name": "vacation-2027"
+      }
+      """
+    Then the response status should be 200
+    And the response field "name" should be "vacation-2027"
+    When I send a "GET" request to "/api/v1/tags"
+    Then the response status should be 200
+    And the response field "tags.0.name" should be "vacation-2027"
+    When I send a "DELETE" request to "/api/v1/tags/{{transaction_id}}"
+    Then the response status should be 204
+    When I send a "GET" request to "/api/v1/tags"
+    Then the response status should be 200
+    And the response field "tags" should be "[]"
+
+  @failure @create
+  Scenario: Cannot create a tag with a name that already exists
+    Given a tag exists with name "reimbursable"
+    When I send a "POST" request to "/api/v1/tags" with body:
+      """
+      {
+        "name": "Reimbursable"
+      }
+      """
+    Then the response status should be 409
+    And the response field "code" should be "TAG-010002"
+
+  @failure @validation
+  Scenario: Cannot create a tag with an invalid color
+    When I send a "POST" request to "/api/v1/tags" with body:
+      """
+      {
+        "name": "wedding",
+        "color": "orange"
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TAG-010004"
+
+  @success @bulk @list
+  Scenario: Bulk tag transactions and filter the list by tag
+    Given a tag exists with name "vacation-2026"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Hotel",
+        "amount": -400.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-16",
+        "description": "Supermarket",
+        "amount": -100.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/bulk-tag" with body:
+      """
+      {
+        "ids": ["{{transaction_id}}"],
+        "tag_ids": ["{{tag_id:vacation-2026}}"]
+      }
+      """
+    Then the response status should be 200
+    And the response field "updated_count" should be "1"
+    And the db should contain 1 objects in the "transaction_tags" table
+    When I send a "POST" request to "/api/v1/transactions/bulk-tag" with body:
+      """
+      {
+        "ids": {{transaction_ids}},
+        "tag_ids": ["{{tag_id:vacation-2026}}"]
+      }
+      """
+    Then the response status should be 200
+    And the response field "updated_count" should be "1"
+    And the db should contain 2 objects in the "transaction_tags" table
+    When I send a "GET" request to "/api/v1/transactions?tagIds={{tag_id:vacation-2026}}"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "2"
+    And the response field "transactions.0.tags.0.name" should be "vacation-2026"
+    And the response field "totals.expense_total" should be "-500"
+
+  @success @bulk @untag
+  Scenario: Bulk untag transactions
+    Given a tag exists with name "reimbursable"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Taxi",
+        "amount": -45.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/bulk-tag" with body:
+      """
+      {
+        "ids": ["{{transaction_id}}"],
+        "tag_ids": ["{{tag_id:reimbursable}}"]
+      }
+      """
+    Then the response status should be 200
+    When I send a "POST" request to "/api/v1/transactions/bulk-tag" with body:
+      """
+      {
+        "ids": ["{{transaction_id}}"],
+        "tag_ids": ["{{tag_id:reimbursable}}"],
+        "remove": true
+      }
+      """
+    Then the response status should be 200
+    And the response field "updated_count" should be "1"
+    And the db should contain 0 objects in the "transaction_tags" table
+
+  @failure @bulk
+  Scenario: Cannot bulk tag with a tag that does not exist
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Taxi",
+        "amount": -45.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/bulk-tag" with body:
+      """
+      {
+        "ids": ["{{transaction_id}}"],
+        "tag_ids": ["00000000-0000-0000-0000-000000000001"]
+      }
+      """
+    Then the response status should be 404
+    And the response field "code" should be "TXN-010021"
+
+  @success @dashboard
+  Scenario: Tag breakdown and period transactions filtered by tag
+    Given a tag exists with name "vacation-2026"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-10",
+        "description": "Groceries",
+        "amount": -100.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-15",
+        "description": "Hotel",
+        "amount": -300.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/bulk-tag" with body:
+      """
+      {
+        "ids": ["{{transaction_id}}"],
+        "tag_ids": ["{{tag_id:vacation-2026}}"]
+      }
+      """
+    Then the response status should be 200
+    When I send a "GET" request to "/api/v1/dashboard/tag-breakdown?start_date=2024-11-01&end_date=2024-11-30"
+    Then the response status should be 200
+    And the response field "data.total_expenses" should be "400"
+    And the response field "data.tags.0.tag_name" should be "vacation-2026"
+    And the response field "data.tags.0.amount" should be "300"
+    And the response field "data.tags.0.percentage" should be "75"
+    And the response field "data.tags.0.transaction_count" should be "1"
+    When I send a "GET" request to "/api/v1/dashboard/period-transactions?start_date=2024-11-01&end_date=2024-11-30&tag_ids={{tag_id:vacation-2026}}"
+    Then the response status should be 200
+    And the response field "data.summary.total_expenses" should be "300"
+    And the response field "data.pagination.total" should be "1"
+    And the response field "data.transactions.0.description" should be "Hotel"
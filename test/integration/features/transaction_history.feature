@@ -0,0 +1,304 @@
+# Finance Tracker - Transaction History Feature
+
+@all @history
+Feature: Transaction Change History
+  As a user whose transactions are changed by rules, AI suggestions and bulk edits
+  I want to see what changed on each transaction and undo it
+  So that a mistaken batch change can be reverted safely
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-05",
+        "description": "Coffee shop",
+        "amount": -12.50,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+
+  @success
+  Scenario: Creating and updating a transaction records its history
+    When I send a "PATCH" request to "/api/v1/transactions/{{transaction_id}}" with body:
+      """
+      {
+        "description": "Coffee beans",
+        "amount": -15.00
+      }
+      """
+    Then the response status should be 200
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    And the response field "changes.0.action" should be "update"
+    And the response field "changes.0.source" should be "manual"
+    And the response field "changes.0.revertable" should be "true"
+    And the response field "changes.0.fields.0.field" should be "description"
+    And the response field "changes.0.fields.0.before" should be "Coffee shop"
+    And the response field "changes.0.fields.0.after" should be "Coffee beans"
+    And the response field "changes.0.fields.1.field" should be "amount"
+    And the response field "changes.0.fields.1.before" should be "-12.5"
+    And the response field "changes.0.fields.1.after" should be "-15"
+    And the response field "changes.1.action" should be "create"
+    And the response field "changes.1.revertable" should be "false"
+    And the db should contain 2 objects in the "transaction_changes" table
+
+  @success
+  Scenario: Reverting a change restores the previous values
+    When I send a "PATCH" request to "/api/v1/transactions/{{transaction_id}}" with body:
+      """
+      {
+        "description": "Coffee beans"
+      }
+      """
+    Then the response status should be 200
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    When I send a "POST" request to "/api/v1/transactions/changes/{{change_id}}/revert"
+    Then the response status should be 200
+    And the response field "source" should be "revert"
+    And the response field "fields.0.before" should be "Coffee beans"
+    And the response field "fields.0.after" should be "Coffee shop"
+    When I send a "GET" request to "/api/v1/transactions"
+    Then the response status should be 200
+    And the response field "transactions.0.description" should be "Coffee shop"
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    And the response field "changes.0.source" should be "revert"
+    And the response field "changes.1.reverted_at" should exist
+    And the response field "changes.1.revertable" should be "false"
+
+  @success
+  Scenario: A revert can itself be reverted
+    When I send a "PATCH" request to "/api/v1/transactions/{{transaction_id}}" with body:
+      """
+      {
+        "description": "Coffee beans"
+      }
+      """
+    Then the response status should be 200
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    When I send a "POST" request to "/api/v1/transactions/changes/{{change_id}}/revert"
+    Then the response status should be 200
+    When I send a "POST" request to "/api/v1/transactions/changes/{{change_id}}/revert"
+    Then the response status should be 200
+    And the response field "fields.0.after" should be "Coffee beans"
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    And the response field "changes.0.reverted_at" should not exist
+    And the response field "changes.1.reverted_at" should exist
+    And the response field "changes.2.reverted_at" should exist
+
+  @failure
+  Scenario: A change overwritten by a later change cannot be reverted
+    When I send a "PATCH" request to "/api/v1/transactions/{{transaction_id}}" with body:
+      """
+      {
+        "description": "Coffee beans"
+      }
+      """
+    Then the response status should be 200
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    When I send a "PATCH" request to "/api/v1/transactions/{{transaction_id}}" with body:
+      """
+      {
+        "description": "Coffee grinder"
+      }
+      """
+    Then the response status should be 200
+    When I send a "POST" request to "/api/v1/transactions/changes/{{change_id}}/revert"
+    Then the response status should be 409
+    And the response field "code" should be "TXN-010029"
+
+  @failure
+  Scenario: Creating a transaction is not revertable
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    When I send a "POST" request to "/api/v1/transactions/changes/{{change_id}}/revert"
+    Then the response status should be 409
+    And the response field "code" should be "TXN-010028"
+
+  @success
+  Scenario: Reverting a bulk categorize reverts every transaction of the operation
+    Given a category exists with name "Food" and type "expense"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-06",
+        "description": "Bakery",
+        "amount": -8.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/bulk-categorize" with body:
+      """
+      {
+        "ids": {{transaction_ids}},
+        "category_id": "{{category_id}}"
+      }
+      """
+    Then the response status should be 200
+    And the response field "updated_count" should be "2"
+    And the response field "operation_id" should exist
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    And the response field "changes.0.source" should be "bulk"
+    And the response field "changes.0.fields.0.field" should be "category_id"
+    When I send a "POST" request to "/api/v1/transactions/operations/{{operation_id}}/revert"
+    Then the response status should be 200
+    And the response field "reverted_count" should be "2"
+    And the response field "skipped_count" should be "0"
+    When I send a "GET" request to "/api/v1/transactions?uncategorized=true"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "2"
+
+  @success
+  Scenario: Reverting an operation skips transactions changed since
+    Given a category exists with name "Food" and type "expense"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-06",
+        "description": "Bakery",
+        "amount": -8.00,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions/bulk-categorize" with body:
+      """
+      {
+        "ids": {{transaction_ids}},
+        "category_id": "{{category_id}}"
+      }
+      """
+    Then the response status should be 200
+    When I send a "PATCH" request to "/api/v1/transactions/{{transaction_id}}" with body:
+      """
+      {
+        "clear_category": true
+      }
+      """
+    Then the response status should be 200
+    When I send a "POST" request to "/api/v1/transactions/operations/{{operation_id}}/revert"
+    Then the response status should be 200
+    And the response field "reverted_count" should be "1"
+    And the response field "skipped_count" should be "1"
+
+  @success
+  Scenario: Splitting a transaction records a change that cannot be reverted
+    Given a category exists with name "Food" and type "expense"
+    And a category exists with name "Snacks" and type "expense"
+    When I send a "PUT" request to "/api/v1/transactions/{{transaction_id}}/splits" with body:
+      """
+      {
+        "splits": [
+          {"amount": -10.00, "category_id": "{{category_id:Food}}"},
+          {"amount": -2.50, "category_id": "{{category_id:Snacks}}"}
+        ]
+      }
+      """
+    Then the response status should be 200
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    And the response field "changes.0.action" should be "update"
+    And the response field "changes.0.source" should be "manual"
+    And the response field "changes.0.revertable" should be "false"
+    And the db should contain 2 objects in the "transaction_changes" table
+
+  @success
+  Scenario: The history of a deleted transaction remains available
+    When I send a "DELETE" request to "/api/v1/transactions/{{transaction_id}}"
+    Then the response status should be 204
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    And the response field "changes.0.action" should be "delete"
+    And the response field "changes.0.fields.0.before" should be "2024-11-05"
+    And the response field "changes.0.fields.0.after" should not exist
+
+  @success
+  Scenario: Deleting a category records the cleared category on its transactions
+    Given a category exists with name "Food" and type "expense"
+    When I send a "PATCH" request to "/api/v1/transactions/{{transaction_id}}" with body:
+      """
+      {
+        "category_id": "{{category_id:Food}}"
+      }
+      """
+    Then the response status should be 200
+    When I send a "DELETE" request to "/api/v1/categories/{{category_id:Food}}"
+    Then the response status should be 204
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    And the response field "changes.0.action" should be "update"
+    And the response field "changes.0.fields.0.field" should be "category_id"
+    And the response field "changes.0.fields.0.before" should be "{{category_id:Food}}"
+    And the response field "changes.0.fields.0.after" should not exist
+
+  @success
+  Scenario: Deleting an account records the detached transactions
+    Given an account exists with name "Checking" and type "checking"
+    When I send a "PATCH" request to "/api/v1/transactions/{{transaction_id}}" with body:
+      """
+      {
+        "account_id": "{{account_id:Checking}}"
+      }
+      """
+    Then the response status should be 200
+    When I send a "DELETE" request to "/api/v1/accounts/{{account_id:Checking}}"
+    Then the response status should be 204
+    When I send a "GET" request to "/api/v1/transactions/{{transaction_id}}/history"
+    Then the response status should be 200
+    And the response field "changes.0.action" should be "update"
+    And the response field "changes.0.fields.0.field" should be "account_id"
+    And the response field "changes.0.fields.0.before" should be "{{account_id:Checking}}"
+    And the response field "changes.0.fields.0.after" should not exist
+
+  @success
+  Scenario: Creating and deleting a transfer records the history of both legs
+    Given an account exists with name "Checking" and type "checking"
+    And an account exists with name "Savings" and type "savings"
+    When I send a "POST" request to "/api/v1/transfers" with body:
+      """
+      {
+        "from_account_id": "{{account_id:Checking}}",
+        "to_account_id": "{{account_id:Savings}}",
+        "date": "2024-11-06",
+        "description": "Monthly savings",
+        "amount": 500.00
+      }
+      """
+    Then the response status should be 201
+    And the db should contain 3 objects in "transaction_changes" with the values
+      """
+      {"action": "create"}
+      """
+    When I send a "DELETE" request to "/api/v1/transfers/{{transaction_id}}"
+    Then the response status should be 204
+    And the db should contain 2 objects in "transaction_changes" with the values
+      """
+      {"action": "delete"}
+      """
+
+  @failure
+  Scenario: History of an unknown transaction is not found
+    When I send a "GET" request to "/api/v1/transactions/00000000-0000-0000-0000-000000000001/history"
+    Then the response status should be 404
+    And the response field "code" should be "TXN-010004"
+
+  @failure
+  Scenario: Reverting an unknown operation is not found
+    When I send a "POST" request to "/api/v1/transactions/operations/00000000-0000-0000-0000-000000000001/revert"
+    Then the response status should be 404
+    And the response field "code" should be "TXN-010027"
+
+  @failure
+  Scenario: Reverting with an invalid change ID fails
+    When I send a "POST" request to "/api/v1/transactions/changes/not-a-uuid/revert"
+    Then the response status should be 400
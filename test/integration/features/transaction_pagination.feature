@@ -0,0 +1,119 @@
+# Finance Tracker - Transaction Pagination and Sorting Feature
+
+@all @pagination
+Feature: Transaction Pagination and Sorting
+  As a user with a long transaction history
+  I want to page through transactions with cursors and sort them
+  So that listing stays fast and consistent while new transactions are imported
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And a category exists with name "Groceries" and type "expense"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-05",
+        "description": "Bakery",
+        "amount": -12.50,
+        "type": "expense"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-05",
+        "description": "Supermarket",
+        "amount": -250.00,
+        "type": "expense",
+        "category_id": "{{category_id:Groceries}}"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-20",
+        "description": "Freelance",
+        "amount": 80.00,
+        "type": "income"
+      }
+      """
+    Then the response status should be 201
+
+  @success @cursor
+  Scenario: Page through transactions with a cursor
+    When I send a "GET" request to "/api/v1/transactions?limit=2"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "3"
+    And the response field "transactions.0.description" should be "Freelance"
+    And the response field "transactions.1.description" should be "Supermarket"
+    And the response field "pagination.next_cursor" should exist
+    When I send a "GET" request to "/api/v1/transactions?limit=2&cursor={{next_cursor}}"
+    Then the response status should be 200
+    And the response field "transactions.0.description" should be "Bakery"
+    And the response field "transactions.1" should not exist
+    And the response field "pagination.next_cursor" should not exist
+
+  @success @sort
+  Scenario: Sort transactions by amount, description and category
+    When I send a "GET" request to "/api/v1/transactions?sortBy=amount"
+    Then the response status should be 200
+    And the response field "transactions.0.description" should be "Supermarket"
+    And the response field "transactions.1.description" should be "Freelance"
+    And the response field "transactions.2.description" should be "Bakery"
+    When I send a "GET" request to "/api/v1/transactions?sortBy=description&limit=1"
+    Then the response status should be 200
+    And the response field "transactions.0.description" should be "Bakery"
+    When I send a "GET" request to "/api/v1/transactions?limit=1&cursor={{next_cursor}}"
+    Then the response status should be 200
+    And the response field "transactions.0.description" should be "Freelance"
+    When I send a "GET" request to "/api/v1/transactions?sortBy=category&sortOrder=desc"
+    Then the response status should be 200
+    And the response field "transactions.0.description" should be "Supermarket"
+
+  @success @filter
+  Scenario: Filter transactions by amount range and uncategorized
+    When I send a "GET" request to "/api/v1/transactions?minAmount=50&maxAmount=100"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "1"
+    And the response field "transactions.0.description" should be "Freelance"
+    When I send a "GET" request to "/api/v1/transactions?uncategorized=true&type=expense"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "1"
+    And the response field "transactions.0.description" should be "Bakery"
+    And the response field "totals.expense_total" should be "-12.5"
+
+  @success @dashboard
+  Scenario: Page through period transactions sorted by amount
+    When I send a "GET" request to "/api/v1/dashboard/period-transactions?start_date=2024-11-01&end_date=2024-11-30&sort_by=amount&sort_order=asc&limit=2"
+    Then the response status should be 200
+    And the response field "data.transactions.0.description" should be "Bakery"
+    And the response field "data.transactions.1.description" should be "Freelance"
+    And the response field "data.pagination.has_more" should be "true"
+    When I send a "GET" request to "/api/v1/dashboard/period-transactions?start_date=2024-11-01&end_date=2024-11-30&limit=2&cursor={{next_cursor}}"
+    Then the response status should be 200
+    And the response field "data.transactions.0.description" should be "Supermarket"
+    And the response field "data.pagination.has_more" should be "false"
+
+  @failure @validation
+  Scenario: Cannot list transactions with an invalid sort, cursor or amount range
+    When I send a "GET" request to "/api/v1/transactions?sortBy=payee"
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010024"
+    When I send a "GET" request to "/api/v1/transactions?cursor=not-a-cursor"
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010025"
+    When I send a "GET" request to "/api/v1/transactions?limit=1"
+    Then the response status should be 200
+    When I send a "GET" request to "/api/v1/transactions?sortBy=amount&cursor={{next_cursor}}"
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010025"
+    When I send a "GET" request to "/api/v1/transactions?minAmount=100&maxAmount=50"
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010026"
+    When I send a "GET" request to "/api/v1/dashboard/period-transactions?start_date=2024-11-01&end_date=2024-11-30&sort_order=sideways"
+    Then the response status should be 400
+    And the response field "code" should be "DSH-010007"
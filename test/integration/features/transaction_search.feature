@@ -0,0 +1,106 @@
+# Finance Tracker - Transaction Search Feature
+
+@all @search
+Feature: Transaction Search
+  As a user
+  I want to search my transactions with words, phrases and filters
+  So that I can find a transaction without scrolling through months of history
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And a category exists with name "Mercado" and type "expense"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-05",
+        "description": "Supermercado Extra",
+        "amount": -250.00,
+        "type": "expense",
+        "category_id": "{{category_id:Mercado}}",
+        "notes": "compras do mês"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-11-10",
+        "description": "Uber trip",
+        "amount": -32.90,
+        "type": "expense",
+        "notes": "viagem de férias"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-12-02",
+        "description": "Padaria",
+        "amount": -15.00,
+        "type": "expense",
+        "notes": "café com pão"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2025-12-05",
+        "description": "Salary",
+        "amount": 5000.00,
+        "type": "income"
+      }
+      """
+    Then the response status should be 201
+
+  @success @text
+  Scenario: Search words in descriptions and notes
+    When I send a "GET" request to "/api/v1/transactions?search=uber"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "1"
+    And the response field "transactions.0.description" should be "Uber trip"
+    When I send a "GET" request to "/api/v1/transactions?search=compras"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "1"
+    And the response field "transactions.0.description" should be "Supermercado Extra"
+
+  @success @text
+  Scenario: Search a phrase in the notes
+    When I send a "GET" request to "/api/v1/transactions?search=notes%3A%22viagem%20de%20f%C3%A9rias%22"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "1"
+    And the response field "transactions.0.description" should be "Uber trip"
+    When I send a "GET" request to "/api/v1/transactions?search=description%3Aviagem"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "0"
+
+  @success @filter
+  Scenario: Exclude words and filter by type
+    When I send a "GET" request to "/api/v1/transactions?search=-uber%20type%3Aexpense"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "2"
+    And the response field "totals.expense_total" should be "-265"
+
+  @success @filter
+  Scenario: Filter by amount, category and date
+    When I send a "GET" request to "/api/v1/transactions?search=amount%3A%3E100"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "2"
+    When I send a "GET" request to "/api/v1/transactions?search=category%3Amercado"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "1"
+    And the response field "transactions.0.description" should be "Supermercado Extra"
+    When I send a "GET" request to "/api/v1/transactions?search=date%3A2025-11%20amount%3A10..50"
+    Then the response status should be 200
+    And the response field "pagination.total" should be "1"
+    And the response field "transactions.0.description" should be "Uber trip"
+
+  @failure @validation
+  Scenario: Cannot search with an invalid query
+    When I send a "GET" request to "/api/v1/transactions?search=notes%3A%22viagem"
+    Then the response status should be 400
+    And the response field "code" should be "TXN-010023"
+    And the response field "error" should be "invalid search query: unterminated quote at position 7"
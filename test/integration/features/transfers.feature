@@ -0,0 +1,255 @@
+# Finance Tracker - Transfers Feature
+
+@all @transfers
+Feature: Transfers Between Accounts
+  As a user
+  I want to record money moved between my own accounts
+  So that it does not count as income or expense
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And an account exists with name "Checking" and type "checking"
+    And an account exists with name "Savings" and type "savings"
+
+  @success @create
+  Scenario: Create transfer between accounts
+    When I send a "POST" request to "/api/v1/transfers" with body:
+      """
+      {
+        "from_account_id": "{{account_id:Checking}}",
+        "to_account_id": "{{account_id:Savings}}",
+        "date": "2024-11-20",
+        "description": "Monthly savings",
+        "amount": 500.00
+      }
+      """
+    Then the response status should be 201
+    And the response should be JSON
+    And the response field "amount" should be "500"
+    And the response field "from_account_id" should be "{{account_id:Checking}}"
+    And the response field "to_account_id" should be "{{account_id:Savings}}"
+    And the response field "from_transaction_id" should exist
+    And the db should contain 2 objects in the "transactions" table
+
+  @success @dashboard
+  Scenario: Transfers are not counted as income or expense
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-18",
+        "description": "Groceries",
+        "amount": -150.00,
+        "type": "expense",
+        "account_id": "{{account_id:Checking}}"
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transfers" with body:
+      """
+      {
+        "from_account_id": "{{account_id:Checking}}",
+        "to_account_id": "{{account_id:Savings}}",
+        "date": "2024-11-20",
+        "description": "Monthly savings",
+        "amount": 500.00
+      }
+      """
+    Then the response status should be 201
+    When I send a "GET" request to "/api/v1/dashboard/period-transactions?start_date=2024-11-01&end_date=2024-11-30"
+    Then the response status should be 200
+    And the response field "data.summary.total_income" should be "0"
+    And the response field "data.summary.total_expenses" should be "150"
+    And the response field "data.summary.transaction_count" should be "1"
+    When I send a "GET" request to "/api/v1/accounts/{{account_id:Savings}}"
+    Then the response status should be 200
+    And the response field "current_balance" should be "500"
+
+  @failure @create
+  Scenario: Cannot transfer between accounts in different currencies
+    When I send a "PATCH" request to "/api/v1/accounts/{{account_id:Savings}}" with body:
+      """
+      {
+        "currency": "USD"
+      }
+      """
+    Then the response status should be 200
+    When I send a "POST" request to "/api/v1/transfers" with body:
+      """
+      {
+        "from_account_id": "{{account_id:Checking}}",
+        "to_account_id": "{{account_id:Savings}}",
+        "date": "2024-11-20",
+        "description": "Monthly savings",
+        "amount": 500.00
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TRF-010012"
+    And the db should contain 0 objects in the "transactions" table
+
+  @failure @create @validation
+  Scenario: Cannot transfer to the same account
+    When I send a "POST" request to "/api/v1/transfers" with body:
+      """
+      {
+        "from_account_id": "{{account_id:Checking}}",
+        "to_account_id": "{{account_id:Checking}}",
+        "date": "2024-11-20",
+        "description": "Loop",
+        "amount": 100.00
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TRF-010005"
+
+  @failure @create @validation
+  Scenario: Cannot transfer a negative amount
+    When I send a "POST" request to "/api/v1/transfers" with body:
+      """
+      {
+        "from_account_id": "{{account_id:Checking}}",
+        "to_account_id": "{{account_id:Savings}}",
+        "date": "2024-11-20",
+        "description": "Monthly savings",
+        "amount": -100.00
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TRF-010006"
+
+  @success @update
+  Scenario: Update transfer amount changes both legs
+    When I send a "POST" request to "/api/v1/transfers" with body:
+      """
+      {
+        "from_account_id": "{{account_id:Checking}}",
+        "to_account_id": "{{account_id:Savings}}",
+        "date": "2024-11-20",
+        "description": "Monthly savings",
+        "amount": 500.00
+      }
+      """
+    Then the response status should be 201
+    When I send a "PATCH" request to "/api/v1/transfers/{{transaction_id}}" with body:
+      """
+      {
+        "amount": 750.00
+      }
+      """
+    Then the response status should be 200
+    And the response field "amount" should be "750"
+    When I send a "GET" request to "/api/v1/accounts/{{account_id:Checking}}"
+    Then the response field "current_balance" should be "-750"
+
+  @failure @update
+  Scenario: Transfer legs cannot be edited as regular transactions
+    When I send a "POST" request to "/api/v1/transfers" with body:
+      """
+      {
+        "from_account_id": "{{account_id:Checking}}",
+        "to_account_id": "{{account_id:Savings}}",
+        "date": "2024-11-20",
+        "description": "Monthly savings",
+        "amount": 500.00
+      }
+      """
+    Then the response status should be 201
+    When I send a "PATCH" request to "/api/v1/transactions/{{transfer_leg_id}}" with body:
+      """
+      {
+        "amount": -100.00
+      }
+      """
+    Then the response status should be 409
+    And the response field "code" should be "TXN-010015"
+
+  @success @delete
+  Scenario: Deleting a transfer leg deletes the whole transfer
+    When I send a "POST" request to "/api/v1/transfers" with body:
+      """
+      {
+        "from_account_id": "{{account_id:Checking}}",
+        "to_account_id": "{{account_id:Savings}}",
+        "date": "2024-11-20",
+        "description": "Monthly savings",
+        "amount": 500.00
+      }
+      """
+    Then the response status should be 201
+    When I send a "DELETE" request to "/api/v1/transactions/{{transfer_leg_id}}"
+    Then the response status should be 204
+    When I send a "GET" request to "/api/v1/transfers/{{transaction_id}}"
+    Then the response status should be 404
+
+  @success @delete
+  Scenario: Delete transfer
+    When I send a "POST" request to "/api/v1/transfers" with body:
+      """
+      {
+        "from_account_id": "{{account_id:Checking}}",
+        "to_account_id": "{{account_id:Savings}}",
+        "date": "2024-11-20",
+        "description": "Monthly savings",
+        "amount": 500.00
+      }
+      """
+    Then the response status should be 201
+    When I send a "DELETE" request to "/api/v1/transfers/{{transaction_id}}"
+    Then the response status should be 204
+    When I send a "GET" request to "/api/v1/transfers/{{transaction_id}}"
+    Then the response status should be 404
+    And the response field "code" should be "TRF-010001"
+
+  @success @convert
+  Scenario: Convert a credit card bill payment into a transfer
+    Given an account exists with name "Visa" and type "credit_card"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-10",
+        "description": "Pagamento de fatura",
+        "amount": -1200.00,
+        "type": "expense",
+        "account_id": "{{account_id:Checking}}",
+        "is_credit_card_payment": true
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transfers/convert" with body:
+      """
+      {
+        "transaction_id": "{{transaction_id}}",
+        "counter_account_id": "{{account_id:Visa}}"
+      }
+      """
+    Then the response status should be 200
+    And the response field "amount" should be "1200"
+    And the response field "from_account_id" should be "{{account_id:Checking}}"
+    And the response field "to_account_id" should be "{{account_id:Visa}}"
+    And the db should contain 2 objects in the "transactions" table
+
+  @failure @convert
+  Scenario: Bill payments can only become transfers to a credit card
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-10",
+        "description": "Pagamento de fatura",
+        "amount": -1200.00,
+        "type": "expense",
+        "account_id": "{{account_id:Checking}}",
+        "is_credit_card_payment": true
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/transfers/convert" with body:
+      """
+      {
+        "transaction_id": "{{transaction_id}}",
+        "counter_account_id": "{{account_id:Savings}}"
+      }
+      """
+    Then the response status should be 409
+    And the response field "code" should be "TRF-010010"
@@ -0,0 +1,245 @@
+# Finance Tracker - Trash Feature
+
+@all @trash
+Feature: Trash
+  As a user who deleted something by mistake
+  I want deleted transactions, categories, goals and rules to stay in a trash for a while
+  So that I can restore them before they are permanently deleted
+
+  Background:
+    Given the API server is running
+    And a user exists with email "test@example.com" and password "SecurePass123!"
+    And the user is logged in with valid tokens
+    And a category exists with name "Food" and type "expense"
+    When I send a "POST" request to "/api/v1/transactions" with body:
+      """
+      {
+        "date": "2024-11-05",
+        "description": "Grocery store",
+        "amount": -84.20,
+        "type": "expense",
+        "category_id": "{{category_id:Food}}"
+      }
+      """
+    Then the response status should be 201
+
+  @success @list
+  Scenario: Deleted items are listed in the trash
+    When I send a "DELETE" request to "/api/v1/transactions/{{transaction_id}}"
+    Then the response status should be 204
+    When I send a "DELETE" request to "/api/v1/categories/{{category_id:Food}}"
+    Then the response status should be 204
+    When I send a "GET" request to "/api/v1/trash"
+    Then the response status should be 200
+    And the response field "total" should be "2"
+    And the response field "retention_days" should be "30"
+    And the response field "items.0.type" should be "category"
+    And the response field "items.0.name" should be "Food"
+    And the response field "items.1.type" should be "transaction"
+    And the response field "items.1.name" should be "Grocery store"
+    And the response field "items.1.expires_at" should exist
+
+  @success @list
+  Scenario: The trash can be filtered by item type
+    When I send a "DELETE" request to "/api/v1/transactions/{{transaction_id}}"
+    Then the response status should be 204
+    When I send a "DELETE" request to "/api/v1/categories/{{category_id:Food}}"
+    Then the response status should be 204
+    When I send a "GET" request to "/api/v1/trash?type=transaction"
+    Then the response status should be 200
+    And the response field "total" should be "1"
+    And the response field "items.0.type" should be "transaction"
+
+  @error @list
+  Scenario: Cannot filter the trash by an unknown item type
+    When I send a "GET" request to "/api/v1/trash?type=account"
+    Then the response status should be 400
+    And the response field "code" should be "TRS-010002"
+
+  @success @restore
+  Scenario: Restore a deleted transaction
+    When I send a "DELETE" request to "/api/v1/transactions/{{transaction_id}}"
+    Then the response status should be 204
+    When I send a "POST" request to "/api/v1/trash/transaction/{{transaction_id}}/restore" with body:
+      """
+      {}
+      """
+    Then the response status should be 200
+    And the response field "restored.0.name" should be "Grocery store"
+    When I send a "GET" request to "/api/v1/transactions"
+    Then the response status should be 200
+    And the response field "transactions.0.description" should be "Grocery store"
+    And the response field "transactions.0.category.name" should be "Food"
+    When I send a "GET" request to "/api/v1/trash"
+    Then the response field "total" should be "0"
+
+  @error @restore
+  Scenario: Cannot restore a transaction whose category is also in the trash without choosing what to do
+    When I send a "DELETE" request to "/api/v1/transactions/{{transaction_id}}"
+    Then the response status should be 204
+    When I send a "DELETE" request to "/api/v1/categories/{{category_id:Food}}"
+    Then the response status should be 204
+    When I send a "POST" request to "/api/v1/trash/transaction/{{transaction_id}}/restore" with body:
+      """
+      {}
+      """
+    Then the response status should be 409
+    And the response field "code" should be "TRS-010005"
+    When I send a "GET" request to "/api/v1/trash"
+    Then the response field "total" should be "2"
+
+  @success @restore
+  Scenario: Restore a transaction together with its deleted category
+    When I send a "DELETE" request to "/api/v1/transactions/{{transaction_id}}"
+    Then the response status should be 204
+    When I send a "DELETE" request to "/api/v1/categories/{{category_id:Food}}"
+    Then the response status should be 204
+    When I send a "POST" request to "/api/v1/trash/transaction/{{transaction_id}}/restore?references=restore" with body:
+      """
+      {}
+      """
+    Then the response status should be 200
+    And the response field "restored.0.type" should be "transaction"
+    And the response field "restored.1.type" should be "category"
+    And the response field "restored.1.name" should be "Food"
+    When I send a "GET" request to "/api/v1/transactions"
+    Then the response status should be 200
+    And the response field "transactions.0.description" should be "Grocery store"
+    And the response field "transactions.0.category.name" should be "Food"
+
+  @success @restore
+  Scenario: Restore a transaction without its deleted category
+    When I send a "DELETE" request to "/api/v1/transactions/{{transaction_id}}"
+    Then the response status should be 204
+    When I send a "DELETE" request to "/api/v1/categories/{{category_id:Food}}"
+    Then the response status should be 204
+    When I send a "POST" request to "/api/v1/trash/transaction/{{transaction_id}}/restore?references=detach" with body:
+      """
+      {}
+      """
+    Then the response status should be 200
+    And the response field "detached_category_ids.0" should exist
+    When I send a "GET" request to "/api/v1/transactions"
+    Then the response status should be 200
+    And the response field "transactions.0.description" should be "Grocery store"
+    And the response field "transactions.0.category_id" should not exist
+    When I send a "GET" request to "/api/v1/trash?type=category"
+    Then the response field "total" should be "1"
+
+  @success @restore
+  Scenario: Restore several items at once
+    When I send a "DELETE" request to "/api/v1/transactions/{{transaction_id}}"
+    Then the response status should be 204
+    When I send a "DELETE" request to "/api/v1/categories/{{category_id:Food}}"
+    Then the response status should be 204
+    When I send a "POST" request to "/api/v1/trash/restore" with body:
+      """
+      {
+        "items": [
+          {"type": "transaction", "id": "{{transaction_id}}"},
+          {"type": "category", "id": "{{category_id:Food}}"}
+        ]
+      }
+      """
+    Then the response status should be 200
+    And the response field "restored.1.name" should be "Food"
+    When I send a "GET" request to "/api/v1/trash"
+    Then the response field "total" should be "0"
+
+  @error @restore
+  Scenario: Cannot restore without items
+    When I send a "POST" request to "/api/v1/trash/restore" with body:
+      """
+      {
+        "items": []
+      }
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TRS-010003"
+
+  @error @restore
+  Scenario: Cannot restore an item that is not in the trash
+    When I send a "POST" request to "/api/v1/trash/transaction/{{transaction_id}}/restore" with body:
+      """
+      {}
+      """
+    Then the response status should be 404
+    And the response field "code" should be "TRS-010001"
+
+  @error @restore
+  Scenario: Cannot restore a goal for a category that has an active goal
+    Given a goal exists for category "Food" with limit "500.00"
+    When I send a "DELETE" request to "/api/v1/goals/{{goal_id}}"
+    Then the response status should be 204
+    When I send a "POST" request to "/api/v1/goals" with body:
+      """
+      {
+        "category_id": "{{category_id:Food}}",
+        "limit_amount": 300.00
+      }
+      """
+    Then the response status should be 201
+    When I send a "POST" request to "/api/v1/trash/goal/{{goal_id}}/restore" with body:
+      """
+      {}
+      """
+    Then the response status should be 409
+    And the response field "code" should be "TRS-010006"
+
+  @error @restore
+  Scenario: Cannot restore a goal without its deleted category
+    Given a goal exists for category "Food" with limit "500.00"
+    When I send a "DELETE" request to "/api/v1/goals/{{goal_id}}"
+    Then the response status should be 204
+    When I send a "DELETE" request to "/api/v1/categories/{{category_id:Food}}"
+    Then the response status should be 204
+    When I send a "POST" request to "/api/v1/trash/goal/{{goal_id}}/restore?references=detach" with body:
+      """
+      {}
+      """
+    Then the response status should be 409
+    And the response field "code" should be "TRS-010005"
+
+  @error @restore
+  Scenario: Cannot restore with an unknown references mode
+    When I send a "DELETE" request to "/api/v1/transactions/{{transaction_id}}"
+    Then the response status should be 204
+    When I send a "POST" request to "/api/v1/trash/transaction/{{transaction_id}}/restore?references=ignore" with body:
+      """
+      {}
+      """
+    Then the response status should be 400
+    And the response field "code" should be "TRS-010004"
+
+  @success @retention
+  Scenario: Items are permanently deleted after the retention period
+    When I send a "DELETE" request to "/api/v1/transactions/{{transaction_id}}"
+    Then the response status should be 204
+    When the trash retention job runs with a retention of 30 days
+    Then the db should contain 1 objects in the "transactions" table
+    When the trash retention job runs with a retention of 0 days
+    Then the db should contain 0 objects in the "transactions" table
+    And the db should contain 1 objects in the "categories" table
+
+  @success @retention
+  Scenario: Categories still used by a live goal or rule are not purged
+    Given a goal exists for category "Food" with limit "500"
+    And a category exists with name "Transport" and type "expense"
+    When I send a "POST" request to "/api/v1/category-rules" with body:
+      """
+      {
+        "pattern": "UBER",
+        "category_id": "{{category_id:Transport}}"
+      }
+      """
+    Then the response status should be 201
+    When I send a "DELETE" request to "/api/v1/categories/{{category_id:Food}}"
+    Then the response status should be 204
+    When I send a "DELETE" request to "/api/v1/categories/{{category_id:Transport}}"
+    Then the response status should be 204
+    When the trash retention job runs with a retention of 0 days
+    Then the db should contain 2 objects in the "categories" table
+    And the db should contain 1 objects in the "goals" table
+    And the db should contain 1 objects in the "category_rules" table
+    When I send a "GET" request to "/api/v1/goals/{{goal_id}}"
+    Then the response status should be 200
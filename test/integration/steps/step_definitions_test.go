@@ -7,11 +7,18 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"image"
+	"image/color"
+	"image/png"
 	"io"
+	"mime/multipart"
 	"net"
 	"net/http"
+	"net/textproto"
 	"os"
+	"path/filepath"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
@@ -27,13 +34,24 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/finance-tracker/backend/internal/application/adapter"
+	"github.com/finance-tracker/backend/internal/application/usecase/account"
+	"github.com/finance-tracker/backend/internal/application/usecase/attachment"
 	"github.com/finance-tracker/backend/internal/application/usecase/auth"
 	"github.com/finance-tracker/backend/internal/application/usecase/category"
 	categoryrule "github.com/finance-tracker/backend/internal/application/usecase/category_rule"
+	creditcard "github.com/finance-tracker/backend/internal/application/usecase/credit_card"
 	"github.com/finance-tracker/backend/internal/application/usecase/dashboard"
+	exchangerate "github.com/finance-tracker/backend/internal/application/usecase/exchange_rate"
 	"github.com/finance-tracker/backend/internal/application/usecase/goal"
 	"github.com/finance-tracker/backend/internal/application/usecase/group"
+	importprofile "github.com/finance-tracker/backend/internal/application/usecase/import_profile"
+	recurringschedule "github.com/finance-tracker/backend/internal/application/usecase/recurring_schedule"
+	"github.com/finance-tracker/backend/internal/application/usecase/installment"
+	"github.com/finance-tracker/backend/internal/application/usecase/merchant"
+	"github.com/finance-tracker/backend/internal/application/usecase/tag"
 	"github.com/finance-tracker/backend/internal/application/usecase/transaction"
+	"github.com/finance-tracker/backend/internal/application/usecase/transfer"
+	"github.com/finance-tracker/backend/internal/application/usecase/trash"
 	domainerror "github.com/finance-tracker/backend/internal/domain/error"
 	"github.com/finance-tracker/backend/internal/infra/server/router"
 	"github.com/finance-tracker/backend/internal/integration/adapters"
@@ -41,8 +59,11 @@ import (
 	"github.com/finance-tracker/backend/internal/integration/email/templates"
 	"github.com/finance-tracker/backend/internal/integration/entrypoint/controller"
 	"github.com/finance-tracker/backend/internal/integration/entrypoint/middleware"
+	"github.com/finance-tracker/backend/internal/integration/export"
 	"github.com/finance-tracker/backend/internal/integration/persistence"
 	"github.com/finance-tracker/backend/internal/integration/persistence/model"
+	"github.com/finance-tracker/backend/internal/integration/statement"
+	"github.com/finance-tracker/backend/internal/integration/storage"
 	"github.com/finance-tracker/backend/test/integration/mock"
 )
 
@@ -95,6 +116,19 @@ type testContext struct {
 	currentInviteToken string
 	transactionIDs     []uuid.UUID
 	lastTransactionID  uuid.UUID
+	accountIDs         map[string]uuid.UUID // Accounts created by setup steps, by name
+	categoryIDs        map[string]uuid.UUID // Categories created by setup steps, by name
+	tagIDs             map[string]uuid.UUID // Tags created by setup steps, by name
+	merchantIDs        map[string]uuid.UUID // Merchants created by setup steps, by name
+	lastTransferLegID  uuid.UUID            // Outgoing leg of the last transfer returned by the API
+	lastAttachmentID   uuid.UUID            // Last attachment returned by the API
+	lastNextCursor     string               // Next page cursor of the last list returned by the API
+	lastChangeID       uuid.UUID            // Newest transaction change returned by the API
+	lastOperationID    uuid.UUID            // Operation of the last bulk change returned by the API
+	lastPlanID         uuid.UUID            // First installment plan of the last plan list returned by the API
+	lastProfileID      uuid.UUID            // Last CSV import profile returned by the API
+	lastScheduleID     uuid.UUID            // Last recurring schedule returned by the API
+	uploadFields       map[string]string    // Form fields sent along with the next uploaded file
 	// Email testing
 	lastEmailJobID     uuid.UUID
 	emailSenderMock    *mockEmailSender
@@ -102,6 +136,7 @@ type testContext struct {
 
 type response struct {
 	status int
+	header http.Header
 	body   any
 	err    error
 }
@@ -128,16 +163,32 @@ func InitializeScenario(ctx *godog.ScenarioContext) {
 		timeMock:   mock.NewTime(),
 		serverPort: testServerPort,
 		db: mock.NewDb("finance_tracker", map[string]any{
-			"users":                 &model.UserModel{},
-			"refresh_tokens":        &model.RefreshTokenModel{},
-			"password_reset_tokens": &model.PasswordResetTokenModel{},
-			"categories":            &model.CategoryModel{},
-			"transactions":          &model.TransactionModel{},
-			"goals":                 &model.GoalModel{},
-			"groups":                &model.GroupModel{},
-			"group_members":         &model.GroupMemberModel{},
-			"group_invites":         &model.GroupInviteModel{},
-			"email_queue":           &model.EmailQueueModel{},
+			"users":                            &model.UserModel{},
+			"refresh_tokens":                   &model.RefreshTokenModel{},
+			"password_reset_tokens":            &model.PasswordResetTokenModel{},
+			"categories":                       &model.CategoryModel{},
+			"category_rules":                   &model.CategoryRuleModel{},
+			"transactions":                     &model.TransactionModel{},
+			"transaction_splits":               &model.TransactionSplitModel{},
+			"tags":                             &model.TagModel{},
+			"transaction_tags":                 &model.TransactionTagModel{},
+			"merchants":                        &model.MerchantModel{},
+			"installment_plans":                &model.InstallmentPlanModel{},
+			"attachments":                      &model.AttachmentModel{},
+			"transaction_changes":              &model.TransactionChangeModel{},
+			"goals":                            &model.GoalModel{},
+			"goal_contributions":               &model.GoalContributionModel{},
+			"goal_alerts":                      &model.GoalAlertModel{},
+			"accounts":                         &model.AccountModel{},
+			"billing_cycle_overrides":          &model.BillingCycleOverrideModel{},
+			"exchange_rates":                   &model.ExchangeRateModel{},
+			"groups":                           &model.GroupModel{},
+			"group_members":                    &model.GroupMemberModel{},
+			"group_invites":                    &model.GroupInviteModel{},
+			"email_queue":                      &model.EmailQueueModel{},
+			"transaction_duplicate_dismissals": &model.DuplicateDismissalModel{},
+			"import_profiles":                  &model.ImportProfileModel{},
+			"recurring_schedules":              &model.RecurringScheduleModel{},
 		}),
 	}
 
@@ -165,6 +216,11 @@ func InitializeScenario(ctx *godog.ScenarioContext) {
 	// Category setup steps
 	ctx.Given(`^a category exists with name "([^"]*)" and type "([^"]*)"$`, test.aCategoryExistsWithNameAndType)
 
+	// Account setup steps
+	ctx.Given(`^an account exists with name "([^"]*)" and type "([^"]*)"$`, test.anAccountExistsWithNameAndType)
+	ctx.Given(`^a tag exists with name "([^"]*)"$`, test.aTagExistsWithName)
+	ctx.Given(`^a merchant exists with name "([^"]*)" and key "([^"]*)"$`, test.aMerchantExistsWithNameAndKey)
+
 	// Goal setup steps
 	ctx.Given(`^a goal exists for category "([^"]*)" with limit "([^"]*)"$`, test.aGoalExistsForCategoryWithLimit)
 
@@ -182,6 +238,12 @@ func InitializeScenario(ctx *godog.ScenarioContext) {
 	// Request steps
 	ctx.When(`^I send a "([^"]*)" request to "([^"]*)"$`, test.iSendARequestTo)
 	ctx.When(`^I send a "([^"]*)" request to "([^"]*)" with body:$`, test.iSendARequestToWithBody)
+	ctx.When(`^I upload a file "([^"]*)" with content type "([^"]*)" and content "([^"]*)" to "([^"]*)"$`, test.iUploadAFileWithContentTypeAndContentTo)
+	ctx.When(`^I upload a file "([^"]*)" with content type "([^"]*)" to "([^"]*)" with content:$`, test.iUploadAFileWithContentTypeToWithContent)
+	ctx.Given(`^the next upload sends the form field "([^"]*)" with value "([^"]*)"$`, test.theNextUploadSendsTheFormFieldWithValue)
+	ctx.Given(`^the next upload sends the form field "([^"]*)" with value:$`, test.theNextUploadSendsTheFormFieldWithDocValue)
+	ctx.When(`^I upload a (\d+)x(\d+) PNG image "([^"]*)" to "([^"]*)"$`, test.iUploadAPNGImageTo)
+	ctx.When(`^I upload a PDF statement "([^"]*)" to "([^"]*)" with text:$`, test.iUploadAPDFStatementToWithText)
 
 	// Response assertion steps
 	ctx.Then(`^the response status should be (\d+)$`, test.theResponseStatusShouldBe)
@@ -189,6 +251,19 @@ func InitializeScenario(ctx *godog.ScenarioContext) {
 	ctx.Then(`^the response should contain "([^"]*)"$`, test.theResponseShouldContain)
 	ctx.Then(`^the response field "([^"]*)" should be "([^"]*)"$`, test.theResponseFieldShouldBe)
 	ctx.Then(`^the response field "([^"]*)" should exist$`, test.theResponseFieldShouldExist)
+	ctx.Then(`^the response field "([^"]*)" should not exist$`, test.theResponseFieldShouldNotExist)
+	ctx.Then(`^the response body should be "([^"]*)"$`, test.theResponseBodyShouldBe)
+	ctx.Then(`^the response body should contain "([^"]*)"$`, test.theResponseBodyShouldContain)
+	ctx.Then(`^the response body should not contain "([^"]*)"$`, test.theResponseBodyShouldNotContain)
+	ctx.Then(`^the response header "([^"]*)" should be "([^"]*)"$`, test.theResponseHeaderShouldBe)
+
+	// Attachment steps
+	ctx.When(`^the attachment cleanup job runs$`, test.theAttachmentCleanupJobRuns)
+
+	// Trash steps
+	ctx.When(`^the trash retention job runs with a retention of (\d+) days$`, test.theTrashRetentionJobRunsWithARetentionOfDays)
+	ctx.When(`^the recurring scheduler runs on "([^"]*)"$`, test.theRecurringSchedulerRunsOn)
+	ctx.When(`^goal alerts are evaluated$`, test.goalAlertsAreEvaluated)
 
 	// Database assertion steps
 	ctx.Then(`^the db should contain (\d+) objects in the "([^"]*)" table$`, test.theDbShouldContainObjectsInTheTable)
@@ -236,6 +311,19 @@ func (t *testContext) before() {
 	t.currentInviteToken = ""
 	t.transactionIDs = nil
 	t.lastTransactionID = uuid.Nil
+	t.accountIDs = make(map[string]uuid.UUID)
+	t.categoryIDs = make(map[string]uuid.UUID)
+	t.tagIDs = make(map[string]uuid.UUID)
+	t.merchantIDs = make(map[string]uuid.UUID)
+	t.lastTransferLegID = uuid.Nil
+	t.lastAttachmentID = uuid.Nil
+	t.lastNextCursor = ""
+	t.lastChangeID = uuid.Nil
+	t.lastOperationID = uuid.Nil
+	t.lastPlanID = uuid.Nil
+	t.lastProfileID = uuid.Nil
+	t.lastScheduleID = uuid.Nil
+	t.uploadFields = make(map[string]string)
 
 	if t.db != nil {
 		_ = t.db.ClearDB()
@@ -252,9 +340,27 @@ func (t *testContext) startServer() {
 			tokenRepo := persistence.NewTokenRepository(testDB.DbConn)
 			categoryRepo := persistence.NewCategoryRepository(testDB.DbConn)
 			transactionRepo := persistence.NewTransactionRepository(testDB.DbConn)
+			transactionChangeRepo := persistence.NewTransactionChangeRepository(testDB.DbConn)
+			txManager := persistence.NewTxManager(testDB.DbConn)
 			goalRepo := persistence.NewGoalRepository(testDB.DbConn)
+			goalContributionRepo := persistence.NewGoalContributionRepository(testDB.DbConn)
 			groupRepo := persistence.NewGroupRepository(testDB.DbConn)
 			categoryRuleRepo := persistence.NewCategoryRuleRepository(testDB.DbConn)
+			duplicateDismissalRepo := persistence.NewDuplicateDismissalRepository(testDB.DbConn)
+			accountRepo := persistence.NewAccountRepository(testDB.DbConn)
+			billingCycleOverrideRepo := persistence.NewBillingCycleOverrideRepository(testDB.DbConn)
+			exchangeRateRepo := persistence.NewExchangeRateRepository(testDB.DbConn)
+			tagRepo := persistence.NewTagRepository(testDB.DbConn)
+			merchantRepo := persistence.NewMerchantRepository(testDB.DbConn)
+			installmentPlanRepo := persistence.NewInstallmentPlanRepository(testDB.DbConn)
+			attachmentRepo := persistence.NewAttachmentRepository(testDB.DbConn)
+			trashRepo := persistence.NewTrashRepository(testDB.DbConn)
+			reconciliationRepo := persistence.NewReconciliationRepository(testDB.DbConn)
+			importProfileRepo := persistence.NewImportProfileRepository(testDB.DbConn)
+			currencyConverter := exchangerate.NewConverter(exchangeRateRepo, userRepo)
+			merchantResolver := merchant.NewResolver(merchantRepo)
+			installmentTracker := installment.NewTracker(installmentPlanRepo)
+			calendarLoader := account.NewCalendarLoader(billingCycleOverrideRepo)
 
 			// Create adapters/services
 			passwordService := adapters.NewPasswordService()
@@ -277,22 +383,48 @@ func (t *testContext) startServer() {
 			listCategoriesUseCase := category.NewListCategoriesUseCase(categoryRepo)
 			createCategoryUseCase := category.NewCreateCategoryUseCase(categoryRepo)
 			updateCategoryUseCase := category.NewUpdateCategoryUseCase(categoryRepo)
-			deleteCategoryUseCase := category.NewDeleteCategoryUseCase(categoryRepo)
+			deleteCategoryUseCase := category.NewDeleteCategoryUseCase(categoryRepo, transactionRepo, transactionChangeRepo)
 
 			// Create transaction use cases
-			listTransactionsUseCase := transaction.NewListTransactionsUseCase(transactionRepo)
-			createTransactionUseCase := transaction.NewCreateTransactionUseCase(transactionRepo, categoryRepo, categoryRuleRepo)
-			updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(transactionRepo, categoryRepo)
-			deleteTransactionUseCase := transaction.NewDeleteTransactionUseCase(transactionRepo)
-			bulkDeleteTransactionsUseCase := transaction.NewBulkDeleteTransactionsUseCase(transactionRepo)
-			bulkCategorizeTransactionsUseCase := transaction.NewBulkCategorizeTransactionsUseCase(transactionRepo, categoryRepo)
+			listTransactionsUseCase := transaction.NewListTransactionsUseCase(transactionRepo, accountRepo)
+			createTransactionUseCase := transaction.NewCreateTransactionUseCase(transactionRepo, transactionChangeRepo, categoryRepo, categoryRuleRepo, accountRepo, nil, currencyConverter, merchantResolver, calendarLoader)
+			updateTransactionUseCase := transaction.NewUpdateTransactionUseCase(transactionRepo, transactionChangeRepo, categoryRepo, accountRepo, nil, currencyConverter)
+			deleteTransactionUseCase := transaction.NewDeleteTransactionUseCase(transactionRepo, transactionChangeRepo, nil)
+			bulkDeleteTransactionsUseCase := transaction.NewBulkDeleteTransactionsUseCase(transactionRepo, transactionChangeRepo, nil)
+			bulkCategorizeTransactionsUseCase := transaction.NewBulkCategorizeTransactionsUseCase(transactionRepo, transactionChangeRepo, categoryRepo)
+			listDuplicatesUseCase := transaction.NewListDuplicatesUseCase(transactionRepo, duplicateDismissalRepo)
+			mergeDuplicateUseCase := transaction.NewMergeDuplicateUseCase(transactionRepo, transactionChangeRepo, attachmentRepo, txManager)
+			dismissDuplicateUseCase := transaction.NewDismissDuplicateUseCase(transactionRepo, duplicateDismissalRepo)
+			splitTransactionUseCase := transaction.NewSplitTransactionUseCase(transactionRepo, transactionChangeRepo, categoryRepo, nil)
+			unsplitTransactionUseCase := transaction.NewUnsplitTransactionUseCase(transactionRepo, transactionChangeRepo, nil)
+			bulkTagTransactionsUseCase := transaction.NewBulkTagTransactionsUseCase(transactionRepo, transactionChangeRepo, tagRepo)
+			getTransactionHistoryUseCase := transaction.NewGetTransactionHistoryUseCase(transactionRepo, transactionChangeRepo)
+			revertTransactionChangeUseCase := transaction.NewRevertTransactionChangeUseCase(transactionRepo, transactionChangeRepo, nil)
+			revertTransactionOperationUseCase := transaction.NewRevertTransactionOperationUseCase(transactionRepo, transactionChangeRepo, nil)
+			bulkUpdateTransactionsUseCase := transaction.NewBulkUpdateTransactionsUseCase(transactionRepo, transactionChangeRepo, categoryRepo, currencyConverter, nil)
+			exportTransactionsUseCase := transaction.NewExportTransactionsUseCase(transactionRepo, userRepo, categoryRepo, accountRepo, map[adapter.ExportFormat]adapter.TransactionExporter{
+				adapter.ExportFormatCSV:  export.NewCSVExporter(),
+				adapter.ExportFormatXLSX: export.NewXLSXExporter(),
+				adapter.ExportFormatOFX:  export.NewOFXExporter(),
+			})
+			csvParser := statement.NewCSVParser()
+			importStatementUseCase := transaction.NewImportStatementUseCase(transactionRepo, transactionChangeRepo, txManager, categoryRepo, categoryRuleRepo, nil, currencyConverter, merchantResolver, installmentTracker)
+			previewCSVImportUseCase := transaction.NewPreviewCSVImportUseCase(transactionRepo, categoryRepo, categoryRuleRepo, importProfileRepo, userRepo, csvParser)
+			importCSVUseCase := transaction.NewImportCSVUseCase(transactionRepo, transactionChangeRepo, txManager, categoryRepo, categoryRuleRepo, importProfileRepo, userRepo, csvParser, nil, currencyConverter, merchantResolver, installmentTracker)
+			exportJournalUseCase := transaction.NewExportJournalUseCase(transactionRepo, userRepo, categoryRepo, accountRepo, reconciliationRepo, map[adapter.JournalFormat]adapter.JournalExporter{
+				adapter.JournalFormatBeancount: export.NewBeancountExporter(),
+				adapter.JournalFormatHledger:   export.NewHledgerExporter(),
+			})
 
 			// Create goal use cases
-			listGoalsUseCase := goal.NewListGoalsUseCase(goalRepo, categoryRepo)
+			listGoalsUseCase := goal.NewListGoalsUseCase(goalRepo, categoryRepo, goalContributionRepo)
 			createGoalUseCase := goal.NewCreateGoalUseCase(goalRepo, categoryRepo)
-			getGoalUseCase := goal.NewGetGoalUseCase(goalRepo, categoryRepo)
+			getGoalUseCase := goal.NewGetGoalUseCase(goalRepo, categoryRepo, goalContributionRepo)
 			updateGoalUseCase := goal.NewUpdateGoalUseCase(goalRepo)
 			deleteGoalUseCase := goal.NewDeleteGoalUseCase(goalRepo)
+			listGoalContributionsUseCase := goal.NewListGoalContributionsUseCase(goalRepo, goalContributionRepo)
+			addGoalContributionUseCase := goal.NewAddGoalContributionUseCase(goalRepo, goalContributionRepo, transactionRepo)
+			deleteGoalContributionUseCase := goal.NewDeleteGoalContributionUseCase(goalRepo, goalContributionRepo)
 
 			// Create group use cases (with email service for integration tests)
 			createGroupUseCase := group.NewCreateGroupUseCase(groupRepo, userRepo)
@@ -308,14 +440,14 @@ func (t *testContext) startServer() {
 
 			// Create category rule use cases (categoryRuleRepo already created above)
 			listCategoryRulesUseCase := categoryrule.NewListCategoryRulesUseCase(categoryRuleRepo)
-			createCategoryRuleUseCase := categoryrule.NewCreateCategoryRuleUseCase(categoryRuleRepo, categoryRepo, transactionRepo)
+			createCategoryRuleUseCase := categoryrule.NewCreateCategoryRuleUseCase(categoryRuleRepo, categoryRepo, transactionRepo, transactionChangeRepo)
 			updateCategoryRuleUseCase := categoryrule.NewUpdateCategoryRuleUseCase(categoryRuleRepo, categoryRepo)
 			deleteCategoryRuleUseCase := categoryrule.NewDeleteCategoryRuleUseCase(categoryRuleRepo)
 			reorderCategoryRulesUseCase := categoryrule.NewReorderCategoryRulesUseCase(categoryRuleRepo)
 			testPatternUseCase := categoryrule.NewTestPatternUseCase(categoryRuleRepo)
 
 			// Create user use cases (delete account)
-			deleteAccountUseCase := auth.NewDeleteAccountUseCase(userRepo, passwordService, tokenService)
+			deleteAccountUseCase := auth.NewDeleteAccountUseCase(userRepo, passwordService, tokenService, nil)
 
 			// Create controllers
 			healthController := controller.NewHealthController(func() bool {
@@ -345,6 +477,18 @@ func (t *testContext) startServer() {
 				deleteTransactionUseCase,
 				bulkDeleteTransactionsUseCase,
 				bulkCategorizeTransactionsUseCase,
+				listDuplicatesUseCase,
+				mergeDuplicateUseCase,
+				dismissDuplicateUseCase,
+				splitTransactionUseCase,
+				unsplitTransactionUseCase,
+				bulkTagTransactionsUseCase,
+				getTransactionHistoryUseCase,
+				revertTransactionChangeUseCase,
+				revertTransactionOperationUseCase,
+				bulkUpdateTransactionsUseCase,
+				exportTransactionsUseCase,
+				exportJournalUseCase,
 			)
 
 			goalController := controller.NewGoalController(
@@ -353,6 +497,9 @@ func (t *testContext) startServer() {
 				getGoalUseCase,
 				updateGoalUseCase,
 				deleteGoalUseCase,
+				listGoalContributionsUseCase,
+				addGoalContributionUseCase,
+				deleteGoalContributionUseCase,
 			)
 
 			groupController := controller.NewGroupController(
@@ -380,7 +527,10 @@ func (t *testContext) startServer() {
 				testPatternUseCase,
 			)
 
-			userController := controller.NewUserController(deleteAccountUseCase)
+			userController := controller.NewUserController(
+				deleteAccountUseCase,
+				exchangerate.NewUpdateBaseCurrencyUseCase(userRepo, transactionRepo, transactionChangeRepo, currencyConverter),
+			)
 
 			// Create dashboard repository and use cases
 			dashboardRepo := persistence.NewDashboardRepository(testDB.DbConn)
@@ -389,6 +539,8 @@ func (t *testContext) startServer() {
 			getTrendsUseCase := dashboard.NewGetTrendsUseCase(dashboardRepo)
 			getCategoryBreakdownUseCase := dashboard.NewGetCategoryBreakdownUseCase(dashboardRepo)
 			getPeriodTransactionsUseCase := dashboard.NewGetPeriodTransactionsUseCase(dashboardRepo)
+			getTagBreakdownUseCase := dashboard.NewGetTagBreakdownUseCase(dashboardRepo)
+			getTopMerchantsUseCase := dashboard.NewGetTopMerchantsUseCase(dashboardRepo)
 
 			// Create dashboard controller
 			dashboardController := controller.NewDashboardController(
@@ -397,13 +549,126 @@ func (t *testContext) startServer() {
 				getTrendsUseCase,
 				getCategoryBreakdownUseCase,
 				getPeriodTransactionsUseCase,
+				getTagBreakdownUseCase,
+				getTopMerchantsUseCase,
+			)
+
+			// Create account controller
+			accountController := controller.NewAccountController(
+				account.NewListAccountsUseCase(accountRepo),
+				account.NewGetAccountUseCase(accountRepo),
+				account.NewCreateAccountUseCase(accountRepo),
+				account.NewUpdateAccountUseCase(accountRepo),
+				account.NewDeleteAccountUseCase(accountRepo, transactionRepo, transactionChangeRepo, nil),
+				account.NewListBillingCyclesUseCase(accountRepo, billingCycleOverrideRepo),
+				account.NewSetBillingCycleOverrideUseCase(accountRepo, billingCycleOverrideRepo),
+				account.NewDeleteBillingCycleOverrideUseCase(accountRepo, billingCycleOverrideRepo),
+				account.NewGetOpenBillUseCase(accountRepo, billingCycleOverrideRepo, currencyConverter),
+			)
+
+			// Create transfer controller
+			transferController := controller.NewTransferController(
+				transfer.NewGetTransferUseCase(transactionRepo),
+				transfer.NewCreateTransferUseCase(transactionRepo, transactionChangeRepo, accountRepo),
+				transfer.NewUpdateTransferUseCase(transactionRepo, transactionChangeRepo, accountRepo),
+				transfer.NewDeleteTransferUseCase(transactionRepo, transactionChangeRepo, nil),
+				transfer.NewConvertTransactionUseCase(transactionRepo, transactionChangeRepo, accountRepo, nil),
+			)
+
+			// Create exchange rate controller
+			exchangeRateController := controller.NewExchangeRateController(
+				exchangerate.NewListExchangeRatesUseCase(exchangeRateRepo, currencyConverter),
+				exchangerate.NewCreateExchangeRateUseCase(exchangeRateRepo),
+				exchangerate.NewDeleteExchangeRateUseCase(exchangeRateRepo),
+				exchangerate.NewImportExchangeRatesUseCase(exchangeRateRepo, statement.NewExchangeRateParser()),
+			)
+
+			// Create tag controller
+			tagController := controller.NewTagController(
+				tag.NewListTagsUseCase(tagRepo),
+				tag.NewCreateTagUseCase(tagRepo),
+				tag.NewUpdateTagUseCase(tagRepo),
+				tag.NewDeleteTagUseCase(tagRepo),
+			)
+
+			merchantController := controller.NewMerchantController(
+				merchant.NewListMerchantsUseCase(merchantRepo),
+				merchant.NewUpdateMerchantUseCase(merchantRepo, categoryRepo),
+				merchant.NewMergeMerchantsUseCase(merchantRepo),
+			)
+
+			installmentController := controller.NewInstallmentController(
+				installment.NewListPlansUseCase(installmentPlanRepo),
+				installment.NewGetPlanUseCase(installmentPlanRepo),
+				installment.NewGetCommitmentsUseCase(installmentPlanRepo, currencyConverter),
+			)
+
+			// Create attachment controller (local storage cannot presign upload URLs)
+			attachmentStorage, err := newTestAttachmentStorage()
+			if err != nil {
+				panic(err)
+			}
+			thumbnailGenerator := storage.NewImageThumbnailGenerator(storage.DefaultThumbnailSize)
+			attachmentController := controller.NewAttachmentController(
+				attachment.NewListAttachmentsUseCase(attachmentRepo, transactionRepo, accountRepo),
+				attachment.NewUploadAttachmentUseCase(attachmentRepo, transactionRepo, accountRepo, attachmentStorage, thumbnailGenerator),
+				attachment.NewCreateUploadURLUseCase(attachmentRepo, transactionRepo, accountRepo, attachmentStorage, 15*time.Minute),
+				attachment.NewCompleteUploadUseCase(attachmentRepo, attachmentStorage, thumbnailGenerator),
+				attachment.NewGetAttachmentUseCase(attachmentRepo, attachmentStorage, 15*time.Minute),
+				attachment.NewGetAttachmentContentUseCase(attachmentRepo, attachmentStorage),
+				attachment.NewDeleteAttachmentUseCase(attachmentRepo, attachmentStorage),
+			)
+
+			// Create trash controller
+			// Create recurring schedule controller
+			recurringScheduleRepo := persistence.NewRecurringScheduleRepository(testDB.DbConn)
+			recurringScheduleController := controller.NewRecurringScheduleController(
+				recurringschedule.NewListRecurringSchedulesUseCase(recurringScheduleRepo, categoryRepo),
+				recurringschedule.NewGetRecurringScheduleUseCase(recurringScheduleRepo, categoryRepo),
+				recurringschedule.NewCreateRecurringScheduleUseCase(recurringScheduleRepo, categoryRepo),
+				recurringschedule.NewUpdateRecurringScheduleUseCase(recurringScheduleRepo, categoryRepo),
+				recurringschedule.NewDeleteRecurringScheduleUseCase(recurringScheduleRepo),
+			)
+
+			trashController := controller.NewTrashController(
+				trash.NewListTrashUseCase(trashRepo, trash.DefaultRetentionDays),
+				trash.NewRestoreTrashItemsUseCase(trashRepo, transactionRepo, transactionChangeRepo, nil, trash.DefaultRetentionDays),
+			)
+
+			// Create credit card controller
+			creditCardController := controller.NewCreditCardController(
+				creditcard.NewPreviewImportUseCase(transactionRepo),
+				creditcard.NewImportTransactionsUseCase(transactionRepo, transactionChangeRepo, txManager, categoryRepo, categoryRuleRepo, accountRepo, nil, currencyConverter, merchantResolver, installmentTracker, calendarLoader),
+				creditcard.NewCollapseExpansionUseCase(transactionRepo, transactionChangeRepo),
+				creditcard.NewGetStatusUseCase(transactionRepo),
+				creditcard.NewGetForecastUseCase(
+					transactionRepo,
+					accountRepo,
+					calendarLoader,
+					installment.NewGetCommitmentsUseCase(installmentPlanRepo, currencyConverter),
+					currencyConverter,
+				),
+				creditcard.NewParseStatementPDFUseCase(
+					accountRepo,
+					calendarLoader,
+					statement.NewCardStatementParser(statement.DefaultCardStatementLayouts()...),
+				),
+			)
+
+			// Create statement file import controllers
+			importController := controller.NewImportController(importStatementUseCase, previewCSVImportUseCase, importCSVUseCase)
+			importProfileController := controller.NewImportProfileController(
+				importprofile.NewListImportProfilesUseCase(importProfileRepo),
+				importprofile.NewCreateImportProfileUseCase(importProfileRepo),
+				importprofile.NewUpdateImportProfileUseCase(importProfileRepo),
+				importprofile.NewDeleteImportProfileUseCase(importProfileRepo),
 			)
 
 			// Create middleware
 			loginRateLimiter := middleware.NewRateLimiter()
 			authMiddleware := middleware.NewAuthMiddleware(tokenService)
 
-			r := router.NewRouter(healthController, authController, userController, categoryController, transactionController, nil, nil, goalController, groupController, categoryRuleController, dashboardController, nil, loginRateLimiter, authMiddleware)
+			r := router.NewRouter(healthController, authController, userController, categoryController, transactionController, creditCardController, nil, goalController, groupController, categoryRuleController, dashboardController, nil, importController, importProfileController, recurringScheduleController, accountController, transferController, exchangeRateController, tagController, merchantController, installmentController, attachmentController, trashController, loginRateLimiter, authMiddleware)
 			engine := r.Setup("test")
 
 			addr := fmt.Sprintf(":%d", testServerPort)
@@ -594,6 +859,13 @@ func (t *testContext) iSendARequestToWithBody(method, path string, body *godog.D
 	return t.executeRequest(method, path, payload)
 }
 
+// billingCyclePlaceholderRegex matches {{billing_cycle:<offset>}} placeholders, such as {{billing_cycle:-1}}.
+var billingCyclePlaceholderRegex = regexp.MustCompile(`{{billing_cycle:(-?\d+)}}`)
+
+// transactionIndexPlaceholderRegex matches {{transaction_id:<index>}} placeholders for the transactions
+// created so far in the scenario, in creation order, such as {{transaction_id:0}}.
+var transactionIndexPlaceholderRegex = regexp.MustCompile(`{{transaction_id:(\d+)}}`)
+
 func (t *testContext) replaceTokenPlaceholders(content string) string {
 	content = strings.ReplaceAll(content, "{{refresh_token}}", t.refreshToken)
 	content = strings.ReplaceAll(content, "{{access_token}}", t.accessToken)
@@ -605,6 +877,50 @@ func (t *testContext) replaceTokenPlaceholders(content string) string {
 	content = strings.ReplaceAll(content, "{{group_id}}", t.currentGroupID.String())
 	content = strings.ReplaceAll(content, "{{member_id}}", t.currentMemberID.String())
 	content = strings.ReplaceAll(content, "{{invite_token}}", t.currentInviteToken)
+	content = strings.ReplaceAll(content, "{{transfer_leg_id}}", t.lastTransferLegID.String())
+	content = strings.ReplaceAll(content, "{{attachment_id}}", t.lastAttachmentID.String())
+	content = strings.ReplaceAll(content, "{{next_cursor}}", t.lastNextCursor)
+	content = strings.ReplaceAll(content, "{{change_id}}", t.lastChangeID.String())
+	content = strings.ReplaceAll(content, "{{operation_id}}", t.lastOperationID.String())
+	content = strings.ReplaceAll(content, "{{installment_plan_id}}", t.lastPlanID.String())
+	content = strings.ReplaceAll(content, "{{import_profile_id}}", t.lastProfileID.String())
+	content = strings.ReplaceAll(content, "{{recurring_schedule_id}}", t.lastScheduleID.String())
+
+	// Handle {{account_id:<name>}} placeholders for accounts created by setup steps
+	for name, id := range t.accountIDs {
+		content = strings.ReplaceAll(content, "{{account_id:"+name+"}}", id.String())
+	}
+
+	// Handle {{category_id:<name>}} placeholders for categories created by setup steps
+	for name, id := range t.categoryIDs {
+		content = strings.ReplaceAll(content, "{{category_id:"+name+"}}", id.String())
+	}
+
+	// Handle {{tag_id:<name>}} placeholders for tags created by setup steps
+	for name, id := range t.tagIDs {
+		content = strings.ReplaceAll(content, "{{tag_id:"+name+"}}", id.String())
+	}
+
+	// Handle {{merchant_id:<name>}} placeholders for merchants created by setup steps
+	for name, id := range t.merchantIDs {
+		content = strings.ReplaceAll(content, "{{merchant_id:"+name+"}}", id.String())
+	}
+
+	// Handle {{billing_cycle:<offset>}} placeholders for the month the given number of months from now
+	content = billingCyclePlaceholderRegex.ReplaceAllStringFunc(content, func(placeholder string) string {
+		offset, _ := strconv.Atoi(billingCyclePlaceholderRegex.FindStringSubmatch(placeholder)[1])
+		now := time.Now().UTC()
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, offset, 0).Format("2006-01")
+	})
+
+	// Handle {{transaction_id:<index>}} placeholders
+	content = transactionIndexPlaceholderRegex.ReplaceAllStringFunc(content, func(placeholder string) string {
+		index, _ := strconv.Atoi(transactionIndexPlaceholderRegex.FindStringSubmatch(placeholder)[1])
+		if index >= len(t.transactionIDs) {
+			return placeholder
+		}
+		return t.transactionIDs[index].String()
+	})
 
 	// Handle transaction_ids array placeholder
 	if len(t.transactionIDs) > 0 {
@@ -635,6 +951,11 @@ func (t *testContext) executeRequest(method, path string, payload []byte) error
 
 	req.Header.Set("Content-Type", "application/json")
 
+	return t.sendRequest(req)
+}
+
+// sendRequest authenticates the request, sends it and records the response.
+func (t *testContext) sendRequest(req *http.Request) error {
 	if t.accessToken != "" {
 		req.Header.Set("Authorization", "Bearer "+t.accessToken)
 	}
@@ -656,6 +977,7 @@ func (t *testContext) executeRequest(method, path string, payload []byte) error
 
 	t.response = &response{
 		status: resp.StatusCode,
+		header: resp.Header,
 	}
 
 	var responseBody map[string]any
@@ -664,8 +986,32 @@ func (t *testContext) executeRequest(method, path string, payload []byte) error
 	} else {
 		t.response.body = responseBody
 
-		// Capture transaction ID from response if present
-		if idStr, ok := responseBody["id"].(string); ok {
+		// Capture attachment ID separately so it does not replace the transaction ID
+		if _, isAttachment := responseBody["owner_type"]; isAttachment {
+			if id, err := uuid.Parse(fmt.Sprintf("%v", responseBody["id"])); err == nil {
+				t.lastAttachmentID = id
+			}
+		} else if attachment, ok := responseBody["attachment"].(map[string]any); ok {
+			if id, err := uuid.Parse(fmt.Sprintf("%v", attachment["id"])); err == nil {
+				t.lastAttachmentID = id
+			}
+		} else if _, isImportProfile := responseBody["date_column"]; isImportProfile {
+			// Capture import profile ID separately so it does not replace the transaction ID
+			if id, err := uuid.Parse(fmt.Sprintf("%v", responseBody["id"])); err == nil {
+				t.lastProfileID = id
+			}
+		} else if _, isSchedule := responseBody["frequency"]; isSchedule {
+			// Capture recurring schedule ID separately so it does not replace the transaction ID
+			if id, err := uuid.Parse(fmt.Sprintf("%v", responseBody["id"])); err == nil {
+				t.lastScheduleID = id
+			}
+		} else if _, isChange := responseBody["action"]; isChange {
+			// Capture transaction change ID separately so it does not replace the transaction ID
+			if id, err := uuid.Parse(fmt.Sprintf("%v", responseBody["id"])); err == nil {
+				t.lastChangeID = id
+			}
+		} else if idStr, ok := responseBody["id"].(string); ok {
+			// Capture transaction ID from response if present
 			if id, err := uuid.Parse(idStr); err == nil {
 				t.lastTransactionID = id
 				t.transactionIDs = append(t.transactionIDs, id)
@@ -678,6 +1024,56 @@ func (t *testContext) executeRequest(method, path string, payload []byte) error
 					}
 				}
 			}
+		} else if _, isCCImport := responseBody["imported_count"]; isCCImport {
+			// Capture the first transaction of credit card import responses
+			if transactions, ok := responseBody["transactions"].([]any); ok && len(transactions) > 0 {
+				if first, ok := transactions[0].(map[string]any); ok {
+					if id, err := uuid.Parse(fmt.Sprintf("%v", first["id"])); err == nil {
+						t.lastTransactionID = id
+					}
+				}
+			}
+		}
+
+		// Capture the next page cursor of list responses, including dashboard ones wrapped in "data"
+		pagination, _ := responseBody["pagination"].(map[string]any)
+		if data, ok := responseBody["data"].(map[string]any); ok && pagination == nil {
+			pagination, _ = data["pagination"].(map[string]any)
+		}
+		if pagination != nil {
+			t.lastNextCursor, _ = pagination["next_cursor"].(string)
+		}
+
+		// Capture the newest change of a transaction history response
+		if changes, ok := responseBody["changes"].([]any); ok && len(changes) > 0 {
+			if change, ok := changes[0].(map[string]any); ok {
+				if id, err := uuid.Parse(fmt.Sprintf("%v", change["id"])); err == nil {
+					t.lastChangeID = id
+				}
+			}
+		}
+
+		// Capture the operation ID of bulk change responses
+		if operationIDStr, ok := responseBody["operation_id"].(string); ok {
+			if id, err := uuid.Parse(operationIDStr); err == nil {
+				t.lastOperationID = id
+			}
+		}
+
+		// Capture the first installment plan of plan list responses
+		if plans, ok := responseBody["plans"].([]any); ok && len(plans) > 0 {
+			if plan, ok := plans[0].(map[string]any); ok {
+				if id, err := uuid.Parse(fmt.Sprintf("%v", plan["id"])); err == nil {
+					t.lastPlanID = id
+				}
+			}
+		}
+
+		// Capture the outgoing leg of a transfer response
+		if legIDStr, ok := responseBody["from_transaction_id"].(string); ok {
+			if id, err := uuid.Parse(legIDStr); err == nil {
+				t.lastTransferLegID = id
+			}
 		}
 
 		// Capture invite token from response if present
@@ -767,6 +1163,7 @@ func (t *testContext) theResponseFieldShouldBe(field, expectedValue string) erro
 		return fmt.Errorf("field '%s' not found in response: %v", field, body)
 	}
 
+	expectedValue = t.replaceTokenPlaceholders(expectedValue)
 	actualValue := fmt.Sprintf("%v", value)
 	if actualValue != expectedValue {
 		return fmt.Errorf("field '%s' expected '%s', got '%s'", field, expectedValue, actualValue)
@@ -791,6 +1188,22 @@ func (t *testContext) theResponseFieldShouldExist(field string) error {
 	return nil
 }
 
+func (t *testContext) theResponseFieldShouldNotExist(field string) error {
+	if t.response == nil {
+		return errors.New("no response received")
+	}
+
+	body, ok := t.response.body.(map[string]any)
+	if !ok {
+		return fmt.Errorf("response is not a JSON object: %v", t.response.body)
+	}
+
+	if value := getFieldValue(body, field); value != nil {
+		return fmt.Errorf("field '%s' expected to be absent, got '%v'", field, value)
+	}
+	return nil
+}
+
 func (t *testContext) theDbShouldContainObjectsInTheTable(quantity int, table string) error {
 	if entity, ok := t.db.GetModel(table); ok {
 		entityType := reflect.TypeOf(entity).Elem()
@@ -814,7 +1227,7 @@ func (t *testContext) theDbShouldContainObjectsInTheTable(quantity int, table st
 
 func (t *testContext) theDbShouldContainObjectsInWithTheValues(quantity int, table string, content *godog.DocString) error {
 	var criteria map[string]any
-	if err := json.Unmarshal([]byte(content.Content), &criteria); err != nil {
+	if err := json.Unmarshal([]byte(t.replaceTokenPlaceholders(content.Content)), &criteria); err != nil {
 		return err
 	}
 
@@ -889,6 +1302,7 @@ func getFieldValue(object any, dotSeparatedField string) any {
 func (t *testContext) aCategoryExistsWithNameAndType(name, categoryType string) error {
 	categoryID := uuid.New()
 	t.currentCategoryID = categoryID
+	t.categoryIDs[name] = categoryID
 
 	now := time.Now().UTC()
 	categoryModel := &model.CategoryModel{
@@ -1028,6 +1442,67 @@ func (t *testContext) fetchMemberIDAfterAcceptInvite() {
 	}
 }
 
+// anAccountExistsWithNameAndType creates an account for the current user.
+// Its ID can be referenced in requests as {{account_id:<name>}}.
+func (t *testContext) anAccountExistsWithNameAndType(name, accountType string) error {
+	accountID := uuid.New()
+	t.accountIDs[name] = accountID
+
+	now := time.Now().UTC()
+	accountModel := &model.AccountModel{
+		ID:        accountID,
+		UserID:    t.currentUserID,
+		Name:      name,
+		Type:      accountType,
+		Currency:  "BRL",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	result := t.db.DbConn.Create(accountModel)
+	return result.Error
+}
+
+// aTagExistsWithName creates a tag for the current user.
+// Its ID can be referenced in requests as {{tag_id:<name>}}.
+func (t *testContext) aTagExistsWithName(name string) error {
+	tagID := uuid.New()
+	t.tagIDs[name] = tagID
+
+	now := time.Now().UTC()
+	tagModel := &model.TagModel{
+		ID:        tagID,
+		UserID:    t.currentUserID,
+		Name:      name,
+		Color:     "#64748B",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	result := t.db.DbConn.Create(tagModel)
+	return result.Error
+}
+
+// aMerchantExistsWithNameAndKey creates a merchant for the current user.
+// Its ID can be referenced in requests as {{merchant_id:<name>}}.
+func (t *testContext) aMerchantExistsWithNameAndKey(name, key string) error {
+	merchantID := uuid.New()
+	t.merchantIDs[name] = merchantID
+
+	now := time.Now().UTC()
+	merchantModel := &model.MerchantModel{
+		ID:        merchantID,
+		UserID:    t.currentUserID,
+		Name:      name,
+		Key:       key,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	result := t.db.DbConn.Create(merchantModel)
+	return result.Error
+}
+
 // aGoalExistsForCategoryWithLimit creates a goal for the specified category with the given limit amount.
 func (t *testContext) aGoalExistsForCategoryWithLimit(categoryName, limitAmount string) error {
 	// Find the category by name
@@ -1046,13 +1521,15 @@ func (t *testContext) aGoalExistsForCategoryWithLimit(categoryName, limitAmount
 	t.currentGoalID = goalID
 
 	now := time.Now().UTC()
+	period := "monthly"
 	goalModel := &model.GoalModel{
 		ID:            goalID,
 		UserID:        t.currentUserID,
-		CategoryID:    categoryModel.ID,
+		Kind:          "spending_limit",
+		CategoryID:    &categoryModel.ID,
 		LimitAmount:   limit,
 		AlertOnExceed: true,
-		Period:        "monthly",
+		Period:        &period,
 		CreatedAt:     now,
 		UpdatedAt:     now,
 	}
@@ -1443,3 +1920,254 @@ func (t *testContext) theDatabaseShouldContainAnEmailQueueRecordWith(table *godo
 	t.lastEmailJobID = uuid.MustParse(id)
 	return nil
 }
+
+// newTestAttachmentStorage returns the local object storage shared by the test server and the cleanup step.
+func newTestAttachmentStorage() (*storage.LocalStorage, error) {
+	return storage.NewLocalStorage(filepath.Join(os.TempDir(), fmt.Sprintf("finance-tracker-attachments-%d", testServerPort)))
+}
+
+// iUploadAFileWithContentTypeAndContentTo uploads a text file as multipart form data.
+func (t *testContext) iUploadAFileWithContentTypeAndContentTo(fileName, contentType, content, path string) error {
+	return t.uploadFile(t.replaceTokenPlaceholders(path), fileName, contentType, []byte(content))
+}
+
+// iUploadAFileWithContentTypeToWithContent uploads a multi-line text file, such as a bank statement, as multipart form data.
+func (t *testContext) iUploadAFileWithContentTypeToWithContent(fileName, contentType, path string, content *godog.DocString) error {
+	return t.uploadFile(t.replaceTokenPlaceholders(path), fileName, contentType, []byte(content.Content))
+}
+
+// theNextUploadSendsTheFormFieldWithValue adds a form field to the next uploaded file.
+func (t *testContext) theNextUploadSendsTheFormFieldWithValue(name, value string) error {
+	t.uploadFields[name] = t.replaceTokenPlaceholders(value)
+	return nil
+}
+
+// theNextUploadSendsTheFormFieldWithDocValue adds a multi-line form field, such as a JSON mapping, to the next uploaded file.
+func (t *testContext) theNextUploadSendsTheFormFieldWithDocValue(name string, value *godog.DocString) error {
+	return t.theNextUploadSendsTheFormFieldWithValue(name, value.Content)
+}
+
+// iUploadAPNGImageTo uploads a generated PNG image of the given size as multipart form data.
+func (t *testContext) iUploadAPNGImageTo(width, height int, fileName, path string) error {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return err
+	}
+	return t.uploadFile(t.replaceTokenPlaceholders(path), fileName, "image/png", buf.Bytes())
+}
+
+// iUploadAPDFStatementToWithText uploads a generated PDF drawing each line of the text on its own row.
+func (t *testContext) iUploadAPDFStatementToWithText(fileName, path string, text *godog.DocString) error {
+	// Draw with Helvetica in WinAnsiEncoding, which covers the Latin-1 characters of Portuguese text
+	var content strings.Builder
+	for i, line := range strings.Split(text.Content, "\n") {
+		var encoded bytes.Buffer
+		for _, r := range line {
+			if r > 0xFF {
+				return fmt.Errorf("character %q cannot be drawn in the generated PDF", r)
+			}
+			encoded.WriteByte(byte(r))
+		}
+		escaped := strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`).Replace(encoded.String())
+		fmt.Fprintf(&content, "BT /F1 9 Tf 40 %d Td (%s) Tj ET\n", 800-12*i, escaped)
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] /Resources << /Font << /F1 5 0 R >> >> /Contents 4 0 R >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+	}
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = pdf.Len()
+		fmt.Fprintf(&pdf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := pdf.Len()
+	fmt.Fprintf(&pdf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&pdf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&pdf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return t.uploadFile(t.replaceTokenPlaceholders(path), fileName, "application/pdf", pdf.Bytes())
+}
+
+// uploadFile sends the content in the "file" field of a multipart POST request, along with the
+// form fields set for the upload.
+func (t *testContext) uploadFile(path, fileName, contentType string, content []byte) error {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	for name, value := range t.uploadFields {
+		if err := writer.WriteField(name, value); err != nil {
+			return err
+		}
+	}
+	t.uploadFields = make(map[string]string)
+
+	partHeader := make(textproto.MIMEHeader)
+	partHeader.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, fileName))
+	partHeader.Set("Content-Type", contentType)
+	part, err := writer.CreatePart(partHeader)
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(content); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, t.uri+path, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return t.sendRequest(req)
+}
+
+// theResponseBodyShouldBe checks the raw body of a non-JSON response.
+func (t *testContext) theResponseBodyShouldBe(expected string) error {
+	if t.response == nil {
+		return errors.New("no response received")
+	}
+
+	body, ok := t.response.body.(string)
+	if !ok {
+		return fmt.Errorf("response is JSON, not a raw body: %v", t.response.body)
+	}
+	if body != expected {
+		return fmt.Errorf("response body expected '%s', got '%s'", expected, body)
+	}
+	return nil
+}
+
+// theResponseBodyShouldContain checks that the raw (non-JSON) body of the last response contains the text.
+func (t *testContext) theResponseBodyShouldContain(expected string) error {
+	if t.response == nil {
+		return errors.New("no response received")
+	}
+
+	body, ok := t.response.body.(string)
+	if !ok {
+		return fmt.Errorf("response is JSON, not a raw body: %v", t.response.body)
+	}
+	if !strings.Contains(body, expected) {
+		return fmt.Errorf("response body does not contain '%s': '%s'", expected, body)
+	}
+	return nil
+}
+
+// theResponseBodyShouldNotContain checks that the raw (non-JSON) body of the last response does not contain the text.
+func (t *testContext) theResponseBodyShouldNotContain(unexpected string) error {
+	if t.response == nil {
+		return errors.New("no response received")
+	}
+
+	body, ok := t.response.body.(string)
+	if !ok {
+		return fmt.Errorf("response is JSON, not a raw body: %v", t.response.body)
+	}
+	if strings.Contains(body, unexpected) {
+		return fmt.Errorf("response body contains '%s': '%s'", unexpected, body)
+	}
+	return nil
+}
+
+// theResponseHeaderShouldBe checks a header of the last response.
+func (t *testContext) theResponseHeaderShouldBe(key, expected string) error {
+	if t.response == nil {
+		return errors.New("no response received")
+	}
+
+	actual := t.response.header.Get(key)
+	if actual != expected {
+		return fmt.Errorf("response header '%s' expected '%s', got '%s'", key, expected, actual)
+	}
+	return nil
+}
+
+// theAttachmentCleanupJobRuns purges orphaned attachments the way the cleanup scheduler does.
+func (t *testContext) theAttachmentCleanupJobRuns() error {
+	attachmentStorage, err := newTestAttachmentStorage()
+	if err != nil {
+		return err
+	}
+
+	attachmentRepo := persistence.NewAttachmentRepository(t.db.DbConn)
+	purgeUseCase := attachment.NewPurgeOrphanedAttachmentsUseCase(attachmentRepo, attachmentStorage)
+
+	output, err := purgeUseCase.Execute(context.Background(), attachment.PurgeOrphanedAttachmentsInput{
+		Now: time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	if output.FailedCount > 0 {
+		return fmt.Errorf("failed to purge %d attachments", output.FailedCount)
+	}
+	return nil
+}
+
+// theTrashRetentionJobRunsWithARetentionOfDays purges the trash the way the retention scheduler does.
+func (t *testContext) theTrashRetentionJobRunsWithARetentionOfDays(retentionDays int) error {
+	purgeUseCase := trash.NewPurgeTrashUseCase(persistence.NewTrashRepository(t.db.DbConn))
+
+	_, err := purgeUseCase.Execute(context.Background(), trash.PurgeTrashInput{
+		Now:           time.Now().UTC(),
+		RetentionDays: retentionDays,
+	})
+	return err
+}
+
+// theRecurringSchedulerRunsOn runs the recurring scheduler as if today were the given date.
+func (t *testContext) theRecurringSchedulerRunsOn(date string) error {
+	now, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return fmt.Errorf("invalid scheduler date %q: %w", date, err)
+	}
+
+	userRepo := persistence.NewUserRepository(t.db.DbConn)
+	processUseCase := recurringschedule.NewProcessRecurringSchedulesUseCase(
+		persistence.NewRecurringScheduleRepository(t.db.DbConn),
+		userRepo,
+		nil,
+		exchangerate.NewConverter(persistence.NewExchangeRateRepository(t.db.DbConn), userRepo),
+	)
+
+	_, err = processUseCase.Execute(context.Background(), recurringschedule.ProcessRecurringSchedulesInput{
+		Now: now,
+	})
+	return err
+}
+
+// goalAlertsAreEvaluated runs the goal alert evaluation for the current user's goals.
+func (t *testContext) goalAlertsAreEvaluated() error {
+	evaluateUseCase := goal.NewEvaluateGoalAlertsUseCase(
+		persistence.NewGoalRepository(t.db.DbConn),
+		persistence.NewGoalAlertRepository(t.db.DbConn),
+		persistence.NewUserRepository(t.db.DbConn),
+		persistence.NewCategoryRepository(t.db.DbConn),
+		email.NewService(persistence.NewEmailQueueRepository(t.db.DbConn), "http://localhost:3000"),
+		persistence.NewTxManager(t.db.DbConn),
+	)
+
+	_, err := evaluateUseCase.Execute(context.Background(), goal.EvaluateGoalAlertsInput{
+		UserID: &t.currentUserID,
+		Now:    time.Now().UTC(),
+	})
+	return err
+}